@@ -2,5 +2,4 @@ package api
 
 ////go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen --config=v1/handler/dto/config.yaml v1/handler/dto/dto.yaml
 //go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen --config=v1/handler/config.yaml v1/handler/openapi.yml
-////go:generate go run github.com/bufbuild/buf/cmd/buf generate proto/pvz.proto --template proto/buf.gen.yaml
-////go:generate go run github.com/bufbuild/buf/cmd/buf generate proto/pvz.proto --template proto/buf.gen.doc.yaml
+//go:generate go run github.com/bufbuild/buf/cmd/buf generate proto --template proto/buf.gen.yaml
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"pr-reviewers-service/internal/app"
+	"pr-reviewers-service/internal/config"
+	"pr-reviewers-service/internal/usecase/team_apply"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+// teamsFile is the declarative teams configuration, it mirrors the POST /admin/teams/apply request. Route policies
+// are not part of it, they are read from app.policies at startup, so unknown sections are rejected rather than
+// silently ignored.
+type teamsFile struct {
+	Teams []struct {
+		TeamName       string `yaml:"team_name"`
+		ParentTeamName string `yaml:"parent_team_name"`
+		IsArchived     bool   `yaml:"is_archived"`
+		Members        []struct {
+			UserID   uuid.UUID `yaml:"user_id"`
+			Username string    `yaml:"username"`
+			IsActive bool      `yaml:"is_active"`
+		} `yaml:"members"`
+		AdditionalMemberIDs []uuid.UUID `yaml:"additional_member_ids"`
+	} `yaml:"teams"`
+}
+
+// runApply applies a teams configuration file: apply -f teams.yaml [-plan].
+func runApply(cfg config.Config, args []string) int {
+	flags := flag.NewFlagSet("apply", flag.ExitOnError)
+	path := flags.String("f", "teams.yaml", "path to the teams configuration file")
+	plan := flags.Bool("plan", false, "print the changes without applying them")
+	_ = flags.Parse(args)
+
+	req, err := readTeamsFile(*path)
+	if err != nil {
+		slog.Error("cannot read teams file:", "error", err)
+		return 1
+	}
+	req.Plan = *plan
+
+	ctx := context.Background()
+	a, err := app.NewApp(ctx, cfg)
+	if err != nil {
+		slog.Error("cannot suite app:", "error", err)
+		return 1
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := a.Stop(ctx); err != nil {
+			slog.Error("app shutdown failed", "error", err)
+		}
+	}()
+
+	result, err := a.ApplyTeams(ctx, req)
+	if err != nil {
+		slog.Error("cannot apply teams:", "error", err)
+		return 1
+	}
+	printChanges(os.Stdout, result)
+	return 0
+}
+
+func readTeamsFile(path string) (team_apply.In, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return team_apply.In{}, err
+	}
+	var file teamsFile
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err = decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return team_apply.In{}, err
+	}
+
+	req := team_apply.In{Teams: make([]team_apply.Team, 0, len(file.Teams))}
+	for _, team := range file.Teams {
+		in := team_apply.Team{
+			TeamName:            team.TeamName,
+			ParentTeamName:      team.ParentTeamName,
+			IsArchived:          team.IsArchived,
+			Members:             make([]team_apply.Member, 0, len(team.Members)),
+			AdditionalMemberIDs: team.AdditionalMemberIDs,
+		}
+		for _, member := range team.Members {
+			in.Members = append(in.Members, team_apply.Member{
+				UserID:   member.UserID,
+				Username: member.Username,
+				IsActive: member.IsActive,
+			})
+		}
+		req.Teams = append(req.Teams, in)
+	}
+	return req, nil
+}
+
+func printChanges(w io.Writer, result *team_apply.Out) {
+	if len(result.Changes) == 0 {
+		fmt.Fprintln(w, "no changes")
+		return
+	}
+	for _, change := range result.Changes {
+		line := fmt.Sprintf("%-17s %s", change.Action, change.TeamName)
+		if change.UserID != uuid.Nil {
+			line += " " + change.UserID.String()
+		}
+		if change.From != "" || change.To != "" {
+			line += fmt.Sprintf(" %q -> %q", change.From, change.To)
+		}
+		fmt.Fprintln(w, line)
+	}
+	if result.Plan {
+		fmt.Fprintf(w, "plan: %d changes, nothing applied\n", len(result.Changes))
+		return
+	}
+	fmt.Fprintf(w, "applied %d changes, %d pull requests got new reviewers, %d reviews reassigned\n",
+		len(result.Changes), len(result.AffectedPullRequests), len(result.ReassignedReviews))
+}
@@ -5,6 +5,7 @@ import (
 	"log/slog"
 	"os"
 	"time"
+	_ "time/tzdata"
 
 	"pr-reviewers-service/internal/app"
 	"pr-reviewers-service/internal/config"
@@ -12,6 +13,9 @@ import (
 
 func main() {
 	cfg := config.MustLoad("./config/config.yaml")
+	if len(os.Args) > 1 && os.Args[1] == "apply" {
+		os.Exit(runApply(cfg, os.Args[2:]))
+	}
 	ctx := context.TODO()
 
 	a, err := app.NewApp(ctx, cfg)
@@ -19,12 +23,19 @@ func main() {
 		slog.Error("cannot suite app:", "error", err)
 		os.Exit(1)
 	}
+	a.WorkersRun()
 	go func() {
 		if err = a.RestRun(); err != nil {
 			slog.Error("stop rest server:", "error", err)
 			os.Exit(1)
 		}
 	}()
+	go func() {
+		if err := a.GrpcRun(); err != nil {
+			slog.Error("stop grpc server:", "error", err)
+			os.Exit(1)
+		}
+	}()
 
 	<-ctx.Done()
 	slog.Info("got interruption signal")
@@ -15,70 +15,42 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/dummyLogin": {
-            "post": {
-                "description": "Get JWT token for testing purposes",
-                "consumes": [
-                    "application/json"
-                ],
+        "/admin/policies": {
+            "get": {
+                "description": "Get the roles allowed to call every route that needs a token: the defaults with the overrides from app.policies.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Admin"
                 ],
-                "summary": "Dummy login",
-                "operationId": "DummyLogin",
+                "summary": "Get route policies",
+                "operationId": "GetPolicies",
                 "responses": {
                     "200": {
-                        "description": "Successfully logged in",
+                        "description": "Effective policies",
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DummyLoginOut"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PoliciesResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/health": {
-            "get": {
-                "description": "Check service health and DB connection",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Health"
-                ],
-                "summary": "Health check",
-                "responses": {
-                    "200": {
-                        "description": "Service health",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
                     },
-                    "503": {
-                        "description": "Service unhealth",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/pullRequest/create": {
+        "/admin/teams/apply": {
             "post": {
-                "description": "Create PR and automatically assign up to 2 reviewers from author's team",
+                "description": "Compare the full desired state of teams and users with the database and apply the difference in one transaction.\nTeams missing from the request are archived, users missing from it are deactivated with their open reviews reassigned.\nWith plan the changes are only computed.\nRoute policies are not part of the state, they come from the app.policies configuration.",
                 "consumes": [
                     "application/json"
                 ],
@@ -86,36 +58,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "Teams"
                 ],
-                "summary": "Create pull request",
-                "operationId": "CreatePullRequest",
+                "summary": "Apply declarative teams configuration",
+                "operationId": "ApplyTeams",
                 "parameters": [
                     {
-                        "description": "Pull request data",
+                        "description": "Desired teams state",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestCreateJSONRequestBody"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostAdminTeamsApplyJSONRequestBody"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "PR successfully created",
+                    "200": {
+                        "description": "Changes applied or planned",
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.CreatePullRequestResponse"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ApplyTeamsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request (PR already exists or invalid data)",
+                        "description": "Invalid desired state",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Author not found",
+                        "description": "Team or user not found",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
@@ -135,9 +113,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/pullRequest/merge": {
-            "post": {
-                "description": "Merge an existing pull request",
+        "/codeOwners/get": {
+            "get": {
+                "description": "Get the CODEOWNERS rules uploaded for the repository in the file order",
                 "consumes": [
                     "application/json"
                 ],
@@ -145,42 +123,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "CodeOwners"
                 ],
-                "summary": "Merge pull request",
-                "operationId": "MergePullRequest",
+                "summary": "Get CODEOWNERS",
+                "operationId": "GetCodeOwners",
                 "parameters": [
                     {
-                        "description": "Pull request merge data",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestMergeJSONRequestBody"
-                        }
+                        "type": "string",
+                        "description": "Repository, e.g. org/service",
+                        "name": "repository",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "PR successfully merged or already merged",
+                        "description": "Uploaded rules",
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.MergePullRequestResponse"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.CodeOwnersResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request data",
+                        "description": "Missing repository",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Pull request not found",
-                        "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
-                        }
-                    },
-                    "422": {
-                        "description": "Validation failed",
+                        "description": "Nothing uploaded for the repository",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
@@ -194,9 +164,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/pullRequest/reassign": {
+        "/codeOwners/upload": {
             "post": {
-                "description": "Replace one reviewer with another from the same team",
+                "description": "Replace the CODEOWNERS file of the repository. Rules follow GitHub syntax and precedence,\nevery owner must be a known user, email identity or team, otherwise the file is rejected.",
                 "consumes": [
                     "application/json"
                 ],
@@ -204,48 +174,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PullRequests"
+                    "CodeOwners"
                 ],
-                "summary": "Reassign pull request reviewer",
-                "operationId": "ReassignPullRequest",
+                "summary": "Upload CODEOWNERS",
+                "operationId": "UploadCodeOwners",
                 "parameters": [
                     {
-                        "description": "Reassignment data",
+                        "description": "CODEOWNERS file",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestReassignJSONRequestBody"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostCodeOwnersUploadJSONRequestBody"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Reviewer successfully reassigned",
+                        "description": "Parsed rules",
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ReassignPullRequestResponse"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.CodeOwnersResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request data",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Pull request, reviewer, author not found or no available reviewers",
-                        "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Pull request already merged",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
                     },
                     "422": {
-                        "description": "Validation failed",
+                        "description": "Invalid file or unknown owners",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
@@ -259,26 +223,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/stats/reviewers": {
-            "get": {
-                "description": "Get assignment count statistics for all reviewers",
+        "/dummyLogin": {
+            "post": {
+                "description": "Get JWT token for testing purposes. Without a body or with an empty one the token is for ADMIN with a random id,\nwith a body it is for the given role and user.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Statistics"
+                    "User"
+                ],
+                "summary": "Dummy login",
+                "operationId": "DummyLogin",
+                "parameters": [
+                    {
+                        "description": "Role and user of the token",
+                        "name": "input",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DummyLoginRequest"
+                        }
+                    }
                 ],
-                "summary": "Get reviewers assignment statistics",
-                "operationId": "GetReviewersStats",
                 "responses": {
                     "200": {
-                        "description": "Statistics successfully retrieved",
+                        "description": "Successfully logged in",
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ReviewersStatsResponse"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DummyLoginOut"
                         }
                     },
-                    "404": {
-                        "description": "Reviewers data not found",
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
@@ -292,9 +275,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/team/add": {
-            "post": {
-                "description": "Create a new team with members (creates/updates users)",
+        "/health": {
+            "get": {
+                "description": "Check service health and DB connection",
                 "consumes": [
                     "application/json"
                 ],
@@ -302,49 +285,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
-                ],
-                "summary": "Create team with members",
-                "operationId": "AddTeam",
-                "parameters": [
-                    {
-                        "description": "Team data with members",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostTeamAddJSONRequestBody"
-                        }
-                    }
+                    "Health"
                 ],
+                "summary": "Health check",
                 "responses": {
-                    "201": {
-                        "description": "Team successfully created",
-                        "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.Team"
-                        }
-                    },
-                    "304": {
-                        "description": "No changes - team exists and no users were changed or added"
-                    },
-                    "400": {
-                        "description": "Validation failed or duplicate users",
+                    "200": {
+                        "description": "Service health",
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "503": {
+                        "description": "Service unhealth",
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     }
                 }
             }
         },
-        "/team/deactivateUsers": {
-            "patch": {
-                "description": "Deactivate multiple users in a team and handle PR reassignments",
+        "/integrations/github/webhook": {
+            "post": {
+                "description": "Receive GitHub pull_request events and apply them to the pull request lifecycle",
                 "consumes": [
                     "application/json"
                 ],
@@ -352,26 +316,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Integrations"
                 ],
-                "summary": "Deactivate team users",
-                "operationId": "DeactivateTeamUsers",
+                "summary": "GitHub webhook",
+                "operationId": "GithubWebhook",
                 "parameters": [
                     {
-                        "description": "Team deactivation data",
+                        "type": "string",
+                        "description": "GitHub event name",
+                        "name": "X-GitHub-Event",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "GitHub delivery id",
+                        "name": "X-GitHub-Delivery",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "HMAC SHA-256 signature of the body",
+                        "name": "X-Hub-Signature-256",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "GitHub pull_request event",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PatchTeamDeactivateUsersJSONRequestBody"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.GithubPullRequestEvent"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Users successfully deactivated",
+                        "description": "Event processed, duplicated or ignored",
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DeactivateTeamUsersResponse"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.WebhookResponse"
                         }
                     },
                     "400": {
@@ -380,14 +365,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Team, users, or pull requests not found",
-                        "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "User does not belong to the specified team",
+                    "401": {
+                        "description": "Invalid signature",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
@@ -407,9 +386,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/team/get": {
-            "get": {
-                "description": "Get team details with members by team name",
+        "/integrations/gitlab/webhook": {
+            "post": {
+                "description": "Receive GitLab merge request events and apply them to the pull request lifecycle",
                 "consumes": [
                     "application/json"
                 ],
@@ -417,34 +396,63 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Teams"
+                    "Integrations"
                 ],
-                "summary": "Get team information",
-                "operationId": "GetTeam",
+                "summary": "GitLab webhook",
+                "operationId": "GitlabWebhook",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Team name",
-                        "name": "team_name",
-                        "in": "query",
+                        "description": "GitLab event name",
+                        "name": "X-Gitlab-Event",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "GitLab delivery id",
+                        "name": "X-Gitlab-Event-UUID",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Webhook secret token",
+                        "name": "X-Gitlab-Token",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "GitLab merge request event",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.GitlabMergeRequestEvent"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Team found successfully",
+                        "description": "Event processed, duplicated or ignored",
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.Team"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.WebhookResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid team_name parameter",
+                        "description": "Invalid request data",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Team not found",
+                    "401": {
+                        "description": "Invalid token",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
@@ -458,9 +466,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/review": {
-            "get": {
-                "description": "Get all pull requests assigned to user for review",
+        "/pullRequest/create": {
+            "post": {
+                "description": "Create PR and automatically assign up to 2 reviewers from author's team.\nauthor_id is either a user UUID or an external identity like github:alice.\nWith repository and changed_files the owners of the changed files from the uploaded CODEOWNERS\nare required reviewers, reviewers shows which rule produced each of them.",
                 "consumes": [
                     "application/json"
                 ],
@@ -468,35 +476,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reviews"
+                    "PullRequests"
                 ],
-                "summary": "Get users pull requests for review",
-                "operationId": "GetUserReviewPRs",
-                "parameters": [
+                "summary": "Create pull request",
+                "operationId": "CreatePullRequest",
+                "parameters": [
                     {
-                        "type": "string",
-                        "format": "uuid",
-                        "description": "User ID",
-                        "name": "user_id",
-                        "in": "query",
-                        "required": true
+                        "description": "Pull request data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestCreateJSONRequestBody"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Successfully retrieved pull requests",
+                    "201": {
+                        "description": "PR successfully created",
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.GetUserReviewPRsResponse"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.CreatePullRequestResponse"
                         }
                     },
                     "400": {
-                        "description": "Missing or invalid user_id",
+                        "description": "Bad request (PR already exists or invalid data)",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "User not found or no active reviewers",
+                        "description": "Author not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
@@ -510,9 +525,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/setIsActive": {
+        "/pullRequest/merge": {
             "post": {
-                "description": "Activate or deactivate a user",
+                "description": "Merge an existing pull request",
                 "consumes": [
                     "application/json"
                 ],
@@ -520,39 +535,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "PullRequests"
                 ],
-                "summary": "Set user active status",
-                "operationId": "SetIsActive",
+                "summary": "Merge pull request",
+                "operationId": "MergePullRequest",
                 "parameters": [
                     {
-                        "description": "User status data",
+                        "description": "Pull request merge data",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostUsersSetIsActiveJSONRequestBody"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestMergeJSONRequestBody"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User status successfully updated",
+                        "description": "PR successfully merged or already merged",
                         "schema": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.SetUserActiveStatusResponse"
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.MergePullRequestResponse"
                         }
                     },
-                    "304": {
-                        "description": "User already has the target status (no changes)"
-                    },
                     "400": {
                         "description": "Invalid request data",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Users can merge only their own pull requests",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "User not found",
+                        "description": "Pull request not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pull request was closed without merge",
                         "schema": {
                             "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
                         }
@@ -571,167 +595,4119 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "pr-reviewers-service_internal_generated_api_v1_handler.CreatePullRequestResponse": {
-            "type": "object",
-            "properties": {
-                "pr": {
-                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequest"
-                }
-            }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.DeactivateTeamUsersResponse": {
-            "type": "object",
-            "properties": {
-                "affected_pull_requests": {
-                    "description": "AffectedPullRequests Список PR где были изменены ревьюверы",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequestShort"
+        "/pullRequest/reassign": {
+            "post": {
+                "description": "Replace one reviewer with another from the same team",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PullRequests"
+                ],
+                "summary": "Reassign pull request reviewer",
+                "operationId": "ReassignPullRequest",
+                "parameters": [
+                    {
+                        "description": "Reassignment data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestReassignJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reviewer successfully reassigned",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ReassignPullRequestResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Users can reassign only their own reviews",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Pull request, reviewer, author not found or no available reviewers",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Pull request already merged or closed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
                     }
-                },
-                "team": {
-                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.Team"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.DummyLoginOut": {
-            "type": "object",
-            "properties": {
-                "token": {
-                    "type": "string"
+        "/scim/v2/Groups": {
+            "get": {
+                "description": "Filter supports only displayName eq, archived teams are listed only when filtered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "List SCIM groups",
+                "operationId": "ScimListGroups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "displayName eq \\",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "1-based index of the first result",
+                        "name": "startIndex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, at most 100",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Groups",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimGroupListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unsupported filter or invalid paging",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    }
                 }
-            }
-        },
-        "pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "object",
-                    "properties": {
-                        "code": {
-                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponseErrorCode"
-                        },
-                        "message": {
-                            "type": "string"
+            },
+            "post": {
+                "description": "Create a team, members still in the default team make it their primary team",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Create SCIM group",
+                "operationId": "ScimCreateGroup",
+                "parameters": [
+                    {
+                        "description": "SCIM group",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimGroup"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Group created",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimGroup"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or unknown member",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "409": {
+                        "description": "displayName already taken",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
                         }
                     }
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponseErrorCode": {
-            "type": "string",
-            "enum": [
-                "BAD_REQUEST",
-                "NO_CANDIDATE",
-                "NOT_ASSIGNED",
-                "NOT_FOUND",
-                "PR_EXISTS",
-                "PR_MERGED",
-                "TEAM_EXISTS",
-                "UNKNOWN"
-            ],
-            "x-enum-varnames": [
-                "BADREQUEST",
-                "NOCANDIDATE",
-                "NOTASSIGNED",
-                "NOTFOUND",
-                "PREXISTS",
-                "PRMERGED",
-                "TEAMEXISTS",
+        "/scim/v2/Groups/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Get SCIM group",
+                "operationId": "ScimGetGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Group",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimGroup"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "404": {
+                        "description": "Group not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove the team, its primary members are moved back to the default team",
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Delete SCIM group",
+                "operationId": "ScimDeleteGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Group deleted"
+                    },
+                    "401": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "404": {
+                        "description": "Group not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "409": {
+                        "description": "Default team cannot be deleted",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Supports displayName and members, including removal by members[value eq \"id\"]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Patch SCIM group",
+                "operationId": "ScimPatchGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SCIM patch operations",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Patched group",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimGroup"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid operation, path, value or unknown member",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "404": {
+                        "description": "Group not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "409": {
+                        "description": "displayName already taken or default team renamed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    }
+                }
+            }
+        },
+        "/scim/v2/Users": {
+            "get": {
+                "description": "Filter supports only userName eq and externalId eq, userName is compared case-insensitively",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "List SCIM users",
+                "operationId": "ScimListUsers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "userName eq \\",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "1-based index of the first result",
+                        "name": "startIndex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, at most 100",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimUserListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unsupported filter or invalid paging",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Provision a user into the default team, externalId is kept as the scim identity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Create SCIM user",
+                "operationId": "ScimCreateUser",
+                "parameters": [
+                    {
+                        "description": "SCIM user",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimUser"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "User created",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "409": {
+                        "description": "userName or externalId already taken",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    }
+                }
+            }
+        },
+        "/scim/v2/Users/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Get SCIM user",
+                "operationId": "ScimGetUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimUser"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Hands over open reviews of the user and removes the user with the pull requests they authored",
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Delete SCIM user",
+                "operationId": "ScimDeleteUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "User deleted"
+                    },
+                    "401": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Supports active, userName and externalId. active=false hands over open reviews like team deactivation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SCIM"
+                ],
+                "summary": "Patch SCIM user",
+                "operationId": "ScimPatchUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SCIM patch operations",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimPatchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Patched user",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimUser"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid operation, path or value",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid bearer token",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "409": {
+                        "description": "userName or externalId already taken",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimError"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/reviewers": {
+            "get": {
+                "description": "Get assignment count statistics for all reviewers.\nWith team_name only members of that team are counted, include_subteams rolls up the whole subtree.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Statistics"
+                ],
+                "summary": "Get reviewers assignment statistics",
+                "operationId": "GetReviewersStats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team_name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include members of all subteams",
+                        "name": "include_subteams",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Statistics successfully retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ReviewersStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid include_subteams parameter",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Reviewers data or team not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/activateUsers": {
+            "patch": {
+                "description": "Activate multiple users in a team. With backfill the returning users are assigned\nto open PRs of the team that have fewer reviewers than required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Activate team users",
+                "operationId": "ActivateTeamUsers",
+                "parameters": [
+                    {
+                        "description": "Team activation data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PatchTeamActivateUsersJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users successfully activated",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ActivateTeamUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Team or users not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "User does not belong to the specified team",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/add": {
+            "post": {
+                "description": "Create a new team with members (creates/updates users).\nUsers that already belong to another team keep their primary team and join this one as additional members.\nWith parent_team_name the team is nested under that team, reviewers are looked up there when the team runs out of candidates.\nA null or empty parent_team_name makes the team a root one, without the field the parent is kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Create team with members",
+                "operationId": "AddTeam",
+                "parameters": [
+                    {
+                        "description": "Team data with members",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostTeamAddJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Team successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.Team"
+                        }
+                    },
+                    "304": {
+                        "description": "No changes - team exists and no users were changed or added"
+                    },
+                    "400": {
+                        "description": "Validation failed, duplicate users or cyclic team hierarchy",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Parent team not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Identity is already linked to another user",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/deactivateUsers": {
+            "patch": {
+                "description": "Deactivate multiple users in a team and handle PR reassignments.\nReturns a per-PR report; with dry_run the report is computed and all changes are rolled back.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Deactivate team users",
+                "operationId": "DeactivateTeamUsers",
+                "parameters": [
+                    {
+                        "description": "Team deactivation data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PatchTeamDeactivateUsersJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Users successfully deactivated",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DeactivateTeamUsersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Team or users not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "User does not belong to the specified team",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/delete": {
+            "post": {
+                "description": "Delete team. Users whose primary team it is stay in their oldest other team. Users without one\nare moved to the default team if they authored pull requests or reviewed closed ones, the rest\nis deleted. The request is refused while these users have open pull requests unless\ncascade_reassign is set, then their reviews are reassigned to the author's team.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Delete team",
+                "operationId": "DeleteTeam",
+                "parameters": [
+                    {
+                        "description": "Team delete data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostTeamDeleteJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team successfully deleted",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DeleteTeamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Team members have open pull requests or the default team has pull request history",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/get": {
+            "get": {
+                "description": "Get team details with members by team name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Get team information",
+                "operationId": "GetTeam",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name",
+                        "name": "team_name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team found successfully",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.Team"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid team_name parameter",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/list": {
+            "get": {
+                "description": "List not archived teams ordered by name with member counts, open PRs\nand average open reviews per active member. Supports name prefix search and pagination.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "List teams",
+                "operationId": "ListTeams",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Team name prefix, case insensitive",
+                        "name": "name_prefix",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Page size, 1-100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of teams to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Teams page",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ListTeamsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid limit or offset",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/rebalance": {
+            "post": {
+                "description": "Move open review assignments between active team members until the gap between the busiest\nand the least busy member is within tolerance. With dry_run the plan is computed and rolled back.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Rebalance team review workload",
+                "operationId": "RebalanceTeam",
+                "parameters": [
+                    {
+                        "description": "Team rebalance data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostTeamRebalanceJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Workload successfully rebalanced",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.RebalanceTeamResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/rename": {
+            "post": {
+                "description": "Rename team keeping team names unique",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Rename team",
+                "operationId": "RenameTeam",
+                "parameters": [
+                    {
+                        "description": "Team rename data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostTeamRenameJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team successfully renamed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.RenameTeamResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "Team already has this name"
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Team with such name already exists",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/setIsArchived": {
+            "post": {
+                "description": "Members of an archived team are not selected as reviewers and the team is hidden from the team tree",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Archive or restore team",
+                "operationId": "SetTeamIsArchived",
+                "parameters": [
+                    {
+                        "description": "Team archive data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostTeamSetIsArchivedJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team archive state updated",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.SetTeamIsArchivedResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "Team already has this archive state"
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/team/tree": {
+            "get": {
+                "description": "Get all root teams with their nested subteams, or only the subtree of team_name when it is given",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Teams"
+                ],
+                "summary": "Get team hierarchy",
+                "operationId": "GetTeamTree",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Root team name",
+                        "name": "team_name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Team tree",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.TeamTreeResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Team not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/review": {
+            "get": {
+                "description": "Get all pull requests assigned to user for review",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Get users pull requests for review",
+                "operationId": "GetUserReviewPRs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully retrieved pull requests",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.GetUserReviewPRsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing or invalid user_id",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Users can read only their own review queue",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found or no active reviewers",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/addIdentity": {
+            "post": {
+                "description": "Link a GitHub/GitLab login or an email to the user. Provider and external_id are case insensitive.\nLinking an identity the user already has is a no-op.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Link external identity to user",
+                "operationId": "AddUserIdentity",
+                "parameters": [
+                    {
+                        "description": "Identity data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostUsersAddIdentityJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "All identities of the user",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.UserIdentitiesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown provider or empty external_id",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Identity is already linked to another user",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/deleteIdentity": {
+            "post": {
+                "description": "Unlink a GitHub/GitLab login or an email from the user and return the remaining identities.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Unlink external identity from user",
+                "operationId": "DeleteUserIdentity",
+                "parameters": [
+                    {
+                        "description": "Identity data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostUsersDeleteIdentityJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Remaining identities of the user",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.UserIdentitiesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown provider or empty external_id",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Identity is not linked to the user",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/findByIdentity": {
+            "get": {
+                "description": "Find the user a GitHub/GitLab login or an email is linked to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Find user by external identity",
+                "operationId": "FindUserByIdentity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Identity provider: github, gitlab or email",
+                        "name": "provider",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Login or email in the provider",
+                        "name": "external_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User the identity is linked to",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.FindUserByIdentityResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Unknown provider or empty external_id",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Identity is not linked to any user",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/get": {
+            "get": {
+                "description": "Get user with primary and additional teams and a workload summary:\nopen reviews, authored open PRs and reviews completed in the last 30 days.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get user profile",
+                "operationId": "GetUser",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User found successfully",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.GetUserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing or invalid user_id",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/getIdentities": {
+            "get": {
+                "description": "Get GitHub/GitLab logins and emails linked to the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get user identities",
+                "operationId": "GetUserIdentities",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Identities of the user",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.UserIdentitiesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing or invalid user_id",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/handoverReviews": {
+            "post": {
+                "description": "Move every open review assignment of one user to another user.\nPRs where the target is the author or already assigned fall back to regular team selection.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Hand over reviews",
+                "operationId": "HandoverReviews",
+                "parameters": [
+                    {
+                        "description": "Handover data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostUsersHandoverReviewsJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reviews successfully handed over",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.HandoverReviewsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Users can hand over only their own reviews",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Target user is inactive",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/moveTeam": {
+            "post": {
+                "description": "Change the primary team of a user. Optionally hand the user's open reviews on old team PRs over\nto other old team members and re-select reviewers of the user's open PRs from the new team.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Move user to another team",
+                "operationId": "MoveUserTeam",
+                "parameters": [
+                    {
+                        "description": "Team move data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostUsersMoveTeamJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User successfully moved",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.MoveUserTeamResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "User already belongs to the team"
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User or team not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/reviewStream": {
+            "get": {
+                "description": "Server-Sent Events stream of the user's review queue: assignments, unassignments and merged or\nclosed pull requests the user reviews. The event id is the event UUID, a reconnecting client\npasses the last one in Last-Event-ID to get the events it missed.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Reviews"
+                ],
+                "summary": "Stream review queue changes",
+                "operationId": "StreamUserReviews",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Last received event ID",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of events, data is the JSON event",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ReviewStreamEvent"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing or invalid user_id or Last-Event-ID",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Users can read only their own review queue",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/setIsActive": {
+            "post": {
+                "description": "Activate or deactivate a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Set user active status",
+                "operationId": "SetIsActive",
+                "parameters": [
+                    {
+                        "description": "User status data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostUsersSetIsActiveJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User status successfully updated",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.SetUserActiveStatusResponse"
+                        }
+                    },
+                    "304": {
+                        "description": "User already has the target status (no changes)"
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/setUnavailable": {
+            "post": {
+                "description": "Mark a user unavailable until the given time, reviewers are not picked among unavailable users.\nWithout unavailable_until the user becomes available again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Set user unavailability",
+                "operationId": "SetUnavailable",
+                "parameters": [
+                    {
+                        "description": "Unavailability data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostUsersSetUnavailableJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User unavailability successfully updated",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.SetUserUnavailableResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or time in the past",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Users can change only their own unavailability",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/snoozeReview": {
+            "post": {
+                "description": "Stop stale-review reminders about one PR for its reviewer until the given time.\nA repeated call replaces the previous snooze.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Snooze review reminders",
+                "operationId": "SnoozeReview",
+                "parameters": [
+                    {
+                        "description": "Snooze data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostUsersSnoozeReviewJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reminders snoozed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.SnoozeReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or time in the past",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Users can snooze only their own reviews",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User, pull request or reviewer not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/deliveries": {
+            "get": {
+                "description": "List webhook deliveries newest first, optionally filtered by subscription and status.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Webhook delivery log",
+                "operationId": "ListWebhookDeliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "format": "uuid",
+                        "description": "Subscription ID",
+                        "name": "subscription_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "PENDING",
+                            "DELIVERED",
+                            "FAILED"
+                        ],
+                        "type": "string",
+                        "description": "Delivery status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Page size, 1-200",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of deliveries to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deliveries page",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.WebhookDeliveriesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter, limit or offset",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/list": {
+            "get": {
+                "description": "List webhook subscriptions, signing secrets are not returned",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook subscriptions",
+                "operationId": "ListWebhookSubscriptions",
+                "responses": {
+                    "200": {
+                        "description": "Subscriptions",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.WebhookSubscriptionsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/replayDelivery": {
+            "post": {
+                "description": "Put a FAILED webhook delivery back into the queue with a reset attempts counter",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Replay failed delivery",
+                "operationId": "ReplayWebhookDelivery",
+                "parameters": [
+                    {
+                        "description": "Delivery to replay",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostWebhooksReplayDeliveryJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delivery queued",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ReplayWebhookDeliveryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Delivery is not failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/subscribe": {
+            "post": {
+                "description": "Register an HTTP endpoint for reviewer assignment and merge events. Events are POSTed as JSON\nsigned with HMAC-SHA256 of the subscription secret and retried with exponential backoff.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Subscribe to events",
+                "operationId": "SubscribeWebhook",
+                "parameters": [
+                    {
+                        "description": "Subscription data",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostWebhooksSubscribeJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Subscription created",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.SubscribeWebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data or unknown event",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/unsubscribe": {
+            "post": {
+                "description": "Delete webhook subscription together with its delivery log",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Unsubscribe from events",
+                "operationId": "UnsubscribeWebhook",
+                "parameters": [
+                    {
+                        "description": "Subscription to delete",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PostWebhooksUnsubscribeJSONRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Subscription deleted",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.UnsubscribeWebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request data",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Validation failed",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.ActivateTeamUsersResponse": {
+            "type": "object",
+            "properties": {
+                "backfilled_pull_requests": {
+                    "description": "BackfilledPullRequests Список PR, на которые были назначены вернувшиеся пользователи",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.BackfilledPullRequest"
+                    }
+                },
+                "team": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.Team"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ApplyTeam": {
+            "type": "object",
+            "required": [
+                "team_name"
+            ],
+            "properties": {
+                "additional_member_ids": {
+                    "description": "AdditionalMemberIds Дополнительные участники, их основная команда указывается в другом элементе списка",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_archived": {
+                    "type": "boolean"
+                },
+                "members": {
+                    "description": "Members Пользователи, для которых команда основная",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ApplyTeamMember"
+                    }
+                },
+                "parent_team_name": {
+                    "description": "ParentTeamName Родительская команда, должна присутствовать в списке",
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ApplyTeamMember": {
+            "type": "object",
+            "required": [
+                "user_id",
+                "username"
+            ],
+            "properties": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ApplyTeamsChange": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ApplyTeamsChangeAction"
+                },
+                "from": {
+                    "description": "From Прежнее значение для set_parent, rename_user и move_user",
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "to": {
+                    "description": "To Новое значение для set_parent, rename_user, move_user и имя создаваемого пользователя",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserId Пользователь, null для изменений команды",
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ApplyTeamsChangeAction": {
+            "type": "string",
+            "enum": [
+                "activate_user",
+                "add_membership",
+                "archive_team",
+                "create_team",
+                "create_user",
+                "deactivate_user",
+                "move_user",
+                "remove_membership",
+                "rename_user",
+                "set_parent",
+                "unarchive_team"
+            ],
+            "x-enum-varnames": [
+                "ActivateUser",
+                "AddMembership",
+                "ArchiveTeam",
+                "CreateTeam",
+                "CreateUser",
+                "DeactivateUser",
+                "MoveUser",
+                "RemoveMembership",
+                "RenameUser",
+                "SetParent",
+                "UnarchiveTeam"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ApplyTeamsResponse": {
+            "type": "object",
+            "properties": {
+                "affected_pull_requests": {
+                    "description": "AffectedPullRequests PR, где были заменены ревьюверы деактивированных пользователей",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DeactivationAffectedPullRequest"
+                    }
+                },
+                "changes": {
+                    "description": "Changes Изменения в порядке применения",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ApplyTeamsChange"
+                    }
+                },
+                "plan": {
+                    "description": "Plan true, если изменения не были сохранены",
+                    "type": "boolean"
+                },
+                "reassigned_reviews": {
+                    "description": "ReassignedReviews Открытые ревью переведённых пользователей на PR их старых команд",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.MoveTeamReassignedReview"
+                    }
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.BackfilledPullRequest": {
+            "type": "object",
+            "properties": {
+                "added_reviewers": {
+                    "description": "AddedReviewers user_id назначенных ревьюверов",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "understaffed": {
+                    "description": "Understaffed true, если у PR всё ещё меньше ревьюверов, чем требуется",
+                    "type": "boolean"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.CodeOwnersResponse": {
+            "type": "object",
+            "properties": {
+                "repository": {
+                    "type": "string"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.CodeOwnersRule"
+                    }
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.CodeOwnersRule": {
+            "type": "object",
+            "properties": {
+                "line": {
+                    "type": "integer"
+                },
+                "owners": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pattern": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.CreatePullRequestResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequest"
+                },
+                "reviewers": {
+                    "description": "Reviewers Назначенные ревьюверы в порядке назначения и причина выбора каждого",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ReviewerAssignment"
+                    }
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.DeactivateTeamUsersResponse": {
+            "type": "object",
+            "properties": {
+                "affected_pull_requests": {
+                    "description": "AffectedPullRequests Список PR где были изменены ревьюверы",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DeactivationAffectedPullRequest"
+                    }
+                },
+                "dry_run": {
+                    "description": "DryRun true, если изменения не были сохранены",
+                    "type": "boolean"
+                },
+                "team": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.Team"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.DeactivationAffectedPullRequest": {
+            "type": "object",
+            "properties": {
+                "added_reviewers": {
+                    "description": "AddedReviewers user_id назначенных взамен ревьюверов",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "removed_reviewers": {
+                    "description": "RemovedReviewers user_id снятых с PR ревьюверов",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DeactivationAffectedPullRequestStatus"
+                },
+                "understaffed": {
+                    "description": "Understaffed true, если у PR осталось меньше ревьюверов, чем требуется",
+                    "type": "boolean"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.DeactivationAffectedPullRequestStatus": {
+            "type": "string",
+            "enum": [
+                "MERGED",
+                "OPEN"
+            ],
+            "x-enum-varnames": [
+                "DeactivationAffectedPullRequestStatusMERGED",
+                "DeactivationAffectedPullRequestStatusOPEN"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.DeleteTeamResponse": {
+            "type": "object",
+            "properties": {
+                "affected_pull_requests": {
+                    "description": "AffectedPullRequests PR других команд, где были заменены ревьюверы",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DeactivationAffectedPullRequest"
+                    }
+                },
+                "deleted_user_ids": {
+                    "description": "DeletedUserIds Пользователи, для которых команда была основной и единственной и у которых нет истории PR, удалены\nвместе с ней",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "detached_members": {
+                    "description": "DetachedMembers Пользователи без других команд, которые были авторами PR или ревьюверами закрытых PR: история\nсохраняется, пользователи переведены в команду по умолчанию",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DeletedTeamPromotedMember"
+                    }
+                },
+                "promoted_members": {
+                    "description": "PromotedMembers Пользователи, для которых команда была основной, остались в другой своей команде",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DeletedTeamPromotedMember"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.DeletedTeamPromotedMember": {
+            "type": "object",
+            "properties": {
+                "team_name": {
+                    "description": "TeamName Команда, которая стала основной для пользователя",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.DummyLoginOut": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.DummyLoginRequest": {
+            "type": "object",
+            "required": [
+                "role",
+                "user_id"
+            ],
+            "properties": {
+                "role": {
+                    "enum": [
+                        "ADMIN",
+                        "USER"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.DummyLoginRequestRole"
+                        }
+                    ]
+                },
+                "user_id": {
+                    "description": "UserId Пользователь, от имени которого выдаётся токен",
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.DummyLoginRequestRole": {
+            "type": "string",
+            "enum": [
+                "ADMIN",
+                "USER"
+            ],
+            "x-enum-varnames": [
+                "ADMIN",
+                "USER"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "object",
+                    "properties": {
+                        "code": {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponseErrorCode"
+                        },
+                        "message": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ErrorResponseErrorCode": {
+            "type": "string",
+            "enum": [
+                "BAD_REQUEST",
+                "DELIVERY_NOT_FAILED",
+                "FORBIDDEN",
+                "NO_CANDIDATE",
+                "NOT_ASSIGNED",
+                "NOT_FOUND",
+                "PR_CLOSED",
+                "PR_EXISTS",
+                "PR_MERGED",
+                "TEAM_EXISTS",
+                "TEAM_HAS_OPEN_PRS",
+                "TEAM_HAS_PR_HISTORY",
+                "UNKNOWN"
+            ],
+            "x-enum-varnames": [
+                "BADREQUEST",
+                "DELIVERYNOTFAILED",
+                "FORBIDDEN",
+                "NOCANDIDATE",
+                "NOTASSIGNED",
+                "NOTFOUND",
+                "PRCLOSED",
+                "PREXISTS",
+                "PRMERGED",
+                "TEAMEXISTS",
+                "TEAMHASOPENPRS",
+                "TEAMHASPRHISTORY",
                 "UNKNOWN"
             ]
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.GetUserReviewPRsResponse": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.FindUserByIdentityResponse": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.User"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.GetUserResponse": {
+            "type": "object",
+            "properties": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "is_available": {
+                    "description": "IsAvailable Пользователь активен и не отмечен недоступным, ревьюеры подбираются только среди доступных",
+                    "type": "boolean"
+                },
+                "team_name": {
+                    "description": "TeamName Основная команда пользователя",
+                    "type": "string"
+                },
+                "teams": {
+                    "description": "Teams Все команды пользователя, основная идёт первой",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.UserTeamMembership"
+                    }
+                },
+                "unavailable_until": {
+                    "description": "UnavailableUntil До какого момента пользователь недоступен, только пока недоступность не закончилась",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "workload": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.UserWorkload"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.GetUserReviewPRsResponse": {
+            "type": "object",
+            "properties": {
+                "pull_requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequestShort"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.GithubPullRequest": {
+            "type": "object",
+            "required": [
+                "html_url",
+                "title"
+            ],
+            "properties": {
+                "draft": {
+                    "type": "boolean"
+                },
+                "html_url": {
+                    "type": "string"
+                },
+                "merged": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.GithubUser"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.GithubPullRequestEvent": {
+            "type": "object",
+            "required": [
+                "action"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "pull_request": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.GithubPullRequest"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.GithubUser": {
+            "type": "object",
+            "required": [
+                "login"
+            ],
+            "properties": {
+                "login": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.GitlabBoolChange": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "type": "boolean"
+                },
+                "previous": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.GitlabMergeRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "title",
+                "url"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "author_id": {
+                    "type": "integer"
+                },
+                "draft": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.GitlabMergeRequestChanges": {
+            "type": "object",
+            "properties": {
+                "draft": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.GitlabBoolChange"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.GitlabMergeRequestEvent": {
+            "type": "object",
+            "required": [
+                "object_kind"
+            ],
+            "properties": {
+                "changes": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.GitlabMergeRequestChanges"
+                },
+                "object_attributes": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.GitlabMergeRequest"
+                },
+                "object_kind": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.GitlabUser"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.GitlabUser": {
+            "type": "object",
+            "required": [
+                "username"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.HandoverPullRequest": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "new_reviewer_id": {
+                    "description": "NewReviewerId user_id нового ревьювера, null если замену найти не удалось",
+                    "type": "string"
+                },
+                "outcome": {
+                    "description": "Outcome HANDED_OVER - ревью передано целевому пользователю;\nREASSIGNED - целевой пользователь автор PR или уже назначен, выбран другой участник команды автора;\nUNASSIGNED - свободных ревьюверов нет, пользователь снят без замены",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.HandoverPullRequestOutcome"
+                        }
+                    ]
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.HandoverPullRequestOutcome": {
+            "type": "string",
+            "enum": [
+                "HANDED_OVER",
+                "REASSIGNED",
+                "UNASSIGNED"
+            ],
+            "x-enum-varnames": [
+                "HANDEDOVER",
+                "REASSIGNED",
+                "UNASSIGNED"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.HandoverReviewsResponse": {
+            "type": "object",
+            "properties": {
+                "from_user_id": {
+                    "type": "string"
+                },
+                "pull_requests": {
+                    "description": "PullRequests Отчёт по каждому открытому PR, с которого был снят пользователь",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.HandoverPullRequest"
+                    }
+                },
+                "to_user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ListTeamsResponse": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "teams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.TeamListItem"
+                    }
+                },
+                "total": {
+                    "description": "Total Общее число команд, подходящих под фильтр",
+                    "type": "integer"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.MergePullRequestResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequest"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.MoveTeamReassignedReview": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "new_reviewer_id": {
+                    "description": "NewReviewerId user_id нового ревьювера, null если замену найти не удалось",
+                    "type": "string"
+                },
+                "outcome": {
+                    "description": "Outcome REASSIGNED - ревью передано другому участнику старой команды;\nUNASSIGNED - свободных ревьюверов нет, пользователь снят без замены",
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.MoveTeamReselectedPullRequest": {
+            "type": "object",
+            "properties": {
+                "added_reviewers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "removed_reviewers": {
+                    "description": "RemovedReviewers Ревьюверы, не входящие в новую команду",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "understaffed": {
+                    "description": "Understaffed true, если в новой команде не хватило ревьюверов",
+                    "type": "boolean"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.MoveUserTeamResponse": {
+            "type": "object",
+            "properties": {
+                "new_team_name": {
+                    "type": "string"
+                },
+                "old_team_name": {
+                    "type": "string"
+                },
+                "reassigned_reviews": {
+                    "description": "ReassignedReviews Открытые ревью на PR старой команды, с которых снят пользователь",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.MoveTeamReassignedReview"
+                    }
+                },
+                "reselected_pull_requests": {
+                    "description": "ReselectedPullRequests Открытые PR пользователя, ревьюверы которых подобраны из новой команды",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.MoveTeamReselectedPullRequest"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PatchTeamActivateUsersJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "team_name",
+                "user_ids"
+            ],
+            "properties": {
+                "backfill": {
+                    "description": "Backfill Назначить вернувшихся пользователей ревьюерами на открытые PR команды, где ревьюеров не хватает",
+                    "type": "boolean"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "user_ids": {
+                    "description": "UserIds Список ID пользователей для активации",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PatchTeamDeactivateUsersJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "team_name",
+                "user_ids"
+            ],
+            "properties": {
+                "dry_run": {
+                    "description": "DryRun Рассчитать отчёт без сохранения изменений (транзакция откатывается)",
+                    "type": "boolean"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "user_ids": {
+                    "description": "UserIds Список ID пользователей для деактивации",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PoliciesResponse": {
+            "type": "object",
+            "properties": {
+                "policies": {
+                    "description": "Policies Действующие правила, отсортированные по ручке",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.RoutePolicy"
+                    }
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostAdminTeamsApplyJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "teams"
+            ],
+            "properties": {
+                "plan": {
+                    "description": "Plan Только рассчитать изменения, ничего не сохраняя",
+                    "type": "boolean"
+                },
+                "teams": {
+                    "description": "Teams Полное желаемое состояние: команды, которых нет в списке, архивируются,\nпользователи, которых нет в списке, деактивируются",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ApplyTeam"
+                    }
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostCodeOwnersUploadJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "content",
+                "repository"
+            ],
+            "properties": {
+                "content": {
+                    "description": "Content Содержимое файла CODEOWNERS",
+                    "type": "string"
+                },
+                "repository": {
+                    "description": "Repository Репозиторий, например org/service",
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestCreateJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "author_id",
+                "changed_files",
+                "pull_request_id",
+                "pull_request_name"
+            ],
+            "properties": {
+                "author_id": {
+                    "description": "AuthorId UUID автора или его внешняя учётная запись в виде ` + "`" + `provider:external_id` + "`" + `, например ` + "`" + `github:alice` + "`" + `",
+                    "type": "string"
+                },
+                "changed_files": {
+                    "description": "ChangedFiles Изменённые файлы относительно корня репозитория, их владельцы становятся обязательными ревьюверами",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "repository": {
+                    "description": "Repository Репозиторий PR, по нему выбирается загруженный файл CODEOWNERS",
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestMergeJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "pull_request_id"
+            ],
+            "properties": {
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestReassignJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "old_reviewer_id",
+                "pull_request_id"
+            ],
+            "properties": {
+                "old_reviewer_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostTeamAddJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "members",
+                "team_name"
+            ],
+            "properties": {
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.TeamMember"
+                    }
+                },
+                "parent_team_name": {
+                    "description": "ParentTeamName Имя родительской команды, в которой ищутся ревьюверы, если в команде автора не хватает кандидатов. В /team/add null или пустая строка делают команду корневой, без поля родитель не меняется",
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostTeamDeleteJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "team_name"
+            ],
+            "properties": {
+                "cascade_reassign": {
+                    "description": "CascadeReassign Удалить команду, даже если у уходящих участников есть открытые PR: в их ревью они заменяются участниками\nкоманды автора, свои PR остаются у автора",
+                    "type": "boolean"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostTeamRebalanceJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "team_name"
+            ],
+            "properties": {
+                "dry_run": {
+                    "description": "DryRun Рассчитать план перераспределения без сохранения изменений (транзакция откатывается)",
+                    "type": "boolean"
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "tolerance": {
+                    "description": "Tolerance Допустимая разница между максимальной и минимальной нагрузкой (по умолчанию 1)",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostTeamRenameJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "new_team_name",
+                "team_name"
+            ],
+            "properties": {
+                "new_team_name": {
+                    "description": "NewTeamName Новое уникальное имя команды",
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostTeamSetIsArchivedJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "team_name"
+            ],
+            "properties": {
+                "is_archived": {
+                    "description": "IsArchived true - архивировать команду, false - вернуть из архива",
+                    "type": "boolean"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostUsersAddIdentityJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "external_id",
+                "provider",
+                "user_id"
+            ],
+            "properties": {
+                "external_id": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostUsersDeleteIdentityJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "external_id",
+                "provider",
+                "user_id"
+            ],
+            "properties": {
+                "external_id": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostUsersHandoverReviewsJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "from_user_id",
+                "to_user_id"
+            ],
+            "properties": {
+                "from_user_id": {
+                    "description": "FromUserId Пользователь, чьи открытые ревью передаются",
+                    "type": "string"
+                },
+                "to_user_id": {
+                    "description": "ToUserId Пользователь, которому передаются ревью",
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostUsersMoveTeamJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "team_name",
+                "user_id"
+            ],
+            "properties": {
+                "reassign_reviews": {
+                    "description": "ReassignReviews Передать открытые ревью пользователя на PR старой команды другим её участникам",
+                    "type": "boolean"
+                },
+                "reselect_reviewers": {
+                    "description": "ReselectReviewers Переподобрать ревьюверов открытых PR пользователя из новой команды",
+                    "type": "boolean"
+                },
+                "team_name": {
+                    "description": "TeamName Команда, в которую переводится пользователь",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostUsersSetIsActiveJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "is_active": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostUsersSetUnavailableJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "unavailable_until": {
+                    "description": "UnavailableUntil До какого момента пользователь недоступен, должен быть в будущем. Без поля пользователь снова доступен",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostUsersSnoozeReviewJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "pull_request_id",
+                "until",
+                "user_id"
+            ],
+            "properties": {
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "until": {
+                    "description": "Until До какого момента не напоминать о PR, должен быть в будущем",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "UserId Ревьювер, откладывающий напоминания",
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostWebhooksReplayDeliveryJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "delivery_id"
+            ],
+            "properties": {
+                "delivery_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostWebhooksSubscribeJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "events",
+                "secret",
+                "url"
+            ],
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.SubscribeWebhookRequestEvents"
+                    }
+                },
+                "secret": {
+                    "description": "Secret Секрет для подписи тела события (HMAC-SHA256, заголовок X-Reviewers-Signature-256)",
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 16
+                },
+                "url": {
+                    "description": "Url Адрес, на который отправляются события",
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PostWebhooksUnsubscribeJSONRequestBody": {
+            "type": "object",
+            "required": [
+                "subscription_id"
+            ],
+            "properties": {
+                "subscription_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PullRequest": {
+            "type": "object",
+            "required": [
+                "assigned_reviewers",
+                "author_id",
+                "pull_request_id",
+                "pull_request_name",
+                "status"
+            ],
+            "properties": {
+                "assigned_reviewers": {
+                    "description": "AssignedReviewers user_id назначенных ревьюверов (0..2)",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "author_id": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "merged_at": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequestStatus"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PullRequestShort": {
+            "type": "object",
+            "required": [
+                "author_id",
+                "pull_request_id",
+                "pull_request_name",
+                "status"
+            ],
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequestShortStatus"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PullRequestShortStatus": {
+            "type": "string",
+            "enum": [
+                "CLOSED",
+                "MERGED",
+                "OPEN"
+            ],
+            "x-enum-varnames": [
+                "CLOSED",
+                "MERGED",
+                "OPEN"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.PullRequestStatus": {
+            "type": "string",
+            "enum": [
+                "CLOSED",
+                "MERGED",
+                "OPEN"
+            ],
+            "x-enum-varnames": [
+                "PullRequestStatusCLOSED",
+                "PullRequestStatusMERGED",
+                "PullRequestStatusOPEN"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ReassignPullRequestResponse": {
+            "type": "object",
+            "properties": {
+                "pr": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequest"
+                },
+                "replaced_by": {
+                    "description": "ReplacedBy user_id нового ревьювера",
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.RebalanceMemberWorkload": {
+            "type": "object",
+            "properties": {
+                "open_reviews_after": {
+                    "description": "OpenReviewsAfter Количество открытых PR на ревью после перераспределения",
+                    "type": "integer"
+                },
+                "open_reviews_before": {
+                    "description": "OpenReviewsBefore Количество открытых PR на ревью до перераспределения",
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.RebalanceReassignment": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "string"
+                },
+                "new_reviewer_id": {
+                    "type": "string"
+                },
+                "old_reviewer_id": {
+                    "type": "string"
+                },
+                "pull_request_id": {
+                    "type": "string"
+                },
+                "pull_request_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.RebalanceTeamResponse": {
+            "type": "object",
+            "properties": {
+                "balanced": {
+                    "description": "Balanced true, если разница нагрузки укладывается в tolerance",
+                    "type": "boolean"
+                },
+                "dry_run": {
+                    "description": "DryRun true, если изменения не были сохранены",
+                    "type": "boolean"
+                },
+                "reassignments": {
+                    "description": "Reassignments Выполненные переназначения ревьюверов в порядке применения",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.RebalanceReassignment"
+                    }
+                },
+                "team_name": {
+                    "type": "string"
+                },
+                "tolerance": {
+                    "type": "integer"
+                },
+                "workload": {
+                    "description": "Workload Нагрузка активных участников команды до и после перераспределения",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.RebalanceMemberWorkload"
+                    }
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.RenameTeamResponse": {
+            "type": "object",
+            "properties": {
+                "previous_team_name": {
+                    "type": "string"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ReplayWebhookDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "delivery": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.WebhookDelivery"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ReviewStreamEvent": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ReviewStreamEventEvent"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "occurred_at": {
+                    "type": "string"
+                },
+                "pull_request": {
+                    "type": "object",
+                    "properties": {
+                        "author_id": {
+                            "type": "string"
+                        },
+                        "pull_request_id": {
+                            "type": "string"
+                        },
+                        "pull_request_name": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "reviewer_id": {
+                    "description": "ReviewerId Ревьювер, для назначения и снятия с PR",
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ReviewStreamEventEvent": {
+            "type": "string",
+            "enum": [
+                "pull_request.closed",
+                "pull_request.merged",
+                "reviewer.assigned",
+                "reviewer.unassigned"
+            ],
+            "x-enum-varnames": [
+                "ReviewStreamEventEventPullRequestClosed",
+                "ReviewStreamEventEventPullRequestMerged",
+                "ReviewStreamEventEventReviewerAssigned",
+                "ReviewStreamEventEventReviewerUnassigned"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ReviewerAssignment": {
             "type": "object",
             "properties": {
-                "pull_requests": {
+                "owner": {
+                    "description": "Owner Владелец из правила, как он записан в файле, например @alice или @org/backend",
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                },
+                "rule_line": {
+                    "description": "RuleLine Номер строки правила CODEOWNERS, только для source CODEOWNERS",
+                    "type": "integer"
+                },
+                "rule_pattern": {
+                    "description": "RulePattern Шаблон путей правила CODEOWNERS",
+                    "type": "string"
+                },
+                "source": {
+                    "description": "Source CODEOWNERS - владелец изменённых файлов, TEAM - команда автора, PARENT_TEAM - родительская команда",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ReviewerAssignmentSource"
+                        }
+                    ]
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ReviewerAssignmentCount": {
+            "type": "object",
+            "properties": {
+                "assignment_count": {
+                    "description": "AssignmentCount Количество PR, где пользователь был назначен ревьювером",
+                    "type": "integer"
+                },
+                "reviewer_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ReviewerAssignmentSource": {
+            "type": "string",
+            "enum": [
+                "CODEOWNERS",
+                "PARENT_TEAM",
+                "TEAM"
+            ],
+            "x-enum-varnames": [
+                "CODEOWNERS",
+                "PARENTTEAM",
+                "TEAM"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ReviewersStatsResponse": {
+            "type": "object",
+            "properties": {
+                "reviewers": {
+                    "description": "Reviewers Список ревьюверов с количеством назначений",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequestShort"
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ReviewerAssignmentCount"
+                    }
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.RoutePolicy": {
+            "type": "object",
+            "properties": {
+                "roles": {
+                    "description": "Roles Роли (ADMIN, USER), которым разрешён вызов",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
                 },
-                "user_id": {
+                "route": {
+                    "description": "Route REST ручка в виде \"METHOD /path\" или полное имя gRPC метода",
                     "type": "string"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.MergePullRequestResponse": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimError": {
             "type": "object",
             "properties": {
-                "pr": {
-                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequest"
+                "detail": {
+                    "type": "string"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scimType": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimErrorScimType"
+                },
+                "status": {
+                    "description": "Status HTTP статус строкой",
+                    "type": "string"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.PatchTeamDeactivateUsersJSONRequestBody": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimErrorScimType": {
+            "type": "string",
+            "enum": [
+                "invalidFilter",
+                "invalidPath",
+                "invalidSyntax",
+                "invalidValue",
+                "mutability",
+                "noTarget",
+                "uniqueness"
+            ],
+            "x-enum-varnames": [
+                "InvalidFilter",
+                "InvalidPath",
+                "InvalidSyntax",
+                "InvalidValue",
+                "Mutability",
+                "NoTarget",
+                "Uniqueness"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimGroup": {
             "type": "object",
             "required": [
-                "team_name",
-                "user_ids"
+                "displayName"
             ],
             "properties": {
-                "team_name": {
+                "displayName": {
                     "type": "string"
                 },
-                "user_ids": {
-                    "description": "UserIds Список ID пользователей для деактивации",
+                "id": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimMember"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimMeta"
+                },
+                "schemas": {
                     "type": "array",
-                    "minItems": 1,
                     "items": {
                         "type": "string"
                     }
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestCreateJSONRequestBody": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimGroupListResponse": {
+            "type": "object",
+            "properties": {
+                "Resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimGroup"
+                    }
+                },
+                "itemsPerPage": {
+                    "type": "integer"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startIndex": {
+                    "type": "integer"
+                },
+                "totalResults": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimGroupRef": {
+            "type": "object",
+            "properties": {
+                "display": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimGroupRefType"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimGroupRefType": {
+            "type": "string",
+            "enum": [
+                "direct"
+            ],
+            "x-enum-varnames": [
+                "Direct"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimMember": {
+            "type": "object",
+            "properties": {
+                "display": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimMeta": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "resourceType": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimMetaResourceType"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimMetaResourceType": {
+            "type": "string",
+            "enum": [
+                "Group",
+                "User"
+            ],
+            "x-enum-varnames": [
+                "ScimMetaResourceTypeGroup",
+                "ScimMetaResourceTypeUser"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimPatchOperation": {
+            "type": "object",
+            "properties": {
+                "op": {
+                    "description": "Op add, remove или replace без учёта регистра",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path active, userName, externalId, displayName, members или members[value eq \"\u003cid\u003e\"]",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value Значение атрибута, без path - объект с атрибутами"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimPatchRequest": {
+            "type": "object",
+            "properties": {
+                "Operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimPatchOperation"
+                    }
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimUser": {
             "type": "object",
             "required": [
-                "author_id",
-                "pull_request_id",
-                "pull_request_name"
+                "userName"
             ],
             "properties": {
-                "author_id": {
-                    "type": "string"
-                },
-                "pull_request_id": {
+                "active": {
+                    "description": "Active По умолчанию true",
+                    "type": "boolean"
+                },
+                "externalId": {
+                    "description": "ExternalId Хранится как учётная запись провайдера scim",
+                    "type": "string"
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimGroupRef"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "meta": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimMeta"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.ScimUserListResponse": {
+            "type": "object",
+            "properties": {
+                "Resources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ScimUser"
+                    }
+                },
+                "itemsPerPage": {
+                    "type": "integer"
+                },
+                "schemas": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startIndex": {
+                    "type": "integer"
+                },
+                "totalResults": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.SetTeamIsArchivedResponse": {
+            "type": "object",
+            "properties": {
+                "archived_at": {
+                    "type": "string"
+                },
+                "is_archived": {
+                    "type": "boolean"
+                },
+                "team_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.SetUserActiveStatusResponse": {
+            "type": "object",
+            "properties": {
+                "user": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.User"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.SetUserUnavailableResponse": {
+            "type": "object",
+            "properties": {
+                "unavailable_until": {
                     "type": "string"
                 },
-                "pull_request_name": {
-                    "type": "string"
+                "user": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.User"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestMergeJSONRequestBody": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.SnoozeReviewResponse": {
             "type": "object",
-            "required": [
-                "pull_request_id"
-            ],
             "properties": {
                 "pull_request_id": {
                     "type": "string"
+                },
+                "snoozed_until": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.PostPullRequestReassignJSONRequestBody": {
-            "type": "object",
-            "required": [
-                "old_reviewer_id",
-                "pull_request_id"
+        "pr-reviewers-service_internal_generated_api_v1_handler.SubscribeWebhookRequestEvents": {
+            "type": "string",
+            "enum": [
+                "pull_request.created",
+                "pull_request.merged",
+                "reviewer.assigned",
+                "reviewer.unassigned"
             ],
+            "x-enum-varnames": [
+                "SubscribeWebhookRequestEventsPullRequestCreated",
+                "SubscribeWebhookRequestEventsPullRequestMerged",
+                "SubscribeWebhookRequestEventsReviewerAssigned",
+                "SubscribeWebhookRequestEventsReviewerUnassigned"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.SubscribeWebhookResponse": {
+            "type": "object",
             "properties": {
-                "old_reviewer_id": {
-                    "type": "string"
-                },
-                "pull_request_id": {
-                    "type": "string"
+                "subscription": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.WebhookSubscription"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.PostTeamAddJSONRequestBody": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.Team": {
             "type": "object",
             "required": [
                 "members",
@@ -744,208 +4720,296 @@ const docTemplate = `{
                         "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.TeamMember"
                     }
                 },
+                "parent_team_name": {
+                    "description": "ParentTeamName Имя родительской команды, в которой ищутся ревьюверы, если в команде автора не хватает кандидатов. В /team/add null или пустая строка делают команду корневой, без поля родитель не меняется",
+                    "type": "string"
+                },
                 "team_name": {
                     "type": "string"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.PostUsersSetIsActiveJSONRequestBody": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.TeamListItem": {
             "type": "object",
-            "required": [
-                "user_id"
-            ],
             "properties": {
-                "is_active": {
-                    "type": "boolean"
+                "active_members_count": {
+                    "type": "integer"
                 },
-                "user_id": {
+                "avg_open_reviews_per_active_member": {
+                    "description": "AvgOpenReviewsPerActiveMember Среднее число открытых ревью на активного участника",
+                    "type": "number"
+                },
+                "members_count": {
+                    "description": "MembersCount Участники команды, включая дополнительных",
+                    "type": "integer"
+                },
+                "open_pull_requests_count": {
+                    "description": "OpenPullRequestsCount Открытые PR авторов, для которых команда основная",
+                    "type": "integer"
+                },
+                "team_name": {
                     "type": "string"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.PullRequest": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.TeamMember": {
             "type": "object",
             "required": [
-                "assigned_reviewers",
-                "author_id",
-                "pull_request_id",
-                "pull_request_name",
-                "status"
+                "user_id",
+                "username"
             ],
             "properties": {
-                "assigned_reviewers": {
-                    "description": "AssignedReviewers user_id назначенных ревьюверов (0..2)",
+                "identities": {
+                    "description": "Identities Учётные записи пользователя во внешних системах, уже привязанные не удаляются",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.UserIdentity"
                     }
                 },
-                "author_id": {
-                    "type": "string"
+                "is_active": {
+                    "type": "boolean"
                 },
-                "created_at": {
+                "user_id": {
                     "type": "string"
                 },
-                "merged_at": {
+                "username": {
                     "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.TeamTreeNode": {
+            "type": "object",
+            "properties": {
+                "subteams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.TeamTreeNode"
+                    }
                 },
-                "pull_request_id": {
+                "team_name": {
                     "type": "string"
-                },
-                "pull_request_name": {
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.TeamTreeResponse": {
+            "type": "object",
+            "properties": {
+                "teams": {
+                    "description": "Teams Корневые команды со всеми вложенными подкомандами",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.TeamTreeNode"
+                    }
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.UnsubscribeWebhookResponse": {
+            "type": "object",
+            "properties": {
+                "subscription_id": {
                     "type": "string"
-                },
-                "status": {
-                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequestStatus"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.PullRequestShort": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.User": {
             "type": "object",
             "required": [
-                "author_id",
-                "pull_request_id",
-                "pull_request_name",
-                "status"
+                "team_name",
+                "user_id",
+                "username"
             ],
             "properties": {
-                "author_id": {
-                    "type": "string"
+                "is_active": {
+                    "type": "boolean"
                 },
-                "pull_request_id": {
+                "team_name": {
                     "type": "string"
                 },
-                "pull_request_name": {
+                "user_id": {
                     "type": "string"
                 },
-                "status": {
-                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequestShortStatus"
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.PullRequestShortStatus": {
-            "type": "string",
-            "enum": [
-                "MERGED",
-                "OPEN"
-            ],
-            "x-enum-varnames": [
-                "PullRequestShortStatusMERGED",
-                "PullRequestShortStatusOPEN"
-            ]
-        },
-        "pr-reviewers-service_internal_generated_api_v1_handler.PullRequestStatus": {
-            "type": "string",
-            "enum": [
-                "MERGED",
-                "OPEN"
-            ],
-            "x-enum-varnames": [
-                "PullRequestStatusMERGED",
-                "PullRequestStatusOPEN"
-            ]
-        },
-        "pr-reviewers-service_internal_generated_api_v1_handler.ReassignPullRequestResponse": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.UserIdentitiesResponse": {
             "type": "object",
             "properties": {
-                "pr": {
-                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.PullRequest"
+                "identities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.UserIdentity"
+                    }
                 },
-                "replaced_by": {
-                    "description": "ReplacedBy user_id нового ревьювера",
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.ReviewerAssignmentCount": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.UserIdentity": {
             "type": "object",
+            "required": [
+                "external_id",
+                "provider"
+            ],
             "properties": {
-                "assignment_count": {
-                    "description": "AssignmentCount Количество PR, где пользователь был назначен ревьювером",
-                    "type": "integer"
+                "external_id": {
+                    "description": "ExternalId Логин или email пользователя во внешней системе",
+                    "type": "string"
                 },
-                "reviewer_id": {
+                "provider": {
+                    "description": "Provider Внешняя система, одна из github, gitlab, email",
                     "type": "string"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.ReviewersStatsResponse": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.UserTeamMembership": {
             "type": "object",
             "properties": {
-                "reviewers": {
-                    "description": "Reviewers Список ревьюверов с количеством назначений",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.ReviewerAssignmentCount"
-                    }
+                "is_primary": {
+                    "type": "boolean"
+                },
+                "team_name": {
+                    "type": "string"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.SetUserActiveStatusResponse": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.UserWorkload": {
             "type": "object",
             "properties": {
-                "user": {
-                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.User"
+                "authored_open_pull_requests": {
+                    "description": "AuthoredOpenPullRequests Количество открытых PR пользователя",
+                    "type": "integer"
+                },
+                "completed_reviews_last_30_days": {
+                    "description": "CompletedReviewsLast30Days Количество PR с ревью пользователя, смёрженных за последние 30 дней",
+                    "type": "integer"
+                },
+                "open_reviews": {
+                    "description": "OpenReviews Количество открытых PR, на которые пользователь назначен ревьюером",
+                    "type": "integer"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.Team": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.WebhookDeliveriesResponse": {
             "type": "object",
-            "required": [
-                "members",
-                "team_name"
-            ],
             "properties": {
-                "members": {
+                "deliveries": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.TeamMember"
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.WebhookDelivery"
                     }
                 },
-                "team_name": {
-                    "type": "string"
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.TeamMember": {
+        "pr-reviewers-service_internal_generated_api_v1_handler.WebhookDelivery": {
             "type": "object",
-            "required": [
-                "user_id",
-                "username"
-            ],
             "properties": {
-                "is_active": {
-                    "type": "boolean"
+                "attempts": {
+                    "type": "integer"
                 },
-                "user_id": {
+                "created_at": {
                     "type": "string"
                 },
-                "username": {
+                "delivery_id": {
+                    "description": "DeliveryId Передаётся подписчику в заголовке X-Reviewers-Delivery",
+                    "type": "string"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "description": "LastError Ошибка последней неудачной попытки",
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "response_code": {
+                    "description": "ResponseCode HTTP код последнего ответа подписчика",
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.WebhookDeliveryStatus"
+                },
+                "subscription_id": {
+                    "type": "string"
+                },
+                "updated_at": {
                     "type": "string"
                 }
             }
         },
-        "pr-reviewers-service_internal_generated_api_v1_handler.User": {
-            "type": "object",
-            "required": [
-                "team_name",
-                "user_id",
-                "username"
+        "pr-reviewers-service_internal_generated_api_v1_handler.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "DELIVERED",
+                "FAILED",
+                "PENDING"
             ],
+            "x-enum-varnames": [
+                "WebhookDeliveryStatusDELIVERED",
+                "WebhookDeliveryStatusFAILED",
+                "WebhookDeliveryStatusPENDING"
+            ]
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.WebhookResponse": {
+            "type": "object",
             "properties": {
-                "is_active": {
-                    "type": "boolean"
+                "pull_request_id": {
+                    "description": "PullRequestId Идентификатор PR, выведенный из его ссылки",
+                    "type": "string"
                 },
-                "team_name": {
+                "reason": {
+                    "description": "Reason Почему событие не обработано",
                     "type": "string"
                 },
-                "user_id": {
+                "result": {
+                    "description": "Result processed, duplicate или ignored",
+                    "type": "string"
+                }
+            }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.WebhookSubscription": {
+            "type": "object",
+            "properties": {
+                "created_at": {
                     "type": "string"
                 },
-                "username": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "subscription_id": {
+                    "type": "string"
+                },
+                "url": {
                     "type": "string"
                 }
             }
+        },
+        "pr-reviewers-service_internal_generated_api_v1_handler.WebhookSubscriptionsResponse": {
+            "type": "object",
+            "properties": {
+                "subscriptions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pr-reviewers-service_internal_generated_api_v1_handler.WebhookSubscription"
+                    }
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -7,8 +7,13 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"sync"
 
 	"pr-reviewers-service/internal/config"
+	"pr-reviewers-service/internal/handler/middleware"
+	"pr-reviewers-service/internal/infrastructure/event_bus"
+	"pr-reviewers-service/internal/infrastructure/worker"
+	"pr-reviewers-service/internal/usecase/team_apply"
 
 	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 	"github.com/go-playground/validator/v10"
@@ -17,6 +22,10 @@ import (
 	"google.golang.org/grpc"
 )
 
+type teamApplier interface {
+	Run(ctx context.Context, req team_apply.In) (*team_apply.Out, error)
+}
+
 type App struct {
 	restServer *http.Server
 	grpcServer *grpc.Server
@@ -24,6 +33,13 @@ type App struct {
 	validator  *validator.Validate
 	pool       *pgxpool.Pool
 	trManager  *manager.Manager
+	reviewBus  *event_bus.Bus
+	teamApply  teamApplier
+	policy     middleware.Policy
+
+	workers       []*worker.Periodic
+	workersCancel context.CancelFunc
+	workersWG     sync.WaitGroup
 }
 
 func NewApp(ctx context.Context, cfg config.Config) (*App, error) {
@@ -63,6 +79,22 @@ func (a *App) Stop(ctx context.Context) error {
 		errs = append(errs, fmt.Errorf("rest server shutdown: %w", err))
 	}
 
+	if a.workersCancel != nil {
+		a.workersCancel()
+		stopped := make(chan struct{})
+
+		go func() {
+			defer close(stopped)
+			a.workersWG.Wait()
+		}()
+
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			errs = append(errs, errors.New("workers shutdown timeout"))
+		}
+	}
+
 	if a.pool != nil {
 		a.pool.Close()
 	}
@@ -79,11 +111,31 @@ func (a *App) RestRun() error {
 	return a.restServer.Serve(listener)
 }
 
-//func (a *App) GrpcRun() error {
-//	listener, err := net.Listen("tcp", a.config.Server.GRPC.Address)
-//	if err != nil {
-//		return err
-//	}
-//	slog.Info("grpc starting on:" + a.config.Server.GRPC.Address)
-//	return a.grpcServer.Serve(listener)
-//}
+// WorkersRun starts the background jobs, they are stopped by Stop.
+func (a *App) WorkersRun() {
+	ctx, cancel := context.WithCancel(context.Background())
+	a.workersCancel = cancel
+
+	for _, w := range a.workers {
+		a.workersWG.Add(1)
+		go func(w *worker.Periodic) {
+			defer a.workersWG.Done()
+			w.Run(ctx)
+		}(w)
+	}
+	slog.Info("workers started", "count", len(a.workers))
+}
+
+func (a *App) GrpcRun() error {
+	listener, err := net.Listen("tcp", a.config.Server.GRPC.Address)
+	if err != nil {
+		return err
+	}
+	slog.Info("grpc starting on:" + a.config.Server.GRPC.Address)
+	return a.grpcServer.Serve(listener)
+}
+
+// ApplyTeams applies a declarative teams configuration the same way POST /admin/teams/apply does.
+func (a *App) ApplyTeams(ctx context.Context, req team_apply.In) (*team_apply.Out, error) {
+	return a.teamApply.Run(ctx, req)
+}
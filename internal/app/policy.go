@@ -0,0 +1,81 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	pr_reviewers_v1 "pr-reviewers-service/internal/generated/proto/pr_reviewers/v1"
+	"pr-reviewers-service/internal/handler/middleware"
+)
+
+var (
+	adminRoleOnly = []middleware.UserRole{middleware.Admin}
+	allRoles      = []middleware.UserRole{middleware.Admin, middleware.User}
+)
+
+// defaultPolicy lists every route that needs a token. Reads are open to all roles, while team mutation, user
+// deactivation and administration are admin-only. Routes where USER acts on their own reviews and pull requests
+// are open to all roles, the handlers check the ownership.
+var defaultPolicy = middleware.Policy{
+	"POST /api/v1/team/add":              adminRoleOnly,
+	"GET /api/v1/team/get":               allRoles,
+	"GET /api/v1/team/tree":              allRoles,
+	"GET /api/v1/team/list":              allRoles,
+	"PATCH /api/v1/team/activateUsers":   adminRoleOnly,
+	"PATCH /api/v1/team/deactivateUsers": adminRoleOnly,
+	"POST /api/v1/team/rebalance":        adminRoleOnly,
+	"POST /api/v1/team/rename":           adminRoleOnly,
+	"POST /api/v1/team/setIsArchived":    adminRoleOnly,
+	"POST /api/v1/team/delete":           adminRoleOnly,
+
+	"POST /api/v1/users/setIsActive":     adminRoleOnly,
+	"POST /api/v1/users/setUnavailable":  allRoles,
+	"GET /api/v1/users/get":              allRoles,
+	"GET /api/v1/users/getReview":        allRoles,
+	"POST /api/v1/users/handoverReviews": allRoles,
+	"GET /api/v1/users/reviewStream":     allRoles,
+	"POST /api/v1/users/snoozeReview":    allRoles,
+	"POST /api/v1/users/moveTeam":        adminRoleOnly,
+	"POST /api/v1/users/addIdentity":     adminRoleOnly,
+	"POST /api/v1/users/deleteIdentity":  adminRoleOnly,
+	"GET /api/v1/users/getIdentities":    allRoles,
+	"GET /api/v1/users/findByIdentity":   allRoles,
+
+	"POST /api/v1/pullRequest/create":   allRoles,
+	"POST /api/v1/pullRequest/merge":    allRoles,
+	"POST /api/v1/pullRequest/reassign": allRoles,
+
+	"POST /api/v1/codeOwners/upload": adminRoleOnly,
+	"GET /api/v1/codeOwners/get":     allRoles,
+
+	"GET /api/v1/statistics/reviewers": allRoles,
+
+	"POST /api/v1/webhooks/subscribe":      adminRoleOnly,
+	"POST /api/v1/webhooks/unsubscribe":    adminRoleOnly,
+	"GET /api/v1/webhooks/list":            adminRoleOnly,
+	"GET /api/v1/webhooks/deliveries":      adminRoleOnly,
+	"POST /api/v1/webhooks/replayDelivery": adminRoleOnly,
+
+	"POST /api/v1/admin/teams/apply": adminRoleOnly,
+	"GET /api/v1/admin/policies":     adminRoleOnly,
+
+	pr_reviewers_v1.PRReviewersService_AddTeam_FullMethodName:             adminRoleOnly,
+	pr_reviewers_v1.PRReviewersService_GetTeam_FullMethodName:             allRoles,
+	pr_reviewers_v1.PRReviewersService_ListTeams_FullMethodName:           allRoles,
+	pr_reviewers_v1.PRReviewersService_SetUserIsActive_FullMethodName:     adminRoleOnly,
+	pr_reviewers_v1.PRReviewersService_GetUser_FullMethodName:             allRoles,
+	pr_reviewers_v1.PRReviewersService_GetUserReviews_FullMethodName:      allRoles,
+	pr_reviewers_v1.PRReviewersService_CreatePullRequest_FullMethodName:   allRoles,
+	pr_reviewers_v1.PRReviewersService_MergePullRequest_FullMethodName:    allRoles,
+	pr_reviewers_v1.PRReviewersService_ReassignPullRequest_FullMethodName: allRoles,
+	pr_reviewers_v1.PRReviewersService_GetReviewerStats_FullMethodName:    allRoles,
+}
+
+func (a *App) setupPolicy(_ context.Context) error {
+	policy, err := defaultPolicy.Override(a.config.App.Policies)
+	if err != nil {
+		return fmt.Errorf("failed to load policies: %w", err)
+	}
+	a.policy = policy
+	return nil
+}
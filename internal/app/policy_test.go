@@ -0,0 +1,183 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/config"
+	pr_reviewers_v1 "pr-reviewers-service/internal/generated/proto/pr_reviewers/v1"
+	"pr-reviewers-service/internal/handler/middleware"
+	"pr-reviewers-service/internal/jwt"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "secret"
+
+// newTestRouter builds the REST router of the app without a database, requests reaching a handler that needs one
+// panic, which is enough to tell they passed authorisation.
+func newTestRouter(t *testing.T, overrides map[string][]string) (*mux.Router, middleware.Policy) {
+	a := &App{config: config.Config{App: config.AppConfig{
+		AuthorisationNeeded: true,
+		JWTSecret:           testSecret,
+		Policies:            overrides,
+	}}}
+	ctx := context.Background()
+	require.NoError(t, a.setupValidator(ctx))
+	require.NoError(t, a.setupPolicy(ctx))
+	require.NoError(t, a.setupReviewBus(ctx))
+	require.NoError(t, a.setupRestServer(ctx))
+
+	return a.restServer.Handler.(*mux.Router), a.policy
+}
+
+// serve returns the status of the request, or 0 when the handler panicked after authorisation let it through.
+func serve(router http.Handler, method, path, role string) (code int) {
+	req := httptest.NewRequest(method, path, nil)
+	if role != "" {
+		token, _ := jwt.GenerateToken(testSecret, role, uuid.New(), time.Hour)
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+
+	defer func() {
+		if recover() != nil {
+			code = 0
+		}
+	}()
+	router.ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	admin := []middleware.UserRole{middleware.Admin}
+	all := []middleware.UserRole{middleware.Admin, middleware.User}
+
+	want := map[string][]middleware.UserRole{
+		"POST /api/v1/team/add":                admin,
+		"GET /api/v1/team/get":                 all,
+		"GET /api/v1/team/tree":                all,
+		"GET /api/v1/team/list":                all,
+		"PATCH /api/v1/team/activateUsers":     admin,
+		"PATCH /api/v1/team/deactivateUsers":   admin,
+		"POST /api/v1/team/rebalance":          admin,
+		"POST /api/v1/team/rename":             admin,
+		"POST /api/v1/team/setIsArchived":      admin,
+		"POST /api/v1/team/delete":             admin,
+		"POST /api/v1/users/setIsActive":       admin,
+		"POST /api/v1/users/setUnavailable":    all,
+		"GET /api/v1/users/get":                all,
+		"GET /api/v1/users/getReview":          all,
+		"POST /api/v1/users/handoverReviews":   all,
+		"GET /api/v1/users/reviewStream":       all,
+		"POST /api/v1/users/snoozeReview":      all,
+		"POST /api/v1/users/moveTeam":          admin,
+		"POST /api/v1/users/addIdentity":       admin,
+		"POST /api/v1/users/deleteIdentity":    admin,
+		"GET /api/v1/users/getIdentities":      all,
+		"GET /api/v1/users/findByIdentity":     all,
+		"POST /api/v1/pullRequest/create":      all,
+		"POST /api/v1/pullRequest/merge":       all,
+		"POST /api/v1/pullRequest/reassign":    all,
+		"POST /api/v1/codeOwners/upload":       admin,
+		"GET /api/v1/codeOwners/get":           all,
+		"GET /api/v1/statistics/reviewers":     all,
+		"POST /api/v1/webhooks/subscribe":      admin,
+		"POST /api/v1/webhooks/unsubscribe":    admin,
+		"GET /api/v1/webhooks/list":            admin,
+		"GET /api/v1/webhooks/deliveries":      admin,
+		"POST /api/v1/webhooks/replayDelivery": admin,
+		"POST /api/v1/admin/teams/apply":       admin,
+		"GET /api/v1/admin/policies":           admin,
+
+		pr_reviewers_v1.PRReviewersService_AddTeam_FullMethodName:             admin,
+		pr_reviewers_v1.PRReviewersService_GetTeam_FullMethodName:             all,
+		pr_reviewers_v1.PRReviewersService_ListTeams_FullMethodName:           all,
+		pr_reviewers_v1.PRReviewersService_SetUserIsActive_FullMethodName:     admin,
+		pr_reviewers_v1.PRReviewersService_GetUser_FullMethodName:             all,
+		pr_reviewers_v1.PRReviewersService_GetUserReviews_FullMethodName:      all,
+		pr_reviewers_v1.PRReviewersService_CreatePullRequest_FullMethodName:   all,
+		pr_reviewers_v1.PRReviewersService_MergePullRequest_FullMethodName:    all,
+		pr_reviewers_v1.PRReviewersService_ReassignPullRequest_FullMethodName: all,
+		pr_reviewers_v1.PRReviewersService_GetReviewerStats_FullMethodName:    all,
+	}
+	assert.Equal(t, middleware.Policy(want), defaultPolicy)
+
+	t.Run("every grpc method has a policy", func(t *testing.T) {
+		desc := pr_reviewers_v1.PRReviewersService_ServiceDesc
+		for _, method := range desc.Methods {
+			assert.Contains(t, defaultPolicy, "/"+desc.ServiceName+"/"+method.MethodName)
+		}
+	})
+}
+
+func TestRoutePolicy(t *testing.T) {
+	router, policy := newTestRouter(t, nil)
+
+	var registered []string
+	require.NoError(t, router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		methods, _ := route.GetMethods()
+		for _, method := range methods {
+			if _, ok := policy[middleware.RouteKey(method, template)]; ok {
+				registered = append(registered, middleware.RouteKey(method, template))
+			}
+		}
+		return nil
+	}))
+
+	var restRoutes []string
+	for _, route := range policy.Routes() {
+		if !strings.HasPrefix(route, "/") {
+			restRoutes = append(restRoutes, route)
+		}
+	}
+	sort.Strings(registered)
+	assert.Equal(t, restRoutes, registered, "every REST route of the policy must be registered")
+
+	for _, route := range restRoutes {
+		t.Run(route, func(t *testing.T) {
+			method, path, _ := strings.Cut(route, " ")
+			assert.Equal(t, http.StatusUnauthorized, serve(router, method, path, ""))
+			for _, role := range []middleware.UserRole{middleware.Admin, middleware.User} {
+				code := serve(router, method, path, string(role))
+				if slices.Contains(policy[route], role) {
+					assert.NotContains(t, []int{http.StatusUnauthorized, http.StatusForbidden}, code,
+						"%s must be allowed", role)
+				} else {
+					assert.Equal(t, http.StatusForbidden, code, "%s must be denied", role)
+				}
+			}
+		})
+	}
+}
+
+func TestRoutePolicyOverrides(t *testing.T) {
+	router, policy := newTestRouter(t, map[string][]string{
+		"GET /api/v1/team/get":  {"ADMIN"},
+		"POST /api/v1/team/add": {"USER"},
+	})
+
+	assert.Equal(t, []middleware.UserRole{middleware.Admin}, policy["GET /api/v1/team/get"])
+	assert.Equal(t, http.StatusForbidden, serve(router, "GET", "/api/v1/team/get", string(middleware.User)))
+	assert.Equal(t, http.StatusForbidden, serve(router, "POST", "/api/v1/team/add", string(middleware.Admin)))
+
+	t.Run("unknown route", func(t *testing.T) {
+		a := &App{config: config.Config{App: config.AppConfig{
+			Policies: map[string][]string{"GET /api/v1/team/unknown": {"ADMIN"}},
+		}}}
+		assert.ErrorIs(t, a.setupPolicy(context.Background()), middleware.ErrUnknownRoute)
+	})
+}
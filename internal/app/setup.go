@@ -10,38 +10,126 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
+	"time"
 
 	_ "pr-reviewers-service/docs/rest"
+	pr_reviewers_v1 "pr-reviewers-service/internal/generated/proto/pr_reviewers/v1"
+	"pr-reviewers-service/internal/grpc/server"
 	add_team2 "pr-reviewers-service/internal/handler/add_team"
+	code_owners_upload2 "pr-reviewers-service/internal/handler/code_owners_upload"
 	"pr-reviewers-service/internal/handler/dummy_login"
+	find_user_by_identity2 "pr-reviewers-service/internal/handler/find_user_by_identity"
+	get_code_owners2 "pr-reviewers-service/internal/handler/get_code_owners"
+	get_policies2 "pr-reviewers-service/internal/handler/get_policies"
 	get_review2 "pr-reviewers-service/internal/handler/get_review"
 	get_team2 "pr-reviewers-service/internal/handler/get_team"
+	get_team_list2 "pr-reviewers-service/internal/handler/get_team_list"
+	get_team_tree2 "pr-reviewers-service/internal/handler/get_team_tree"
+	get_user2 "pr-reviewers-service/internal/handler/get_user"
+	get_user_identities2 "pr-reviewers-service/internal/handler/get_user_identities"
+	get_webhook_deliveries2 "pr-reviewers-service/internal/handler/get_webhook_deliveries"
+	get_webhook_subscriptions2 "pr-reviewers-service/internal/handler/get_webhook_subscriptions"
+	github_webhook "pr-reviewers-service/internal/handler/github_webhook"
+	gitlab_webhook "pr-reviewers-service/internal/handler/gitlab_webhook"
+	handover_reviews2 "pr-reviewers-service/internal/handler/handover_reviews"
 	"pr-reviewers-service/internal/handler/health"
 	"pr-reviewers-service/internal/handler/middleware"
 	pull_request_create2 "pr-reviewers-service/internal/handler/pull_request_create"
 	pull_request_merge2 "pr-reviewers-service/internal/handler/pull_request_merge"
 	pull_request_reassign2 "pr-reviewers-service/internal/handler/pull_request_reassign"
+	review_snooze2 "pr-reviewers-service/internal/handler/review_snooze"
+	review_stream2 "pr-reviewers-service/internal/handler/review_stream"
+	"pr-reviewers-service/internal/handler/scim"
 	set_is_active2 "pr-reviewers-service/internal/handler/set_is_active"
+	set_unavailable2 "pr-reviewers-service/internal/handler/set_unavailable"
 	stats_pr_assignments2 "pr-reviewers-service/internal/handler/stats_pr_assignments"
+	team_activate_users2 "pr-reviewers-service/internal/handler/team_activate_users"
+	team_apply2 "pr-reviewers-service/internal/handler/team_apply"
+	team_archive2 "pr-reviewers-service/internal/handler/team_archive"
 	team_deactivate_users2 "pr-reviewers-service/internal/handler/team_deactivate_users"
+	team_delete2 "pr-reviewers-service/internal/handler/team_delete"
+	team_rebalance2 "pr-reviewers-service/internal/handler/team_rebalance"
+	team_rename2 "pr-reviewers-service/internal/handler/team_rename"
+	user_add_identity2 "pr-reviewers-service/internal/handler/user_add_identity"
+	user_delete_identity2 "pr-reviewers-service/internal/handler/user_delete_identity"
+	user_move_team2 "pr-reviewers-service/internal/handler/user_move_team"
+	webhook_delivery_replay2 "pr-reviewers-service/internal/handler/webhook_delivery_replay"
+	webhook_subscribe2 "pr-reviewers-service/internal/handler/webhook_subscribe"
+	webhook_unsubscribe2 "pr-reviewers-service/internal/handler/webhook_unsubscribe"
+	"pr-reviewers-service/internal/infrastructure/chat_sender"
+	"pr-reviewers-service/internal/infrastructure/email_sender"
+	"pr-reviewers-service/internal/infrastructure/event_bus"
 	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/pg_notify"
 	randomizer2 "pr-reviewers-service/internal/infrastructure/randomizer"
+	"pr-reviewers-service/internal/infrastructure/repository/code_owners"
+	"pr-reviewers-service/internal/infrastructure/repository/outbox"
 	"pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
 	"pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
 	"pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	"pr-reviewers-service/internal/infrastructure/repository/review_snoozes"
+	"pr-reviewers-service/internal/infrastructure/repository/team_memberships"
 	"pr-reviewers-service/internal/infrastructure/repository/teams"
+	"pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	"pr-reviewers-service/internal/infrastructure/repository/user_notifications"
 	"pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/infrastructure/repository/webhook_deliveries"
+	"pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+	"pr-reviewers-service/internal/infrastructure/repository/webhook_subscriptions"
+	"pr-reviewers-service/internal/infrastructure/webhook_sender"
+	"pr-reviewers-service/internal/infrastructure/worker"
 	"pr-reviewers-service/internal/logging"
 	"pr-reviewers-service/internal/metrics"
 	"pr-reviewers-service/internal/usecase/add_team"
+	"pr-reviewers-service/internal/usecase/chat_notify"
+	"pr-reviewers-service/internal/usecase/code_owners_upload"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/email_digest"
+	"pr-reviewers-service/internal/usecase/email_notify"
+	"pr-reviewers-service/internal/usecase/email_templates"
+	"pr-reviewers-service/internal/usecase/find_user_by_identity"
+	"pr-reviewers-service/internal/usecase/get_code_owners"
 	"pr-reviewers-service/internal/usecase/get_review"
 	"pr-reviewers-service/internal/usecase/get_team"
+	"pr-reviewers-service/internal/usecase/get_team_list"
+	"pr-reviewers-service/internal/usecase/get_team_tree"
+	"pr-reviewers-service/internal/usecase/get_user"
+	"pr-reviewers-service/internal/usecase/get_user_identities"
+	"pr-reviewers-service/internal/usecase/get_webhook_deliveries"
+	"pr-reviewers-service/internal/usecase/get_webhook_subscriptions"
+	"pr-reviewers-service/internal/usecase/handover_reviews"
+	"pr-reviewers-service/internal/usecase/outbox_publish"
+	"pr-reviewers-service/internal/usecase/outbox_relay"
+	"pr-reviewers-service/internal/usecase/pull_request_close"
 	"pr-reviewers-service/internal/usecase/pull_request_create"
+	"pr-reviewers-service/internal/usecase/pull_request_event"
 	"pr-reviewers-service/internal/usecase/pull_request_merge"
 	"pr-reviewers-service/internal/usecase/pull_request_reassign"
+	"pr-reviewers-service/internal/usecase/review_snooze"
+	"pr-reviewers-service/internal/usecase/review_stream"
+	"pr-reviewers-service/internal/usecase/review_stream_publish"
+	"pr-reviewers-service/internal/usecase/scim_groups"
+	"pr-reviewers-service/internal/usecase/scim_users"
 	"pr-reviewers-service/internal/usecase/set_is_active"
+	"pr-reviewers-service/internal/usecase/set_unavailable"
+	"pr-reviewers-service/internal/usecase/stale_reminders"
 	"pr-reviewers-service/internal/usecase/stats_pr_assignments"
+	"pr-reviewers-service/internal/usecase/team_activate_users"
+	"pr-reviewers-service/internal/usecase/team_apply"
+	"pr-reviewers-service/internal/usecase/team_archive"
 	"pr-reviewers-service/internal/usecase/team_deactivate_users"
+	"pr-reviewers-service/internal/usecase/team_delete"
+	"pr-reviewers-service/internal/usecase/team_rebalance"
+	"pr-reviewers-service/internal/usecase/team_rename"
+	"pr-reviewers-service/internal/usecase/user_add_identity"
+	"pr-reviewers-service/internal/usecase/user_delete_identity"
+	"pr-reviewers-service/internal/usecase/user_move_team"
+	"pr-reviewers-service/internal/usecase/webhook_delivery_replay"
+	"pr-reviewers-service/internal/usecase/webhook_dispatch"
+	"pr-reviewers-service/internal/usecase/webhook_publish"
+	"pr-reviewers-service/internal/usecase/webhook_subscribe"
+	"pr-reviewers-service/internal/usecase/webhook_unsubscribe"
 
 	trmpgxv5 "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
 	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
@@ -52,19 +140,16 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	httpSwagger "github.com/swaggo/http-swagger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 )
 
 type ctxKey string
 
 const SkipMigrationsKey ctxKey = "skipMigrations"
 
-var (
-	// nolint:unused
-	userRoleOnly = []middleware.UserRole{middleware.User}
-	// nolint:unused
-	adminRoleOnly = []middleware.UserRole{middleware.Admin}
-	allRoles      = []middleware.UserRole{middleware.User, middleware.Admin}
-)
+// reviewStreamChannel is the Postgres NOTIFY channel that carries review stream events to every instance.
+const reviewStreamChannel = "review_stream"
 
 func (a *App) setup(ctx context.Context) error {
 	funcs := []func(context.Context) error{
@@ -72,7 +157,10 @@ func (a *App) setup(ctx context.Context) error {
 		a.setupMetrics,
 		a.setupValidator,
 		a.setupDbPoolTrManager,
+		a.setupPolicy,
+		a.setupReviewBus,
 		a.setupRestServer,
+		a.setupWorkers,
 		a.setupGrpcServer,
 		a.setupMigrationsDB,
 	}
@@ -133,6 +221,9 @@ func (a *App) setupMetrics(_ context.Context) error {
 		metrics.RestRequestsTotal,
 		metrics.RestResponseDuration,
 		metrics.RestEndpointsResponsesTotal,
+		metrics.GrpcRequestsTotal,
+		metrics.GrpcResponseDuration,
+		metrics.GrpcResponsesTotal,
 		metrics.CreatedTeams,
 		metrics.CreatedUsers,
 		metrics.CreatedPRs,
@@ -163,34 +254,119 @@ func (a *App) setupRestServer(_ context.Context) error {
 	repPrStatuses := pr_statuses.NewRepository(a.pool)
 	repPullRequests := pull_requests.NewRepository(a.pool, nower)
 	repTeams := teams.NewRepository(a.pool, nower)
+	repTeamMemberships := team_memberships.NewRepository(a.pool, nower)
 	repUsers := users.NewRepository(a.pool, nower)
+	repUserIdentities := user_identities.NewRepository(a.pool, nower)
+	repWebhookDeliveries := webhook_deliveries.NewRepository(a.pool, nower)
+	repWebhookSubscriptions := webhook_subscriptions.NewRepository(a.pool, nower)
+	repWebhookEventDeliveries := webhook_event_deliveries.NewRepository(a.pool, nower)
+	repOutbox := outbox.NewRepository(a.pool, nower)
+	repReviewSnoozes := review_snoozes.NewRepository(a.pool, nower)
+	repCodeOwners := code_owners.NewRepository(a.pool, nower)
+
+	eventsPublisher := outbox_publish.NewUsecase(repOutbox, nower)
 
 	dummy := dummy_login.New(a.config.App.JWTSecret, a.validator)
-	addTeamUseCase := add_team.Newusecase(repUsers, repTeams, a.trManager)
+	addTeamUseCase := add_team.Newusecase(repUsers, repTeams, repTeamMemberships, repUserIdentities,
+		eventsPublisher, a.trManager)
 	addTeam := add_team2.New(addTeamUseCase, a.validator)
 	getTeamUsecase := get_team.NewUsecase(repTeams, repUsers)
 	getTeam := get_team2.New(getTeamUsecase)
+	getTeamTreeUsecase := get_team_tree.NewUsecase(repTeams)
+	getTeamTree := get_team_tree2.New(getTeamTreeUsecase)
+	getTeamListUsecase := get_team_list.NewUsecase(repTeams)
+	getTeamList := get_team_list2.New(getTeamListUsecase)
 
-	setIsActiveUseCase := set_is_active.NewUsecase(repTeams, repUsers, a.trManager)
+	setIsActiveUseCase := set_is_active.NewUsecase(repTeams, repUsers, eventsPublisher, a.trManager)
 	setIsActive := set_is_active2.New(setIsActiveUseCase, a.validator)
+	setUnavailableUseCase := set_unavailable.NewUsecase(repUsers, repTeams, nower)
+	setUnavailable := set_unavailable2.New(setUnavailableUseCase, a.validator)
 	getReviewUseCase := get_review.NewUsecase(repUsers, repPullRequests, repPrReviewers, repPrStatuses)
 	getReview := get_review2.New(getReviewUseCase, a.validator)
-
-	prCreateUseCase := pull_request_create.NewUsecase(repUsers, repPullRequests, repPrReviewers,
-		repPrStatuses, randomizer, a.config.App.Validation.MaxPrReviewers, a.trManager)
+	getUserUseCase := get_user.NewUsecase(repUsers, repTeams, repTeamMemberships, repPullRequests, repPrReviewers, nower)
+	getUser := get_user2.New(getUserUseCase)
+	handoverReviewsUseCase := handover_reviews.NewUsecase(repUsers, repTeams, repPullRequests, repPrReviewers,
+		repPrStatuses, randomizer, eventsPublisher, a.trManager)
+	handoverReviews := handover_reviews2.New(handoverReviewsUseCase, a.validator)
+	moveUserTeamUseCase := user_move_team.NewUsecase(repUsers, repTeams, repTeamMemberships, repPullRequests,
+		repPrReviewers, repPrStatuses, randomizer, a.config.App.Validation.MaxPrReviewers, eventsPublisher,
+		a.trManager)
+	moveUserTeam := user_move_team2.New(moveUserTeamUseCase, a.validator)
+	reviewSnoozeUseCase := review_snooze.NewUsecase(repUsers, repPullRequests, repPrReviewers, repReviewSnoozes, nower)
+	reviewSnooze := review_snooze2.New(reviewSnoozeUseCase, a.validator)
+	reviewStreamUseCase := review_stream.NewUsecase(repUsers, a.reviewBus)
+	reviewStream := review_stream2.New(reviewStreamUseCase, a.config.App.ReviewStream.KeepAlive,
+		a.config.App.ReviewStream.WriteTimeout)
+	addUserIdentityUseCase := user_add_identity.NewUsecase(repUsers, repUserIdentities, a.trManager)
+	addUserIdentity := user_add_identity2.New(addUserIdentityUseCase, a.validator)
+	deleteUserIdentityUseCase := user_delete_identity.NewUsecase(repUserIdentities, a.trManager)
+	deleteUserIdentity := user_delete_identity2.New(deleteUserIdentityUseCase, a.validator)
+	getUserIdentitiesUseCase := get_user_identities.NewUsecase(repUsers, repUserIdentities)
+	getUserIdentities := get_user_identities2.New(getUserIdentitiesUseCase)
+	findUserByIdentityUseCase := find_user_by_identity.NewUsecase(repUsers, repTeams, repUserIdentities)
+	findUserByIdentity := find_user_by_identity2.New(findUserByIdentityUseCase)
+
+	prCreateUseCase := pull_request_create.NewUsecase(repUsers, repTeams, repPullRequests, repPrReviewers,
+		repPrStatuses, repUserIdentities, repCodeOwners, randomizer, a.config.App.Validation.MaxPrReviewers,
+		eventsPublisher, a.trManager)
 	prCreate := pull_request_create2.New(prCreateUseCase, a.validator)
-	prMergeUseCase := pull_request_merge.NewUsecase(repPullRequests, repPrReviewers, repPrStatuses, a.trManager)
+	uploadCodeOwnersUseCase := code_owners_upload.NewUsecase(repCodeOwners, repUserIdentities, repUsers, repTeams)
+	uploadCodeOwners := code_owners_upload2.New(uploadCodeOwnersUseCase, a.validator)
+	getCodeOwnersUseCase := get_code_owners.NewUsecase(repCodeOwners)
+	getCodeOwners := get_code_owners2.New(getCodeOwnersUseCase)
+	prMergeUseCase := pull_request_merge.NewUsecase(repPullRequests, repPrReviewers, repPrStatuses,
+		eventsPublisher, a.trManager)
 	prMerge := pull_request_merge2.New(prMergeUseCase, a.validator)
-	reassignUseCase := pull_request_reassign.NewUsecase(repUsers, repPullRequests, repPrReviewers,
-		repPrStatuses, randomizer, a.config.App.Validation.MaxPrReviewers, a.trManager)
+	reassignUseCase := pull_request_reassign.NewUsecase(repUsers, repTeams, repPullRequests, repPrReviewers,
+		repPrStatuses, randomizer, a.config.App.Validation.MaxPrReviewers, eventsPublisher, a.trManager)
 	reassign := pull_request_reassign2.New(reassignUseCase, a.validator)
-
-	statsPrAssignmentsUseCase := stats_pr_assignments.NewUsecase(repPrReviewers)
+	prCloseUseCase := pull_request_close.NewUsecase(repPullRequests, repPrStatuses, eventsPublisher, a.trManager)
+	prEventUseCase := pull_request_event.NewUsecase(repWebhookDeliveries, prCreateUseCase, prMergeUseCase,
+		prCloseUseCase, a.trManager)
+	githubWebhook := github_webhook.New(prEventUseCase, a.validator, a.config.App.Integrations.GitHub.WebhookSecret)
+	gitlabWebhook := gitlab_webhook.New(prEventUseCase, a.validator, a.config.App.Integrations.GitLab.WebhookToken)
+
+	subscribeWebhookUseCase := webhook_subscribe.NewUsecase(repWebhookSubscriptions)
+	subscribeWebhook := webhook_subscribe2.New(subscribeWebhookUseCase, a.validator)
+	unsubscribeWebhookUseCase := webhook_unsubscribe.NewUsecase(repWebhookSubscriptions)
+	unsubscribeWebhook := webhook_unsubscribe2.New(unsubscribeWebhookUseCase, a.validator)
+	getWebhookSubscriptionsUseCase := get_webhook_subscriptions.NewUsecase(repWebhookSubscriptions)
+	getWebhookSubscriptions := get_webhook_subscriptions2.New(getWebhookSubscriptionsUseCase)
+	getWebhookDeliveriesUseCase := get_webhook_deliveries.NewUsecase(repWebhookEventDeliveries)
+	getWebhookDeliveries := get_webhook_deliveries2.New(getWebhookDeliveriesUseCase)
+	replayWebhookDeliveryUseCase := webhook_delivery_replay.NewUsecase(repWebhookEventDeliveries, nower, a.trManager)
+	replayWebhookDelivery := webhook_delivery_replay2.New(replayWebhookDeliveryUseCase, a.validator)
+
+	statsPrAssignmentsUseCase := stats_pr_assignments.NewUsecase(repPrReviewers, repTeams, repUsers)
 	stats := stats_pr_assignments2.New(statsPrAssignmentsUseCase)
 
 	deactivateTeamUseCase := team_deactivate_users.NewUsecase(repTeams, repUsers, repPullRequests,
-		repPrReviewers, repPrStatuses, randomizer, a.trManager)
+		repPrReviewers, repPrStatuses, randomizer, a.config.App.Validation.MaxPrReviewers, eventsPublisher, a.trManager)
 	deactivateTeam := team_deactivate_users2.New(deactivateTeamUseCase, a.validator)
+	activateTeamUseCase := team_activate_users.NewUsecase(repTeams, repUsers, repPullRequests,
+		repPrReviewers, repPrStatuses, a.config.App.Validation.MaxPrReviewers, nower, eventsPublisher, a.trManager)
+	activateTeam := team_activate_users2.New(activateTeamUseCase, a.validator)
+	rebalanceTeamUseCase := team_rebalance.NewUsecase(repTeams, repUsers, repPullRequests,
+		repPrReviewers, repPrStatuses, nower, eventsPublisher, a.trManager)
+	rebalanceTeam := team_rebalance2.New(rebalanceTeamUseCase, a.validator)
+	renameTeamUseCase := team_rename.NewUsecase(repTeams, eventsPublisher, a.trManager)
+	renameTeam := team_rename2.New(renameTeamUseCase, a.validator)
+	archiveTeamUseCase := team_archive.NewUsecase(repTeams, eventsPublisher, a.trManager)
+	archiveTeam := team_archive2.New(archiveTeamUseCase, a.validator)
+	deleteTeamUseCase := team_delete.NewUsecase(repTeams, repUsers, repPullRequests,
+		repPrReviewers, repPrStatuses, repTeamMemberships, randomizer, a.config.App.Validation.MaxPrReviewers, eventsPublisher,
+		a.config.App.SCIM.DefaultTeam, a.trManager)
+	deleteTeam := team_delete2.New(deleteTeamUseCase, a.validator)
+	a.teamApply = team_apply.NewUsecase(repTeams, repUsers, repTeamMemberships,
+		deactivateTeamUseCase, activateTeamUseCase, moveUserTeamUseCase, eventsPublisher, a.trManager)
+	applyTeams := team_apply2.New(a.teamApply, a.validator)
+	getPolicies := get_policies2.New(a.policy)
+
+	scimUsersUseCase := scim_users.NewUsecase(repUsers, repTeams, repTeamMemberships, repUserIdentities,
+		deactivateTeamUseCase, activateTeamUseCase, a.config.App.SCIM.DefaultTeam, a.trManager)
+	scimGroupsUseCase := scim_groups.NewUsecase(repUsers, repTeams, repTeamMemberships, eventsPublisher,
+		a.config.App.SCIM.DefaultTeam, a.trManager)
+	scimHandler := scim.New(scimUsersUseCase, scimGroupsUseCase, a.validator, a.config.App.SCIM.Token)
 
 	middlewares := func(mustBeOneOfRole []middleware.UserRole, h http.HandlerFunc) http.Handler {
 		handler := h
@@ -202,6 +378,18 @@ func (a *App) setupRestServer(_ context.Context) error {
 		return handler
 	}
 
+	// protected registers a route that needs a token, the roles allowed to call it come from the policy.
+	var withoutPolicy []string
+	protected := func(router *mux.Router, method, path string, h http.HandlerFunc) {
+		route := router.NewRoute().Path(path).Methods(method)
+		template, _ := route.GetPathTemplate()
+		roles, ok := a.policy[middleware.RouteKey(method, template)]
+		if !ok {
+			withoutPolicy = append(withoutPolicy, middleware.RouteKey(method, template))
+		}
+		route.Handler(middlewares(roles, h))
+	}
+
 	r := mux.NewRouter()
 	v1 := r.PathPrefix("/api/v1").Subrouter()
 	r.Handle("/health", healthChecker.HandlerFunc()).Methods("GET")
@@ -211,21 +399,74 @@ func (a *App) setupRestServer(_ context.Context) error {
 	v1.Handle("/dummyLogin", middlewares(nil, dummy.DummyLogin)).Methods("POST")
 
 	teamV1 := v1.PathPrefix("/team").Subrouter()
-	teamV1.Handle("/add", middlewares(allRoles, addTeam.AddTeam)).Methods("POST")
-	teamV1.Handle("/get", middlewares(allRoles, getTeam.GetTeam)).Methods("GET")
-	teamV1.Handle("/deactivateUsers", middlewares(allRoles, deactivateTeam.DeactivateTeamUsers)).Methods("PATCH")
+	protected(teamV1, "POST", "/add", addTeam.AddTeam)
+	protected(teamV1, "GET", "/get", getTeam.GetTeam)
+	protected(teamV1, "GET", "/tree", getTeamTree.GetTeamTree)
+	protected(teamV1, "GET", "/list", getTeamList.ListTeams)
+	protected(teamV1, "PATCH", "/activateUsers", activateTeam.ActivateTeamUsers)
+	protected(teamV1, "PATCH", "/deactivateUsers", deactivateTeam.DeactivateTeamUsers)
+	protected(teamV1, "POST", "/rebalance", rebalanceTeam.RebalanceTeam)
+	protected(teamV1, "POST", "/rename", renameTeam.RenameTeam)
+	protected(teamV1, "POST", "/setIsArchived", archiveTeam.SetTeamIsArchived)
+	protected(teamV1, "POST", "/delete", deleteTeam.DeleteTeam)
 
 	usersV1 := v1.PathPrefix("/users").Subrouter()
-	usersV1.Handle("/setIsActive", middlewares(allRoles, setIsActive.SetIsActive)).Methods("POST")
-	usersV1.Handle("/getReview", middlewares(allRoles, getReview.GetUserReviewPRs)).Methods("GET")
+	protected(usersV1, "POST", "/setIsActive", setIsActive.SetIsActive)
+	protected(usersV1, "POST", "/setUnavailable", setUnavailable.SetUnavailable)
+	protected(usersV1, "GET", "/get", getUser.GetUser)
+	protected(usersV1, "GET", "/getReview", getReview.GetUserReviewPRs)
+	protected(usersV1, "POST", "/handoverReviews", handoverReviews.HandoverReviews)
+	protected(usersV1, "GET", "/reviewStream", reviewStream.StreamUserReviews)
+	protected(usersV1, "POST", "/snoozeReview", reviewSnooze.SnoozeReview)
+	protected(usersV1, "POST", "/moveTeam", moveUserTeam.MoveUserTeam)
+	protected(usersV1, "POST", "/addIdentity", addUserIdentity.AddUserIdentity)
+	protected(usersV1, "POST", "/deleteIdentity", deleteUserIdentity.DeleteUserIdentity)
+	protected(usersV1, "GET", "/getIdentities", getUserIdentities.GetUserIdentities)
+	protected(usersV1, "GET", "/findByIdentity", findUserByIdentity.FindUserByIdentity)
 
 	prV1 := v1.PathPrefix("/pullRequest").Subrouter()
-	prV1.Handle("/create", middlewares(allRoles, prCreate.CreatePullRequest)).Methods("POST")
-	prV1.Handle("/merge", middlewares(allRoles, prMerge.MergePullRequest)).Methods("POST")
-	prV1.Handle("/reassign", middlewares(allRoles, reassign.ReassignPullRequest)).Methods("POST")
+	protected(prV1, "POST", "/create", prCreate.CreatePullRequest)
+	protected(prV1, "POST", "/merge", prMerge.MergePullRequest)
+	protected(prV1, "POST", "/reassign", reassign.ReassignPullRequest)
+
+	codeOwnersV1 := v1.PathPrefix("/codeOwners").Subrouter()
+	protected(codeOwnersV1, "POST", "/upload", uploadCodeOwners.UploadCodeOwners)
+	protected(codeOwnersV1, "GET", "/get", getCodeOwners.GetCodeOwners)
 
 	statV1 := v1.PathPrefix("/statistics").Subrouter()
-	statV1.Handle("/reviewers", middlewares(allRoles, stats.GetReviewersStats)).Methods("GET")
+	protected(statV1, "GET", "/reviewers", stats.GetReviewersStats)
+
+	integrationsV1 := v1.PathPrefix("/integrations").Subrouter()
+	integrationsV1.Handle("/github/webhook", middlewares(nil, githubWebhook.HandleWebhook)).Methods("POST")
+	integrationsV1.Handle("/gitlab/webhook", middlewares(nil, gitlabWebhook.HandleWebhook)).Methods("POST")
+
+	webhooksV1 := v1.PathPrefix("/webhooks").Subrouter()
+	protected(webhooksV1, "POST", "/subscribe", subscribeWebhook.SubscribeWebhook)
+	protected(webhooksV1, "POST", "/unsubscribe", unsubscribeWebhook.UnsubscribeWebhook)
+	protected(webhooksV1, "GET", "/list", getWebhookSubscriptions.ListWebhookSubscriptions)
+	protected(webhooksV1, "GET", "/deliveries", getWebhookDeliveries.ListWebhookDeliveries)
+	protected(webhooksV1, "POST", "/replayDelivery", replayWebhookDelivery.ReplayWebhookDelivery)
+
+	adminV1 := v1.PathPrefix("/admin").Subrouter()
+	protected(adminV1, "POST", "/teams/apply", applyTeams.ApplyTeams)
+	protected(adminV1, "GET", "/policies", getPolicies.GetPolicies)
+
+	// SCIM requests carry the identity provider token instead of a JWT.
+	scimV2 := v1.PathPrefix("/scim/v2").Subrouter()
+	scimV2.Handle("/Users", middlewares(nil, scimHandler.Authorize(scimHandler.CreateUser))).Methods("POST")
+	scimV2.Handle("/Users", middlewares(nil, scimHandler.Authorize(scimHandler.ListUsers))).Methods("GET")
+	scimV2.Handle("/Users/{id}", middlewares(nil, scimHandler.Authorize(scimHandler.GetUser))).Methods("GET")
+	scimV2.Handle("/Users/{id}", middlewares(nil, scimHandler.Authorize(scimHandler.PatchUser))).Methods("PATCH")
+	scimV2.Handle("/Users/{id}", middlewares(nil, scimHandler.Authorize(scimHandler.DeleteUser))).Methods("DELETE")
+	scimV2.Handle("/Groups", middlewares(nil, scimHandler.Authorize(scimHandler.CreateGroup))).Methods("POST")
+	scimV2.Handle("/Groups", middlewares(nil, scimHandler.Authorize(scimHandler.ListGroups))).Methods("GET")
+	scimV2.Handle("/Groups/{id}", middlewares(nil, scimHandler.Authorize(scimHandler.GetGroup))).Methods("GET")
+	scimV2.Handle("/Groups/{id}", middlewares(nil, scimHandler.Authorize(scimHandler.PatchGroup))).Methods("PATCH")
+	scimV2.Handle("/Groups/{id}", middlewares(nil, scimHandler.Authorize(scimHandler.DeleteGroup))).Methods("DELETE")
+
+	if len(withoutPolicy) != 0 {
+		return fmt.Errorf("%w: %s", middleware.ErrRouteWithoutPolicy, strings.Join(withoutPolicy, ", "))
+	}
 
 	a.restServer = &http.Server{
 		Addr:         a.config.Server.Rest.Address,
@@ -234,25 +475,202 @@ func (a *App) setupRestServer(_ context.Context) error {
 		IdleTimeout:  a.config.Server.Rest.Connsettings.IdleTimeout,
 		Handler:      r,
 	}
+	// Shutdown waits for active requests, review streams end when the bus is closed.
+	a.restServer.RegisterOnShutdown(a.reviewBus.Close)
+
+	return nil
+}
+
+func (a *App) setupReviewBus(_ context.Context) error {
+	a.reviewBus = event_bus.New(a.config.App.ReviewStream.HistorySize, a.config.App.ReviewStream.BufferSize)
+	return nil
+}
+
+func (a *App) setupWorkers(_ context.Context) error {
+	nower := nower2.Nower{}
+	cfg := a.config.App.Webhooks
+
+	repWebhookEventDeliveries := webhook_event_deliveries.NewRepository(a.pool, nower)
+	sender := webhook_sender.New(cfg.Timeout)
+	dispatchUseCase := webhook_dispatch.NewUsecase(repWebhookEventDeliveries, sender, nower,
+		cfg.BatchSize, cfg.MaxAttempts, cfg.BaseBackoff, cfg.MaxBackoff, a.trManager)
+
+	a.workers = append(a.workers, worker.NewPeriodic("webhook_dispatch", cfg.DispatchInterval,
+		func(ctx context.Context) error {
+			out, err := dispatchUseCase.Run(ctx)
+			if err != nil {
+				return err
+			}
+			if out.Delivered+out.Retried+out.Failed > 0 {
+				slog.InfoContext(ctx, "webhook deliveries dispatched",
+					"delivered", out.Delivered, "retried", out.Retried, "failed", out.Failed)
+			}
+			return nil
+		}))
+
+	reviewStreamListener := pg_notify.NewListener(a.pool, reviewStreamChannel, a.reviewBus)
+	a.workers = append(a.workers, worker.NewPeriodic("review_stream_listener",
+		a.config.App.ReviewStream.ListenRetryInterval, reviewStreamListener.Listen))
+
+	outboxCfg := a.config.App.Outbox
+	repOutbox := outbox.NewRepository(a.pool, nower)
+	repWebhookSubscriptions := webhook_subscriptions.NewRepository(a.pool, nower)
+	publishers := []events.Publisher{
+		webhook_publish.NewUsecase(repWebhookSubscriptions, repWebhookEventDeliveries, nower),
+		review_stream_publish.NewUsecase(pr_reviewers.NewRepository(a.pool),
+			pg_notify.NewNotifier(a.pool, reviewStreamChannel)),
+	}
+	var notifiers []events.Publisher
+
+	chatCfg := a.config.App.Notifications.Chat
+	if chatCfg.Enabled {
+		templates, err := chat_notify.NewTemplates(chatCfg.AssignedTemplate, chatCfg.UnassignedTemplate,
+			chatCfg.ReminderTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to parse chat templates: %w", err)
+		}
+		notifiers = append(notifiers, chat_notify.NewUsecase(users.NewRepository(a.pool, nower),
+			teams.NewRepository(a.pool, nower), chat_sender.New(chatCfg.Timeout), chatCfg.Channels,
+			chatCfg.DefaultChannelURL, chatCfg.Mentions, templates))
+	}
+
+	emailCfg := a.config.App.Notifications.Email
+	if emailCfg.Enabled {
+		templates, err := email_templates.Load(emailCfg.TemplatesDir)
+		if err != nil {
+			return fmt.Errorf("failed to load email templates: %w", err)
+		}
+		emailSender := email_sender.New(email_sender.Config{
+			Host:     emailCfg.SMTPHost,
+			Port:     emailCfg.SMTPPort,
+			Username: emailCfg.SMTPUsername,
+			Password: emailCfg.SMTPPassword,
+			From:     emailCfg.From,
+			Timeout:  emailCfg.Timeout,
+		})
+		repUsers := users.NewRepository(a.pool, nower)
+		repIdentities := user_identities.NewRepository(a.pool, nower)
+		notifiers = append(notifiers, email_notify.NewUsecase(repUsers, repIdentities, emailSender, templates))
+
+		if emailCfg.DigestEnabled {
+			digestTime, err := time.Parse("15:04", emailCfg.DigestTime)
+			if err != nil {
+				return fmt.Errorf("failed to parse email digest time: %w", err)
+			}
+			location, err := time.LoadLocation(emailCfg.DigestTimezone)
+			if err != nil {
+				return fmt.Errorf("failed to load email digest timezone: %w", err)
+			}
+			sendAt := time.Duration(digestTime.Hour())*time.Hour + time.Duration(digestTime.Minute())*time.Minute
+			digestUseCase := email_digest.NewUsecase(pr_reviewers.NewRepository(a.pool),
+				pull_requests.NewRepository(a.pool, nower), pr_statuses.NewRepository(a.pool), repUsers,
+				repIdentities, user_notifications.NewRepository(a.pool, nower), emailSender, templates, nower,
+				sendAt, location, a.trManager)
+
+			a.workers = append(a.workers, worker.NewPeriodic("email_digest", emailCfg.DigestCheckInterval,
+				func(ctx context.Context) error {
+					out, err := digestUseCase.Run(ctx)
+					if err != nil {
+						return err
+					}
+					if out.Sent+out.Failed > 0 {
+						slog.InfoContext(ctx, "email digests sent", "sent", out.Sent, "failed", out.Failed)
+					}
+					return nil
+				}))
+		}
+	}
+
+	remindersCfg := a.config.App.Notifications.Reminders
+	if remindersCfg.Enabled {
+		remindersUseCase := stale_reminders.NewUsecase(pr_reviewers.NewRepository(a.pool),
+			pull_requests.NewRepository(a.pool, nower), pr_statuses.NewRepository(a.pool),
+			users.NewRepository(a.pool, nower), review_snoozes.NewRepository(a.pool, nower),
+			user_notifications.NewRepository(a.pool, nower), outbox_publish.NewUsecase(repOutbox, nower), nower,
+			remindersCfg.StaleAfter, a.trManager)
+
+		a.workers = append(a.workers, worker.NewPeriodic("stale_reminders", remindersCfg.Interval,
+			func(ctx context.Context) error {
+				out, err := remindersUseCase.Run(ctx)
+				if err != nil {
+					return err
+				}
+				if out.Reminded > 0 {
+					slog.InfoContext(ctx, "stale review reminders published",
+						"reviewers", out.Reminded, "reminders", out.Reminders)
+				}
+				return nil
+			}))
+	}
+
+	relayUseCase := outbox_relay.NewUsecase(repOutbox, publishers, notifiers, nower, outboxCfg.BatchSize,
+		outboxCfg.MaxAttempts, outboxCfg.BaseBackoff, outboxCfg.MaxBackoff, a.trManager)
+
+	a.workers = append(a.workers, worker.NewPeriodic("outbox_relay", outboxCfg.RelayInterval,
+		func(ctx context.Context) error {
+			out, err := relayUseCase.Run(ctx)
+			if err != nil {
+				return err
+			}
+			if out.Published+out.Retried+out.Failed > 0 {
+				slog.InfoContext(ctx, "outbox events relayed",
+					"published", out.Published, "retried", out.Retried, "failed", out.Failed)
+			}
+			return nil
+		}))
 
 	return nil
 }
 
 func (a *App) setupGrpcServer(_ context.Context) error {
-	//grpcServer := grpc.NewServer(
-	//	grpc.KeepaliveParams(keepalive.ServerParameters{
-	//		MaxConnectionIdle: a.config.Server.GRPC.ConnSettings.MaxConnIdle,
-	//		MaxConnectionAge:  a.config.Server.GRPC.ConnSettings.MaxConnAge,
-	//	}),
-	//)
-	//
-	//nower := nower2.Nower{}
-	//repPVZ := pvz.NewRepository(a.pool, nower)
-	//listpvzsUsecase := list_pvzs.NewUsecase(repPVZ)
-	//pvzServer := server.New(listpvzsUsecase)
-	//
-	//pvz_v1.RegisterPVZServiceServer(grpcServer, pvzServer)
-	//a.grpcServer = grpcServer
+	interceptors := []grpc.UnaryServerInterceptor{
+		middleware.LoggerInterceptor,
+		middleware.MetricsInterceptor,
+		middleware.PanicInterceptor,
+	}
+	if a.config.App.AuthorisationNeeded {
+		interceptors = append(interceptors, middleware.AuthInterceptor(a.config.App.JWTSecret, a.policy))
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: a.config.Server.GRPC.ConnSettings.MaxConnIdle,
+			MaxConnectionAge:  a.config.Server.GRPC.ConnSettings.MaxConnAge,
+		}),
+		grpc.ChainUnaryInterceptor(interceptors...),
+	)
+
+	nower := nower2.Nower{}
+	randomizer := randomizer2.Randomizer{}
+	maxReviewers := a.config.App.Validation.MaxPrReviewers
+
+	repPrReviewers := pr_reviewers.NewRepository(a.pool)
+	repPrStatuses := pr_statuses.NewRepository(a.pool)
+	repPullRequests := pull_requests.NewRepository(a.pool, nower)
+	repTeams := teams.NewRepository(a.pool, nower)
+	repTeamMemberships := team_memberships.NewRepository(a.pool, nower)
+	repUsers := users.NewRepository(a.pool, nower)
+	repUserIdentities := user_identities.NewRepository(a.pool, nower)
+	repCodeOwners := code_owners.NewRepository(a.pool, nower)
+	eventsPublisher := outbox_publish.NewUsecase(outbox.NewRepository(a.pool, nower), nower)
+
+	prReviewersServer := server.New(
+		add_team.Newusecase(repUsers, repTeams, repTeamMemberships, repUserIdentities, eventsPublisher, a.trManager),
+		get_team.NewUsecase(repTeams, repUsers),
+		get_team_list.NewUsecase(repTeams),
+		set_is_active.NewUsecase(repTeams, repUsers, eventsPublisher, a.trManager),
+		get_user.NewUsecase(repUsers, repTeams, repTeamMemberships, repPullRequests, repPrReviewers, nower),
+		get_review.NewUsecase(repUsers, repPullRequests, repPrReviewers, repPrStatuses),
+		pull_request_create.NewUsecase(repUsers, repTeams, repPullRequests, repPrReviewers, repPrStatuses,
+			repUserIdentities, repCodeOwners, randomizer, maxReviewers, eventsPublisher, a.trManager),
+		pull_request_merge.NewUsecase(repPullRequests, repPrReviewers, repPrStatuses, eventsPublisher, a.trManager),
+		pull_request_reassign.NewUsecase(repUsers, repTeams, repPullRequests, repPrReviewers, repPrStatuses,
+			randomizer, maxReviewers, eventsPublisher, a.trManager),
+		stats_pr_assignments.NewUsecase(repPrReviewers, repTeams, repUsers),
+	)
+
+	pr_reviewers_v1.RegisterPRReviewersServiceServer(grpcServer, prReviewersServer)
+	a.grpcServer = grpcServer
 
 	return nil
 }
@@ -17,8 +17,114 @@ type Config struct {
 type AppConfig struct {
 	Validation          Validation
 	Logging             Logging
-	AuthorisationNeeded bool   `yaml:"authorisation_needed" env:"AUTHORISATION_NEEDED" env-default:"false"`
-	JWTSecret           string `yaml:"jwt_secret" env:"JWT_SECRET" env-default:""`
+	AuthorisationNeeded bool          `yaml:"authorisation_needed" env:"AUTHORISATION_NEEDED" env-default:"false"`
+	JWTSecret           string        `yaml:"jwt_secret" env:"JWT_SECRET" env-default:""`
+	Integrations        Integrations  `yaml:"integrations"`
+	Webhooks            Webhooks      `yaml:"webhooks"`
+	Outbox              Outbox        `yaml:"outbox"`
+	Notifications       Notifications `yaml:"notifications"`
+	ReviewStream        ReviewStream  `yaml:"review_stream"`
+	SCIM                SCIM          `yaml:"scim"`
+	// Policies overrides the roles allowed to call a route, see GET /admin/policies for the routes and defaults.
+	Policies map[string][]string `yaml:"policies"`
+}
+
+type Integrations struct {
+	GitHub GitHubIntegration `yaml:"github"`
+	GitLab GitLabIntegration `yaml:"gitlab"`
+}
+
+type GitHubIntegration struct {
+	WebhookSecret string `yaml:"webhook_secret" env:"GITHUB_WEBHOOK_SECRET" env-default:""`
+}
+
+type GitLabIntegration struct {
+	WebhookToken string `yaml:"webhook_token" env:"GITLAB_WEBHOOK_TOKEN" env-default:""`
+}
+
+// Webhooks configures delivery of events to subscribed endpoints.
+type Webhooks struct {
+	DispatchInterval time.Duration `yaml:"dispatch_interval" env:"WEBHOOKS_DISPATCH_INTERVAL" env-default:"5s"`
+	BatchSize        uint64        `yaml:"batch_size" env:"WEBHOOKS_BATCH_SIZE" env-default:"50"`
+	MaxAttempts      int           `yaml:"max_attempts" env:"WEBHOOKS_MAX_ATTEMPTS" env-default:"8"`
+	BaseBackoff      time.Duration `yaml:"base_backoff" env:"WEBHOOKS_BASE_BACKOFF" env-default:"30s"`
+	MaxBackoff       time.Duration `yaml:"max_backoff" env:"WEBHOOKS_MAX_BACKOFF" env-default:"1h"`
+	Timeout          time.Duration `yaml:"timeout" env:"WEBHOOKS_TIMEOUT" env-default:"10s"`
+}
+
+// Outbox configures the relay of committed domain events to publishers.
+type Outbox struct {
+	RelayInterval time.Duration `yaml:"relay_interval" env:"OUTBOX_RELAY_INTERVAL" env-default:"1s"`
+	BatchSize     uint64        `yaml:"batch_size" env:"OUTBOX_BATCH_SIZE" env-default:"100"`
+	MaxAttempts   int           `yaml:"max_attempts" env:"OUTBOX_MAX_ATTEMPTS" env-default:"10"`
+	BaseBackoff   time.Duration `yaml:"base_backoff" env:"OUTBOX_BASE_BACKOFF" env-default:"5s"`
+	MaxBackoff    time.Duration `yaml:"max_backoff" env:"OUTBOX_MAX_BACKOFF" env-default:"10m"`
+}
+
+// Notifications configures messages sent to reviewers about their reviews.
+type Notifications struct {
+	Chat      ChatNotifications     `yaml:"chat"`
+	Email     EmailNotifications    `yaml:"email"`
+	Reminders ReminderNotifications `yaml:"reminders"`
+}
+
+// ChatNotifications configures Slack or Mattermost incoming webhooks. Channels maps a team name to its webhook URL,
+// Mentions maps a user ID to the handle used in messages. Templates are text/template, empty means the default text.
+type ChatNotifications struct {
+	Enabled            bool              `yaml:"enabled" env:"CHAT_ENABLED" env-default:"false"`
+	Timeout            time.Duration     `yaml:"timeout" env:"CHAT_TIMEOUT" env-default:"5s"`
+	DefaultChannelURL  string            `yaml:"default_channel_url" env:"CHAT_DEFAULT_CHANNEL_URL" env-default:""`
+	Channels           map[string]string `yaml:"channels" env:"CHAT_CHANNELS"`
+	Mentions           map[string]string `yaml:"mentions" env:"CHAT_MENTIONS"`
+	AssignedTemplate   string            `yaml:"assigned_template" env:"CHAT_ASSIGNED_TEMPLATE" env-default:""`
+	UnassignedTemplate string            `yaml:"unassigned_template" env:"CHAT_UNASSIGNED_TEMPLATE" env-default:""`
+	ReminderTemplate   string            `yaml:"reminder_template" env:"CHAT_REMINDER_TEMPLATE" env-default:""`
+}
+
+// EmailNotifications configures the SMTP relay used for assignment emails and the daily digest. Reviewers are
+// emailed at the address of their "email" identity. DigestTime is HH:MM in DigestTimezone, TemplatesDir holds
+// template files overriding the embedded ones.
+type EmailNotifications struct {
+	Enabled             bool          `yaml:"enabled" env:"EMAIL_ENABLED" env-default:"false"`
+	SMTPHost            string        `yaml:"smtp_host" env:"EMAIL_SMTP_HOST" env-default:""`
+	SMTPPort            int           `yaml:"smtp_port" env:"EMAIL_SMTP_PORT" env-default:"587"`
+	SMTPUsername        string        `yaml:"smtp_username" env:"EMAIL_SMTP_USERNAME" env-default:""`
+	SMTPPassword        string        `yaml:"smtp_password" env:"EMAIL_SMTP_PASSWORD" env-default:""`
+	From                string        `yaml:"from" env:"EMAIL_FROM" env-default:""`
+	Timeout             time.Duration `yaml:"timeout" env:"EMAIL_TIMEOUT" env-default:"10s"`
+	TemplatesDir        string        `yaml:"templates_dir" env:"EMAIL_TEMPLATES_DIR" env-default:""`
+	DigestEnabled       bool          `yaml:"digest_enabled" env:"EMAIL_DIGEST_ENABLED" env-default:"true"`
+	DigestTime          string        `yaml:"digest_time" env:"EMAIL_DIGEST_TIME" env-default:"09:00"`
+	DigestTimezone      string        `yaml:"digest_timezone" env:"EMAIL_DIGEST_TIMEZONE" env-default:"UTC"`
+	DigestCheckInterval time.Duration `yaml:"digest_check_interval" env:"EMAIL_DIGEST_CHECK_INTERVAL" env-default:"1m"`
+}
+
+// ReminderNotifications configures nudges about reviews of pull requests open for longer than StaleAfter. They go
+// through the enabled chat and email notifications, at most once a day per reviewer.
+type ReminderNotifications struct {
+	Enabled    bool          `yaml:"enabled" env:"REMINDERS_ENABLED" env-default:"false"`
+	Interval   time.Duration `yaml:"interval" env:"REMINDERS_INTERVAL" env-default:"10m"`
+	StaleAfter time.Duration `yaml:"stale_after" env:"REMINDERS_STALE_AFTER" env-default:"48h"`
+}
+
+// ReviewStream configures the Server-Sent Events stream of review queue changes. HistorySize events are kept per user
+// for reconnecting clients, a client more than BufferSize events behind is disconnected. WriteTimeout bounds every
+// write of the stream in place of the server write timeout. ListenRetryInterval is the pause before the instance
+// listens for stream events again after its database connection failed.
+type ReviewStream struct {
+	HistorySize         int           `yaml:"history_size" env:"REVIEW_STREAM_HISTORY_SIZE" env-default:"100"`
+	BufferSize          int           `yaml:"buffer_size" env:"REVIEW_STREAM_BUFFER_SIZE" env-default:"32"`
+	KeepAlive           time.Duration `yaml:"keep_alive" env:"REVIEW_STREAM_KEEP_ALIVE" env-default:"15s"`
+	WriteTimeout        time.Duration `yaml:"write_timeout" env:"REVIEW_STREAM_WRITE_TIMEOUT" env-default:"5s"`
+	ListenRetryInterval time.Duration `yaml:"listen_retry_interval" env:"REVIEW_STREAM_LISTEN_RETRY_INTERVAL" env-default:"1s"`
+}
+
+// SCIM configures provisioning from the identity provider. Requests are rejected while Token is empty, users created
+// through SCIM are kept in DefaultTeam until a group makes another team their primary one. Deleting a team moves
+// its users with pull request history to DefaultTeam as well.
+type SCIM struct {
+	Token       string `yaml:"token" env:"SCIM_TOKEN" env-default:""`
+	DefaultTeam string `yaml:"default_team" env:"SCIM_DEFAULT_TEAM" env-default:"scim"`
 }
 
 type Logging struct {
@@ -33,7 +139,7 @@ type Validation struct {
 
 type ServerConfig struct {
 	Rest RestConfig `yaml:"rest"`
-	//GRPC GRPCConfig `yaml:"grpc"`
+	GRPC GRPCConfig `yaml:"grpc"`
 }
 
 type RestConfig struct {
@@ -47,15 +153,15 @@ type RestConnSettings struct {
 	IdleTimeout  time.Duration `yaml:"idle_timeout" env:"IDLE_TIMEOUT" env-default:"5m"`
 }
 
-//type GRPCConfig struct {
-//	Address      string           `yaml:"address" env:"GRPC_ADDRESS" env-default:":3000"`
-//	ConnSettings GRPCConnSettings `yaml:"conn_settings"`
-//}
+type GRPCConfig struct {
+	Address      string           `yaml:"address" env:"GRPC_ADDRESS" env-default:":3000"`
+	ConnSettings GRPCConnSettings `yaml:"conn_settings"`
+}
 
-//type GRPCConnSettings struct {
-//	MaxConnIdle time.Duration `yaml:"max_conn_idle" env:"GRPC_MAX_CONN_IDLE" env-default:"5m"`
-//	MaxConnAge  time.Duration `yaml:"max_conn_age" env:"GRPC_MAX_CONN_AGE" env-default:"10m"`
-//}
+type GRPCConnSettings struct {
+	MaxConnIdle time.Duration `yaml:"max_conn_idle" env:"GRPC_MAX_CONN_IDLE" env-default:"5m"`
+	MaxConnAge  time.Duration `yaml:"max_conn_age" env:"GRPC_MAX_CONN_AGE" env-default:"10m"`
+}
 
 type DBConfig struct {
 	MigrationsDir string       `yaml:"migrations_dir" env:"MIGRATIONS_DIR" env-default:"./migrations"`
@@ -9,42 +9,254 @@ import (
 	"github.com/google/uuid"
 )
 
+// Defines values for ApplyTeamsChangeAction.
+const (
+	ActivateUser     ApplyTeamsChangeAction = "activate_user"
+	AddMembership    ApplyTeamsChangeAction = "add_membership"
+	ArchiveTeam      ApplyTeamsChangeAction = "archive_team"
+	CreateTeam       ApplyTeamsChangeAction = "create_team"
+	CreateUser       ApplyTeamsChangeAction = "create_user"
+	DeactivateUser   ApplyTeamsChangeAction = "deactivate_user"
+	MoveUser         ApplyTeamsChangeAction = "move_user"
+	RemoveMembership ApplyTeamsChangeAction = "remove_membership"
+	RenameUser       ApplyTeamsChangeAction = "rename_user"
+	SetParent        ApplyTeamsChangeAction = "set_parent"
+	UnarchiveTeam    ApplyTeamsChangeAction = "unarchive_team"
+)
+
+// Defines values for DeactivationAffectedPullRequestStatus.
+const (
+	DeactivationAffectedPullRequestStatusMERGED DeactivationAffectedPullRequestStatus = "MERGED"
+	DeactivationAffectedPullRequestStatusOPEN   DeactivationAffectedPullRequestStatus = "OPEN"
+)
+
+// Defines values for DummyLoginRequestRole.
+const (
+	ADMIN DummyLoginRequestRole = "ADMIN"
+	USER  DummyLoginRequestRole = "USER"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	BADREQUEST  ErrorResponseErrorCode = "BAD_REQUEST"
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
-	UNKNOWN     ErrorResponseErrorCode = "UNKNOWN"
+	BADREQUEST        ErrorResponseErrorCode = "BAD_REQUEST"
+	DELIVERYNOTFAILED ErrorResponseErrorCode = "DELIVERY_NOT_FAILED"
+	FORBIDDEN         ErrorResponseErrorCode = "FORBIDDEN"
+	NOCANDIDATE       ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED       ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND          ErrorResponseErrorCode = "NOT_FOUND"
+	PRCLOSED          ErrorResponseErrorCode = "PR_CLOSED"
+	PREXISTS          ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED          ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS        ErrorResponseErrorCode = "TEAM_EXISTS"
+	TEAMHASOPENPRS    ErrorResponseErrorCode = "TEAM_HAS_OPEN_PRS"
+	TEAMHASPRHISTORY  ErrorResponseErrorCode = "TEAM_HAS_PR_HISTORY"
+	UNKNOWN           ErrorResponseErrorCode = "UNKNOWN"
+)
+
+// Defines values for HandoverPullRequestOutcome.
+const (
+	HANDEDOVER HandoverPullRequestOutcome = "HANDED_OVER"
+	REASSIGNED HandoverPullRequestOutcome = "REASSIGNED"
+	UNASSIGNED HandoverPullRequestOutcome = "UNASSIGNED"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
-	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
-	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+	CLOSED PullRequestShortStatus = "CLOSED"
+	MERGED PullRequestShortStatus = "MERGED"
+	OPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Defines values for ReviewStreamEventEvent.
+const (
+	ReviewStreamEventEventPullRequestClosed  ReviewStreamEventEvent = "pull_request.closed"
+	ReviewStreamEventEventPullRequestMerged  ReviewStreamEventEvent = "pull_request.merged"
+	ReviewStreamEventEventReviewerAssigned   ReviewStreamEventEvent = "reviewer.assigned"
+	ReviewStreamEventEventReviewerUnassigned ReviewStreamEventEvent = "reviewer.unassigned"
+)
+
+// Defines values for ReviewerAssignmentSource.
+const (
+	CODEOWNERS ReviewerAssignmentSource = "CODEOWNERS"
+	PARENTTEAM ReviewerAssignmentSource = "PARENT_TEAM"
+	TEAM       ReviewerAssignmentSource = "TEAM"
+)
+
+// Defines values for ScimErrorScimType.
+const (
+	InvalidFilter ScimErrorScimType = "invalidFilter"
+	InvalidPath   ScimErrorScimType = "invalidPath"
+	InvalidSyntax ScimErrorScimType = "invalidSyntax"
+	InvalidValue  ScimErrorScimType = "invalidValue"
+	Mutability    ScimErrorScimType = "mutability"
+	NoTarget      ScimErrorScimType = "noTarget"
+	Uniqueness    ScimErrorScimType = "uniqueness"
+)
+
+// Defines values for ScimGroupRefType.
+const (
+	Direct ScimGroupRefType = "direct"
+)
+
+// Defines values for ScimMetaResourceType.
+const (
+	ScimMetaResourceTypeGroup ScimMetaResourceType = "Group"
+	ScimMetaResourceTypeUser  ScimMetaResourceType = "User"
+)
+
+// Defines values for SubscribeWebhookRequestEvents.
+const (
+	SubscribeWebhookRequestEventsPullRequestCreated SubscribeWebhookRequestEvents = "pull_request.created"
+	SubscribeWebhookRequestEventsPullRequestMerged  SubscribeWebhookRequestEvents = "pull_request.merged"
+	SubscribeWebhookRequestEventsReviewerAssigned   SubscribeWebhookRequestEvents = "reviewer.assigned"
+	SubscribeWebhookRequestEventsReviewerUnassigned SubscribeWebhookRequestEvents = "reviewer.unassigned"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDELIVERED WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
 )
 
+// Defines values for GetWebhooksDeliveriesParamsStatus.
+const (
+	GetWebhooksDeliveriesParamsStatusDELIVERED GetWebhooksDeliveriesParamsStatus = "DELIVERED"
+	GetWebhooksDeliveriesParamsStatusFAILED    GetWebhooksDeliveriesParamsStatus = "FAILED"
+	GetWebhooksDeliveriesParamsStatusPENDING   GetWebhooksDeliveriesParamsStatus = "PENDING"
+)
+
+// ActivateTeamUsersRequest defines model for ActivateTeamUsersRequest.
+type ActivateTeamUsersRequest struct {
+	// Backfill Назначить вернувшихся пользователей ревьюерами на открытые PR команды, где ревьюеров не хватает
+	Backfill *bool  `json:"backfill,omitempty"`
+	TeamName string `json:"team_name" validate:"required"`
+
+	// UserIds Список ID пользователей для активации
+	UserIds []uuid.UUID `json:"user_ids" validate:"required,min=1,dive,uuid"`
+}
+
+// ActivateTeamUsersResponse defines model for ActivateTeamUsersResponse.
+type ActivateTeamUsersResponse struct {
+	// BackfilledPullRequests Список PR, на которые были назначены вернувшиеся пользователи
+	BackfilledPullRequests []BackfilledPullRequest `json:"backfilled_pull_requests"`
+	Team                   Team                    `json:"team"`
+}
+
 // AddTeamResponse defines model for AddTeamResponse.
 type AddTeamResponse struct {
 	Team Team `json:"team"`
 }
 
+// ApplyTeam defines model for ApplyTeam.
+type ApplyTeam struct {
+	// AdditionalMemberIds Дополнительные участники, их основная команда указывается в другом элементе списка
+	AdditionalMemberIds *[]uuid.UUID `json:"additional_member_ids,omitempty"`
+	IsArchived          *bool        `json:"is_archived,omitempty"`
+
+	// Members Пользователи, для которых команда основная
+	Members []ApplyTeamMember `json:"members" validate:"dive"`
+
+	// ParentTeamName Родительская команда, должна присутствовать в списке
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
+	TeamName       string  `json:"team_name" validate:"required"`
+}
+
+// ApplyTeamMember defines model for ApplyTeamMember.
+type ApplyTeamMember struct {
+	IsActive bool      `json:"is_active"`
+	UserId   uuid.UUID `json:"user_id" validate:"required"`
+	Username string    `json:"username" validate:"required"`
+}
+
+// ApplyTeamsChange defines model for ApplyTeamsChange.
+type ApplyTeamsChange struct {
+	Action ApplyTeamsChangeAction `json:"action"`
+
+	// From Прежнее значение для set_parent, rename_user и move_user
+	From     *string `json:"from,omitempty"`
+	TeamName string  `json:"team_name"`
+
+	// To Новое значение для set_parent, rename_user, move_user и имя создаваемого пользователя
+	To *string `json:"to,omitempty"`
+
+	// UserId Пользователь, null для изменений команды
+	UserId *uuid.UUID `json:"user_id"`
+}
+
+// ApplyTeamsChangeAction defines model for ApplyTeamsChange.Action.
+type ApplyTeamsChangeAction string
+
+// ApplyTeamsRequest defines model for ApplyTeamsRequest.
+type ApplyTeamsRequest struct {
+	// Plan Только рассчитать изменения, ничего не сохраняя
+	Plan *bool `json:"plan,omitempty"`
+
+	// Teams Полное желаемое состояние: команды, которых нет в списке, архивируются,
+	// пользователи, которых нет в списке, деактивируются
+	Teams []ApplyTeam `json:"teams" validate:"required,min=1,dive"`
+}
+
+// ApplyTeamsResponse defines model for ApplyTeamsResponse.
+type ApplyTeamsResponse struct {
+	// AffectedPullRequests PR, где были заменены ревьюверы деактивированных пользователей
+	AffectedPullRequests []DeactivationAffectedPullRequest `json:"affected_pull_requests"`
+
+	// Changes Изменения в порядке применения
+	Changes []ApplyTeamsChange `json:"changes"`
+
+	// Plan true, если изменения не были сохранены
+	Plan bool `json:"plan"`
+
+	// ReassignedReviews Открытые ревью переведённых пользователей на PR их старых команд
+	ReassignedReviews []MoveTeamReassignedReview `json:"reassigned_reviews"`
+}
+
+// BackfilledPullRequest defines model for BackfilledPullRequest.
+type BackfilledPullRequest struct {
+	// AddedReviewers user_id назначенных ревьюверов
+	AddedReviewers  []uuid.UUID `json:"added_reviewers"`
+	AuthorId        uuid.UUID   `json:"author_id"`
+	PullRequestId   uuid.UUID   `json:"pull_request_id"`
+	PullRequestName string      `json:"pull_request_name"`
+
+	// Understaffed true, если у PR всё ещё меньше ревьюверов, чем требуется
+	Understaffed bool `json:"understaffed"`
+}
+
+// CodeOwnersResponse defines model for CodeOwnersResponse.
+type CodeOwnersResponse struct {
+	Repository string           `json:"repository"`
+	Rules      []CodeOwnersRule `json:"rules"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+}
+
+// CodeOwnersRule defines model for CodeOwnersRule.
+type CodeOwnersRule struct {
+	Line    int      `json:"line"`
+	Owners  []string `json:"owners"`
+	Pattern string   `json:"pattern"`
+}
+
 // CreatePullRequestResponse defines model for CreatePullRequestResponse.
 type CreatePullRequestResponse struct {
 	Pr PullRequest `json:"pr"`
+
+	// Reviewers Назначенные ревьюверы в порядке назначения и причина выбора каждого
+	Reviewers []ReviewerAssignment `json:"reviewers"`
 }
 
 // DeactivateTeamUsersRequest defines model for DeactivateTeamUsersRequest.
 type DeactivateTeamUsersRequest struct {
+	// DryRun Рассчитать отчёт без сохранения изменений (транзакция откатывается)
+	DryRun   *bool  `json:"dry_run,omitempty"`
 	TeamName string `json:"team_name" validate:"required"`
 
 	// UserIds Список ID пользователей для деактивации
@@ -54,8 +266,63 @@ type DeactivateTeamUsersRequest struct {
 // DeactivateTeamUsersResponse defines model for DeactivateTeamUsersResponse.
 type DeactivateTeamUsersResponse struct {
 	// AffectedPullRequests Список PR где были изменены ревьюверы
-	AffectedPullRequests []PullRequestShort `json:"affected_pull_requests"`
-	Team                 Team               `json:"team"`
+	AffectedPullRequests []DeactivationAffectedPullRequest `json:"affected_pull_requests"`
+
+	// DryRun true, если изменения не были сохранены
+	DryRun bool `json:"dry_run"`
+	Team   Team `json:"team"`
+}
+
+// DeactivationAffectedPullRequest defines model for DeactivationAffectedPullRequest.
+type DeactivationAffectedPullRequest struct {
+	// AddedReviewers user_id назначенных взамен ревьюверов
+	AddedReviewers  []uuid.UUID `json:"added_reviewers"`
+	AuthorId        uuid.UUID   `json:"author_id"`
+	PullRequestId   uuid.UUID   `json:"pull_request_id"`
+	PullRequestName string      `json:"pull_request_name"`
+
+	// RemovedReviewers user_id снятых с PR ревьюверов
+	RemovedReviewers []uuid.UUID                           `json:"removed_reviewers"`
+	Status           DeactivationAffectedPullRequestStatus `json:"status"`
+
+	// Understaffed true, если у PR осталось меньше ревьюверов, чем требуется
+	Understaffed bool `json:"understaffed"`
+}
+
+// DeactivationAffectedPullRequestStatus defines model for DeactivationAffectedPullRequest.Status.
+type DeactivationAffectedPullRequestStatus string
+
+// DeleteTeamRequest defines model for DeleteTeamRequest.
+type DeleteTeamRequest struct {
+	// CascadeReassign Удалить команду, даже если у уходящих участников есть открытые PR: в их ревью они заменяются участниками
+	// команды автора, свои PR остаются у автора
+	CascadeReassign *bool  `json:"cascade_reassign,omitempty"`
+	TeamName        string `json:"team_name" validate:"required"`
+}
+
+// DeleteTeamResponse defines model for DeleteTeamResponse.
+type DeleteTeamResponse struct {
+	// AffectedPullRequests PR других команд, где были заменены ревьюверы
+	AffectedPullRequests []DeactivationAffectedPullRequest `json:"affected_pull_requests"`
+
+	// DeletedUserIds Пользователи, для которых команда была основной и единственной и у которых нет истории PR, удалены
+	// вместе с ней
+	DeletedUserIds []uuid.UUID `json:"deleted_user_ids"`
+
+	// DetachedMembers Пользователи без других команд, которые были авторами PR или ревьюверами закрытых PR: история
+	// сохраняется, пользователи переведены в команду по умолчанию
+	DetachedMembers []DeletedTeamPromotedMember `json:"detached_members"`
+
+	// PromotedMembers Пользователи, для которых команда была основной, остались в другой своей команде
+	PromotedMembers []DeletedTeamPromotedMember `json:"promoted_members"`
+	TeamName        string                      `json:"team_name"`
+}
+
+// DeletedTeamPromotedMember defines model for DeletedTeamPromotedMember.
+type DeletedTeamPromotedMember struct {
+	// TeamName Команда, которая стала основной для пользователя
+	TeamName string    `json:"team_name"`
+	UserId   uuid.UUID `json:"user_id"`
 }
 
 // DummyLoginOut defines model for DummyLoginOut.
@@ -63,6 +330,17 @@ type DummyLoginOut struct {
 	Token string `json:"token"`
 }
 
+// DummyLoginRequest defines model for DummyLoginRequest.
+type DummyLoginRequest struct {
+	Role DummyLoginRequestRole `json:"role" validate:"required,oneof=ADMIN USER"`
+
+	// UserId Пользователь, от имени которого выдаётся токен
+	UserId uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// DummyLoginRequestRole defines model for DummyLoginRequest.Role.
+type DummyLoginRequestRole string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -74,17 +352,204 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// FindUserByIdentityResponse defines model for FindUserByIdentityResponse.
+type FindUserByIdentityResponse struct {
+	User User `json:"user"`
+}
+
+// GetUserResponse defines model for GetUserResponse.
+type GetUserResponse struct {
+	IsActive bool `json:"is_active"`
+
+	// IsAvailable Пользователь активен и не отмечен недоступным, ревьюеры подбираются только среди доступных
+	IsAvailable bool `json:"is_available"`
+
+	// TeamName Основная команда пользователя
+	TeamName string `json:"team_name"`
+
+	// Teams Все команды пользователя, основная идёт первой
+	Teams []UserTeamMembership `json:"teams"`
+
+	// UnavailableUntil До какого момента пользователь недоступен, только пока недоступность не закончилась
+	UnavailableUntil *time.Time   `json:"unavailable_until,omitempty"`
+	UserId           uuid.UUID    `json:"user_id"`
+	Username         string       `json:"username"`
+	Workload         UserWorkload `json:"workload"`
+}
+
 // GetUserReviewPRsResponse defines model for GetUserReviewPRsResponse.
 type GetUserReviewPRsResponse struct {
 	PullRequests []PullRequestShort `json:"pull_requests"`
 	UserId       uuid.UUID          `json:"user_id"`
 }
 
+// GithubPullRequest defines model for GithubPullRequest.
+type GithubPullRequest struct {
+	Draft   *bool      `json:"draft,omitempty"`
+	HtmlUrl string     `json:"html_url" validate:"required,url"`
+	Merged  *bool      `json:"merged,omitempty"`
+	Title   string     `json:"title" validate:"required"`
+	User    GithubUser `json:"user"`
+}
+
+// GithubPullRequestEvent defines model for GithubPullRequestEvent.
+type GithubPullRequestEvent struct {
+	Action      string            `json:"action" validate:"required"`
+	PullRequest GithubPullRequest `json:"pull_request"`
+}
+
+// GithubUser defines model for GithubUser.
+type GithubUser struct {
+	Login string `json:"login" validate:"required"`
+}
+
+// GitlabBoolChange defines model for GitlabBoolChange.
+type GitlabBoolChange struct {
+	Current  *bool `json:"current,omitempty"`
+	Previous *bool `json:"previous,omitempty"`
+}
+
+// GitlabMergeRequest defines model for GitlabMergeRequest.
+type GitlabMergeRequest struct {
+	Action   string `json:"action" validate:"required"`
+	AuthorId int64  `json:"author_id"`
+	Draft    *bool  `json:"draft,omitempty"`
+	Title    string `json:"title" validate:"required"`
+	Url      string `json:"url" validate:"required,url"`
+}
+
+// GitlabMergeRequestChanges defines model for GitlabMergeRequestChanges.
+type GitlabMergeRequestChanges struct {
+	Draft *GitlabBoolChange `json:"draft,omitempty"`
+}
+
+// GitlabMergeRequestEvent defines model for GitlabMergeRequestEvent.
+type GitlabMergeRequestEvent struct {
+	Changes          *GitlabMergeRequestChanges `json:"changes,omitempty"`
+	ObjectAttributes GitlabMergeRequest         `json:"object_attributes"`
+	ObjectKind       string                     `json:"object_kind" validate:"required"`
+	User             GitlabUser                 `json:"user"`
+}
+
+// GitlabUser defines model for GitlabUser.
+type GitlabUser struct {
+	Id       int64  `json:"id"`
+	Username string `json:"username" validate:"required"`
+}
+
+// HandoverPullRequest defines model for HandoverPullRequest.
+type HandoverPullRequest struct {
+	AuthorId uuid.UUID `json:"author_id"`
+
+	// NewReviewerId user_id нового ревьювера, null если замену найти не удалось
+	NewReviewerId *uuid.UUID `json:"new_reviewer_id"`
+
+	// Outcome HANDED_OVER - ревью передано целевому пользователю;
+	// REASSIGNED - целевой пользователь автор PR или уже назначен, выбран другой участник команды автора;
+	// UNASSIGNED - свободных ревьюверов нет, пользователь снят без замены
+	Outcome         HandoverPullRequestOutcome `json:"outcome"`
+	PullRequestId   uuid.UUID                  `json:"pull_request_id"`
+	PullRequestName string                     `json:"pull_request_name"`
+}
+
+// HandoverPullRequestOutcome HANDED_OVER - ревью передано целевому пользователю;
+// REASSIGNED - целевой пользователь автор PR или уже назначен, выбран другой участник команды автора;
+// UNASSIGNED - свободных ревьюверов нет, пользователь снят без замены
+type HandoverPullRequestOutcome string
+
+// HandoverReviewsRequest defines model for HandoverReviewsRequest.
+type HandoverReviewsRequest struct {
+	// FromUserId Пользователь, чьи открытые ревью передаются
+	FromUserId uuid.UUID `json:"from_user_id" validate:"required"`
+
+	// ToUserId Пользователь, которому передаются ревью
+	ToUserId uuid.UUID `json:"to_user_id" validate:"required"`
+}
+
+// HandoverReviewsResponse defines model for HandoverReviewsResponse.
+type HandoverReviewsResponse struct {
+	FromUserId uuid.UUID `json:"from_user_id"`
+
+	// PullRequests Отчёт по каждому открытому PR, с которого был снят пользователь
+	PullRequests []HandoverPullRequest `json:"pull_requests"`
+	ToUserId     uuid.UUID             `json:"to_user_id"`
+}
+
+// ListTeamsResponse defines model for ListTeamsResponse.
+type ListTeamsResponse struct {
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
+	Teams  []TeamListItem `json:"teams"`
+
+	// Total Общее число команд, подходящих под фильтр
+	Total int `json:"total"`
+}
+
 // MergePullRequestResponse defines model for MergePullRequestResponse.
 type MergePullRequestResponse struct {
 	Pr PullRequest `json:"pr"`
 }
 
+// MoveTeamReassignedReview defines model for MoveTeamReassignedReview.
+type MoveTeamReassignedReview struct {
+	AuthorId uuid.UUID `json:"author_id"`
+
+	// NewReviewerId user_id нового ревьювера, null если замену найти не удалось
+	NewReviewerId *uuid.UUID `json:"new_reviewer_id"`
+
+	// Outcome REASSIGNED - ревью передано другому участнику старой команды;
+	// UNASSIGNED - свободных ревьюверов нет, пользователь снят без замены
+	Outcome         string    `json:"outcome"`
+	PullRequestId   uuid.UUID `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+}
+
+// MoveTeamReselectedPullRequest defines model for MoveTeamReselectedPullRequest.
+type MoveTeamReselectedPullRequest struct {
+	AddedReviewers  []uuid.UUID `json:"added_reviewers"`
+	PullRequestId   uuid.UUID   `json:"pull_request_id"`
+	PullRequestName string      `json:"pull_request_name"`
+
+	// RemovedReviewers Ревьюверы, не входящие в новую команду
+	RemovedReviewers []uuid.UUID `json:"removed_reviewers"`
+
+	// Understaffed true, если в новой команде не хватило ревьюверов
+	Understaffed bool `json:"understaffed"`
+}
+
+// MoveUserTeamRequest defines model for MoveUserTeamRequest.
+type MoveUserTeamRequest struct {
+	// ReassignReviews Передать открытые ревью пользователя на PR старой команды другим её участникам
+	ReassignReviews *bool `json:"reassign_reviews,omitempty"`
+
+	// ReselectReviewers Переподобрать ревьюверов открытых PR пользователя из новой команды
+	ReselectReviewers *bool `json:"reselect_reviewers,omitempty"`
+
+	// TeamName Команда, в которую переводится пользователь
+	TeamName string    `json:"team_name" validate:"required"`
+	UserId   uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// MoveUserTeamResponse defines model for MoveUserTeamResponse.
+type MoveUserTeamResponse struct {
+	NewTeamName string `json:"new_team_name"`
+	OldTeamName string `json:"old_team_name"`
+
+	// ReassignedReviews Открытые ревью на PR старой команды, с которых снят пользователь
+	ReassignedReviews []MoveTeamReassignedReview `json:"reassigned_reviews"`
+
+	// ReselectedPullRequests Открытые PR пользователя, ревьюверы которых подобраны из новой команды
+	ReselectedPullRequests []MoveTeamReselectedPullRequest `json:"reselected_pull_requests"`
+	UserId                 uuid.UUID                       `json:"user_id"`
+	Username               string                          `json:"username"`
+}
+
+// PoliciesResponse defines model for PoliciesResponse.
+type PoliciesResponse struct {
+	// Policies Действующие правила, отсортированные по ручке
+	Policies []RoutePolicy `json:"policies"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
@@ -119,6 +584,113 @@ type ReassignPullRequestResponse struct {
 	ReplacedBy uuid.UUID `json:"replaced_by"`
 }
 
+// RebalanceMemberWorkload defines model for RebalanceMemberWorkload.
+type RebalanceMemberWorkload struct {
+	// OpenReviewsAfter Количество открытых PR на ревью после перераспределения
+	OpenReviewsAfter int `json:"open_reviews_after"`
+
+	// OpenReviewsBefore Количество открытых PR на ревью до перераспределения
+	OpenReviewsBefore int       `json:"open_reviews_before"`
+	UserId            uuid.UUID `json:"user_id"`
+	Username          string    `json:"username"`
+}
+
+// RebalanceReassignment defines model for RebalanceReassignment.
+type RebalanceReassignment struct {
+	AuthorId        uuid.UUID `json:"author_id"`
+	NewReviewerId   uuid.UUID `json:"new_reviewer_id"`
+	OldReviewerId   uuid.UUID `json:"old_reviewer_id"`
+	PullRequestId   uuid.UUID `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+}
+
+// RebalanceTeamRequest defines model for RebalanceTeamRequest.
+type RebalanceTeamRequest struct {
+	// DryRun Рассчитать план перераспределения без сохранения изменений (транзакция откатывается)
+	DryRun   *bool  `json:"dry_run,omitempty"`
+	TeamName string `json:"team_name" validate:"required"`
+
+	// Tolerance Допустимая разница между максимальной и минимальной нагрузкой (по умолчанию 1)
+	Tolerance *int `json:"tolerance,omitempty" validate:"omitempty,min=0"`
+}
+
+// RebalanceTeamResponse defines model for RebalanceTeamResponse.
+type RebalanceTeamResponse struct {
+	// Balanced true, если разница нагрузки укладывается в tolerance
+	Balanced bool `json:"balanced"`
+
+	// DryRun true, если изменения не были сохранены
+	DryRun bool `json:"dry_run"`
+
+	// Reassignments Выполненные переназначения ревьюверов в порядке применения
+	Reassignments []RebalanceReassignment `json:"reassignments"`
+	TeamName      string                  `json:"team_name"`
+	Tolerance     int                     `json:"tolerance"`
+
+	// Workload Нагрузка активных участников команды до и после перераспределения
+	Workload []RebalanceMemberWorkload `json:"workload"`
+}
+
+// RenameTeamRequest defines model for RenameTeamRequest.
+type RenameTeamRequest struct {
+	// NewTeamName Новое уникальное имя команды
+	NewTeamName string `json:"new_team_name" validate:"required"`
+	TeamName    string `json:"team_name" validate:"required"`
+}
+
+// RenameTeamResponse defines model for RenameTeamResponse.
+type RenameTeamResponse struct {
+	PreviousTeamName string `json:"previous_team_name"`
+	TeamName         string `json:"team_name"`
+}
+
+// ReplayWebhookDeliveryRequest defines model for ReplayWebhookDeliveryRequest.
+type ReplayWebhookDeliveryRequest struct {
+	DeliveryId uuid.UUID `json:"delivery_id" validate:"required"`
+}
+
+// ReplayWebhookDeliveryResponse defines model for ReplayWebhookDeliveryResponse.
+type ReplayWebhookDeliveryResponse struct {
+	Delivery WebhookDelivery `json:"delivery"`
+}
+
+// ReviewStreamEvent Данные (data) события потока /users/reviewStream; id события SSE - event_id, тип - event
+type ReviewStreamEvent struct {
+	Event       ReviewStreamEventEvent `json:"event"`
+	EventId     uuid.UUID              `json:"event_id"`
+	OccurredAt  time.Time              `json:"occurred_at"`
+	PullRequest struct {
+		AuthorId        uuid.UUID `json:"author_id"`
+		PullRequestId   uuid.UUID `json:"pull_request_id"`
+		PullRequestName string    `json:"pull_request_name"`
+	} `json:"pull_request"`
+
+	// ReviewerId Ревьювер, для назначения и снятия с PR
+	ReviewerId *uuid.UUID `json:"reviewer_id,omitempty"`
+}
+
+// ReviewStreamEventEvent defines model for ReviewStreamEvent.Event.
+type ReviewStreamEventEvent string
+
+// ReviewerAssignment defines model for ReviewerAssignment.
+type ReviewerAssignment struct {
+	// Owner Владелец из правила, как он записан в файле, например @alice или @org/backend
+	Owner      *string   `json:"owner,omitempty"`
+	ReviewerId uuid.UUID `json:"reviewer_id"`
+
+	// RuleLine Номер строки правила CODEOWNERS, только для source CODEOWNERS
+	RuleLine *int `json:"rule_line,omitempty"`
+
+	// RulePattern Шаблон путей правила CODEOWNERS
+	RulePattern *string `json:"rule_pattern,omitempty"`
+
+	// Source CODEOWNERS - владелец изменённых файлов, TEAM - команда автора, PARENT_TEAM - родительская команда
+	Source ReviewerAssignmentSource `json:"source"`
+}
+
+// ReviewerAssignmentSource CODEOWNERS - владелец изменённых файлов, TEAM - команда автора, PARENT_TEAM - родительская команда
+type ReviewerAssignmentSource string
+
 // ReviewerAssignmentCount defines model for ReviewerAssignmentCount.
 type ReviewerAssignmentCount struct {
 	// AssignmentCount Количество PR, где пользователь был назначен ревьювером
@@ -132,22 +704,244 @@ type ReviewersStatsResponse struct {
 	Reviewers []ReviewerAssignmentCount `json:"reviewers"`
 }
 
+// RoutePolicy defines model for RoutePolicy.
+type RoutePolicy struct {
+	// Roles Роли (ADMIN, USER), которым разрешён вызов
+	Roles []string `json:"roles"`
+
+	// Route REST ручка в виде "METHOD /path" или полное имя gRPC метода
+	Route string `json:"route"`
+}
+
+// ScimError defines model for ScimError.
+type ScimError struct {
+	Detail   *string            `json:"detail,omitempty"`
+	Schemas  []string           `json:"schemas"`
+	ScimType *ScimErrorScimType `json:"scimType,omitempty"`
+
+	// Status HTTP статус строкой
+	Status string `json:"status"`
+}
+
+// ScimErrorScimType defines model for ScimError.ScimType.
+type ScimErrorScimType string
+
+// ScimGroup defines model for ScimGroup.
+type ScimGroup struct {
+	DisplayName string        `json:"displayName" validate:"required"`
+	Id          *uuid.UUID    `json:"id,omitempty"`
+	Members     *[]ScimMember `json:"members,omitempty"`
+	Meta        *ScimMeta     `json:"meta,omitempty"`
+	Schemas     []string      `json:"schemas"`
+}
+
+// ScimGroupListResponse defines model for ScimGroupListResponse.
+type ScimGroupListResponse struct {
+	Resources    []ScimGroup `json:"Resources"`
+	ItemsPerPage int         `json:"itemsPerPage"`
+	Schemas      []string    `json:"schemas"`
+	StartIndex   int         `json:"startIndex"`
+	TotalResults int         `json:"totalResults"`
+}
+
+// ScimGroupRef Основная или дополнительная команда пользователя
+type ScimGroupRef struct {
+	Display string           `json:"display"`
+	Type    ScimGroupRefType `json:"type"`
+	Value   uuid.UUID        `json:"value"`
+}
+
+// ScimGroupRefType defines model for ScimGroupRef.Type.
+type ScimGroupRefType string
+
+// ScimMember defines model for ScimMember.
+type ScimMember struct {
+	Display *string   `json:"display,omitempty"`
+	Value   uuid.UUID `json:"value"`
+}
+
+// ScimMeta defines model for ScimMeta.
+type ScimMeta struct {
+	Created      time.Time            `json:"created"`
+	Location     string               `json:"location"`
+	ResourceType ScimMetaResourceType `json:"resourceType"`
+}
+
+// ScimMetaResourceType defines model for ScimMeta.ResourceType.
+type ScimMetaResourceType string
+
+// ScimPatchOperation defines model for ScimPatchOperation.
+type ScimPatchOperation struct {
+	// Op add, remove или replace без учёта регистра
+	Op string `json:"op"`
+
+	// Path active, userName, externalId, displayName, members или members[value eq "<id>"]
+	Path *string `json:"path,omitempty"`
+
+	// Value Значение атрибута, без path - объект с атрибутами
+	Value interface{} `json:"value,omitempty"`
+}
+
+// ScimPatchRequest defines model for ScimPatchRequest.
+type ScimPatchRequest struct {
+	Operations []ScimPatchOperation `json:"Operations"`
+	Schemas    []string             `json:"schemas"`
+}
+
+// ScimUser defines model for ScimUser.
+type ScimUser struct {
+	// Active По умолчанию true
+	Active *bool `json:"active,omitempty"`
+
+	// ExternalId Хранится как учётная запись провайдера scim
+	ExternalId *string         `json:"externalId,omitempty"`
+	Groups     *[]ScimGroupRef `json:"groups,omitempty"`
+	Id         *uuid.UUID      `json:"id,omitempty"`
+	Meta       *ScimMeta       `json:"meta,omitempty"`
+	Schemas    []string        `json:"schemas"`
+	UserName   string          `json:"userName" validate:"required"`
+}
+
+// ScimUserListResponse defines model for ScimUserListResponse.
+type ScimUserListResponse struct {
+	Resources    []ScimUser `json:"Resources"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Schemas      []string   `json:"schemas"`
+	StartIndex   int        `json:"startIndex"`
+	TotalResults int        `json:"totalResults"`
+}
+
+// SetTeamIsArchivedRequest defines model for SetTeamIsArchivedRequest.
+type SetTeamIsArchivedRequest struct {
+	// IsArchived true - архивировать команду, false - вернуть из архива
+	IsArchived bool   `json:"is_archived"`
+	TeamName   string `json:"team_name" validate:"required"`
+}
+
+// SetTeamIsArchivedResponse defines model for SetTeamIsArchivedResponse.
+type SetTeamIsArchivedResponse struct {
+	ArchivedAt *time.Time `json:"archived_at"`
+	IsArchived bool       `json:"is_archived"`
+	TeamName   string     `json:"team_name"`
+}
+
 // SetUserActiveStatusResponse defines model for SetUserActiveStatusResponse.
 type SetUserActiveStatusResponse struct {
 	User User `json:"user"`
 }
 
+// SetUserUnavailableRequest defines model for SetUserUnavailableRequest.
+type SetUserUnavailableRequest struct {
+	// UnavailableUntil До какого момента пользователь недоступен, должен быть в будущем. Без поля пользователь снова доступен
+	UnavailableUntil *time.Time `json:"unavailable_until,omitempty"`
+	UserId           uuid.UUID  `json:"user_id" validate:"required"`
+}
+
+// SetUserUnavailableResponse defines model for SetUserUnavailableResponse.
+type SetUserUnavailableResponse struct {
+	UnavailableUntil *time.Time `json:"unavailable_until,omitempty"`
+	User             User       `json:"user"`
+}
+
+// SnoozeReviewRequest defines model for SnoozeReviewRequest.
+type SnoozeReviewRequest struct {
+	PullRequestId uuid.UUID `json:"pull_request_id" validate:"required"`
+
+	// Until До какого момента не напоминать о PR, должен быть в будущем
+	Until time.Time `json:"until" validate:"required"`
+
+	// UserId Ревьювер, откладывающий напоминания
+	UserId uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// SnoozeReviewResponse defines model for SnoozeReviewResponse.
+type SnoozeReviewResponse struct {
+	PullRequestId uuid.UUID `json:"pull_request_id"`
+	SnoozedUntil  time.Time `json:"snoozed_until"`
+	UserId        uuid.UUID `json:"user_id"`
+}
+
+// SubscribeWebhookRequest defines model for SubscribeWebhookRequest.
+type SubscribeWebhookRequest struct {
+	Events []SubscribeWebhookRequestEvents `json:"events" validate:"required,min=1"`
+
+	// Secret Секрет для подписи тела события (HMAC-SHA256, заголовок X-Reviewers-Signature-256)
+	Secret string `json:"secret" validate:"required,min=16,max=255"`
+
+	// Url Адрес, на который отправляются события
+	Url string `json:"url" validate:"required,url"`
+}
+
+// SubscribeWebhookRequestEvents defines model for SubscribeWebhookRequest.Events.
+type SubscribeWebhookRequestEvents string
+
+// SubscribeWebhookResponse defines model for SubscribeWebhookResponse.
+type SubscribeWebhookResponse struct {
+	Subscription WebhookSubscription `json:"subscription"`
+}
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members" validate:"required,dive"`
-	TeamName string       `json:"team_name" validate:"required"`
+	Members []TeamMember `json:"members" validate:"required,dive"`
+
+	// ParentTeamName Имя родительской команды, в которой ищутся ревьюверы, если в команде автора не хватает кандидатов. В /team/add null или пустая строка делают команду корневой, без поля родитель не меняется
+	ParentTeamName *string `json:"parent_team_name,omitempty"`
+	TeamName       string  `json:"team_name" validate:"required"`
+}
+
+// TeamListItem defines model for TeamListItem.
+type TeamListItem struct {
+	ActiveMembersCount int `json:"active_members_count"`
+
+	// AvgOpenReviewsPerActiveMember Среднее число открытых ревью на активного участника
+	AvgOpenReviewsPerActiveMember float64 `json:"avg_open_reviews_per_active_member"`
+
+	// MembersCount Участники команды, включая дополнительных
+	MembersCount int `json:"members_count"`
+
+	// OpenPullRequestsCount Открытые PR авторов, для которых команда основная
+	OpenPullRequestsCount int    `json:"open_pull_requests_count"`
+	TeamName              string `json:"team_name"`
 }
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool      `json:"is_active"`
-	UserId   uuid.UUID `json:"user_id" validate:"required"`
-	Username string    `json:"username" validate:"required"`
+	// Identities Учётные записи пользователя во внешних системах, уже привязанные не удаляются
+	Identities *[]UserIdentity `json:"identities,omitempty" validate:"omitempty,dive"`
+	IsActive   bool            `json:"is_active"`
+	UserId     uuid.UUID       `json:"user_id" validate:"required"`
+	Username   string          `json:"username" validate:"required"`
+}
+
+// TeamTreeNode defines model for TeamTreeNode.
+type TeamTreeNode struct {
+	Subteams []TeamTreeNode `json:"subteams"`
+	TeamName string         `json:"team_name"`
+}
+
+// TeamTreeResponse defines model for TeamTreeResponse.
+type TeamTreeResponse struct {
+	// Teams Корневые команды со всеми вложенными подкомандами
+	Teams []TeamTreeNode `json:"teams"`
+}
+
+// UnsubscribeWebhookRequest defines model for UnsubscribeWebhookRequest.
+type UnsubscribeWebhookRequest struct {
+	SubscriptionId uuid.UUID `json:"subscription_id" validate:"required"`
+}
+
+// UnsubscribeWebhookResponse defines model for UnsubscribeWebhookResponse.
+type UnsubscribeWebhookResponse struct {
+	SubscriptionId uuid.UUID `json:"subscription_id"`
+}
+
+// UploadCodeOwnersRequest defines model for UploadCodeOwnersRequest.
+type UploadCodeOwnersRequest struct {
+	// Content Содержимое файла CODEOWNERS
+	Content string `json:"content" validate:"required"`
+
+	// Repository Репозиторий, например org/service
+	Repository string `json:"repository" validate:"required"`
 }
 
 // User defines model for User.
@@ -158,17 +952,133 @@ type User struct {
 	Username string    `json:"username" validate:"required"`
 }
 
+// UserIdentitiesResponse defines model for UserIdentitiesResponse.
+type UserIdentitiesResponse struct {
+	Identities []UserIdentity `json:"identities"`
+	UserId     uuid.UUID      `json:"user_id"`
+}
+
+// UserIdentity defines model for UserIdentity.
+type UserIdentity struct {
+	// ExternalId Логин или email пользователя во внешней системе
+	ExternalId string `json:"external_id" validate:"required"`
+
+	// Provider Внешняя система, одна из github, gitlab, email
+	Provider string `json:"provider" validate:"required"`
+}
+
+// UserTeamMembership defines model for UserTeamMembership.
+type UserTeamMembership struct {
+	IsPrimary bool   `json:"is_primary"`
+	TeamName  string `json:"team_name"`
+}
+
+// UserWorkload defines model for UserWorkload.
+type UserWorkload struct {
+	// AuthoredOpenPullRequests Количество открытых PR пользователя
+	AuthoredOpenPullRequests int `json:"authored_open_pull_requests"`
+
+	// CompletedReviewsLast30Days Количество PR с ревью пользователя, смёрженных за последние 30 дней
+	CompletedReviewsLast30Days int `json:"completed_reviews_last_30_days"`
+
+	// OpenReviews Количество открытых PR, на которые пользователь назначен ревьюером
+	OpenReviews int `json:"open_reviews"`
+}
+
+// WebhookDeliveriesResponse defines model for WebhookDeliveriesResponse.
+type WebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+	Limit      int               `json:"limit"`
+	Offset     int               `json:"offset"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts  int       `json:"attempts"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// DeliveryId Передаётся подписчику в заголовке X-Reviewers-Delivery
+	DeliveryId uuid.UUID `json:"delivery_id"`
+	Event      string    `json:"event"`
+	EventId    uuid.UUID `json:"event_id"`
+
+	// LastError Ошибка последней неудачной попытки
+	LastError     *string   `json:"last_error,omitempty"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+
+	// ResponseCode HTTP код последнего ответа подписчика
+	ResponseCode   *int                  `json:"response_code,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	SubscriptionId uuid.UUID             `json:"subscription_id"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse struct {
+	// PullRequestId Идентификатор PR, выведенный из его ссылки
+	PullRequestId *uuid.UUID `json:"pull_request_id,omitempty"`
+
+	// Reason Почему событие не обработано
+	Reason *string `json:"reason,omitempty"`
+
+	// Result processed, duplicate или ignored
+	Result string `json:"result"`
+}
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt      time.Time `json:"created_at"`
+	Events         []string  `json:"events"`
+	SubscriptionId uuid.UUID `json:"subscription_id"`
+	Url            string    `json:"url"`
+}
+
+// WebhookSubscriptionsResponse defines model for WebhookSubscriptionsResponse.
+type WebhookSubscriptionsResponse struct {
+	Subscriptions []WebhookSubscription `json:"subscriptions"`
+}
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = uuid.UUID
 
+// GetCodeOwnersGetParams defines parameters for GetCodeOwnersGet.
+type GetCodeOwnersGetParams struct {
+	// Repository Репозиторий, например org/service
+	Repository string `form:"repository" json:"repository"`
+}
+
+// PostIntegrationsGithubWebhookParams defines parameters for PostIntegrationsGithubWebhook.
+type PostIntegrationsGithubWebhookParams struct {
+	XGitHubEvent     string `json:"X-GitHub-Event"`
+	XGitHubDelivery  string `json:"X-GitHub-Delivery"`
+	XHubSignature256 string `json:"X-Hub-Signature-256"`
+}
+
+// PostIntegrationsGitlabWebhookParams defines parameters for PostIntegrationsGitlabWebhook.
+type PostIntegrationsGitlabWebhookParams struct {
+	XGitlabEvent     string `json:"X-Gitlab-Event"`
+	XGitlabEventUUID string `json:"X-Gitlab-Event-UUID"`
+	XGitlabToken     string `json:"X-Gitlab-Token"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        uuid.UUID `json:"author_id" validate:"required"`
+	// AuthorId UUID автора или его внешняя учётная запись в виде `provider:external_id`, например `github:alice`
+	AuthorId string `json:"author_id" validate:"required"`
+
+	// ChangedFiles Изменённые файлы относительно корня репозитория, их владельцы становятся обязательными ревьюверами
+	ChangedFiles    *[]string `json:"changed_files,omitempty" validate:"omitempty,dive,required"`
 	PullRequestId   uuid.UUID `json:"pull_request_id" validate:"required"`
 	PullRequestName string    `json:"pull_request_name" validate:"required"`
+
+	// Repository Репозиторий PR, по нему выбирается загруженный файл CODEOWNERS
+	Repository *string `json:"repository,omitempty" validate:"required_with=ChangedFiles"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
@@ -182,24 +1092,133 @@ type PostPullRequestReassignJSONBody struct {
 	PullRequestId uuid.UUID `json:"pull_request_id" validate:"required"`
 }
 
+// GetScimV2GroupsParams defines parameters for GetScimV2Groups.
+type GetScimV2GroupsParams struct {
+	// Filter Только displayName eq "<значение>"
+	Filter     *string `form:"filter,omitempty" json:"filter,omitempty"`
+	StartIndex *int    `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+	Count      *int    `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// GetScimV2UsersParams defines parameters for GetScimV2Users.
+type GetScimV2UsersParams struct {
+	// Filter Только userName или externalId eq "<значение>"
+	Filter     *string `form:"filter,omitempty" json:"filter,omitempty"`
+	StartIndex *int    `form:"startIndex,omitempty" json:"startIndex,omitempty"`
+	Count      *int    `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// GetStatisticsReviewersParams defines parameters for GetStatisticsReviewers.
+type GetStatisticsReviewersParams struct {
+	// TeamName Учитывать только ревьюверов этой команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// IncludeSubteams Вместе с team_name учитывать ревьюверов всех подкоманд
+	IncludeSubteams *bool `form:"include_subteams,omitempty" json:"include_subteams,omitempty"`
+}
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// GetTeamListParams defines parameters for GetTeamList.
+type GetTeamListParams struct {
+	// NamePrefix Префикс названия команды (без учёта регистра)
+	NamePrefix *string `form:"name_prefix,omitempty" json:"name_prefix,omitempty"`
+	Limit      *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset     *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetTeamTreeParams defines parameters for GetTeamTree.
+type GetTeamTreeParams struct {
+	// TeamName Вернуть только поддерево этой команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// PostUsersAddIdentityJSONBody defines parameters for PostUsersAddIdentity.
+type PostUsersAddIdentityJSONBody struct {
+	ExternalId string    `json:"external_id" validate:"required"`
+	Provider   string    `json:"provider" validate:"required"`
+	UserId     uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// PostUsersDeleteIdentityJSONBody defines parameters for PostUsersDeleteIdentity.
+type PostUsersDeleteIdentityJSONBody struct {
+	ExternalId string    `json:"external_id" validate:"required"`
+	Provider   string    `json:"provider" validate:"required"`
+	UserId     uuid.UUID `json:"user_id" validate:"required"`
+}
+
+// GetUsersFindByIdentityParams defines parameters for GetUsersFindByIdentity.
+type GetUsersFindByIdentityParams struct {
+	// Provider Внешняя система, одна из github, gitlab, email
+	Provider string `form:"provider" json:"provider"`
+
+	// ExternalId Логин или email пользователя во внешней системе
+	ExternalId string `form:"external_id" json:"external_id"`
+}
+
+// GetUsersGetParams defines parameters for GetUsersGet.
+type GetUsersGetParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetUsersGetIdentitiesParams defines parameters for GetUsersGetIdentities.
+type GetUsersGetIdentitiesParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// GetUsersReviewStreamParams defines parameters for GetUsersReviewStream.
+type GetUsersReviewStreamParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// LastEventID id последнего полученного события
+	LastEventID *uuid.UUID `json:"Last-Event-ID,omitempty"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
 	IsActive bool      `json:"is_active"`
 	UserId   uuid.UUID `json:"user_id" validate:"required"`
 }
 
+// GetWebhooksDeliveriesParams defines parameters for GetWebhooksDeliveries.
+type GetWebhooksDeliveriesParams struct {
+	SubscriptionId *uuid.UUID                         `form:"subscription_id,omitempty" json:"subscription_id,omitempty"`
+	Status         *GetWebhooksDeliveriesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit          *int                               `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset         *int                               `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetWebhooksDeliveriesParamsStatus defines parameters for GetWebhooksDeliveries.
+type GetWebhooksDeliveriesParamsStatus string
+
+// PostAdminTeamsApplyJSONRequestBody defines body for PostAdminTeamsApply for application/json ContentType.
+type PostAdminTeamsApplyJSONRequestBody = ApplyTeamsRequest
+
+// PostCodeOwnersUploadJSONRequestBody defines body for PostCodeOwnersUpload for application/json ContentType.
+type PostCodeOwnersUploadJSONRequestBody = UploadCodeOwnersRequest
+
+// PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
+type PostDummyLoginJSONRequestBody = DummyLoginRequest
+
+// PostIntegrationsGithubWebhookJSONRequestBody defines body for PostIntegrationsGithubWebhook for application/json ContentType.
+type PostIntegrationsGithubWebhookJSONRequestBody = GithubPullRequestEvent
+
+// PostIntegrationsGitlabWebhookJSONRequestBody defines body for PostIntegrationsGitlabWebhook for application/json ContentType.
+type PostIntegrationsGitlabWebhookJSONRequestBody = GitlabMergeRequestEvent
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
@@ -209,11 +1228,65 @@ type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostScimV2GroupsApplicationScimPlusJSONRequestBody defines body for PostScimV2Groups for application/scim+json ContentType.
+type PostScimV2GroupsApplicationScimPlusJSONRequestBody = ScimGroup
+
+// PatchScimV2GroupsIdApplicationScimPlusJSONRequestBody defines body for PatchScimV2GroupsId for application/scim+json ContentType.
+type PatchScimV2GroupsIdApplicationScimPlusJSONRequestBody = ScimPatchRequest
+
+// PostScimV2UsersApplicationScimPlusJSONRequestBody defines body for PostScimV2Users for application/scim+json ContentType.
+type PostScimV2UsersApplicationScimPlusJSONRequestBody = ScimUser
+
+// PatchScimV2UsersIdApplicationScimPlusJSONRequestBody defines body for PatchScimV2UsersId for application/scim+json ContentType.
+type PatchScimV2UsersIdApplicationScimPlusJSONRequestBody = ScimPatchRequest
+
+// PatchTeamActivateUsersJSONRequestBody defines body for PatchTeamActivateUsers for application/json ContentType.
+type PatchTeamActivateUsersJSONRequestBody = ActivateTeamUsersRequest
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
 // PatchTeamDeactivateUsersJSONRequestBody defines body for PatchTeamDeactivateUsers for application/json ContentType.
 type PatchTeamDeactivateUsersJSONRequestBody = DeactivateTeamUsersRequest
 
+// PostTeamDeleteJSONRequestBody defines body for PostTeamDelete for application/json ContentType.
+type PostTeamDeleteJSONRequestBody = DeleteTeamRequest
+
+// PostTeamRebalanceJSONRequestBody defines body for PostTeamRebalance for application/json ContentType.
+type PostTeamRebalanceJSONRequestBody = RebalanceTeamRequest
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody = RenameTeamRequest
+
+// PostTeamSetIsArchivedJSONRequestBody defines body for PostTeamSetIsArchived for application/json ContentType.
+type PostTeamSetIsArchivedJSONRequestBody = SetTeamIsArchivedRequest
+
+// PostUsersAddIdentityJSONRequestBody defines body for PostUsersAddIdentity for application/json ContentType.
+type PostUsersAddIdentityJSONRequestBody PostUsersAddIdentityJSONBody
+
+// PostUsersDeleteIdentityJSONRequestBody defines body for PostUsersDeleteIdentity for application/json ContentType.
+type PostUsersDeleteIdentityJSONRequestBody PostUsersDeleteIdentityJSONBody
+
+// PostUsersHandoverReviewsJSONRequestBody defines body for PostUsersHandoverReviews for application/json ContentType.
+type PostUsersHandoverReviewsJSONRequestBody = HandoverReviewsRequest
+
+// PostUsersMoveTeamJSONRequestBody defines body for PostUsersMoveTeam for application/json ContentType.
+type PostUsersMoveTeamJSONRequestBody = MoveUserTeamRequest
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSetUnavailableJSONRequestBody defines body for PostUsersSetUnavailable for application/json ContentType.
+type PostUsersSetUnavailableJSONRequestBody = SetUserUnavailableRequest
+
+// PostUsersSnoozeReviewJSONRequestBody defines body for PostUsersSnoozeReview for application/json ContentType.
+type PostUsersSnoozeReviewJSONRequestBody = SnoozeReviewRequest
+
+// PostWebhooksReplayDeliveryJSONRequestBody defines body for PostWebhooksReplayDelivery for application/json ContentType.
+type PostWebhooksReplayDeliveryJSONRequestBody = ReplayWebhookDeliveryRequest
+
+// PostWebhooksSubscribeJSONRequestBody defines body for PostWebhooksSubscribe for application/json ContentType.
+type PostWebhooksSubscribeJSONRequestBody = SubscribeWebhookRequest
+
+// PostWebhooksUnsubscribeJSONRequestBody defines body for PostWebhooksUnsubscribe for application/json ContentType.
+type PostWebhooksUnsubscribeJSONRequestBody = UnsubscribeWebhookRequest
@@ -0,0 +1,1939 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: pr_reviewers/v1/pr_reviewers.proto
+
+package pr_reviewers_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Identity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Identity) Reset() {
+	*x = Identity{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Identity) ProtoMessage() {}
+
+func (x *Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Identity.ProtoReflect.Descriptor instead.
+func (*Identity) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Identity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Identity) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Identities    []*Identity            `protobuf:"bytes,4,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TeamMember) GetIdentities() []*Identity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type Team struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TeamName       string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ParentTeamName string                 `protobuf:"bytes,2,opt,name=parent_team_name,json=parentTeamName,proto3" json:"parent_team_name,omitempty"`
+	Members        []*TeamMember          `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetParentTeamName() string {
+	if x != nil {
+		return x.ParentTeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type AddTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamResponse) Reset() {
+	*x = AddTeamResponse{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamResponse) ProtoMessage() {}
+
+func (x *AddTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamResponse) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamResponse) Reset() {
+	*x = GetTeamResponse{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamResponse) ProtoMessage() {}
+
+func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type ListTeamsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	NamePrefix string                 `protobuf:"bytes,1,opt,name=name_prefix,json=namePrefix,proto3" json:"name_prefix,omitempty"`
+	// limit is 1-100, 0 means 20.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTeamsRequest) GetNamePrefix() string {
+	if x != nil {
+		return x.NamePrefix
+	}
+	return ""
+}
+
+func (x *ListTeamsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTeamsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type TeamSummary struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName                      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	MembersCount                  int32                  `protobuf:"varint,2,opt,name=members_count,json=membersCount,proto3" json:"members_count,omitempty"`
+	ActiveMembersCount            int32                  `protobuf:"varint,3,opt,name=active_members_count,json=activeMembersCount,proto3" json:"active_members_count,omitempty"`
+	OpenPullRequestsCount         int32                  `protobuf:"varint,4,opt,name=open_pull_requests_count,json=openPullRequestsCount,proto3" json:"open_pull_requests_count,omitempty"`
+	AvgOpenReviewsPerActiveMember float64                `protobuf:"fixed64,5,opt,name=avg_open_reviews_per_active_member,json=avgOpenReviewsPerActiveMember,proto3" json:"avg_open_reviews_per_active_member,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *TeamSummary) Reset() {
+	*x = TeamSummary{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSummary) ProtoMessage() {}
+
+func (x *TeamSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSummary.ProtoReflect.Descriptor instead.
+func (*TeamSummary) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TeamSummary) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamSummary) GetMembersCount() int32 {
+	if x != nil {
+		return x.MembersCount
+	}
+	return 0
+}
+
+func (x *TeamSummary) GetActiveMembersCount() int32 {
+	if x != nil {
+		return x.ActiveMembersCount
+	}
+	return 0
+}
+
+func (x *TeamSummary) GetOpenPullRequestsCount() int32 {
+	if x != nil {
+		return x.OpenPullRequestsCount
+	}
+	return 0
+}
+
+func (x *TeamSummary) GetAvgOpenReviewsPerActiveMember() float64 {
+	if x != nil {
+		return x.AvgOpenReviewsPerActiveMember
+	}
+	return 0
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamSummary         `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*TeamSummary {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *ListTeamsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTeamsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListTeamsResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type SetUserIsActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserIsActiveRequest) Reset() {
+	*x = SetUserIsActiveRequest{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserIsActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserIsActiveRequest) ProtoMessage() {}
+
+func (x *SetUserIsActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserIsActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetUserIsActiveRequest) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetUserIsActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserIsActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type SetUserIsActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserIsActiveResponse) Reset() {
+	*x = SetUserIsActiveResponse{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserIsActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserIsActiveResponse) ProtoMessage() {}
+
+func (x *SetUserIsActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserIsActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetUserIsActiveResponse) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetUserIsActiveResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type TeamMembership struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,2,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMembership) Reset() {
+	*x = TeamMembership{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMembership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMembership) ProtoMessage() {}
+
+func (x *TeamMembership) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMembership.ProtoReflect.Descriptor instead.
+func (*TeamMembership) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TeamMembership) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamMembership) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type Workload struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	OpenReviews              int32                  `protobuf:"varint,1,opt,name=open_reviews,json=openReviews,proto3" json:"open_reviews,omitempty"`
+	AuthoredOpenPullRequests int32                  `protobuf:"varint,2,opt,name=authored_open_pull_requests,json=authoredOpenPullRequests,proto3" json:"authored_open_pull_requests,omitempty"`
+	CompletedReviews         int32                  `protobuf:"varint,3,opt,name=completed_reviews,json=completedReviews,proto3" json:"completed_reviews,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Workload) Reset() {
+	*x = Workload{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Workload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workload) ProtoMessage() {}
+
+func (x *Workload) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workload.ProtoReflect.Descriptor instead.
+func (*Workload) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Workload) GetOpenReviews() int32 {
+	if x != nil {
+		return x.OpenReviews
+	}
+	return 0
+}
+
+func (x *Workload) GetAuthoredOpenPullRequests() int32 {
+	if x != nil {
+		return x.AuthoredOpenPullRequests
+	}
+	return 0
+}
+
+func (x *Workload) GetCompletedReviews() int32 {
+	if x != nil {
+		return x.CompletedReviews
+	}
+	return 0
+}
+
+type GetUserResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	User     *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Teams    []*TeamMembership      `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	Workload *Workload              `protobuf:"bytes,3,opt,name=workload,proto3" json:"workload,omitempty"`
+	// is_available is false for inactive users and while the unavailability lasts.
+	IsAvailable bool `protobuf:"varint,4,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	// unavailable_until is set while the user is unavailable.
+	UnavailableUntil *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=unavailable_until,json=unavailableUntil,proto3" json:"unavailable_until,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetTeams() []*TeamMembership {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetWorkload() *Workload {
+	if x != nil {
+		return x.Workload
+	}
+	return nil
+}
+
+func (x *GetUserResponse) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *GetUserResponse) GetUnavailableUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnavailableUntil
+	}
+	return nil
+}
+
+type GetUserReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsRequest) Reset() {
+	*x = GetUserReviewsRequest{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsRequest) ProtoMessage() {}
+
+func (x *GetUserReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetUserReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsResponse) Reset() {
+	*x = GetUserReviewsResponse{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsResponse) ProtoMessage() {}
+
+func (x *GetUserReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserReviewsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type PullRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId     string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName   string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// merged_at is set for merged pull requests only.
+	MergedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	// The author is author_id or, when it is set, the user linked to author_identity.
+	AuthorId       string    `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorIdentity *Identity `protobuf:"bytes,4,opt,name=author_identity,json=authorIdentity,proto3" json:"author_identity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorIdentity() *Identity {
+	if x != nil {
+		return x.AuthorIdentity
+	}
+	return nil
+}
+
+type CreatePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestResponse) Reset() {
+	*x = CreatePullRequestResponse{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestResponse) ProtoMessage() {}
+
+func (x *CreatePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreatePullRequestResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type MergePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestResponse) Reset() {
+	*x = MergePullRequestResponse{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestResponse) ProtoMessage() {}
+
+func (x *MergePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*MergePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MergePullRequestResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+type ReassignPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignPullRequestRequest) Reset() {
+	*x = ReassignPullRequestRequest{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignPullRequestRequest) ProtoMessage() {}
+
+func (x *ReassignPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*ReassignPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReassignPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignPullRequestRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+type ReassignPullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignPullRequestResponse) Reset() {
+	*x = ReassignPullRequestResponse{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignPullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignPullRequestResponse) ProtoMessage() {}
+
+func (x *ReassignPullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignPullRequestResponse.ProtoReflect.Descriptor instead.
+func (*ReassignPullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReassignPullRequestResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *ReassignPullRequestResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type GetReviewerStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Empty team_name returns the stats of every reviewer.
+	TeamName        string `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IncludeSubteams bool   `protobuf:"varint,2,opt,name=include_subteams,json=includeSubteams,proto3" json:"include_subteams,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetReviewerStatsRequest) Reset() {
+	*x = GetReviewerStatsRequest{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerStatsRequest) ProtoMessage() {}
+
+func (x *GetReviewerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetReviewerStatsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetReviewerStatsRequest) GetIncludeSubteams() bool {
+	if x != nil {
+		return x.IncludeSubteams
+	}
+	return false
+}
+
+type ReviewerStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReviewerId      string                 `protobuf:"bytes,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	AssignmentCount int32                  `protobuf:"varint,2,opt,name=assignment_count,json=assignmentCount,proto3" json:"assignment_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReviewerStats) Reset() {
+	*x = ReviewerStats{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerStats) ProtoMessage() {}
+
+func (x *ReviewerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerStats.ProtoReflect.Descriptor instead.
+func (*ReviewerStats) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReviewerStats) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewerStats) GetAssignmentCount() int32 {
+	if x != nil {
+		return x.AssignmentCount
+	}
+	return 0
+}
+
+type GetReviewerStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviewers     []*ReviewerStats       `protobuf:"bytes,1,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewerStatsResponse) Reset() {
+	*x = GetReviewerStatsResponse{}
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewerStatsResponse) ProtoMessage() {}
+
+func (x *GetReviewerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_reviewers_v1_pr_reviewers_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetReviewerStatsResponse) GetReviewers() []*ReviewerStats {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+var File_pr_reviewers_v1_pr_reviewers_proto protoreflect.FileDescriptor
+
+const file_pr_reviewers_v1_pr_reviewers_proto_rawDesc = "" +
+	"\n" +
+	"\"pr_reviewers/v1/pr_reviewers.proto\x12\x0fpr_reviewers.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"G\n" +
+	"\bIdentity\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\"\x99\x01\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"identities\x18\x04 \x03(\v2\x19.pr_reviewers.v1.IdentityR\n" +
+	"identities\"\x84\x01\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12(\n" +
+	"\x10parent_team_name\x18\x02 \x01(\tR\x0eparentTeamName\x125\n" +
+	"\amembers\x18\x03 \x03(\v2\x1b.pr_reviewers.v1.TeamMemberR\amembers\";\n" +
+	"\x0eAddTeamRequest\x12)\n" +
+	"\x04team\x18\x01 \x01(\v2\x15.pr_reviewers.v1.TeamR\x04team\"<\n" +
+	"\x0fAddTeamResponse\x12)\n" +
+	"\x04team\x18\x01 \x01(\v2\x15.pr_reviewers.v1.TeamR\x04team\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"<\n" +
+	"\x0fGetTeamResponse\x12)\n" +
+	"\x04team\x18\x01 \x01(\v2\x15.pr_reviewers.v1.TeamR\x04team\"a\n" +
+	"\x10ListTeamsRequest\x12\x1f\n" +
+	"\vname_prefix\x18\x01 \x01(\tR\n" +
+	"namePrefix\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\x85\x02\n" +
+	"\vTeamSummary\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12#\n" +
+	"\rmembers_count\x18\x02 \x01(\x05R\fmembersCount\x120\n" +
+	"\x14active_members_count\x18\x03 \x01(\x05R\x12activeMembersCount\x127\n" +
+	"\x18open_pull_requests_count\x18\x04 \x01(\x05R\x15openPullRequestsCount\x12I\n" +
+	"\"avg_open_reviews_per_active_member\x18\x05 \x01(\x01R\x1davgOpenReviewsPerActiveMember\"\x8b\x01\n" +
+	"\x11ListTeamsResponse\x122\n" +
+	"\x05teams\x18\x01 \x03(\v2\x1c.pr_reviewers.v1.TeamSummaryR\x05teams\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"u\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\"N\n" +
+	"\x16SetUserIsActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\"D\n" +
+	"\x17SetUserIsActiveResponse\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.pr_reviewers.v1.UserR\x04user\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"L\n" +
+	"\x0eTeamMembership\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x02 \x01(\bR\tisPrimary\"\x99\x01\n" +
+	"\bWorkload\x12!\n" +
+	"\fopen_reviews\x18\x01 \x01(\x05R\vopenReviews\x12=\n" +
+	"\x1bauthored_open_pull_requests\x18\x02 \x01(\x05R\x18authoredOpenPullRequests\x12+\n" +
+	"\x11completed_reviews\x18\x03 \x01(\x05R\x10completedReviews\"\x96\x02\n" +
+	"\x0fGetUserResponse\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.pr_reviewers.v1.UserR\x04user\x125\n" +
+	"\x05teams\x18\x02 \x03(\v2\x1f.pr_reviewers.v1.TeamMembershipR\x05teams\x125\n" +
+	"\bworkload\x18\x03 \x01(\v2\x19.pr_reviewers.v1.WorkloadR\bworkload\x12!\n" +
+	"\fis_available\x18\x04 \x01(\bR\visAvailable\x12G\n" +
+	"\x11unavailable_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x10unavailableUntil\"0\n" +
+	"\x15GetUserReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x9b\x01\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"y\n" +
+	"\x16GetUserReviewsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12F\n" +
+	"\rpull_requests\x18\x02 \x03(\v2!.pr_reviewers.v1.PullRequestShortR\fpullRequests\"\xb9\x02\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"\xcf\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12B\n" +
+	"\x0fauthor_identity\x18\x04 \x01(\v2\x19.pr_reviewers.v1.IdentityR\x0eauthorIdentity\"\\\n" +
+	"\x19CreatePullRequestResponse\x12?\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1c.pr_reviewers.v1.PullRequestR\vpullRequest\"A\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"[\n" +
+	"\x18MergePullRequestResponse\x12?\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1c.pr_reviewers.v1.PullRequestR\vpullRequest\"d\n" +
+	"\x1aReassignPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\"\x7f\n" +
+	"\x1bReassignPullRequestResponse\x12?\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x1c.pr_reviewers.v1.PullRequestR\vpullRequest\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"a\n" +
+	"\x17GetReviewerStatsRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12)\n" +
+	"\x10include_subteams\x18\x02 \x01(\bR\x0fincludeSubteams\"[\n" +
+	"\rReviewerStats\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\tR\n" +
+	"reviewerId\x12)\n" +
+	"\x10assignment_count\x18\x02 \x01(\x05R\x0fassignmentCount\"X\n" +
+	"\x18GetReviewerStatsResponse\x12<\n" +
+	"\treviewers\x18\x01 \x03(\v2\x1e.pr_reviewers.v1.ReviewerStatsR\treviewers2\xcb\a\n" +
+	"\x12PRReviewersService\x12L\n" +
+	"\aAddTeam\x12\x1f.pr_reviewers.v1.AddTeamRequest\x1a .pr_reviewers.v1.AddTeamResponse\x12L\n" +
+	"\aGetTeam\x12\x1f.pr_reviewers.v1.GetTeamRequest\x1a .pr_reviewers.v1.GetTeamResponse\x12R\n" +
+	"\tListTeams\x12!.pr_reviewers.v1.ListTeamsRequest\x1a\".pr_reviewers.v1.ListTeamsResponse\x12d\n" +
+	"\x0fSetUserIsActive\x12'.pr_reviewers.v1.SetUserIsActiveRequest\x1a(.pr_reviewers.v1.SetUserIsActiveResponse\x12L\n" +
+	"\aGetUser\x12\x1f.pr_reviewers.v1.GetUserRequest\x1a .pr_reviewers.v1.GetUserResponse\x12a\n" +
+	"\x0eGetUserReviews\x12&.pr_reviewers.v1.GetUserReviewsRequest\x1a'.pr_reviewers.v1.GetUserReviewsResponse\x12j\n" +
+	"\x11CreatePullRequest\x12).pr_reviewers.v1.CreatePullRequestRequest\x1a*.pr_reviewers.v1.CreatePullRequestResponse\x12g\n" +
+	"\x10MergePullRequest\x12(.pr_reviewers.v1.MergePullRequestRequest\x1a).pr_reviewers.v1.MergePullRequestResponse\x12p\n" +
+	"\x13ReassignPullRequest\x12+.pr_reviewers.v1.ReassignPullRequestRequest\x1a,.pr_reviewers.v1.ReassignPullRequestResponse\x12g\n" +
+	"\x10GetReviewerStats\x12(.pr_reviewers.v1.GetReviewerStatsRequest\x1a).pr_reviewers.v1.GetReviewerStatsResponseBOZMpr-reviewers-service/internal/generated/proto/pr_reviewers/v1;pr_reviewers_v1b\x06proto3"
+
+var (
+	file_pr_reviewers_v1_pr_reviewers_proto_rawDescOnce sync.Once
+	file_pr_reviewers_v1_pr_reviewers_proto_rawDescData []byte
+)
+
+func file_pr_reviewers_v1_pr_reviewers_proto_rawDescGZIP() []byte {
+	file_pr_reviewers_v1_pr_reviewers_proto_rawDescOnce.Do(func() {
+		file_pr_reviewers_v1_pr_reviewers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pr_reviewers_v1_pr_reviewers_proto_rawDesc), len(file_pr_reviewers_v1_pr_reviewers_proto_rawDesc)))
+	})
+	return file_pr_reviewers_v1_pr_reviewers_proto_rawDescData
+}
+
+var file_pr_reviewers_v1_pr_reviewers_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_pr_reviewers_v1_pr_reviewers_proto_goTypes = []any{
+	(*Identity)(nil),                    // 0: pr_reviewers.v1.Identity
+	(*TeamMember)(nil),                  // 1: pr_reviewers.v1.TeamMember
+	(*Team)(nil),                        // 2: pr_reviewers.v1.Team
+	(*AddTeamRequest)(nil),              // 3: pr_reviewers.v1.AddTeamRequest
+	(*AddTeamResponse)(nil),             // 4: pr_reviewers.v1.AddTeamResponse
+	(*GetTeamRequest)(nil),              // 5: pr_reviewers.v1.GetTeamRequest
+	(*GetTeamResponse)(nil),             // 6: pr_reviewers.v1.GetTeamResponse
+	(*ListTeamsRequest)(nil),            // 7: pr_reviewers.v1.ListTeamsRequest
+	(*TeamSummary)(nil),                 // 8: pr_reviewers.v1.TeamSummary
+	(*ListTeamsResponse)(nil),           // 9: pr_reviewers.v1.ListTeamsResponse
+	(*User)(nil),                        // 10: pr_reviewers.v1.User
+	(*SetUserIsActiveRequest)(nil),      // 11: pr_reviewers.v1.SetUserIsActiveRequest
+	(*SetUserIsActiveResponse)(nil),     // 12: pr_reviewers.v1.SetUserIsActiveResponse
+	(*GetUserRequest)(nil),              // 13: pr_reviewers.v1.GetUserRequest
+	(*TeamMembership)(nil),              // 14: pr_reviewers.v1.TeamMembership
+	(*Workload)(nil),                    // 15: pr_reviewers.v1.Workload
+	(*GetUserResponse)(nil),             // 16: pr_reviewers.v1.GetUserResponse
+	(*GetUserReviewsRequest)(nil),       // 17: pr_reviewers.v1.GetUserReviewsRequest
+	(*PullRequestShort)(nil),            // 18: pr_reviewers.v1.PullRequestShort
+	(*GetUserReviewsResponse)(nil),      // 19: pr_reviewers.v1.GetUserReviewsResponse
+	(*PullRequest)(nil),                 // 20: pr_reviewers.v1.PullRequest
+	(*CreatePullRequestRequest)(nil),    // 21: pr_reviewers.v1.CreatePullRequestRequest
+	(*CreatePullRequestResponse)(nil),   // 22: pr_reviewers.v1.CreatePullRequestResponse
+	(*MergePullRequestRequest)(nil),     // 23: pr_reviewers.v1.MergePullRequestRequest
+	(*MergePullRequestResponse)(nil),    // 24: pr_reviewers.v1.MergePullRequestResponse
+	(*ReassignPullRequestRequest)(nil),  // 25: pr_reviewers.v1.ReassignPullRequestRequest
+	(*ReassignPullRequestResponse)(nil), // 26: pr_reviewers.v1.ReassignPullRequestResponse
+	(*GetReviewerStatsRequest)(nil),     // 27: pr_reviewers.v1.GetReviewerStatsRequest
+	(*ReviewerStats)(nil),               // 28: pr_reviewers.v1.ReviewerStats
+	(*GetReviewerStatsResponse)(nil),    // 29: pr_reviewers.v1.GetReviewerStatsResponse
+	(*timestamppb.Timestamp)(nil),       // 30: google.protobuf.Timestamp
+}
+var file_pr_reviewers_v1_pr_reviewers_proto_depIdxs = []int32{
+	0,  // 0: pr_reviewers.v1.TeamMember.identities:type_name -> pr_reviewers.v1.Identity
+	1,  // 1: pr_reviewers.v1.Team.members:type_name -> pr_reviewers.v1.TeamMember
+	2,  // 2: pr_reviewers.v1.AddTeamRequest.team:type_name -> pr_reviewers.v1.Team
+	2,  // 3: pr_reviewers.v1.AddTeamResponse.team:type_name -> pr_reviewers.v1.Team
+	2,  // 4: pr_reviewers.v1.GetTeamResponse.team:type_name -> pr_reviewers.v1.Team
+	8,  // 5: pr_reviewers.v1.ListTeamsResponse.teams:type_name -> pr_reviewers.v1.TeamSummary
+	10, // 6: pr_reviewers.v1.SetUserIsActiveResponse.user:type_name -> pr_reviewers.v1.User
+	10, // 7: pr_reviewers.v1.GetUserResponse.user:type_name -> pr_reviewers.v1.User
+	14, // 8: pr_reviewers.v1.GetUserResponse.teams:type_name -> pr_reviewers.v1.TeamMembership
+	15, // 9: pr_reviewers.v1.GetUserResponse.workload:type_name -> pr_reviewers.v1.Workload
+	30, // 10: pr_reviewers.v1.GetUserResponse.unavailable_until:type_name -> google.protobuf.Timestamp
+	18, // 11: pr_reviewers.v1.GetUserReviewsResponse.pull_requests:type_name -> pr_reviewers.v1.PullRequestShort
+	30, // 12: pr_reviewers.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	30, // 13: pr_reviewers.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	0,  // 14: pr_reviewers.v1.CreatePullRequestRequest.author_identity:type_name -> pr_reviewers.v1.Identity
+	20, // 15: pr_reviewers.v1.CreatePullRequestResponse.pull_request:type_name -> pr_reviewers.v1.PullRequest
+	20, // 16: pr_reviewers.v1.MergePullRequestResponse.pull_request:type_name -> pr_reviewers.v1.PullRequest
+	20, // 17: pr_reviewers.v1.ReassignPullRequestResponse.pull_request:type_name -> pr_reviewers.v1.PullRequest
+	28, // 18: pr_reviewers.v1.GetReviewerStatsResponse.reviewers:type_name -> pr_reviewers.v1.ReviewerStats
+	3,  // 19: pr_reviewers.v1.PRReviewersService.AddTeam:input_type -> pr_reviewers.v1.AddTeamRequest
+	5,  // 20: pr_reviewers.v1.PRReviewersService.GetTeam:input_type -> pr_reviewers.v1.GetTeamRequest
+	7,  // 21: pr_reviewers.v1.PRReviewersService.ListTeams:input_type -> pr_reviewers.v1.ListTeamsRequest
+	11, // 22: pr_reviewers.v1.PRReviewersService.SetUserIsActive:input_type -> pr_reviewers.v1.SetUserIsActiveRequest
+	13, // 23: pr_reviewers.v1.PRReviewersService.GetUser:input_type -> pr_reviewers.v1.GetUserRequest
+	17, // 24: pr_reviewers.v1.PRReviewersService.GetUserReviews:input_type -> pr_reviewers.v1.GetUserReviewsRequest
+	21, // 25: pr_reviewers.v1.PRReviewersService.CreatePullRequest:input_type -> pr_reviewers.v1.CreatePullRequestRequest
+	23, // 26: pr_reviewers.v1.PRReviewersService.MergePullRequest:input_type -> pr_reviewers.v1.MergePullRequestRequest
+	25, // 27: pr_reviewers.v1.PRReviewersService.ReassignPullRequest:input_type -> pr_reviewers.v1.ReassignPullRequestRequest
+	27, // 28: pr_reviewers.v1.PRReviewersService.GetReviewerStats:input_type -> pr_reviewers.v1.GetReviewerStatsRequest
+	4,  // 29: pr_reviewers.v1.PRReviewersService.AddTeam:output_type -> pr_reviewers.v1.AddTeamResponse
+	6,  // 30: pr_reviewers.v1.PRReviewersService.GetTeam:output_type -> pr_reviewers.v1.GetTeamResponse
+	9,  // 31: pr_reviewers.v1.PRReviewersService.ListTeams:output_type -> pr_reviewers.v1.ListTeamsResponse
+	12, // 32: pr_reviewers.v1.PRReviewersService.SetUserIsActive:output_type -> pr_reviewers.v1.SetUserIsActiveResponse
+	16, // 33: pr_reviewers.v1.PRReviewersService.GetUser:output_type -> pr_reviewers.v1.GetUserResponse
+	19, // 34: pr_reviewers.v1.PRReviewersService.GetUserReviews:output_type -> pr_reviewers.v1.GetUserReviewsResponse
+	22, // 35: pr_reviewers.v1.PRReviewersService.CreatePullRequest:output_type -> pr_reviewers.v1.CreatePullRequestResponse
+	24, // 36: pr_reviewers.v1.PRReviewersService.MergePullRequest:output_type -> pr_reviewers.v1.MergePullRequestResponse
+	26, // 37: pr_reviewers.v1.PRReviewersService.ReassignPullRequest:output_type -> pr_reviewers.v1.ReassignPullRequestResponse
+	29, // 38: pr_reviewers.v1.PRReviewersService.GetReviewerStats:output_type -> pr_reviewers.v1.GetReviewerStatsResponse
+	29, // [29:39] is the sub-list for method output_type
+	19, // [19:29] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_pr_reviewers_v1_pr_reviewers_proto_init() }
+func file_pr_reviewers_v1_pr_reviewers_proto_init() {
+	if File_pr_reviewers_v1_pr_reviewers_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pr_reviewers_v1_pr_reviewers_proto_rawDesc), len(file_pr_reviewers_v1_pr_reviewers_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pr_reviewers_v1_pr_reviewers_proto_goTypes,
+		DependencyIndexes: file_pr_reviewers_v1_pr_reviewers_proto_depIdxs,
+		MessageInfos:      file_pr_reviewers_v1_pr_reviewers_proto_msgTypes,
+	}.Build()
+	File_pr_reviewers_v1_pr_reviewers_proto = out.File
+	file_pr_reviewers_v1_pr_reviewers_proto_goTypes = nil
+	file_pr_reviewers_v1_pr_reviewers_proto_depIdxs = nil
+}
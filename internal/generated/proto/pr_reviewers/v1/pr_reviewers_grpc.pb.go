@@ -0,0 +1,493 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: pr_reviewers/v1/pr_reviewers.proto
+
+package pr_reviewers_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PRReviewersService_AddTeam_FullMethodName             = "/pr_reviewers.v1.PRReviewersService/AddTeam"
+	PRReviewersService_GetTeam_FullMethodName             = "/pr_reviewers.v1.PRReviewersService/GetTeam"
+	PRReviewersService_ListTeams_FullMethodName           = "/pr_reviewers.v1.PRReviewersService/ListTeams"
+	PRReviewersService_SetUserIsActive_FullMethodName     = "/pr_reviewers.v1.PRReviewersService/SetUserIsActive"
+	PRReviewersService_GetUser_FullMethodName             = "/pr_reviewers.v1.PRReviewersService/GetUser"
+	PRReviewersService_GetUserReviews_FullMethodName      = "/pr_reviewers.v1.PRReviewersService/GetUserReviews"
+	PRReviewersService_CreatePullRequest_FullMethodName   = "/pr_reviewers.v1.PRReviewersService/CreatePullRequest"
+	PRReviewersService_MergePullRequest_FullMethodName    = "/pr_reviewers.v1.PRReviewersService/MergePullRequest"
+	PRReviewersService_ReassignPullRequest_FullMethodName = "/pr_reviewers.v1.PRReviewersService/ReassignPullRequest"
+	PRReviewersService_GetReviewerStats_FullMethodName    = "/pr_reviewers.v1.PRReviewersService/GetReviewerStats"
+)
+
+// PRReviewersServiceClient is the client API for PRReviewersService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PRReviewersService mirrors the REST API on top of the same usecases. IDs are UUID strings. Errors carry the
+// gRPC code matching the REST status: INVALID_ARGUMENT for 400, NOT_FOUND for 404, ALREADY_EXISTS and
+// FAILED_PRECONDITION for 409, UNAUTHENTICATED and PERMISSION_DENIED for 401 and 403. The REST 304 answers
+// (nothing to change) are FAILED_PRECONDITION as well, and PR_EXISTS is ALREADY_EXISTS.
+type PRReviewersServiceClient interface {
+	// AddTeam creates a team with its members, creating or updating the users (POST /team/add).
+	AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*AddTeamResponse, error)
+	// GetTeam returns a team with its members (GET /team/get).
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error)
+	// ListTeams returns a page of not archived teams ordered by name (GET /team/list).
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	// SetUserIsActive activates or deactivates a user (POST /users/setIsActive).
+	SetUserIsActive(ctx context.Context, in *SetUserIsActiveRequest, opts ...grpc.CallOption) (*SetUserIsActiveResponse, error)
+	// GetUser returns a user profile with teams and workload (GET /users/get).
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// GetUserReviews returns the pull requests the user reviews (GET /users/getReview).
+	GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error)
+	// CreatePullRequest creates a pull request and assigns reviewers from the author's team (POST /pullRequest/create).
+	CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*CreatePullRequestResponse, error)
+	// MergePullRequest merges a pull request, merging it again is not an error (POST /pullRequest/merge).
+	MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*MergePullRequestResponse, error)
+	// ReassignPullRequest replaces a reviewer with another member of the team (POST /pullRequest/reassign).
+	ReassignPullRequest(ctx context.Context, in *ReassignPullRequestRequest, opts ...grpc.CallOption) (*ReassignPullRequestResponse, error)
+	// GetReviewerStats returns the number of assignments of every reviewer (GET /statistics/reviewers).
+	GetReviewerStats(ctx context.Context, in *GetReviewerStatsRequest, opts ...grpc.CallOption) (*GetReviewerStatsResponse, error)
+}
+
+type pRReviewersServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPRReviewersServiceClient(cc grpc.ClientConnInterface) PRReviewersServiceClient {
+	return &pRReviewersServiceClient{cc}
+}
+
+func (c *pRReviewersServiceClient) AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*AddTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTeamResponse)
+	err := c.cc.Invoke(ctx, PRReviewersService_AddTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRReviewersServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamResponse)
+	err := c.cc.Invoke(ctx, PRReviewersService_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRReviewersServiceClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, PRReviewersService_ListTeams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRReviewersServiceClient) SetUserIsActive(ctx context.Context, in *SetUserIsActiveRequest, opts ...grpc.CallOption) (*SetUserIsActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserIsActiveResponse)
+	err := c.cc.Invoke(ctx, PRReviewersService_SetUserIsActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRReviewersServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, PRReviewersService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRReviewersServiceClient) GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserReviewsResponse)
+	err := c.cc.Invoke(ctx, PRReviewersService_GetUserReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRReviewersServiceClient) CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*CreatePullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePullRequestResponse)
+	err := c.cc.Invoke(ctx, PRReviewersService_CreatePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRReviewersServiceClient) MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*MergePullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergePullRequestResponse)
+	err := c.cc.Invoke(ctx, PRReviewersService_MergePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRReviewersServiceClient) ReassignPullRequest(ctx context.Context, in *ReassignPullRequestRequest, opts ...grpc.CallOption) (*ReassignPullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignPullRequestResponse)
+	err := c.cc.Invoke(ctx, PRReviewersService_ReassignPullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRReviewersServiceClient) GetReviewerStats(ctx context.Context, in *GetReviewerStatsRequest, opts ...grpc.CallOption) (*GetReviewerStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewerStatsResponse)
+	err := c.cc.Invoke(ctx, PRReviewersService_GetReviewerStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PRReviewersServiceServer is the server API for PRReviewersService service.
+// All implementations must embed UnimplementedPRReviewersServiceServer
+// for forward compatibility.
+//
+// PRReviewersService mirrors the REST API on top of the same usecases. IDs are UUID strings. Errors carry the
+// gRPC code matching the REST status: INVALID_ARGUMENT for 400, NOT_FOUND for 404, ALREADY_EXISTS and
+// FAILED_PRECONDITION for 409, UNAUTHENTICATED and PERMISSION_DENIED for 401 and 403. The REST 304 answers
+// (nothing to change) are FAILED_PRECONDITION as well, and PR_EXISTS is ALREADY_EXISTS.
+type PRReviewersServiceServer interface {
+	// AddTeam creates a team with its members, creating or updating the users (POST /team/add).
+	AddTeam(context.Context, *AddTeamRequest) (*AddTeamResponse, error)
+	// GetTeam returns a team with its members (GET /team/get).
+	GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error)
+	// ListTeams returns a page of not archived teams ordered by name (GET /team/list).
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	// SetUserIsActive activates or deactivates a user (POST /users/setIsActive).
+	SetUserIsActive(context.Context, *SetUserIsActiveRequest) (*SetUserIsActiveResponse, error)
+	// GetUser returns a user profile with teams and workload (GET /users/get).
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// GetUserReviews returns the pull requests the user reviews (GET /users/getReview).
+	GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error)
+	// CreatePullRequest creates a pull request and assigns reviewers from the author's team (POST /pullRequest/create).
+	CreatePullRequest(context.Context, *CreatePullRequestRequest) (*CreatePullRequestResponse, error)
+	// MergePullRequest merges a pull request, merging it again is not an error (POST /pullRequest/merge).
+	MergePullRequest(context.Context, *MergePullRequestRequest) (*MergePullRequestResponse, error)
+	// ReassignPullRequest replaces a reviewer with another member of the team (POST /pullRequest/reassign).
+	ReassignPullRequest(context.Context, *ReassignPullRequestRequest) (*ReassignPullRequestResponse, error)
+	// GetReviewerStats returns the number of assignments of every reviewer (GET /statistics/reviewers).
+	GetReviewerStats(context.Context, *GetReviewerStatsRequest) (*GetReviewerStatsResponse, error)
+	mustEmbedUnimplementedPRReviewersServiceServer()
+}
+
+// UnimplementedPRReviewersServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPRReviewersServiceServer struct{}
+
+func (UnimplementedPRReviewersServiceServer) AddTeam(context.Context, *AddTeamRequest) (*AddTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTeam not implemented")
+}
+func (UnimplementedPRReviewersServiceServer) GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedPRReviewersServiceServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedPRReviewersServiceServer) SetUserIsActive(context.Context, *SetUserIsActiveRequest) (*SetUserIsActiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserIsActive not implemented")
+}
+func (UnimplementedPRReviewersServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedPRReviewersServiceServer) GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserReviews not implemented")
+}
+func (UnimplementedPRReviewersServiceServer) CreatePullRequest(context.Context, *CreatePullRequestRequest) (*CreatePullRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePullRequest not implemented")
+}
+func (UnimplementedPRReviewersServiceServer) MergePullRequest(context.Context, *MergePullRequestRequest) (*MergePullRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePullRequest not implemented")
+}
+func (UnimplementedPRReviewersServiceServer) ReassignPullRequest(context.Context, *ReassignPullRequestRequest) (*ReassignPullRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignPullRequest not implemented")
+}
+func (UnimplementedPRReviewersServiceServer) GetReviewerStats(context.Context, *GetReviewerStatsRequest) (*GetReviewerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReviewerStats not implemented")
+}
+func (UnimplementedPRReviewersServiceServer) mustEmbedUnimplementedPRReviewersServiceServer() {}
+func (UnimplementedPRReviewersServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafePRReviewersServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PRReviewersServiceServer will
+// result in compilation errors.
+type UnsafePRReviewersServiceServer interface {
+	mustEmbedUnimplementedPRReviewersServiceServer()
+}
+
+func RegisterPRReviewersServiceServer(s grpc.ServiceRegistrar, srv PRReviewersServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPRReviewersServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PRReviewersService_ServiceDesc, srv)
+}
+
+func _PRReviewersService_AddTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRReviewersServiceServer).AddTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRReviewersService_AddTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRReviewersServiceServer).AddTeam(ctx, req.(*AddTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRReviewersService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRReviewersServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRReviewersService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRReviewersServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRReviewersService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRReviewersServiceServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRReviewersService_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRReviewersServiceServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRReviewersService_SetUserIsActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserIsActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRReviewersServiceServer).SetUserIsActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRReviewersService_SetUserIsActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRReviewersServiceServer).SetUserIsActive(ctx, req.(*SetUserIsActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRReviewersService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRReviewersServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRReviewersService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRReviewersServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRReviewersService_GetUserReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRReviewersServiceServer).GetUserReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRReviewersService_GetUserReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRReviewersServiceServer).GetUserReviews(ctx, req.(*GetUserReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRReviewersService_CreatePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRReviewersServiceServer).CreatePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRReviewersService_CreatePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRReviewersServiceServer).CreatePullRequest(ctx, req.(*CreatePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRReviewersService_MergePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRReviewersServiceServer).MergePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRReviewersService_MergePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRReviewersServiceServer).MergePullRequest(ctx, req.(*MergePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRReviewersService_ReassignPullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignPullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRReviewersServiceServer).ReassignPullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRReviewersService_ReassignPullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRReviewersServiceServer).ReassignPullRequest(ctx, req.(*ReassignPullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRReviewersService_GetReviewerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRReviewersServiceServer).GetReviewerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRReviewersService_GetReviewerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRReviewersServiceServer).GetReviewerStats(ctx, req.(*GetReviewerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PRReviewersService_ServiceDesc is the grpc.ServiceDesc for PRReviewersService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PRReviewersService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pr_reviewers.v1.PRReviewersService",
+	HandlerType: (*PRReviewersServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTeam",
+			Handler:    _PRReviewersService_AddTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _PRReviewersService_GetTeam_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _PRReviewersService_ListTeams_Handler,
+		},
+		{
+			MethodName: "SetUserIsActive",
+			Handler:    _PRReviewersService_SetUserIsActive_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _PRReviewersService_GetUser_Handler,
+		},
+		{
+			MethodName: "GetUserReviews",
+			Handler:    _PRReviewersService_GetUserReviews_Handler,
+		},
+		{
+			MethodName: "CreatePullRequest",
+			Handler:    _PRReviewersService_CreatePullRequest_Handler,
+		},
+		{
+			MethodName: "MergePullRequest",
+			Handler:    _PRReviewersService_MergePullRequest_Handler,
+		},
+		{
+			MethodName: "ReassignPullRequest",
+			Handler:    _PRReviewersService_ReassignPullRequest_Handler,
+		},
+		{
+			MethodName: "GetReviewerStats",
+			Handler:    _PRReviewersService_GetReviewerStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pr_reviewers/v1/pr_reviewers.proto",
+}
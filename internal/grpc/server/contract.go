@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/add_team"
+	"pr-reviewers-service/internal/usecase/get_review"
+	"pr-reviewers-service/internal/usecase/get_team"
+	"pr-reviewers-service/internal/usecase/get_team_list"
+	"pr-reviewers-service/internal/usecase/get_user"
+	"pr-reviewers-service/internal/usecase/pull_request_create"
+	"pr-reviewers-service/internal/usecase/pull_request_merge"
+	"pr-reviewers-service/internal/usecase/pull_request_reassign"
+	"pr-reviewers-service/internal/usecase/set_is_active"
+	"pr-reviewers-service/internal/usecase/stats_pr_assignments"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=server addTeamUsecase,getTeamUsecase,listTeamsUsecase,setIsActiveUsecase,getUserUsecase,getReviewUsecase,createPullRequestUsecase,mergePullRequestUsecase,reassignPullRequestUsecase,statsUsecase
+
+type addTeamUsecase interface {
+	Run(ctx context.Context, req add_team.In) (*add_team.Out, error)
+}
+
+type getTeamUsecase interface {
+	Run(ctx context.Context, req get_team.In) (*get_team.Out, error)
+}
+
+type listTeamsUsecase interface {
+	Run(ctx context.Context, req get_team_list.In) (*get_team_list.Out, error)
+}
+
+type setIsActiveUsecase interface {
+	Run(ctx context.Context, req set_is_active.In) (*set_is_active.Out, error)
+}
+
+type getUserUsecase interface {
+	Run(ctx context.Context, req get_user.In) (*get_user.Out, error)
+}
+
+type getReviewUsecase interface {
+	Run(ctx context.Context, req get_review.In) (*get_review.Out, error)
+}
+
+type createPullRequestUsecase interface {
+	Run(ctx context.Context, req pull_request_create.In) (*pull_request_create.Out, error)
+}
+
+type mergePullRequestUsecase interface {
+	Run(ctx context.Context, req pull_request_merge.In) (*pull_request_merge.Out, error)
+}
+
+type reassignPullRequestUsecase interface {
+	Run(ctx context.Context, req pull_request_reassign.In) (*pull_request_reassign.Out, error)
+}
+
+type statsUsecase interface {
+	Run(ctx context.Context, req stats_pr_assignments.In) (*stats_pr_assignments.Out, error)
+}
@@ -0,0 +1,402 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package server is a generated GoMock package.
+package server
+
+import (
+	context "context"
+	add_team "pr-reviewers-service/internal/usecase/add_team"
+	get_review "pr-reviewers-service/internal/usecase/get_review"
+	get_team "pr-reviewers-service/internal/usecase/get_team"
+	get_team_list "pr-reviewers-service/internal/usecase/get_team_list"
+	get_user "pr-reviewers-service/internal/usecase/get_user"
+	pull_request_create "pr-reviewers-service/internal/usecase/pull_request_create"
+	pull_request_merge "pr-reviewers-service/internal/usecase/pull_request_merge"
+	pull_request_reassign "pr-reviewers-service/internal/usecase/pull_request_reassign"
+	set_is_active "pr-reviewers-service/internal/usecase/set_is_active"
+	stats_pr_assignments "pr-reviewers-service/internal/usecase/stats_pr_assignments"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockaddTeamUsecase is a mock of addTeamUsecase interface.
+type MockaddTeamUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockaddTeamUsecaseMockRecorder
+}
+
+// MockaddTeamUsecaseMockRecorder is the mock recorder for MockaddTeamUsecase.
+type MockaddTeamUsecaseMockRecorder struct {
+	mock *MockaddTeamUsecase
+}
+
+// NewMockaddTeamUsecase creates a new mock instance.
+func NewMockaddTeamUsecase(ctrl *gomock.Controller) *MockaddTeamUsecase {
+	mock := &MockaddTeamUsecase{ctrl: ctrl}
+	mock.recorder = &MockaddTeamUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockaddTeamUsecase) EXPECT() *MockaddTeamUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockaddTeamUsecase) Run(ctx context.Context, req add_team.In) (*add_team.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*add_team.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockaddTeamUsecaseMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockaddTeamUsecase)(nil).Run), ctx, req)
+}
+
+// MockgetTeamUsecase is a mock of getTeamUsecase interface.
+type MockgetTeamUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockgetTeamUsecaseMockRecorder
+}
+
+// MockgetTeamUsecaseMockRecorder is the mock recorder for MockgetTeamUsecase.
+type MockgetTeamUsecaseMockRecorder struct {
+	mock *MockgetTeamUsecase
+}
+
+// NewMockgetTeamUsecase creates a new mock instance.
+func NewMockgetTeamUsecase(ctrl *gomock.Controller) *MockgetTeamUsecase {
+	mock := &MockgetTeamUsecase{ctrl: ctrl}
+	mock.recorder = &MockgetTeamUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgetTeamUsecase) EXPECT() *MockgetTeamUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockgetTeamUsecase) Run(ctx context.Context, req get_team.In) (*get_team.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*get_team.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockgetTeamUsecaseMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockgetTeamUsecase)(nil).Run), ctx, req)
+}
+
+// MocklistTeamsUsecase is a mock of listTeamsUsecase interface.
+type MocklistTeamsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MocklistTeamsUsecaseMockRecorder
+}
+
+// MocklistTeamsUsecaseMockRecorder is the mock recorder for MocklistTeamsUsecase.
+type MocklistTeamsUsecaseMockRecorder struct {
+	mock *MocklistTeamsUsecase
+}
+
+// NewMocklistTeamsUsecase creates a new mock instance.
+func NewMocklistTeamsUsecase(ctrl *gomock.Controller) *MocklistTeamsUsecase {
+	mock := &MocklistTeamsUsecase{ctrl: ctrl}
+	mock.recorder = &MocklistTeamsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklistTeamsUsecase) EXPECT() *MocklistTeamsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MocklistTeamsUsecase) Run(ctx context.Context, req get_team_list.In) (*get_team_list.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*get_team_list.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MocklistTeamsUsecaseMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocklistTeamsUsecase)(nil).Run), ctx, req)
+}
+
+// MocksetIsActiveUsecase is a mock of setIsActiveUsecase interface.
+type MocksetIsActiveUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MocksetIsActiveUsecaseMockRecorder
+}
+
+// MocksetIsActiveUsecaseMockRecorder is the mock recorder for MocksetIsActiveUsecase.
+type MocksetIsActiveUsecaseMockRecorder struct {
+	mock *MocksetIsActiveUsecase
+}
+
+// NewMocksetIsActiveUsecase creates a new mock instance.
+func NewMocksetIsActiveUsecase(ctrl *gomock.Controller) *MocksetIsActiveUsecase {
+	mock := &MocksetIsActiveUsecase{ctrl: ctrl}
+	mock.recorder = &MocksetIsActiveUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksetIsActiveUsecase) EXPECT() *MocksetIsActiveUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MocksetIsActiveUsecase) Run(ctx context.Context, req set_is_active.In) (*set_is_active.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*set_is_active.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MocksetIsActiveUsecaseMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MocksetIsActiveUsecase)(nil).Run), ctx, req)
+}
+
+// MockgetUserUsecase is a mock of getUserUsecase interface.
+type MockgetUserUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockgetUserUsecaseMockRecorder
+}
+
+// MockgetUserUsecaseMockRecorder is the mock recorder for MockgetUserUsecase.
+type MockgetUserUsecaseMockRecorder struct {
+	mock *MockgetUserUsecase
+}
+
+// NewMockgetUserUsecase creates a new mock instance.
+func NewMockgetUserUsecase(ctrl *gomock.Controller) *MockgetUserUsecase {
+	mock := &MockgetUserUsecase{ctrl: ctrl}
+	mock.recorder = &MockgetUserUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgetUserUsecase) EXPECT() *MockgetUserUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockgetUserUsecase) Run(ctx context.Context, req get_user.In) (*get_user.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*get_user.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockgetUserUsecaseMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockgetUserUsecase)(nil).Run), ctx, req)
+}
+
+// MockgetReviewUsecase is a mock of getReviewUsecase interface.
+type MockgetReviewUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockgetReviewUsecaseMockRecorder
+}
+
+// MockgetReviewUsecaseMockRecorder is the mock recorder for MockgetReviewUsecase.
+type MockgetReviewUsecaseMockRecorder struct {
+	mock *MockgetReviewUsecase
+}
+
+// NewMockgetReviewUsecase creates a new mock instance.
+func NewMockgetReviewUsecase(ctrl *gomock.Controller) *MockgetReviewUsecase {
+	mock := &MockgetReviewUsecase{ctrl: ctrl}
+	mock.recorder = &MockgetReviewUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgetReviewUsecase) EXPECT() *MockgetReviewUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockgetReviewUsecase) Run(ctx context.Context, req get_review.In) (*get_review.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*get_review.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockgetReviewUsecaseMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockgetReviewUsecase)(nil).Run), ctx, req)
+}
+
+// MockcreatePullRequestUsecase is a mock of createPullRequestUsecase interface.
+type MockcreatePullRequestUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockcreatePullRequestUsecaseMockRecorder
+}
+
+// MockcreatePullRequestUsecaseMockRecorder is the mock recorder for MockcreatePullRequestUsecase.
+type MockcreatePullRequestUsecaseMockRecorder struct {
+	mock *MockcreatePullRequestUsecase
+}
+
+// NewMockcreatePullRequestUsecase creates a new mock instance.
+func NewMockcreatePullRequestUsecase(ctrl *gomock.Controller) *MockcreatePullRequestUsecase {
+	mock := &MockcreatePullRequestUsecase{ctrl: ctrl}
+	mock.recorder = &MockcreatePullRequestUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcreatePullRequestUsecase) EXPECT() *MockcreatePullRequestUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockcreatePullRequestUsecase) Run(ctx context.Context, req pull_request_create.In) (*pull_request_create.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*pull_request_create.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockcreatePullRequestUsecaseMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockcreatePullRequestUsecase)(nil).Run), ctx, req)
+}
+
+// MockmergePullRequestUsecase is a mock of mergePullRequestUsecase interface.
+type MockmergePullRequestUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockmergePullRequestUsecaseMockRecorder
+}
+
+// MockmergePullRequestUsecaseMockRecorder is the mock recorder for MockmergePullRequestUsecase.
+type MockmergePullRequestUsecaseMockRecorder struct {
+	mock *MockmergePullRequestUsecase
+}
+
+// NewMockmergePullRequestUsecase creates a new mock instance.
+func NewMockmergePullRequestUsecase(ctrl *gomock.Controller) *MockmergePullRequestUsecase {
+	mock := &MockmergePullRequestUsecase{ctrl: ctrl}
+	mock.recorder = &MockmergePullRequestUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmergePullRequestUsecase) EXPECT() *MockmergePullRequestUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockmergePullRequestUsecase) Run(ctx context.Context, req pull_request_merge.In) (*pull_request_merge.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*pull_request_merge.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockmergePullRequestUsecaseMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockmergePullRequestUsecase)(nil).Run), ctx, req)
+}
+
+// MockreassignPullRequestUsecase is a mock of reassignPullRequestUsecase interface.
+type MockreassignPullRequestUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockreassignPullRequestUsecaseMockRecorder
+}
+
+// MockreassignPullRequestUsecaseMockRecorder is the mock recorder for MockreassignPullRequestUsecase.
+type MockreassignPullRequestUsecaseMockRecorder struct {
+	mock *MockreassignPullRequestUsecase
+}
+
+// NewMockreassignPullRequestUsecase creates a new mock instance.
+func NewMockreassignPullRequestUsecase(ctrl *gomock.Controller) *MockreassignPullRequestUsecase {
+	mock := &MockreassignPullRequestUsecase{ctrl: ctrl}
+	mock.recorder = &MockreassignPullRequestUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreassignPullRequestUsecase) EXPECT() *MockreassignPullRequestUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockreassignPullRequestUsecase) Run(ctx context.Context, req pull_request_reassign.In) (*pull_request_reassign.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*pull_request_reassign.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockreassignPullRequestUsecaseMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockreassignPullRequestUsecase)(nil).Run), ctx, req)
+}
+
+// MockstatsUsecase is a mock of statsUsecase interface.
+type MockstatsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockstatsUsecaseMockRecorder
+}
+
+// MockstatsUsecaseMockRecorder is the mock recorder for MockstatsUsecase.
+type MockstatsUsecaseMockRecorder struct {
+	mock *MockstatsUsecase
+}
+
+// NewMockstatsUsecase creates a new mock instance.
+func NewMockstatsUsecase(ctrl *gomock.Controller) *MockstatsUsecase {
+	mock := &MockstatsUsecase{ctrl: ctrl}
+	mock.recorder = &MockstatsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstatsUsecase) EXPECT() *MockstatsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockstatsUsecase) Run(ctx context.Context, req stats_pr_assignments.In) (*stats_pr_assignments.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*stats_pr_assignments.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockstatsUsecaseMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockstatsUsecase)(nil).Run), ctx, req)
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	pr_reviewers_v1 "pr-reviewers-service/internal/generated/proto/pr_reviewers/v1"
+	"pr-reviewers-service/internal/handler/middleware"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/pull_request_create"
+	"pr-reviewers-service/internal/usecase/pull_request_merge"
+	"pr-reviewers-service/internal/usecase/pull_request_reassign"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *server) CreatePullRequest(ctx context.Context, req *pr_reviewers_v1.CreatePullRequestRequest) (*pr_reviewers_v1.CreatePullRequestResponse, error) {
+	pullRequestID, err := parseUUID("pull_request_id", req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetPullRequestName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "pull_request_name cannot be empty")
+	}
+
+	var (
+		authorID       uuid.UUID
+		authorIdentity *usecase2.Identity
+	)
+	if identity := req.GetAuthorIdentity(); identity != nil {
+		authorIdentity = &usecase2.Identity{
+			Provider:   identity.GetProvider(),
+			ExternalID: identity.GetExternalId(),
+		}
+	} else {
+		authorID, err = parseUUID("author_id", req.GetAuthorId())
+		if err != nil {
+			return nil, err
+		}
+		ctx = logging.WithLogAuthorID(ctx, authorID)
+	}
+	ctx = logging.WithLogPullRequestID(ctx, pullRequestID)
+
+	result, err := s.createPullRequest.Run(ctx, pull_request_create.In{
+		PullRequestID:   pullRequestID,
+		PullRequestName: req.GetPullRequestName(),
+		AuthorID:        authorID,
+		AuthorIdentity:  authorIdentity,
+	})
+	if err != nil {
+		return nil, usecaseError(ctx, err)
+	}
+
+	return &pr_reviewers_v1.CreatePullRequestResponse{
+		PullRequest: &pr_reviewers_v1.PullRequest{
+			PullRequestId:     result.PullRequestID.String(),
+			PullRequestName:   result.PullRequestName,
+			AuthorId:          result.AuthorID.String(),
+			Status:            result.Status,
+			AssignedReviewers: uuidsToStrings(result.AssignedReviewers),
+			CreatedAt:         toTimestamp(result.CreatedAt),
+			MergedAt:          toTimestamp(result.MergedAt),
+		},
+	}, nil
+}
+
+func (s *server) MergePullRequest(ctx context.Context, req *pr_reviewers_v1.MergePullRequestRequest) (*pr_reviewers_v1.MergePullRequestResponse, error) {
+	pullRequestID, err := parseUUID("pull_request_id", req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	ctx = logging.WithLogPullRequestID(ctx, pullRequestID)
+
+	result, err := s.mergePullRequest.Run(ctx, pull_request_merge.In{
+		PullRequestID: pullRequestID,
+		RequesterID:   middleware.RestrictedUserID(ctx),
+	})
+	if err != nil && !errors.Is(err, usecase2.ErrPullRequestAlreadyMerged) {
+		return nil, usecaseError(ctx, err)
+	}
+
+	return &pr_reviewers_v1.MergePullRequestResponse{
+		PullRequest: &pr_reviewers_v1.PullRequest{
+			PullRequestId:     result.PullRequestID.String(),
+			PullRequestName:   result.PullRequestName,
+			AuthorId:          result.AuthorID.String(),
+			Status:            result.Status,
+			AssignedReviewers: uuidsToStrings(result.AssignedReviewers),
+			CreatedAt:         toTimestamp(result.CreatedAt),
+			MergedAt:          toTimestamp(result.MergedAt),
+		},
+	}, nil
+}
+
+func (s *server) ReassignPullRequest(ctx context.Context, req *pr_reviewers_v1.ReassignPullRequestRequest) (*pr_reviewers_v1.ReassignPullRequestResponse, error) {
+	pullRequestID, err := parseUUID("pull_request_id", req.GetPullRequestId())
+	if err != nil {
+		return nil, err
+	}
+	oldUserID, err := parseUUID("old_user_id", req.GetOldUserId())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCanActAs(ctx, oldUserID); err != nil {
+		return nil, err
+	}
+	ctx = logging.WithLogPullRequestID(ctx, pullRequestID)
+	ctx = logging.WithLogUserId(ctx, oldUserID)
+
+	result, err := s.reassignPullRequest.Run(ctx, pull_request_reassign.In{
+		PullRequestID: pullRequestID,
+		OldUserId:     oldUserID,
+	})
+	if err != nil {
+		return nil, usecaseError(ctx, err)
+	}
+
+	return &pr_reviewers_v1.ReassignPullRequestResponse{
+		PullRequest: &pr_reviewers_v1.PullRequest{
+			PullRequestId:     result.PullRequestID.String(),
+			PullRequestName:   result.PullRequestName,
+			AuthorId:          result.AuthorID.String(),
+			Status:            result.Status,
+			AssignedReviewers: uuidsToStrings(result.AssignedReviewers),
+			CreatedAt:         toTimestamp(result.CreatedAt),
+			MergedAt:          toTimestamp(result.MergedAt),
+		},
+		ReplacedBy: result.ReplacedBy.String(),
+	}, nil
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	pr_reviewers_v1 "pr-reviewers-service/internal/generated/proto/pr_reviewers/v1"
+	"pr-reviewers-service/internal/handler/middleware"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type server struct {
+	pr_reviewers_v1.UnimplementedPRReviewersServiceServer
+
+	addTeam             addTeamUsecase
+	getTeam             getTeamUsecase
+	listTeams           listTeamsUsecase
+	setIsActive         setIsActiveUsecase
+	getUser             getUserUsecase
+	getReview           getReviewUsecase
+	createPullRequest   createPullRequestUsecase
+	mergePullRequest    mergePullRequestUsecase
+	reassignPullRequest reassignPullRequestUsecase
+	stats               statsUsecase
+}
+
+func New(
+	addTeam addTeamUsecase,
+	getTeam getTeamUsecase,
+	listTeams listTeamsUsecase,
+	setIsActive setIsActiveUsecase,
+	getUser getUserUsecase,
+	getReview getReviewUsecase,
+	createPullRequest createPullRequestUsecase,
+	mergePullRequest mergePullRequestUsecase,
+	reassignPullRequest reassignPullRequestUsecase,
+	stats statsUsecase,
+) *server {
+	return &server{
+		addTeam:             addTeam,
+		getTeam:             getTeam,
+		listTeams:           listTeams,
+		setIsActive:         setIsActive,
+		getUser:             getUser,
+		getReview:           getReview,
+		createPullRequest:   createPullRequest,
+		mergePullRequest:    mergePullRequest,
+		reassignPullRequest: reassignPullRequest,
+		stats:               stats,
+	}
+}
+
+// errorCodes maps usecase errors to gRPC codes the same way the REST handlers map them to HTTP statuses,
+// "not modified" answers become FailedPrecondition since gRPC has no success status without a body.
+var errorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{usecase2.ErrUserNotFound, codes.NotFound},
+	{usecase2.ErrUsersByIDsNotFound, codes.NotFound},
+	{usecase2.ErrTeamNotFound, codes.NotFound},
+	{usecase2.ErrParentTeamNotFound, codes.NotFound},
+	{usecase2.ErrPullRequestNotFound, codes.NotFound},
+	{usecase2.ErrAuthorPrNotFound, codes.NotFound},
+	{usecase2.ErrIdentityNotFound, codes.NotFound},
+	{usecase2.ErrReviewerNotFound, codes.NotFound},
+	{usecase2.ErrNoAvailableReviewers, codes.NotFound},
+	{usecase2.ErrNoActiveReviewers, codes.NotFound},
+	{usecase2.ErrPRsReviewersNotFound, codes.NotFound},
+	{usecase2.ErrTeamHierarchyCycle, codes.InvalidArgument},
+	{usecase2.ErrInvalidIdentity, codes.InvalidArgument},
+	{usecase2.ErrDuplicateUsers, codes.InvalidArgument},
+	{usecase2.ErrPullRequestExists, codes.AlreadyExists},
+	{usecase2.ErrIdentityAlreadyLinked, codes.AlreadyExists},
+	{usecase2.ErrPullRequestAlreadyMerged, codes.FailedPrecondition},
+	{usecase2.ErrPullRequestClosed, codes.FailedPrecondition},
+	{usecase2.ErrNoUsersWereUpdatedAddedTeam, codes.FailedPrecondition},
+	{usecase2.ErrUserDontNeedChange, codes.FailedPrecondition},
+	{usecase2.ErrNotPullRequestAuthor, codes.PermissionDenied},
+}
+
+func usecaseError(ctx context.Context, err error) error {
+	for _, e := range errorCodes {
+		if errors.Is(err, e.err) {
+			return status.Error(e.code, err.Error())
+		}
+	}
+
+	slog.ErrorContext(logging.ErrorCtx(ctx, err), fmt.Sprintf("Error: %s", err.Error()))
+	return status.Error(codes.Internal, err.Error())
+}
+
+// checkCanActAs denies a USER acting on someone else's reviews, like the REST handlers do.
+func checkCanActAs(ctx context.Context, userID uuid.UUID) error {
+	if !middleware.CanActAs(ctx, userID) {
+		return status.Errorf(codes.PermissionDenied, "insufficient permissions: %s", middleware.ErrNotOwner)
+	}
+	return nil
+}
+
+func parseUUID(field, raw string) (uuid.UUID, error) {
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid %s format: %s", field, err)
+	}
+	return id, nil
+}
+
+func uuidsToStrings(ids []uuid.UUID) []string {
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, id.String())
+	}
+	return out
+}
+
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
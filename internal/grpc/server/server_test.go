@@ -0,0 +1,339 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	pr_reviewers_v1 "pr-reviewers-service/internal/generated/proto/pr_reviewers/v1"
+	"pr-reviewers-service/internal/grpc/server"
+	mockServer "pr-reviewers-service/internal/grpc/server/mocks"
+	"pr-reviewers-service/internal/handler/middleware"
+	"pr-reviewers-service/internal/jwt"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/get_review"
+	"pr-reviewers-service/internal/usecase/get_team_list"
+	"pr-reviewers-service/internal/usecase/pull_request_create"
+	"pr-reviewers-service/internal/usecase/pull_request_merge"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const secret = "test-secret"
+
+type mocks struct {
+	addTeam     *mockServer.MockaddTeamUsecase
+	getTeam     *mockServer.MockgetTeamUsecase
+	listTeams   *mockServer.MocklistTeamsUsecase
+	setIsActive *mockServer.MocksetIsActiveUsecase
+	getUser     *mockServer.MockgetUserUsecase
+	getReview   *mockServer.MockgetReviewUsecase
+	create      *mockServer.MockcreatePullRequestUsecase
+	merge       *mockServer.MockmergePullRequestUsecase
+	reassign    *mockServer.MockreassignPullRequestUsecase
+	stats       *mockServer.MockstatsUsecase
+}
+
+// startServer serves the service over an in-memory listener with the same interceptors as the app,
+// the auth interceptor allowing the roles to call every method is added when roles are given.
+func startServer(t *testing.T, roles []middleware.UserRole) (pr_reviewers_v1.PRReviewersServiceClient, mocks) {
+	ctrl := gomock.NewController(t)
+	m := mocks{
+		addTeam:     mockServer.NewMockaddTeamUsecase(ctrl),
+		getTeam:     mockServer.NewMockgetTeamUsecase(ctrl),
+		listTeams:   mockServer.NewMocklistTeamsUsecase(ctrl),
+		setIsActive: mockServer.NewMocksetIsActiveUsecase(ctrl),
+		getUser:     mockServer.NewMockgetUserUsecase(ctrl),
+		getReview:   mockServer.NewMockgetReviewUsecase(ctrl),
+		create:      mockServer.NewMockcreatePullRequestUsecase(ctrl),
+		merge:       mockServer.NewMockmergePullRequestUsecase(ctrl),
+		reassign:    mockServer.NewMockreassignPullRequestUsecase(ctrl),
+		stats:       mockServer.NewMockstatsUsecase(ctrl),
+	}
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		middleware.LoggerInterceptor,
+		middleware.MetricsInterceptor,
+		middleware.PanicInterceptor,
+	}
+	if roles != nil {
+		policy := middleware.Policy{}
+		for _, method := range pr_reviewers_v1.PRReviewersService_ServiceDesc.Methods {
+			policy["/"+pr_reviewers_v1.PRReviewersService_ServiceDesc.ServiceName+"/"+method.MethodName] = roles
+		}
+		interceptors = append(interceptors, middleware.AuthInterceptor(secret, policy))
+	}
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	pr_reviewers_v1.RegisterPRReviewersServiceServer(grpcServer, server.New(m.addTeam, m.getTeam, m.listTeams,
+		m.setIsActive, m.getUser, m.getReview, m.create, m.merge, m.reassign, m.stats))
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pr_reviewers_v1.NewPRReviewersServiceClient(conn), m
+}
+
+func TestMergePullRequest(t *testing.T) {
+	client, m := startServer(t, nil)
+
+	prID := uuid.New()
+	authorID := uuid.New()
+	reviewer := uuid.New()
+	now := time.Now().UTC()
+	ucOut := &pull_request_merge.Out{
+		PullRequestID:     prID,
+		PullRequestName:   "Fix bug",
+		AuthorID:          authorID,
+		Status:            "MERGED",
+		AssignedReviewers: []uuid.UUID{reviewer},
+		CreatedAt:         now,
+		MergedAt:          now,
+	}
+
+	tests := []struct {
+		name     string
+		prID     string
+		mock     func()
+		wantCode codes.Code
+	}{
+		{
+			name: "success",
+			prID: prID.String(),
+			mock: func() {
+				m.merge.EXPECT().Run(gomock.Any(), pull_request_merge.In{PullRequestID: prID}).Return(ucOut, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "already merged is not an error",
+			prID: prID.String(),
+			mock: func() {
+				m.merge.EXPECT().Run(gomock.Any(), gomock.Any()).Return(ucOut, usecase2.ErrPullRequestAlreadyMerged)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "invalid id",
+			prID:     "not-a-uuid",
+			mock:     func() {},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "not found",
+			prID: prID.String(),
+			mock: func() {
+				m.merge.EXPECT().Run(gomock.Any(), gomock.Any()).Return(nil, usecase2.ErrPullRequestNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "closed",
+			prID: prID.String(),
+			mock: func() {
+				m.merge.EXPECT().Run(gomock.Any(), gomock.Any()).Return(nil, usecase2.ErrPullRequestClosed)
+			},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name: "internal error",
+			prID: prID.String(),
+			mock: func() {
+				m.merge.EXPECT().Run(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			resp, err := client.MergePullRequest(context.Background(),
+				&pr_reviewers_v1.MergePullRequestRequest{PullRequestId: tt.prID})
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+			pr := resp.GetPullRequest()
+			assert.Equal(t, prID.String(), pr.GetPullRequestId())
+			assert.Equal(t, authorID.String(), pr.GetAuthorId())
+			assert.Equal(t, "MERGED", pr.GetStatus())
+			assert.Equal(t, []string{reviewer.String()}, pr.GetAssignedReviewers())
+			assert.True(t, now.Equal(pr.GetMergedAt().AsTime()))
+		})
+	}
+}
+
+func TestCreatePullRequest(t *testing.T) {
+	client, m := startServer(t, nil)
+
+	prID := uuid.New()
+	authorID := uuid.New()
+
+	t.Run("author identity", func(t *testing.T) {
+		m.create.EXPECT().Run(gomock.Any(), pull_request_create.In{
+			PullRequestID:   prID,
+			PullRequestName: "Add feature",
+			AuthorIdentity:  &usecase2.Identity{Provider: "github", ExternalID: "octocat"},
+		}).Return(&pull_request_create.Out{
+			PullRequestID:   prID,
+			PullRequestName: "Add feature",
+			AuthorID:        authorID,
+			Status:          "OPEN",
+			CreatedAt:       time.Now(),
+		}, nil)
+
+		resp, err := client.CreatePullRequest(context.Background(), &pr_reviewers_v1.CreatePullRequestRequest{
+			PullRequestId:   prID.String(),
+			PullRequestName: "Add feature",
+			AuthorIdentity:  &pr_reviewers_v1.Identity{Provider: "github", ExternalId: "octocat"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, authorID.String(), resp.GetPullRequest().GetAuthorId())
+		assert.Nil(t, resp.GetPullRequest().GetMergedAt())
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		m.create.EXPECT().Run(gomock.Any(), gomock.Any()).Return(nil, usecase2.ErrPullRequestExists)
+
+		_, err := client.CreatePullRequest(context.Background(), &pr_reviewers_v1.CreatePullRequestRequest{
+			PullRequestId:   prID.String(),
+			PullRequestName: "Add feature",
+			AuthorId:        authorID.String(),
+		})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		_, err := client.CreatePullRequest(context.Background(), &pr_reviewers_v1.CreatePullRequestRequest{
+			PullRequestId: prID.String(),
+			AuthorId:      authorID.String(),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestListTeams(t *testing.T) {
+	client, m := startServer(t, nil)
+
+	t.Run("default limit", func(t *testing.T) {
+		m.listTeams.EXPECT().Run(gomock.Any(), get_team_list.In{
+			NamePrefix: "back",
+			Limit:      get_team_list.DefaultLimit,
+		}).Return(&get_team_list.Out{
+			Teams: []get_team_list.TeamSummary{{TeamName: "backend", MembersCount: 3}},
+			Total: 1,
+			Limit: get_team_list.DefaultLimit,
+		}, nil)
+
+		resp, err := client.ListTeams(context.Background(), &pr_reviewers_v1.ListTeamsRequest{NamePrefix: " back "})
+		require.NoError(t, err)
+		require.Len(t, resp.GetTeams(), 1)
+		assert.Equal(t, "backend", resp.GetTeams()[0].GetTeamName())
+		assert.Equal(t, int32(3), resp.GetTeams()[0].GetMembersCount())
+		assert.Equal(t, int32(1), resp.GetTotal())
+	})
+
+	t.Run("limit too big", func(t *testing.T) {
+		_, err := client.ListTeams(context.Background(),
+			&pr_reviewers_v1.ListTeamsRequest{Limit: get_team_list.MaxLimit + 1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestInterceptors(t *testing.T) {
+	client, m := startServer(t, []middleware.UserRole{middleware.Admin})
+
+	withToken := func(role string) context.Context {
+		token, err := jwt.GenerateToken(secret, role, uuid.New(), time.Hour)
+		require.NoError(t, err)
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	}
+	req := &pr_reviewers_v1.GetTeamRequest{TeamName: "backend"}
+
+	t.Run("no token", func(t *testing.T) {
+		_, err := client.GetTeam(context.Background(), req)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("wrong role", func(t *testing.T) {
+		_, err := client.GetTeam(withToken(string(middleware.User)), req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("panic is recovered", func(t *testing.T) {
+		m.getTeam.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ any) (any, error) {
+			panic("boom")
+		})
+
+		_, err := client.GetTeam(withToken(string(middleware.Admin)), req)
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
+
+func TestOwnership(t *testing.T) {
+	client, m := startServer(t, []middleware.UserRole{middleware.Admin, middleware.User})
+
+	userID := uuid.New()
+	otherID := uuid.New()
+	prID := uuid.New()
+	withToken := func(role middleware.UserRole, id uuid.UUID) context.Context {
+		token, err := jwt.GenerateToken(secret, string(role), id, time.Hour)
+		require.NoError(t, err)
+		return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	}
+
+	t.Run("user reads someone else's reviews", func(t *testing.T) {
+		_, err := client.GetUserReviews(withToken(middleware.User, userID),
+			&pr_reviewers_v1.GetUserReviewsRequest{UserId: otherID.String()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("admin reads someone else's reviews", func(t *testing.T) {
+		m.getReview.EXPECT().Run(gomock.Any(), get_review.In{UserID: otherID}).
+			Return(&get_review.Out{UserID: otherID}, nil)
+
+		_, err := client.GetUserReviews(withToken(middleware.Admin, userID),
+			&pr_reviewers_v1.GetUserReviewsRequest{UserId: otherID.String()})
+		assert.NoError(t, err)
+	})
+
+	t.Run("user reassigns someone else's review", func(t *testing.T) {
+		_, err := client.ReassignPullRequest(withToken(middleware.User, userID),
+			&pr_reviewers_v1.ReassignPullRequestRequest{PullRequestId: prID.String(), OldUserId: otherID.String()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("user merges someone else's pull request", func(t *testing.T) {
+		m.merge.EXPECT().Run(gomock.Any(), pull_request_merge.In{PullRequestID: prID, RequesterID: userID}).
+			Return(nil, usecase2.ErrNotPullRequestAuthor)
+
+		_, err := client.MergePullRequest(withToken(middleware.User, userID),
+			&pr_reviewers_v1.MergePullRequestRequest{PullRequestId: prID.String()})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	pr_reviewers_v1 "pr-reviewers-service/internal/generated/proto/pr_reviewers/v1"
+	"pr-reviewers-service/internal/logging"
+	"pr-reviewers-service/internal/usecase/stats_pr_assignments"
+)
+
+func (s *server) GetReviewerStats(ctx context.Context, req *pr_reviewers_v1.GetReviewerStatsRequest) (*pr_reviewers_v1.GetReviewerStatsResponse, error) {
+	teamName := strings.TrimSpace(req.GetTeamName())
+	if teamName != "" {
+		ctx = logging.WithLogTeamName(ctx, teamName)
+	}
+
+	result, err := s.stats.Run(ctx, stats_pr_assignments.In{
+		TeamName:        teamName,
+		IncludeSubteams: req.GetIncludeSubteams(),
+	})
+	if err != nil {
+		return nil, usecaseError(ctx, err)
+	}
+
+	reviewers := make([]*pr_reviewers_v1.ReviewerStats, 0, len(result.Reviewers))
+	for _, reviewer := range result.Reviewers {
+		reviewers = append(reviewers, &pr_reviewers_v1.ReviewerStats{
+			ReviewerId:      reviewer.ReviewerID.String(),
+			AssignmentCount: int32(reviewer.AssignmentCount),
+		})
+	}
+
+	return &pr_reviewers_v1.GetReviewerStatsResponse{Reviewers: reviewers}, nil
+}
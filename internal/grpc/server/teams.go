@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	pr_reviewers_v1 "pr-reviewers-service/internal/generated/proto/pr_reviewers/v1"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/add_team"
+	"pr-reviewers-service/internal/usecase/get_team"
+	"pr-reviewers-service/internal/usecase/get_team_list"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *server) AddTeam(ctx context.Context, req *pr_reviewers_v1.AddTeamRequest) (*pr_reviewers_v1.AddTeamResponse, error) {
+	team := req.GetTeam()
+	if team.GetTeamName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "team_name cannot be empty")
+	}
+
+	members := make([]add_team.TeamMembers, 0, len(team.GetMembers()))
+	for _, member := range team.GetMembers() {
+		userID, err := parseUUID("user_id", member.GetUserId())
+		if err != nil {
+			return nil, err
+		}
+		if member.GetUsername() == "" {
+			return nil, status.Error(codes.InvalidArgument, "username cannot be empty")
+		}
+
+		teamMember := add_team.TeamMembers{
+			IsActive: member.GetIsActive(),
+			UserID:   userID,
+			Username: member.GetUsername(),
+		}
+		for _, identity := range member.GetIdentities() {
+			teamMember.Identities = append(teamMember.Identities, usecase2.Identity{
+				Provider:   identity.GetProvider(),
+				ExternalID: identity.GetExternalId(),
+			})
+		}
+		members = append(members, teamMember)
+	}
+
+	ctx = logging.WithLogTeamName(ctx, team.GetTeamName())
+	ctx = logging.WithLogTeamMembersCount(ctx, len(members))
+
+	// proto3 cannot tell an empty parent from a missing one, so gRPC never detaches the team.
+	var parentTeamName *string
+	if team.GetParentTeamName() != "" {
+		parentTeamName = &team.ParentTeamName
+	}
+	result, err := s.addTeam.Run(ctx, add_team.In{
+		TeamName:       team.GetTeamName(),
+		ParentTeamName: parentTeamName,
+		Members:        members,
+	})
+	if err != nil {
+		return nil, usecaseError(ctx, err)
+	}
+
+	out := &pr_reviewers_v1.Team{
+		TeamName:       result.TeamName,
+		ParentTeamName: result.ParentTeamName,
+		Members:        make([]*pr_reviewers_v1.TeamMember, 0, len(result.Members)),
+	}
+	for _, member := range result.Members {
+		teamMember := &pr_reviewers_v1.TeamMember{
+			UserId:   member.UserID.String(),
+			Username: member.Username,
+			IsActive: member.IsActive,
+		}
+		for _, identity := range member.Identities {
+			teamMember.Identities = append(teamMember.Identities, &pr_reviewers_v1.Identity{
+				Provider:   identity.Provider,
+				ExternalId: identity.ExternalID,
+			})
+		}
+		out.Members = append(out.Members, teamMember)
+	}
+
+	return &pr_reviewers_v1.AddTeamResponse{Team: out}, nil
+}
+
+func (s *server) GetTeam(ctx context.Context, req *pr_reviewers_v1.GetTeamRequest) (*pr_reviewers_v1.GetTeamResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "team_name cannot be empty")
+	}
+	ctx = logging.WithLogTeamName(ctx, req.GetTeamName())
+
+	result, err := s.getTeam.Run(ctx, get_team.In{
+		TeamName: req.GetTeamName(),
+	})
+	if err != nil {
+		return nil, usecaseError(ctx, err)
+	}
+
+	out := &pr_reviewers_v1.Team{
+		TeamName:       result.TeamName,
+		ParentTeamName: result.ParentTeamName,
+		Members:        make([]*pr_reviewers_v1.TeamMember, 0, len(result.Members)),
+	}
+	for _, member := range result.Members {
+		out.Members = append(out.Members, &pr_reviewers_v1.TeamMember{
+			UserId:   member.UserID.String(),
+			Username: member.Username,
+			IsActive: member.IsActive,
+		})
+	}
+
+	return &pr_reviewers_v1.GetTeamResponse{Team: out}, nil
+}
+
+func (s *server) ListTeams(ctx context.Context, req *pr_reviewers_v1.ListTeamsRequest) (*pr_reviewers_v1.ListTeamsResponse, error) {
+	limit := get_team_list.DefaultLimit
+	if req.GetLimit() != 0 {
+		if req.GetLimit() < 1 || req.GetLimit() > get_team_list.MaxLimit {
+			return nil, status.Error(codes.InvalidArgument,
+				"limit must be an integer between 1 and "+strconv.Itoa(get_team_list.MaxLimit))
+		}
+		limit = int(req.GetLimit())
+	}
+	if req.GetOffset() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must be a non-negative integer")
+	}
+
+	result, err := s.listTeams.Run(ctx, get_team_list.In{
+		NamePrefix: strings.TrimSpace(req.GetNamePrefix()),
+		Limit:      limit,
+		Offset:     int(req.GetOffset()),
+	})
+	if err != nil {
+		return nil, usecaseError(ctx, err)
+	}
+
+	teams := make([]*pr_reviewers_v1.TeamSummary, 0, len(result.Teams))
+	for _, team := range result.Teams {
+		teams = append(teams, &pr_reviewers_v1.TeamSummary{
+			TeamName:                      team.TeamName,
+			MembersCount:                  int32(team.MembersCount),
+			ActiveMembersCount:            int32(team.ActiveMembersCount),
+			OpenPullRequestsCount:         int32(team.OpenPullRequestsCount),
+			AvgOpenReviewsPerActiveMember: team.AvgOpenReviewsPerActiveMember,
+		})
+	}
+
+	return &pr_reviewers_v1.ListTeamsResponse{
+		Teams:  teams,
+		Total:  int32(result.Total),
+		Limit:  int32(result.Limit),
+		Offset: int32(result.Offset),
+	}, nil
+}
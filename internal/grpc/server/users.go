@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	pr_reviewers_v1 "pr-reviewers-service/internal/generated/proto/pr_reviewers/v1"
+	"pr-reviewers-service/internal/logging"
+	"pr-reviewers-service/internal/usecase/get_review"
+	"pr-reviewers-service/internal/usecase/get_user"
+	"pr-reviewers-service/internal/usecase/set_is_active"
+)
+
+func (s *server) SetUserIsActive(ctx context.Context, req *pr_reviewers_v1.SetUserIsActiveRequest) (*pr_reviewers_v1.SetUserIsActiveResponse, error) {
+	userID, err := parseUUID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	ctx = logging.WithLogUserId(ctx, userID)
+
+	result, err := s.setIsActive.Run(ctx, set_is_active.In{
+		UserID:   userID,
+		IsActive: req.GetIsActive(),
+	})
+	if err != nil {
+		return nil, usecaseError(ctx, err)
+	}
+
+	return &pr_reviewers_v1.SetUserIsActiveResponse{
+		User: &pr_reviewers_v1.User{
+			UserId:   result.UserId.String(),
+			Username: result.Username,
+			TeamName: result.TeamName,
+			IsActive: result.IsActive,
+		},
+	}, nil
+}
+
+func (s *server) GetUser(ctx context.Context, req *pr_reviewers_v1.GetUserRequest) (*pr_reviewers_v1.GetUserResponse, error) {
+	userID, err := parseUUID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	ctx = logging.WithLogUserId(ctx, userID)
+
+	result, err := s.getUser.Run(ctx, get_user.In{
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, usecaseError(ctx, err)
+	}
+
+	teams := make([]*pr_reviewers_v1.TeamMembership, 0, len(result.Teams))
+	for _, team := range result.Teams {
+		teams = append(teams, &pr_reviewers_v1.TeamMembership{
+			TeamName:  team.TeamName,
+			IsPrimary: team.IsPrimary,
+		})
+	}
+
+	var unavailableUntil time.Time
+	if result.UnavailableUntil != nil {
+		unavailableUntil = *result.UnavailableUntil
+	}
+
+	return &pr_reviewers_v1.GetUserResponse{
+		User: &pr_reviewers_v1.User{
+			UserId:   result.UserID.String(),
+			Username: result.Username,
+			TeamName: result.TeamName,
+			IsActive: result.IsActive,
+		},
+		Teams: teams,
+		Workload: &pr_reviewers_v1.Workload{
+			OpenReviews:              int32(result.Workload.OpenReviews),
+			AuthoredOpenPullRequests: int32(result.Workload.AuthoredOpenPullRequests),
+			CompletedReviews:         int32(result.Workload.CompletedReviews),
+		},
+		IsAvailable:      result.IsAvailable,
+		UnavailableUntil: toTimestamp(unavailableUntil),
+	}, nil
+}
+
+func (s *server) GetUserReviews(ctx context.Context, req *pr_reviewers_v1.GetUserReviewsRequest) (*pr_reviewers_v1.GetUserReviewsResponse, error) {
+	userID, err := parseUUID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkCanActAs(ctx, userID); err != nil {
+		return nil, err
+	}
+	ctx = logging.WithLogUserId(ctx, userID)
+
+	result, err := s.getReview.Run(ctx, get_review.In{
+		UserID: userID,
+	})
+	if err != nil {
+		return nil, usecaseError(ctx, err)
+	}
+
+	pullRequests := make([]*pr_reviewers_v1.PullRequestShort, 0, len(result.PullRequests))
+	for _, pr := range result.PullRequests {
+		pullRequests = append(pullRequests, &pr_reviewers_v1.PullRequestShort{
+			PullRequestId:   pr.PullRequestID.String(),
+			PullRequestName: pr.PullRequestName,
+			AuthorId:        pr.AuthorID.String(),
+			Status:          pr.Status,
+		})
+	}
+
+	return &pr_reviewers_v1.GetUserReviewsResponse{
+		UserId:       result.UserID.String(),
+		PullRequests: pullRequests,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 
 	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
@@ -28,7 +29,10 @@ func New(usecase usecase, validator *validator.Validate) *addTeamHandler {
 }
 
 // @Summary Create team with members
-// @Description Create a new team with members (creates/updates users)
+// @Description Create a new team with members (creates/updates users).
+// @Description Users that already belong to another team keep their primary team and join this one as additional members.
+// @Description With parent_team_name the team is nested under that team, reviewers are looked up there when the team runs out of candidates.
+// @Description A null or empty parent_team_name makes the team a root one, without the field the parent is kept.
 // @ID AddTeam
 // @Tags Teams
 // @Accept json
@@ -36,15 +40,30 @@ func New(usecase usecase, validator *validator.Validate) *addTeamHandler {
 // @Param input body handler2.PostTeamAddJSONRequestBody true "Team data with members"
 // @Success 201 {object} handler2.Team "Team successfully created"
 // @Success 304 "No changes - team exists and no users were changed or added"
-// @Failure 400 {object} handler2.ErrorResponse "Validation failed or duplicate users"
+// @Failure 400 {object} handler2.ErrorResponse "Validation failed, duplicate users or cyclic team hierarchy"
+// @Failure 404 {object} handler2.ErrorResponse "Parent team not found"
+// @Failure 409 {object} handler2.ErrorResponse "Identity is already linked to another user"
 // @Failure 500 {object} handler2.ErrorResponse "Internal server error"
 // @Router /team/add [post]
 func (h *addTeamHandler) AddTeam(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	ctx := r.Context()
 
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to read request", err)
+		return
+	}
 	var request handler2.PostTeamAddJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+	if err = json.Unmarshal(body, &request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+	// An explicit null decodes like a missing field, only the raw body tells them apart.
+	var fields struct {
+		ParentTeamName json.RawMessage `json:"parent_team_name"`
+	}
+	if err = json.Unmarshal(body, &fields); err != nil {
 		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
 		return
 	}
@@ -57,16 +76,31 @@ func (h *addTeamHandler) AddTeam(w http.ResponseWriter, r *http.Request) {
 	ctx = logging.WithLogTeamName(ctx, request.TeamName)
 	ctx = logging.WithLogTeamMembersCount(ctx, len(request.Members))
 
+	parentTeamName := request.ParentTeamName
+	if parentTeamName == nil && fields.ParentTeamName != nil {
+		parentTeamName = new(string)
+	}
+
 	result, err := h.usecase.Run(ctx, add_team.In{
-		TeamName: request.TeamName,
+		TeamName:       request.TeamName,
+		ParentTeamName: parentTeamName,
 		Members: func() []add_team.TeamMembers {
 			members := make([]add_team.TeamMembers, 0, len(request.Members))
 			for _, member := range request.Members {
-				members = append(members, add_team.TeamMembers{
+				teamMember := add_team.TeamMembers{
 					IsActive: member.IsActive,
 					UserID:   member.UserId,
 					Username: member.Username,
-				})
+				}
+				if member.Identities != nil {
+					for _, identity := range *member.Identities {
+						teamMember.Identities = append(teamMember.Identities, usecase2.Identity{
+							Provider:   identity.Provider,
+							ExternalID: identity.ExternalId,
+						})
+					}
+				}
+				members = append(members, teamMember)
 			}
 			return members
 		}(),
@@ -81,15 +115,29 @@ func (h *addTeamHandler) AddTeam(w http.ResponseWriter, r *http.Request) {
 		Members: func() []handler2.TeamMember {
 			members := make([]handler2.TeamMember, 0, len(result.Members))
 			for _, member := range result.Members {
-				members = append(members, handler2.TeamMember{
+				teamMember := handler2.TeamMember{
 					IsActive: member.IsActive,
 					UserId:   member.UserID,
 					Username: member.Username,
-				})
+				}
+				if len(member.Identities) > 0 {
+					identities := make([]handler2.UserIdentity, 0, len(member.Identities))
+					for _, identity := range member.Identities {
+						identities = append(identities, handler2.UserIdentity{
+							Provider:   identity.Provider,
+							ExternalId: identity.ExternalID,
+						})
+					}
+					teamMember.Identities = &identities
+				}
+				members = append(members, teamMember)
 			}
 			return members
 		}(),
 	}
+	if result.ParentTeamName != "" {
+		team.ParentTeamName = &result.ParentTeamName
+	}
 
 	w.WriteHeader(http.StatusCreated)
 	if err = json.NewEncoder(w).Encode(team); err != nil {
@@ -108,16 +156,40 @@ func handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
 		errorMsg = "error occurred while getting team with such id"
 	case errors.Is(err, usecase2.ErrSaveTeam):
 		errorMsg = "error occurred while saving team in db"
+	case errors.Is(err, usecase2.ErrUpdateTeam):
+		errorMsg = "error occurred while updating team in db"
 	case errors.Is(err, usecase2.ErrGetUsers):
 		errorMsg = "error occurred while checking if users exist with such ids"
 	case errors.Is(err, usecase2.ErrSaveUsersBatch):
 		errorMsg = "error occurred while saving new users in db"
 	case errors.Is(err, usecase2.ErrUpdateUsersBatch):
 		errorMsg = "error occurred while updating existing users in db"
+	case errors.Is(err, usecase2.ErrSaveTeamMemberships):
+		errorMsg = "error occurred while saving team memberships in db"
 	case errors.Is(err, usecase2.ErrNoUsersWereUpdatedAddedTeam):
 		errorMsg = "team exists and no users were changed or added"
 		errorResponseErrorCode = handler2.TEAMEXISTS
 		statusCode = http.StatusNotModified
+	case errors.Is(err, usecase2.ErrParentTeamNotFound):
+		errorMsg = "parent team not found"
+		errorResponseErrorCode = handler2.NOTFOUND
+		statusCode = http.StatusNotFound
+	case errors.Is(err, usecase2.ErrTeamHierarchyCycle):
+		errorMsg = "team cannot be nested under itself or its subteam"
+		errorResponseErrorCode = handler2.BADREQUEST
+		statusCode = http.StatusBadRequest
+	case errors.Is(err, usecase2.ErrGetIdentities):
+		errorMsg = "error occurred while getting user identities"
+	case errors.Is(err, usecase2.ErrSaveIdentities):
+		errorMsg = "error occurred while saving user identities in db"
+	case errors.Is(err, usecase2.ErrInvalidIdentity):
+		errorMsg = "identity provider must be one of github, gitlab, email and external_id must not be empty"
+		errorResponseErrorCode = handler2.BADREQUEST
+		statusCode = http.StatusBadRequest
+	case errors.Is(err, usecase2.ErrIdentityAlreadyLinked):
+		errorMsg = "identity is already linked to another user"
+		errorResponseErrorCode = handler2.BADREQUEST
+		statusCode = http.StatusConflict
 	case errors.Is(err, usecase2.ErrDuplicateUsers):
 		errorMsg = "dont use same user ids"
 		errorResponseErrorCode = handler2.BADREQUEST
@@ -64,6 +64,51 @@ func TestAddTeam(t *testing.T) {
 		},
 	}
 
+	parentTeamName := "platform"
+	reqBodyWithParent := reqBody
+	reqBodyWithParent.ParentTeamName = &parentTeamName
+	ucInWithParent := ucIn
+	ucInWithParent.ParentTeamName = &parentTeamName
+	ucOutWithParent := ucOut
+	ucOutWithParent.ParentTeamName = parentTeamName
+
+	ucInDetached := ucIn
+	ucInDetached.ParentTeamName = new(string)
+	detachedTeam := &handler.Team{
+		TeamName: "backend",
+		Members: []handler.TeamMember{
+			{
+				UserId:   userID,
+				Username: "alice",
+				IsActive: true,
+			},
+		},
+	}
+
+	identities := []handler.UserIdentity{{Provider: "github", ExternalId: "alice"}}
+	reqBodyWithIdentities := handler.PostTeamAddJSONRequestBody{
+		TeamName: "backend",
+		Members: []handler.TeamMember{
+			{
+				UserId:     userID,
+				Username:   "alice",
+				IsActive:   true,
+				Identities: &identities,
+			},
+		},
+	}
+	ucInWithIdentities := usecase.In{
+		TeamName: "backend",
+		Members: []usecase.TeamMembers{
+			{
+				UserID:     userID,
+				Username:   "alice",
+				IsActive:   true,
+				Identities: []usecase2.Identity{{Provider: "github", ExternalID: "alice"}},
+			},
+		},
+	}
+
 	tests := []struct {
 		name        string
 		reqBody     interface{}
@@ -90,6 +135,104 @@ func TestAddTeam(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "success with parent team",
+			reqBody: reqBodyWithParent,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucInWithParent).Return(&ucOutWithParent, nil)
+			},
+			wantCode: http.StatusCreated,
+			wantSuccess: &handler.Team{
+				TeamName:       "backend",
+				ParentTeamName: &parentTeamName,
+				Members: []handler.TeamMember{
+					{
+						UserId:   userID,
+						Username: "alice",
+						IsActive: true,
+					},
+				},
+			},
+		},
+		{
+			name: "null parent team detaches the team",
+			reqBody: `{"team_name":"backend","parent_team_name":null,"members":[{"user_id":"` + userID.String() +
+				`","username":"alice","is_active":true}]}`,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucInDetached).Return(&ucOut, nil)
+			},
+			wantCode:    http.StatusCreated,
+			wantSuccess: detachedTeam,
+		},
+		{
+			name: "empty parent team detaches the team",
+			reqBody: `{"team_name":"backend","parent_team_name":"","members":[{"user_id":"` + userID.String() +
+				`","username":"alice","is_active":true}]}`,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucInDetached).Return(&ucOut, nil)
+			},
+			wantCode:    http.StatusCreated,
+			wantSuccess: detachedTeam,
+		},
+		{
+			name:    "success with identities",
+			reqBody: reqBodyWithIdentities,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucInWithIdentities).Return(&usecase.Out{
+					TeamName: "backend",
+					Members:  ucInWithIdentities.Members,
+				}, nil)
+			},
+			wantCode: http.StatusCreated,
+			wantSuccess: &handler.Team{
+				TeamName: "backend",
+				Members:  reqBodyWithIdentities.Members,
+			},
+		},
+		{
+			name: "identity validation error",
+			reqBody: handler.PostTeamAddJSONRequestBody{
+				TeamName: "backend",
+				Members: []handler.TeamMember{
+					{
+						UserId:     userID,
+						Username:   "alice",
+						IsActive:   true,
+						Identities: &[]handler.UserIdentity{{Provider: "github"}},
+					},
+				},
+			},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name:    "ErrInvalidIdentity",
+			reqBody: reqBodyWithIdentities,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucInWithIdentities).Return(nil, usecase2.ErrInvalidIdentity)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "identity provider must be one of github, gitlab, email",
+		},
+		{
+			name:    "ErrIdentityAlreadyLinked",
+			reqBody: reqBodyWithIdentities,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucInWithIdentities).Return(nil, usecase2.ErrIdentityAlreadyLinked)
+			},
+			wantCode:  http.StatusConflict,
+			wantError: "identity is already linked to another user",
+		},
+		{
+			name:    "ErrSaveIdentities",
+			reqBody: reqBodyWithIdentities,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucInWithIdentities).Return(nil, usecase2.ErrSaveIdentities)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while saving user identities in db",
+		},
 		{
 			name:      "decode error",
 			reqBody:   "not json",
@@ -170,6 +313,42 @@ func TestAddTeam(t *testing.T) {
 			wantCode:  http.StatusInternalServerError,
 			wantError: "error occurred while updating existing users in db",
 		},
+		{
+			name:    "ErrSaveTeamMemberships",
+			reqBody: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrSaveTeamMemberships)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while saving team memberships in db",
+		},
+		{
+			name:    "ErrUpdateTeam",
+			reqBody: reqBodyWithParent,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucInWithParent).Return(nil, usecase2.ErrUpdateTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while updating team in db",
+		},
+		{
+			name:    "ErrParentTeamNotFound",
+			reqBody: reqBodyWithParent,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucInWithParent).Return(nil, usecase2.ErrParentTeamNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "parent team not found",
+		},
+		{
+			name:    "ErrTeamHierarchyCycle",
+			reqBody: reqBodyWithParent,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucInWithParent).Return(nil, usecase2.ErrTeamHierarchyCycle)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "team cannot be nested under itself or its subteam",
+		},
 		{
 			name:    "unknown error",
 			reqBody: reqBody,
@@ -0,0 +1,12 @@
+package code_owners_upload
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/code_owners_upload"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=code_owners_upload usecase
+type usecase interface {
+	Run(ctx context.Context, req code_owners_upload.In) (*code_owners_upload.Out, error)
+}
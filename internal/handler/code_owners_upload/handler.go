@@ -0,0 +1,114 @@
+package code_owners_upload
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/code_owners_upload"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type uploadCodeOwnersHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *uploadCodeOwnersHandler {
+	return &uploadCodeOwnersHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Upload CODEOWNERS
+// @Description Replace the CODEOWNERS file of the repository. Rules follow GitHub syntax and precedence,
+// @Description every owner must be a known user, email identity or team, otherwise the file is rejected.
+// @ID UploadCodeOwners
+// @Tags CodeOwners
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostCodeOwnersUploadJSONRequestBody true "CODEOWNERS file"
+// @Success 200 {object} handler2.CodeOwnersResponse "Parsed rules"
+// @Failure 400 {object} handler2.ErrorResponse "Bad request"
+// @Failure 401 {object} handler2.ErrorResponse "Unauthorized"
+// @Failure 422 {object} handler2.ErrorResponse "Invalid file or unknown owners"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /codeOwners/upload [post]
+func (h *uploadCodeOwnersHandler) UploadCodeOwners(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostCodeOwnersUploadJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	result, err := h.usecase.Run(ctx, code_owners_upload.In{
+		Repository: request.Repository,
+		Content:    request.Content,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.CodeOwnersResponse{
+		Repository: result.CodeOwners.Repository,
+		Rules: func() []handler2.CodeOwnersRule {
+			rules := make([]handler2.CodeOwnersRule, 0, len(result.CodeOwners.Rules))
+			for _, rule := range result.CodeOwners.Rules {
+				rules = append(rules, handler2.CodeOwnersRule{
+					Line:    rule.Line,
+					Pattern: rule.Pattern,
+					Owners:  rule.Owners,
+				})
+			}
+			return rules
+		}(),
+		UpdatedAt: result.CodeOwners.UpdatedAt,
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *uploadCodeOwnersHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrInvalidCodeOwners):
+		errorMsg = "invalid codeowners file"
+		statusCode = http.StatusUnprocessableEntity
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrCodeOwnerNotFound):
+		errorMsg = "unknown code owners"
+		statusCode = http.StatusUnprocessableEntity
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrGetIdentities):
+		errorMsg = "error occurred while resolving owner identity"
+	case errors.Is(err, usecase2.ErrGetUsers):
+		errorMsg = "error occurred while getting users"
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting team"
+	case errors.Is(err, usecase2.ErrSaveCodeOwners):
+		errorMsg = "error occurred while saving code owners"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,162 @@
+package code_owners_upload_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerCodeOwners "pr-reviewers-service/internal/handler/code_owners_upload"
+	mockCodeOwners "pr-reviewers-service/internal/handler/code_owners_upload/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseCodeOwners "pr-reviewers-service/internal/usecase/code_owners_upload"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadCodeOwners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockCodeOwners.NewMockusecase(ctrl)
+	h := handlerCodeOwners.New(mockUC, validate)
+
+	content := "*.go @alice\n/docs/ @org/docs\n"
+	updatedAt := time.Date(2025, 12, 14, 10, 0, 0, 0, time.UTC)
+	reqBody := handler2.PostCodeOwnersUploadJSONRequestBody{Repository: "org/service", Content: content}
+	ucIn := usecaseCodeOwners.In{Repository: "org/service", Content: content}
+	ucOut := usecaseCodeOwners.Out{CodeOwners: usecase2.CodeOwners{
+		Repository: "org/service",
+		Rules: []usecase2.CodeOwnersRule{
+			{Line: 1, Pattern: "*.go", Owners: []string{"@alice"}},
+			{Line: 2, Pattern: "/docs/", Owners: []string{"@org/docs"}},
+		},
+		UpdatedAt: updatedAt,
+	}}
+
+	tests := []struct {
+		name      string
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.CodeOwnersResponse{
+				Repository: "org/service",
+				Rules: []handler2.CodeOwnersRule{
+					{Line: 1, Pattern: "*.go", Owners: []string{"@alice"}},
+					{Line: 2, Pattern: "/docs/", Owners: []string{"@org/docs"}},
+				},
+				UpdatedAt: updatedAt,
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "validation failed - empty content",
+			body:      handler2.PostCodeOwnersUploadJSONRequestBody{Repository: "org/service"},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrInvalidCodeOwners",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrInvalidCodeOwners)
+			},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "invalid codeowners file",
+		},
+		{
+			name: "usecase returns ErrCodeOwnerNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrCodeOwnerNotFound)
+			},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "unknown code owners",
+		},
+		{
+			name: "usecase returns ErrSaveCodeOwners",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrSaveCodeOwners)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while saving code owners",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/codeOwners/upload", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.UploadCodeOwners(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.CodeOwnersResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
@@ -2,13 +2,14 @@ package dummy_login
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"math"
 	"net/http"
 	"time"
 
 	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
 	"pr-reviewers-service/internal/handler"
-	"pr-reviewers-service/internal/handler/middleware"
 	"pr-reviewers-service/internal/jwt"
 	"pr-reviewers-service/internal/logging"
 
@@ -37,25 +38,43 @@ func New(secret string, validator *validator.Validate) *createHandler {
 }
 
 // @Summary Dummy login
-// @Description Get JWT token for testing purposes
+// @Description Get JWT token for testing purposes. Without a body or with an empty one the token is for ADMIN with a random id,
+// @Description with a body it is for the given role and user.
 // @ID DummyLogin
 // @Tags User
 // @Accept json
 // @Produce json
+// @Param input body handler2.DummyLoginRequest false "Role and user of the token"
 // @Success 200 {object} handler2.DummyLoginOut "Successfully logged in"
+// @Failure 400 {object} handler2.ErrorResponse "Bad request"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
 // @Failure 500 {object} handler2.ErrorResponse "Internal server error"
 // @Router /dummyLogin [post]
 func (h *createHandler) DummyLogin(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	ctx := r.Context()
 
-	token, err := jwt.GenerateToken(h.secret, string(middleware.Admin), dummyId, expIn)
+	var request handler2.DummyLoginRequest
+	switch err := json.NewDecoder(r.Body).Decode(&request); {
+	case errors.Is(err, io.EOF), err == nil && request == handler2.DummyLoginRequest{}:
+		request = handler2.DummyLoginRequest{Role: handler2.ADMIN, UserId: dummyId}
+	case err != nil:
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	default:
+		if err = h.validator.Struct(request); err != nil {
+			handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+			return
+		}
+	}
+
+	token, err := jwt.GenerateToken(h.secret, string(request.Role), request.UserId, expIn)
 	if err != nil {
 		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "generate token failed", err)
 		return
 	}
 
-	ctx = logging.WithLogRole(ctx, string(middleware.Admin))
+	ctx = logging.WithLogRole(ctx, string(request.Role))
 	out := handler2.DummyLoginOut{
 		Token: token,
 	}
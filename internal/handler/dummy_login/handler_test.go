@@ -4,12 +4,15 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
 	"pr-reviewers-service/internal/handler/dummy_login"
+	"pr-reviewers-service/internal/jwt"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -30,3 +33,59 @@ func TestDummyLogin_Success(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, resp.Token)
 }
+
+func TestDummyLogin_EmptyBody(t *testing.T) {
+	validate := validator.New()
+	handler := dummy_login.New("secret123", validate)
+
+	req := httptest.NewRequest("POST", "/dummyLogin", strings.NewReader("{}"))
+	w := httptest.NewRecorder()
+
+	handler.DummyLogin(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp handler2.DummyLoginOut
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	role, _, err := jwt.ParseToken(resp.Token, "secret123")
+	require.NoError(t, err)
+	assert.Equal(t, "ADMIN", role)
+}
+
+func TestDummyLogin_UserToken(t *testing.T) {
+	validate := validator.New()
+	handler := dummy_login.New("secret123", validate)
+	userID := uuid.New()
+
+	tests := []struct {
+		name     string
+		body     string
+		wantCode int
+	}{
+		{name: "user token", body: `{"role":"USER","user_id":"` + userID.String() + `"}`, wantCode: http.StatusOK},
+		{name: "invalid JSON", body: "invalid-json", wantCode: http.StatusBadRequest},
+		{name: "unknown role", body: `{"role":"OWNER","user_id":"` + userID.String() + `"}`, wantCode: http.StatusUnprocessableEntity},
+		{name: "missing user_id", body: `{"role":"USER"}`, wantCode: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/dummyLogin", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			handler.DummyLogin(w, req)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+
+			var resp handler2.DummyLoginOut
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			role, id, err := jwt.ParseToken(resp.Token, "secret123")
+			require.NoError(t, err)
+			assert.Equal(t, "USER", role)
+			assert.Equal(t, userID, id)
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package find_user_by_identity
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/find_user_by_identity"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=find_user_by_identity usecase
+type usecase interface {
+	Run(ctx context.Context, req find_user_by_identity.In) (*find_user_by_identity.Out, error)
+}
@@ -0,0 +1,94 @@
+package find_user_by_identity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/find_user_by_identity"
+)
+
+type findUserByIdentityHandler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *findUserByIdentityHandler {
+	return &findUserByIdentityHandler{
+		usecase: usecase,
+	}
+}
+
+// @Summary Find user by external identity
+// @Description Find the user a GitHub/GitLab login or an email is linked to
+// @ID FindUserByIdentity
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param provider query string true "Identity provider: github, gitlab or email"
+// @Param external_id query string true "Login or email in the provider"
+// @Success 200 {object} handler2.FindUserByIdentityResponse "User the identity is linked to"
+// @Failure 400 {object} handler2.ErrorResponse "Unknown provider or empty external_id"
+// @Failure 404 {object} handler2.ErrorResponse "Identity is not linked to any user"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /users/findByIdentity [get]
+func (h *findUserByIdentityHandler) FindUserByIdentity(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	query := r.URL.Query()
+	result, err := h.usecase.Run(ctx, find_user_by_identity.In{
+		Provider:   query.Get("provider"),
+		ExternalID: query.Get("external_id"),
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.FindUserByIdentityResponse{
+		User: handler2.User{
+			UserId:   result.UserID,
+			Username: result.Username,
+			TeamName: result.TeamName,
+			IsActive: result.IsActive,
+		},
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *findUserByIdentityHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrInvalidIdentity):
+		errorMsg = "provider must be one of github, gitlab, email and external_id must not be empty"
+		statusCode = http.StatusBadRequest
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrIdentityNotFound):
+		errorMsg = "identity is not linked to any user"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		errorMsg = "user not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrGetIdentities):
+		errorMsg = "error occurred while getting user identity"
+	case errors.Is(err, usecase2.ErrGetUser):
+		errorMsg = "error occurred while getting user from db"
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting users team"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
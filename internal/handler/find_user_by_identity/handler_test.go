@@ -0,0 +1,142 @@
+package find_user_by_identity_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler "pr-reviewers-service/internal/generated/api/v1/handler"
+	find_user_handler "pr-reviewers-service/internal/handler/find_user_by_identity"
+	mock_find "pr-reviewers-service/internal/handler/find_user_by_identity/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/find_user_by_identity"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindUserByIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mock_find.NewMockusecase(ctrl)
+	h := find_user_handler.New(mockUC)
+
+	userID := uuid.New()
+	query := "?provider=github&external_id=alice"
+	ucIn := usecase.In{Provider: "github", ExternalID: "alice"}
+
+	ucOut := usecase.Out{
+		UserID:   userID,
+		Username: "Alice",
+		TeamName: "backend",
+		IsActive: true,
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.FindUserByIdentityResponse
+	}{
+		{
+			name:  "success",
+			query: query,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.FindUserByIdentityResponse{
+				User: handler.User{
+					UserId:   userID,
+					Username: "Alice",
+					TeamName: "backend",
+					IsActive: true,
+				},
+			},
+		},
+		{
+			name:  "missing external_id",
+			query: "?provider=github",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{Provider: "github"}).Return(nil, usecase2.ErrInvalidIdentity)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "provider must be one of github, gitlab, email",
+		},
+		{
+			name:  "usecase returns ErrIdentityNotFound",
+			query: query,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrIdentityNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "identity is not linked to any user",
+		},
+		{
+			name:  "usecase returns ErrGetIdentities",
+			query: query,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrGetIdentities)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting user identity",
+		},
+		{
+			name:  "usecase returns ErrGetTeam",
+			query: query,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrGetTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting users team",
+		},
+		{
+			name:  "usecase returns unknown error",
+			query: query,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			req := httptest.NewRequest("GET", "/users/findByIdentity"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.FindUserByIdentity(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+
+			if tt.wantSuccess != nil {
+				var got handler.FindUserByIdentityResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got)
+				return
+			}
+
+			if tt.wantError != "" {
+				var got struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Contains(t, got.Error.Message, tt.wantError)
+			}
+		})
+	}
+}
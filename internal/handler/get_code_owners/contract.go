@@ -0,0 +1,12 @@
+package get_code_owners
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/get_code_owners"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=get_code_owners usecase
+type usecase interface {
+	Run(ctx context.Context, req get_code_owners.In) (*get_code_owners.Out, error)
+}
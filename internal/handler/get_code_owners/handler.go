@@ -0,0 +1,94 @@
+package get_code_owners
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/get_code_owners"
+)
+
+type getCodeOwnersHandler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *getCodeOwnersHandler {
+	return &getCodeOwnersHandler{
+		usecase: usecase,
+	}
+}
+
+// @Summary Get CODEOWNERS
+// @Description Get the CODEOWNERS rules uploaded for the repository in the file order
+// @ID GetCodeOwners
+// @Tags CodeOwners
+// @Accept json
+// @Produce json
+// @Param repository query string true "Repository, e.g. org/service"
+// @Success 200 {object} handler2.CodeOwnersResponse "Uploaded rules"
+// @Failure 400 {object} handler2.ErrorResponse "Missing repository"
+// @Failure 404 {object} handler2.ErrorResponse "Nothing uploaded for the repository"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /codeOwners/get [get]
+func (h *getCodeOwnersHandler) GetCodeOwners(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	repository := r.URL.Query().Get("repository")
+	if repository == "" {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "repository is required", nil)
+		return
+	}
+
+	result, err := h.usecase.Run(ctx, get_code_owners.In{
+		Repository: repository,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.CodeOwnersResponse{
+		Repository: result.CodeOwners.Repository,
+		Rules: func() []handler2.CodeOwnersRule {
+			rules := make([]handler2.CodeOwnersRule, 0, len(result.CodeOwners.Rules))
+			for _, rule := range result.CodeOwners.Rules {
+				rules = append(rules, handler2.CodeOwnersRule{
+					Line:    rule.Line,
+					Pattern: rule.Pattern,
+					Owners:  rule.Owners,
+				})
+			}
+			return rules
+		}(),
+		UpdatedAt: result.CodeOwners.UpdatedAt,
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *getCodeOwnersHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrCodeOwnersNotFound):
+		errorMsg = "code owners not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrGetCodeOwners):
+		errorMsg = "error occurred while getting code owners"
+	case errors.Is(err, usecase2.ErrInvalidCodeOwners):
+		errorMsg = "stored code owners are invalid"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,130 @@
+package get_code_owners_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handler "pr-reviewers-service/internal/generated/api/v1/handler"
+	get_code_owners_handler "pr-reviewers-service/internal/handler/get_code_owners"
+	mock_code_owners "pr-reviewers-service/internal/handler/get_code_owners/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/get_code_owners"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCodeOwners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mock_code_owners.NewMockusecase(ctrl)
+	h := get_code_owners_handler.New(mockUC)
+
+	updatedAt := time.Date(2025, 12, 14, 10, 0, 0, 0, time.UTC)
+	ucIn := usecase.In{Repository: "org/service"}
+	ucOut := usecase.Out{CodeOwners: usecase2.CodeOwners{
+		Repository: "org/service",
+		Rules: []usecase2.CodeOwnersRule{
+			{Line: 1, Pattern: "*", Owners: []string{"@org/backend"}},
+			{Line: 3, Pattern: "/docs/legacy/", Owners: []string{}},
+		},
+		UpdatedAt: updatedAt,
+	}}
+
+	tests := []struct {
+		name        string
+		query       string
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.CodeOwnersResponse
+	}{
+		{
+			name:  "success",
+			query: "?repository=org/service",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.CodeOwnersResponse{
+				Repository: "org/service",
+				Rules: []handler.CodeOwnersRule{
+					{Line: 1, Pattern: "*", Owners: []string{"@org/backend"}},
+					{Line: 3, Pattern: "/docs/legacy/", Owners: []string{}},
+				},
+				UpdatedAt: updatedAt,
+			},
+		},
+		{
+			name:      "missing repository",
+			query:     "",
+			wantCode:  http.StatusBadRequest,
+			wantError: "repository is required",
+		},
+		{
+			name:  "usecase returns ErrCodeOwnersNotFound",
+			query: "?repository=org/service",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrCodeOwnersNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "code owners not found",
+		},
+		{
+			name:  "usecase returns ErrGetCodeOwners",
+			query: "?repository=org/service",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrGetCodeOwners)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting code owners",
+		},
+		{
+			name:  "usecase returns unknown error",
+			query: "?repository=org/service",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			req := httptest.NewRequest("GET", "/codeOwners/get"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.GetCodeOwners(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+
+			if tt.wantSuccess != nil {
+				var got handler.CodeOwnersResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got)
+				return
+			}
+
+			if tt.wantError != "" {
+				var got struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Contains(t, got.Error.Message, tt.wantError)
+			}
+		})
+	}
+}
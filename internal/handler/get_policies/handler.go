@@ -0,0 +1,53 @@
+package get_policies
+
+import (
+	"encoding/json"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/handler/middleware"
+)
+
+type getPoliciesHandler struct {
+	policy middleware.Policy
+}
+
+func New(policy middleware.Policy) *getPoliciesHandler {
+	return &getPoliciesHandler{
+		policy: policy,
+	}
+}
+
+// @Summary Get route policies
+// @Description Get the roles allowed to call every route that needs a token: the defaults with the overrides from app.policies.
+// @ID GetPolicies
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} handler2.PoliciesResponse "Effective policies"
+// @Failure 401 {object} handler2.ErrorResponse "Unauthorized"
+// @Failure 403 {object} handler2.ErrorResponse "Forbidden"
+// @Router /admin/policies [get]
+func (h *getPoliciesHandler) GetPolicies(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	routes := h.policy.Routes()
+	out := handler2.PoliciesResponse{
+		Policies: make([]handler2.RoutePolicy, 0, len(routes)),
+	}
+	for _, route := range routes {
+		roles := make([]string, 0, len(h.policy[route]))
+		for _, role := range h.policy[route] {
+			roles = append(roles, string(role))
+		}
+		out.Policies = append(out.Policies, handler2.RoutePolicy{
+			Route: route,
+			Roles: roles,
+		})
+	}
+
+	if err := json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, r.Context(), http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
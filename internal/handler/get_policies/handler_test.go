@@ -0,0 +1,40 @@
+package get_policies_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler/get_policies"
+	"pr-reviewers-service/internal/handler/middleware"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPolicies(t *testing.T) {
+	h := get_policies.New(middleware.Policy{
+		"POST /api/v1/team/add":                 {middleware.Admin},
+		"GET /api/v1/team/get":                  {middleware.Admin, middleware.User},
+		"/pr_reviewers.v1.PRReviewersService/A": {middleware.User},
+	})
+
+	req := httptest.NewRequest("GET", "/admin/policies", nil)
+	w := httptest.NewRecorder()
+
+	h.GetPolicies(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var resp handler2.PoliciesResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, handler2.PoliciesResponse{
+		Policies: []handler2.RoutePolicy{
+			{Route: "/pr_reviewers.v1.PRReviewersService/A", Roles: []string{"USER"}},
+			{Route: "GET /api/v1/team/get", Roles: []string{"ADMIN", "USER"}},
+			{Route: "POST /api/v1/team/add", Roles: []string{"ADMIN"}},
+		},
+	}, resp)
+}
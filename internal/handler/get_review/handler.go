@@ -8,6 +8,7 @@ import (
 
 	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
 	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/handler/middleware"
 	"pr-reviewers-service/internal/logging"
 	usecase2 "pr-reviewers-service/internal/usecase"
 	"pr-reviewers-service/internal/usecase/get_review"
@@ -37,6 +38,7 @@ func New(usecase usecase, validator *validator.Validate) *getUserReviewPRsHandle
 // @Param user_id query string true "User ID" format(uuid)
 // @Success 200 {object} handler2.GetUserReviewPRsResponse "Successfully retrieved pull requests"
 // @Failure 400 {object} handler2.ErrorResponse "Missing or invalid user_id"
+// @Failure 403 {object} handler2.ErrorResponse "Users can read only their own review queue"
 // @Failure 404 {object} handler2.ErrorResponse "User not found or no active reviewers"
 // @Failure 500 {object} handler2.ErrorResponse "Internal server error"
 // @Router /user/review [get]
@@ -55,6 +57,11 @@ func (h *getUserReviewPRsHandler) GetUserReviewPRs(w http.ResponseWriter, r *htt
 		return
 	}
 
+	if !middleware.CanActAs(ctx, userID) {
+		middleware.RespondForbidden(w, ctx)
+		return
+	}
+
 	ctx = logging.WithLogUserId(ctx, userID)
 
 	result, err := h.usecase.Run(ctx, get_review.In{
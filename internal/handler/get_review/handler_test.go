@@ -10,6 +10,7 @@ import (
 	handler "pr-reviewers-service/internal/generated/api/v1/handler"
 	get_review_handler "pr-reviewers-service/internal/handler/get_review"
 	mock_review "pr-reviewers-service/internal/handler/get_review/mocks"
+	"pr-reviewers-service/internal/handler/middleware"
 	usecase2 "pr-reviewers-service/internal/usecase"
 	usecase "pr-reviewers-service/internal/usecase/get_review"
 
@@ -49,6 +50,7 @@ func TestGetUserReviewPRs(t *testing.T) {
 
 	tests := []struct {
 		name        string
+		principal   *middleware.Principal
 		query       string
 		mock        func()
 		wantCode    int
@@ -160,6 +162,23 @@ func TestGetUserReviewPRs(t *testing.T) {
 			wantCode:  http.StatusInternalServerError,
 			wantError: "internal server error",
 		},
+		{
+			name:      "user reads someone else's queue",
+			principal: &middleware.Principal{UserID: uAuthor, Role: middleware.User},
+			query:     "?user_id=" + u1.String(),
+			mock:      func() {},
+			wantCode:  http.StatusForbidden,
+			wantError: "insufficient permissions",
+		},
+		{
+			name:      "admin reads someone else's queue",
+			principal: &middleware.Principal{UserID: uAuthor, Role: middleware.Admin},
+			query:     "?user_id=" + u1.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+		},
 	}
 
 	for _, tt := range tests {
@@ -169,6 +188,9 @@ func TestGetUserReviewPRs(t *testing.T) {
 			}
 
 			req := httptest.NewRequest("GET", "/review"+tt.query, nil)
+			if tt.principal != nil {
+				req = req.WithContext(middleware.WithPrincipal(req.Context(), *tt.principal))
+			}
 			w := httptest.NewRecorder()
 
 			h.GetUserReviewPRs(w, req)
@@ -73,6 +73,9 @@ func (h *getTeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
 			return members
 		}(),
 	}
+	if result.ParentTeamName != "" {
+		out.ParentTeamName = &result.ParentTeamName
+	}
 	if err = json.NewEncoder(w).Encode(out); err != nil {
 		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
 		return
@@ -0,0 +1,12 @@
+package get_team_list
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/get_team_list"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=get_team_list usecase
+type usecase interface {
+	Run(ctx context.Context, req get_team_list.In) (*get_team_list.Out, error)
+}
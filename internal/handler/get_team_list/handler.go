@@ -0,0 +1,113 @@
+package get_team_list
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/get_team_list"
+)
+
+type getTeamListHandler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *getTeamListHandler {
+	return &getTeamListHandler{
+		usecase: usecase,
+	}
+}
+
+// @Summary List teams
+// @Description List not archived teams ordered by name with member counts, open PRs
+// @Description and average open reviews per active member. Supports name prefix search and pagination.
+// @ID ListTeams
+// @Tags Teams
+// @Produce json
+// @Param name_prefix query string false "Team name prefix, case insensitive"
+// @Param limit query int false "Page size, 1-100" default(20)
+// @Param offset query int false "Number of teams to skip" default(0)
+// @Success 200 {object} handler2.ListTeamsResponse "Teams page"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid limit or offset"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /team/list [get]
+func (h *getTeamListHandler) ListTeams(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	limit := get_team_list.DefaultLimit
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > get_team_list.MaxLimit {
+			handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST,
+				"limit must be an integer between 1 and "+strconv.Itoa(get_team_list.MaxLimit), err)
+			return
+		}
+		limit = parsed
+	}
+
+	offset := 0
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsed, err := strconv.Atoi(offsetStr)
+		if err != nil || parsed < 0 {
+			handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST,
+				"offset must be a non-negative integer", err)
+			return
+		}
+		offset = parsed
+	}
+
+	result, err := h.usecase.Run(ctx, get_team_list.In{
+		NamePrefix: strings.TrimSpace(query.Get("name_prefix")),
+		Limit:      limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	items := make([]handler2.TeamListItem, 0, len(result.Teams))
+	for _, team := range result.Teams {
+		items = append(items, handler2.TeamListItem{
+			TeamName:                      team.TeamName,
+			MembersCount:                  team.MembersCount,
+			ActiveMembersCount:            team.ActiveMembersCount,
+			OpenPullRequestsCount:         team.OpenPullRequestsCount,
+			AvgOpenReviewsPerActiveMember: team.AvgOpenReviewsPerActiveMember,
+		})
+	}
+
+	out := handler2.ListTeamsResponse{
+		Teams:  items,
+		Total:  result.Total,
+		Limit:  result.Limit,
+		Offset: result.Offset,
+	}
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting teams"
+	case errors.Is(err, usecase2.ErrGetTeamsLoad):
+		errorMsg = "error occurred while getting teams load"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
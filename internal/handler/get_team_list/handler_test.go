@@ -0,0 +1,169 @@
+package get_team_list_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler "pr-reviewers-service/internal/generated/api/v1/handler"
+	get_team_list_handler "pr-reviewers-service/internal/handler/get_team_list"
+	mock_get_team_list "pr-reviewers-service/internal/handler/get_team_list/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/get_team_list"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTeams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mock_get_team_list.NewMockusecase(ctrl)
+	h := get_team_list_handler.New(mockUC)
+
+	tests := []struct {
+		name        string
+		query       string
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.ListTeamsResponse
+	}{
+		{
+			name:  "success with defaults",
+			query: "",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{Limit: usecase.DefaultLimit}).Return(&usecase.Out{
+					Teams: []usecase.TeamSummary{
+						{
+							TeamName:                      "backend",
+							MembersCount:                  5,
+							ActiveMembersCount:            4,
+							OpenPullRequestsCount:         3,
+							AvgOpenReviewsPerActiveMember: 1.5,
+						},
+					},
+					Total: 1,
+					Limit: usecase.DefaultLimit,
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.ListTeamsResponse{
+				Teams: []handler.TeamListItem{
+					{
+						TeamName:                      "backend",
+						MembersCount:                  5,
+						ActiveMembersCount:            4,
+						OpenPullRequestsCount:         3,
+						AvgOpenReviewsPerActiveMember: 1.5,
+					},
+				},
+				Total: 1,
+				Limit: usecase.DefaultLimit,
+			},
+		},
+		{
+			name:  "success with prefix and pagination",
+			query: "?name_prefix=back&limit=10&offset=10",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{NamePrefix: "back", Limit: 10, Offset: 10}).
+					Return(&usecase.Out{Teams: []usecase.TeamSummary{}, Total: 3, Limit: 10, Offset: 10}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.ListTeamsResponse{
+				Teams:  []handler.TeamListItem{},
+				Total:  3,
+				Limit:  10,
+				Offset: 10,
+			},
+		},
+		{
+			name:      "invalid limit",
+			query:     "?limit=abc",
+			wantCode:  http.StatusBadRequest,
+			wantError: "limit must be an integer",
+		},
+		{
+			name:      "limit above max",
+			query:     "?limit=101",
+			wantCode:  http.StatusBadRequest,
+			wantError: "limit must be an integer",
+		},
+		{
+			name:      "zero limit",
+			query:     "?limit=0",
+			wantCode:  http.StatusBadRequest,
+			wantError: "limit must be an integer",
+		},
+		{
+			name:      "negative offset",
+			query:     "?offset=-1",
+			wantCode:  http.StatusBadRequest,
+			wantError: "offset must be a non-negative integer",
+		},
+		{
+			name:  "ErrGetTeam",
+			query: "",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{Limit: usecase.DefaultLimit}).Return(nil, usecase2.ErrGetTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting teams",
+		},
+		{
+			name:  "ErrGetTeamsLoad",
+			query: "",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{Limit: usecase.DefaultLimit}).
+					Return(nil, usecase2.ErrGetTeamsLoad)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting teams load",
+		},
+		{
+			name:  "unknown error",
+			query: "",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{Limit: usecase.DefaultLimit}).
+					Return(nil, fmt.Errorf("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			req := httptest.NewRequest("GET", "/team/list"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.ListTeams(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantSuccess != nil {
+				var got handler.ListTeamsResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got, "Response body mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantError != "" {
+				var got struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Contains(t, got.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
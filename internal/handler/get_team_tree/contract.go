@@ -0,0 +1,12 @@
+package get_team_tree
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/get_team_tree"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=get_team_tree usecase
+type usecase interface {
+	Run(ctx context.Context, req get_team_tree.In) (*get_team_tree.Out, error)
+}
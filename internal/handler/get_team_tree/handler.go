@@ -0,0 +1,89 @@
+package get_team_tree
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/get_team_tree"
+)
+
+type getTeamTreeHandler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *getTeamTreeHandler {
+	return &getTeamTreeHandler{
+		usecase: usecase,
+	}
+}
+
+// @Summary Get team hierarchy
+// @Description Get all root teams with their nested subteams, or only the subtree of team_name when it is given
+// @ID GetTeamTree
+// @Tags Teams
+// @Produce json
+// @Param team_name query string false "Root team name"
+// @Success 200 {object} handler2.TeamTreeResponse "Team tree"
+// @Failure 404 {object} handler2.ErrorResponse "Team not found"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /team/tree [get]
+func (h *getTeamTreeHandler) GetTeamTree(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	teamName := strings.TrimSpace(r.URL.Query().Get("team_name"))
+	if teamName != "" {
+		ctx = logging.WithLogTeamName(ctx, teamName)
+	}
+
+	result, err := h.usecase.Run(ctx, get_team_tree.In{
+		TeamName: teamName,
+	})
+	if err != nil {
+		handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.TeamTreeResponse{
+		Teams: toTeamTreeNodes(result.Teams),
+	}
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func toTeamTreeNodes(nodes []get_team_tree.TeamNode) []handler2.TeamTreeNode {
+	out := make([]handler2.TeamTreeNode, 0, len(nodes))
+	for _, node := range nodes {
+		out = append(out, handler2.TeamTreeNode{
+			TeamName: node.TeamName,
+			Subteams: toTeamTreeNodes(node.Subteams),
+		})
+	}
+	return out
+}
+
+func handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting teams"
+	case errors.Is(err, usecase2.ErrTeamNotFound):
+		errorMsg = "there is no team looking for"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
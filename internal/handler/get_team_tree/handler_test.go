@@ -0,0 +1,142 @@
+package get_team_tree_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler "pr-reviewers-service/internal/generated/api/v1/handler"
+	get_team_tree_handler "pr-reviewers-service/internal/handler/get_team_tree"
+	mock_get_team_tree "pr-reviewers-service/internal/handler/get_team_tree/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/get_team_tree"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTeamTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mock_get_team_tree.NewMockusecase(ctrl)
+	h := get_team_tree_handler.New(mockUC)
+
+	ucOut := usecase.Out{
+		Teams: []usecase.TeamNode{
+			{
+				TeamName: "platform",
+				Subteams: []usecase.TeamNode{
+					{TeamName: "platform-db", Subteams: []usecase.TeamNode{}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.TeamTreeResponse
+	}{
+		{
+			name:  "success whole tree",
+			query: "",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{}).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.TeamTreeResponse{
+				Teams: []handler.TeamTreeNode{
+					{
+						TeamName: "platform",
+						Subteams: []handler.TeamTreeNode{
+							{TeamName: "platform-db", Subteams: []handler.TeamTreeNode{}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "success subtree",
+			query: "?team_name=platform",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{TeamName: "platform"}).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.TeamTreeResponse{
+				Teams: []handler.TeamTreeNode{
+					{
+						TeamName: "platform",
+						Subteams: []handler.TeamTreeNode{
+							{TeamName: "platform-db", Subteams: []handler.TeamTreeNode{}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "ErrTeamNotFound",
+			query: "?team_name=unknown",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{TeamName: "unknown"}).Return(nil, usecase2.ErrTeamNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "there is no team looking for",
+		},
+		{
+			name:  "ErrGetTeam",
+			query: "",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{}).Return(nil, usecase2.ErrGetTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting teams",
+		},
+		{
+			name:  "unknown error",
+			query: "",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{}).Return(nil, fmt.Errorf("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			req := httptest.NewRequest("GET", "/team/tree"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.GetTeamTree(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantSuccess != nil {
+				var got handler.TeamTreeResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got, "Response body mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantError != "" {
+				var got struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Contains(t, got.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
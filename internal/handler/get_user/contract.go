@@ -0,0 +1,12 @@
+package get_user
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/get_user"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=get_user usecase
+type usecase interface {
+	Run(ctx context.Context, req get_user.In) (*get_user.Out, error)
+}
@@ -0,0 +1,117 @@
+package get_user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/get_user"
+
+	"github.com/google/uuid"
+)
+
+type getUserHandler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *getUserHandler {
+	return &getUserHandler{
+		usecase: usecase,
+	}
+}
+
+// @Summary Get user profile
+// @Description Get user with primary and additional teams and a workload summary:
+// @Description open reviews, authored open PRs and reviews completed in the last 30 days.
+// @ID GetUser
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param user_id query string true "User ID" format(uuid)
+// @Success 200 {object} handler2.GetUserResponse "User found successfully"
+// @Failure 400 {object} handler2.ErrorResponse "Missing or invalid user_id"
+// @Failure 404 {object} handler2.ErrorResponse "User not found"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /users/get [get]
+func (h *getUserHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	userIDStr := r.URL.Query().Get("user_id")
+	if userIDStr == "" {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "user_id is required", nil)
+		return
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "invalid user_id format", err)
+		return
+	}
+
+	ctx = logging.WithLogUserId(ctx, userID)
+
+	result, err := h.usecase.Run(ctx, get_user.In{
+		UserID: userID,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.GetUserResponse{
+		UserId:           result.UserID,
+		Username:         result.Username,
+		IsActive:         result.IsActive,
+		IsAvailable:      result.IsAvailable,
+		UnavailableUntil: result.UnavailableUntil,
+		TeamName:         result.TeamName,
+		Teams: func() []handler2.UserTeamMembership {
+			teams := make([]handler2.UserTeamMembership, 0, len(result.Teams))
+			for _, team := range result.Teams {
+				teams = append(teams, handler2.UserTeamMembership{
+					TeamName:  team.TeamName,
+					IsPrimary: team.IsPrimary,
+				})
+			}
+			return teams
+		}(),
+		Workload: handler2.UserWorkload{
+			OpenReviews:                result.Workload.OpenReviews,
+			AuthoredOpenPullRequests:   result.Workload.AuthoredOpenPullRequests,
+			CompletedReviewsLast30Days: result.Workload.CompletedReviews,
+		},
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *getUserHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetUser):
+		errorMsg = "error occurred while getting user from db"
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting team"
+	case errors.Is(err, usecase2.ErrGetTeamMemberships):
+		errorMsg = "error occurred while getting team memberships"
+	case errors.Is(err, usecase2.ErrGetWorkload):
+		errorMsg = "error occurred while getting user workload"
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		errorMsg = "user not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
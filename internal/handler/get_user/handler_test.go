@@ -0,0 +1,166 @@
+package get_user_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handler "pr-reviewers-service/internal/generated/api/v1/handler"
+	get_user_handler "pr-reviewers-service/internal/handler/get_user"
+	mock_user "pr-reviewers-service/internal/handler/get_user/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/get_user"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mock_user.NewMockusecase(ctrl)
+	h := get_user_handler.New(mockUC)
+
+	userID := uuid.New()
+	ucIn := usecase.In{UserID: userID}
+
+	unavailableUntil := time.Date(2025, 12, 22, 9, 0, 0, 0, time.UTC)
+	ucOut := usecase.Out{
+		UserID:           userID,
+		Username:         "Alice",
+		IsActive:         true,
+		UnavailableUntil: &unavailableUntil,
+		TeamName:         "backend",
+		Teams: []usecase.TeamMembership{
+			{TeamName: "backend", IsPrimary: true},
+			{TeamName: "platform", IsPrimary: false},
+		},
+		Workload: usecase.Workload{
+			OpenReviews:              3,
+			AuthoredOpenPullRequests: 1,
+			CompletedReviews:         5,
+		},
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.GetUserResponse
+	}{
+		{
+			name:  "success",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.GetUserResponse{
+				UserId:           userID,
+				Username:         "Alice",
+				IsActive:         true,
+				UnavailableUntil: &unavailableUntil,
+				TeamName:         "backend",
+				Teams: []handler.UserTeamMembership{
+					{TeamName: "backend", IsPrimary: true},
+					{TeamName: "platform", IsPrimary: false},
+				},
+				Workload: handler.UserWorkload{
+					OpenReviews:                3,
+					AuthoredOpenPullRequests:   1,
+					CompletedReviewsLast30Days: 5,
+				},
+			},
+		},
+		{
+			name:      "missing user_id",
+			query:     "",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "user_id is required",
+		},
+		{
+			name:      "invalid user_id",
+			query:     "?user_id=not-a-uuid",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "invalid user_id format",
+		},
+		{
+			name:  "usecase returns ErrUserNotFound",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUserNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "user not found",
+		},
+		{
+			name:  "usecase returns ErrGetTeamMemberships",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrGetTeamMemberships)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting team memberships",
+		},
+		{
+			name:  "usecase returns ErrGetWorkload",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrGetWorkload)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting user workload",
+		},
+		{
+			name:  "usecase returns unknown error",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			req := httptest.NewRequest("GET", "/users/get"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.GetUser(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+
+			if tt.wantSuccess != nil {
+				var got handler.GetUserResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got)
+				return
+			}
+
+			if tt.wantError != "" {
+				var got struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Contains(t, got.Error.Message, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package get_user_identities
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/get_user_identities"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=get_user_identities usecase
+type usecase interface {
+	Run(ctx context.Context, req get_user_identities.In) (*get_user_identities.Out, error)
+}
@@ -0,0 +1,102 @@
+package get_user_identities
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/get_user_identities"
+
+	"github.com/google/uuid"
+)
+
+type getUserIdentitiesHandler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *getUserIdentitiesHandler {
+	return &getUserIdentitiesHandler{
+		usecase: usecase,
+	}
+}
+
+// @Summary Get user identities
+// @Description Get GitHub/GitLab logins and emails linked to the user
+// @ID GetUserIdentities
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param user_id query string true "User ID" format(uuid)
+// @Success 200 {object} handler2.UserIdentitiesResponse "Identities of the user"
+// @Failure 400 {object} handler2.ErrorResponse "Missing or invalid user_id"
+// @Failure 404 {object} handler2.ErrorResponse "User not found"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /users/getIdentities [get]
+func (h *getUserIdentitiesHandler) GetUserIdentities(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	userIDStr := r.URL.Query().Get("user_id")
+	if userIDStr == "" {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "user_id is required", nil)
+		return
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "invalid user_id format", err)
+		return
+	}
+
+	ctx = logging.WithLogUserId(ctx, userID)
+
+	result, err := h.usecase.Run(ctx, get_user_identities.In{
+		UserID: userID,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.UserIdentitiesResponse{
+		UserId: result.UserID,
+		Identities: func() []handler2.UserIdentity {
+			identities := make([]handler2.UserIdentity, 0, len(result.Identities))
+			for _, identity := range result.Identities {
+				identities = append(identities, handler2.UserIdentity{
+					Provider:   identity.Provider,
+					ExternalId: identity.ExternalID,
+				})
+			}
+			return identities
+		}(),
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *getUserIdentitiesHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		errorMsg = "user not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrGetUser):
+		errorMsg = "error occurred while getting user from db"
+	case errors.Is(err, usecase2.ErrGetIdentities):
+		errorMsg = "error occurred while getting user identities"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,147 @@
+package get_user_identities_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler "pr-reviewers-service/internal/generated/api/v1/handler"
+	get_user_identities_handler "pr-reviewers-service/internal/handler/get_user_identities"
+	mock_identities "pr-reviewers-service/internal/handler/get_user_identities/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/get_user_identities"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserIdentities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mock_identities.NewMockusecase(ctrl)
+	h := get_user_identities_handler.New(mockUC)
+
+	userID := uuid.New()
+	ucIn := usecase.In{UserID: userID}
+
+	ucOut := usecase.Out{
+		UserID: userID,
+		Identities: []usecase2.Identity{
+			{Provider: "email", ExternalID: "alice@example.com"},
+			{Provider: "github", ExternalID: "alice"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.UserIdentitiesResponse
+	}{
+		{
+			name:  "success",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.UserIdentitiesResponse{
+				UserId: userID,
+				Identities: []handler.UserIdentity{
+					{Provider: "email", ExternalId: "alice@example.com"},
+					{Provider: "github", ExternalId: "alice"},
+				},
+			},
+		},
+		{
+			name:  "success without identities",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&usecase.Out{UserID: userID}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.UserIdentitiesResponse{
+				UserId:     userID,
+				Identities: []handler.UserIdentity{},
+			},
+		},
+		{
+			name:      "missing user_id",
+			query:     "",
+			wantCode:  http.StatusBadRequest,
+			wantError: "user_id is required",
+		},
+		{
+			name:      "invalid user_id",
+			query:     "?user_id=not-a-uuid",
+			wantCode:  http.StatusBadRequest,
+			wantError: "invalid user_id format",
+		},
+		{
+			name:  "usecase returns ErrUserNotFound",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUserNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "user not found",
+		},
+		{
+			name:  "usecase returns ErrGetIdentities",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrGetIdentities)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting user identities",
+		},
+		{
+			name:  "usecase returns unknown error",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			req := httptest.NewRequest("GET", "/users/getIdentities"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.GetUserIdentities(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+
+			if tt.wantSuccess != nil {
+				var got handler.UserIdentitiesResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got)
+				return
+			}
+
+			if tt.wantError != "" {
+				var got struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Contains(t, got.Error.Message, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package get_webhook_deliveries
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/get_webhook_deliveries"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=get_webhook_deliveries usecase
+type usecase interface {
+	Run(ctx context.Context, req get_webhook_deliveries.In) (*get_webhook_deliveries.Out, error)
+}
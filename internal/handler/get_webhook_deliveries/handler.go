@@ -0,0 +1,134 @@
+package get_webhook_deliveries
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/get_webhook_deliveries"
+
+	"github.com/google/uuid"
+)
+
+type getWebhookDeliveriesHandler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *getWebhookDeliveriesHandler {
+	return &getWebhookDeliveriesHandler{
+		usecase: usecase,
+	}
+}
+
+// @Summary Webhook delivery log
+// @Description List webhook deliveries newest first, optionally filtered by subscription and status.
+// @ID ListWebhookDeliveries
+// @Tags Webhooks
+// @Produce json
+// @Param subscription_id query string false "Subscription ID" format(uuid)
+// @Param status query string false "Delivery status" Enums(PENDING, DELIVERED, FAILED)
+// @Param limit query int false "Page size, 1-200" default(50)
+// @Param offset query int false "Number of deliveries to skip" default(0)
+// @Success 200 {object} handler2.WebhookDeliveriesResponse "Deliveries page"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid filter, limit or offset"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /webhooks/deliveries [get]
+func (h *getWebhookDeliveriesHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	req := get_webhook_deliveries.In{
+		Limit: get_webhook_deliveries.DefaultLimit,
+	}
+
+	if subscriptionIDStr := query.Get("subscription_id"); subscriptionIDStr != "" {
+		subscriptionID, err := uuid.Parse(subscriptionIDStr)
+		if err != nil {
+			handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "invalid subscription_id format", err)
+			return
+		}
+		req.SubscriptionID = &subscriptionID
+	}
+
+	if status := query.Get("status"); status != "" {
+		switch status {
+		case usecase2.WebhookDeliveryPendingStatus, usecase2.WebhookDeliveryDeliveredStatus, usecase2.WebhookDeliveryFailedStatus:
+			req.Status = &status
+		default:
+			handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST,
+				"status must be one of PENDING, DELIVERED, FAILED", nil)
+			return
+		}
+	}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > get_webhook_deliveries.MaxLimit {
+			handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST,
+				"limit must be an integer between 1 and "+strconv.Itoa(get_webhook_deliveries.MaxLimit), err)
+			return
+		}
+		req.Limit = parsed
+	}
+
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsed, err := strconv.Atoi(offsetStr)
+		if err != nil || parsed < 0 {
+			handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST,
+				"offset must be a non-negative integer", err)
+			return
+		}
+		req.Offset = parsed
+	}
+
+	result, err := h.usecase.Run(ctx, req)
+	if err != nil {
+		handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	deliveries := make([]handler2.WebhookDelivery, 0, len(result.Deliveries))
+	for _, delivery := range result.Deliveries {
+		deliveries = append(deliveries, handler2.WebhookDelivery{
+			DeliveryId:     delivery.ID,
+			SubscriptionId: delivery.SubscriptionID,
+			EventId:        delivery.EventID,
+			Event:          delivery.Event,
+			Status:         handler2.WebhookDeliveryStatus(delivery.Status),
+			Attempts:       delivery.Attempts,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			LastError:      delivery.LastError,
+			ResponseCode:   delivery.ResponseCode,
+			CreatedAt:      delivery.CreatedAt,
+			UpdatedAt:      delivery.UpdatedAt,
+		})
+	}
+
+	out := handler2.WebhookDeliveriesResponse{
+		Deliveries: deliveries,
+		Limit:      result.Limit,
+		Offset:     result.Offset,
+	}
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	if errors.Is(err, usecase2.ErrGetWebhookDeliveries) {
+		errorMsg = "error occurred while getting webhook deliveries"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,185 @@
+package get_webhook_deliveries_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handler "pr-reviewers-service/internal/generated/api/v1/handler"
+	get_webhook_deliveries_handler "pr-reviewers-service/internal/handler/get_webhook_deliveries"
+	mock_get_webhook_deliveries "pr-reviewers-service/internal/handler/get_webhook_deliveries/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/get_webhook_deliveries"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListWebhookDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mock_get_webhook_deliveries.NewMockusecase(ctrl)
+	h := get_webhook_deliveries_handler.New(mockUC)
+
+	subscriptionID := uuid.New()
+	deliveryID := uuid.New()
+	eventID := uuid.New()
+	now := time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+	failed := usecase2.WebhookDeliveryFailedStatus
+	lastError := "unexpected webhook response status: 500"
+	responseCode := 500
+
+	tests := []struct {
+		name        string
+		query       string
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.WebhookDeliveriesResponse
+	}{
+		{
+			name:  "success with defaults",
+			query: "",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{Limit: usecase.DefaultLimit}).
+					Return(&usecase.Out{Deliveries: []usecase2.WebhookDelivery{}, Limit: usecase.DefaultLimit}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.WebhookDeliveriesResponse{
+				Deliveries: []handler.WebhookDelivery{},
+				Limit:      usecase.DefaultLimit,
+			},
+		},
+		{
+			name:  "success with filter and pagination",
+			query: "?subscription_id=" + subscriptionID.String() + "&status=FAILED&limit=10&offset=20",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					SubscriptionID: &subscriptionID,
+					Status:         &failed,
+					Limit:          10,
+					Offset:         20,
+				}).Return(&usecase.Out{
+					Deliveries: []usecase2.WebhookDelivery{
+						{
+							ID:             deliveryID,
+							SubscriptionID: subscriptionID,
+							EventID:        eventID,
+							Event:          usecase2.EventReviewerAssigned,
+							Status:         failed,
+							Attempts:       5,
+							NextAttemptAt:  now,
+							LastError:      &lastError,
+							ResponseCode:   &responseCode,
+							CreatedAt:      now,
+							UpdatedAt:      now,
+						},
+					},
+					Limit:  10,
+					Offset: 20,
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.WebhookDeliveriesResponse{
+				Deliveries: []handler.WebhookDelivery{
+					{
+						DeliveryId:     deliveryID,
+						SubscriptionId: subscriptionID,
+						EventId:        eventID,
+						Event:          usecase2.EventReviewerAssigned,
+						Status:         handler.WebhookDeliveryStatusFAILED,
+						Attempts:       5,
+						NextAttemptAt:  now,
+						LastError:      &lastError,
+						ResponseCode:   &responseCode,
+						CreatedAt:      now,
+						UpdatedAt:      now,
+					},
+				},
+				Limit:  10,
+				Offset: 20,
+			},
+		},
+		{
+			name:      "invalid subscription id",
+			query:     "?subscription_id=abc",
+			wantCode:  http.StatusBadRequest,
+			wantError: "invalid subscription_id format",
+		},
+		{
+			name:      "unknown status",
+			query:     "?status=LOST",
+			wantCode:  http.StatusBadRequest,
+			wantError: "status must be one of",
+		},
+		{
+			name:      "limit above max",
+			query:     "?limit=201",
+			wantCode:  http.StatusBadRequest,
+			wantError: "limit must be an integer",
+		},
+		{
+			name:      "negative offset",
+			query:     "?offset=-1",
+			wantCode:  http.StatusBadRequest,
+			wantError: "offset must be a non-negative integer",
+		},
+		{
+			name:  "ErrGetWebhookDeliveries",
+			query: "",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{Limit: usecase.DefaultLimit}).
+					Return(nil, usecase2.ErrGetWebhookDeliveries)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting webhook deliveries",
+		},
+		{
+			name:  "unknown error",
+			query: "",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{Limit: usecase.DefaultLimit}).
+					Return(nil, fmt.Errorf("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			req := httptest.NewRequest("GET", "/webhooks/deliveries"+tt.query, nil)
+			w := httptest.NewRecorder()
+
+			h.ListWebhookDeliveries(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantSuccess != nil {
+				var got handler.WebhookDeliveriesResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got, "Response body mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantError != "" {
+				var got struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Contains(t, got.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
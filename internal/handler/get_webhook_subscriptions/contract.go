@@ -0,0 +1,12 @@
+package get_webhook_subscriptions
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/get_webhook_subscriptions"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=get_webhook_subscriptions usecase
+type usecase interface {
+	Run(ctx context.Context) (*get_webhook_subscriptions.Out, error)
+}
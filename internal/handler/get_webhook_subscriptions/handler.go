@@ -0,0 +1,71 @@
+package get_webhook_subscriptions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+)
+
+type getWebhookSubscriptionsHandler struct {
+	usecase usecase
+}
+
+func New(usecase usecase) *getWebhookSubscriptionsHandler {
+	return &getWebhookSubscriptionsHandler{
+		usecase: usecase,
+	}
+}
+
+// @Summary List webhook subscriptions
+// @Description List webhook subscriptions, signing secrets are not returned
+// @ID ListWebhookSubscriptions
+// @Tags Webhooks
+// @Produce json
+// @Success 200 {object} handler2.WebhookSubscriptionsResponse "Subscriptions"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /webhooks/list [get]
+func (h *getWebhookSubscriptionsHandler) ListWebhookSubscriptions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	result, err := h.usecase.Run(ctx)
+	if err != nil {
+		handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	subscriptions := make([]handler2.WebhookSubscription, 0, len(result.Subscriptions))
+	for _, subscription := range result.Subscriptions {
+		subscriptions = append(subscriptions, handler2.WebhookSubscription{
+			SubscriptionId: subscription.ID,
+			Url:            subscription.URL,
+			Events:         subscription.Events,
+			CreatedAt:      subscription.CreatedAt,
+		})
+	}
+
+	out := handler2.WebhookSubscriptionsResponse{
+		Subscriptions: subscriptions,
+	}
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	if errors.Is(err, usecase2.ErrGetWebhookSubscriptions) {
+		errorMsg = "error occurred while getting webhook subscriptions"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,114 @@
+package get_webhook_subscriptions_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handler "pr-reviewers-service/internal/generated/api/v1/handler"
+	get_webhook_subscriptions_handler "pr-reviewers-service/internal/handler/get_webhook_subscriptions"
+	mock_get_webhook_subscriptions "pr-reviewers-service/internal/handler/get_webhook_subscriptions/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/get_webhook_subscriptions"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListWebhookSubscriptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mock_get_webhook_subscriptions.NewMockusecase(ctrl)
+	h := get_webhook_subscriptions_handler.New(mockUC)
+
+	subscriptionID := uuid.New()
+	createdAt := time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.WebhookSubscriptionsResponse
+	}{
+		{
+			name: "success",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any()).Return(&usecase.Out{
+					Subscriptions: []usecase2.WebhookSubscription{
+						{
+							ID:        subscriptionID,
+							URL:       "https://hooks.example.com",
+							Events:    []string{usecase2.EventReviewerAssigned},
+							CreatedAt: createdAt,
+						},
+					},
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.WebhookSubscriptionsResponse{
+				Subscriptions: []handler.WebhookSubscription{
+					{
+						SubscriptionId: subscriptionID,
+						Url:            "https://hooks.example.com",
+						Events:         []string{usecase2.EventReviewerAssigned},
+						CreatedAt:      createdAt,
+					},
+				},
+			},
+		},
+		{
+			name: "ErrGetWebhookSubscriptions",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any()).Return(nil, usecase2.ErrGetWebhookSubscriptions)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting webhook subscriptions",
+		},
+		{
+			name: "unknown error",
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any()).Return(nil, fmt.Errorf("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			req := httptest.NewRequest("GET", "/webhooks/list", nil)
+			w := httptest.NewRecorder()
+
+			h.ListWebhookSubscriptions(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantSuccess != nil {
+				var got handler.WebhookSubscriptionsResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got, "Response body mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantError != "" {
+				var got struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Contains(t, got.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
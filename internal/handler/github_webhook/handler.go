@@ -0,0 +1,193 @@
+package github_webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/pull_request_event"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+const (
+	provider = "github"
+
+	eventHeader     = "X-GitHub-Event"
+	deliveryHeader  = "X-GitHub-Delivery"
+	signatureHeader = "X-Hub-Signature-256"
+	signaturePrefix = "sha256="
+
+	pullRequestEvent = "pull_request"
+)
+
+type githubWebhookHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+	secret    []byte
+}
+
+func New(usecase usecase, validator *validator.Validate, secret string) *githubWebhookHandler {
+	return &githubWebhookHandler{
+		usecase:   usecase,
+		validator: validator,
+		secret:    []byte(secret),
+	}
+}
+
+// @Summary GitHub webhook
+// @Description Receive GitHub pull_request events and apply them to the pull request lifecycle
+// @ID GithubWebhook
+// @Tags Integrations
+// @Accept json
+// @Produce json
+// @Param X-GitHub-Event header string true "GitHub event name"
+// @Param X-GitHub-Delivery header string true "GitHub delivery id"
+// @Param X-Hub-Signature-256 header string true "HMAC SHA-256 signature of the body"
+// @Param input body handler2.GithubPullRequestEvent true "GitHub pull_request event"
+// @Success 200 {object} handler2.WebhookResponse "Event processed, duplicated or ignored"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 401 {object} handler2.ErrorResponse "Invalid signature"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /integrations/github/webhook [post]
+func (h *githubWebhookHandler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to read request", err)
+		return
+	}
+
+	if err = h.verifySignature(body, r.Header.Get(signatureHeader)); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnauthorized, handler2.UNKNOWN, "invalid signature", err)
+		return
+	}
+
+	deliveryID := r.Header.Get(deliveryHeader)
+	if deliveryID == "" {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "missing delivery id", errors.New("empty "+deliveryHeader))
+		return
+	}
+
+	in := pull_request_event.In{
+		Provider:   provider,
+		DeliveryID: deliveryID,
+		Event:      r.Header.Get(eventHeader),
+	}
+
+	if in.Event == pullRequestEvent {
+		var request handler2.GithubPullRequestEvent
+		if err = json.NewDecoder(bytes.NewReader(body)).Decode(&request); err != nil {
+			handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+			return
+		}
+
+		if err = h.validator.Struct(request); err != nil {
+			handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+			return
+		}
+
+		in.Event = pullRequestEvent + "." + request.Action
+		in.Action = mapAction(request)
+		in.PullRequestURL = request.PullRequest.HtmlUrl
+		in.PullRequestName = request.PullRequest.Title
+		in.AuthorLogin = request.PullRequest.User.Login
+	}
+
+	result, err := h.usecase.Run(ctx, in)
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.WebhookResponse{
+		Result: result.Result,
+	}
+	if result.Reason != "" {
+		out.Reason = &result.Reason
+	}
+	if result.PullRequestID != uuid.Nil {
+		out.PullRequestId = &result.PullRequestID
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+// verifySignature checks the "sha256=<hex>" HMAC GitHub computes over the raw body,
+// an empty secret rejects every delivery.
+func (h *githubWebhookHandler) verifySignature(body []byte, signature string) error {
+	if len(h.secret) == 0 {
+		return errors.New("webhook secret is not configured")
+	}
+	if !strings.HasPrefix(signature, signaturePrefix) {
+		return fmt.Errorf("malformed %s", signatureHeader)
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, signaturePrefix))
+	if err != nil {
+		return fmt.Errorf("malformed %s: %w", signatureHeader, err)
+	}
+
+	mac := hmac.New(sha256.New, h.secret)
+	mac.Write(body)
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return fmt.Errorf("%s mismatch", signatureHeader)
+	}
+	return nil
+}
+
+// mapAction translates a GitHub pull_request action, drafts are not tracked until they
+// become ready for review.
+func mapAction(event handler2.GithubPullRequestEvent) string {
+	switch event.Action {
+	case "opened":
+		if event.PullRequest.Draft != nil && *event.PullRequest.Draft {
+			return ""
+		}
+		return pull_request_event.ActionOpen
+	case "ready_for_review":
+		return pull_request_event.ActionOpen
+	case "closed":
+		if event.PullRequest.Merged != nil && *event.PullRequest.Merged {
+			return pull_request_event.ActionMerge
+		}
+		return pull_request_event.ActionClose
+	}
+	return ""
+}
+
+func (h *githubWebhookHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrSaveWebhookDelivery):
+		errorMsg = "error occurred while saving webhook delivery"
+	case errors.Is(err, usecase2.ErrGetPullRequest):
+		errorMsg = "error occurred while getting pull request"
+	case errors.Is(err, usecase2.ErrGetPRStatus):
+		errorMsg = "error occurred while getting pr status"
+	case errors.Is(err, usecase2.ErrUpdatePrStatus):
+		errorMsg = "error occurred while updating pr status"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
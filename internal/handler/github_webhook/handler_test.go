@@ -0,0 +1,297 @@
+package github_webhook_test
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerWebhook "pr-reviewers-service/internal/handler/github_webhook"
+	mockWebhook "pr-reviewers-service/internal/handler/github_webhook/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/pull_request_event"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	secret     = "It's a Secret to Everybody"
+	deliveryID = "72d3162e-cc78-11e3-81ab-4c9367dc0958"
+	prURL      = "https://github.com/acme/service/pull/42"
+	prTitle    = "Add retry to payment client"
+)
+
+func sign(body []byte, key string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+func TestGithubWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockWebhook.NewMockusecase(ctrl)
+	h := handlerWebhook.New(mockUC, validate, secret)
+
+	prID := usecase.PullRequestID(prURL)
+	prEvent := func(event, action string) usecase.In {
+		return usecase.In{
+			Provider:        "github",
+			DeliveryID:      deliveryID,
+			Event:           event,
+			Action:          action,
+			PullRequestURL:  prURL,
+			PullRequestName: prTitle,
+			AuthorLogin:     "octocat",
+		}
+	}
+	processed := &usecase.Out{Result: usecase.ResultProcessed, PullRequestID: prID}
+
+	tests := []struct {
+		name        string
+		fixture     string
+		body        string
+		event       string
+		delivery    string
+		signature   func(body []byte) string
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.WebhookResponse
+	}{
+		{
+			name:     "opened creates pull request",
+			fixture:  "opened.json",
+			event:    "pull_request",
+			delivery: deliveryID,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), prEvent("pull_request.opened", usecase.ActionOpen)).Return(processed, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultProcessed, PullRequestId: &prID},
+		},
+		{
+			name:     "ready_for_review creates pull request",
+			fixture:  "ready_for_review.json",
+			event:    "pull_request",
+			delivery: deliveryID,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), prEvent("pull_request.ready_for_review", usecase.ActionOpen)).Return(processed, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultProcessed, PullRequestId: &prID},
+		},
+		{
+			name:     "opened draft is not tracked",
+			fixture:  "opened_draft.json",
+			event:    "pull_request",
+			delivery: deliveryID,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), prEvent("pull_request.opened", "")).
+					Return(&usecase.Out{Result: usecase.ResultIgnored, Reason: "event pull_request.opened is not handled"}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{
+				Result: usecase.ResultIgnored,
+				Reason: func() *string { s := "event pull_request.opened is not handled"; return &s }(),
+			},
+		},
+		{
+			name:     "closed with merge merges pull request",
+			fixture:  "closed_merged.json",
+			event:    "pull_request",
+			delivery: deliveryID,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), prEvent("pull_request.closed", usecase.ActionMerge)).Return(processed, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultProcessed, PullRequestId: &prID},
+		},
+		{
+			name:     "closed without merge closes pull request",
+			fixture:  "closed.json",
+			event:    "pull_request",
+			delivery: deliveryID,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), prEvent("pull_request.closed", usecase.ActionClose)).Return(processed, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultProcessed, PullRequestId: &prID},
+		},
+		{
+			name:     "unhandled action is acknowledged",
+			fixture:  "labeled.json",
+			event:    "pull_request",
+			delivery: deliveryID,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), prEvent("pull_request.labeled", "")).
+					Return(&usecase.Out{Result: usecase.ResultIgnored}, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultIgnored},
+		},
+		{
+			name:     "unknown event is acknowledged",
+			fixture:  "ping.json",
+			event:    "ping",
+			delivery: deliveryID,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					Provider:   "github",
+					DeliveryID: deliveryID,
+					Event:      "ping",
+				}).Return(&usecase.Out{Result: usecase.ResultIgnored}, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultIgnored},
+		},
+		{
+			name:     "duplicate delivery is acknowledged",
+			fixture:  "opened.json",
+			event:    "pull_request",
+			delivery: deliveryID,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), prEvent("pull_request.opened", usecase.ActionOpen)).
+					Return(&usecase.Out{Result: usecase.ResultDuplicate}, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultDuplicate},
+		},
+		{
+			name:      "missing signature",
+			fixture:   "opened.json",
+			event:     "pull_request",
+			delivery:  deliveryID,
+			signature: func([]byte) string { return "" },
+			mock:      func() {},
+			wantCode:  http.StatusUnauthorized,
+			wantError: "invalid signature",
+		},
+		{
+			name:      "signature with another secret",
+			fixture:   "opened.json",
+			event:     "pull_request",
+			delivery:  deliveryID,
+			signature: func(body []byte) string { return sign(body, "another secret") },
+			mock:      func() {},
+			wantCode:  http.StatusUnauthorized,
+			wantError: "invalid signature",
+		},
+		{
+			name:      "missing delivery id",
+			fixture:   "opened.json",
+			event:     "pull_request",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "missing delivery id",
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			event:     "pull_request",
+			delivery:  deliveryID,
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "validation failed",
+			body:      `{"action":"opened","pull_request":{"html_url":"","title":""}}`,
+			event:     "pull_request",
+			delivery:  deliveryID,
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name:     "usecase returns ErrSaveWebhookDelivery",
+			fixture:  "closed.json",
+			event:    "pull_request",
+			delivery: deliveryID,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), prEvent("pull_request.closed", usecase.ActionClose)).
+					Return(nil, usecase2.ErrSaveWebhookDelivery)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while saving webhook delivery",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			bodyBytes := []byte(tt.body)
+			if tt.fixture != "" {
+				bodyBytes = fixture(t, tt.fixture)
+			}
+			signature := sign(bodyBytes, secret)
+			if tt.signature != nil {
+				signature = tt.signature(bodyBytes)
+			}
+
+			req := httptest.NewRequest("POST", "/integrations/github/webhook", bytes.NewReader(bodyBytes))
+			req.Header.Set("X-GitHub-Event", tt.event)
+			req.Header.Set("X-GitHub-Delivery", tt.delivery)
+			req.Header.Set("X-Hub-Signature-256", signature)
+			w := httptest.NewRecorder()
+
+			h.HandleWebhook(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+
+			if tt.wantSuccess != nil {
+				var got handler.WebhookResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got)
+			}
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestGithubWebhookWithoutSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handlerWebhook.New(mockWebhook.NewMockusecase(ctrl), validator.New(), "")
+
+	body := fixture(t, "opened.json")
+	req := httptest.NewRequest("POST", "/integrations/github/webhook", bytes.NewReader(body))
+	req.Header.Set("X-GitHub-Event", "pull_request")
+	req.Header.Set("X-GitHub-Delivery", deliveryID)
+	req.Header.Set("X-Hub-Signature-256", sign(body, ""))
+	w := httptest.NewRecorder()
+
+	h.HandleWebhook(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,12 @@
+package gitlab_webhook
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/pull_request_event"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=gitlab_webhook usecase
+type usecase interface {
+	Run(ctx context.Context, req pull_request_event.In) (*pull_request_event.Out, error)
+}
@@ -0,0 +1,198 @@
+package gitlab_webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/pull_request_event"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+const (
+	provider = "gitlab"
+
+	eventHeader    = "X-Gitlab-Event"
+	deliveryHeader = "X-Gitlab-Event-UUID"
+	tokenHeader    = "X-Gitlab-Token"
+
+	mergeRequestEvent = "Merge Request Hook"
+	mergeRequestKind  = "merge_request"
+)
+
+type gitlabWebhookHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+	token     []byte
+}
+
+func New(usecase usecase, validator *validator.Validate, token string) *gitlabWebhookHandler {
+	return &gitlabWebhookHandler{
+		usecase:   usecase,
+		validator: validator,
+		token:     []byte(token),
+	}
+}
+
+// @Summary GitLab webhook
+// @Description Receive GitLab merge request events and apply them to the pull request lifecycle
+// @ID GitlabWebhook
+// @Tags Integrations
+// @Accept json
+// @Produce json
+// @Param X-Gitlab-Event header string true "GitLab event name"
+// @Param X-Gitlab-Event-UUID header string true "GitLab delivery id"
+// @Param X-Gitlab-Token header string true "Webhook secret token"
+// @Param input body handler2.GitlabMergeRequestEvent true "GitLab merge request event"
+// @Success 200 {object} handler2.WebhookResponse "Event processed, duplicated or ignored"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 401 {object} handler2.ErrorResponse "Invalid token"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /integrations/gitlab/webhook [post]
+func (h *gitlabWebhookHandler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	if err := h.verifyToken(r.Header.Get(tokenHeader)); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnauthorized, handler2.UNKNOWN, "invalid token", err)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to read request", err)
+		return
+	}
+
+	deliveryID := r.Header.Get(deliveryHeader)
+	if deliveryID == "" {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "missing delivery id", errors.New("empty "+deliveryHeader))
+		return
+	}
+
+	in := pull_request_event.In{
+		Provider:   provider,
+		DeliveryID: deliveryID,
+		Event:      r.Header.Get(eventHeader),
+	}
+
+	if in.Event == mergeRequestEvent {
+		var request handler2.GitlabMergeRequestEvent
+		if err = json.NewDecoder(bytes.NewReader(body)).Decode(&request); err != nil {
+			handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+			return
+		}
+
+		if err = h.validator.Struct(request); err != nil {
+			handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+			return
+		}
+
+		in.Event = request.ObjectKind + "." + request.ObjectAttributes.Action
+		if request.ObjectKind == mergeRequestKind {
+			in.Action = mapAction(request)
+		}
+		in.PullRequestURL = request.ObjectAttributes.Url
+		in.PullRequestName = request.ObjectAttributes.Title
+		in.AuthorLogin = authorLogin(request)
+	}
+
+	result, err := h.usecase.Run(ctx, in)
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.WebhookResponse{
+		Result: result.Result,
+	}
+	if result.Reason != "" {
+		out.Reason = &result.Reason
+	}
+	if result.PullRequestID != uuid.Nil {
+		out.PullRequestId = &result.PullRequestID
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+// verifyToken compares the secret token GitLab sends as is, an empty configured token
+// rejects every delivery.
+func (h *gitlabWebhookHandler) verifyToken(token string) error {
+	if len(h.token) == 0 {
+		return errors.New("webhook token is not configured")
+	}
+	if subtle.ConstantTimeCompare([]byte(token), h.token) != 1 {
+		return fmt.Errorf("%s mismatch", tokenHeader)
+	}
+	return nil
+}
+
+// mapAction translates a GitLab merge request action, drafts are not tracked until an
+// update marks them ready.
+func mapAction(event handler2.GitlabMergeRequestEvent) string {
+	attrs := event.ObjectAttributes
+	switch attrs.Action {
+	case "open":
+		if attrs.Draft != nil && *attrs.Draft {
+			return ""
+		}
+		return pull_request_event.ActionOpen
+	case "update":
+		if event.Changes == nil || event.Changes.Draft == nil {
+			return ""
+		}
+		change := event.Changes.Draft
+		if change.Previous != nil && *change.Previous && change.Current != nil && !*change.Current {
+			return pull_request_event.ActionOpen
+		}
+		return ""
+	case "merge":
+		return pull_request_event.ActionMerge
+	case "close":
+		return pull_request_event.ActionClose
+	}
+	return ""
+}
+
+// authorLogin returns the username of the user who triggered the event when that user is
+// the merge request author, GitLab payloads carry only the numeric author id otherwise.
+func authorLogin(event handler2.GitlabMergeRequestEvent) string {
+	if event.User.Id != event.ObjectAttributes.AuthorId {
+		return ""
+	}
+	return event.User.Username
+}
+
+func (h *gitlabWebhookHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrSaveWebhookDelivery):
+		errorMsg = "error occurred while saving webhook delivery"
+	case errors.Is(err, usecase2.ErrGetPullRequest):
+		errorMsg = "error occurred while getting pull request"
+	case errors.Is(err, usecase2.ErrGetPRStatus):
+		errorMsg = "error occurred while getting pr status"
+	case errors.Is(err, usecase2.ErrUpdatePrStatus):
+		errorMsg = "error occurred while updating pr status"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
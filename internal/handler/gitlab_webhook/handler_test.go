@@ -0,0 +1,302 @@
+package gitlab_webhook_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerWebhook "pr-reviewers-service/internal/handler/gitlab_webhook"
+	mockWebhook "pr-reviewers-service/internal/handler/gitlab_webhook/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/pull_request_event"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token        = "It's a Secret to Everybody"
+	deliveryID   = "1b6d9e55-3c1e-4b7a-9a59-6f6d1c2e8f10"
+	mergeRequest = "Merge Request Hook"
+	mrURL        = "https://gitlab.example.com/acme/service/-/merge_requests/7"
+	mrTitle      = "Add retry to payment client"
+)
+
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+func TestGitlabWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockWebhook.NewMockusecase(ctrl)
+	h := handlerWebhook.New(mockUC, validate, token)
+
+	prID := usecase.PullRequestID(mrURL)
+	mrEvent := func(event, action, author string) usecase.In {
+		return usecase.In{
+			Provider:        "gitlab",
+			DeliveryID:      deliveryID,
+			Event:           event,
+			Action:          action,
+			PullRequestURL:  mrURL,
+			PullRequestName: mrTitle,
+			AuthorLogin:     author,
+		}
+	}
+	processed := &usecase.Out{Result: usecase.ResultProcessed, PullRequestID: prID}
+	ignored := &usecase.Out{Result: usecase.ResultIgnored}
+
+	tests := []struct {
+		name        string
+		fixture     string
+		body        string
+		event       string
+		delivery    string
+		token       string
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.WebhookResponse
+	}{
+		{
+			name:     "open creates pull request",
+			fixture:  "open.json",
+			event:    mergeRequest,
+			delivery: deliveryID,
+			token:    token,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), mrEvent("merge_request.open", usecase.ActionOpen, "root")).Return(processed, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultProcessed, PullRequestId: &prID},
+		},
+		{
+			name:     "open draft is not tracked",
+			fixture:  "open_draft.json",
+			event:    mergeRequest,
+			delivery: deliveryID,
+			token:    token,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), mrEvent("merge_request.open", "", "root")).Return(ignored, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultIgnored},
+		},
+		{
+			name:     "open by another user has no author login",
+			fixture:  "open_by_another_user.json",
+			event:    mergeRequest,
+			delivery: deliveryID,
+			token:    token,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), mrEvent("merge_request.open", usecase.ActionOpen, "")).Return(ignored, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultIgnored},
+		},
+		{
+			name:     "update from draft to ready creates pull request",
+			fixture:  "update_ready.json",
+			event:    mergeRequest,
+			delivery: deliveryID,
+			token:    token,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), mrEvent("merge_request.update", usecase.ActionOpen, "root")).Return(processed, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultProcessed, PullRequestId: &prID},
+		},
+		{
+			name:     "other update is acknowledged",
+			fixture:  "update_title.json",
+			event:    mergeRequest,
+			delivery: deliveryID,
+			token:    token,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), mrEvent("merge_request.update", "", "root")).Return(ignored, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultIgnored},
+		},
+		{
+			name:     "merge merges pull request",
+			fixture:  "merge.json",
+			event:    mergeRequest,
+			delivery: deliveryID,
+			token:    token,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), mrEvent("merge_request.merge", usecase.ActionMerge, "root")).Return(processed, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultProcessed, PullRequestId: &prID},
+		},
+		{
+			name:     "close closes pull request",
+			fixture:  "close.json",
+			event:    mergeRequest,
+			delivery: deliveryID,
+			token:    token,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), mrEvent("merge_request.close", usecase.ActionClose, "root")).Return(processed, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultProcessed, PullRequestId: &prID},
+		},
+		{
+			name:     "unknown event is acknowledged",
+			fixture:  "push.json",
+			event:    "Push Hook",
+			delivery: deliveryID,
+			token:    token,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					Provider:   "gitlab",
+					DeliveryID: deliveryID,
+					Event:      "Push Hook",
+				}).Return(ignored, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultIgnored},
+		},
+		{
+			name:     "duplicate delivery is acknowledged",
+			fixture:  "merge.json",
+			event:    mergeRequest,
+			delivery: deliveryID,
+			token:    token,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), mrEvent("merge_request.merge", usecase.ActionMerge, "root")).
+					Return(&usecase.Out{Result: usecase.ResultDuplicate}, nil)
+			},
+			wantCode:    http.StatusOK,
+			wantSuccess: &handler.WebhookResponse{Result: usecase.ResultDuplicate},
+		},
+		{
+			name:      "missing token",
+			fixture:   "open.json",
+			event:     mergeRequest,
+			delivery:  deliveryID,
+			mock:      func() {},
+			wantCode:  http.StatusUnauthorized,
+			wantError: "invalid token",
+		},
+		{
+			name:      "wrong token",
+			fixture:   "open.json",
+			event:     mergeRequest,
+			delivery:  deliveryID,
+			token:     "another token",
+			mock:      func() {},
+			wantCode:  http.StatusUnauthorized,
+			wantError: "invalid token",
+		},
+		{
+			name:      "missing delivery id",
+			fixture:   "open.json",
+			event:     mergeRequest,
+			token:     token,
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "missing delivery id",
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			event:     mergeRequest,
+			delivery:  deliveryID,
+			token:     token,
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "validation failed",
+			body:      `{"object_kind":"merge_request","user":{"id":1,"username":"root"},"object_attributes":{"action":"open","url":"","title":""}}`,
+			event:     mergeRequest,
+			delivery:  deliveryID,
+			token:     token,
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name:     "usecase returns ErrSaveWebhookDelivery",
+			fixture:  "close.json",
+			event:    mergeRequest,
+			delivery: deliveryID,
+			token:    token,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), mrEvent("merge_request.close", usecase.ActionClose, "root")).
+					Return(nil, usecase2.ErrSaveWebhookDelivery)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while saving webhook delivery",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			bodyBytes := []byte(tt.body)
+			if tt.fixture != "" {
+				bodyBytes = fixture(t, tt.fixture)
+			}
+
+			req := httptest.NewRequest("POST", "/integrations/gitlab/webhook", bytes.NewReader(bodyBytes))
+			req.Header.Set("X-Gitlab-Event", tt.event)
+			req.Header.Set("X-Gitlab-Event-UUID", tt.delivery)
+			req.Header.Set("X-Gitlab-Token", tt.token)
+			w := httptest.NewRecorder()
+
+			h.HandleWebhook(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+
+			if tt.wantSuccess != nil {
+				var got handler.WebhookResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got)
+			}
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestGitlabWebhookWithoutToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	h := handlerWebhook.New(mockWebhook.NewMockusecase(ctrl), validator.New(), "")
+
+	req := httptest.NewRequest("POST", "/integrations/gitlab/webhook", bytes.NewReader(fixture(t, "open.json")))
+	req.Header.Set("X-Gitlab-Event", mergeRequest)
+	req.Header.Set("X-Gitlab-Event-UUID", deliveryID)
+	w := httptest.NewRecorder()
+
+	h.HandleWebhook(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,12 @@
+package handover_reviews
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/handover_reviews"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=handover_reviews usecase
+type usecase interface {
+	Run(ctx context.Context, req handover_reviews.In) (*handover_reviews.Out, error)
+}
@@ -0,0 +1,141 @@
+package handover_reviews
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/handler/middleware"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/handover_reviews"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type handoverReviewsHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *handoverReviewsHandler {
+	return &handoverReviewsHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Hand over reviews
+// @Description Move every open review assignment of one user to another user.
+// @Description PRs where the target is the author or already assigned fall back to regular team selection.
+// @ID HandoverReviews
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostUsersHandoverReviewsJSONRequestBody true "Handover data"
+// @Success 200 {object} handler2.HandoverReviewsResponse "Reviews successfully handed over"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 403 {object} handler2.ErrorResponse "Users can hand over only their own reviews"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "User not found"
+// @Failure 409 {object} handler2.ErrorResponse "Target user is inactive"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /users/handoverReviews [post]
+func (h *handoverReviewsHandler) HandoverReviews(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostUsersHandoverReviewsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	if !middleware.CanActAs(ctx, request.FromUserId) {
+		middleware.RespondForbidden(w, ctx)
+		return
+	}
+
+	ctx = logging.WithLogUserId(ctx, request.FromUserId)
+
+	result, err := h.usecase.Run(ctx, handover_reviews.In{
+		FromUserID: request.FromUserId,
+		ToUserID:   request.ToUserId,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.HandoverReviewsResponse{
+		FromUserId: result.FromUserID,
+		ToUserId:   result.ToUserID,
+		PullRequests: func() []handler2.HandoverPullRequest {
+			prs := make([]handler2.HandoverPullRequest, 0, len(result.PullRequests))
+			for _, pr := range result.PullRequests {
+				prs = append(prs, handler2.HandoverPullRequest{
+					PullRequestId:   pr.PullRequestID,
+					PullRequestName: pr.PullRequestName,
+					AuthorId:        pr.AuthorID,
+					NewReviewerId:   pr.NewReviewerID,
+					Outcome:         handler2.HandoverPullRequestOutcome(pr.Outcome),
+				})
+			}
+			return prs
+		}(),
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *handoverReviewsHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetUser):
+		errorMsg = "error occurred while getting user"
+	case errors.Is(err, usecase2.ErrGetUsers):
+		errorMsg = "error occurred while getting users"
+	case errors.Is(err, usecase2.ErrGetPRReviewers):
+		errorMsg = "error occurred while getting pr reviewers"
+	case errors.Is(err, usecase2.ErrGetPullRequest):
+		errorMsg = "error occurred while getting pull request"
+	case errors.Is(err, usecase2.ErrGetPRStatus):
+		errorMsg = "error occurred while getting pr status"
+	case errors.Is(err, usecase2.ErrRemoveReviewer):
+		errorMsg = "error occurred while removing reviewer"
+	case errors.Is(err, usecase2.ErrAssignReviewer):
+		errorMsg = "error occurred while assigning reviewer"
+	case errors.Is(err, usecase2.ErrHandoverToSameUser):
+		errorMsg = "cannot hand over reviews to the same user"
+		statusCode = http.StatusBadRequest
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrHandoverTargetInactive):
+		errorMsg = "target user is inactive"
+		statusCode = http.StatusConflict
+		errorResponseErrorCode = handler2.NOCANDIDATE
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		errorMsg = "user not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrAuthorPrNotFound):
+		errorMsg = "pull request author not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,265 @@
+package handover_reviews_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerHandover "pr-reviewers-service/internal/handler/handover_reviews"
+	mockHandover "pr-reviewers-service/internal/handler/handover_reviews/mocks"
+	"pr-reviewers-service/internal/handler/middleware"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseHandover "pr-reviewers-service/internal/usecase/handover_reviews"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandoverReviews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockHandover.NewMockusecase(ctrl)
+	h := handlerHandover.New(mockUC, validate)
+
+	fromID := uuid.New()
+	toID := uuid.New()
+	otherID := uuid.New()
+	authorID := uuid.New()
+	pr1 := uuid.New()
+	pr2 := uuid.New()
+	pr3 := uuid.New()
+
+	reqBody := handler2.PostUsersHandoverReviewsJSONRequestBody{
+		FromUserId: fromID,
+		ToUserId:   toID,
+	}
+	ucIn := usecaseHandover.In{
+		FromUserID: fromID,
+		ToUserID:   toID,
+	}
+
+	ucOut := usecaseHandover.Out{
+		FromUserID: fromID,
+		ToUserID:   toID,
+		PullRequests: []usecaseHandover.HandoverPullRequest{
+			{PullRequestID: pr1, PullRequestName: "PR1", AuthorID: authorID, NewReviewerID: &toID, Outcome: usecaseHandover.OutcomeHandedOver},
+			{PullRequestID: pr2, PullRequestName: "PR2", AuthorID: toID, NewReviewerID: &otherID, Outcome: usecaseHandover.OutcomeReassigned},
+			{PullRequestID: pr3, PullRequestName: "PR3", AuthorID: toID, Outcome: usecaseHandover.OutcomeUnassigned},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		principal *middleware.Principal
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.HandoverReviewsResponse{
+				FromUserId: fromID,
+				ToUserId:   toID,
+				PullRequests: []handler2.HandoverPullRequest{
+					{PullRequestId: pr1, PullRequestName: "PR1", AuthorId: authorID, NewReviewerId: &toID, Outcome: handler2.HANDEDOVER},
+					{PullRequestId: pr2, PullRequestName: "PR2", AuthorId: toID, NewReviewerId: &otherID, Outcome: handler2.REASSIGNED},
+					{PullRequestId: pr3, PullRequestName: "PR3", AuthorId: toID, Outcome: handler2.UNASSIGNED},
+				},
+			},
+		},
+		{
+			name: "success without open reviews",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&usecaseHandover.Out{
+					FromUserID:   fromID,
+					ToUserID:     toID,
+					PullRequests: []usecaseHandover.HandoverPullRequest{},
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.HandoverReviewsResponse{
+				FromUserId:   fromID,
+				ToUserId:     toID,
+				PullRequests: []handler2.HandoverPullRequest{},
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "empty body",
+			body:      nil,
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name: "validation failed - missing target",
+			body: struct {
+				FromUserId uuid.UUID `json:"from_user_id"`
+			}{
+				FromUserId: fromID,
+			},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrHandoverToSameUser",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrHandoverToSameUser)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "cannot hand over reviews to the same user",
+		},
+		{
+			name: "usecase returns ErrHandoverTargetInactive",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrHandoverTargetInactive)
+			},
+			wantCode:  http.StatusConflict,
+			wantError: "target user is inactive",
+		},
+		{
+			name: "usecase returns ErrUserNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUserNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "user not found",
+		},
+		{
+			name: "usecase returns ErrAuthorPrNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrAuthorPrNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "pull request author not found",
+		},
+		{
+			name: "usecase returns ErrGetUser",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrGetUser)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting user",
+		},
+		{
+			name: "usecase returns ErrGetPRReviewers",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrGetPRReviewers)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting pr reviewers",
+		},
+		{
+			name: "usecase returns ErrRemoveReviewer",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrRemoveReviewer)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while removing reviewer",
+		},
+		{
+			name: "usecase returns ErrAssignReviewer",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrAssignReviewer)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while assigning reviewer",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+		{
+			name:      "user hands over someone else's reviews",
+			principal: &middleware.Principal{UserID: toID, Role: middleware.User},
+			body:      reqBody,
+			mock:      func() {},
+			wantCode:  http.StatusForbidden,
+			wantError: "insufficient permissions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			case nil:
+				bodyBytes = nil
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/users/handoverReviews", bytes.NewReader(bodyBytes))
+			if tt.principal != nil {
+				req = req.WithContext(middleware.WithPrincipal(req.Context(), *tt.principal))
+			}
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.HandoverReviews(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.HandoverReviewsResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strings"
@@ -8,11 +9,15 @@ import (
 	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
 	"pr-reviewers-service/internal/handler"
 	"pr-reviewers-service/internal/jwt"
+	"pr-reviewers-service/internal/logging"
+
+	"github.com/google/uuid"
 )
 
 var (
 	ErrNoToken          = errors.New("no token provided")
 	ErrNoAcceptableRole = errors.New("no acceptable role")
+	ErrNotOwner         = errors.New("users can act only on their own reviews and pull requests")
 )
 
 type UserRole string
@@ -24,6 +29,44 @@ const (
 	authorisationPrefix = "Bearer "
 )
 
+type principalKey struct{}
+
+// Principal is the authenticated user, it is put into the request context by AuthMiddleware and AuthInterceptor.
+type Principal struct {
+	UserID uuid.UUID
+	Role   UserRole
+}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// RestrictedUserID returns the id of the authenticated USER, who may act only on their own reviews and pull requests.
+// It is uuid.Nil for ADMIN and when authorisation is disabled, both have full access.
+func RestrictedUserID(ctx context.Context) uuid.UUID {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.Role == Admin {
+		return uuid.Nil
+	}
+	return principal.UserID
+}
+
+// CanActAs reports whether the request may act on behalf of the user.
+func CanActAs(ctx context.Context, userID uuid.UUID) bool {
+	restricted := RestrictedUserID(ctx)
+	return restricted == uuid.Nil || restricted == userID
+}
+
+// RespondForbidden writes the 403 returned when a USER acts on someone else's reviews or pull requests.
+func RespondForbidden(w http.ResponseWriter, ctx context.Context) {
+	handler.RespondWithError(w, ctx, http.StatusForbidden, handler2.FORBIDDEN, "insufficient permissions", ErrNotOwner)
+}
+
 func hasRequiredRole(userRole UserRole, requiredRoles []UserRole) bool {
 	role := UserRole(strings.ToUpper(string(userRole)))
 	for _, requiredRole := range requiredRoles {
@@ -51,18 +94,20 @@ func AuthMiddleware(secret string, requiredRoles []UserRole, next http.Handler)
 			return
 		}
 
-		role, err := jwt.ParseToken(tokenString, secret)
+		role, userID, err := jwt.ParseToken(tokenString, secret)
 		if err != nil {
 			handler.RespondWithError(w, r.Context(), http.StatusUnauthorized, handler2.UNKNOWN, "invalid token", err)
 			return
 		}
 
-		userRole := UserRole(role)
+		userRole := UserRole(strings.ToUpper(role))
 		if !hasRequiredRole(userRole, requiredRoles) {
-			handler.RespondWithError(w, r.Context(), http.StatusForbidden, handler2.UNKNOWN, "insufficient permissions", ErrNoAcceptableRole)
+			handler.RespondWithError(w, r.Context(), http.StatusForbidden, handler2.FORBIDDEN, "insufficient permissions", ErrNoAcceptableRole)
 			return
 		}
 
-		next.ServeHTTP(w, r)
+		ctx := WithPrincipal(r.Context(), Principal{UserID: userID, Role: userRole})
+		ctx = logging.WithLogRole(ctx, string(userRole))
+		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
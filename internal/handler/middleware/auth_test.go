@@ -0,0 +1,141 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler/middleware"
+	"pr-reviewers-service/internal/jwt"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const secret = "secret"
+
+func TestAuthMiddleware(t *testing.T) {
+	userID := uuid.New()
+	token := func(role string, id uuid.UUID) string {
+		tokenString, err := jwt.GenerateToken(secret, role, id, time.Hour)
+		require.NoError(t, err)
+		return "Bearer " + tokenString
+	}
+
+	tests := []struct {
+		name          string
+		authorization string
+		requiredRoles []middleware.UserRole
+		wantCode      int
+		wantErrCode   handler2.ErrorResponseErrorCode
+		wantPrincipal middleware.Principal
+	}{
+		{
+			name:          "user token",
+			authorization: token("USER", userID),
+			requiredRoles: []middleware.UserRole{middleware.User, middleware.Admin},
+			wantCode:      http.StatusOK,
+			wantPrincipal: middleware.Principal{UserID: userID, Role: middleware.User},
+		},
+		{
+			name:          "role is case insensitive",
+			authorization: token("admin", userID),
+			requiredRoles: []middleware.UserRole{middleware.Admin},
+			wantCode:      http.StatusOK,
+			wantPrincipal: middleware.Principal{UserID: userID, Role: middleware.Admin},
+		},
+		{
+			name:          "no token",
+			requiredRoles: []middleware.UserRole{middleware.Admin},
+			wantCode:      http.StatusUnauthorized,
+			wantErrCode:   handler2.UNKNOWN,
+		},
+		{
+			name:          "invalid token",
+			authorization: "Bearer invalid",
+			requiredRoles: []middleware.UserRole{middleware.Admin},
+			wantCode:      http.StatusUnauthorized,
+			wantErrCode:   handler2.UNKNOWN,
+		},
+		{
+			name:          "role not allowed",
+			authorization: token("USER", userID),
+			requiredRoles: []middleware.UserRole{middleware.Admin},
+			wantCode:      http.StatusForbidden,
+			wantErrCode:   handler2.FORBIDDEN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got middleware.Principal
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got, _ = middleware.PrincipalFromContext(r.Context())
+			})
+
+			req := httptest.NewRequest("GET", "/", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			middleware.AuthMiddleware(secret, tt.requiredRoles, next).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode == http.StatusOK {
+				assert.Equal(t, tt.wantPrincipal, got)
+				return
+			}
+
+			var errResp handler2.ErrorResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+			assert.Equal(t, tt.wantErrCode, errResp.Error.Code)
+		})
+	}
+}
+
+func TestCanActAs(t *testing.T) {
+	userID := uuid.New()
+	otherID := uuid.New()
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		wantRestricted uuid.UUID
+		wantSelf       bool
+		wantOther      bool
+	}{
+		{
+			name:      "authorisation disabled",
+			ctx:       context.Background(),
+			wantSelf:  true,
+			wantOther: true,
+		},
+		{
+			name:      "admin",
+			ctx:       middleware.WithPrincipal(context.Background(), middleware.Principal{UserID: userID, Role: middleware.Admin}),
+			wantSelf:  true,
+			wantOther: true,
+		},
+		{
+			name:           "user",
+			ctx:            middleware.WithPrincipal(context.Background(), middleware.Principal{UserID: userID, Role: middleware.User}),
+			wantRestricted: userID,
+			wantSelf:       true,
+			wantOther:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantRestricted, middleware.RestrictedUserID(tt.ctx))
+			assert.Equal(t, tt.wantSelf, middleware.CanActAs(tt.ctx, userID))
+			assert.Equal(t, tt.wantOther, middleware.CanActAs(tt.ctx, otherID))
+		})
+	}
+}
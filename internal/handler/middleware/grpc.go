@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"pr-reviewers-service/internal/jwt"
+	"pr-reviewers-service/internal/logging"
+	"pr-reviewers-service/internal/metrics"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const grpcMethod = "GRPC"
+
+// LoggerInterceptor is the gRPC counterpart of LoggerMiddleware.
+func LoggerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (any, error) {
+	requestId := uuid.New()
+	slog.InfoContext(ctx, fmt.Sprintf("Start [%s] request processing", requestId.String()))
+	start := time.Now()
+
+	ctx = logging.WithLogRequestID(ctx, requestId)
+	ctx = logging.WithLogRequestPath(ctx, info.FullMethod)
+	ctx = logging.WithLogRequestMethod(ctx, grpcMethod)
+
+	resp, err := next(ctx, req)
+
+	ctx = logging.WithLogRequestStatus(ctx, int(status.Code(err)))
+	ctx = logging.WithLogRequestDuration(ctx, time.Since(start).String())
+	slog.InfoContext(ctx, fmt.Sprintf("Ended [%s] request processing", requestId.String()))
+
+	return resp, err
+}
+
+// MetricsInterceptor counts calls, their duration and status codes per method.
+func MetricsInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (any, error) {
+	metrics.IncGrpcRequestsTotal(info.FullMethod)
+	start := time.Now()
+
+	resp, err := next(ctx, req)
+
+	metrics.IncGrpcResponsesDuration(info.FullMethod, time.Since(start))
+	metrics.IncGrpcResponsesCodesTotal(info.FullMethod, status.Code(err))
+
+	return resp, err
+}
+
+// PanicInterceptor turns a panic in a handler into an Internal error instead of crashing the server.
+func PanicInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (resp any, err error) {
+	start := time.Now()
+	defer func() {
+		if p := recover(); p != nil {
+			slog.ErrorContext(
+				ctx,
+				"Caught panic",
+				"method", info.FullMethod,
+				"panic", fmt.Sprint(p),
+				"time", time.Since(start),
+				"stack trace", string(debug.Stack()),
+			)
+			resp, err = nil, status.Error(codes.Internal, "Internal server error")
+		}
+	}()
+
+	return next(ctx, req)
+}
+
+// AuthInterceptor checks the JWT from the authorization metadata like AuthMiddleware does for REST, the roles
+// allowed to call a method come from the policy and methods missing from it are denied.
+func AuthInterceptor(secret string, policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (any, error) {
+		tokenString, err := extractMetadataToken(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "authorization required: %s", err)
+		}
+
+		role, userID, err := jwt.ParseToken(tokenString, secret)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "invalid token: %s", err)
+		}
+
+		userRole := UserRole(strings.ToUpper(role))
+		if !hasRequiredRole(userRole, policy[info.FullMethod]) {
+			return nil, status.Errorf(codes.PermissionDenied, "insufficient permissions: %s", ErrNoAcceptableRole)
+		}
+
+		ctx = WithPrincipal(ctx, Principal{UserID: userID, Role: userRole})
+		return next(ctx, req)
+	}
+}
+
+func extractMetadataToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ErrNoToken
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 || values[0] == "" {
+		return "", ErrNoToken
+	}
+
+	return strings.TrimPrefix(values[0], authorisationPrefix), nil
+}
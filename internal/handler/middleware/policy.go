@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrUnknownRoute       = errors.New("unknown route")
+	ErrInvalidRoles       = errors.New("invalid roles")
+	ErrRouteWithoutPolicy = errors.New("route has no policy")
+)
+
+// Policy maps a route to the roles allowed to call it. REST routes are written as "METHOD /path/template" and gRPC
+// methods by their full name, e.g. "/pr_reviewers.v1.PRReviewersService/AddTeam".
+type Policy map[string][]UserRole
+
+func RouteKey(method, pathTemplate string) string {
+	return method + " " + pathTemplate
+}
+
+// Override returns the policy with the roles of the listed routes replaced. Routes missing from the policy, unknown
+// roles and empty role lists are rejected, so a typo in the configuration cannot leave a route open or closed
+// by accident.
+func (p Policy) Override(overrides map[string][]string) (Policy, error) {
+	result := make(Policy, len(p))
+	for route, roles := range p {
+		result[route] = roles
+	}
+
+	for route, rawRoles := range overrides {
+		if _, ok := p[route]; !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownRoute, route)
+		}
+		if len(rawRoles) == 0 {
+			return nil, fmt.Errorf("%w: %q has no roles", ErrInvalidRoles, route)
+		}
+
+		roles := make([]UserRole, 0, len(rawRoles))
+		for _, raw := range rawRoles {
+			role := UserRole(strings.ToUpper(raw))
+			if role != Admin && role != User {
+				return nil, fmt.Errorf("%w: %q has unknown role %q", ErrInvalidRoles, route, raw)
+			}
+			if !hasRequiredRole(role, roles) {
+				roles = append(roles, role)
+			}
+		}
+		result[route] = roles
+	}
+
+	return result, nil
+}
+
+// Routes returns the routes of the policy in sorted order.
+func (p Policy) Routes() []string {
+	routes := make([]string, 0, len(p))
+	for route := range p {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+	return routes
+}
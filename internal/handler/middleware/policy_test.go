@@ -0,0 +1,83 @@
+package middleware_test
+
+import (
+	"testing"
+
+	"pr-reviewers-service/internal/handler/middleware"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyOverride(t *testing.T) {
+	defaults := middleware.Policy{
+		"POST /api/v1/team/add": {middleware.Admin},
+		"GET /api/v1/team/get":  {middleware.Admin, middleware.User},
+	}
+
+	tests := []struct {
+		name      string
+		overrides map[string][]string
+		want      middleware.Policy
+		wantErr   error
+	}{
+		{
+			name: "no overrides",
+			want: defaults,
+		},
+		{
+			name:      "roles are replaced and normalized",
+			overrides: map[string][]string{"POST /api/v1/team/add": {"admin", "User", "USER"}},
+			want: middleware.Policy{
+				"POST /api/v1/team/add": {middleware.Admin, middleware.User},
+				"GET /api/v1/team/get":  {middleware.Admin, middleware.User},
+			},
+		},
+		{
+			name:      "unknown route",
+			overrides: map[string][]string{"POST /api/v1/team/ad": {"ADMIN"}},
+			wantErr:   middleware.ErrUnknownRoute,
+		},
+		{
+			name:      "unknown role",
+			overrides: map[string][]string{"GET /api/v1/team/get": {"GUEST"}},
+			wantErr:   middleware.ErrInvalidRoles,
+		},
+		{
+			name:      "no roles",
+			overrides: map[string][]string{"GET /api/v1/team/get": {}},
+			wantErr:   middleware.ErrInvalidRoles,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := defaults.Override(tt.overrides)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, policy)
+		})
+	}
+
+	t.Run("defaults are not changed", func(t *testing.T) {
+		_, err := defaults.Override(map[string][]string{"GET /api/v1/team/get": {"ADMIN"}})
+		require.NoError(t, err)
+		assert.Equal(t, []middleware.UserRole{middleware.Admin, middleware.User}, defaults["GET /api/v1/team/get"])
+	})
+}
+
+func TestPolicyRoutes(t *testing.T) {
+	policy := middleware.Policy{
+		"POST /api/v1/team/add":                       {middleware.Admin},
+		"GET /api/v1/team/get":                        {middleware.Admin},
+		"/pr_reviewers.v1.PRReviewersService/AddTeam": {middleware.Admin},
+	}
+	assert.Equal(t, []string{
+		"/pr_reviewers.v1.PRReviewersService/AddTeam",
+		"GET /api/v1/team/get",
+		"POST /api/v1/team/add",
+	}, policy.Routes())
+}
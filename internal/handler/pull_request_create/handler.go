@@ -29,7 +29,10 @@ func New(usecase usecase, validator *validator.Validate) *createPullRequestHandl
 }
 
 // @Summary Create pull request
-// @Description Create PR and automatically assign up to 2 reviewers from author's team
+// @Description Create PR and automatically assign up to 2 reviewers from author's team.
+// @Description author_id is either a user UUID or an external identity like github:alice.
+// @Description With repository and changed_files the owners of the changed files from the uploaded CODEOWNERS
+// @Description are required reviewers, reviewers shows which rule produced each of them.
 // @ID CreatePullRequest
 // @Tags PullRequests
 // @Accept json
@@ -56,14 +59,32 @@ func (h *createPullRequestHandler) CreatePullRequest(w http.ResponseWriter, r *h
 		return
 	}
 
-	ctx = logging.WithLogAuthorID(ctx, request.AuthorId)
+	authorID, authorIdentity, err := usecase2.ParseUserRef(request.AuthorId)
+	if err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST,
+			"author_id must be uuid or provider:external_id", err)
+		return
+	}
+
+	if authorIdentity == nil {
+		ctx = logging.WithLogAuthorID(ctx, authorID)
+	}
 	ctx = logging.WithLogPullRequestID(ctx, request.PullRequestId)
 
-	result, err := h.usecase.Run(ctx, pull_request_create.In{
+	in := pull_request_create.In{
 		PullRequestID:   request.PullRequestId,
 		PullRequestName: request.PullRequestName,
-		AuthorID:        request.AuthorId,
-	})
+		AuthorID:        authorID,
+		AuthorIdentity:  authorIdentity,
+	}
+	if request.Repository != nil {
+		in.Repository = *request.Repository
+	}
+	if request.ChangedFiles != nil {
+		in.ChangedFiles = *request.ChangedFiles
+	}
+
+	result, err := h.usecase.Run(ctx, in)
 	if err != nil {
 		h.handleUseCaseError(w, ctx, err)
 		return
@@ -84,6 +105,22 @@ func (h *createPullRequestHandler) CreatePullRequest(w http.ResponseWriter, r *h
 				return &result.MergedAt
 			}(),
 		},
+		Reviewers: func() []handler2.ReviewerAssignment {
+			reviewers := make([]handler2.ReviewerAssignment, 0, len(result.Reviewers))
+			for _, reviewer := range result.Reviewers {
+				item := handler2.ReviewerAssignment{
+					ReviewerId: reviewer.ReviewerID,
+					Source:     handler2.ReviewerAssignmentSource(reviewer.Source),
+				}
+				if reviewer.Source == pull_request_create.SourceCodeOwners {
+					item.RuleLine = &reviewer.RuleLine
+					item.RulePattern = &reviewer.RulePattern
+					item.Owner = &reviewer.Owner
+				}
+				reviewers = append(reviewers, item)
+			}
+			return reviewers
+		}(),
 	}
 	w.WriteHeader(http.StatusCreated)
 	if err = json.NewEncoder(w).Encode(out); err != nil {
@@ -102,15 +139,23 @@ func (h *createPullRequestHandler) handleUseCaseError(w http.ResponseWriter, ctx
 		errorMsg = "error occurred while checking pull request existence"
 	case errors.Is(err, usecase2.ErrGetUser):
 		errorMsg = "error occurred while getting author information"
+	case errors.Is(err, usecase2.ErrGetIdentities):
+		errorMsg = "error occurred while resolving author identity"
 	case errors.Is(err, usecase2.ErrGetUsers):
 		errorMsg = "error occurred while getting team members"
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting parent team"
+	case errors.Is(err, usecase2.ErrGetCodeOwners):
+		errorMsg = "error occurred while getting code owners"
+	case errors.Is(err, usecase2.ErrInvalidCodeOwners):
+		errorMsg = "stored code owners are invalid"
 	case errors.Is(err, usecase2.ErrSetPRStatus):
 		errorMsg = "error occurred while setting PR status"
 	case errors.Is(err, usecase2.ErrSavePullRequest):
 		errorMsg = "error occurred while saving pull request in db"
 	case errors.Is(err, usecase2.ErrAssignReviewer):
 		errorMsg = "error occurred while assigning reviewers"
-	case errors.Is(err, usecase2.ErrAuthorPrNotFound):
+	case errors.Is(err, usecase2.ErrAuthorPrNotFound), errors.Is(err, usecase2.ErrIdentityNotFound):
 		errorMsg = "author not found"
 		statusCode = http.StatusNotFound
 		errorResponseErrorCode = handler2.NOCANDIDATE
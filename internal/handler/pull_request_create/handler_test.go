@@ -36,7 +36,33 @@ func TestCreatePullRequest(t *testing.T) {
 	reqBody := handler.PostPullRequestCreateJSONRequestBody{
 		PullRequestId:   prID,
 		PullRequestName: "Add new feature",
-		AuthorId:        authorID,
+		AuthorId:        authorID.String(),
+	}
+	identityReqBody := handler.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: "Add new feature",
+		AuthorId:        "GitHub:Alice",
+	}
+	invalidAuthorReqBody := handler.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: "Add new feature",
+		AuthorId:        "bitbucket:alice",
+	}
+
+	repository := "org/service"
+	changedFiles := []string{"cmd/main.go", "README.md"}
+	codeOwnersReqBody := handler.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: "Add new feature",
+		AuthorId:        authorID.String(),
+		Repository:      &repository,
+		ChangedFiles:    &changedFiles,
+	}
+	withoutRepositoryReqBody := handler.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: "Add new feature",
+		AuthorId:        authorID.String(),
+		ChangedFiles:    &changedFiles,
 	}
 
 	now := time.Now()
@@ -52,6 +78,13 @@ func TestCreatePullRequest(t *testing.T) {
 		MergedAt:          time.Time{},
 	}
 
+	codeOwnersOut := ucOut
+	codeOwnersOut.Reviewers = []usecase.AssignedReviewer{
+		{ReviewerID: assigned[0], Source: usecase.SourceCodeOwners, RuleLine: 2, RulePattern: "*.go", Owner: "@alice"},
+		{ReviewerID: assigned[1], Source: usecase.SourceTeam},
+	}
+	ruleLine, rulePattern, owner := 2, "*.go", "@alice"
+
 	tests := []struct {
 		name        string
 		body        interface{}
@@ -100,6 +133,102 @@ func TestCreatePullRequest(t *testing.T) {
 			wantCode:  http.StatusUnprocessableEntity,
 			wantError: "validation failed",
 		},
+		{
+			name: "success with author identity",
+			body: identityReqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID:   prID,
+					PullRequestName: "Add new feature",
+					AuthorIdentity:  &usecase2.Identity{Provider: "github", ExternalID: "alice"},
+				}).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusCreated,
+			wantSuccess: &handler.CreatePullRequestResponse{
+				Pr: handler.PullRequest{
+					PullRequestId:     prID,
+					PullRequestName:   "Add new feature",
+					AuthorId:          authorID,
+					Status:            handler.PullRequestStatus("OPEN"),
+					AssignedReviewers: assigned,
+					CreatedAt:         &now,
+					MergedAt:          nil,
+				},
+			},
+		},
+		{
+			name: "success with code owners",
+			body: codeOwnersReqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID:   prID,
+					PullRequestName: "Add new feature",
+					AuthorID:        authorID,
+					Repository:      repository,
+					ChangedFiles:    changedFiles,
+				}).Return(&codeOwnersOut, nil)
+			},
+			wantCode: http.StatusCreated,
+			wantSuccess: &handler.CreatePullRequestResponse{
+				Pr: handler.PullRequest{
+					PullRequestId:     prID,
+					PullRequestName:   "Add new feature",
+					AuthorId:          authorID,
+					Status:            handler.PullRequestStatus("OPEN"),
+					AssignedReviewers: assigned,
+				},
+				Reviewers: []handler.ReviewerAssignment{
+					{
+						ReviewerId:  assigned[0],
+						Source:      handler.CODEOWNERS,
+						RuleLine:    &ruleLine,
+						RulePattern: &rulePattern,
+						Owner:       &owner,
+					},
+					{ReviewerId: assigned[1], Source: handler.TEAM},
+				},
+			},
+		},
+		{
+			name:      "validation failed - changed files without repository",
+			body:      withoutRepositoryReqBody,
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name:      "invalid author_id",
+			body:      invalidAuthorReqBody,
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "author_id must be uuid or provider:external_id",
+		},
+		{
+			name: "usecase returns ErrIdentityNotFound",
+			body: identityReqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID:   prID,
+					PullRequestName: "Add new feature",
+					AuthorIdentity:  &usecase2.Identity{Provider: "github", ExternalID: "alice"},
+				}).Return(nil, usecase2.ErrIdentityNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "author not found",
+		},
+		{
+			name: "usecase returns ErrGetIdentities",
+			body: identityReqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID:   prID,
+					PullRequestName: "Add new feature",
+					AuthorIdentity:  &usecase2.Identity{Provider: "github", ExternalID: "alice"},
+				}).Return(nil, usecase2.ErrGetIdentities)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while resolving author identity",
+		},
 		{
 			name: "usecase returns ErrAuthorPrNotFound",
 			body: reqBody,
@@ -152,6 +281,32 @@ func TestCreatePullRequest(t *testing.T) {
 			wantCode:  http.StatusInternalServerError,
 			wantError: "error occurred while getting team members",
 		},
+		{
+			name: "usecase returns ErrGetTeam",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID:   prID,
+					PullRequestName: "Add new feature",
+					AuthorID:        authorID,
+				}).Return(nil, usecase2.ErrGetTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting parent team",
+		},
+		{
+			name: "usecase returns ErrGetCodeOwners",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID:   prID,
+					PullRequestName: "Add new feature",
+					AuthorID:        authorID,
+				}).Return(nil, usecase2.ErrGetCodeOwners)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting code owners",
+		},
 		{
 			name: "usecase returns ErrSetPRStatus",
 			body: reqBody,
@@ -251,6 +406,9 @@ func TestCreatePullRequest(t *testing.T) {
 				assert.Equal(t, tt.wantSuccess.Pr.AssignedReviewers, got.Pr.AssignedReviewers)
 				assert.Nil(t, got.Pr.MergedAt)
 				assert.NotNil(t, got.Pr.CreatedAt)
+				if tt.wantSuccess.Reviewers != nil {
+					assert.Equal(t, tt.wantSuccess.Reviewers, got.Reviewers)
+				}
 			}
 
 			if tt.wantError != "" {
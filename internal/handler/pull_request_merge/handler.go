@@ -9,6 +9,7 @@ import (
 
 	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
 	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/handler/middleware"
 	"pr-reviewers-service/internal/logging"
 	usecase2 "pr-reviewers-service/internal/usecase"
 	"pr-reviewers-service/internal/usecase/pull_request_merge"
@@ -37,8 +38,10 @@ func New(usecase usecase, validator *validator.Validate) *mergePullRequestHandle
 // @Param input body handler2.PostPullRequestMergeJSONRequestBody true "Pull request merge data"
 // @Success 200 {object} handler2.MergePullRequestResponse "PR successfully merged or already merged"
 // @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 403 {object} handler2.ErrorResponse "Users can merge only their own pull requests"
 // @Failure 422 {object} handler2.ErrorResponse "Validation failed"
 // @Failure 404 {object} handler2.ErrorResponse "Pull request not found"
+// @Failure 409 {object} handler2.ErrorResponse "Pull request was closed without merge"
 // @Failure 500 {object} handler2.ErrorResponse "Internal server error"
 // @Router /pullRequest/merge [post]
 func (h *mergePullRequestHandler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
@@ -60,6 +63,7 @@ func (h *mergePullRequestHandler) MergePullRequest(w http.ResponseWriter, r *htt
 
 	result, err := h.usecase.Run(ctx, pull_request_merge.In{
 		PullRequestID: request.PullRequestId,
+		RequesterID:   middleware.RestrictedUserID(ctx),
 	})
 	if err != nil && !errors.Is(err, usecase2.ErrPullRequestAlreadyMerged) {
 		h.handleUseCaseError(w, ctx, err)
@@ -95,6 +99,10 @@ func (h *mergePullRequestHandler) handleUseCaseError(w http.ResponseWriter, ctx
 	errorMsg := "internal server error"
 
 	switch {
+	case errors.Is(err, usecase2.ErrNotPullRequestAuthor):
+		errorMsg = "insufficient permissions"
+		statusCode = http.StatusForbidden
+		errorResponseErrorCode = handler2.FORBIDDEN
 	case errors.Is(err, usecase2.ErrGetPullRequest):
 		errorMsg = "error occurred while getting pull request"
 	case errors.Is(err, usecase2.ErrGetPRStatus):
@@ -109,6 +117,10 @@ func (h *mergePullRequestHandler) handleUseCaseError(w http.ResponseWriter, ctx
 		errorMsg = "pull request not found"
 		statusCode = http.StatusNotFound
 		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrPullRequestClosed):
+		errorMsg = "pull request is closed"
+		statusCode = http.StatusConflict
+		errorResponseErrorCode = handler2.PRCLOSED
 	}
 
 	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler/middleware"
 	handlerPR "pr-reviewers-service/internal/handler/pull_request_merge"
 	mockPR "pr-reviewers-service/internal/handler/pull_request_merge/mocks"
 	usecase2 "pr-reviewers-service/internal/usecase"
@@ -52,6 +53,7 @@ func TestMergePullRequest(t *testing.T) {
 
 	tests := []struct {
 		name        string
+		principal   *middleware.Principal
 		body        interface{}
 		mock        func()
 		wantCode    int
@@ -104,6 +106,17 @@ func TestMergePullRequest(t *testing.T) {
 			wantCode:  http.StatusNotFound,
 			wantError: "pull request not found",
 		},
+		{
+			name: "usecase returns ErrPullRequestClosed",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID: prID,
+				}).Return(nil, usecase2.ErrPullRequestClosed)
+			},
+			wantCode:  http.StatusConflict,
+			wantError: "pull request is closed",
+		},
 		{
 			name: "usecase returns ErrGetPullRequest",
 			body: reqBody,
@@ -170,6 +183,31 @@ func TestMergePullRequest(t *testing.T) {
 			wantCode:  http.StatusInternalServerError,
 			wantError: "internal server error",
 		},
+		{
+			name:      "user merges own pull request",
+			principal: &middleware.Principal{UserID: authorID, Role: middleware.User},
+			body:      reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID: prID,
+					RequesterID:   authorID,
+				}).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:      "user merges someone else's pull request",
+			principal: &middleware.Principal{UserID: assigned[0], Role: middleware.User},
+			body:      reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID: prID,
+					RequesterID:   assigned[0],
+				}).Return(nil, usecase2.ErrNotPullRequestAuthor)
+			},
+			wantCode:  http.StatusForbidden,
+			wantError: "insufficient permissions",
+		},
 	}
 
 	for _, tt := range tests {
@@ -187,6 +225,9 @@ func TestMergePullRequest(t *testing.T) {
 			}
 
 			req := httptest.NewRequest("POST", "/pullRequest/merge", bytes.NewReader(bodyBytes))
+			if tt.principal != nil {
+				req = req.WithContext(middleware.WithPrincipal(req.Context(), *tt.principal))
+			}
 			w := httptest.NewRecorder()
 
 			h.MergePullRequest(w, req)
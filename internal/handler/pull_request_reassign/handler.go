@@ -9,6 +9,7 @@ import (
 
 	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
 	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/handler/middleware"
 	"pr-reviewers-service/internal/logging"
 	usecase2 "pr-reviewers-service/internal/usecase"
 	"pr-reviewers-service/internal/usecase/pull_request_reassign"
@@ -37,9 +38,10 @@ func New(usecase usecase, validator *validator.Validate) *reassignPullRequestHan
 // @Param input body handler2.PostPullRequestReassignJSONRequestBody true "Reassignment data"
 // @Success 200 {object} handler2.ReassignPullRequestResponse "Reviewer successfully reassigned"
 // @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 403 {object} handler2.ErrorResponse "Users can reassign only their own reviews"
 // @Failure 422 {object} handler2.ErrorResponse "Validation failed"
 // @Failure 404 {object} handler2.ErrorResponse "Pull request, reviewer, author not found or no available reviewers"
-// @Failure 409 {object} handler2.ErrorResponse "Pull request already merged"
+// @Failure 409 {object} handler2.ErrorResponse "Pull request already merged or closed"
 // @Failure 500 {object} handler2.ErrorResponse "Internal server error"
 // @Router /pullRequest/reassign [post]
 func (h *reassignPullRequestHandler) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
@@ -57,6 +59,11 @@ func (h *reassignPullRequestHandler) ReassignPullRequest(w http.ResponseWriter,
 		return
 	}
 
+	if !middleware.CanActAs(ctx, request.OldReviewerId) {
+		middleware.RespondForbidden(w, ctx)
+		return
+	}
+
 	ctx = logging.WithLogPullRequestID(ctx, request.PullRequestId)
 
 	result, err := h.usecase.Run(ctx, pull_request_reassign.In{
@@ -108,6 +115,8 @@ func (h *reassignPullRequestHandler) handleUseCaseError(w http.ResponseWriter, c
 		errorMsg = "error occurred while getting user"
 	case errors.Is(err, usecase2.ErrGetUsers):
 		errorMsg = "error occurred while getting users"
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting parent team"
 	case errors.Is(err, usecase2.ErrRemoveReviewer):
 		errorMsg = "error occurred while removing reviewer"
 	case errors.Is(err, usecase2.ErrAssignReviewer):
@@ -128,6 +137,10 @@ func (h *reassignPullRequestHandler) handleUseCaseError(w http.ResponseWriter, c
 		errorMsg = "pull request already merged"
 		statusCode = http.StatusConflict
 		errorResponseErrorCode = handler2.PRMERGED
+	case errors.Is(err, usecase2.ErrPullRequestClosed):
+		errorMsg = "pull request is closed"
+		statusCode = http.StatusConflict
+		errorResponseErrorCode = handler2.PRCLOSED
 	case errors.Is(err, usecase2.ErrAuthorPrNotFound):
 		errorMsg = "author not found"
 		statusCode = http.StatusNotFound
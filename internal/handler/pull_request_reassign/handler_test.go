@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler/middleware"
 	handlerPR "pr-reviewers-service/internal/handler/pull_request_reassign"
 	mockPR "pr-reviewers-service/internal/handler/pull_request_reassign/mocks"
 	usecase2 "pr-reviewers-service/internal/usecase"
@@ -56,6 +57,7 @@ func TestReassignPullRequest(t *testing.T) {
 
 	tests := []struct {
 		name        string
+		principal   *middleware.Principal
 		body        interface{}
 		mock        func()
 		wantCode    int
@@ -147,6 +149,18 @@ func TestReassignPullRequest(t *testing.T) {
 			wantCode:  http.StatusConflict,
 			wantError: "pull request already merged",
 		},
+		{
+			name: "usecase returns ErrPullRequestClosed",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID: prID,
+					OldUserId:     oldReviewerID,
+				}).Return(nil, usecase2.ErrPullRequestClosed)
+			},
+			wantCode:  http.StatusConflict,
+			wantError: "pull request is closed",
+		},
 		{
 			name: "usecase returns ErrAuthorPrNotFound",
 			body: reqBody,
@@ -219,6 +233,18 @@ func TestReassignPullRequest(t *testing.T) {
 			wantCode:  http.StatusInternalServerError,
 			wantError: "error occurred while getting users",
 		},
+		{
+			name: "usecase returns ErrGetTeam",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID: prID,
+					OldUserId:     oldReviewerID,
+				}).Return(nil, usecase2.ErrGetTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting parent team",
+		},
 		{
 			name: "usecase returns ErrRemoveReviewer",
 			body: reqBody,
@@ -255,6 +281,26 @@ func TestReassignPullRequest(t *testing.T) {
 			wantCode:  http.StatusInternalServerError,
 			wantError: "internal server error",
 		},
+		{
+			name:      "user reassigns someone else's review",
+			principal: &middleware.Principal{UserID: uuid.New(), Role: middleware.User},
+			body:      reqBody,
+			mock:      func() {},
+			wantCode:  http.StatusForbidden,
+			wantError: "insufficient permissions",
+		},
+		{
+			name:      "user reassigns own review",
+			principal: &middleware.Principal{UserID: oldReviewerID, Role: middleware.User},
+			body:      reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{
+					PullRequestID: prID,
+					OldUserId:     oldReviewerID,
+				}).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+		},
 	}
 
 	for _, tt := range tests {
@@ -272,6 +318,9 @@ func TestReassignPullRequest(t *testing.T) {
 			}
 
 			req := httptest.NewRequest("POST", "/pullRequest/reassign", bytes.NewReader(bodyBytes))
+			if tt.principal != nil {
+				req = req.WithContext(middleware.WithPrincipal(req.Context(), *tt.principal))
+			}
 			w := httptest.NewRecorder()
 
 			h.ReassignPullRequest(w, req)
@@ -0,0 +1,12 @@
+package review_snooze
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/review_snooze"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=review_snooze usecase
+type usecase interface {
+	Run(ctx context.Context, req review_snooze.In) (*review_snooze.Out, error)
+}
@@ -0,0 +1,123 @@
+package review_snooze
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/handler/middleware"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/review_snooze"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type reviewSnoozeHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *reviewSnoozeHandler {
+	return &reviewSnoozeHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Snooze review reminders
+// @Description Stop stale-review reminders about one PR for its reviewer until the given time.
+// @Description A repeated call replaces the previous snooze.
+// @ID SnoozeReview
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostUsersSnoozeReviewJSONRequestBody true "Snooze data"
+// @Success 200 {object} handler2.SnoozeReviewResponse "Reminders snoozed"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data or time in the past"
+// @Failure 403 {object} handler2.ErrorResponse "Users can snooze only their own reviews"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "User, pull request or reviewer not found"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /users/snoozeReview [post]
+func (h *reviewSnoozeHandler) SnoozeReview(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostUsersSnoozeReviewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	if !middleware.CanActAs(ctx, request.UserId) {
+		middleware.RespondForbidden(w, ctx)
+		return
+	}
+
+	ctx = logging.WithLogUserId(ctx, request.UserId)
+
+	result, err := h.usecase.Run(ctx, review_snooze.In{
+		UserID:        request.UserId,
+		PullRequestID: request.PullRequestId,
+		Until:         request.Until,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.SnoozeReviewResponse{
+		UserId:        result.UserID,
+		PullRequestId: result.PullRequestID,
+		SnoozedUntil:  result.SnoozedUntil,
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *reviewSnoozeHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetUser):
+		errorMsg = "error occurred while getting user"
+	case errors.Is(err, usecase2.ErrGetPullRequest):
+		errorMsg = "error occurred while getting pull request"
+	case errors.Is(err, usecase2.ErrGetPRReviewers):
+		errorMsg = "error occurred while getting pr reviewers"
+	case errors.Is(err, usecase2.ErrSaveReviewSnooze):
+		errorMsg = "error occurred while saving review snooze"
+	case errors.Is(err, usecase2.ErrSnoozeInPast):
+		errorMsg = "snooze time must be in the future"
+		statusCode = http.StatusBadRequest
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		errorMsg = "user not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrPullRequestNotFound):
+		errorMsg = "pull request not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrReviewerNotFound):
+		errorMsg = "user is not a reviewer of this pull request"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
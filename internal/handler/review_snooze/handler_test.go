@@ -0,0 +1,205 @@
+package review_snooze_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler/middleware"
+	handlerSnooze "pr-reviewers-service/internal/handler/review_snooze"
+	mockSnooze "pr-reviewers-service/internal/handler/review_snooze/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseSnooze "pr-reviewers-service/internal/usecase/review_snooze"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnoozeReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockSnooze.NewMockusecase(ctrl)
+	h := handlerSnooze.New(mockUC, validate)
+
+	userID := uuid.New()
+	prID := uuid.New()
+	until := time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC)
+
+	reqBody := handler2.PostUsersSnoozeReviewJSONRequestBody{
+		UserId:        userID,
+		PullRequestId: prID,
+		Until:         until,
+	}
+	ucIn := usecaseSnooze.In{
+		UserID:        userID,
+		PullRequestID: prID,
+		Until:         until,
+	}
+
+	tests := []struct {
+		name      string
+		principal *middleware.Principal
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&usecaseSnooze.Out{
+					UserID:        userID,
+					PullRequestID: prID,
+					SnoozedUntil:  until,
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.SnoozeReviewResponse{
+				UserId:        userID,
+				PullRequestId: prID,
+				SnoozedUntil:  until,
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name: "validation failed - missing until",
+			body: struct {
+				UserId        uuid.UUID `json:"user_id"`
+				PullRequestId uuid.UUID `json:"pull_request_id"`
+			}{
+				UserId:        userID,
+				PullRequestId: prID,
+			},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrSnoozeInPast",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrSnoozeInPast)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "snooze time must be in the future",
+		},
+		{
+			name: "usecase returns ErrUserNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUserNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "user not found",
+		},
+		{
+			name: "usecase returns ErrPullRequestNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrPullRequestNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "pull request not found",
+		},
+		{
+			name: "usecase returns ErrReviewerNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrReviewerNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "user is not a reviewer of this pull request",
+		},
+		{
+			name: "usecase returns ErrSaveReviewSnooze",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrSaveReviewSnooze)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while saving review snooze",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+		{
+			name:      "user snoozes someone else's review",
+			principal: &middleware.Principal{UserID: uuid.New(), Role: middleware.User},
+			body:      reqBody,
+			mock:      func() {},
+			wantCode:  http.StatusForbidden,
+			wantError: "insufficient permissions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			case nil:
+				bodyBytes = nil
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/users/snoozeReview", bytes.NewReader(bodyBytes))
+			if tt.principal != nil {
+				req = req.WithContext(middleware.WithPrincipal(req.Context(), *tt.principal))
+			}
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.SnoozeReview(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.SnoozeReviewResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package review_stream
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/review_stream"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=review_stream usecase
+type usecase interface {
+	Run(ctx context.Context, req review_stream.In) (*review_stream.Out, error)
+}
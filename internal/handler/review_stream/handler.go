@@ -0,0 +1,165 @@
+package review_stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/handler/middleware"
+	"pr-reviewers-service/internal/infrastructure/event_bus"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/review_stream"
+
+	"github.com/google/uuid"
+)
+
+type reviewStreamHandler struct {
+	usecase      usecase
+	keepAlive    time.Duration
+	writeTimeout time.Duration
+}
+
+// New builds the review stream handler. A comment is sent after keepAlive without events so that proxies keep
+// the connection open, writeTimeout bounds every write in place of the server write timeout.
+func New(usecase usecase, keepAlive, writeTimeout time.Duration) *reviewStreamHandler {
+	return &reviewStreamHandler{
+		usecase:      usecase,
+		keepAlive:    keepAlive,
+		writeTimeout: writeTimeout,
+	}
+}
+
+// @Summary Stream review queue changes
+// @Description Server-Sent Events stream of the user's review queue: assignments, unassignments and merged or
+// @Description closed pull requests the user reviews. The event id is the event UUID, a reconnecting client
+// @Description passes the last one in Last-Event-ID to get the events it missed.
+// @ID StreamUserReviews
+// @Tags Reviews
+// @Produce event-stream
+// @Param user_id query string true "User ID" format(uuid)
+// @Param Last-Event-ID header string false "Last received event ID" format(uuid)
+// @Success 200 {object} handler2.ReviewStreamEvent "Stream of events, data is the JSON event"
+// @Failure 400 {object} handler2.ErrorResponse "Missing or invalid user_id or Last-Event-ID"
+// @Failure 403 {object} handler2.ErrorResponse "Users can read only their own review queue"
+// @Failure 404 {object} handler2.ErrorResponse "User not found"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /users/reviewStream [get]
+func (h *reviewStreamHandler) StreamUserReviews(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	userIDStr := r.URL.Query().Get("user_id")
+	if userIDStr == "" {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "user_id is required", nil)
+		return
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "invalid user_id format", err)
+		return
+	}
+	var lastEventID uuid.UUID
+	if lastEventIDStr := r.Header.Get("Last-Event-ID"); lastEventIDStr != "" {
+		lastEventID, err = uuid.Parse(lastEventIDStr)
+		if err != nil {
+			handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "invalid Last-Event-ID format", err)
+			return
+		}
+	}
+
+	if !middleware.CanActAs(ctx, userID) {
+		middleware.RespondForbidden(w, ctx)
+		return
+	}
+
+	ctx = logging.WithLogUserId(ctx, userID)
+
+	result, err := h.usecase.Run(ctx, review_stream.In{
+		UserID:      userID,
+		LastEventID: lastEventID,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+	subscription := result.Subscription
+	defer subscription.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	if err = h.send(rc, w, nil); err != nil {
+		slog.WarnContext(ctx, "Review stream is not supported by the connection", "error", err)
+		return
+	}
+	for _, message := range subscription.Replay() {
+		if err = h.send(rc, w, frame(message)); err != nil {
+			slog.DebugContext(ctx, "Review stream client gone", "error", err)
+			return
+		}
+	}
+
+	keepAlive := time.NewTicker(h.keepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			slog.DebugContext(ctx, "Review stream client disconnected")
+			return
+		case message, ok := <-subscription.Events():
+			if !ok {
+				slog.DebugContext(ctx, "Review stream closed by the event bus")
+				return
+			}
+			err = h.send(rc, w, frame(message))
+		case <-keepAlive.C:
+			err = h.send(rc, w, []byte(": keepalive\n\n"))
+		}
+		if err != nil {
+			slog.DebugContext(ctx, "Review stream client gone", "error", err)
+			return
+		}
+	}
+}
+
+// send writes and flushes data, the write deadline is moved forward first so that the stream outlives the
+// server write timeout.
+func (h *reviewStreamHandler) send(rc *http.ResponseController, w http.ResponseWriter, data []byte) error {
+	if err := rc.SetWriteDeadline(time.Now().Add(h.writeTimeout)); err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	return rc.Flush()
+}
+
+func frame(message event_bus.Message) []byte {
+	return fmt.Appendf(nil, "id: %s\nevent: %s\ndata: %s\n\n", message.ID, message.Type, message.Data)
+}
+
+func (h *reviewStreamHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetUser):
+		errorMsg = "error occurred while getting user from db"
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		errorMsg = "user not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
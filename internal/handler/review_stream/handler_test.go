@@ -0,0 +1,176 @@
+package review_stream_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	handler "pr-reviewers-service/internal/generated/api/v1/handler"
+	review_stream_handler "pr-reviewers-service/internal/handler/review_stream"
+	mock_review_stream "pr-reviewers-service/internal/handler/review_stream/mocks"
+	"pr-reviewers-service/internal/infrastructure/event_bus"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/review_stream"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamUserReviewsErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUC := mock_review_stream.NewMockusecase(ctrl)
+	h := review_stream_handler.New(mockUC, time.Second, time.Second)
+
+	userID := uuid.New()
+
+	tests := []struct {
+		name        string
+		query       string
+		lastEventID string
+		mock        func()
+		wantCode    int
+		wantError   handler.ErrorResponseErrorCode
+	}{
+		{
+			name:      "missing user_id",
+			query:     "",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: handler.BADREQUEST,
+		},
+		{
+			name:      "invalid user_id",
+			query:     "?user_id=123",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: handler.BADREQUEST,
+		},
+		{
+			name:        "invalid Last-Event-ID",
+			query:       "?user_id=" + userID.String(),
+			lastEventID: "42",
+			mock:        func() {},
+			wantCode:    http.StatusBadRequest,
+			wantError:   handler.BADREQUEST,
+		},
+		{
+			name:  "user not found",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecase.In{UserID: userID}).Return(nil, usecase2.ErrUserNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: handler.NOTFOUND,
+		},
+		{
+			name:  "get user error",
+			query: "?user_id=" + userID.String(),
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), gomock.Any()).Return(nil, errors.Join(usecase2.ErrGetUser, errors.New("db error")))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: handler.UNKNOWN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			req := httptest.NewRequest(http.MethodGet, "/users/reviewStream"+tt.query, nil)
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+			w := httptest.NewRecorder()
+
+			h.StreamUserReviews(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			var resp handler.ErrorResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			assert.Equal(t, tt.wantError, resp.Error.Code)
+		})
+	}
+}
+
+func TestStreamUserReviews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	seen := event_bus.Message{ID: uuid.New(), Type: usecase2.EventReviewerAssigned, Data: []byte(`{"n":1}`)}
+	missed := event_bus.Message{ID: uuid.New(), Type: usecase2.EventReviewerUnassigned, Data: []byte(`{"n":2}`)}
+	live := event_bus.Message{ID: uuid.New(), Type: usecase2.EventPullRequestMerged, Data: []byte(`{"n":3}`)}
+
+	bus := event_bus.New(10, 10)
+	bus.Publish(userID, seen)
+	bus.Publish(userID, missed)
+
+	mockUC := mock_review_stream.NewMockusecase(ctrl)
+	mockUC.EXPECT().
+		Run(gomock.Any(), usecase.In{UserID: userID, LastEventID: seen.ID}).
+		DoAndReturn(func(_ any, req usecase.In) (*usecase.Out, error) {
+			return &usecase.Out{Subscription: bus.Subscribe(req.UserID, req.LastEventID)}, nil
+		})
+
+	h := review_stream_handler.New(mockUC, 20*time.Millisecond, time.Second)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(h.StreamUserReviews))
+	server.Config.WriteTimeout = 50 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"?user_id="+userID.String(), nil)
+	require.NoError(t, err)
+	req.Header.Set("Last-Event-ID", seen.ID.String())
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+
+	reader := bufio.NewReader(resp.Body)
+	readFrame := func() string {
+		var frame strings.Builder
+		for {
+			line, err := reader.ReadString('\n')
+			require.NoError(t, err)
+			if line == "\n" {
+				return frame.String()
+			}
+			frame.WriteString(line)
+		}
+	}
+
+	assert.Equal(t, "id: "+missed.ID.String()+"\nevent: reviewer.unassigned\ndata: {\"n\":2}\n", readFrame())
+	assert.Equal(t, ": keepalive\n", readFrame())
+
+	// the stream outlives the server write timeout
+	time.Sleep(100 * time.Millisecond)
+	bus.Publish(userID, live)
+	for {
+		frame := readFrame()
+		if frame == ": keepalive\n" {
+			continue
+		}
+		assert.Equal(t, "id: "+live.ID.String()+"\nevent: pull_request.merged\ndata: {\"n\":3}\n", frame)
+		break
+	}
+
+	bus.Close()
+	for {
+		if _, err = reader.ReadString('\n'); err != nil {
+			break
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package scim
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/scim_groups"
+	"pr-reviewers-service/internal/usecase/scim_users"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=scim usersUsecase,groupsUsecase
+type usersUsecase interface {
+	Create(ctx context.Context, req scim_users.CreateIn) (*scim_users.User, error)
+	Get(ctx context.Context, userID uuid.UUID) (*scim_users.User, error)
+	List(ctx context.Context, req scim_users.ListIn) (*scim_users.ListOut, error)
+	Patch(ctx context.Context, req scim_users.PatchIn) (*scim_users.User, error)
+	Delete(ctx context.Context, userID uuid.UUID) error
+}
+
+type groupsUsecase interface {
+	Create(ctx context.Context, req scim_groups.CreateIn) (*scim_groups.Group, error)
+	Get(ctx context.Context, groupID uuid.UUID) (*scim_groups.Group, error)
+	List(ctx context.Context, req scim_groups.ListIn) (*scim_groups.ListOut, error)
+	Patch(ctx context.Context, req scim_groups.PatchIn) (*scim_groups.Group, error)
+	Delete(ctx context.Context, groupID uuid.UUID) error
+}
@@ -0,0 +1,311 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/scim_groups"
+
+	"github.com/google/uuid"
+)
+
+// @Summary Create SCIM group
+// @Description Create a team, members still in the default team make it their primary team
+// @ID ScimCreateGroup
+// @Tags SCIM
+// @Accept json
+// @Produce json
+// @Param input body handler2.ScimGroup true "SCIM group"
+// @Success 201 {object} handler2.ScimGroup "Group created"
+// @Failure 400 {object} handler2.ScimError "Invalid request data or unknown member"
+// @Failure 401 {object} handler2.ScimError "Invalid bearer token"
+// @Failure 409 {object} handler2.ScimError "displayName already taken"
+// @Failure 500 {object} handler2.ScimError "Internal server error"
+// @Router /scim/v2/Groups [post]
+func (h *scimHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var request handler2.ScimGroup
+	if err := h.decode(r, &request); err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+	ctx = logging.WithLogTeamName(ctx, request.DisplayName)
+
+	in := scim_groups.CreateIn{DisplayName: request.DisplayName}
+	if request.Members != nil {
+		for _, member := range *request.Members {
+			in.MemberIDs = append(in.MemberIDs, member.Value)
+		}
+	}
+
+	result, err := h.groups.Create(ctx, in)
+	if err != nil {
+		handleGroupsError(w, ctx, err)
+		return
+	}
+
+	group := toScimGroup(r, result)
+	w.Header().Set("Location", group.Meta.Location)
+	respond(w, ctx, http.StatusCreated, group)
+}
+
+// @Summary Get SCIM group
+// @ID ScimGetGroup
+// @Tags SCIM
+// @Produce json
+// @Param id path string true "Team id"
+// @Success 200 {object} handler2.ScimGroup "Group"
+// @Failure 401 {object} handler2.ScimError "Invalid bearer token"
+// @Failure 404 {object} handler2.ScimError "Group not found"
+// @Failure 500 {object} handler2.ScimError "Internal server error"
+// @Router /scim/v2/Groups/{id} [get]
+func (h *scimHandler) GetGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	groupID, err := pathID(r)
+	if err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+
+	result, err := h.groups.Get(ctx, groupID)
+	if err != nil {
+		handleGroupsError(w, ctx, err)
+		return
+	}
+
+	respond(w, ctx, http.StatusOK, toScimGroup(r, result))
+}
+
+// @Summary List SCIM groups
+// @Description Filter supports only displayName eq, archived teams are listed only when filtered by name
+// @ID ScimListGroups
+// @Tags SCIM
+// @Produce json
+// @Param filter query string false "displayName eq \"value\""
+// @Param startIndex query int false "1-based index of the first result"
+// @Param count query int false "Page size, at most 100"
+// @Success 200 {object} handler2.ScimGroupListResponse "Groups"
+// @Failure 400 {object} handler2.ScimError "Unsupported filter or invalid paging"
+// @Failure 401 {object} handler2.ScimError "Invalid bearer token"
+// @Failure 500 {object} handler2.ScimError "Internal server error"
+// @Router /scim/v2/Groups [get]
+func (h *scimHandler) ListGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	startIndex, count, err := parsePaging(r)
+	if err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+	in := scim_groups.ListIn{StartIndex: startIndex, Count: count}
+	if filter := r.URL.Query().Get("filter"); filter != "" {
+		_, value, err := parseFilter(filter, "displayName")
+		if err != nil {
+			respondWithScimError(w, ctx, err)
+			return
+		}
+		in.DisplayName = value
+	}
+
+	result, err := h.groups.List(ctx, in)
+	if err != nil {
+		handleGroupsError(w, ctx, err)
+		return
+	}
+
+	resources := make([]handler2.ScimGroup, 0, len(result.Groups))
+	for i := range result.Groups {
+		resources = append(resources, toScimGroup(r, &result.Groups[i]))
+	}
+	respond(w, ctx, http.StatusOK, handler2.ScimGroupListResponse{
+		Schemas:      []string{listSchema},
+		TotalResults: result.TotalResults,
+		StartIndex:   result.StartIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+// @Summary Patch SCIM group
+// @Description Supports displayName and members, including removal by members[value eq "id"]
+// @ID ScimPatchGroup
+// @Tags SCIM
+// @Accept json
+// @Produce json
+// @Param id path string true "Team id"
+// @Param input body handler2.ScimPatchRequest true "SCIM patch operations"
+// @Success 200 {object} handler2.ScimGroup "Patched group"
+// @Failure 400 {object} handler2.ScimError "Invalid operation, path, value or unknown member"
+// @Failure 401 {object} handler2.ScimError "Invalid bearer token"
+// @Failure 404 {object} handler2.ScimError "Group not found"
+// @Failure 409 {object} handler2.ScimError "displayName already taken or default team renamed"
+// @Failure 500 {object} handler2.ScimError "Internal server error"
+// @Router /scim/v2/Groups/{id} [patch]
+func (h *scimHandler) PatchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	groupID, err := pathID(r)
+	if err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+
+	var request handler2.ScimPatchRequest
+	if err = h.decode(r, &request); err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+	in, err := groupPatch(request)
+	if err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+	in.GroupID = groupID
+
+	result, err := h.groups.Patch(ctx, *in)
+	if err != nil {
+		handleGroupsError(w, ctx, err)
+		return
+	}
+
+	respond(w, ctx, http.StatusOK, toScimGroup(r, result))
+}
+
+// @Summary Delete SCIM group
+// @Description Remove the team, its primary members are moved back to the default team
+// @ID ScimDeleteGroup
+// @Tags SCIM
+// @Param id path string true "Team id"
+// @Success 204 "Group deleted"
+// @Failure 401 {object} handler2.ScimError "Invalid bearer token"
+// @Failure 404 {object} handler2.ScimError "Group not found"
+// @Failure 409 {object} handler2.ScimError "Default team cannot be deleted"
+// @Failure 500 {object} handler2.ScimError "Internal server error"
+// @Router /scim/v2/Groups/{id} [delete]
+func (h *scimHandler) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	groupID, err := pathID(r)
+	if err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+
+	if err = h.groups.Delete(ctx, groupID); err != nil {
+		handleGroupsError(w, ctx, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// groupPatch folds the operations into a single change, later operations on members override
+// earlier ones for the same member.
+func groupPatch(request handler2.ScimPatchRequest) (*scim_groups.PatchIn, error) {
+	ops, err := parsePatch(request)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &scim_groups.PatchIn{}
+	for _, op := range ops {
+		switch op.attr {
+		case "displayname":
+			if op.op == opRemove {
+				return nil, badRequest(handler2.Mutability, "displayName cannot be removed")
+			}
+			displayName, err := op.stringValue()
+			if err != nil {
+				return nil, err
+			}
+			if displayName == "" {
+				return nil, badRequest(handler2.InvalidValue, "displayName cannot be empty")
+			}
+			in.DisplayName = &displayName
+		case "members":
+			var memberIDs []uuid.UUID
+			switch {
+			case op.memberID != nil:
+				memberIDs = []uuid.UUID{*op.memberID}
+			case op.op == opRemove && op.value == nil:
+				op.op = opReplace
+			default:
+				if memberIDs, err = op.memberIDs(); err != nil {
+					return nil, err
+				}
+			}
+
+			switch op.op {
+			case opReplace:
+				replace := memberIDs
+				if replace == nil {
+					replace = []uuid.UUID{}
+				}
+				in.ReplaceMemberIDs = &replace
+				in.AddMemberIDs = nil
+				in.RemoveMemberIDs = nil
+			case opAdd:
+				in.RemoveMemberIDs = without(in.RemoveMemberIDs, memberIDs)
+				in.AddMemberIDs = append(without(in.AddMemberIDs, memberIDs), memberIDs...)
+			case opRemove:
+				in.AddMemberIDs = without(in.AddMemberIDs, memberIDs)
+				in.RemoveMemberIDs = append(without(in.RemoveMemberIDs, memberIDs), memberIDs...)
+			}
+		default:
+			if !op.implicit {
+				return nil, badRequest(handler2.InvalidPath, "attribute %s is not supported", op.attr)
+			}
+		}
+	}
+	return in, nil
+}
+
+func without(ids, excluded []uuid.UUID) []uuid.UUID {
+	return slices.DeleteFunc(ids, func(id uuid.UUID) bool {
+		return slices.Contains(excluded, id)
+	})
+}
+
+func toScimGroup(r *http.Request, group *scim_groups.Group) handler2.ScimGroup {
+	members := make([]handler2.ScimMember, 0, len(group.Members))
+	for _, member := range group.Members {
+		members = append(members, handler2.ScimMember{
+			Value:   member.ID,
+			Display: &member.UserName,
+		})
+	}
+
+	return handler2.ScimGroup{
+		Schemas:     []string{groupSchema},
+		Id:          &group.ID,
+		DisplayName: group.DisplayName,
+		Members:     &members,
+		Meta: &handler2.ScimMeta{
+			ResourceType: handler2.ScimMetaResourceTypeGroup,
+			Created:      group.CreatedAt,
+			Location:     location(r, "Groups", group.ID),
+		},
+	}
+}
+
+func handleGroupsError(w http.ResponseWriter, ctx context.Context, err error) {
+	switch {
+	case errors.Is(err, usecase2.ErrTeamNotFound):
+		respondWithError(w, ctx, http.StatusNotFound, "", "group not found", err)
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		respondWithError(w, ctx, http.StatusBadRequest, handler2.InvalidValue, "member not found", err)
+	case errors.Is(err, usecase2.ErrTeamAlreadyExists):
+		respondWithError(w, ctx, http.StatusConflict, handler2.Uniqueness, "group with such displayName already exists", err)
+	case errors.Is(err, usecase2.ErrDefaultTeamChange):
+		respondWithError(w, ctx, http.StatusConflict, "", "default team cannot be renamed or deleted", err)
+	default:
+		respondWithError(w, ctx, http.StatusInternalServerError, "", "internal server error", err)
+	}
+}
@@ -0,0 +1,191 @@
+package scim
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/logging"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+const (
+	basePath    = "/scim/v2"
+	contentType = "application/scim+json"
+
+	userSchema  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	listSchema  = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	patchSchema = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	errorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	// MaxCount caps the page size of list responses, larger counts are cut down as RFC 7644 allows.
+	MaxCount = 100
+)
+
+// filterPattern matches the only filter form supported, an equality on a single attribute.
+var filterPattern = regexp.MustCompile(`(?i)^\s*([a-z]+)\s+eq\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+type scimHandler struct {
+	users     usersUsecase
+	groups    groupsUsecase
+	validator *validator.Validate
+	token     []byte
+}
+
+func New(users usersUsecase, groups groupsUsecase, validator *validator.Validate, token string) *scimHandler {
+	return &scimHandler{
+		users:     users,
+		groups:    groups,
+		validator: validator,
+		token:     []byte(token),
+	}
+}
+
+// Authorize checks the bearer token of the identity provider, an empty configured token
+// rejects every request.
+func (h *scimHandler) Authorize(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if len(h.token) == 0 || !found || subtle.ConstantTimeCompare([]byte(token), h.token) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+			respondWithError(w, r.Context(), http.StatusUnauthorized, "", "invalid bearer token", nil)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// scimError carries the status and scimType of a request the handler rejects itself.
+type scimError struct {
+	status   int
+	scimType handler2.ScimErrorScimType
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func badRequest(scimType handler2.ScimErrorScimType, format string, args ...any) error {
+	return &scimError{status: http.StatusBadRequest, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+func respondWithError(w http.ResponseWriter, ctx context.Context, status int, scimType handler2.ScimErrorScimType, detail string, err error) {
+	if status == http.StatusInternalServerError {
+		slog.ErrorContext(logging.ErrorCtx(ctx, err), fmt.Sprintf("Error: %s", err.Error()))
+	}
+
+	response := handler2.ScimError{
+		Schemas: []string{errorSchema},
+		Status:  strconv.Itoa(status),
+		Detail:  &detail,
+	}
+	if scimType != "" {
+		response.ScimType = &scimType
+	}
+	respond(w, ctx, status, response)
+}
+
+func respondWithScimError(w http.ResponseWriter, ctx context.Context, err error) {
+	var scimErr *scimError
+	if errors.As(err, &scimErr) {
+		respondWithError(w, ctx, scimErr.status, scimErr.scimType, scimErr.detail, nil)
+		return
+	}
+	respondWithError(w, ctx, http.StatusInternalServerError, "", "internal server error", err)
+}
+
+func respond(w http.ResponseWriter, ctx context.Context, status int, body any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		slog.ErrorContext(ctx, "Failed to encode SCIM response", "error", err)
+	}
+}
+
+// decode reads a request body, a body violating the schema is reported with invalidValue.
+func (h *scimHandler) decode(r *http.Request, request any) error {
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		return badRequest(handler2.InvalidSyntax, "failed to decode request: %s", err)
+	}
+	if err := h.validator.Struct(request); err != nil {
+		return badRequest(handler2.InvalidValue, "validation failed: %s", err)
+	}
+	return nil
+}
+
+func pathID(r *http.Request) (uuid.UUID, error) {
+	id, err := uuid.Parse(mux.Vars(r)["id"])
+	if err != nil {
+		return uuid.Nil, &scimError{status: http.StatusNotFound, detail: "resource not found"}
+	}
+	return id, nil
+}
+
+// parsePaging reads startIndex and count. As RFC 7644 requires, a startIndex below 1 is treated as 1
+// and a negative count as 0.
+func parsePaging(r *http.Request) (startIndex, count int, err error) {
+	startIndex, count = 1, MaxCount
+	query := r.URL.Query()
+	if raw := query.Get("startIndex"); raw != "" {
+		if startIndex, err = strconv.Atoi(raw); err != nil {
+			return 0, 0, badRequest(handler2.InvalidValue, "startIndex must be an integer")
+		}
+	}
+	if raw := query.Get("count"); raw != "" {
+		if count, err = strconv.Atoi(raw); err != nil {
+			return 0, 0, badRequest(handler2.InvalidValue, "count must be an integer")
+		}
+	}
+	return max(startIndex, 1), min(max(count, 0), MaxCount), nil
+}
+
+// parseFilter returns the lowercased attribute and the value of an `attr eq "value"` filter,
+// the attribute must be one of allowed.
+func parseFilter(filter string, allowed ...string) (attr, value string, err error) {
+	match := filterPattern.FindStringSubmatch(filter)
+	if match == nil {
+		return "", "", badRequest(handler2.InvalidFilter, "only `attribute eq \"value\"` filters are supported")
+	}
+	attr = strings.ToLower(match[1])
+	found := false
+	for _, name := range allowed {
+		if strings.ToLower(name) == attr {
+			found = true
+		}
+	}
+	if !found {
+		return "", "", badRequest(handler2.InvalidFilter, "filtering by %s is not supported", match[1])
+	}
+	value, err = strconv.Unquote(match[2])
+	if err != nil {
+		return "", "", badRequest(handler2.InvalidFilter, "invalid filter value %s", match[2])
+	}
+	return attr, value, nil
+}
+
+// location returns the absolute URL of a resource for meta.location and the Location header,
+// keeping the prefix the SCIM base URL is mounted under.
+func location(r *http.Request, resourceType string, id uuid.UUID) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	prefix, _, _ := strings.Cut(r.URL.Path, basePath)
+	return fmt.Sprintf("%s://%s%s%s/%s/%s", scheme, r.Host, prefix, basePath, resourceType, id)
+}
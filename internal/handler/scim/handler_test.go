@@ -0,0 +1,648 @@
+package scim_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerScim "pr-reviewers-service/internal/handler/scim"
+	mockScim "pr-reviewers-service/internal/handler/scim/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/scim_groups"
+	"pr-reviewers-service/internal/usecase/scim_users"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const token = "idp-token"
+
+var (
+	// Ids of the users in the RFC 7644 examples.
+	babsID  = uuid.MustParse("2819c223-7f76-453a-919d-413861904646")
+	mandyID = uuid.MustParse("902c246b-6245-4190-8e05-00816be7344a")
+	jamesID = uuid.MustParse("08e1d05d-121c-4561-8b96-473d93df9210")
+)
+
+// newRouter mounts the handler the same way the app does.
+func newRouter(t *testing.T, configuredToken string) (*mux.Router, *mockScim.MockusersUsecase, *mockScim.MockgroupsUsecase) {
+	ctrl := gomock.NewController(t)
+	users := mockScim.NewMockusersUsecase(ctrl)
+	groups := mockScim.NewMockgroupsUsecase(ctrl)
+	h := handlerScim.New(users, groups, validator.New(), configuredToken)
+
+	r := mux.NewRouter()
+	scim := r.PathPrefix("/api/v1/scim/v2").Subrouter()
+	scim.Handle("/Users", h.Authorize(h.CreateUser)).Methods("POST")
+	scim.Handle("/Users", h.Authorize(h.ListUsers)).Methods("GET")
+	scim.Handle("/Users/{id}", h.Authorize(h.GetUser)).Methods("GET")
+	scim.Handle("/Users/{id}", h.Authorize(h.PatchUser)).Methods("PATCH")
+	scim.Handle("/Users/{id}", h.Authorize(h.DeleteUser)).Methods("DELETE")
+	scim.Handle("/Groups", h.Authorize(h.CreateGroup)).Methods("POST")
+	scim.Handle("/Groups", h.Authorize(h.ListGroups)).Methods("GET")
+	scim.Handle("/Groups/{id}", h.Authorize(h.GetGroup)).Methods("GET")
+	scim.Handle("/Groups/{id}", h.Authorize(h.PatchGroup)).Methods("PATCH")
+	scim.Handle("/Groups/{id}", h.Authorize(h.DeleteGroup)).Methods("DELETE")
+	return r, users, groups
+}
+
+func serve(r *mux.Router, method, target string, body []byte) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "http://example.com/api/v1/scim/v2"+target, bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/scim+json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func loadPayload(t *testing.T, name string) []byte {
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+func decodeError(t *testing.T, w *httptest.ResponseRecorder) handler2.ScimError {
+	var resp handler2.ScimError
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, []string{"urn:ietf:params:scim:api:messages:2.0:Error"}, resp.Schemas)
+	return resp
+}
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		name            string
+		configuredToken string
+		header          string
+	}{
+		{name: "missing token", configuredToken: token},
+		{name: "wrong token", configuredToken: token, header: "Bearer other"},
+		{name: "not a bearer token", configuredToken: token, header: token},
+		{name: "token not configured", header: "Bearer "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _, _ := newRouter(t, tt.configuredToken)
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/scim/v2/Users", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+			assert.Equal(t, "application/scim+json", w.Header().Get("Content-Type"))
+			assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+			assert.Equal(t, "401", decodeError(t, w).Status)
+		})
+	}
+}
+
+func TestCreateUser(t *testing.T) {
+	userID := uuid.New()
+	created := time.Date(2010, 1, 23, 4, 56, 22, 0, time.UTC)
+
+	t.Run("RFC 7644 3.3 example", func(t *testing.T) {
+		r, users, _ := newRouter(t, token)
+		users.EXPECT().Create(gomock.Any(), scim_users.CreateIn{
+			UserName:   "bjensen",
+			ExternalID: "bjensen",
+			Active:     true,
+		}).Return(&scim_users.User{
+			ID:         userID,
+			UserName:   "bjensen",
+			ExternalID: "bjensen",
+			Active:     true,
+			Groups:     []scim_users.Group{{ID: babsID, Name: "scim", IsPrimary: true}},
+			CreatedAt:  created,
+		}, nil)
+
+		w := serve(r, http.MethodPost, "/Users", loadPayload(t, "create_user.json"))
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, "application/scim+json", w.Header().Get("Content-Type"))
+		location := "http://example.com/api/v1/scim/v2/Users/" + userID.String()
+		assert.Equal(t, location, w.Header().Get("Location"))
+		assert.JSONEq(t, `{
+			"schemas": ["urn:ietf:params:scim:schemas:core:2.0:User"],
+			"id": "`+userID.String()+`",
+			"externalId": "bjensen",
+			"userName": "bjensen",
+			"active": true,
+			"groups": [{"value": "`+babsID.String()+`", "display": "scim", "type": "direct"}],
+			"meta": {
+				"resourceType": "User",
+				"created": "2010-01-23T04:56:22Z",
+				"location": "`+location+`"
+			}
+		}`, w.Body.String())
+	})
+
+	t.Run("inactive user", func(t *testing.T) {
+		r, users, _ := newRouter(t, token)
+		users.EXPECT().Create(gomock.Any(), scim_users.CreateIn{UserName: "jsmith"}).
+			Return(&scim_users.User{ID: userID, UserName: "jsmith"}, nil)
+
+		w := serve(r, http.MethodPost, "/Users", []byte(`{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],"userName":"jsmith","active":false}`))
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("userName taken", func(t *testing.T) {
+		r, users, _ := newRouter(t, token)
+		users.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, usecase2.ErrUserNameAlreadyExists)
+
+		w := serve(r, http.MethodPost, "/Users", loadPayload(t, "create_user.json"))
+
+		require.Equal(t, http.StatusConflict, w.Code)
+		resp := decodeError(t, w)
+		assert.Equal(t, "409", resp.Status)
+		require.NotNil(t, resp.ScimType)
+		assert.Equal(t, handler2.Uniqueness, *resp.ScimType)
+	})
+
+	t.Run("missing userName", func(t *testing.T) {
+		r, _, _ := newRouter(t, token)
+
+		w := serve(r, http.MethodPost, "/Users", []byte(`{"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"]}`))
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		resp := decodeError(t, w)
+		require.NotNil(t, resp.ScimType)
+		assert.Equal(t, handler2.InvalidValue, *resp.ScimType)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		r, _, _ := newRouter(t, token)
+
+		w := serve(r, http.MethodPost, "/Users", []byte(`{`))
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		resp := decodeError(t, w)
+		require.NotNil(t, resp.ScimType)
+		assert.Equal(t, handler2.InvalidSyntax, *resp.ScimType)
+	})
+}
+
+func TestListUsers(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name         string
+		query        string
+		mock         func(users *mockScim.MockusersUsecase)
+		wantCode     int
+		wantTotal    int
+		wantScimType handler2.ScimErrorScimType
+	}{
+		{
+			name:  "RFC 7644 3.4.2.2 filter by userName",
+			query: `filter=userName eq "bjensen"`,
+			mock: func(users *mockScim.MockusersUsecase) {
+				users.EXPECT().List(gomock.Any(), scim_users.ListIn{UserName: "bjensen", StartIndex: 1, Count: 100}).
+					Return(&scim_users.ListOut{
+						Users:        []scim_users.User{{ID: userID, UserName: "bjensen", Active: true}},
+						TotalResults: 1,
+						StartIndex:   1,
+					}, nil)
+			},
+			wantCode:  http.StatusOK,
+			wantTotal: 1,
+		},
+		{
+			name:  "operator and attribute are case-insensitive",
+			query: `filter=externalid EQ "701984"&startIndex=0&count=500`,
+			mock: func(users *mockScim.MockusersUsecase) {
+				users.EXPECT().List(gomock.Any(), scim_users.ListIn{ExternalID: "701984", StartIndex: 1, Count: 100}).
+					Return(&scim_users.ListOut{Users: []scim_users.User{}, StartIndex: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:  "RFC 7644 3.4.2.4 paging",
+			query: `startIndex=11&count=10`,
+			mock: func(users *mockScim.MockusersUsecase) {
+				users.EXPECT().List(gomock.Any(), scim_users.ListIn{StartIndex: 11, Count: 10}).
+					Return(&scim_users.ListOut{Users: []scim_users.User{}, TotalResults: 12, StartIndex: 11}, nil)
+			},
+			wantCode:  http.StatusOK,
+			wantTotal: 12,
+		},
+		{
+			name:         "unsupported operator",
+			query:        `filter=userName sw "J"`,
+			mock:         func(users *mockScim.MockusersUsecase) {},
+			wantCode:     http.StatusBadRequest,
+			wantScimType: handler2.InvalidFilter,
+		},
+		{
+			name:         "unsupported attribute",
+			query:        `filter=title eq "Tour Guide"`,
+			mock:         func(users *mockScim.MockusersUsecase) {},
+			wantCode:     http.StatusBadRequest,
+			wantScimType: handler2.InvalidFilter,
+		},
+		{
+			name:         "invalid count",
+			query:        `count=ten`,
+			mock:         func(users *mockScim.MockusersUsecase) {},
+			wantCode:     http.StatusBadRequest,
+			wantScimType: handler2.InvalidValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, users, _ := newRouter(t, token)
+			tt.mock(users)
+
+			query, err := url.ParseQuery(tt.query)
+			require.NoError(t, err)
+			w := serve(r, http.MethodGet, "/Users?"+query.Encode(), nil)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			if tt.wantCode != http.StatusOK {
+				assert.Equal(t, tt.wantScimType, *decodeError(t, w).ScimType)
+				return
+			}
+			var resp handler2.ScimUserListResponse
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+			assert.Equal(t, []string{"urn:ietf:params:scim:api:messages:2.0:ListResponse"}, resp.Schemas)
+			assert.Equal(t, tt.wantTotal, resp.TotalResults)
+			assert.Equal(t, len(resp.Resources), resp.ItemsPerPage)
+		})
+	}
+}
+
+func TestPatchUser(t *testing.T) {
+	userID := uuid.New()
+	inactive := false
+	removed := ""
+	user := &scim_users.User{ID: userID, UserName: "bjensen"}
+
+	tests := []struct {
+		name         string
+		body         []byte
+		mock         func(users *mockScim.MockusersUsecase)
+		wantCode     int
+		wantScimType handler2.ScimErrorScimType
+	}{
+		{
+			name: "replace active with false",
+			body: loadPayload(t, "patch_deactivate.json"),
+			mock: func(users *mockScim.MockusersUsecase) {
+				users.EXPECT().Patch(gomock.Any(), scim_users.PatchIn{UserID: userID, Active: &inactive}).Return(user, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "RFC 7644 3.5.2.3 replace without path ignores unsupported attributes",
+			body: loadPayload(t, "patch_replace_attributes.json"),
+			mock: func(users *mockScim.MockusersUsecase) {
+				users.EXPECT().Patch(gomock.Any(), scim_users.PatchIn{UserID: userID, Active: &inactive}).Return(user, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "string boolean and capitalized op",
+			body: []byte(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations":[{"op":"Replace","path":"active","value":"False"}]}`),
+			mock: func(users *mockScim.MockusersUsecase) {
+				users.EXPECT().Patch(gomock.Any(), scim_users.PatchIn{UserID: userID, Active: &inactive}).Return(user, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "remove externalId",
+			body: []byte(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations":[{"op":"remove","path":"externalId"}]}`),
+			mock: func(users *mockScim.MockusersUsecase) {
+				users.EXPECT().Patch(gomock.Any(), scim_users.PatchIn{UserID: userID, ExternalID: &removed}).Return(user, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "unsupported path",
+			body: []byte(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations":[{"op":"replace","path":"name.familyName","value":"Jensen"}]}`),
+			mock:         func(users *mockScim.MockusersUsecase) {},
+			wantCode:     http.StatusBadRequest,
+			wantScimType: handler2.InvalidPath,
+		},
+		{
+			name: "userName cannot be removed",
+			body: []byte(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations":[{"op":"remove","path":"userName"}]}`),
+			mock:         func(users *mockScim.MockusersUsecase) {},
+			wantCode:     http.StatusBadRequest,
+			wantScimType: handler2.Mutability,
+		},
+		{
+			name:         "missing PatchOp schema",
+			body:         []byte(`{"Operations":[{"op":"replace","path":"active","value":false}]}`),
+			mock:         func(users *mockScim.MockusersUsecase) {},
+			wantCode:     http.StatusBadRequest,
+			wantScimType: handler2.InvalidSyntax,
+		},
+		{
+			name: "user not found",
+			body: loadPayload(t, "patch_deactivate.json"),
+			mock: func(users *mockScim.MockusersUsecase) {
+				users.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil, usecase2.ErrUserNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "internal error",
+			body: loadPayload(t, "patch_deactivate.json"),
+			mock: func(users *mockScim.MockusersUsecase) {
+				users.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, users, _ := newRouter(t, token)
+			tt.mock(users)
+
+			w := serve(r, http.MethodPatch, "/Users/"+userID.String(), tt.body)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			if tt.wantScimType != "" {
+				resp := decodeError(t, w)
+				require.NotNil(t, resp.ScimType)
+				assert.Equal(t, tt.wantScimType, *resp.ScimType)
+			}
+		})
+	}
+}
+
+func TestGetAndDeleteUser(t *testing.T) {
+	userID := uuid.New()
+
+	t.Run("get unknown user", func(t *testing.T) {
+		r, users, _ := newRouter(t, token)
+		users.EXPECT().Get(gomock.Any(), userID).Return(nil, usecase2.ErrUserNotFound)
+
+		w := serve(r, http.MethodGet, "/Users/"+userID.String(), nil)
+
+		require.Equal(t, http.StatusNotFound, w.Code)
+		assert.Equal(t, "404", decodeError(t, w).Status)
+	})
+
+	t.Run("get with malformed id", func(t *testing.T) {
+		r, _, _ := newRouter(t, token)
+
+		w := serve(r, http.MethodGet, "/Users/bjensen", nil)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		r, users, _ := newRouter(t, token)
+		users.EXPECT().Delete(gomock.Any(), userID).Return(nil)
+
+		w := serve(r, http.MethodDelete, "/Users/"+userID.String(), nil)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+}
+
+func TestCreateGroup(t *testing.T) {
+	groupID := uuid.New()
+
+	t.Run("RFC 7643 8.4 example", func(t *testing.T) {
+		r, _, groups := newRouter(t, token)
+		groups.EXPECT().Create(gomock.Any(), scim_groups.CreateIn{
+			DisplayName: "Tour Guides",
+			MemberIDs:   []uuid.UUID{babsID, mandyID},
+		}).Return(&scim_groups.Group{
+			ID:          groupID,
+			DisplayName: "Tour Guides",
+			Members: []scim_groups.Member{
+				{ID: babsID, UserName: "bjensen"},
+				{ID: mandyID, UserName: "mpepperidge"},
+			},
+		}, nil)
+
+		w := serve(r, http.MethodPost, "/Groups", loadPayload(t, "create_group.json"))
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		var resp handler2.ScimGroup
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		assert.Equal(t, []string{"urn:ietf:params:scim:schemas:core:2.0:Group"}, resp.Schemas)
+		assert.Equal(t, groupID, *resp.Id)
+		require.Len(t, *resp.Members, 2)
+		assert.Equal(t, "bjensen", *(*resp.Members)[0].Display)
+		assert.Equal(t, handler2.ScimMetaResourceTypeGroup, resp.Meta.ResourceType)
+		assert.Equal(t, resp.Meta.Location, w.Header().Get("Location"))
+	})
+
+	t.Run("unknown member", func(t *testing.T) {
+		r, _, groups := newRouter(t, token)
+		groups.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, usecase2.ErrUserNotFound)
+
+		w := serve(r, http.MethodPost, "/Groups", loadPayload(t, "create_group.json"))
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, handler2.InvalidValue, *decodeError(t, w).ScimType)
+	})
+
+	t.Run("displayName taken", func(t *testing.T) {
+		r, _, groups := newRouter(t, token)
+		groups.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, usecase2.ErrTeamAlreadyExists)
+
+		w := serve(r, http.MethodPost, "/Groups", loadPayload(t, "create_group.json"))
+
+		require.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, handler2.Uniqueness, *decodeError(t, w).ScimType)
+	})
+}
+
+func TestListGroups(t *testing.T) {
+	r, _, groups := newRouter(t, token)
+	groups.EXPECT().List(gomock.Any(), scim_groups.ListIn{DisplayName: "Tour Guides", StartIndex: 1, Count: 100}).
+		Return(&scim_groups.ListOut{
+			Groups:       []scim_groups.Group{{ID: uuid.New(), DisplayName: "Tour Guides"}},
+			TotalResults: 1,
+			StartIndex:   1,
+		}, nil)
+
+	w := serve(r, http.MethodGet, "/Groups?"+url.Values{"filter": {`displayName eq "Tour Guides"`}}.Encode(), nil)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	var resp handler2.ScimGroupListResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	assert.Equal(t, 1, resp.TotalResults)
+	require.Len(t, resp.Resources, 1)
+	assert.Equal(t, "Tour Guides", resp.Resources[0].DisplayName)
+}
+
+func TestPatchGroup(t *testing.T) {
+	groupID := uuid.New()
+	group := &scim_groups.Group{ID: groupID, DisplayName: "Tour Guides"}
+	newName := "Guides"
+
+	tests := []struct {
+		name         string
+		body         []byte
+		mock         func(groups *mockScim.MockgroupsUsecase)
+		wantCode     int
+		wantScimType handler2.ScimErrorScimType
+	}{
+		{
+			name: "RFC 7644 3.5.2.1 add member",
+			body: loadPayload(t, "patch_add_member.json"),
+			mock: func(groups *mockScim.MockgroupsUsecase) {
+				groups.EXPECT().Patch(gomock.Any(), scim_groups.PatchIn{
+					GroupID:      groupID,
+					AddMemberIDs: []uuid.UUID{babsID},
+				}).Return(group, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "RFC 7644 3.5.2.2 remove single member",
+			body: loadPayload(t, "patch_remove_member.json"),
+			mock: func(groups *mockScim.MockgroupsUsecase) {
+				groups.EXPECT().Patch(gomock.Any(), scim_groups.PatchIn{
+					GroupID:         groupID,
+					RemoveMemberIDs: []uuid.UUID{babsID},
+				}).Return(group, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "RFC 7644 3.5.2.2 remove all members",
+			body: loadPayload(t, "patch_remove_all_members.json"),
+			mock: func(groups *mockScim.MockgroupsUsecase) {
+				groups.EXPECT().Patch(gomock.Any(), scim_groups.PatchIn{
+					GroupID:          groupID,
+					ReplaceMemberIDs: &[]uuid.UUID{},
+				}).Return(group, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "RFC 7644 3.5.2.2 replace members by remove and add",
+			body: loadPayload(t, "patch_replace_members.json"),
+			mock: func(groups *mockScim.MockgroupsUsecase) {
+				groups.EXPECT().Patch(gomock.Any(), scim_groups.PatchIn{
+					GroupID:          groupID,
+					ReplaceMemberIDs: &[]uuid.UUID{},
+					AddMemberIDs:     []uuid.UUID{babsID, jamesID},
+				}).Return(group, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "replace members and rename without path",
+			body: []byte(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations":[{"op":"replace","value":{"displayName":"Guides",
+				"members":[{"value":"` + jamesID.String() + `"}]}}]}`),
+			mock: func(groups *mockScim.MockgroupsUsecase) {
+				groups.EXPECT().Patch(gomock.Any(), scim_groups.PatchIn{
+					GroupID:          groupID,
+					DisplayName:      &newName,
+					ReplaceMemberIDs: &[]uuid.UUID{jamesID},
+				}).Return(group, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "member value filter only supports remove",
+			body: []byte(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations":[{"op":"add","path":"members[value eq \"` + babsID.String() + `\"]"}]}`),
+			mock:         func(groups *mockScim.MockgroupsUsecase) {},
+			wantCode:     http.StatusBadRequest,
+			wantScimType: handler2.InvalidPath,
+		},
+		{
+			name: "member without value",
+			body: []byte(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations":[{"op":"add","path":"members","value":[{"display":"Babs Jensen"}]}]}`),
+			mock:         func(groups *mockScim.MockgroupsUsecase) {},
+			wantCode:     http.StatusBadRequest,
+			wantScimType: handler2.InvalidValue,
+		},
+		{
+			name: "unknown operation",
+			body: []byte(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations":[{"op":"move","path":"members"}]}`),
+			mock:         func(groups *mockScim.MockgroupsUsecase) {},
+			wantCode:     http.StatusBadRequest,
+			wantScimType: handler2.InvalidSyntax,
+		},
+		{
+			name: "default team cannot be renamed",
+			body: []byte(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+				"Operations":[{"op":"replace","path":"displayName","value":"Guides"}]}`),
+			mock: func(groups *mockScim.MockgroupsUsecase) {
+				groups.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil, usecase2.ErrDefaultTeamChange)
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name: "group not found",
+			body: loadPayload(t, "patch_add_member.json"),
+			mock: func(groups *mockScim.MockgroupsUsecase) {
+				groups.EXPECT().Patch(gomock.Any(), gomock.Any()).Return(nil, usecase2.ErrTeamNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _, groups := newRouter(t, token)
+			tt.mock(groups)
+
+			w := serve(r, http.MethodPatch, "/Groups/"+groupID.String(), tt.body)
+
+			require.Equal(t, tt.wantCode, w.Code)
+			if tt.wantScimType != "" {
+				resp := decodeError(t, w)
+				require.NotNil(t, resp.ScimType)
+				assert.Equal(t, tt.wantScimType, *resp.ScimType)
+			}
+		})
+	}
+}
+
+func TestDeleteGroup(t *testing.T) {
+	groupID := uuid.New()
+
+	t.Run("success", func(t *testing.T) {
+		r, _, groups := newRouter(t, token)
+		groups.EXPECT().Delete(gomock.Any(), groupID).Return(nil)
+
+		w := serve(r, http.MethodDelete, "/Groups/"+groupID.String(), nil)
+
+		assert.Equal(t, http.StatusNoContent, w.Code)
+	})
+
+	t.Run("default team", func(t *testing.T) {
+		r, _, groups := newRouter(t, token)
+		groups.EXPECT().Delete(gomock.Any(), groupID).Return(usecase2.ErrDefaultTeamChange)
+
+		w := serve(r, http.MethodDelete, "/Groups/"+groupID.String(), nil)
+
+		require.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, "409", decodeError(t, w).Status)
+	})
+}
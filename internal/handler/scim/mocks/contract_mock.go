@@ -0,0 +1,209 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package scim is a generated GoMock package.
+package scim
+
+import (
+	context "context"
+	scim_groups "pr-reviewers-service/internal/usecase/scim_groups"
+	scim_users "pr-reviewers-service/internal/usecase/scim_users"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockusersUsecase is a mock of usersUsecase interface.
+type MockusersUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockusersUsecaseMockRecorder
+}
+
+// MockusersUsecaseMockRecorder is the mock recorder for MockusersUsecase.
+type MockusersUsecaseMockRecorder struct {
+	mock *MockusersUsecase
+}
+
+// NewMockusersUsecase creates a new mock instance.
+func NewMockusersUsecase(ctrl *gomock.Controller) *MockusersUsecase {
+	mock := &MockusersUsecase{ctrl: ctrl}
+	mock.recorder = &MockusersUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockusersUsecase) EXPECT() *MockusersUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockusersUsecase) Create(ctx context.Context, req scim_users.CreateIn) (*scim_users.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req)
+	ret0, _ := ret[0].(*scim_users.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockusersUsecaseMockRecorder) Create(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockusersUsecase)(nil).Create), ctx, req)
+}
+
+// Delete mocks base method.
+func (m *MockusersUsecase) Delete(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockusersUsecaseMockRecorder) Delete(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockusersUsecase)(nil).Delete), ctx, userID)
+}
+
+// Get mocks base method.
+func (m *MockusersUsecase) Get(ctx context.Context, userID uuid.UUID) (*scim_users.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID)
+	ret0, _ := ret[0].(*scim_users.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockusersUsecaseMockRecorder) Get(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockusersUsecase)(nil).Get), ctx, userID)
+}
+
+// List mocks base method.
+func (m *MockusersUsecase) List(ctx context.Context, req scim_users.ListIn) (*scim_users.ListOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, req)
+	ret0, _ := ret[0].(*scim_users.ListOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockusersUsecaseMockRecorder) List(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockusersUsecase)(nil).List), ctx, req)
+}
+
+// Patch mocks base method.
+func (m *MockusersUsecase) Patch(ctx context.Context, req scim_users.PatchIn) (*scim_users.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, req)
+	ret0, _ := ret[0].(*scim_users.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockusersUsecaseMockRecorder) Patch(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockusersUsecase)(nil).Patch), ctx, req)
+}
+
+// MockgroupsUsecase is a mock of groupsUsecase interface.
+type MockgroupsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockgroupsUsecaseMockRecorder
+}
+
+// MockgroupsUsecaseMockRecorder is the mock recorder for MockgroupsUsecase.
+type MockgroupsUsecaseMockRecorder struct {
+	mock *MockgroupsUsecase
+}
+
+// NewMockgroupsUsecase creates a new mock instance.
+func NewMockgroupsUsecase(ctrl *gomock.Controller) *MockgroupsUsecase {
+	mock := &MockgroupsUsecase{ctrl: ctrl}
+	mock.recorder = &MockgroupsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgroupsUsecase) EXPECT() *MockgroupsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockgroupsUsecase) Create(ctx context.Context, req scim_groups.CreateIn) (*scim_groups.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req)
+	ret0, _ := ret[0].(*scim_groups.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockgroupsUsecaseMockRecorder) Create(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockgroupsUsecase)(nil).Create), ctx, req)
+}
+
+// Delete mocks base method.
+func (m *MockgroupsUsecase) Delete(ctx context.Context, groupID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockgroupsUsecaseMockRecorder) Delete(ctx, groupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockgroupsUsecase)(nil).Delete), ctx, groupID)
+}
+
+// Get mocks base method.
+func (m *MockgroupsUsecase) Get(ctx context.Context, groupID uuid.UUID) (*scim_groups.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, groupID)
+	ret0, _ := ret[0].(*scim_groups.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockgroupsUsecaseMockRecorder) Get(ctx, groupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockgroupsUsecase)(nil).Get), ctx, groupID)
+}
+
+// List mocks base method.
+func (m *MockgroupsUsecase) List(ctx context.Context, req scim_groups.ListIn) (*scim_groups.ListOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, req)
+	ret0, _ := ret[0].(*scim_groups.ListOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockgroupsUsecaseMockRecorder) List(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockgroupsUsecase)(nil).List), ctx, req)
+}
+
+// Patch mocks base method.
+func (m *MockgroupsUsecase) Patch(ctx context.Context, req scim_groups.PatchIn) (*scim_groups.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, req)
+	ret0, _ := ret[0].(*scim_groups.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockgroupsUsecaseMockRecorder) Patch(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockgroupsUsecase)(nil).Patch), ctx, req)
+}
@@ -0,0 +1,130 @@
+package scim
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+
+	"github.com/google/uuid"
+)
+
+const (
+	opAdd     = "add"
+	opRemove  = "remove"
+	opReplace = "replace"
+)
+
+// memberPathPattern matches the value filter RFC 7644 uses to remove a single member.
+var memberPathPattern = regexp.MustCompile(`(?i)^members\[\s*value\s+eq\s+("(?:[^"\\]|\\.)*")\s*\]$`)
+
+// patchOp is a PATCH operation on one attribute. Operations without a path are split into one
+// operation per attribute of their value and marked implicit, unknown implicit attributes are ignored.
+type patchOp struct {
+	op       string
+	attr     string
+	memberID *uuid.UUID
+	value    any
+	implicit bool
+}
+
+func parsePatch(request handler2.ScimPatchRequest) ([]patchOp, error) {
+	if !slices.Contains(request.Schemas, patchSchema) {
+		return nil, badRequest(handler2.InvalidSyntax, "schemas must contain %s", patchSchema)
+	}
+
+	ops := make([]patchOp, 0, len(request.Operations))
+	for _, operation := range request.Operations {
+		op := strings.ToLower(operation.Op)
+		if op != opAdd && op != opRemove && op != opReplace {
+			return nil, badRequest(handler2.InvalidSyntax, "unknown operation %q", operation.Op)
+		}
+
+		path := ""
+		if operation.Path != nil {
+			path = strings.TrimSpace(*operation.Path)
+		}
+		if path == "" {
+			if op == opRemove {
+				return nil, badRequest(handler2.NoTarget, "remove requires a path")
+			}
+			attrs, ok := operation.Value.(map[string]any)
+			if !ok {
+				return nil, badRequest(handler2.InvalidValue, "%s without a path requires an object value", op)
+			}
+			for attr, value := range attrs {
+				ops = append(ops, patchOp{op: op, attr: strings.ToLower(attr), value: value, implicit: true})
+			}
+			continue
+		}
+
+		if match := memberPathPattern.FindStringSubmatch(path); match != nil {
+			if op != opRemove {
+				return nil, badRequest(handler2.InvalidPath, "only remove supports a members value filter")
+			}
+			raw, err := strconv.Unquote(match[1])
+			if err != nil {
+				return nil, badRequest(handler2.InvalidPath, "invalid path %s", path)
+			}
+			memberID, err := uuid.Parse(raw)
+			if err != nil {
+				return nil, badRequest(handler2.NoTarget, "member %s not found", raw)
+			}
+			ops = append(ops, patchOp{op: op, attr: "members", memberID: &memberID})
+			continue
+		}
+
+		ops = append(ops, patchOp{op: op, attr: strings.ToLower(path), value: operation.Value})
+	}
+	return ops, nil
+}
+
+func (o patchOp) stringValue() (string, error) {
+	value, ok := o.value.(string)
+	if !ok {
+		return "", badRequest(handler2.InvalidValue, "%s must be a string", o.attr)
+	}
+	return value, nil
+}
+
+// boolValue also accepts "True" and "False" strings some identity providers send.
+func (o patchOp) boolValue() (bool, error) {
+	switch value := o.value.(type) {
+	case bool:
+		return value, nil
+	case string:
+		parsed, err := strconv.ParseBool(strings.ToLower(value))
+		if err == nil {
+			return parsed, nil
+		}
+	}
+	return false, badRequest(handler2.InvalidValue, "%s must be a boolean", o.attr)
+}
+
+// memberIDs reads a list of members, a single member object is accepted as well.
+func (o patchOp) memberIDs() ([]uuid.UUID, error) {
+	raw, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, badRequest(handler2.InvalidValue, "invalid members")
+	}
+	var members []handler2.ScimMember
+	if err = json.Unmarshal(raw, &members); err != nil {
+		var member handler2.ScimMember
+		if err = json.Unmarshal(raw, &member); err != nil {
+			return nil, badRequest(handler2.InvalidValue, "members must be a list of objects with a value")
+		}
+		members = []handler2.ScimMember{member}
+	}
+
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		if member.Value == uuid.Nil {
+			return nil, badRequest(handler2.InvalidValue, "member value must be a user id")
+		}
+		ids = append(ids, member.Value)
+	}
+	return ids, nil
+}
@@ -0,0 +1,299 @@
+package scim
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/scim_users"
+)
+
+// @Summary Create SCIM user
+// @Description Provision a user into the default team, externalId is kept as the scim identity
+// @ID ScimCreateUser
+// @Tags SCIM
+// @Accept json
+// @Produce json
+// @Param input body handler2.ScimUser true "SCIM user"
+// @Success 201 {object} handler2.ScimUser "User created"
+// @Failure 400 {object} handler2.ScimError "Invalid request data"
+// @Failure 401 {object} handler2.ScimError "Invalid bearer token"
+// @Failure 409 {object} handler2.ScimError "userName or externalId already taken"
+// @Failure 500 {object} handler2.ScimError "Internal server error"
+// @Router /scim/v2/Users [post]
+func (h *scimHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var request handler2.ScimUser
+	if err := h.decode(r, &request); err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+	ctx = logging.WithLogName(ctx, request.UserName)
+
+	in := scim_users.CreateIn{
+		UserName: request.UserName,
+		Active:   request.Active == nil || *request.Active,
+	}
+	if request.ExternalId != nil {
+		in.ExternalID = *request.ExternalId
+	}
+
+	result, err := h.users.Create(ctx, in)
+	if err != nil {
+		handleUsersError(w, ctx, err)
+		return
+	}
+
+	user := toScimUser(r, result)
+	w.Header().Set("Location", user.Meta.Location)
+	respond(w, ctx, http.StatusCreated, user)
+}
+
+// @Summary Get SCIM user
+// @ID ScimGetUser
+// @Tags SCIM
+// @Produce json
+// @Param id path string true "User id"
+// @Success 200 {object} handler2.ScimUser "User"
+// @Failure 401 {object} handler2.ScimError "Invalid bearer token"
+// @Failure 404 {object} handler2.ScimError "User not found"
+// @Failure 500 {object} handler2.ScimError "Internal server error"
+// @Router /scim/v2/Users/{id} [get]
+func (h *scimHandler) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, err := pathID(r)
+	if err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+	ctx = logging.WithLogUserId(ctx, userID)
+
+	result, err := h.users.Get(ctx, userID)
+	if err != nil {
+		handleUsersError(w, ctx, err)
+		return
+	}
+
+	respond(w, ctx, http.StatusOK, toScimUser(r, result))
+}
+
+// @Summary List SCIM users
+// @Description Filter supports only userName eq and externalId eq, userName is compared case-insensitively
+// @ID ScimListUsers
+// @Tags SCIM
+// @Produce json
+// @Param filter query string false "userName eq \"value\" or externalId eq \"value\""
+// @Param startIndex query int false "1-based index of the first result"
+// @Param count query int false "Page size, at most 100"
+// @Success 200 {object} handler2.ScimUserListResponse "Users"
+// @Failure 400 {object} handler2.ScimError "Unsupported filter or invalid paging"
+// @Failure 401 {object} handler2.ScimError "Invalid bearer token"
+// @Failure 500 {object} handler2.ScimError "Internal server error"
+// @Router /scim/v2/Users [get]
+func (h *scimHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	startIndex, count, err := parsePaging(r)
+	if err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+	in := scim_users.ListIn{StartIndex: startIndex, Count: count}
+	if filter := r.URL.Query().Get("filter"); filter != "" {
+		attr, value, err := parseFilter(filter, "userName", "externalId")
+		if err != nil {
+			respondWithScimError(w, ctx, err)
+			return
+		}
+		if attr == "username" {
+			in.UserName = value
+		} else {
+			in.ExternalID = value
+		}
+	}
+
+	result, err := h.users.List(ctx, in)
+	if err != nil {
+		handleUsersError(w, ctx, err)
+		return
+	}
+
+	resources := make([]handler2.ScimUser, 0, len(result.Users))
+	for i := range result.Users {
+		resources = append(resources, toScimUser(r, &result.Users[i]))
+	}
+	respond(w, ctx, http.StatusOK, handler2.ScimUserListResponse{
+		Schemas:      []string{listSchema},
+		TotalResults: result.TotalResults,
+		StartIndex:   result.StartIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+// @Summary Patch SCIM user
+// @Description Supports active, userName and externalId. active=false hands over open reviews like team deactivation
+// @ID ScimPatchUser
+// @Tags SCIM
+// @Accept json
+// @Produce json
+// @Param id path string true "User id"
+// @Param input body handler2.ScimPatchRequest true "SCIM patch operations"
+// @Success 200 {object} handler2.ScimUser "Patched user"
+// @Failure 400 {object} handler2.ScimError "Invalid operation, path or value"
+// @Failure 401 {object} handler2.ScimError "Invalid bearer token"
+// @Failure 404 {object} handler2.ScimError "User not found"
+// @Failure 409 {object} handler2.ScimError "userName or externalId already taken"
+// @Failure 500 {object} handler2.ScimError "Internal server error"
+// @Router /scim/v2/Users/{id} [patch]
+func (h *scimHandler) PatchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, err := pathID(r)
+	if err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+	ctx = logging.WithLogUserId(ctx, userID)
+
+	var request handler2.ScimPatchRequest
+	if err = h.decode(r, &request); err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+	in, err := userPatch(request)
+	if err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+	in.UserID = userID
+
+	result, err := h.users.Patch(ctx, *in)
+	if err != nil {
+		handleUsersError(w, ctx, err)
+		return
+	}
+
+	respond(w, ctx, http.StatusOK, toScimUser(r, result))
+}
+
+// @Summary Delete SCIM user
+// @Description Hands over open reviews of the user and removes the user with the pull requests they authored
+// @ID ScimDeleteUser
+// @Tags SCIM
+// @Param id path string true "User id"
+// @Success 204 "User deleted"
+// @Failure 401 {object} handler2.ScimError "Invalid bearer token"
+// @Failure 404 {object} handler2.ScimError "User not found"
+// @Failure 500 {object} handler2.ScimError "Internal server error"
+// @Router /scim/v2/Users/{id} [delete]
+func (h *scimHandler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userID, err := pathID(r)
+	if err != nil {
+		respondWithScimError(w, ctx, err)
+		return
+	}
+	ctx = logging.WithLogUserId(ctx, userID)
+
+	if err = h.users.Delete(ctx, userID); err != nil {
+		handleUsersError(w, ctx, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func userPatch(request handler2.ScimPatchRequest) (*scim_users.PatchIn, error) {
+	ops, err := parsePatch(request)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &scim_users.PatchIn{}
+	for _, op := range ops {
+		switch op.attr {
+		case "active":
+			if op.op == opRemove {
+				return nil, badRequest(handler2.Mutability, "active cannot be removed")
+			}
+			active, err := op.boolValue()
+			if err != nil {
+				return nil, err
+			}
+			in.Active = &active
+		case "username":
+			if op.op == opRemove {
+				return nil, badRequest(handler2.Mutability, "userName cannot be removed")
+			}
+			userName, err := op.stringValue()
+			if err != nil {
+				return nil, err
+			}
+			if userName == "" {
+				return nil, badRequest(handler2.InvalidValue, "userName cannot be empty")
+			}
+			in.UserName = &userName
+		case "externalid":
+			externalID := ""
+			if op.op != opRemove {
+				if externalID, err = op.stringValue(); err != nil {
+					return nil, err
+				}
+			}
+			in.ExternalID = &externalID
+		default:
+			if !op.implicit {
+				return nil, badRequest(handler2.InvalidPath, "attribute %s is not supported", op.attr)
+			}
+		}
+	}
+	return in, nil
+}
+
+func toScimUser(r *http.Request, user *scim_users.User) handler2.ScimUser {
+	groups := make([]handler2.ScimGroupRef, 0, len(user.Groups))
+	for _, group := range user.Groups {
+		groups = append(groups, handler2.ScimGroupRef{
+			Value:   group.ID,
+			Display: group.Name,
+			Type:    handler2.Direct,
+		})
+	}
+
+	out := handler2.ScimUser{
+		Schemas:  []string{userSchema},
+		Id:       &user.ID,
+		UserName: user.UserName,
+		Active:   &user.Active,
+		Groups:   &groups,
+		Meta: &handler2.ScimMeta{
+			ResourceType: handler2.ScimMetaResourceTypeUser,
+			Created:      user.CreatedAt,
+			Location:     location(r, "Users", user.ID),
+		},
+	}
+	if user.ExternalID != "" {
+		out.ExternalId = &user.ExternalID
+	}
+	return out
+}
+
+func handleUsersError(w http.ResponseWriter, ctx context.Context, err error) {
+	switch {
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		respondWithError(w, ctx, http.StatusNotFound, "", "user not found", err)
+	case errors.Is(err, usecase2.ErrUserNameAlreadyExists):
+		respondWithError(w, ctx, http.StatusConflict, handler2.Uniqueness, "user with such userName already exists", err)
+	case errors.Is(err, usecase2.ErrIdentityAlreadyLinked):
+		respondWithError(w, ctx, http.StatusConflict, handler2.Uniqueness, "externalId is already linked to another user", err)
+	default:
+		respondWithError(w, ctx, http.StatusInternalServerError, "", "internal server error", err)
+	}
+}
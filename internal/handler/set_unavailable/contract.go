@@ -0,0 +1,12 @@
+package set_unavailable
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/set_unavailable"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=set_unavailable usecase
+type usecase interface {
+	Run(ctx context.Context, req set_unavailable.In) (*set_unavailable.Out, error)
+}
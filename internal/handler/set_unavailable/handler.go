@@ -0,0 +1,116 @@
+package set_unavailable
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/handler/middleware"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/set_unavailable"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type setUnavailableHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *setUnavailableHandler {
+	return &setUnavailableHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Set user unavailability
+// @Description Mark a user unavailable until the given time, reviewers are not picked among unavailable users.
+// @Description Without unavailable_until the user becomes available again.
+// @ID SetUnavailable
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostUsersSetUnavailableJSONRequestBody true "Unavailability data"
+// @Success 200 {object} handler2.SetUserUnavailableResponse "User unavailability successfully updated"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data or time in the past"
+// @Failure 403 {object} handler2.ErrorResponse "Users can change only their own unavailability"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "User not found"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /users/setUnavailable [post]
+func (h *setUnavailableHandler) SetUnavailable(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostUsersSetUnavailableJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	if !middleware.CanActAs(ctx, request.UserId) {
+		middleware.RespondForbidden(w, ctx)
+		return
+	}
+
+	ctx = logging.WithLogUserId(ctx, request.UserId)
+
+	result, err := h.usecase.Run(ctx, set_unavailable.In{
+		UserID: request.UserId,
+		Until:  request.UnavailableUntil,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.SetUserUnavailableResponse{
+		User: handler2.User{
+			UserId:   result.UserID,
+			Username: result.Username,
+			TeamName: result.TeamName,
+			IsActive: result.IsActive,
+		},
+		UnavailableUntil: result.UnavailableUntil,
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *setUnavailableHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrUnavailableInPast):
+		errorMsg = "unavailability end must be in the future"
+		statusCode = http.StatusBadRequest
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		errorMsg = "user not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrGetUser):
+		errorMsg = "error occurred while getting user from db"
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting users team"
+	case errors.Is(err, usecase2.ErrUpdateUser):
+		errorMsg = "error occurred while updating user in db"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,222 @@
+package set_unavailable_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler/middleware"
+	handlerUnavailable "pr-reviewers-service/internal/handler/set_unavailable"
+	mockUnavailable "pr-reviewers-service/internal/handler/set_unavailable/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseUnavailable "pr-reviewers-service/internal/usecase/set_unavailable"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockUnavailable.NewMockusecase(ctrl)
+	h := handlerUnavailable.New(mockUC, validate)
+
+	userID := uuid.New()
+	until := time.Date(2025, 12, 22, 9, 0, 0, 0, time.UTC)
+
+	reqBody := handler2.PostUsersSetUnavailableJSONRequestBody{
+		UserId:           userID,
+		UnavailableUntil: &until,
+	}
+	ucIn := usecaseUnavailable.In{
+		UserID: userID,
+		Until:  &until,
+	}
+	user := handler2.User{
+		UserId:   userID,
+		Username: "Alice",
+		TeamName: "backend",
+		IsActive: true,
+	}
+
+	tests := []struct {
+		name      string
+		principal *middleware.Principal
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&usecaseUnavailable.Out{
+					UserID:           userID,
+					Username:         "Alice",
+					TeamName:         "backend",
+					IsActive:         true,
+					UnavailableUntil: &until,
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.SetUserUnavailableResponse{
+				User:             user,
+				UnavailableUntil: &until,
+			},
+		},
+		{
+			name: "success without time makes the user available",
+			body: handler2.PostUsersSetUnavailableJSONRequestBody{UserId: userID},
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecaseUnavailable.In{UserID: userID}).Return(&usecaseUnavailable.Out{
+					UserID:   userID,
+					Username: "Alice",
+					TeamName: "backend",
+					IsActive: true,
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.SetUserUnavailableResponse{
+				User: user,
+			},
+		},
+		{
+			name:      "user marks themselves unavailable",
+			principal: &middleware.Principal{UserID: userID, Role: middleware.User},
+			body:      reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&usecaseUnavailable.Out{
+					UserID:           userID,
+					Username:         "Alice",
+					TeamName:         "backend",
+					IsActive:         true,
+					UnavailableUntil: &until,
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.SetUserUnavailableResponse{
+				User:             user,
+				UnavailableUntil: &until,
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name: "validation failed - missing user_id",
+			body: struct {
+				UnavailableUntil time.Time `json:"unavailable_until"`
+			}{
+				UnavailableUntil: until,
+			},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrUnavailableInPast",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUnavailableInPast)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "unavailability end must be in the future",
+		},
+		{
+			name: "usecase returns ErrUserNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUserNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "user not found",
+		},
+		{
+			name: "usecase returns ErrUpdateUser",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUpdateUser)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while updating user in db",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+		{
+			name:      "user marks someone else unavailable",
+			principal: &middleware.Principal{UserID: uuid.New(), Role: middleware.User},
+			body:      reqBody,
+			mock:      func() {},
+			wantCode:  http.StatusForbidden,
+			wantError: "insufficient permissions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/users/setUnavailable", bytes.NewReader(bodyBytes))
+			if tt.principal != nil {
+				req = req.WithContext(middleware.WithPrincipal(req.Context(), *tt.principal))
+			}
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.SetUnavailable(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.SetUserUnavailableResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
@@ -5,9 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
 	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
 	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/logging"
 	usecase2 "pr-reviewers-service/internal/usecase"
 	"pr-reviewers-service/internal/usecase/stats_pr_assignments"
 )
@@ -23,19 +26,41 @@ func New(usecase usecase) *reviewersStatsHandler {
 }
 
 // @Summary Get reviewers assignment statistics
-// @Description Get assignment count statistics for all reviewers
+// @Description Get assignment count statistics for all reviewers.
+// @Description With team_name only members of that team are counted, include_subteams rolls up the whole subtree.
 // @ID GetReviewersStats
 // @Tags Statistics
 // @Produce json
+// @Param team_name query string false "Team name"
+// @Param include_subteams query bool false "Include members of all subteams"
 // @Success 200 {object} handler2.ReviewersStatsResponse "Statistics successfully retrieved"
-// @Failure 404 {object} handler2.ErrorResponse "Reviewers data not found"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid include_subteams parameter"
+// @Failure 404 {object} handler2.ErrorResponse "Reviewers data or team not found"
 // @Failure 500 {object} handler2.ErrorResponse "Internal server error"
 // @Router /stats/reviewers [get]
 func (h *reviewersStatsHandler) GetReviewersStats(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	ctx := r.Context()
 
-	result, err := h.usecase.Run(ctx, stats_pr_assignments.In{})
+	queryParams := r.URL.Query()
+	teamName := strings.TrimSpace(queryParams.Get("team_name"))
+	includeSubteams := false
+	if raw := queryParams.Get("include_subteams"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "include_subteams must be a boolean", err)
+			return
+		}
+		includeSubteams = parsed
+	}
+	if teamName != "" {
+		ctx = logging.WithLogTeamName(ctx, teamName)
+	}
+
+	result, err := h.usecase.Run(ctx, stats_pr_assignments.In{
+		TeamName:        teamName,
+		IncludeSubteams: includeSubteams,
+	})
 	if err != nil {
 		h.handleUseCaseError(w, ctx, err)
 		return
@@ -66,6 +91,14 @@ func (h *reviewersStatsHandler) handleUseCaseError(w http.ResponseWriter, ctx co
 	switch {
 	case errors.Is(err, usecase2.ErrGetPRReviewers):
 		errorMsg = "error occurred while getting PR reviewers"
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting team"
+	case errors.Is(err, usecase2.ErrGetUsers):
+		errorMsg = "error occurred while getting team members"
+	case errors.Is(err, usecase2.ErrTeamNotFound):
+		errorMsg = "team not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
 	case errors.Is(err, usecase2.ErrPRsReviewersNotFound):
 		errorMsg = "PR reviewers not found"
 		statusCode = http.StatusNotFound
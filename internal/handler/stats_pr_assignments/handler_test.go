@@ -39,6 +39,7 @@ func TestGetReviewersStats(t *testing.T) {
 
 	tests := []struct {
 		name      string
+		query     string
 		mock      func()
 		wantCode  int
 		wantError string
@@ -59,6 +60,42 @@ func TestGetReviewersStats(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "success for team subtree",
+			query: "?team_name=platform&include_subteams=true",
+			mock: func() {
+				mockUC.EXPECT().
+					Run(gomock.Any(), usecaseStats.In{TeamName: "platform", IncludeSubteams: true}).
+					Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: &handler2.ReviewersStatsResponse{
+				Reviewers: []handler2.ReviewerAssignmentCount{
+					{
+						ReviewerId:      reviewerID,
+						AssignmentCount: 5,
+					},
+				},
+			},
+		},
+		{
+			name:      "invalid include_subteams",
+			query:     "?team_name=platform&include_subteams=maybe",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "include_subteams must be a boolean",
+		},
+		{
+			name:  "usecase returns ErrTeamNotFound",
+			query: "?team_name=unknown",
+			mock: func() {
+				mockUC.EXPECT().
+					Run(gomock.Any(), usecaseStats.In{TeamName: "unknown"}).
+					Return(nil, usecase2.ErrTeamNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "team not found",
+		},
 		{
 			name: "usecase returns ErrGetPRReviewers",
 			mock: func() {
@@ -91,7 +128,7 @@ func TestGetReviewersStats(t *testing.T) {
 				tt.mock()
 			}
 
-			req := httptest.NewRequest("GET", "/stats/reviewers", bytes.NewReader(nil))
+			req := httptest.NewRequest("GET", "/stats/reviewers"+tt.query, bytes.NewReader(nil))
 			w := httptest.NewRecorder()
 
 			h.GetReviewersStats(w, req)
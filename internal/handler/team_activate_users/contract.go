@@ -0,0 +1,12 @@
+package team_activate_users
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/team_activate_users"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=team_activate_users usecase
+type usecase interface {
+	Run(ctx context.Context, req team_activate_users.In) (*team_activate_users.Out, error)
+}
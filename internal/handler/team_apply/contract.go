@@ -0,0 +1,12 @@
+package team_apply
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/team_apply"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=team_apply usecase
+type usecase interface {
+	Run(ctx context.Context, req team_apply.In) (*team_apply.Out, error)
+}
@@ -0,0 +1,201 @@
+package team_apply
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/team_apply"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+type applyTeamsHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *applyTeamsHandler {
+	return &applyTeamsHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Apply declarative teams configuration
+// @Description Compare the full desired state of teams and users with the database and apply the difference in one transaction.
+// @Description Teams missing from the request are archived, users missing from it are deactivated with their open reviews reassigned.
+// @Description With plan the changes are only computed.
+// @Description Route policies are not part of the state, they come from the app.policies configuration.
+// @ID ApplyTeams
+// @Tags Teams
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostAdminTeamsApplyJSONRequestBody true "Desired teams state"
+// @Success 200 {object} handler2.ApplyTeamsResponse "Changes applied or planned"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid desired state"
+// @Failure 401 {object} handler2.ErrorResponse "Unauthorized"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "Team or user not found"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /admin/teams/apply [post]
+func (h *applyTeamsHandler) ApplyTeams(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostAdminTeamsApplyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	teams := make([]team_apply.Team, 0, len(request.Teams))
+	for _, team := range request.Teams {
+		in := team_apply.Team{
+			TeamName:   team.TeamName,
+			IsArchived: team.IsArchived != nil && *team.IsArchived,
+			Members:    make([]team_apply.Member, 0, len(team.Members)),
+		}
+		if team.ParentTeamName != nil {
+			in.ParentTeamName = *team.ParentTeamName
+		}
+		if team.AdditionalMemberIds != nil {
+			in.AdditionalMemberIDs = *team.AdditionalMemberIds
+		}
+		for _, member := range team.Members {
+			in.Members = append(in.Members, team_apply.Member{
+				UserID:   member.UserId,
+				Username: member.Username,
+				IsActive: member.IsActive,
+			})
+		}
+		teams = append(teams, in)
+	}
+
+	result, err := h.usecase.Run(ctx, team_apply.In{
+		Teams: teams,
+		Plan:  request.Plan != nil && *request.Plan,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.ApplyTeamsResponse{
+		Changes: func() []handler2.ApplyTeamsChange {
+			changes := make([]handler2.ApplyTeamsChange, 0, len(result.Changes))
+			for _, change := range result.Changes {
+				item := handler2.ApplyTeamsChange{
+					Action:   handler2.ApplyTeamsChangeAction(change.Action),
+					TeamName: change.TeamName,
+				}
+				if change.UserID != uuid.Nil {
+					item.UserId = &change.UserID
+				}
+				if change.From != "" {
+					item.From = &change.From
+				}
+				if change.To != "" {
+					item.To = &change.To
+				}
+				changes = append(changes, item)
+			}
+			return changes
+		}(),
+		AffectedPullRequests: func() []handler2.DeactivationAffectedPullRequest {
+			prs := make([]handler2.DeactivationAffectedPullRequest, 0, len(result.AffectedPullRequests))
+			for _, pr := range result.AffectedPullRequests {
+				prs = append(prs, handler2.DeactivationAffectedPullRequest{
+					PullRequestId:    pr.PullRequestID,
+					PullRequestName:  pr.PullRequestName,
+					AuthorId:         pr.AuthorID,
+					Status:           handler2.DeactivationAffectedPullRequestStatus(pr.Status),
+					RemovedReviewers: pr.RemovedReviewers,
+					AddedReviewers:   pr.AddedReviewers,
+					Understaffed:     pr.Understaffed,
+				})
+			}
+			return prs
+		}(),
+		ReassignedReviews: func() []handler2.MoveTeamReassignedReview {
+			reviews := make([]handler2.MoveTeamReassignedReview, 0, len(result.ReassignedReviews))
+			for _, review := range result.ReassignedReviews {
+				reviews = append(reviews, handler2.MoveTeamReassignedReview{
+					PullRequestId:   review.PullRequestID,
+					PullRequestName: review.PullRequestName,
+					AuthorId:        review.AuthorID,
+					NewReviewerId:   review.NewReviewerID,
+					Outcome:         review.Outcome,
+				})
+			}
+			return reviews
+		}(),
+		Plan: result.Plan,
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *applyTeamsHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrInvalidTeamsState):
+		errorMsg = "invalid desired teams state"
+		statusCode = http.StatusBadRequest
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrDuplicateUsers):
+		errorMsg = "user is listed several times"
+		statusCode = http.StatusBadRequest
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrParentTeamNotFound):
+		errorMsg = "parent team is not listed"
+		statusCode = http.StatusBadRequest
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrTeamHierarchyCycle):
+		errorMsg = "team cannot be nested under itself or its subteam"
+		statusCode = http.StatusBadRequest
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrTeamNotFound):
+		errorMsg = "team not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		errorMsg = "user not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting teams"
+	case errors.Is(err, usecase2.ErrGetUsers):
+		errorMsg = "error occurred while getting users"
+	case errors.Is(err, usecase2.ErrGetTeamMemberships):
+		errorMsg = "error occurred while getting team memberships"
+	case errors.Is(err, usecase2.ErrSaveTeam):
+		errorMsg = "error occurred while saving team"
+	case errors.Is(err, usecase2.ErrUpdateTeam):
+		errorMsg = "error occurred while updating team"
+	case errors.Is(err, usecase2.ErrSaveUsersBatch):
+		errorMsg = "error occurred while saving users"
+	case errors.Is(err, usecase2.ErrUpdateUser):
+		errorMsg = "error occurred while updating user"
+	case errors.Is(err, usecase2.ErrSaveTeamMemberships), errors.Is(err, usecase2.ErrDeleteTeamMembership):
+		errorMsg = "error occurred while updating team memberships"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,284 @@
+package team_apply_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerTeam "pr-reviewers-service/internal/handler/team_apply"
+	mockTeam "pr-reviewers-service/internal/handler/team_apply/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseTeam "pr-reviewers-service/internal/usecase/team_apply"
+	"pr-reviewers-service/internal/usecase/team_deactivate_users"
+	"pr-reviewers-service/internal/usecase/user_move_team"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyTeams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockTeam.NewMockusecase(ctrl)
+	h := handlerTeam.New(mockUC, validate)
+
+	u1 := uuid.New()
+	u2 := uuid.New()
+	u3 := uuid.New()
+	pr1 := uuid.New()
+	pr2 := uuid.New()
+	parent := "platform"
+	plan := true
+
+	reqBody := handler2.PostAdminTeamsApplyJSONRequestBody{
+		Teams: []handler2.ApplyTeam{
+			{
+				TeamName: "platform",
+				Members:  []handler2.ApplyTeamMember{{UserId: u1, Username: "alice", IsActive: true}},
+			},
+			{
+				TeamName:            "backend",
+				ParentTeamName:      &parent,
+				Members:             []handler2.ApplyTeamMember{{UserId: u2, Username: "bob", IsActive: false}},
+				AdditionalMemberIds: &[]uuid.UUID{u1},
+			},
+		},
+	}
+	ucIn := usecaseTeam.In{
+		Teams: []usecaseTeam.Team{
+			{
+				TeamName: "platform",
+				Members:  []usecaseTeam.Member{{UserID: u1, Username: "alice", IsActive: true}},
+			},
+			{
+				TeamName:            "backend",
+				ParentTeamName:      "platform",
+				Members:             []usecaseTeam.Member{{UserID: u2, Username: "bob", IsActive: false}},
+				AdditionalMemberIDs: []uuid.UUID{u1},
+			},
+		},
+	}
+	ucOut := usecaseTeam.Out{
+		Changes: []usecaseTeam.Change{
+			{Action: usecaseTeam.ActionCreateTeam, TeamName: "backend"},
+			{Action: usecaseTeam.ActionSetParent, TeamName: "backend", To: "platform"},
+			{Action: usecaseTeam.ActionMoveUser, TeamName: "backend", UserID: u2, From: "frontend", To: "backend"},
+			{Action: usecaseTeam.ActionDeactivateUser, TeamName: "backend", UserID: u2},
+		},
+		AffectedPullRequests: []team_deactivate_users.AffectedPullRequest{
+			{
+				PullRequestID:    pr1,
+				PullRequestName:  "PR1",
+				AuthorID:         u3,
+				Status:           "OPEN",
+				RemovedReviewers: []uuid.UUID{u2},
+				AddedReviewers:   []uuid.UUID{u1},
+			},
+		},
+		ReassignedReviews: []user_move_team.ReassignedReview{
+			{PullRequestID: pr2, PullRequestName: "PR2", AuthorID: u3, NewReviewerID: &u1, Outcome: "REASSIGNED"},
+		},
+	}
+
+	planReqBody := reqBody
+	planReqBody.Plan = &plan
+	planIn := ucIn
+	planIn.Plan = true
+	planOut := usecaseTeam.Out{Changes: ucOut.Changes, Plan: true}
+
+	from, to := "frontend", "backend"
+	wantChanges := []handler2.ApplyTeamsChange{
+		{Action: handler2.CreateTeam, TeamName: "backend"},
+		{Action: handler2.SetParent, TeamName: "backend", To: &parent},
+		{Action: handler2.MoveUser, TeamName: "backend", UserId: &u2, From: &from, To: &to},
+		{Action: handler2.DeactivateUser, TeamName: "backend", UserId: &u2},
+	}
+
+	tests := []struct {
+		name      string
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.ApplyTeamsResponse{
+				Changes: wantChanges,
+				AffectedPullRequests: []handler2.DeactivationAffectedPullRequest{
+					{
+						PullRequestId:    pr1,
+						PullRequestName:  "PR1",
+						AuthorId:         u3,
+						Status:           handler2.DeactivationAffectedPullRequestStatus("OPEN"),
+						RemovedReviewers: []uuid.UUID{u2},
+						AddedReviewers:   []uuid.UUID{u1},
+					},
+				},
+				ReassignedReviews: []handler2.MoveTeamReassignedReview{
+					{PullRequestId: pr2, PullRequestName: "PR2", AuthorId: u3, NewReviewerId: &u1, Outcome: "REASSIGNED"},
+				},
+				Plan: false,
+			},
+		},
+		{
+			name: "success plan",
+			body: planReqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), planIn).Return(&planOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.ApplyTeamsResponse{
+				Changes:              wantChanges,
+				AffectedPullRequests: []handler2.DeactivationAffectedPullRequest{},
+				ReassignedReviews:    []handler2.MoveTeamReassignedReview{},
+				Plan:                 true,
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "validation failed - empty teams",
+			body:      handler2.PostAdminTeamsApplyJSONRequestBody{Teams: []handler2.ApplyTeam{}},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "validation failed - member without username",
+			body: handler2.PostAdminTeamsApplyJSONRequestBody{Teams: []handler2.ApplyTeam{
+				{TeamName: "platform", Members: []handler2.ApplyTeamMember{{UserId: u1}}},
+			}},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrInvalidTeamsState",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrInvalidTeamsState)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "invalid desired teams state",
+		},
+		{
+			name: "usecase returns ErrDuplicateUsers",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrDuplicateUsers)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "user is listed several times",
+		},
+		{
+			name: "usecase returns ErrParentTeamNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrParentTeamNotFound)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "parent team is not listed",
+		},
+		{
+			name: "usecase returns ErrTeamHierarchyCycle",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrTeamHierarchyCycle)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "team cannot be nested under itself or its subteam",
+		},
+		{
+			name: "usecase returns ErrUserNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUserNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "user not found",
+		},
+		{
+			name: "usecase returns ErrGetTeam",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrGetTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting teams",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			case nil:
+				bodyBytes = nil
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/admin/teams/apply", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.ApplyTeams(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.ApplyTeamsResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
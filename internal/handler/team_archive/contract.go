@@ -0,0 +1,12 @@
+package team_archive
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/team_archive"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=team_archive usecase
+type usecase interface {
+	Run(ctx context.Context, req team_archive.In) (*team_archive.Out, error)
+}
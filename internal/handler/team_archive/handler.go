@@ -0,0 +1,102 @@
+package team_archive
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/team_archive"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type archiveTeamHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *archiveTeamHandler {
+	return &archiveTeamHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Archive or restore team
+// @Description Members of an archived team are not selected as reviewers and the team is hidden from the team tree
+// @ID SetTeamIsArchived
+// @Tags Teams
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostTeamSetIsArchivedJSONRequestBody true "Team archive data"
+// @Success 200 {object} handler2.SetTeamIsArchivedResponse "Team archive state updated"
+// @Success 304 "Team already has this archive state"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "Team not found"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /team/setIsArchived [post]
+func (h *archiveTeamHandler) SetTeamIsArchived(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostTeamSetIsArchivedJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	ctx = logging.WithLogTeamName(ctx, request.TeamName)
+
+	result, err := h.usecase.Run(ctx, team_archive.In{
+		TeamName:   request.TeamName,
+		IsArchived: request.IsArchived,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.SetTeamIsArchivedResponse{
+		TeamName:   result.TeamName,
+		IsArchived: result.IsArchived,
+		ArchivedAt: result.ArchivedAt,
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *archiveTeamHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting team"
+	case errors.Is(err, usecase2.ErrUpdateTeam):
+		errorMsg = "error occurred while updating team in db"
+	case errors.Is(err, usecase2.ErrTeamNotFound):
+		errorMsg = "team not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrTeamDontNeedChange):
+		errorMsg = "team already has same archive state as you trying to assign"
+		statusCode = http.StatusNotModified
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,184 @@
+package team_archive_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerTeam "pr-reviewers-service/internal/handler/team_archive"
+	mockTeam "pr-reviewers-service/internal/handler/team_archive/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseTeam "pr-reviewers-service/internal/usecase/team_archive"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTeamIsArchived(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockTeam.NewMockusecase(ctrl)
+	h := handlerTeam.New(mockUC, validate)
+
+	archivedAt := time.Date(2025, 12, 7, 10, 0, 0, 0, time.UTC)
+	reqBody := handler2.PostTeamSetIsArchivedJSONRequestBody{
+		TeamName:   "billing",
+		IsArchived: true,
+	}
+	ucIn := usecaseTeam.In{
+		TeamName:   "billing",
+		IsArchived: true,
+	}
+
+	tests := []struct {
+		name      string
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success archive",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&usecaseTeam.Out{
+					TeamName:   "billing",
+					IsArchived: true,
+					ArchivedAt: &archivedAt,
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.SetTeamIsArchivedResponse{
+				TeamName:   "billing",
+				IsArchived: true,
+				ArchivedAt: &archivedAt,
+			},
+		},
+		{
+			name: "success restore",
+			body: handler2.PostTeamSetIsArchivedJSONRequestBody{TeamName: "billing"},
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecaseTeam.In{TeamName: "billing"}).Return(&usecaseTeam.Out{
+					TeamName: "billing",
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.SetTeamIsArchivedResponse{
+				TeamName: "billing",
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "validation failed - missing team name",
+			body:      struct{}{},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrTeamNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrTeamNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "team not found",
+		},
+		{
+			name: "usecase returns ErrTeamDontNeedChange",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrTeamDontNeedChange)
+			},
+			wantCode:  http.StatusNotModified,
+			wantError: "team already has same archive state as you trying to assign",
+		},
+		{
+			name: "usecase returns ErrGetTeam",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrGetTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting team",
+		},
+		{
+			name: "usecase returns ErrUpdateTeam",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUpdateTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while updating team in db",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			case nil:
+				bodyBytes = nil
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/team/setIsArchived", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.SetTeamIsArchived(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.SetTeamIsArchivedResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
@@ -28,7 +28,8 @@ func New(usecase usecase, validator *validator.Validate) *deactivateTeamUsersHan
 }
 
 // @Summary Deactivate team users
-// @Description Deactivate multiple users in a team and handle PR reassignments
+// @Description Deactivate multiple users in a team and handle PR reassignments.
+// @Description Returns a per-PR report; with dry_run the report is computed and all changes are rolled back.
 // @ID DeactivateTeamUsers
 // @Tags Teams
 // @Accept json
@@ -37,7 +38,7 @@ func New(usecase usecase, validator *validator.Validate) *deactivateTeamUsersHan
 // @Success 200 {object} handler2.DeactivateTeamUsersResponse "Users successfully deactivated"
 // @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
 // @Failure 422 {object} handler2.ErrorResponse "Validation failed"
-// @Failure 404 {object} handler2.ErrorResponse "Team, users, or pull requests not found"
+// @Failure 404 {object} handler2.ErrorResponse "Team or users not found"
 // @Failure 409 {object} handler2.ErrorResponse "User does not belong to the specified team"
 // @Failure 500 {object} handler2.ErrorResponse "Internal server error"
 // @Router /team/deactivateUsers [patch]
@@ -61,6 +62,7 @@ func (h *deactivateTeamUsersHandler) DeactivateTeamUsers(w http.ResponseWriter,
 	result, err := h.usecase.Run(ctx, team_deactivate_users.In{
 		TeamName: request.TeamName,
 		UserIDs:  request.UserIds,
+		DryRun:   request.DryRun != nil && *request.DryRun,
 	})
 	if err != nil {
 		h.handleUseCaseError(w, ctx, err)
@@ -82,18 +84,22 @@ func (h *deactivateTeamUsersHandler) DeactivateTeamUsers(w http.ResponseWriter,
 				return members
 			}(),
 		},
-		AffectedPullRequests: func() []handler2.PullRequestShort {
-			prs := make([]handler2.PullRequestShort, 0, len(result.AffectedPullRequests))
+		AffectedPullRequests: func() []handler2.DeactivationAffectedPullRequest {
+			prs := make([]handler2.DeactivationAffectedPullRequest, 0, len(result.AffectedPullRequests))
 			for _, pr := range result.AffectedPullRequests {
-				prs = append(prs, handler2.PullRequestShort{
-					PullRequestId:   pr.PullRequestID,
-					PullRequestName: pr.PullRequestName,
-					AuthorId:        pr.AuthorID,
-					Status:          handler2.PullRequestShortStatus(pr.Status),
+				prs = append(prs, handler2.DeactivationAffectedPullRequest{
+					PullRequestId:    pr.PullRequestID,
+					PullRequestName:  pr.PullRequestName,
+					AuthorId:         pr.AuthorID,
+					Status:           handler2.DeactivationAffectedPullRequestStatus(pr.Status),
+					RemovedReviewers: pr.RemovedReviewers,
+					AddedReviewers:   pr.AddedReviewers,
+					Understaffed:     pr.Understaffed,
 				})
 			}
 			return prs
 		}(),
+		DryRun: result.DryRun,
 	}
 
 	if err = json.NewEncoder(w).Encode(out); err != nil {
@@ -140,14 +146,6 @@ func (h *deactivateTeamUsersHandler) handleUseCaseError(w http.ResponseWriter, c
 		errorMsg = "user not found"
 		statusCode = http.StatusNotFound
 		errorResponseErrorCode = handler2.NOTFOUND
-	case errors.Is(err, usecase2.ErrNoPRsToAffect):
-		errorMsg = "no pull requests to affect"
-		statusCode = http.StatusNotFound
-		errorResponseErrorCode = handler2.NOTFOUND
-	case errors.Is(err, usecase2.ErrNoUsersAssignedToPRs):
-		errorMsg = "no users assigned to pull requests"
-		statusCode = http.StatusNotFound
-		errorResponseErrorCode = handler2.NOTFOUND
 	}
 
 	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
@@ -47,11 +47,28 @@ func TestDeactivateTeamUsers(t *testing.T) {
 				{UserID: u2, Username: "user2", IsActive: false},
 			},
 		},
-		AffectedPullRequests: []usecaseTeam.PullRequestShort{
-			{PullRequestID: pr1, PullRequestName: "PR1", AuthorID: u3, Status: "OPEN"},
+		AffectedPullRequests: []usecaseTeam.AffectedPullRequest{
+			{
+				PullRequestID:    pr1,
+				PullRequestName:  "PR1",
+				AuthorID:         u3,
+				Status:           "OPEN",
+				RemovedReviewers: []uuid.UUID{u1},
+				AddedReviewers:   []uuid.UUID{},
+				Understaffed:     true,
+			},
 		},
 	}
 
+	dryRun := true
+	dryRunReqBody := handler2.PatchTeamDeactivateUsersJSONRequestBody{
+		TeamName: "teamA",
+		UserIds:  []uuid.UUID{u1, u2},
+		DryRun:   &dryRun,
+	}
+	dryRunOut := ucOut
+	dryRunOut.DryRun = true
+
 	tests := []struct {
 		name      string
 		body      interface{}
@@ -70,10 +87,40 @@ func TestDeactivateTeamUsers(t *testing.T) {
 				}).Return(&ucOut, nil)
 			},
 			wantCode: http.StatusOK,
-			wantBody: struct {
-				Team                 handler2.Team               `json:"team"`
-				AffectedPullRequests []handler2.PullRequestShort `json:"affected_pull_requests"`
-			}{
+			wantBody: handler2.DeactivateTeamUsersResponse{
+				Team: handler2.Team{
+					TeamName: "teamA",
+					Members: []handler2.TeamMember{
+						{UserId: u1, Username: "user1", IsActive: false},
+						{UserId: u2, Username: "user2", IsActive: false},
+					},
+				},
+				AffectedPullRequests: []handler2.DeactivationAffectedPullRequest{
+					{
+						PullRequestId:    pr1,
+						PullRequestName:  "PR1",
+						AuthorId:         u3,
+						Status:           handler2.DeactivationAffectedPullRequestStatus("OPEN"),
+						RemovedReviewers: []uuid.UUID{u1},
+						AddedReviewers:   []uuid.UUID{},
+						Understaffed:     true,
+					},
+				},
+				DryRun: false,
+			},
+		},
+		{
+			name: "success dry run",
+			body: dryRunReqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecaseTeam.In{
+					TeamName: "teamA",
+					UserIDs:  []uuid.UUID{u1, u2},
+					DryRun:   true,
+				}).Return(&dryRunOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.DeactivateTeamUsersResponse{
 				Team: handler2.Team{
 					TeamName: "teamA",
 					Members: []handler2.TeamMember{
@@ -81,9 +128,18 @@ func TestDeactivateTeamUsers(t *testing.T) {
 						{UserId: u2, Username: "user2", IsActive: false},
 					},
 				},
-				AffectedPullRequests: []handler2.PullRequestShort{
-					{PullRequestId: pr1, PullRequestName: "PR1", AuthorId: u3, Status: handler2.PullRequestShortStatus("OPEN")},
+				AffectedPullRequests: []handler2.DeactivationAffectedPullRequest{
+					{
+						PullRequestId:    pr1,
+						PullRequestName:  "PR1",
+						AuthorId:         u3,
+						Status:           handler2.DeactivationAffectedPullRequestStatus("OPEN"),
+						RemovedReviewers: []uuid.UUID{u1},
+						AddedReviewers:   []uuid.UUID{},
+						Understaffed:     true,
+					},
 				},
+				DryRun: true,
 			},
 		},
 		{
@@ -183,30 +239,6 @@ func TestDeactivateTeamUsers(t *testing.T) {
 			wantCode:  http.StatusNotFound,
 			wantError: "users not found by provided IDs",
 		},
-		{
-			name: "usecase returns ErrNoPRsToAffect",
-			body: reqBody,
-			mock: func() {
-				mockUC.EXPECT().Run(gomock.Any(), usecaseTeam.In{
-					TeamName: "teamA",
-					UserIDs:  []uuid.UUID{u1, u2},
-				}).Return(nil, usecase2.ErrNoPRsToAffect)
-			},
-			wantCode:  http.StatusNotFound,
-			wantError: "no pull requests to affect",
-		},
-		{
-			name: "usecase returns ErrNoUsersAssignedToPRs",
-			body: reqBody,
-			mock: func() {
-				mockUC.EXPECT().Run(gomock.Any(), usecaseTeam.In{
-					TeamName: "teamA",
-					UserIDs:  []uuid.UUID{u1, u2},
-				}).Return(nil, usecase2.ErrNoUsersAssignedToPRs)
-			},
-			wantCode:  http.StatusNotFound,
-			wantError: "no users assigned to pull requests",
-		},
 		{
 			name: "usecase returns ErrGetTeam",
 			body: reqBody,
@@ -365,10 +397,7 @@ func TestDeactivateTeamUsers(t *testing.T) {
 			}
 
 			if tt.wantBody != nil {
-				var resp struct {
-					Team                 handler2.Team               `json:"team"`
-					AffectedPullRequests []handler2.PullRequestShort `json:"affected_pull_requests"`
-				}
+				var resp handler2.DeactivateTeamUsersResponse
 				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
 				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
 			}
@@ -0,0 +1,12 @@
+package team_delete
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/team_delete"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=team_delete usecase
+type usecase interface {
+	Run(ctx context.Context, req team_delete.In) (*team_delete.Out, error)
+}
@@ -0,0 +1,166 @@
+package team_delete
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/team_delete"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type deleteTeamHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *deleteTeamHandler {
+	return &deleteTeamHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Delete team
+// @Description Delete team. Users whose primary team it is stay in their oldest other team. Users without one
+// @Description are moved to the default team if they authored pull requests or reviewed closed ones, the rest
+// @Description is deleted. The request is refused while these users have open pull requests unless
+// @Description cascade_reassign is set, then their reviews are reassigned to the author's team.
+// @ID DeleteTeam
+// @Tags Teams
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostTeamDeleteJSONRequestBody true "Team delete data"
+// @Success 200 {object} handler2.DeleteTeamResponse "Team successfully deleted"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "Team not found"
+// @Failure 409 {object} handler2.ErrorResponse "Team members have open pull requests or the default team has pull request history"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /team/delete [post]
+func (h *deleteTeamHandler) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostTeamDeleteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	ctx = logging.WithLogTeamName(ctx, request.TeamName)
+
+	result, err := h.usecase.Run(ctx, team_delete.In{
+		TeamName:        request.TeamName,
+		CascadeReassign: request.CascadeReassign != nil && *request.CascadeReassign,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.DeleteTeamResponse{
+		TeamName:       result.TeamName,
+		DeletedUserIds: result.DeletedUserIDs,
+		PromotedMembers: func() []handler2.DeletedTeamPromotedMember {
+			members := make([]handler2.DeletedTeamPromotedMember, 0, len(result.PromotedMembers))
+			for _, member := range result.PromotedMembers {
+				members = append(members, handler2.DeletedTeamPromotedMember{
+					UserId:   member.UserID,
+					TeamName: member.TeamName,
+				})
+			}
+			return members
+		}(),
+		DetachedMembers: func() []handler2.DeletedTeamPromotedMember {
+			members := make([]handler2.DeletedTeamPromotedMember, 0, len(result.DetachedMembers))
+			for _, member := range result.DetachedMembers {
+				members = append(members, handler2.DeletedTeamPromotedMember{
+					UserId:   member.UserID,
+					TeamName: member.TeamName,
+				})
+			}
+			return members
+		}(),
+		AffectedPullRequests: func() []handler2.DeactivationAffectedPullRequest {
+			prs := make([]handler2.DeactivationAffectedPullRequest, 0, len(result.AffectedPullRequests))
+			for _, pr := range result.AffectedPullRequests {
+				prs = append(prs, handler2.DeactivationAffectedPullRequest{
+					PullRequestId:    pr.PullRequestID,
+					PullRequestName:  pr.PullRequestName,
+					AuthorId:         pr.AuthorID,
+					Status:           handler2.DeactivationAffectedPullRequestStatus(pr.Status),
+					RemovedReviewers: pr.RemovedReviewers,
+					AddedReviewers:   pr.AddedReviewers,
+					Understaffed:     pr.Understaffed,
+				})
+			}
+			return prs
+		}(),
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *deleteTeamHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting team"
+	case errors.Is(err, usecase2.ErrGetUsers):
+		errorMsg = "error occurred while getting users"
+	case errors.Is(err, usecase2.ErrGetUser):
+		errorMsg = "error occurred while getting pr author"
+	case errors.Is(err, usecase2.ErrGetPRReviewers):
+		errorMsg = "error occurred while getting pr reviewers"
+	case errors.Is(err, usecase2.ErrGetPullRequest):
+		errorMsg = "error occurred while getting pull request"
+	case errors.Is(err, usecase2.ErrGetPRStatus):
+		errorMsg = "error occurred while getting pr status"
+	case errors.Is(err, usecase2.ErrRemoveReviewer):
+		errorMsg = "error occurred while removing reviewer"
+	case errors.Is(err, usecase2.ErrAssignReviewer):
+		errorMsg = "error occurred while assigning reviewer"
+	case errors.Is(err, usecase2.ErrGetTeamMemberships):
+		errorMsg = "error occurred while getting team memberships"
+	case errors.Is(err, usecase2.ErrUpdateUser), errors.Is(err, usecase2.ErrSaveTeamMemberships):
+		errorMsg = "error occurred while moving user to another team"
+	case errors.Is(err, usecase2.ErrDeleteTeamMembership):
+		errorMsg = "error occurred while deleting team membership"
+	case errors.Is(err, usecase2.ErrDeleteUser):
+		errorMsg = "error occurred while deleting user"
+	case errors.Is(err, usecase2.ErrDeleteTeam):
+		errorMsg = "error occurred while deleting team"
+	case errors.Is(err, usecase2.ErrTeamNotFound):
+		errorMsg = "team not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrTeamHasOpenPullRequests):
+		errorMsg = "team members have open pull requests, pass cascade_reassign to delete anyway"
+		statusCode = http.StatusConflict
+		errorResponseErrorCode = handler2.TEAMHASOPENPRS
+	case errors.Is(err, usecase2.ErrTeamHasPullRequestHistory):
+		errorMsg = "default team members authored or reviewed closed pull requests, archive the team instead"
+		statusCode = http.StatusConflict
+		errorResponseErrorCode = handler2.TEAMHASPRHISTORY
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
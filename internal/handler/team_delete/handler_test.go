@@ -0,0 +1,267 @@
+package team_delete_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerTeam "pr-reviewers-service/internal/handler/team_delete"
+	mockTeam "pr-reviewers-service/internal/handler/team_delete/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseTeam "pr-reviewers-service/internal/usecase/team_delete"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockTeam.NewMockusecase(ctrl)
+	h := handlerTeam.New(mockUC, validate)
+
+	u1 := uuid.New()
+	u2 := uuid.New()
+	u3 := uuid.New()
+	u4 := uuid.New()
+	u5 := uuid.New()
+	pr2 := uuid.New()
+
+	reqBody := handler2.PostTeamDeleteJSONRequestBody{
+		TeamName: "billing",
+	}
+	defaultIn := usecaseTeam.In{
+		TeamName: "billing",
+	}
+	cascade := true
+	cascadeReqBody := handler2.PostTeamDeleteJSONRequestBody{
+		TeamName:        "billing",
+		CascadeReassign: &cascade,
+	}
+	cascadeIn := usecaseTeam.In{
+		TeamName:        "billing",
+		CascadeReassign: true,
+	}
+
+	ucOut := usecaseTeam.Out{
+		TeamName:       "billing",
+		DeletedUserIDs: []uuid.UUID{u1},
+		PromotedMembers: []usecaseTeam.PromotedMember{
+			{UserID: u4, TeamName: "payments"},
+		},
+		DetachedMembers: []usecaseTeam.PromotedMember{
+			{UserID: u5, TeamName: "scim"},
+		},
+		AffectedPullRequests: []usecaseTeam.AffectedPullRequest{
+			{
+				PullRequestID:    pr2,
+				PullRequestName:  "PR2",
+				AuthorID:         u2,
+				Status:           "OPEN",
+				RemovedReviewers: []uuid.UUID{u1},
+				AddedReviewers:   []uuid.UUID{u3},
+			},
+		},
+	}
+	wantResp := handler2.DeleteTeamResponse{
+		TeamName:       "billing",
+		DeletedUserIds: []uuid.UUID{u1},
+		PromotedMembers: []handler2.DeletedTeamPromotedMember{
+			{UserId: u4, TeamName: "payments"},
+		},
+		DetachedMembers: []handler2.DeletedTeamPromotedMember{
+			{UserId: u5, TeamName: "scim"},
+		},
+		AffectedPullRequests: []handler2.DeactivationAffectedPullRequest{
+			{
+				PullRequestId:    pr2,
+				PullRequestName:  "PR2",
+				AuthorId:         u2,
+				Status:           handler2.DeactivationAffectedPullRequestStatusOPEN,
+				RemovedReviewers: []uuid.UUID{u1},
+				AddedReviewers:   []uuid.UUID{u3},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success with cascade reassignment",
+			body: cascadeReqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), cascadeIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: wantResp,
+		},
+		{
+			name: "success without members",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(&usecaseTeam.Out{TeamName: "billing"}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.DeleteTeamResponse{
+				TeamName:             "billing",
+				PromotedMembers:      []handler2.DeletedTeamPromotedMember{},
+				DetachedMembers:      []handler2.DeletedTeamPromotedMember{},
+				AffectedPullRequests: []handler2.DeactivationAffectedPullRequest{},
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "validation failed - missing team name",
+			body:      struct{}{},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrTeamNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrTeamNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "team not found",
+		},
+		{
+			name: "usecase returns ErrTeamHasOpenPullRequests",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrTeamHasOpenPullRequests)
+			},
+			wantCode:  http.StatusConflict,
+			wantError: "team members have open pull requests",
+		},
+		{
+			name: "usecase returns ErrTeamHasPullRequestHistory",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrTeamHasPullRequestHistory)
+			},
+			wantCode:  http.StatusConflict,
+			wantError: "team members authored or reviewed closed pull requests",
+		},
+		{
+			name: "usecase returns ErrGetTeam",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrGetTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting team",
+		},
+		{
+			name: "usecase returns ErrGetUsers",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrGetUsers)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting users",
+		},
+		{
+			name: "usecase returns ErrGetPullRequest",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrGetPullRequest)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting pull request",
+		},
+		{
+			name: "usecase returns ErrAssignReviewer",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrAssignReviewer)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while assigning reviewer",
+		},
+		{
+			name: "usecase returns ErrDeleteTeam",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrDeleteTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while deleting team",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			case nil:
+				bodyBytes = nil
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/team/delete", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.DeleteTeam(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.DeleteTeamResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package team_rebalance
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/team_rebalance"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=team_rebalance usecase
+type usecase interface {
+	Run(ctx context.Context, req team_rebalance.In) (*team_rebalance.Out, error)
+}
@@ -0,0 +1,141 @@
+package team_rebalance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/team_rebalance"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type rebalanceTeamHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *rebalanceTeamHandler {
+	return &rebalanceTeamHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Rebalance team review workload
+// @Description Move open review assignments between active team members until the gap between the busiest
+// @Description and the least busy member is within tolerance. With dry_run the plan is computed and rolled back.
+// @ID RebalanceTeam
+// @Tags Teams
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostTeamRebalanceJSONRequestBody true "Team rebalance data"
+// @Success 200 {object} handler2.RebalanceTeamResponse "Workload successfully rebalanced"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "Team not found"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /team/rebalance [post]
+func (h *rebalanceTeamHandler) RebalanceTeam(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostTeamRebalanceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	ctx = logging.WithLogTeamName(ctx, request.TeamName)
+
+	tolerance := team_rebalance.DefaultTolerance
+	if request.Tolerance != nil {
+		tolerance = *request.Tolerance
+	}
+
+	result, err := h.usecase.Run(ctx, team_rebalance.In{
+		TeamName:  request.TeamName,
+		Tolerance: tolerance,
+		DryRun:    request.DryRun != nil && *request.DryRun,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.RebalanceTeamResponse{
+		TeamName:  result.TeamName,
+		Tolerance: result.Tolerance,
+		Reassignments: func() []handler2.RebalanceReassignment {
+			reassignments := make([]handler2.RebalanceReassignment, 0, len(result.Reassignments))
+			for _, reassignment := range result.Reassignments {
+				reassignments = append(reassignments, handler2.RebalanceReassignment{
+					PullRequestId:   reassignment.PullRequestID,
+					PullRequestName: reassignment.PullRequestName,
+					AuthorId:        reassignment.AuthorID,
+					OldReviewerId:   reassignment.OldReviewerID,
+					NewReviewerId:   reassignment.NewReviewerID,
+				})
+			}
+			return reassignments
+		}(),
+		Workload: func() []handler2.RebalanceMemberWorkload {
+			workload := make([]handler2.RebalanceMemberWorkload, 0, len(result.Workload))
+			for _, member := range result.Workload {
+				workload = append(workload, handler2.RebalanceMemberWorkload{
+					UserId:            member.UserID,
+					Username:          member.Username,
+					OpenReviewsBefore: member.OpenReviewsBefore,
+					OpenReviewsAfter:  member.OpenReviewsAfter,
+				})
+			}
+			return workload
+		}(),
+		Balanced: result.Balanced,
+		DryRun:   result.DryRun,
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *rebalanceTeamHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting team"
+	case errors.Is(err, usecase2.ErrGetUsers):
+		errorMsg = "error occurred while getting users"
+	case errors.Is(err, usecase2.ErrGetPRReviewers):
+		errorMsg = "error occurred while getting pr reviewers"
+	case errors.Is(err, usecase2.ErrGetPullRequest):
+		errorMsg = "error occurred while getting pull request"
+	case errors.Is(err, usecase2.ErrGetPRStatus):
+		errorMsg = "error occurred while getting pr status"
+	case errors.Is(err, usecase2.ErrRemoveReviewer):
+		errorMsg = "error occurred while removing reviewer"
+	case errors.Is(err, usecase2.ErrAssignReviewer):
+		errorMsg = "error occurred while assigning reviewer"
+	case errors.Is(err, usecase2.ErrTeamNotFound):
+		errorMsg = "team not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
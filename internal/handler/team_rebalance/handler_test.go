@@ -0,0 +1,286 @@
+package team_rebalance_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerTeam "pr-reviewers-service/internal/handler/team_rebalance"
+	mockTeam "pr-reviewers-service/internal/handler/team_rebalance/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseTeam "pr-reviewers-service/internal/usecase/team_rebalance"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebalanceTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockTeam.NewMockusecase(ctrl)
+	h := handlerTeam.New(mockUC, validate)
+
+	u1 := uuid.New()
+	u2 := uuid.New()
+	u3 := uuid.New()
+	pr1 := uuid.New()
+
+	reqBody := handler2.PostTeamRebalanceJSONRequestBody{
+		TeamName: "teamA",
+	}
+	defaultIn := usecaseTeam.In{
+		TeamName:  "teamA",
+		Tolerance: usecaseTeam.DefaultTolerance,
+	}
+
+	ucOut := usecaseTeam.Out{
+		TeamName:  "teamA",
+		Tolerance: 1,
+		Reassignments: []usecaseTeam.Reassignment{
+			{
+				PullRequestID:   pr1,
+				PullRequestName: "PR1",
+				AuthorID:        u3,
+				OldReviewerID:   u1,
+				NewReviewerID:   u2,
+			},
+		},
+		Workload: []usecaseTeam.MemberWorkload{
+			{UserID: u1, Username: "user1", OpenReviewsBefore: 2, OpenReviewsAfter: 1},
+			{UserID: u2, Username: "user2", OpenReviewsBefore: 0, OpenReviewsAfter: 1},
+		},
+		Balanced: true,
+	}
+	wantResp := handler2.RebalanceTeamResponse{
+		TeamName:  "teamA",
+		Tolerance: 1,
+		Reassignments: []handler2.RebalanceReassignment{
+			{
+				PullRequestId:   pr1,
+				PullRequestName: "PR1",
+				AuthorId:        u3,
+				OldReviewerId:   u1,
+				NewReviewerId:   u2,
+			},
+		},
+		Workload: []handler2.RebalanceMemberWorkload{
+			{UserId: u1, Username: "user1", OpenReviewsBefore: 2, OpenReviewsAfter: 1},
+			{UserId: u2, Username: "user2", OpenReviewsBefore: 0, OpenReviewsAfter: 1},
+		},
+		Balanced: true,
+		DryRun:   false,
+	}
+
+	dryRun := true
+	tolerance := 0
+	previewReqBody := handler2.PostTeamRebalanceJSONRequestBody{
+		TeamName:  "teamA",
+		Tolerance: &tolerance,
+		DryRun:    &dryRun,
+	}
+	previewOut := ucOut
+	previewOut.Tolerance = 0
+	previewOut.DryRun = true
+	wantPreviewResp := wantResp
+	wantPreviewResp.Tolerance = 0
+	wantPreviewResp.DryRun = true
+
+	negativeTolerance := -1
+
+	tests := []struct {
+		name      string
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success with default tolerance",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: wantResp,
+		},
+		{
+			name: "success preview with explicit tolerance",
+			body: previewReqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecaseTeam.In{
+					TeamName:  "teamA",
+					Tolerance: 0,
+					DryRun:    true,
+				}).Return(&previewOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: wantPreviewResp,
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "empty body",
+			body:      nil,
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "validation failed - missing team name",
+			body:      struct{}{},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "validation failed - negative tolerance",
+			body: handler2.PostTeamRebalanceJSONRequestBody{
+				TeamName:  "teamA",
+				Tolerance: &negativeTolerance,
+			},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrTeamNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrTeamNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "team not found",
+		},
+		{
+			name: "usecase returns ErrGetTeam",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrGetTeam)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting team",
+		},
+		{
+			name: "usecase returns ErrGetUsers",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrGetUsers)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting users",
+		},
+		{
+			name: "usecase returns ErrGetPRReviewers",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrGetPRReviewers)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting pr reviewers",
+		},
+		{
+			name: "usecase returns ErrGetPullRequest",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrGetPullRequest)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting pull request",
+		},
+		{
+			name: "usecase returns ErrGetPRStatus",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrGetPRStatus)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while getting pr status",
+		},
+		{
+			name: "usecase returns ErrRemoveReviewer",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrRemoveReviewer)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while removing reviewer",
+		},
+		{
+			name: "usecase returns ErrAssignReviewer",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, usecase2.ErrAssignReviewer)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while assigning reviewer",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), defaultIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			case nil:
+				bodyBytes = nil
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/team/rebalance", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.RebalanceTeam(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.RebalanceTeamResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
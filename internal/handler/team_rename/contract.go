@@ -0,0 +1,12 @@
+package team_rename
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/team_rename"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=team_rename usecase
+type usecase interface {
+	Run(ctx context.Context, req team_rename.In) (*team_rename.Out, error)
+}
@@ -0,0 +1,106 @@
+package team_rename
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/team_rename"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type renameTeamHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *renameTeamHandler {
+	return &renameTeamHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Rename team
+// @Description Rename team keeping team names unique
+// @ID RenameTeam
+// @Tags Teams
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostTeamRenameJSONRequestBody true "Team rename data"
+// @Success 200 {object} handler2.RenameTeamResponse "Team successfully renamed"
+// @Success 304 "Team already has this name"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "Team not found"
+// @Failure 409 {object} handler2.ErrorResponse "Team with such name already exists"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /team/rename [post]
+func (h *renameTeamHandler) RenameTeam(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostTeamRenameJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	ctx = logging.WithLogTeamName(ctx, request.TeamName)
+
+	result, err := h.usecase.Run(ctx, team_rename.In{
+		TeamName:    request.TeamName,
+		NewTeamName: request.NewTeamName,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.RenameTeamResponse{
+		TeamName:         result.TeamName,
+		PreviousTeamName: result.PreviousTeamName,
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *renameTeamHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting team"
+	case errors.Is(err, usecase2.ErrUpdateTeam):
+		errorMsg = "error occurred while updating team in db"
+	case errors.Is(err, usecase2.ErrTeamNotFound):
+		errorMsg = "team not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrTeamAlreadyExists):
+		errorMsg = "team with such name already exists"
+		statusCode = http.StatusConflict
+		errorResponseErrorCode = handler2.TEAMEXISTS
+	case errors.Is(err, usecase2.ErrTeamDontNeedChange):
+		errorMsg = "team already has such name"
+		statusCode = http.StatusNotModified
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
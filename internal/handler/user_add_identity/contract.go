@@ -0,0 +1,12 @@
+package user_add_identity
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/user_add_identity"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=user_add_identity usecase
+type usecase interface {
+	Run(ctx context.Context, req user_add_identity.In) (*user_add_identity.Out, error)
+}
@@ -0,0 +1,120 @@
+package user_add_identity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/user_add_identity"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type addUserIdentityHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *addUserIdentityHandler {
+	return &addUserIdentityHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Link external identity to user
+// @Description Link a GitHub/GitLab login or an email to the user. Provider and external_id are case insensitive.
+// @Description Linking an identity the user already has is a no-op.
+// @ID AddUserIdentity
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostUsersAddIdentityJSONRequestBody true "Identity data"
+// @Success 201 {object} handler2.UserIdentitiesResponse "All identities of the user"
+// @Failure 400 {object} handler2.ErrorResponse "Unknown provider or empty external_id"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "User not found"
+// @Failure 409 {object} handler2.ErrorResponse "Identity is already linked to another user"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /users/addIdentity [post]
+func (h *addUserIdentityHandler) AddUserIdentity(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostUsersAddIdentityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	ctx = logging.WithLogUserId(ctx, request.UserId)
+
+	result, err := h.usecase.Run(ctx, user_add_identity.In{
+		UserID:     request.UserId,
+		Provider:   request.Provider,
+		ExternalID: request.ExternalId,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.UserIdentitiesResponse{
+		UserId: result.UserID,
+		Identities: func() []handler2.UserIdentity {
+			identities := make([]handler2.UserIdentity, 0, len(result.Identities))
+			for _, identity := range result.Identities {
+				identities = append(identities, handler2.UserIdentity{
+					Provider:   identity.Provider,
+					ExternalId: identity.ExternalID,
+				})
+			}
+			return identities
+		}(),
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *addUserIdentityHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrInvalidIdentity):
+		errorMsg = "provider must be one of github, gitlab, email and external_id must not be empty"
+		statusCode = http.StatusBadRequest
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		errorMsg = "user not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrIdentityAlreadyLinked):
+		errorMsg = "identity is already linked to another user"
+		statusCode = http.StatusConflict
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrGetUser):
+		errorMsg = "error occurred while getting user from db"
+	case errors.Is(err, usecase2.ErrGetIdentities):
+		errorMsg = "error occurred while getting user identities"
+	case errors.Is(err, usecase2.ErrSaveIdentities):
+		errorMsg = "error occurred while saving user identity in db"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,178 @@
+package user_add_identity_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerAdd "pr-reviewers-service/internal/handler/user_add_identity"
+	mockAdd "pr-reviewers-service/internal/handler/user_add_identity/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/user_add_identity"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddUserIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockAdd.NewMockusecase(ctrl)
+	h := handlerAdd.New(mockUC, validate)
+
+	userID := uuid.New()
+	reqBody := handler.PostUsersAddIdentityJSONRequestBody{
+		UserId:     userID,
+		Provider:   "github",
+		ExternalId: "alice",
+	}
+	ucIn := usecase.In{
+		UserID:     userID,
+		Provider:   "github",
+		ExternalID: "alice",
+	}
+
+	ucOut := usecase.Out{
+		UserID: userID,
+		Identities: []usecase2.Identity{
+			{Provider: "email", ExternalID: "alice@example.com"},
+			{Provider: "github", ExternalID: "alice"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		body        interface{}
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.UserIdentitiesResponse
+	}{
+		{
+			name: "success",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusCreated,
+			wantSuccess: &handler.UserIdentitiesResponse{
+				UserId: userID,
+				Identities: []handler.UserIdentity{
+					{Provider: "email", ExternalId: "alice@example.com"},
+					{Provider: "github", ExternalId: "alice"},
+				},
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name: "validation failed",
+			body: map[string]interface{}{
+				"user_id":  userID,
+				"provider": "github",
+			},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrInvalidIdentity",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrInvalidIdentity)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "provider must be one of github, gitlab, email",
+		},
+		{
+			name: "usecase returns ErrUserNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUserNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "user not found",
+		},
+		{
+			name: "usecase returns ErrIdentityAlreadyLinked",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrIdentityAlreadyLinked)
+			},
+			wantCode:  http.StatusConflict,
+			wantError: "identity is already linked to another user",
+		},
+		{
+			name: "usecase returns ErrSaveIdentities",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrSaveIdentities)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while saving user identity in db",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			default:
+				bodyBytes, _ = json.Marshal(v)
+			}
+
+			req := httptest.NewRequest("POST", "/users/addIdentity", bytes.NewReader(bodyBytes))
+			w := httptest.NewRecorder()
+
+			h.AddUserIdentity(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+
+			if tt.wantSuccess != nil {
+				var got handler.UserIdentitiesResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got)
+				return
+			}
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError)
+			}
+		})
+	}
+}
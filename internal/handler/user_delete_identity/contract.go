@@ -0,0 +1,12 @@
+package user_delete_identity
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/user_delete_identity"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=user_delete_identity usecase
+type usecase interface {
+	Run(ctx context.Context, req user_delete_identity.In) (*user_delete_identity.Out, error)
+}
@@ -0,0 +1,111 @@
+package user_delete_identity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/user_delete_identity"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type deleteUserIdentityHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *deleteUserIdentityHandler {
+	return &deleteUserIdentityHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Unlink external identity from user
+// @Description Unlink a GitHub/GitLab login or an email from the user and return the remaining identities.
+// @ID DeleteUserIdentity
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostUsersDeleteIdentityJSONRequestBody true "Identity data"
+// @Success 200 {object} handler2.UserIdentitiesResponse "Remaining identities of the user"
+// @Failure 400 {object} handler2.ErrorResponse "Unknown provider or empty external_id"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "Identity is not linked to the user"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /users/deleteIdentity [post]
+func (h *deleteUserIdentityHandler) DeleteUserIdentity(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostUsersDeleteIdentityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	ctx = logging.WithLogUserId(ctx, request.UserId)
+
+	result, err := h.usecase.Run(ctx, user_delete_identity.In{
+		UserID:     request.UserId,
+		Provider:   request.Provider,
+		ExternalID: request.ExternalId,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.UserIdentitiesResponse{
+		UserId: result.UserID,
+		Identities: func() []handler2.UserIdentity {
+			identities := make([]handler2.UserIdentity, 0, len(result.Identities))
+			for _, identity := range result.Identities {
+				identities = append(identities, handler2.UserIdentity{
+					Provider:   identity.Provider,
+					ExternalId: identity.ExternalID,
+				})
+			}
+			return identities
+		}(),
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *deleteUserIdentityHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrInvalidIdentity):
+		errorMsg = "provider must be one of github, gitlab, email and external_id must not be empty"
+		statusCode = http.StatusBadRequest
+		errorResponseErrorCode = handler2.BADREQUEST
+	case errors.Is(err, usecase2.ErrIdentityNotFound):
+		errorMsg = "identity is not linked to the user"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrDeleteIdentity):
+		errorMsg = "error occurred while deleting user identity from db"
+	case errors.Is(err, usecase2.ErrGetIdentities):
+		errorMsg = "error occurred while getting user identities"
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,163 @@
+package user_delete_identity_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerDelete "pr-reviewers-service/internal/handler/user_delete_identity"
+	mockDelete "pr-reviewers-service/internal/handler/user_delete_identity/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecase "pr-reviewers-service/internal/usecase/user_delete_identity"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteUserIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockDelete.NewMockusecase(ctrl)
+	h := handlerDelete.New(mockUC, validate)
+
+	userID := uuid.New()
+	reqBody := handler.PostUsersDeleteIdentityJSONRequestBody{
+		UserId:     userID,
+		Provider:   "github",
+		ExternalId: "alice",
+	}
+	ucIn := usecase.In{
+		UserID:     userID,
+		Provider:   "github",
+		ExternalID: "alice",
+	}
+
+	ucOut := usecase.Out{
+		UserID:     userID,
+		Identities: []usecase2.Identity{{Provider: "email", ExternalID: "alice@example.com"}},
+	}
+
+	tests := []struct {
+		name        string
+		body        interface{}
+		mock        func()
+		wantCode    int
+		wantError   string
+		wantSuccess *handler.UserIdentitiesResponse
+	}{
+		{
+			name: "success",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantSuccess: &handler.UserIdentitiesResponse{
+				UserId:     userID,
+				Identities: []handler.UserIdentity{{Provider: "email", ExternalId: "alice@example.com"}},
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name: "validation failed",
+			body: map[string]interface{}{
+				"provider":    "github",
+				"external_id": "alice",
+			},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrInvalidIdentity",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrInvalidIdentity)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "provider must be one of github, gitlab, email",
+		},
+		{
+			name: "usecase returns ErrIdentityNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrIdentityNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "identity is not linked to the user",
+		},
+		{
+			name: "usecase returns ErrDeleteIdentity",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrDeleteIdentity)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while deleting user identity from db",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			default:
+				bodyBytes, _ = json.Marshal(v)
+			}
+
+			req := httptest.NewRequest("POST", "/users/deleteIdentity", bytes.NewReader(bodyBytes))
+			w := httptest.NewRecorder()
+
+			h.DeleteUserIdentity(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+
+			if tt.wantSuccess != nil {
+				var got handler.UserIdentitiesResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, *tt.wantSuccess, got)
+				return
+			}
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError)
+			}
+		})
+	}
+}
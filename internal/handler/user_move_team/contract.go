@@ -0,0 +1,12 @@
+package user_move_team
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/user_move_team"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=user_move_team usecase
+type usecase interface {
+	Run(ctx context.Context, req user_move_team.In) (*user_move_team.Out, error)
+}
@@ -0,0 +1,153 @@
+package user_move_team
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/user_move_team"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type moveUserTeamHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *moveUserTeamHandler {
+	return &moveUserTeamHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Move user to another team
+// @Description Change the primary team of a user. Optionally hand the user's open reviews on old team PRs over
+// @Description to other old team members and re-select reviewers of the user's open PRs from the new team.
+// @ID MoveUserTeam
+// @Tags Users
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostUsersMoveTeamJSONRequestBody true "Team move data"
+// @Success 200 {object} handler2.MoveUserTeamResponse "User successfully moved"
+// @Success 304 "User already belongs to the team"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "User or team not found"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /users/moveTeam [post]
+func (h *moveUserTeamHandler) MoveUserTeam(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostUsersMoveTeamJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	ctx = logging.WithLogUserId(ctx, request.UserId)
+
+	result, err := h.usecase.Run(ctx, user_move_team.In{
+		UserID:            request.UserId,
+		TeamName:          request.TeamName,
+		ReassignReviews:   request.ReassignReviews != nil && *request.ReassignReviews,
+		ReselectReviewers: request.ReselectReviewers != nil && *request.ReselectReviewers,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.MoveUserTeamResponse{
+		UserId:      result.UserID,
+		Username:    result.Username,
+		OldTeamName: result.OldTeamName,
+		NewTeamName: result.NewTeamName,
+		ReassignedReviews: func() []handler2.MoveTeamReassignedReview {
+			reviews := make([]handler2.MoveTeamReassignedReview, 0, len(result.ReassignedReviews))
+			for _, review := range result.ReassignedReviews {
+				reviews = append(reviews, handler2.MoveTeamReassignedReview{
+					PullRequestId:   review.PullRequestID,
+					PullRequestName: review.PullRequestName,
+					AuthorId:        review.AuthorID,
+					NewReviewerId:   review.NewReviewerID,
+					Outcome:         review.Outcome,
+				})
+			}
+			return reviews
+		}(),
+		ReselectedPullRequests: func() []handler2.MoveTeamReselectedPullRequest {
+			prs := make([]handler2.MoveTeamReselectedPullRequest, 0, len(result.ReselectedPullRequests))
+			for _, pr := range result.ReselectedPullRequests {
+				prs = append(prs, handler2.MoveTeamReselectedPullRequest{
+					PullRequestId:    pr.PullRequestID,
+					PullRequestName:  pr.PullRequestName,
+					RemovedReviewers: pr.RemovedReviewers,
+					AddedReviewers:   pr.AddedReviewers,
+					Understaffed:     pr.Understaffed,
+				})
+			}
+			return prs
+		}(),
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *moveUserTeamHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetUser):
+		errorMsg = "error occurred while getting user"
+	case errors.Is(err, usecase2.ErrGetUsers):
+		errorMsg = "error occurred while getting users"
+	case errors.Is(err, usecase2.ErrGetTeam):
+		errorMsg = "error occurred while getting team"
+	case errors.Is(err, usecase2.ErrUpdateUser):
+		errorMsg = "error occurred while updating user in db"
+	case errors.Is(err, usecase2.ErrSaveTeamMemberships):
+		errorMsg = "error occurred while saving team memberships in db"
+	case errors.Is(err, usecase2.ErrGetPRReviewers):
+		errorMsg = "error occurred while getting pr reviewers"
+	case errors.Is(err, usecase2.ErrGetPullRequest):
+		errorMsg = "error occurred while getting pull request"
+	case errors.Is(err, usecase2.ErrGetPRStatus):
+		errorMsg = "error occurred while getting pr status"
+	case errors.Is(err, usecase2.ErrRemoveReviewer):
+		errorMsg = "error occurred while removing reviewer"
+	case errors.Is(err, usecase2.ErrAssignReviewer):
+		errorMsg = "error occurred while assigning reviewer"
+	case errors.Is(err, usecase2.ErrUserNotFound):
+		errorMsg = "user not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrTeamNotFound):
+		errorMsg = "team not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrUserDontNeedChange):
+		errorMsg = "user already belongs to this team"
+		statusCode = http.StatusNotModified
+		errorResponseErrorCode = handler2.NOTASSIGNED
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,273 @@
+package user_move_team_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerMoveTeam "pr-reviewers-service/internal/handler/user_move_team"
+	mockMoveTeam "pr-reviewers-service/internal/handler/user_move_team/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseMoveTeam "pr-reviewers-service/internal/usecase/user_move_team"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveUserTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockMoveTeam.NewMockusecase(ctrl)
+	h := handlerMoveTeam.New(mockUC, validate)
+
+	userID := uuid.New()
+	authorID := uuid.New()
+	newReviewerID := uuid.New()
+	removedReviewerID := uuid.New()
+	addedReviewerID := uuid.New()
+	pr1 := uuid.New()
+	pr2 := uuid.New()
+	pr3 := uuid.New()
+	reassign := true
+	reselect := true
+
+	reqBody := handler2.PostUsersMoveTeamJSONRequestBody{
+		UserId:            userID,
+		TeamName:          "frontend",
+		ReassignReviews:   &reassign,
+		ReselectReviewers: &reselect,
+	}
+	ucIn := usecaseMoveTeam.In{
+		UserID:            userID,
+		TeamName:          "frontend",
+		ReassignReviews:   true,
+		ReselectReviewers: true,
+	}
+
+	ucOut := usecaseMoveTeam.Out{
+		UserID:      userID,
+		Username:    "Alice",
+		OldTeamName: "backend",
+		NewTeamName: "frontend",
+		ReassignedReviews: []usecaseMoveTeam.ReassignedReview{
+			{PullRequestID: pr1, PullRequestName: "PR1", AuthorID: authorID, NewReviewerID: &newReviewerID, Outcome: usecaseMoveTeam.OutcomeReassigned},
+			{PullRequestID: pr2, PullRequestName: "PR2", AuthorID: authorID, Outcome: usecaseMoveTeam.OutcomeUnassigned},
+		},
+		ReselectedPullRequests: []usecaseMoveTeam.ReselectedPullRequest{
+			{
+				PullRequestID:    pr3,
+				PullRequestName:  "PR3",
+				RemovedReviewers: []uuid.UUID{removedReviewerID},
+				AddedReviewers:   []uuid.UUID{addedReviewerID},
+				Understaffed:     true,
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&ucOut, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.MoveUserTeamResponse{
+				UserId:      userID,
+				Username:    "Alice",
+				OldTeamName: "backend",
+				NewTeamName: "frontend",
+				ReassignedReviews: []handler2.MoveTeamReassignedReview{
+					{PullRequestId: pr1, PullRequestName: "PR1", AuthorId: authorID, NewReviewerId: &newReviewerID, Outcome: usecaseMoveTeam.OutcomeReassigned},
+					{PullRequestId: pr2, PullRequestName: "PR2", AuthorId: authorID, Outcome: usecaseMoveTeam.OutcomeUnassigned},
+				},
+				ReselectedPullRequests: []handler2.MoveTeamReselectedPullRequest{
+					{
+						PullRequestId:    pr3,
+						PullRequestName:  "PR3",
+						RemovedReviewers: []uuid.UUID{removedReviewerID},
+						AddedReviewers:   []uuid.UUID{addedReviewerID},
+						Understaffed:     true,
+					},
+				},
+			},
+		},
+		{
+			name: "success without reconciliation flags",
+			body: handler2.PostUsersMoveTeamJSONRequestBody{
+				UserId:   userID,
+				TeamName: "frontend",
+			},
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), usecaseMoveTeam.In{
+					UserID:   userID,
+					TeamName: "frontend",
+				}).Return(&usecaseMoveTeam.Out{
+					UserID:                 userID,
+					Username:               "Alice",
+					OldTeamName:            "backend",
+					NewTeamName:            "frontend",
+					ReassignedReviews:      []usecaseMoveTeam.ReassignedReview{},
+					ReselectedPullRequests: []usecaseMoveTeam.ReselectedPullRequest{},
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.MoveUserTeamResponse{
+				UserId:                 userID,
+				Username:               "Alice",
+				OldTeamName:            "backend",
+				NewTeamName:            "frontend",
+				ReassignedReviews:      []handler2.MoveTeamReassignedReview{},
+				ReselectedPullRequests: []handler2.MoveTeamReselectedPullRequest{},
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "empty body",
+			body:      nil,
+			mock:      func() {},
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name: "validation failed - missing team name",
+			body: struct {
+				UserId uuid.UUID `json:"user_id"`
+			}{
+				UserId: userID,
+			},
+			mock:      func() {},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrUserDontNeedChange",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUserDontNeedChange)
+			},
+			wantCode:  http.StatusNotModified,
+			wantError: "user already belongs to this team",
+		},
+		{
+			name: "usecase returns ErrUserNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUserNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "user not found",
+		},
+		{
+			name: "usecase returns ErrTeamNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrTeamNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "team not found",
+		},
+		{
+			name: "usecase returns ErrUpdateUser",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUpdateUser)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while updating user in db",
+		},
+		{
+			name: "usecase returns ErrSaveTeamMemberships",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrSaveTeamMemberships)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while saving team memberships in db",
+		},
+		{
+			name: "usecase returns ErrAssignReviewer",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrAssignReviewer)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while assigning reviewer",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			case nil:
+				bodyBytes = nil
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/users/moveTeam", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.MoveUserTeam(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.MoveUserTeamResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
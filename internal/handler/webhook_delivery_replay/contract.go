@@ -0,0 +1,12 @@
+package webhook_delivery_replay
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/webhook_delivery_replay"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=webhook_delivery_replay usecase
+type usecase interface {
+	Run(ctx context.Context, req webhook_delivery_replay.In) (*webhook_delivery_replay.Out, error)
+}
@@ -0,0 +1,109 @@
+package webhook_delivery_replay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/webhook_delivery_replay"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type replayWebhookDeliveryHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *replayWebhookDeliveryHandler {
+	return &replayWebhookDeliveryHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Replay failed delivery
+// @Description Put a FAILED webhook delivery back into the queue with a reset attempts counter
+// @ID ReplayWebhookDelivery
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostWebhooksReplayDeliveryJSONRequestBody true "Delivery to replay"
+// @Success 200 {object} handler2.ReplayWebhookDeliveryResponse "Delivery queued"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "Delivery not found"
+// @Failure 409 {object} handler2.ErrorResponse "Delivery is not failed"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /webhooks/replayDelivery [post]
+func (h *replayWebhookDeliveryHandler) ReplayWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostWebhooksReplayDeliveryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	result, err := h.usecase.Run(ctx, webhook_delivery_replay.In{
+		DeliveryID: request.DeliveryId,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.ReplayWebhookDeliveryResponse{
+		Delivery: handler2.WebhookDelivery{
+			DeliveryId:     result.Delivery.ID,
+			SubscriptionId: result.Delivery.SubscriptionID,
+			EventId:        result.Delivery.EventID,
+			Event:          result.Delivery.Event,
+			Status:         handler2.WebhookDeliveryStatus(result.Delivery.Status),
+			Attempts:       result.Delivery.Attempts,
+			NextAttemptAt:  result.Delivery.NextAttemptAt,
+			LastError:      result.Delivery.LastError,
+			ResponseCode:   result.Delivery.ResponseCode,
+			CreatedAt:      result.Delivery.CreatedAt,
+			UpdatedAt:      result.Delivery.UpdatedAt,
+		},
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *replayWebhookDeliveryHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrGetWebhookDeliveries):
+		errorMsg = "error occurred while getting webhook delivery"
+	case errors.Is(err, usecase2.ErrUpdateWebhookDelivery):
+		errorMsg = "error occurred while updating webhook delivery"
+	case errors.Is(err, usecase2.ErrWebhookDeliveryNotFound):
+		errorMsg = "webhook delivery not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	case errors.Is(err, usecase2.ErrWebhookDeliveryNotFailed):
+		errorMsg = "only failed webhook deliveries can be replayed"
+		statusCode = http.StatusConflict
+		errorResponseErrorCode = handler2.DELIVERYNOTFAILED
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
@@ -0,0 +1,171 @@
+package webhook_delivery_replay_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerWebhook "pr-reviewers-service/internal/handler/webhook_delivery_replay"
+	mockWebhook "pr-reviewers-service/internal/handler/webhook_delivery_replay/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseWebhook "pr-reviewers-service/internal/usecase/webhook_delivery_replay"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayWebhookDelivery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockWebhook.NewMockusecase(ctrl)
+	h := handlerWebhook.New(mockUC, validate)
+
+	deliveryID := uuid.New()
+	subscriptionID := uuid.New()
+	eventID := uuid.New()
+	now := time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+
+	reqBody := handler2.PostWebhooksReplayDeliveryJSONRequestBody{DeliveryId: deliveryID}
+	ucIn := usecaseWebhook.In{DeliveryID: deliveryID}
+
+	tests := []struct {
+		name      string
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&usecaseWebhook.Out{
+					Delivery: usecase2.WebhookDelivery{
+						ID:             deliveryID,
+						SubscriptionID: subscriptionID,
+						EventID:        eventID,
+						Event:          usecase2.EventPullRequestMerged,
+						Status:         usecase2.WebhookDeliveryPendingStatus,
+						NextAttemptAt:  now,
+						CreatedAt:      now,
+						UpdatedAt:      now,
+					},
+				}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.ReplayWebhookDeliveryResponse{
+				Delivery: handler2.WebhookDelivery{
+					DeliveryId:     deliveryID,
+					SubscriptionId: subscriptionID,
+					EventId:        eventID,
+					Event:          usecase2.EventPullRequestMerged,
+					Status:         handler2.WebhookDeliveryStatusPENDING,
+					NextAttemptAt:  now,
+					CreatedAt:      now,
+					UpdatedAt:      now,
+				},
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "validation failed - missing delivery id",
+			body:      map[string]string{},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrWebhookDeliveryNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrWebhookDeliveryNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "webhook delivery not found",
+		},
+		{
+			name: "usecase returns ErrWebhookDeliveryNotFailed",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrWebhookDeliveryNotFailed)
+			},
+			wantCode:  http.StatusConflict,
+			wantError: "only failed webhook deliveries can be replayed",
+		},
+		{
+			name: "usecase returns ErrUpdateWebhookDelivery",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUpdateWebhookDelivery)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while updating webhook delivery",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/webhooks/replayDelivery", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.ReplayWebhookDelivery(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.ReplayWebhookDeliveryResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
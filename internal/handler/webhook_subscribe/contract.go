@@ -0,0 +1,12 @@
+package webhook_subscribe
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/webhook_subscribe"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=webhook_subscribe usecase
+type usecase interface {
+	Run(ctx context.Context, req webhook_subscribe.In) (*webhook_subscribe.Out, error)
+}
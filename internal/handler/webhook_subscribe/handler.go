@@ -0,0 +1,103 @@
+package webhook_subscribe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/webhook_subscribe"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type subscribeWebhookHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *subscribeWebhookHandler {
+	return &subscribeWebhookHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Subscribe to events
+// @Description Register an HTTP endpoint for reviewer assignment and merge events. Events are POSTed as JSON
+// @Description signed with HMAC-SHA256 of the subscription secret and retried with exponential backoff.
+// @ID SubscribeWebhook
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostWebhooksSubscribeJSONRequestBody true "Subscription data"
+// @Success 201 {object} handler2.SubscribeWebhookResponse "Subscription created"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data or unknown event"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /webhooks/subscribe [post]
+func (h *subscribeWebhookHandler) SubscribeWebhook(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostWebhooksSubscribeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	events := make([]string, 0, len(request.Events))
+	for _, event := range request.Events {
+		events = append(events, string(event))
+	}
+
+	result, err := h.usecase.Run(ctx, webhook_subscribe.In{
+		URL:    request.Url,
+		Secret: request.Secret,
+		Events: events,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.SubscribeWebhookResponse{
+		Subscription: handler2.WebhookSubscription{
+			SubscriptionId: result.Subscription.ID,
+			Url:            result.Subscription.URL,
+			Events:         result.Subscription.Events,
+			CreatedAt:      result.Subscription.CreatedAt,
+		},
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *subscribeWebhookHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrSaveWebhookSubscription):
+		errorMsg = "error occurred while saving webhook subscription"
+	case errors.Is(err, usecase2.ErrUnknownWebhookEvent):
+		errorMsg = "unknown webhook event"
+		statusCode = http.StatusBadRequest
+		errorResponseErrorCode = handler2.BADREQUEST
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
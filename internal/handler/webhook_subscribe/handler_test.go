@@ -0,0 +1,185 @@
+package webhook_subscribe_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerWebhook "pr-reviewers-service/internal/handler/webhook_subscribe"
+	mockWebhook "pr-reviewers-service/internal/handler/webhook_subscribe/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseWebhook "pr-reviewers-service/internal/usecase/webhook_subscribe"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockWebhook.NewMockusecase(ctrl)
+	h := handlerWebhook.New(mockUC, validate)
+
+	subscriptionID := uuid.New()
+	createdAt := time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+
+	reqBody := handler2.PostWebhooksSubscribeJSONRequestBody{
+		Url:    "https://hooks.example.com/reviewers",
+		Secret: "0123456789abcdef",
+		Events: []handler2.SubscribeWebhookRequestEvents{"reviewer.assigned", "pull_request.merged"},
+	}
+	ucIn := usecaseWebhook.In{
+		URL:    "https://hooks.example.com/reviewers",
+		Secret: "0123456789abcdef",
+		Events: []string{usecase2.EventReviewerAssigned, usecase2.EventPullRequestMerged},
+	}
+
+	tests := []struct {
+		name      string
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&usecaseWebhook.Out{
+					Subscription: usecase2.WebhookSubscription{
+						ID:        subscriptionID,
+						URL:       "https://hooks.example.com/reviewers",
+						Events:    ucIn.Events,
+						CreatedAt: createdAt,
+					},
+				}, nil)
+			},
+			wantCode: http.StatusCreated,
+			wantBody: handler2.SubscribeWebhookResponse{
+				Subscription: handler2.WebhookSubscription{
+					SubscriptionId: subscriptionID,
+					Url:            "https://hooks.example.com/reviewers",
+					Events:         ucIn.Events,
+					CreatedAt:      createdAt,
+				},
+			},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name: "validation failed - invalid url",
+			body: handler2.PostWebhooksSubscribeJSONRequestBody{
+				Url:    "not a url",
+				Secret: reqBody.Secret,
+				Events: reqBody.Events,
+			},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "validation failed - short secret",
+			body: handler2.PostWebhooksSubscribeJSONRequestBody{
+				Url:    reqBody.Url,
+				Secret: "secret",
+				Events: reqBody.Events,
+			},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "validation failed - no events",
+			body: handler2.PostWebhooksSubscribeJSONRequestBody{
+				Url:    reqBody.Url,
+				Secret: reqBody.Secret,
+				Events: []handler2.SubscribeWebhookRequestEvents{},
+			},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrUnknownWebhookEvent",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrUnknownWebhookEvent)
+			},
+			wantCode:  http.StatusBadRequest,
+			wantError: "unknown webhook event",
+		},
+		{
+			name: "usecase returns ErrSaveWebhookSubscription",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrSaveWebhookSubscription)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while saving webhook subscription",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/webhooks/subscribe", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.SubscribeWebhook(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.SubscribeWebhookResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+				assert.NotContains(t, w.Body.String(), reqBody.Secret)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package webhook_unsubscribe
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/webhook_unsubscribe"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=webhook_unsubscribe usecase
+type usecase interface {
+	Run(ctx context.Context, req webhook_unsubscribe.In) (*webhook_unsubscribe.Out, error)
+}
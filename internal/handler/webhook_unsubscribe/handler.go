@@ -0,0 +1,90 @@
+package webhook_unsubscribe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	"pr-reviewers-service/internal/handler"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/webhook_unsubscribe"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type unsubscribeWebhookHandler struct {
+	usecase   usecase
+	validator *validator.Validate
+}
+
+func New(usecase usecase, validator *validator.Validate) *unsubscribeWebhookHandler {
+	return &unsubscribeWebhookHandler{
+		usecase:   usecase,
+		validator: validator,
+	}
+}
+
+// @Summary Unsubscribe from events
+// @Description Delete webhook subscription together with its delivery log
+// @ID UnsubscribeWebhook
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param input body handler2.PostWebhooksUnsubscribeJSONRequestBody true "Subscription to delete"
+// @Success 200 {object} handler2.UnsubscribeWebhookResponse "Subscription deleted"
+// @Failure 400 {object} handler2.ErrorResponse "Invalid request data"
+// @Failure 422 {object} handler2.ErrorResponse "Validation failed"
+// @Failure 404 {object} handler2.ErrorResponse "Subscription not found"
+// @Failure 500 {object} handler2.ErrorResponse "Internal server error"
+// @Router /webhooks/unsubscribe [post]
+func (h *unsubscribeWebhookHandler) UnsubscribeWebhook(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	ctx := r.Context()
+
+	var request handler2.PostWebhooksUnsubscribeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusBadRequest, handler2.BADREQUEST, "failed to decode request", err)
+		return
+	}
+
+	if err := h.validator.Struct(request); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusUnprocessableEntity, handler2.BADREQUEST, "validation failed", err)
+		return
+	}
+
+	result, err := h.usecase.Run(ctx, webhook_unsubscribe.In{
+		SubscriptionID: request.SubscriptionId,
+	})
+	if err != nil {
+		h.handleUseCaseError(w, ctx, err)
+		return
+	}
+
+	out := handler2.UnsubscribeWebhookResponse{
+		SubscriptionId: result.SubscriptionID,
+	}
+
+	if err = json.NewEncoder(w).Encode(out); err != nil {
+		handler.RespondWithError(w, ctx, http.StatusInternalServerError, handler2.UNKNOWN, "failed to encode response", err)
+		return
+	}
+}
+
+func (h *unsubscribeWebhookHandler) handleUseCaseError(w http.ResponseWriter, ctx context.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	errorResponseErrorCode := handler2.UNKNOWN
+	errorMsg := "internal server error"
+
+	switch {
+	case errors.Is(err, usecase2.ErrDeleteWebhookSubscription):
+		errorMsg = "error occurred while deleting webhook subscription"
+	case errors.Is(err, usecase2.ErrWebhookSubscriptionNotFound):
+		errorMsg = "webhook subscription not found"
+		statusCode = http.StatusNotFound
+		errorResponseErrorCode = handler2.NOTFOUND
+	}
+
+	handler.RespondWithError(w, ctx, statusCode, errorResponseErrorCode, errorMsg, err)
+}
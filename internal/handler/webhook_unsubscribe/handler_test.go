@@ -0,0 +1,135 @@
+package webhook_unsubscribe_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	handler2 "pr-reviewers-service/internal/generated/api/v1/handler"
+	handlerWebhook "pr-reviewers-service/internal/handler/webhook_unsubscribe"
+	mockWebhook "pr-reviewers-service/internal/handler/webhook_unsubscribe/mocks"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	usecaseWebhook "pr-reviewers-service/internal/usecase/webhook_unsubscribe"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnsubscribeWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	validate := validator.New()
+	mockUC := mockWebhook.NewMockusecase(ctrl)
+	h := handlerWebhook.New(mockUC, validate)
+
+	subscriptionID := uuid.New()
+	reqBody := handler2.PostWebhooksUnsubscribeJSONRequestBody{SubscriptionId: subscriptionID}
+	ucIn := usecaseWebhook.In{SubscriptionID: subscriptionID}
+
+	tests := []struct {
+		name      string
+		body      interface{}
+		mock      func()
+		wantCode  int
+		wantError string
+		wantBody  interface{}
+	}{
+		{
+			name: "success",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(&usecaseWebhook.Out{SubscriptionID: subscriptionID}, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: handler2.UnsubscribeWebhookResponse{SubscriptionId: subscriptionID},
+		},
+		{
+			name:      "invalid JSON",
+			body:      "invalid-json",
+			wantCode:  http.StatusBadRequest,
+			wantError: "failed to decode request",
+		},
+		{
+			name:      "validation failed - missing subscription id",
+			body:      map[string]string{},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantError: "validation failed",
+		},
+		{
+			name: "usecase returns ErrWebhookSubscriptionNotFound",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrWebhookSubscriptionNotFound)
+			},
+			wantCode:  http.StatusNotFound,
+			wantError: "webhook subscription not found",
+		},
+		{
+			name: "usecase returns ErrDeleteWebhookSubscription",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, usecase2.ErrDeleteWebhookSubscription)
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "error occurred while deleting webhook subscription",
+		},
+		{
+			name: "usecase returns unknown error",
+			body: reqBody,
+			mock: func() {
+				mockUC.EXPECT().Run(gomock.Any(), ucIn).Return(nil, errors.New("unknown error"))
+			},
+			wantCode:  http.StatusInternalServerError,
+			wantError: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.mock != nil {
+				tt.mock()
+			}
+
+			var bodyBytes []byte
+			switch v := tt.body.(type) {
+			case string:
+				bodyBytes = []byte(v)
+			default:
+				var err error
+				bodyBytes, err = json.Marshal(v)
+				require.NoError(t, err)
+			}
+
+			req := httptest.NewRequest("POST", "/webhooks/unsubscribe", bytes.NewReader(bodyBytes))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			h.UnsubscribeWebhook(w, req)
+
+			assert.Equal(t, tt.wantCode, w.Code, "Status code mismatch for test: %s", tt.name)
+
+			if tt.wantError != "" {
+				var errResp struct {
+					Error struct {
+						Message string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&errResp))
+				assert.Contains(t, errResp.Error.Message, tt.wantError, "Error message mismatch for test: %s", tt.name)
+			}
+
+			if tt.wantBody != nil {
+				var resp handler2.UnsubscribeWebhookResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, tt.wantBody, resp, "Response body mismatch for test: %s", tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package chat_sender
+
+// Message is the body of a Slack or Mattermost incoming webhook, both accept the same "text" field.
+type Message struct {
+	Text string `json:"text"`
+}
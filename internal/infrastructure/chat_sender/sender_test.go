@@ -0,0 +1,67 @@
+package chat_sender
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		wantError error
+	}{
+		{
+			name:   "incoming webhook accepts message",
+			status: http.StatusOK,
+		},
+		{
+			name:      "incoming webhook rejects message",
+			status:    http.StatusNotFound,
+			wantError: ErrUnexpectedStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *http.Request
+			var gotBody []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r
+				gotBody, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := New(time.Second).Send(context.Background(), server.URL, Message{Text: "<@U024BE7LH> review \"Add feature\""})
+
+			if tt.wantError != nil {
+				assert.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NotNil(t, got)
+			assert.Equal(t, http.MethodPost, got.Method)
+			assert.Equal(t, "application/json", got.Header.Get("Content-Type"))
+			assert.JSONEq(t, `{"text":"<@U024BE7LH> review \"Add feature\""}`, string(gotBody))
+		})
+	}
+}
+
+func TestSendUnreachableWebhook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	err := New(time.Second).Send(context.Background(), url, Message{Text: "hello"})
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,21 @@
+package email_sender
+
+import "time"
+
+// Config is the SMTP relay emails are sent through. Authentication is used only when Username is set.
+type Config struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	Timeout  time.Duration
+}
+
+// Email is sent as multipart/alternative when HTML is set and as plain text otherwise.
+type Email struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
@@ -0,0 +1,91 @@
+package email_sender
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/email_sender/smtptest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		name             string
+		username         string
+		email            Email
+		rejectRecipients bool
+		wantError        bool
+		wantText         string
+		wantHTML         string
+	}{
+		{
+			name:     "text and html email is delivered as alternatives",
+			email:    Email{To: "alice@example.com", Subject: "Review «Add feature»", Text: "Please review\n", HTML: "<p>Please review</p>"},
+			wantText: "Please review\n",
+			wantHTML: "<p>Please review</p>",
+		},
+		{
+			name:     "text only email is delivered",
+			email:    Email{To: "alice@example.com", Subject: "Review «Add feature»", Text: "Please review\n"},
+			wantText: "Please review\n",
+		},
+		{
+			name:     "email is delivered with authentication",
+			username: "service",
+			email:    Email{To: "alice@example.com", Subject: "Review «Add feature»", Text: "Please review\n"},
+			wantText: "Please review\n",
+		},
+		{
+			name:             "rejected recipient returns error",
+			email:            Email{To: "alice@example.com", Subject: "Review «Add feature»", Text: "Please review\n"},
+			rejectRecipients: true,
+			wantError:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := smtptest.NewServer()
+			defer server.Close()
+			server.RejectRecipients(tt.rejectRecipients)
+
+			sender := New(Config{
+				Host:     server.Host,
+				Port:     server.Port,
+				Username: tt.username,
+				Password: "secret",
+				From:     "Reviewers <reviews@example.com>",
+				Timeout:  time.Second,
+			})
+			err := sender.Send(context.Background(), tt.email)
+
+			if tt.wantError {
+				assert.Error(t, err)
+				assert.Empty(t, server.Messages())
+				return
+			}
+			require.NoError(t, err)
+			messages := server.Messages()
+			require.Len(t, messages, 1)
+			assert.Equal(t, "reviews@example.com", messages[0].From)
+			assert.Equal(t, []string{"alice@example.com"}, messages[0].To)
+			assert.Equal(t, "Review «Add feature»", messages[0].Subject)
+			assert.Equal(t, tt.wantText, messages[0].Text)
+			assert.Equal(t, tt.wantHTML, messages[0].HTML)
+		})
+	}
+}
+
+func TestSendUnreachableServer(t *testing.T) {
+	server := smtptest.NewServer()
+	host, port := server.Host, server.Port
+	server.Close()
+
+	err := New(Config{Host: host, Port: port, From: "reviews@example.com", Timeout: time.Second}).
+		Send(context.Background(), Email{To: "alice@example.com", Subject: "hello", Text: "hello"})
+
+	assert.Error(t, err)
+}
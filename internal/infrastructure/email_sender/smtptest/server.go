@@ -0,0 +1,210 @@
+// Package smtptest provides an in-process SMTP server for tests, in the spirit of net/http/httptest.
+package smtptest
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+)
+
+// Message is an email accepted by the Server. Subject, Text and HTML are decoded from Raw,
+// they are left empty when Raw is not a well formed message.
+type Message struct {
+	From    string
+	To      []string
+	Raw     string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Server accepts any sender and recipient and keeps the received messages in memory.
+// AUTH PLAIN succeeds for any credentials.
+type Server struct {
+	Host string
+	Port int
+
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu               sync.Mutex
+	messages         []Message
+	rejectRecipients bool
+}
+
+// NewServer starts a server on a random local port, it panics if the port cannot be opened.
+func NewServer() *Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic("smtptest: failed to listen: " + err.Error())
+	}
+	addr := listener.Addr().(*net.TCPAddr)
+	s := &Server{Host: addr.IP.String(), Port: addr.Port, listener: listener}
+
+	s.wg.Add(1)
+	go s.serve()
+	return s
+}
+
+// Messages returns the messages received so far.
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// RejectRecipients makes the server answer every RCPT command with a permanent failure.
+func (s *Server) RejectRecipients(reject bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejectRecipients = reject
+}
+
+// Close stops accepting connections and waits for the open sessions to end.
+func (s *Server) Close() {
+	_ = s.listener.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(textproto.NewConn(conn))
+		}()
+	}
+}
+
+func (s *Server) handle(conn *textproto.Conn) {
+	var from string
+	var to []string
+	reply := func(code int, text string) bool {
+		return conn.PrintfLine("%d %s", code, text) == nil
+	}
+
+	if !reply(220, "smtptest ESMTP") {
+		return
+	}
+	for {
+		line, err := conn.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			if conn.PrintfLine("250-smtptest") != nil || conn.PrintfLine("250 AUTH PLAIN") != nil {
+				return
+			}
+		case "HELO":
+			reply(250, "smtptest")
+		case "AUTH":
+			reply(235, "authentication succeeded")
+		case "MAIL":
+			from = addressOf(arg)
+			to = nil
+			reply(250, "ok")
+		case "RCPT":
+			s.mu.Lock()
+			reject := s.rejectRecipients
+			s.mu.Unlock()
+			if reject {
+				reply(550, "mailbox unavailable")
+				continue
+			}
+			to = append(to, addressOf(arg))
+			reply(250, "ok")
+		case "DATA":
+			if !reply(354, "end data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			raw, err := io.ReadAll(conn.DotReader())
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.messages = append(s.messages, parse(from, to, string(raw)))
+			s.mu.Unlock()
+			reply(250, "ok: queued")
+		case "RSET":
+			from, to = "", nil
+			reply(250, "ok")
+		case "NOOP":
+			reply(250, "ok")
+		case "QUIT":
+			reply(221, "bye")
+			return
+		default:
+			reply(502, "command not implemented")
+		}
+	}
+}
+
+func addressOf(arg string) string {
+	_, address, _ := strings.Cut(arg, ":")
+	return strings.Trim(strings.TrimSpace(address), "<>")
+}
+
+func parse(from string, to []string, raw string) Message {
+	message := Message{From: from, To: to, Raw: raw}
+
+	parsed, err := mail.ReadMessage(strings.NewReader(raw))
+	if err != nil {
+		return message
+	}
+	decoder := mime.WordDecoder{}
+	if subject, err := decoder.DecodeHeader(parsed.Header.Get("Subject")); err == nil {
+		message.Subject = subject
+	}
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	if err != nil {
+		return message
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		message.Text = readBody(parsed.Body, parsed.Header.Get("Content-Transfer-Encoding"))
+		return message
+	}
+
+	reader := multipart.NewReader(parsed.Body, params["boundary"])
+	for {
+		// NextPart decodes quoted-printable parts transparently.
+		part, err := reader.NextPart()
+		if err != nil {
+			return message
+		}
+		body, _ := io.ReadAll(part)
+		switch contentType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type")); contentType {
+		case "text/plain":
+			message.Text = normalize(string(body))
+		case "text/html":
+			message.HTML = normalize(string(body))
+		}
+	}
+}
+
+func readBody(body io.Reader, transferEncoding string) string {
+	if strings.EqualFold(transferEncoding, "quoted-printable") {
+		body = quotedprintable.NewReader(body)
+	}
+	content, _ := io.ReadAll(body)
+	return normalize(string(content))
+}
+
+func normalize(content string) string {
+	return strings.ReplaceAll(content, "\r\n", "\n")
+}
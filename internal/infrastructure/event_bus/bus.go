@@ -0,0 +1,151 @@
+package event_bus
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// Message is one event of a topic. ID is what a reconnecting subscriber passes back to resume after it.
+type Message struct {
+	ID   uuid.UUID
+	Type string
+	Data []byte
+}
+
+// Bus fans messages out to the in-process subscribers of a topic. The last historySize messages of every topic
+// are kept for subscribers resuming after a reconnect. A subscriber whose buffer of bufferSize messages is full is
+// dropped, its channel is closed and it is expected to resubscribe from the last message it has seen.
+type Bus struct {
+	mu          sync.Mutex
+	historySize int
+	bufferSize  int
+	topics      map[uuid.UUID]*topic
+	closed      bool
+}
+
+type topic struct {
+	history     []Message
+	subscribers map[*Subscription]struct{}
+}
+
+func New(historySize, bufferSize int) *Bus {
+	return &Bus{
+		historySize: historySize,
+		bufferSize:  bufferSize,
+		topics:      make(map[uuid.UUID]*topic),
+	}
+}
+
+// Subscription receives the messages of one topic published after it was made.
+type Subscription struct {
+	bus    *Bus
+	topic  uuid.UUID
+	replay []Message
+	events chan Message
+}
+
+// Replay returns the kept messages published after the one the subscriber resumes from.
+func (s *Subscription) Replay() []Message {
+	return s.replay
+}
+
+// Events is closed when the subscriber falls behind, is closed or the bus is closed.
+func (s *Subscription) Events() <-chan Message {
+	return s.events
+}
+
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+
+	s.bus.drop(s)
+}
+
+// Publish keeps the message in the topic history and hands it to the topic subscribers without blocking.
+func (b *Bus) Publish(topicID uuid.UUID, message Message) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	t := b.topic(topicID)
+	t.history = append(t.history, message)
+	if len(t.history) > b.historySize {
+		t.history = t.history[len(t.history)-b.historySize:]
+	}
+	for subscription := range t.subscribers {
+		select {
+		case subscription.events <- message:
+		default:
+			b.drop(subscription)
+		}
+	}
+}
+
+// Subscribe subscribes to the topic. With a zero lastID nothing is replayed. Otherwise the messages kept after
+// lastID are replayed, or every kept message when lastID is no longer kept. The subscription of a closed bus
+// has its channel closed.
+func (b *Bus) Subscribe(topicID uuid.UUID, lastID uuid.UUID) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	subscription := &Subscription{
+		bus:    b,
+		topic:  topicID,
+		events: make(chan Message, b.bufferSize),
+	}
+	if b.closed {
+		close(subscription.events)
+		return subscription
+	}
+
+	t := b.topic(topicID)
+	if lastID != uuid.Nil {
+		from := 0
+		for i, message := range t.history {
+			if message.ID == lastID {
+				from = i + 1
+			}
+		}
+		subscription.replay = append([]Message(nil), t.history[from:]...)
+	}
+	t.subscribers[subscription] = struct{}{}
+	return subscription
+}
+
+// Close closes every subscription, later subscriptions are closed right away.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for _, t := range b.topics {
+		for subscription := range t.subscribers {
+			b.drop(subscription)
+		}
+	}
+}
+
+func (b *Bus) topic(topicID uuid.UUID) *topic {
+	t, exists := b.topics[topicID]
+	if !exists {
+		t = &topic{subscribers: make(map[*Subscription]struct{})}
+		b.topics[topicID] = t
+	}
+	return t
+}
+
+// drop must be called with mu held, a subscription is closed once.
+func (b *Bus) drop(subscription *Subscription) {
+	t, exists := b.topics[subscription.topic]
+	if !exists {
+		return
+	}
+	if _, subscribed := t.subscribers[subscription]; !subscribed {
+		return
+	}
+	delete(t.subscribers, subscription)
+	close(subscription.events)
+}
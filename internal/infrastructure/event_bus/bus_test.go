@@ -0,0 +1,112 @@
+package event_bus
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func message(eventType string) Message {
+	return Message{ID: uuid.New(), Type: eventType, Data: []byte(`{}`)}
+}
+
+func TestBusPublish(t *testing.T) {
+	bus := New(10, 10)
+	alice, bob := uuid.New(), uuid.New()
+
+	aliceSubscription := bus.Subscribe(alice, uuid.Nil)
+	defer aliceSubscription.Close()
+	bobSubscription := bus.Subscribe(bob, uuid.Nil)
+	defer bobSubscription.Close()
+
+	assigned := message("reviewer.assigned")
+	bus.Publish(alice, assigned)
+
+	require.Len(t, aliceSubscription.Events(), 1)
+	assert.Equal(t, assigned, <-aliceSubscription.Events())
+	assert.Empty(t, bobSubscription.Events())
+	assert.Empty(t, aliceSubscription.Replay())
+}
+
+func TestBusSubscribeReplay(t *testing.T) {
+	topic := uuid.New()
+	first, second, third, fourth := message("a"), message("b"), message("c"), message("d")
+
+	tests := []struct {
+		name     string
+		lastID   uuid.UUID
+		expected []Message
+	}{
+		{
+			name:     "new subscriber gets no replay",
+			lastID:   uuid.Nil,
+			expected: nil,
+		},
+		{
+			name:     "resumes after the last seen message",
+			lastID:   second.ID,
+			expected: []Message{third, fourth},
+		},
+		{
+			name:     "up to date subscriber gets nothing",
+			lastID:   fourth.ID,
+			expected: nil,
+		},
+		{
+			name:     "unknown last message replays everything kept",
+			lastID:   first.ID,
+			expected: []Message{second, third, fourth},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := New(3, 10)
+			for _, m := range []Message{first, second, third, fourth} {
+				bus.Publish(topic, m)
+			}
+
+			subscription := bus.Subscribe(topic, tt.lastID)
+			defer subscription.Close()
+
+			assert.Equal(t, tt.expected, subscription.Replay())
+		})
+	}
+}
+
+func TestBusDropsSlowSubscriber(t *testing.T) {
+	bus := New(10, 1)
+	topic := uuid.New()
+	subscription := bus.Subscribe(topic, uuid.Nil)
+
+	first := message("a")
+	bus.Publish(topic, first)
+	bus.Publish(topic, message("b"))
+
+	received, ok := <-subscription.Events()
+	require.True(t, ok)
+	assert.Equal(t, first, received)
+	_, ok = <-subscription.Events()
+	assert.False(t, ok)
+
+	subscription.Close()
+}
+
+func TestBusClose(t *testing.T) {
+	bus := New(10, 10)
+	topic := uuid.New()
+	subscription := bus.Subscribe(topic, uuid.Nil)
+
+	bus.Close()
+	_, ok := <-subscription.Events()
+	assert.False(t, ok)
+	subscription.Close()
+
+	bus.Publish(topic, message("a"))
+	late := bus.Subscribe(topic, uuid.Nil)
+	_, ok = <-late.Events()
+	assert.False(t, ok)
+	late.Close()
+}
@@ -0,0 +1,96 @@
+package pg_notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/event_bus"
+
+	trm "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var ErrNotify = errors.New("failed to notify")
+
+// notification is the payload of one NOTIFY, Postgres limits it to 8000 bytes.
+type notification struct {
+	Topics []uuid.UUID     `json:"topics"`
+	ID     uuid.UUID       `json:"id"`
+	Type   string          `json:"type"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// Notifier hands bus messages to every instance of the service through Postgres NOTIFY. A notification sent in
+// a transaction is delivered when it commits and dropped when it, or the savepoint it was sent in, rolls back.
+type Notifier struct {
+	db      *pgxpool.Pool
+	channel string
+}
+
+func NewNotifier(pool *pgxpool.Pool, channel string) *Notifier {
+	return &Notifier{db: pool, channel: channel}
+}
+
+func (n *Notifier) Notify(ctx context.Context, topics []uuid.UUID, message event_bus.Message) error {
+	payload, err := json.Marshal(notification{Topics: topics, ID: message.ID, Type: message.Type, Data: message.Data})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrNotify, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, n.db)
+	if _, err = q.Exec(ctx, "SELECT pg_notify($1, $2)", n.channel, string(payload)); err != nil {
+		return fmt.Errorf("%w: %v", ErrNotify, err)
+	}
+	return nil
+}
+
+// Listener publishes the notifications of a channel to the in-process bus of this instance.
+type Listener struct {
+	db      *pgxpool.Pool
+	channel string
+	bus     *event_bus.Bus
+}
+
+func NewListener(pool *pgxpool.Pool, channel string, bus *event_bus.Bus) *Listener {
+	return &Listener{db: pool, channel: channel, bus: bus}
+}
+
+// Listen holds a connection of its own until ctx is cancelled or the connection fails, notifications sent while
+// no instance listens are lost.
+func (l *Listener) Listen(ctx context.Context) error {
+	pooled, err := l.db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquire connection: %w", err)
+	}
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return fmt.Errorf("listen %s: %w", l.channel, err)
+	}
+	slog.InfoContext(ctx, "Listening for notifications", "channel", l.channel)
+
+	for {
+		received, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+
+		var n notification
+		if err = json.Unmarshal([]byte(received.Payload), &n); err != nil {
+			slog.WarnContext(ctx, "Notification skipped", "channel", l.channel, "error", err)
+			continue
+		}
+		for _, topic := range n.Topics {
+			l.bus.Publish(topic, event_bus.Message{ID: n.ID, Type: n.Type, Data: n.Data})
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package pg_notify
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/event_bus"
+	suite2 "pr-reviewers-service/test/suite"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/settings"
+	"github.com/google/uuid"
+)
+
+func (s *PgNotifyTest) TestNotifyAfterCommit() {
+	const channel = "review_stream_test"
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bus := event_bus.New(10, 10)
+	defer bus.Close()
+	topic := uuid.New()
+	subscription := bus.Subscribe(topic, uuid.Nil)
+
+	listener := NewListener(suite2.GlobalPool, channel, bus)
+	listening := make(chan error, 1)
+	go func() { listening <- listener.Listen(ctx) }()
+
+	notifier := NewNotifier(suite2.GlobalPool, channel)
+	trManager := manager.Must(trmpgx.NewDefaultFactory(suite2.GlobalPool))
+	nested := settings.Must(settings.WithPropagation(trm.PropagationNested))
+	errRollback := errors.New("rollback")
+
+	rolledBack := event_bus.Message{ID: uuid.New(), Type: "reviewer.assigned", Data: []byte(`{"n":1}`)}
+	savepointRolledBack := event_bus.Message{ID: uuid.New(), Type: "reviewer.assigned", Data: []byte(`{"n":2}`)}
+	committed := event_bus.Message{ID: uuid.New(), Type: "reviewer.assigned", Data: []byte(`{"n":3}`)}
+
+	// LISTEN is issued asynchronously, keep notifying until the listener has subscribed.
+	s.Require().Eventually(func() bool {
+		probe := event_bus.Message{ID: uuid.New(), Type: "probe", Data: []byte(`{}`)}
+		if notifier.Notify(ctx, []uuid.UUID{topic}, probe) != nil {
+			return false
+		}
+		select {
+		case <-subscription.Events():
+			return true
+		case <-time.After(50 * time.Millisecond):
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	err := trManager.Do(ctx, func(ctx context.Context) error {
+		s.Require().NoError(notifier.Notify(ctx, []uuid.UUID{topic}, rolledBack))
+		return errRollback
+	})
+	s.Require().ErrorIs(err, errRollback)
+
+	err = trManager.Do(ctx, func(ctx context.Context) error {
+		err := trManager.DoWithSettings(ctx, nested, func(ctx context.Context) error {
+			s.Require().NoError(notifier.Notify(ctx, []uuid.UUID{topic}, savepointRolledBack))
+			return errRollback
+		})
+		s.Require().ErrorIs(err, errRollback)
+		return notifier.Notify(ctx, []uuid.UUID{topic}, committed)
+	})
+	s.Require().NoError(err)
+
+	select {
+	case message := <-subscription.Events():
+		for message.Type == "probe" {
+			message = <-subscription.Events()
+		}
+		s.Equal(committed.ID, message.ID)
+		s.JSONEq(string(committed.Data), string(message.Data))
+	case <-time.After(5 * time.Second):
+		s.Fail("committed notification not received")
+	}
+
+	cancel()
+	s.NoError(<-listening)
+}
@@ -0,0 +1,32 @@
+package pg_notify
+
+import (
+	"testing"
+
+	suite2 "pr-reviewers-service/test/suite"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	migrationsDir = "../../../migrations/"
+)
+
+type PgNotifyTest struct {
+	suite2.TestSuite
+}
+
+func (s *PgNotifyTest) SetupSuite() {
+	s.InitConfig()
+	suite2.Config.DB.MigrationsDir = migrationsDir
+
+	var err error
+	s.Container, err = s.InitDB()
+	assert.NoError(s.T(), err)
+}
+
+func TestAppTestSuite(t *testing.T) {
+	suite.Run(t, new(PgNotifyTest))
+}
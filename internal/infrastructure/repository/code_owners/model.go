@@ -0,0 +1,24 @@
+package code_owners
+
+import (
+	"time"
+)
+
+type CodeOwnersIn struct {
+	Repository string
+	Content    string
+}
+
+type CodeOwnersOut struct {
+	Repository string
+	Content    string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type codeOwnersDB struct {
+	Repository string    `db:"repository"`
+	Content    string    `db:"content"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
@@ -0,0 +1,106 @@
+package code_owners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	nower2 "pr-reviewers-service/internal/usecase/contract/nower"
+
+	"github.com/Masterminds/squirrel"
+	trm "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	codeOwnersTableName  = "code_owners"
+	repositoryColumnName = "repository"
+	contentColumnName    = "content"
+	createdAtColumnName  = "created_at"
+	updatedAtColumnName  = "updated_at"
+
+	returnAll = "RETURNING *"
+)
+
+type Repository struct {
+	db    *pgxpool.Pool
+	nower nower2.Nower
+}
+
+func NewRepository(pool *pgxpool.Pool, nower nower2.Nower) *Repository {
+	return &Repository{db: pool, nower: nower}
+}
+
+// SaveCodeOwners stores the CODEOWNERS file of the repository, a previously uploaded file is replaced.
+func (r *Repository) SaveCodeOwners(ctx context.Context, codeOwners CodeOwnersIn) (*CodeOwnersOut, error) {
+	now := r.nower.Now()
+	queryBuilder := squirrel.Insert(codeOwnersTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Columns(repositoryColumnName, contentColumnName, createdAtColumnName, updatedAtColumnName).
+		Values(codeOwners.Repository, codeOwners.Content, now, now).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s = EXCLUDED.%s, %s = EXCLUDED.%s %s",
+			repositoryColumnName, contentColumnName, contentColumnName,
+			updatedAtColumnName, updatedAtColumnName, returnAll))
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[codeOwnersDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository SaveCodeOwners success")
+	out := CodeOwnersOut(result)
+	return &out, nil
+}
+
+func (r *Repository) GetCodeOwnersByRepository(ctx context.Context, repositoryName string) (*CodeOwnersOut, error) {
+	selectBuilder := squirrel.
+		Select(repositoryColumnName, contentColumnName, createdAtColumnName, updatedAtColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(codeOwnersTableName).
+		Where(squirrel.Eq{repositoryColumnName: repositoryName})
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[codeOwnersDB])
+	if err != nil {
+		slog.DebugContext(ctx, err.Error())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %v", repository.ErrCodeOwnersNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository GetCodeOwnersByRepository success")
+	out := CodeOwnersOut(result)
+	return &out, nil
+}
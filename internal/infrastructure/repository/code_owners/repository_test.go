@@ -0,0 +1,82 @@
+package code_owners
+
+import (
+	"context"
+	"testing"
+
+	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/repository"
+	suite2 "pr-reviewers-service/test/suite"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *CodeOwnersTest) TestSaveCodeOwners() {
+	tests := []struct {
+		name        string
+		input       CodeOwnersIn
+		setup       func(ctx context.Context, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *CodeOwnersOut)
+	}{
+		{
+			name:     "successful SaveCodeOwners returns file",
+			input:    CodeOwnersIn{Repository: "org/service", Content: "* @alice"},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *CodeOwnersOut) {
+				assert.Equal(t, "org/service", result.Repository)
+				assert.Equal(t, "* @alice", result.Content)
+				assert.False(t, result.CreatedAt.IsZero())
+				assert.True(t, result.CreatedAt.Equal(result.UpdatedAt))
+			},
+		},
+		{
+			name:  "SaveCodeOwners replaces existing file",
+			input: CodeOwnersIn{Repository: "org/service", Content: "*.go @bob"},
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveCodeOwners(ctx, CodeOwnersIn{Repository: "org/service", Content: "* @alice"})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *CodeOwnersOut) {
+				assert.Equal(t, "*.go @bob", result.Content)
+				assert.True(t, result.UpdatedAt.After(result.CreatedAt))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.SaveCodeOwners(ctx, tt.input)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *CodeOwnersTest) TestGetCodeOwnersByRepository() {
+	ctx := context.Background()
+	repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+	_, err := repo.SaveCodeOwners(ctx, CodeOwnersIn{Repository: "org/service", Content: "* @alice"})
+	assert.NoError(s.T(), err)
+	_, err = repo.SaveCodeOwners(ctx, CodeOwnersIn{Repository: "org/other", Content: "* @bob"})
+	assert.NoError(s.T(), err)
+
+	result, err := repo.GetCodeOwnersByRepository(ctx, "org/service")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "* @alice", result.Content)
+
+	_, err = repo.GetCodeOwnersByRepository(ctx, "org/unknown")
+	assert.ErrorIs(s.T(), err, repository.ErrCodeOwnersNotFound)
+}
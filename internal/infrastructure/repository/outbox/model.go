@@ -0,0 +1,48 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OutboxEventIn struct {
+	ID            uuid.UUID
+	EventType     string
+	Payload       []byte
+	Status        string
+	NextAttemptAt time.Time
+}
+
+type OutboxEventOut struct {
+	ID            uuid.UUID
+	EventType     string
+	Payload       []byte
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     *string
+	CreatedAt     time.Time
+	PublishedAt   *time.Time
+}
+
+type OutboxEventAttemptIn struct {
+	ID            uuid.UUID
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     *string
+	PublishedAt   *time.Time
+}
+
+type outboxEventDB struct {
+	ID            uuid.UUID  `db:"id"`
+	EventType     string     `db:"event_type"`
+	Payload       []byte     `db:"payload"`
+	Status        string     `db:"status"`
+	Attempts      int        `db:"attempts"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	LastError     *string    `db:"last_error"`
+	CreatedAt     time.Time  `db:"created_at"`
+	PublishedAt   *time.Time `db:"published_at"`
+}
@@ -0,0 +1,177 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	nower2 "pr-reviewers-service/internal/usecase/contract/nower"
+
+	"github.com/Masterminds/squirrel"
+	trm "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	outboxTableName         = "outbox"
+	idColumnName            = "id"
+	eventTypeColumnName     = "event_type"
+	payloadColumnName       = "payload"
+	statusColumnName        = "status"
+	attemptsColumnName      = "attempts"
+	nextAttemptAtColumnName = "next_attempt_at"
+	lastErrorColumnName     = "last_error"
+	createdAtColumnName     = "created_at"
+	publishedAtColumnName   = "published_at"
+
+	returnAll = "RETURNING *"
+)
+
+type Repository struct {
+	db    *pgxpool.Pool
+	nower nower2.Nower
+}
+
+func NewRepository(pool *pgxpool.Pool, nower nower2.Nower) *Repository {
+	return &Repository{db: pool, nower: nower}
+}
+
+func (r *Repository) SaveOutboxEventsBatch(ctx context.Context, events []OutboxEventIn) (*[]OutboxEventOut, error) {
+	if len(events) == 0 {
+		return &[]OutboxEventOut{}, nil
+	}
+
+	queryBuilder := squirrel.Insert(outboxTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Columns(idColumnName, eventTypeColumnName, payloadColumnName, statusColumnName,
+			nextAttemptAtColumnName, createdAtColumnName)
+
+	now := r.nower.Now()
+	for _, event := range events {
+		eventID := event.ID
+		if eventID == uuid.Nil {
+			eventID = uuid.New()
+		}
+		nextAttemptAt := event.NextAttemptAt
+		if nextAttemptAt.IsZero() {
+			nextAttemptAt = now
+		}
+
+		queryBuilder = queryBuilder.Values(eventID, event.EventType, event.Payload, event.Status, nextAttemptAt, now)
+	}
+	queryBuilder = queryBuilder.Suffix(returnAll)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[outboxEventDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	eventOuts := make([]OutboxEventOut, 0, len(results))
+	for _, result := range results {
+		eventOuts = append(eventOuts, OutboxEventOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository SaveOutboxEventsBatch success", "count", len(eventOuts))
+	return &eventOuts, nil
+}
+
+// ClaimDueOutboxEvents locks up to limit events in the given status whose next attempt is due, oldest first,
+// rows locked by another relay are skipped. Must be called inside a transaction to hold the locks.
+func (r *Repository) ClaimDueOutboxEvents(ctx context.Context, status string, limit uint64) (*[]OutboxEventOut, error) {
+	selectBuilder := squirrel.
+		Select(idColumnName, eventTypeColumnName, payloadColumnName, statusColumnName, attemptsColumnName,
+			nextAttemptAtColumnName, lastErrorColumnName, createdAtColumnName, publishedAtColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(outboxTableName).
+		Where(squirrel.Eq{statusColumnName: status}).
+		Where(squirrel.LtOrEq{nextAttemptAtColumnName: r.nower.Now()}).
+		OrderBy(createdAtColumnName, idColumnName).
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[outboxEventDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	eventOuts := make([]OutboxEventOut, 0, len(results))
+	for _, result := range results {
+		eventOuts = append(eventOuts, OutboxEventOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository ClaimDueOutboxEvents success", "count", len(eventOuts))
+	return &eventOuts, nil
+}
+
+func (r *Repository) UpdateOutboxEventAttempt(ctx context.Context, attempt OutboxEventAttemptIn) (*OutboxEventOut, error) {
+	queryBuilder := squirrel.Update(outboxTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Set(statusColumnName, attempt.Status).
+		Set(attemptsColumnName, attempt.Attempts).
+		Set(nextAttemptAtColumnName, attempt.NextAttemptAt).
+		Set(lastErrorColumnName, attempt.LastError).
+		Set(publishedAtColumnName, attempt.PublishedAt).
+		Where(squirrel.Eq{idColumnName: attempt.ID}).
+		Suffix(returnAll)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[outboxEventDB])
+	if err != nil {
+		slog.DebugContext(ctx, err.Error())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", repository.ErrOutboxEventNotFound, attempt.ID)
+		}
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository UpdateOutboxEventAttempt success")
+	out := OutboxEventOut(result)
+	return &out, nil
+}
@@ -0,0 +1,232 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/repository"
+	suite2 "pr-reviewers-service/test/suite"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	pendingStatus   = "PENDING"
+	publishedStatus = "PUBLISHED"
+	failedStatus    = "FAILED"
+)
+
+func newEvent(status string, nextAttemptAt time.Time) OutboxEventIn {
+	return OutboxEventIn{
+		EventType:     "reviewer.assigned",
+		Payload:       []byte(`{"type":"reviewer.assigned"}`),
+		Status:        status,
+		NextAttemptAt: nextAttemptAt,
+	}
+}
+
+func (s *OutboxTest) TestSaveOutboxEventsBatch() {
+	tests := []struct {
+		name        string
+		input       []OutboxEventIn
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *[]OutboxEventOut)
+	}{
+		{
+			name:     "successful SaveOutboxEventsBatch returns saved events",
+			input:    []OutboxEventIn{newEvent(pendingStatus, time.Time{}), newEvent(pendingStatus, time.Time{})},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]OutboxEventOut) {
+				assert.Len(t, *result, 2)
+				for _, event := range *result {
+					assert.NotEqual(t, uuid.Nil, event.ID)
+					assert.Equal(t, "reviewer.assigned", event.EventType)
+					assert.Equal(t, pendingStatus, event.Status)
+					assert.Equal(t, 0, event.Attempts)
+					assert.JSONEq(t, `{"type":"reviewer.assigned"}`, string(event.Payload))
+					assert.False(t, event.NextAttemptAt.IsZero())
+					assert.Nil(t, event.LastError)
+					assert.Nil(t, event.PublishedAt)
+				}
+			},
+		},
+		{
+			name:     "SaveOutboxEventsBatch with empty batch returns empty list",
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]OutboxEventOut) {
+				assert.Empty(t, *result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			result, err := repo.SaveOutboxEventsBatch(ctx, tt.input)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *OutboxTest) TestClaimDueOutboxEvents() {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		limit       uint64
+		input       func() []OutboxEventIn
+		checkResult func(t *testing.T, result *[]OutboxEventOut)
+	}{
+		{
+			name:  "ClaimDueOutboxEvents returns due pending events",
+			limit: 10,
+			input: func() []OutboxEventIn {
+				return []OutboxEventIn{
+					newEvent(pendingStatus, now.Add(-time.Minute)),
+					newEvent(pendingStatus, now.Add(time.Hour)),
+					newEvent(publishedStatus, now.Add(-time.Minute)),
+					newEvent(failedStatus, now.Add(-time.Minute)),
+				}
+			},
+			checkResult: func(t *testing.T, result *[]OutboxEventOut) {
+				require.Len(t, *result, 1)
+				assert.Equal(t, pendingStatus, (*result)[0].Status)
+			},
+		},
+		{
+			name:  "ClaimDueOutboxEvents respects limit",
+			limit: 1,
+			input: func() []OutboxEventIn {
+				return []OutboxEventIn{
+					newEvent(pendingStatus, now.Add(-time.Minute)),
+					newEvent(pendingStatus, now.Add(-time.Minute)),
+				}
+			},
+			checkResult: func(t *testing.T, result *[]OutboxEventOut) {
+				assert.Len(t, *result, 1)
+			},
+		},
+		{
+			name:  "ClaimDueOutboxEvents without due events returns empty list",
+			limit: 10,
+			input: func() []OutboxEventIn {
+				return []OutboxEventIn{newEvent(pendingStatus, now.Add(time.Hour))}
+			},
+			checkResult: func(t *testing.T, result *[]OutboxEventOut) {
+				assert.Empty(t, *result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+			_, err := repo.SaveOutboxEventsBatch(ctx, tt.input())
+			require.NoError(t, err)
+
+			result, err := repo.ClaimDueOutboxEvents(ctx, pendingStatus, tt.limit)
+			require.NoError(t, err)
+			tt.checkResult(t, result)
+		})
+	}
+}
+
+func (s *OutboxTest) TestUpdateOutboxEventAttempt() {
+	now := time.Now().Truncate(time.Microsecond)
+	lastError := "publisher failed"
+
+	tests := []struct {
+		name        string
+		known       bool
+		attempt     func(eventID uuid.UUID) OutboxEventAttemptIn
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *OutboxEventOut)
+	}{
+		{
+			name:  "successful UpdateOutboxEventAttempt marks event published",
+			known: true,
+			attempt: func(eventID uuid.UUID) OutboxEventAttemptIn {
+				return OutboxEventAttemptIn{
+					ID:            eventID,
+					Status:        publishedStatus,
+					Attempts:      1,
+					NextAttemptAt: now,
+					PublishedAt:   &now,
+				}
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *OutboxEventOut) {
+				assert.Equal(t, publishedStatus, result.Status)
+				assert.Equal(t, 1, result.Attempts)
+				require.NotNil(t, result.PublishedAt)
+				assert.True(t, now.Equal(*result.PublishedAt))
+				assert.Nil(t, result.LastError)
+			},
+		},
+		{
+			name:  "successful UpdateOutboxEventAttempt schedules retry",
+			known: true,
+			attempt: func(eventID uuid.UUID) OutboxEventAttemptIn {
+				return OutboxEventAttemptIn{
+					ID:            eventID,
+					Status:        pendingStatus,
+					Attempts:      1,
+					NextAttemptAt: now.Add(time.Minute),
+					LastError:     &lastError,
+				}
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *OutboxEventOut) {
+				assert.Equal(t, pendingStatus, result.Status)
+				assert.True(t, now.Add(time.Minute).Equal(result.NextAttemptAt))
+				assert.Equal(t, &lastError, result.LastError)
+				assert.Nil(t, result.PublishedAt)
+			},
+		},
+		{
+			name: "UpdateOutboxEventAttempt with unknown id returns error",
+			attempt: func(eventID uuid.UUID) OutboxEventAttemptIn {
+				return OutboxEventAttemptIn{ID: eventID, Status: publishedStatus, NextAttemptAt: now}
+			},
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrOutboxEventNotFound, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+			eventID := uuid.New()
+			if tt.known {
+				event := newEvent(pendingStatus, time.Time{})
+				event.ID = eventID
+				_, err := repo.SaveOutboxEventsBatch(ctx, []OutboxEventIn{event})
+				require.NoError(t, err)
+			}
+
+			result, err := repo.UpdateOutboxEventAttempt(ctx, tt.attempt(eventID))
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"pr-reviewers-service/internal/infrastructure/repository"
 
@@ -20,6 +21,12 @@ const (
 	prIdColumnName       = "pr_id"
 	reviewerIdColumnName = "reviewer_id"
 
+	pullRequestsTableName = "pull_requests"
+	prStatusesTableName   = "pr_statuses"
+	prStatusIdColumnName  = "status_id"
+	prMergedAtColumnName  = "merged_at"
+	statusColumnName      = "status"
+
 	returnAll = "RETURNING *"
 )
 
@@ -241,3 +248,45 @@ func (r *Repository) DeletePRReviewerByPRAndReviewer(ctx context.Context, prID,
 	slog.DebugContext(ctx, "Repository DeletePRReviewerByPRAndReviewer success")
 	return nil
 }
+
+// CountReviewsByReviewerID counts PRs in the given status the user is assigned to. When mergedSince is set only PRs
+// merged at or after that moment are counted.
+func (r *Repository) CountReviewsByReviewerID(
+	ctx context.Context,
+	reviewerID uuid.UUID,
+	status string,
+	mergedSince *time.Time,
+) (int, error) {
+	selectBuilder := squirrel.
+		Select("COUNT(*)").
+		PlaceholderFormat(squirrel.Dollar).
+		From(prReviewersTableName).
+		Join(fmt.Sprintf("%s ON %s.%s = %s.%s", pullRequestsTableName,
+			pullRequestsTableName, idColumnName, prReviewersTableName, prIdColumnName)).
+		Join(fmt.Sprintf("%s ON %s.%s = %s.%s", prStatusesTableName,
+			prStatusesTableName, idColumnName, pullRequestsTableName, prStatusIdColumnName)).
+		Where(squirrel.Eq{
+			prReviewersTableName + "." + reviewerIdColumnName: reviewerID,
+			prStatusesTableName + "." + statusColumnName:      status,
+		})
+	if mergedSince != nil {
+		selectBuilder = selectBuilder.Where(squirrel.GtOrEq{pullRequestsTableName + "." + prMergedAtColumnName: *mergedSince})
+	}
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return 0, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	var cnt int
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	err = q.QueryRow(ctx, sql, args...).Scan(&cnt)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return 0, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+
+	slog.DebugContext(ctx, "Repository CountReviewsByReviewerID success", "count", cnt)
+	return cnt, nil
+}
@@ -1097,3 +1097,135 @@ func (s *PRReviewersTest) TestDeletePRReviewerByPRAndReviewer() {
 		})
 	}
 }
+
+func (s *PRReviewersTest) TestCountReviewsByReviewerID() {
+	teamID := uuid.New()
+	authorID := uuid.New()
+	reviewerID := uuid.New()
+	openStatusID := uuid.New()
+	recentMergedStatusID := uuid.New()
+	oldMergedStatusID := uuid.New()
+	openPRID := uuid.New()
+	recentMergedPRID := uuid.New()
+	oldMergedPRID := uuid.New()
+	now := time.Now()
+	since := now.AddDate(0, 0, -30)
+
+	type TestRepos struct {
+		Team     *teams.Repository
+		User     *users.Repository
+		Status   *pr_statuses.Repository
+		PR       *pull_requests.Repository
+		Reviewer *Repository
+	}
+
+	setup := func(ctx context.Context, repos *TestRepos) {
+		_, err := repos.Team.SaveTeam(ctx, teams.TeamIn{
+			ID:   teamID,
+			Name: "Test Team",
+		})
+		assert.NoError(s.T(), err)
+
+		_, err = repos.User.SaveUsersBatch(ctx, []users.UserIn{
+			{ID: authorID, Name: "Author", TeamID: teamID},
+			{ID: reviewerID, Name: "Reviewer", TeamID: teamID},
+		})
+		assert.NoError(s.T(), err)
+
+		for statusID, status := range map[uuid.UUID]string{
+			openStatusID:         "OPEN",
+			recentMergedStatusID: "MERGED",
+			oldMergedStatusID:    "MERGED",
+		} {
+			_, err = repos.Status.SavePRStatus(ctx, pr_statuses.PRStatusIn{
+				ID:     statusID,
+				Status: status,
+			})
+			assert.NoError(s.T(), err)
+		}
+
+		for _, pr := range []pull_requests.PullRequestIn{
+			{ID: openPRID, Name: "Open PR", AuthorID: authorID, StatusID: openStatusID, CreatedAt: now},
+			{ID: recentMergedPRID, Name: "Recent PR", AuthorID: authorID, StatusID: recentMergedStatusID,
+				CreatedAt: now, MergedAt: now.AddDate(0, 0, -1)},
+			{ID: oldMergedPRID, Name: "Old PR", AuthorID: authorID, StatusID: oldMergedStatusID,
+				CreatedAt: now, MergedAt: now.AddDate(0, 0, -60)},
+		} {
+			_, err = repos.PR.SavePullRequest(ctx, pr)
+			assert.NoError(s.T(), err)
+
+			_, err = repos.Reviewer.SavePRReviewer(ctx, PrReviewerIn{
+				PrID:       pr.ID,
+				ReviewerID: reviewerID,
+			})
+			assert.NoError(s.T(), err)
+		}
+	}
+
+	tests := []struct {
+		name        string
+		reviewerID  uuid.UUID
+		status      string
+		mergedSince *time.Time
+		setup       func(ctx context.Context, repos *TestRepos)
+		checkErr    assert.ErrorAssertionFunc
+		expected    int
+	}{
+		{
+			name:       "CountReviewsByReviewerID counts open reviews",
+			reviewerID: reviewerID,
+			status:     "OPEN",
+			setup:      setup,
+			checkErr:   assert.NoError,
+			expected:   1,
+		},
+		{
+			name:       "CountReviewsByReviewerID counts all merged reviews without period",
+			reviewerID: reviewerID,
+			status:     "MERGED",
+			setup:      setup,
+			checkErr:   assert.NoError,
+			expected:   2,
+		},
+		{
+			name:        "CountReviewsByReviewerID counts only reviews merged in period",
+			reviewerID:  reviewerID,
+			status:      "MERGED",
+			mergedSince: &since,
+			setup:       setup,
+			checkErr:    assert.NoError,
+			expected:    1,
+		},
+		{
+			name:       "CountReviewsByReviewerID for user without reviews returns zero",
+			reviewerID: authorID,
+			status:     "OPEN",
+			setup:      setup,
+			checkErr:   assert.NoError,
+			expected:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repos := &TestRepos{
+				Team:     teams.NewRepository(suite2.GlobalPool, nower2.Nower{}),
+				User:     users.NewRepository(suite2.GlobalPool, nower2.Nower{}),
+				Status:   pr_statuses.NewRepository(suite2.GlobalPool),
+				PR:       pull_requests.NewRepository(suite2.GlobalPool, nower2.Nower{}),
+				Reviewer: NewRepository(suite2.GlobalPool),
+			}
+
+			if tt.setup != nil {
+				tt.setup(ctx, repos)
+			}
+
+			result, err := repos.Reviewer.CountReviewsByReviewerID(ctx, tt.reviewerID, tt.status, tt.mergedSince)
+			tt.checkErr(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -25,6 +25,9 @@ const (
 	createdAtColumnName   = "created_at"
 	mergedAtColumnName    = "merged_at"
 
+	prStatusesTableName = "pr_statuses"
+	statusColumnName    = "status"
+
 	returnAll = "RETURNING *"
 )
 
@@ -163,6 +166,46 @@ func (r *Repository) GetPullRequestsByPrIDs(ctx context.Context, prIDs []uuid.UU
 	return &prs, nil
 }
 
+func (r *Repository) GetPullRequestsByAuthorIDs(ctx context.Context, authorIDs []uuid.UUID) (*[]PullRequestOut, error) {
+	if len(authorIDs) == 0 {
+		return &[]PullRequestOut{}, nil
+	}
+
+	selectBuilder := squirrel.
+		Select(idColumnName, nameColumnName, authorIdColumnName, statusIdColumnName, createdAtColumnName, mergedAtColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(pullRequestsTableName).
+		Where(squirrel.Eq{authorIdColumnName: authorIDs})
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[pullRequestDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	prs := make([]PullRequestOut, 0, len(results))
+	for _, result := range results {
+		prs = append(prs, PullRequestOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository GetPullRequestsByAuthorIDs success", "count", len(prs))
+	return &prs, nil
+}
+
 func (r *Repository) MarkPullRequestMergedByID(ctx context.Context, prID uuid.UUID) (*PullRequestOut, error) {
 	now := r.nower.Now()
 
@@ -202,3 +245,34 @@ func (r *Repository) MarkPullRequestMergedByID(ctx context.Context, prID uuid.UU
 		MergedAt:  result.MergedAt,
 	}, nil
 }
+
+// CountPullRequestsByAuthorID counts PRs of the author that are in the given status.
+func (r *Repository) CountPullRequestsByAuthorID(ctx context.Context, authorID uuid.UUID, status string) (int, error) {
+	selectBuilder := squirrel.
+		Select("COUNT(*)").
+		PlaceholderFormat(squirrel.Dollar).
+		From(pullRequestsTableName).
+		Join(fmt.Sprintf("%s ON %s.%s = %s.%s", prStatusesTableName,
+			prStatusesTableName, idColumnName, pullRequestsTableName, statusIdColumnName)).
+		Where(squirrel.Eq{
+			pullRequestsTableName + "." + authorIdColumnName: authorID,
+			prStatusesTableName + "." + statusColumnName:     status,
+		})
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return 0, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	var cnt int
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	err = q.QueryRow(ctx, sql, args...).Scan(&cnt)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return 0, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+
+	slog.DebugContext(ctx, "Repository CountPullRequestsByAuthorID success", "count", cnt)
+	return cnt, nil
+}
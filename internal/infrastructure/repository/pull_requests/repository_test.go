@@ -326,6 +326,214 @@ func (s *PullRequestsTest) TestGetPullRequestsByPrIDs() {
 	}
 }
 
+func (s *PullRequestsTest) TestGetPullRequestsByAuthorIDs() {
+	teamID := uuid.New()
+	authorID1 := uuid.New()
+	authorID2 := uuid.New()
+	statusID := uuid.New()
+	prID1 := uuid.New()
+	prID2 := uuid.New()
+	now := time.Now()
+
+	type TestRepos struct {
+		Team   *teams.Repository
+		User   *users.Repository
+		Status *pr_statuses.Repository
+		PR     *Repository
+	}
+
+	tests := []struct {
+		name        string
+		input       []uuid.UUID
+		setup       func(ctx context.Context, repos *TestRepos)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *[]PullRequestOut)
+	}{
+		{
+			name:  "successful GetPullRequestsByAuthorIDs returns only PRs of given authors",
+			input: []uuid.UUID{authorID1},
+			setup: func(ctx context.Context, repos *TestRepos) {
+				_, err := repos.Team.SaveTeam(ctx, teams.TeamIn{
+					ID:   teamID,
+					Name: "Test Team",
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repos.User.SaveUsersBatch(ctx, []users.UserIn{
+					{ID: authorID1, Name: "Author 1", TeamID: teamID},
+					{ID: authorID2, Name: "Author 2", TeamID: teamID},
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repos.Status.SavePRStatus(ctx, pr_statuses.PRStatusIn{
+					ID:     statusID,
+					Status: "open",
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repos.PR.SavePullRequest(ctx, PullRequestIn{
+					ID:        prID1,
+					Name:      "Test PR 1",
+					AuthorID:  authorID1,
+					StatusID:  statusID,
+					CreatedAt: now,
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repos.PR.SavePullRequest(ctx, PullRequestIn{
+					ID:        prID2,
+					Name:      "Test PR 2",
+					AuthorID:  authorID2,
+					StatusID:  statusID,
+					CreatedAt: now,
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]PullRequestOut) {
+				assert.NotNil(t, result)
+				if assert.Len(t, *result, 1) {
+					assert.Equal(t, prID1, (*result)[0].ID)
+					assert.Equal(t, authorID1, (*result)[0].AuthorID)
+				}
+			},
+		},
+		{
+			name:     "GetPullRequestsByAuthorIDs with empty input returns empty result",
+			input:    []uuid.UUID{},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]PullRequestOut) {
+				assert.NotNil(t, result)
+				assert.Empty(t, *result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repos := &TestRepos{
+				Team:   teams.NewRepository(suite2.GlobalPool, nower2.Nower{}),
+				User:   users.NewRepository(suite2.GlobalPool, nower2.Nower{}),
+				Status: pr_statuses.NewRepository(suite2.GlobalPool),
+				PR:     NewRepository(suite2.GlobalPool, nower2.Nower{}),
+			}
+
+			if tt.setup != nil {
+				tt.setup(ctx, repos)
+			}
+
+			result, err := repos.PR.GetPullRequestsByAuthorIDs(ctx, tt.input)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *PullRequestsTest) TestCountPullRequestsByAuthorID() {
+	teamID := uuid.New()
+	authorID1 := uuid.New()
+	authorID2 := uuid.New()
+	openStatusID1 := uuid.New()
+	openStatusID2 := uuid.New()
+	mergedStatusID := uuid.New()
+	now := time.Now()
+
+	type TestRepos struct {
+		Team   *teams.Repository
+		User   *users.Repository
+		Status *pr_statuses.Repository
+		PR     *Repository
+	}
+
+	setup := func(ctx context.Context, repos *TestRepos) {
+		_, err := repos.Team.SaveTeam(ctx, teams.TeamIn{
+			ID:   teamID,
+			Name: "Test Team",
+		})
+		assert.NoError(s.T(), err)
+
+		_, err = repos.User.SaveUsersBatch(ctx, []users.UserIn{
+			{ID: authorID1, Name: "Author 1", TeamID: teamID},
+			{ID: authorID2, Name: "Author 2", TeamID: teamID},
+		})
+		assert.NoError(s.T(), err)
+
+		for statusID, status := range map[uuid.UUID]string{
+			openStatusID1:  "OPEN",
+			openStatusID2:  "OPEN",
+			mergedStatusID: "MERGED",
+		} {
+			_, err = repos.Status.SavePRStatus(ctx, pr_statuses.PRStatusIn{
+				ID:     statusID,
+				Status: status,
+			})
+			assert.NoError(s.T(), err)
+		}
+
+		for _, pr := range []PullRequestIn{
+			{Name: "Open PR", AuthorID: authorID1, StatusID: openStatusID1, CreatedAt: now},
+			{Name: "Merged PR", AuthorID: authorID1, StatusID: mergedStatusID, CreatedAt: now},
+			{Name: "Other author PR", AuthorID: authorID2, StatusID: openStatusID2, CreatedAt: now},
+		} {
+			_, err = repos.PR.SavePullRequest(ctx, pr)
+			assert.NoError(s.T(), err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		authorID uuid.UUID
+		status   string
+		setup    func(ctx context.Context, repos *TestRepos)
+		checkErr assert.ErrorAssertionFunc
+		expected int
+	}{
+		{
+			name:     "CountPullRequestsByAuthorID counts only PRs of author in given status",
+			authorID: authorID1,
+			status:   "OPEN",
+			setup:    setup,
+			checkErr: assert.NoError,
+			expected: 1,
+		},
+		{
+			name:     "CountPullRequestsByAuthorID for author without PRs returns zero",
+			authorID: uuid.New(),
+			status:   "OPEN",
+			setup:    setup,
+			checkErr: assert.NoError,
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repos := &TestRepos{
+				Team:   teams.NewRepository(suite2.GlobalPool, nower2.Nower{}),
+				User:   users.NewRepository(suite2.GlobalPool, nower2.Nower{}),
+				Status: pr_statuses.NewRepository(suite2.GlobalPool),
+				PR:     NewRepository(suite2.GlobalPool, nower2.Nower{}),
+			}
+
+			if tt.setup != nil {
+				tt.setup(ctx, repos)
+			}
+
+			result, err := repos.PR.CountPullRequestsByAuthorID(ctx, tt.authorID, tt.status)
+			tt.checkErr(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func (s *PullRequestsTest) TestMarkPullRequestMergedByID() {
 	teamID := uuid.New()
 	userID := uuid.New()
@@ -0,0 +1,27 @@
+package review_snoozes
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReviewSnoozeIn struct {
+	PRID         uuid.UUID
+	ReviewerID   uuid.UUID
+	SnoozedUntil time.Time
+}
+
+type ReviewSnoozeOut struct {
+	PRID         uuid.UUID
+	ReviewerID   uuid.UUID
+	SnoozedUntil time.Time
+	CreatedAt    time.Time
+}
+
+type reviewSnoozeDB struct {
+	PRID         uuid.UUID `db:"pr_id"`
+	ReviewerID   uuid.UUID `db:"reviewer_id"`
+	SnoozedUntil time.Time `db:"snoozed_until"`
+	CreatedAt    time.Time `db:"created_at"`
+}
@@ -0,0 +1,106 @@
+package review_snoozes
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	nower2 "pr-reviewers-service/internal/usecase/contract/nower"
+
+	"github.com/Masterminds/squirrel"
+	trm "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	reviewSnoozesTableName = "review_snoozes"
+	prIDColumnName         = "pr_id"
+	reviewerIDColumnName   = "reviewer_id"
+	snoozedUntilColumnName = "snoozed_until"
+	createdAtColumnName    = "created_at"
+
+	returnAll = "RETURNING *"
+)
+
+type Repository struct {
+	db    *pgxpool.Pool
+	nower nower2.Nower
+}
+
+func NewRepository(pool *pgxpool.Pool, nower nower2.Nower) *Repository {
+	return &Repository{db: pool, nower: nower}
+}
+
+// SaveReviewSnooze snoozes the review of the PR by the reviewer, an existing snooze is replaced.
+func (r *Repository) SaveReviewSnooze(ctx context.Context, snooze ReviewSnoozeIn) (*ReviewSnoozeOut, error) {
+	queryBuilder := squirrel.Insert(reviewSnoozesTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Columns(prIDColumnName, reviewerIDColumnName, snoozedUntilColumnName, createdAtColumnName).
+		Values(snooze.PRID, snooze.ReviewerID, snooze.SnoozedUntil, r.nower.Now()).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s, %s) DO UPDATE SET %s = EXCLUDED.%s, %s = EXCLUDED.%s %s",
+			prIDColumnName, reviewerIDColumnName, snoozedUntilColumnName, snoozedUntilColumnName,
+			createdAtColumnName, createdAtColumnName, returnAll))
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[reviewSnoozeDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository SaveReviewSnooze success")
+	out := ReviewSnoozeOut(result)
+	return &out, nil
+}
+
+// GetActiveReviewSnoozes returns the snoozes that have not expired yet.
+func (r *Repository) GetActiveReviewSnoozes(ctx context.Context) (*[]ReviewSnoozeOut, error) {
+	selectBuilder := squirrel.
+		Select(prIDColumnName, reviewerIDColumnName, snoozedUntilColumnName, createdAtColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(reviewSnoozesTableName).
+		Where(squirrel.Gt{snoozedUntilColumnName: r.nower.Now()})
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[reviewSnoozeDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	snoozes := make([]ReviewSnoozeOut, 0, len(results))
+	for _, result := range results {
+		snoozes = append(snoozes, ReviewSnoozeOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository GetActiveReviewSnoozes success", "count", len(snoozes))
+	return &snoozes, nil
+}
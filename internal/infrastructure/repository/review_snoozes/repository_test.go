@@ -0,0 +1,130 @@
+package review_snoozes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
+	"pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	"pr-reviewers-service/internal/infrastructure/repository/teams"
+	"pr-reviewers-service/internal/infrastructure/repository/users"
+	suite2 "pr-reviewers-service/test/suite"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// seedPullRequests creates an author, a reviewer and the pull requests authored by the former.
+func (s *ReviewSnoozesTest) seedPullRequests(ctx context.Context, reviewerID uuid.UUID, prIDs []uuid.UUID) {
+	teamID := uuid.New()
+	authorID := uuid.New()
+	statusID := uuid.New()
+
+	_, err := teams.NewRepository(suite2.GlobalPool, nower2.Nower{}).SaveTeam(ctx, teams.TeamIn{ID: teamID, Name: "Team A"})
+	assert.NoError(s.T(), err)
+	_, err = users.NewRepository(suite2.GlobalPool, nower2.Nower{}).SaveUsersBatch(ctx, []users.UserIn{
+		{ID: authorID, Name: "Author", IsActive: true, TeamID: teamID},
+		{ID: reviewerID, Name: "Reviewer", IsActive: true, TeamID: teamID},
+	})
+	assert.NoError(s.T(), err)
+	_, err = pr_statuses.NewRepository(suite2.GlobalPool).SavePRStatus(ctx, pr_statuses.PRStatusIn{ID: statusID, Status: "OPEN"})
+	assert.NoError(s.T(), err)
+
+	prRepo := pull_requests.NewRepository(suite2.GlobalPool, nower2.Nower{})
+	for _, prID := range prIDs {
+		_, err = prRepo.SavePullRequest(ctx, pull_requests.PullRequestIn{
+			ID:        prID,
+			Name:      "PR " + prID.String()[:8],
+			AuthorID:  authorID,
+			StatusID:  statusID,
+			CreatedAt: time.Now(),
+		})
+		assert.NoError(s.T(), err)
+	}
+}
+
+func (s *ReviewSnoozesTest) TestSaveReviewSnooze() {
+	reviewerID := uuid.New()
+	prID := uuid.New()
+	until := time.Now().Add(24 * time.Hour).Truncate(time.Microsecond)
+
+	tests := []struct {
+		name        string
+		input       ReviewSnoozeIn
+		setup       func(ctx context.Context, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *ReviewSnoozeOut)
+	}{
+		{
+			name:     "successful SaveReviewSnooze returns snooze",
+			input:    ReviewSnoozeIn{PRID: prID, ReviewerID: reviewerID, SnoozedUntil: until},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *ReviewSnoozeOut) {
+				assert.Equal(t, prID, result.PRID)
+				assert.Equal(t, reviewerID, result.ReviewerID)
+				assert.True(t, until.Equal(result.SnoozedUntil))
+				assert.False(t, result.CreatedAt.IsZero())
+			},
+		},
+		{
+			name:  "SaveReviewSnooze replaces existing snooze",
+			input: ReviewSnoozeIn{PRID: prID, ReviewerID: reviewerID, SnoozedUntil: until.Add(time.Hour)},
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveReviewSnooze(ctx, ReviewSnoozeIn{PRID: prID, ReviewerID: reviewerID, SnoozedUntil: until})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *ReviewSnoozeOut) {
+				assert.True(t, until.Add(time.Hour).Equal(result.SnoozedUntil))
+			},
+		},
+		{
+			name:     "SaveReviewSnooze for unknown pull request returns error",
+			input:    ReviewSnoozeIn{PRID: uuid.New(), ReviewerID: reviewerID, SnoozedUntil: until},
+			checkErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+			s.seedPullRequests(ctx, reviewerID, []uuid.UUID{prID})
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.SaveReviewSnooze(ctx, tt.input)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *ReviewSnoozesTest) TestGetActiveReviewSnoozes() {
+	ctx := context.Background()
+	repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+	reviewerID := uuid.New()
+	activePRID := uuid.New()
+	expiredPRID := uuid.New()
+	s.seedPullRequests(ctx, reviewerID, []uuid.UUID{activePRID, expiredPRID})
+
+	_, err := repo.SaveReviewSnooze(ctx, ReviewSnoozeIn{PRID: activePRID, ReviewerID: reviewerID, SnoozedUntil: time.Now().Add(time.Hour)})
+	assert.NoError(s.T(), err)
+	_, err = repo.SaveReviewSnooze(ctx, ReviewSnoozeIn{PRID: expiredPRID, ReviewerID: reviewerID, SnoozedUntil: time.Now().Add(-time.Hour)})
+	assert.NoError(s.T(), err)
+
+	result, err := repo.GetActiveReviewSnoozes(ctx)
+
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), *result, 1) {
+		assert.Equal(s.T(), activePRID, (*result)[0].PRID)
+		assert.Equal(s.T(), reviewerID, (*result)[0].ReviewerID)
+	}
+}
@@ -0,0 +1,31 @@
+package team_memberships
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TeamMembershipIn struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TeamID    uuid.UUID
+	IsPrimary bool
+	CreatedAt time.Time
+}
+
+type TeamMembershipOut struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	TeamID    uuid.UUID
+	IsPrimary bool
+	CreatedAt time.Time
+}
+
+type teamMembershipDB struct {
+	ID        uuid.UUID `db:"id"`
+	UserID    uuid.UUID `db:"user_id"`
+	TeamID    uuid.UUID `db:"team_id"`
+	IsPrimary bool      `db:"is_primary"`
+	CreatedAt time.Time `db:"created_at"`
+}
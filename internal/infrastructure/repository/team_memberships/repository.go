@@ -0,0 +1,211 @@
+package team_memberships
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	nower2 "pr-reviewers-service/internal/usecase/contract/nower"
+
+	"github.com/Masterminds/squirrel"
+	trm "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	teamMembershipsTableName = "team_memberships"
+	idColumnName             = "id"
+	userIdColumnName         = "user_id"
+	teamIdColumnName         = "team_id"
+	isPrimaryColumnName      = "is_primary"
+	createdAtColumnName      = "created_at"
+
+	returnAll = "RETURNING *"
+)
+
+type Repository struct {
+	db    *pgxpool.Pool
+	nower nower2.Nower
+}
+
+func NewRepository(pool *pgxpool.Pool, nower nower2.Nower) *Repository {
+	return &Repository{db: pool, nower: nower}
+}
+
+// SaveTeamMembershipsBatch inserts memberships and skips the ones that already exist.
+// Only newly created memberships are returned.
+func (r *Repository) SaveTeamMembershipsBatch(ctx context.Context, memberships []TeamMembershipIn) (*[]TeamMembershipOut, error) {
+	if len(memberships) == 0 {
+		return &[]TeamMembershipOut{}, nil
+	}
+
+	queryBuilder := squirrel.Insert(teamMembershipsTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Columns(idColumnName, userIdColumnName, teamIdColumnName, isPrimaryColumnName, createdAtColumnName)
+
+	now := r.nower.Now()
+	for _, membership := range memberships {
+		membershipID := membership.ID
+		if membershipID == uuid.Nil {
+			membershipID = uuid.New()
+		}
+
+		queryBuilder = queryBuilder.Values(membershipID, membership.UserID, membership.TeamID, membership.IsPrimary, now)
+	}
+	queryBuilder = queryBuilder.
+		Suffix(fmt.Sprintf("ON CONFLICT (%s, %s) DO NOTHING", userIdColumnName, teamIdColumnName)).
+		Suffix(returnAll)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[teamMembershipDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	membershipOuts := make([]TeamMembershipOut, 0, len(results))
+	for _, result := range results {
+		membershipOuts = append(membershipOuts, TeamMembershipOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository SaveTeamMembershipsBatch success", "count", len(membershipOuts))
+	return &membershipOuts, nil
+}
+
+func (r *Repository) GetTeamMembershipsByUserIDs(ctx context.Context, userIDs []uuid.UUID) (*[]TeamMembershipOut, error) {
+	if len(userIDs) == 0 {
+		return &[]TeamMembershipOut{}, nil
+	}
+
+	selectBuilder := squirrel.
+		Select(idColumnName, userIdColumnName, teamIdColumnName, isPrimaryColumnName, createdAtColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(teamMembershipsTableName).
+		Where(squirrel.Eq{userIdColumnName: userIDs})
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[teamMembershipDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	membershipOuts := make([]TeamMembershipOut, 0, len(results))
+	for _, result := range results {
+		membershipOuts = append(membershipOuts, TeamMembershipOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository GetTeamMembershipsByUserIDs success", "count", len(membershipOuts))
+	return &membershipOuts, nil
+}
+
+// SetPrimaryTeamMembership makes teamID the primary team of the user. The previous primary membership and an
+// additional membership in teamID, if any, are removed.
+func (r *Repository) SetPrimaryTeamMembership(ctx context.Context, userID, teamID uuid.UUID) (*TeamMembershipOut, error) {
+	deleteBuilder := squirrel.Delete(teamMembershipsTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{userIdColumnName: userID}).
+		Where(squirrel.Or{
+			squirrel.Eq{isPrimaryColumnName: true},
+			squirrel.Eq{teamIdColumnName: teamID},
+		})
+
+	sql, args, err := deleteBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	_, err = q.Exec(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+
+	insertBuilder := squirrel.Insert(teamMembershipsTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Columns(idColumnName, userIdColumnName, teamIdColumnName, isPrimaryColumnName, createdAtColumnName).
+		Values(uuid.New(), userID, teamID, true, r.nower.Now()).
+		Suffix(returnAll)
+
+	sql, args, err = insertBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[teamMembershipDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository SetPrimaryTeamMembership success")
+	membership := TeamMembershipOut(result)
+	return &membership, nil
+}
+
+// DeleteTeamMembership removes an additional membership, the primary one is only replaced
+// through SetPrimaryTeamMembership.
+func (r *Repository) DeleteTeamMembership(ctx context.Context, userID, teamID uuid.UUID) error {
+	queryBuilder := squirrel.Delete(teamMembershipsTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{
+			userIdColumnName:    userID,
+			teamIdColumnName:    teamID,
+			isPrimaryColumnName: false,
+		})
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	_, err = q.Exec(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+
+	slog.DebugContext(ctx, "Repository DeleteTeamMembership success")
+	return nil
+}
@@ -0,0 +1,329 @@
+package team_memberships
+
+import (
+	"context"
+	"testing"
+
+	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/repository/teams"
+	"pr-reviewers-service/internal/infrastructure/repository/users"
+	suite2 "pr-reviewers-service/test/suite"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *TeamMembershipsTest) seedTeamsAndUsers(ctx context.Context, teamIDs []uuid.UUID, userIDs []uuid.UUID) {
+	teamRepo := teams.NewRepository(suite2.GlobalPool, nower2.Nower{})
+	for i, teamID := range teamIDs {
+		_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{
+			ID:   teamID,
+			Name: "Team " + string(rune('A'+i)),
+		})
+		assert.NoError(s.T(), err)
+	}
+
+	userRepo := users.NewRepository(suite2.GlobalPool, nower2.Nower{})
+	usersIn := make([]users.UserIn, 0, len(userIDs))
+	for i, userID := range userIDs {
+		usersIn = append(usersIn, users.UserIn{
+			ID:       userID,
+			Name:     "User " + string(rune('A'+i)),
+			IsActive: true,
+			TeamID:   teamIDs[0],
+		})
+	}
+	_, err := userRepo.SaveUsersBatch(ctx, usersIn)
+	assert.NoError(s.T(), err)
+}
+
+func (s *TeamMembershipsTest) TestSaveTeamMembershipsBatch() {
+	teamID1 := uuid.New()
+	teamID2 := uuid.New()
+	userID1 := uuid.New()
+	userID2 := uuid.New()
+
+	tests := []struct {
+		name        string
+		input       []TeamMembershipIn
+		setup       func(ctx context.Context, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *[]TeamMembershipOut)
+	}{
+		{
+			name: "successful SaveTeamMembershipsBatch returns created memberships",
+			input: []TeamMembershipIn{
+				{UserID: userID1, TeamID: teamID2, IsPrimary: false},
+				{UserID: userID2, TeamID: teamID2, IsPrimary: false},
+			},
+			setup: func(ctx context.Context, repo *Repository) {
+				s.seedTeamsAndUsers(ctx, []uuid.UUID{teamID1, teamID2}, []uuid.UUID{userID1, userID2})
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]TeamMembershipOut) {
+				assert.NotNil(t, result)
+				assert.Len(t, *result, 2)
+				for _, membership := range *result {
+					assert.NotEqual(t, uuid.Nil, membership.ID)
+					assert.Equal(t, teamID2, membership.TeamID)
+					assert.False(t, membership.IsPrimary)
+					assert.False(t, membership.CreatedAt.IsZero())
+				}
+			},
+		},
+		{
+			name: "SaveTeamMembershipsBatch skips existing memberships",
+			input: []TeamMembershipIn{
+				{UserID: userID1, TeamID: teamID2, IsPrimary: false},
+				{UserID: userID2, TeamID: teamID2, IsPrimary: false},
+			},
+			setup: func(ctx context.Context, repo *Repository) {
+				s.seedTeamsAndUsers(ctx, []uuid.UUID{teamID1, teamID2}, []uuid.UUID{userID1, userID2})
+
+				_, err := repo.SaveTeamMembershipsBatch(ctx, []TeamMembershipIn{
+					{UserID: userID1, TeamID: teamID2, IsPrimary: false},
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]TeamMembershipOut) {
+				assert.NotNil(t, result)
+				assert.Len(t, *result, 1)
+				assert.Equal(t, userID2, (*result)[0].UserID)
+			},
+		},
+		{
+			name: "SaveTeamMembershipsBatch with second primary team returns error",
+			input: []TeamMembershipIn{
+				{UserID: userID1, TeamID: teamID2, IsPrimary: true},
+			},
+			setup: func(ctx context.Context, repo *Repository) {
+				s.seedTeamsAndUsers(ctx, []uuid.UUID{teamID1, teamID2}, []uuid.UUID{userID1})
+
+				_, err := repo.SaveTeamMembershipsBatch(ctx, []TeamMembershipIn{
+					{UserID: userID1, TeamID: teamID1, IsPrimary: true},
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.Error,
+		},
+		{
+			name:     "SaveTeamMembershipsBatch with empty input returns empty result",
+			input:    []TeamMembershipIn{},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]TeamMembershipOut) {
+				assert.NotNil(t, result)
+				assert.Empty(t, *result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.SaveTeamMembershipsBatch(ctx, tt.input)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *TeamMembershipsTest) TestGetTeamMembershipsByUserIDs() {
+	teamID1 := uuid.New()
+	teamID2 := uuid.New()
+	userID1 := uuid.New()
+	userID2 := uuid.New()
+
+	tests := []struct {
+		name        string
+		input       []uuid.UUID
+		setup       func(ctx context.Context, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *[]TeamMembershipOut)
+	}{
+		{
+			name:  "successful GetTeamMembershipsByUserIDs returns memberships of given users",
+			input: []uuid.UUID{userID1},
+			setup: func(ctx context.Context, repo *Repository) {
+				s.seedTeamsAndUsers(ctx, []uuid.UUID{teamID1, teamID2}, []uuid.UUID{userID1, userID2})
+
+				_, err := repo.SaveTeamMembershipsBatch(ctx, []TeamMembershipIn{
+					{UserID: userID1, TeamID: teamID1, IsPrimary: true},
+					{UserID: userID1, TeamID: teamID2, IsPrimary: false},
+					{UserID: userID2, TeamID: teamID1, IsPrimary: true},
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]TeamMembershipOut) {
+				assert.NotNil(t, result)
+				assert.Len(t, *result, 2)
+
+				teamIDs := make([]uuid.UUID, 0, len(*result))
+				for _, membership := range *result {
+					assert.Equal(t, userID1, membership.UserID)
+					teamIDs = append(teamIDs, membership.TeamID)
+				}
+				assert.ElementsMatch(t, []uuid.UUID{teamID1, teamID2}, teamIDs)
+			},
+		},
+		{
+			name:     "GetTeamMembershipsByUserIDs with empty input returns empty result",
+			input:    []uuid.UUID{},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]TeamMembershipOut) {
+				assert.NotNil(t, result)
+				assert.Empty(t, *result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.GetTeamMembershipsByUserIDs(ctx, tt.input)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *TeamMembershipsTest) TestSetPrimaryTeamMembership() {
+	teamID1 := uuid.New()
+	teamID2 := uuid.New()
+	teamID3 := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name        string
+		teamID      uuid.UUID
+		setup       func(ctx context.Context, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, ctx context.Context, repo *Repository, result *TeamMembershipOut)
+	}{
+		{
+			name:   "successful SetPrimaryTeamMembership replaces primary and keeps other memberships",
+			teamID: teamID2,
+			setup: func(ctx context.Context, repo *Repository) {
+				s.seedTeamsAndUsers(ctx, []uuid.UUID{teamID1, teamID2, teamID3}, []uuid.UUID{userID})
+				_, err := repo.SaveTeamMembershipsBatch(ctx, []TeamMembershipIn{
+					{UserID: userID, TeamID: teamID1, IsPrimary: true},
+					{UserID: userID, TeamID: teamID3, IsPrimary: false},
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, ctx context.Context, repo *Repository, result *TeamMembershipOut) {
+				assert.NotNil(t, result)
+				assert.Equal(t, teamID2, result.TeamID)
+				assert.True(t, result.IsPrimary)
+
+				memberships, err := repo.GetTeamMembershipsByUserIDs(ctx, []uuid.UUID{userID})
+				assert.NoError(t, err)
+				teamsByPrimary := make(map[uuid.UUID]bool)
+				for _, membership := range *memberships {
+					teamsByPrimary[membership.TeamID] = membership.IsPrimary
+				}
+				assert.Equal(t, map[uuid.UUID]bool{teamID2: true, teamID3: false}, teamsByPrimary)
+			},
+		},
+		{
+			name:   "SetPrimaryTeamMembership promotes existing additional membership",
+			teamID: teamID3,
+			setup: func(ctx context.Context, repo *Repository) {
+				s.seedTeamsAndUsers(ctx, []uuid.UUID{teamID1, teamID2, teamID3}, []uuid.UUID{userID})
+				_, err := repo.SaveTeamMembershipsBatch(ctx, []TeamMembershipIn{
+					{UserID: userID, TeamID: teamID1, IsPrimary: true},
+					{UserID: userID, TeamID: teamID3, IsPrimary: false},
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, ctx context.Context, repo *Repository, result *TeamMembershipOut) {
+				assert.NotNil(t, result)
+				assert.Equal(t, teamID3, result.TeamID)
+
+				memberships, err := repo.GetTeamMembershipsByUserIDs(ctx, []uuid.UUID{userID})
+				assert.NoError(t, err)
+				if assert.Len(t, *memberships, 1) {
+					assert.Equal(t, teamID3, (*memberships)[0].TeamID)
+					assert.True(t, (*memberships)[0].IsPrimary)
+				}
+			},
+		},
+		{
+			name:   "SetPrimaryTeamMembership with non-existent team returns error",
+			teamID: uuid.New(),
+			setup: func(ctx context.Context, repo *Repository) {
+				s.seedTeamsAndUsers(ctx, []uuid.UUID{teamID1}, []uuid.UUID{userID})
+			},
+			checkErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.SetPrimaryTeamMembership(ctx, userID, tt.teamID)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, ctx, repo, result)
+			}
+		})
+	}
+}
+
+func (s *TeamMembershipsTest) TestDeleteTeamMembership() {
+	s.SetupTest()
+
+	ctx := context.Background()
+	teamID1 := uuid.New()
+	teamID2 := uuid.New()
+	userID := uuid.New()
+	repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+	s.seedTeamsAndUsers(ctx, []uuid.UUID{teamID1, teamID2}, []uuid.UUID{userID})
+	_, err := repo.SaveTeamMembershipsBatch(ctx, []TeamMembershipIn{
+		{UserID: userID, TeamID: teamID1, IsPrimary: true},
+		{UserID: userID, TeamID: teamID2, IsPrimary: false},
+	})
+	assert.NoError(s.T(), err)
+
+	assert.NoError(s.T(), repo.DeleteTeamMembership(ctx, userID, teamID2))
+	assert.NoError(s.T(), repo.DeleteTeamMembership(ctx, userID, teamID1))
+
+	memberships, err := repo.GetTeamMembershipsByUserIDs(ctx, []uuid.UUID{userID})
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), *memberships, 1) {
+		assert.Equal(s.T(), teamID1, (*memberships)[0].TeamID)
+		assert.True(s.T(), (*memberships)[0].IsPrimary)
+	}
+}
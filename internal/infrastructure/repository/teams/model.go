@@ -7,19 +7,40 @@ import (
 )
 
 type TeamIn struct {
-	ID        uuid.UUID
-	Name      string
-	CreatedAt time.Time
+	ID           uuid.UUID
+	Name         string
+	ParentTeamID *uuid.UUID
+	CreatedAt    time.Time
 }
 
 type TeamOut struct {
-	ID        uuid.UUID
-	Name      string
-	CreatedAt time.Time
+	ID           uuid.UUID
+	Name         string
+	ParentTeamID *uuid.UUID
+	ArchivedAt   *time.Time
+	CreatedAt    time.Time
 }
 
 type teamDB struct {
-	ID        uuid.UUID `db:"id"`
-	Name      string    `db:"name"`
-	CreatedAt time.Time `db:"created_at"`
+	ID           uuid.UUID  `db:"id"`
+	Name         string     `db:"name"`
+	ParentTeamID *uuid.UUID `db:"parent_team_id"`
+	ArchivedAt   *time.Time `db:"archived_at"`
+	CreatedAt    time.Time  `db:"created_at"`
+}
+
+type TeamLoadOut struct {
+	TeamID                        uuid.UUID
+	MembersCount                  int
+	ActiveMembersCount            int
+	OpenPullRequestsCount         int
+	ActiveMembersOpenReviewsCount int
+}
+
+type teamLoadDB struct {
+	TeamID                        uuid.UUID `db:"team_id"`
+	MembersCount                  int64     `db:"members_count"`
+	ActiveMembersCount            int64     `db:"active_members_count"`
+	OpenPullRequestsCount         int64     `db:"open_pull_requests_count"`
+	ActiveMembersOpenReviewsCount int64     `db:"active_members_open_reviews_count"`
 }
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"pr-reviewers-service/internal/infrastructure/repository"
 	nower2 "pr-reviewers-service/internal/usecase/contract/nower"
@@ -13,18 +15,51 @@ import (
 	trm "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 const (
-	teamsTableName      = "teams"
-	idColumnName        = "id"
-	nameColumnName      = "name"
-	createdAtColumnName = "created_at"
+	uniqueViolationCode = "23505"
+
+	teamsTableName         = "teams"
+	idColumnName           = "id"
+	nameColumnName         = "name"
+	parentTeamIdColumnName = "parent_team_id"
+	archivedAtColumnName   = "archived_at"
+	createdAtColumnName    = "created_at"
 
 	returnAll = "RETURNING *"
 )
 
+// Subqueries computing the load of a team row. A member is a user whose primary team is the team or who holds an
+// additional membership in it; open PRs are counted for authors whose primary team is the team.
+const (
+	teamMemberCondition = "(u.team_id = teams.id OR u.id IN " +
+		"(SELECT tm.user_id FROM team_memberships tm WHERE tm.team_id = teams.id))"
+	membersCountQuery       = "(SELECT COUNT(*) FROM users u WHERE " + teamMemberCondition + ")"
+	activeMembersCountQuery = "(SELECT COUNT(*) FROM users u WHERE " + teamMemberCondition + " AND u.is_active)"
+	openPullRequestsQuery   = "(SELECT COUNT(*) FROM pull_requests p " +
+		"JOIN pr_statuses s ON s.id = p.status_id " +
+		"JOIN users u ON u.id = p.author_id " +
+		"WHERE u.team_id = teams.id AND s.status = ?)"
+	activeMembersOpenReviewsQuery = "(SELECT COUNT(*) FROM pr_reviewers r " +
+		"JOIN pull_requests p ON p.id = r.pr_id " +
+		"JOIN pr_statuses s ON s.id = p.status_id " +
+		"JOIN users u ON u.id = r.reviewer_id " +
+		"WHERE " + teamMemberCondition + " AND u.is_active AND s.status = ?)"
+)
+
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// namePrefixCondition matches not archived teams whose name starts with namePrefix, ignoring case.
+func namePrefixCondition(namePrefix string) squirrel.Sqlizer {
+	return squirrel.And{
+		squirrel.Eq{archivedAtColumnName: nil},
+		squirrel.ILike{nameColumnName: likePatternEscaper.Replace(namePrefix) + "%"},
+	}
+}
+
 type Repository struct {
 	db    *pgxpool.Pool
 	nower nower2.Nower
@@ -44,8 +79,8 @@ func (r *Repository) SaveTeam(ctx context.Context, team TeamIn) (*TeamOut, error
 
 	queryBuilder := squirrel.Insert(teamsTableName).
 		PlaceholderFormat(squirrel.Dollar).
-		Columns(idColumnName, nameColumnName, createdAtColumnName).
-		Values(team.ID, team.Name, team.CreatedAt).
+		Columns(idColumnName, nameColumnName, parentTeamIdColumnName, createdAtColumnName).
+		Values(team.ID, team.Name, team.ParentTeamID, team.CreatedAt).
 		Suffix(returnAll)
 
 	sql, args, err := queryBuilder.ToSql()
@@ -70,15 +105,16 @@ func (r *Repository) SaveTeam(ctx context.Context, team TeamIn) (*TeamOut, error
 
 	slog.DebugContext(ctx, "Repository SaveTeam success")
 	return &TeamOut{
-		ID:        team.ID,
-		Name:      team.Name,
-		CreatedAt: team.CreatedAt,
+		ID:           team.ID,
+		Name:         team.Name,
+		ParentTeamID: team.ParentTeamID,
+		CreatedAt:    team.CreatedAt,
 	}, nil
 }
 
 func (r *Repository) GetTeamByID(ctx context.Context, teamId uuid.UUID) (*TeamOut, error) {
 	selectBuilder := squirrel.
-		Select(idColumnName, nameColumnName, createdAtColumnName).
+		Select(idColumnName, nameColumnName, parentTeamIdColumnName, archivedAtColumnName, createdAtColumnName).
 		PlaceholderFormat(squirrel.Dollar).
 		From(teamsTableName).
 		Where(squirrel.Eq{idColumnName: teamId})
@@ -108,15 +144,17 @@ func (r *Repository) GetTeamByID(ctx context.Context, teamId uuid.UUID) (*TeamOu
 
 	slog.DebugContext(ctx, "Repository GetTeamByID success")
 	return &TeamOut{
-		ID:        result.ID,
-		Name:      result.Name,
-		CreatedAt: result.CreatedAt,
+		ID:           result.ID,
+		Name:         result.Name,
+		ParentTeamID: result.ParentTeamID,
+		ArchivedAt:   result.ArchivedAt,
+		CreatedAt:    result.CreatedAt,
 	}, nil
 }
 
 func (r *Repository) GetTeamByName(ctx context.Context, name string) (*TeamOut, error) {
 	selectBuilder := squirrel.
-		Select(idColumnName, nameColumnName, createdAtColumnName).
+		Select(idColumnName, nameColumnName, parentTeamIdColumnName, archivedAtColumnName, createdAtColumnName).
 		PlaceholderFormat(squirrel.Dollar).
 		From(teamsTableName).
 		Where(squirrel.Eq{nameColumnName: name})
@@ -146,8 +184,375 @@ func (r *Repository) GetTeamByName(ctx context.Context, name string) (*TeamOut,
 
 	slog.DebugContext(ctx, "Repository GetTeamByName success")
 	return &TeamOut{
-		ID:        result.ID,
-		Name:      result.Name,
-		CreatedAt: result.CreatedAt,
+		ID:           result.ID,
+		Name:         result.Name,
+		ParentTeamID: result.ParentTeamID,
+		ArchivedAt:   result.ArchivedAt,
+		CreatedAt:    result.CreatedAt,
+	}, nil
+}
+
+// GetAllTeams returns teams that are not archived.
+func (r *Repository) GetAllTeams(ctx context.Context) (*[]TeamOut, error) {
+	selectBuilder := squirrel.
+		Select(idColumnName, nameColumnName, parentTeamIdColumnName, archivedAtColumnName, createdAtColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(teamsTableName).
+		Where(squirrel.Eq{archivedAtColumnName: nil}).
+		OrderBy(nameColumnName)
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[teamDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	teams := make([]TeamOut, 0, len(results))
+	for _, result := range results {
+		teams = append(teams, TeamOut{
+			ID:           result.ID,
+			Name:         result.Name,
+			ParentTeamID: result.ParentTeamID,
+			ArchivedAt:   result.ArchivedAt,
+			CreatedAt:    result.CreatedAt,
+		})
+	}
+
+	slog.DebugContext(ctx, "Repository GetAllTeams success", "count", len(teams))
+	return &teams, nil
+}
+
+// GetAllTeamsWithArchived returns every team ordered by name, archived ones included.
+func (r *Repository) GetAllTeamsWithArchived(ctx context.Context) (*[]TeamOut, error) {
+	selectBuilder := squirrel.
+		Select(idColumnName, nameColumnName, parentTeamIdColumnName, archivedAtColumnName, createdAtColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(teamsTableName).
+		OrderBy(nameColumnName)
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[teamDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	teams := make([]TeamOut, 0, len(results))
+	for _, result := range results {
+		teams = append(teams, TeamOut{
+			ID:           result.ID,
+			Name:         result.Name,
+			ParentTeamID: result.ParentTeamID,
+			ArchivedAt:   result.ArchivedAt,
+			CreatedAt:    result.CreatedAt,
+		})
+	}
+
+	slog.DebugContext(ctx, "Repository GetAllTeamsWithArchived success", "count", len(teams))
+	return &teams, nil
+}
+
+// UpdateTeamParent sets the parent of the team, a nil parentTeamID makes the team a root.
+func (r *Repository) UpdateTeamParent(ctx context.Context, teamID uuid.UUID, parentTeamID *uuid.UUID) (*TeamOut, error) {
+	queryBuilder := squirrel.Update(teamsTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Set(parentTeamIdColumnName, parentTeamID).
+		Where(squirrel.Eq{idColumnName: teamID}).
+		Suffix(returnAll)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[teamDB])
+	if err != nil {
+		slog.DebugContext(ctx, err.Error())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %v", repository.ErrTeamNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository UpdateTeamParent success")
+	return &TeamOut{
+		ID:           result.ID,
+		Name:         result.Name,
+		ParentTeamID: result.ParentTeamID,
+		ArchivedAt:   result.ArchivedAt,
+		CreatedAt:    result.CreatedAt,
+	}, nil
+}
+
+// UpdateTeamName renames the team, the name must stay unique.
+func (r *Repository) UpdateTeamName(ctx context.Context, teamID uuid.UUID, name string) (*TeamOut, error) {
+	queryBuilder := squirrel.Update(teamsTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Set(nameColumnName, name).
+		Where(squirrel.Eq{idColumnName: teamID}).
+		Suffix(returnAll)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[teamDB])
+	if err != nil {
+		slog.DebugContext(ctx, err.Error())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %v", repository.ErrTeamNotFound, err)
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return nil, fmt.Errorf("%w: %v", repository.ErrTeamAlreadyExists, err)
+		}
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository UpdateTeamName success")
+	return &TeamOut{
+		ID:           result.ID,
+		Name:         result.Name,
+		ParentTeamID: result.ParentTeamID,
+		ArchivedAt:   result.ArchivedAt,
+		CreatedAt:    result.CreatedAt,
 	}, nil
 }
+
+// SetTeamArchived marks the team archived at the current time or clears the mark.
+func (r *Repository) SetTeamArchived(ctx context.Context, teamID uuid.UUID, archived bool) (*TeamOut, error) {
+	var archivedAt *time.Time
+	if archived {
+		now := r.nower.Now()
+		archivedAt = &now
+	}
+
+	queryBuilder := squirrel.Update(teamsTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Set(archivedAtColumnName, archivedAt).
+		Where(squirrel.Eq{idColumnName: teamID}).
+		Suffix(returnAll)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[teamDB])
+	if err != nil {
+		slog.DebugContext(ctx, err.Error())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %v", repository.ErrTeamNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository SetTeamArchived success", "archived", archived)
+	return &TeamOut{
+		ID:           result.ID,
+		Name:         result.Name,
+		ParentTeamID: result.ParentTeamID,
+		ArchivedAt:   result.ArchivedAt,
+		CreatedAt:    result.CreatedAt,
+	}, nil
+}
+
+// DeleteTeamByID removes the team. Its primary members must be moved or deleted first, the database refuses to
+// delete a team that is still primary for someone.
+func (r *Repository) DeleteTeamByID(ctx context.Context, teamID uuid.UUID) error {
+	queryBuilder := squirrel.Delete(teamsTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{idColumnName: teamID})
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	tag, err := q.Exec(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: %s", repository.ErrTeamNotFound, teamID)
+	}
+
+	slog.DebugContext(ctx, "Repository DeleteTeamByID success")
+	return nil
+}
+
+// GetTeamsPage returns not archived teams whose name starts with namePrefix, ordered by name.
+func (r *Repository) GetTeamsPage(ctx context.Context, namePrefix string, limit, offset uint64) (*[]TeamOut, error) {
+	selectBuilder := squirrel.
+		Select(idColumnName, nameColumnName, parentTeamIdColumnName, archivedAtColumnName, createdAtColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(teamsTableName).
+		Where(namePrefixCondition(namePrefix)).
+		OrderBy(nameColumnName).
+		Limit(limit).
+		Offset(offset)
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[teamDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	teams := make([]TeamOut, 0, len(results))
+	for _, result := range results {
+		teams = append(teams, TeamOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository GetTeamsPage success", "count", len(teams))
+	return &teams, nil
+}
+
+// CountTeams returns the number of not archived teams whose name starts with namePrefix.
+func (r *Repository) CountTeams(ctx context.Context, namePrefix string) (int, error) {
+	selectBuilder := squirrel.
+		Select("COUNT(*)").
+		PlaceholderFormat(squirrel.Dollar).
+		From(teamsTableName).
+		Where(namePrefixCondition(namePrefix))
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return 0, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	var cnt int
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	err = q.QueryRow(ctx, sql, args...).Scan(&cnt)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return 0, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+
+	slog.DebugContext(ctx, "Repository CountTeams success", "count", cnt)
+	return cnt, nil
+}
+
+// GetTeamsLoad returns member counts and review load of the given teams. PRs are considered open when their status
+// equals openStatus.
+func (r *Repository) GetTeamsLoad(ctx context.Context, teamIDs []uuid.UUID, openStatus string) (*[]TeamLoadOut, error) {
+	if len(teamIDs) == 0 {
+		return &[]TeamLoadOut{}, nil
+	}
+
+	selectBuilder := squirrel.
+		Select(idColumnName + " AS team_id").
+		Column(squirrel.Alias(squirrel.Expr(membersCountQuery), "members_count")).
+		Column(squirrel.Alias(squirrel.Expr(activeMembersCountQuery), "active_members_count")).
+		Column(squirrel.Alias(squirrel.Expr(openPullRequestsQuery, openStatus), "open_pull_requests_count")).
+		Column(squirrel.Alias(squirrel.Expr(activeMembersOpenReviewsQuery, openStatus), "active_members_open_reviews_count")).
+		PlaceholderFormat(squirrel.Dollar).
+		From(teamsTableName).
+		Where(squirrel.Eq{idColumnName: teamIDs})
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[teamLoadDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	loads := make([]TeamLoadOut, 0, len(results))
+	for _, result := range results {
+		loads = append(loads, TeamLoadOut{
+			TeamID:                        result.TeamID,
+			MembersCount:                  int(result.MembersCount),
+			ActiveMembersCount:            int(result.ActiveMembersCount),
+			OpenPullRequestsCount:         int(result.OpenPullRequestsCount),
+			ActiveMembersOpenReviewsCount: int(result.ActiveMembersOpenReviewsCount),
+		})
+	}
+
+	slog.DebugContext(ctx, "Repository GetTeamsLoad success", "count", len(loads))
+	return &loads, nil
+}
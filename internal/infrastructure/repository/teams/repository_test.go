@@ -6,6 +6,7 @@ import (
 	"time"
 
 	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/repository"
 	suite2 "pr-reviewers-service/test/suite"
 
 	"github.com/google/uuid"
@@ -295,3 +296,579 @@ func (s *TeamsTest) TestGetTeamByName() {
 		})
 	}
 }
+
+func (s *TeamsTest) TestGetAllTeams() {
+	rootID := uuid.New()
+	childID := uuid.New()
+
+	tests := []struct {
+		name        string
+		setup       func(ctx context.Context, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *[]TeamOut)
+	}{
+		{
+			name: "successful GetAllTeams returns teams ordered by name with parents",
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveTeam(ctx, TeamIn{
+					ID:   rootID,
+					Name: "platform",
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SaveTeam(ctx, TeamIn{
+					ID:           childID,
+					Name:         "platform-db",
+					ParentTeamID: &rootID,
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]TeamOut) {
+				assert.NotNil(t, result)
+				assert.Len(t, *result, 2)
+				assert.Equal(t, rootID, (*result)[0].ID)
+				assert.Nil(t, (*result)[0].ParentTeamID)
+				assert.Equal(t, childID, (*result)[1].ID)
+				if assert.NotNil(t, (*result)[1].ParentTeamID) {
+					assert.Equal(t, rootID, *(*result)[1].ParentTeamID)
+				}
+			},
+		},
+		{
+			name: "GetAllTeams skips archived teams",
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveTeam(ctx, TeamIn{ID: rootID, Name: "platform"})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SaveTeam(ctx, TeamIn{ID: childID, Name: "platform-db"})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SetTeamArchived(ctx, childID, true)
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]TeamOut) {
+				assert.NotNil(t, result)
+				assert.Len(t, *result, 1)
+				assert.Equal(t, rootID, (*result)[0].ID)
+			},
+		},
+		{
+			name:     "GetAllTeams with no teams returns empty result",
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]TeamOut) {
+				assert.NotNil(t, result)
+				assert.Empty(t, *result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.GetAllTeams(ctx)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *TeamsTest) TestGetAllTeamsWithArchived() {
+	s.SetupTest()
+
+	ctx := context.Background()
+	repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+	activeID := uuid.New()
+	archivedID := uuid.New()
+
+	_, err := repo.SaveTeam(ctx, TeamIn{ID: activeID, Name: "platform"})
+	assert.NoError(s.T(), err)
+	_, err = repo.SaveTeam(ctx, TeamIn{ID: archivedID, Name: "legacy"})
+	assert.NoError(s.T(), err)
+	_, err = repo.SetTeamArchived(ctx, archivedID, true)
+	assert.NoError(s.T(), err)
+
+	result, err := repo.GetAllTeamsWithArchived(ctx)
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), *result, 2) {
+		assert.Equal(s.T(), archivedID, (*result)[0].ID)
+		assert.NotNil(s.T(), (*result)[0].ArchivedAt)
+		assert.Equal(s.T(), activeID, (*result)[1].ID)
+		assert.Nil(s.T(), (*result)[1].ArchivedAt)
+	}
+}
+
+func (s *TeamsTest) TestUpdateTeamParent() {
+	parentID := uuid.New()
+	teamID := uuid.New()
+
+	tests := []struct {
+		name         string
+		teamID       uuid.UUID
+		parentTeamID *uuid.UUID
+		setup        func(ctx context.Context, repo *Repository)
+		checkErr     assert.ErrorAssertionFunc
+		checkResult  func(t *testing.T, result *TeamOut)
+	}{
+		{
+			name:         "successful UpdateTeamParent sets parent",
+			teamID:       teamID,
+			parentTeamID: &parentID,
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveTeam(ctx, TeamIn{ID: parentID, Name: "platform"})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SaveTeam(ctx, TeamIn{ID: teamID, Name: "platform-db"})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *TeamOut) {
+				assert.NotNil(t, result)
+				if assert.NotNil(t, result.ParentTeamID) {
+					assert.Equal(t, parentID, *result.ParentTeamID)
+				}
+			},
+		},
+		{
+			name:         "UpdateTeamParent with nil parent detaches team",
+			teamID:       teamID,
+			parentTeamID: nil,
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveTeam(ctx, TeamIn{ID: parentID, Name: "platform"})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SaveTeam(ctx, TeamIn{ID: teamID, Name: "platform-db", ParentTeamID: &parentID})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *TeamOut) {
+				assert.NotNil(t, result)
+				assert.Nil(t, result.ParentTeamID)
+			},
+		},
+		{
+			name:         "UpdateTeamParent with team as its own parent returns error",
+			teamID:       teamID,
+			parentTeamID: &teamID,
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveTeam(ctx, TeamIn{ID: teamID, Name: "platform-db"})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.Error,
+			checkResult: func(t *testing.T, result *TeamOut) {
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name:         "UpdateTeamParent with non-existent team returns not found error",
+			teamID:       uuid.New(),
+			parentTeamID: nil,
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrTeamNotFound)
+			},
+			checkResult: func(t *testing.T, result *TeamOut) {
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.UpdateTeamParent(ctx, tt.teamID, tt.parentTeamID)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *TeamsTest) TestUpdateTeamName() {
+	teamID := uuid.New()
+
+	tests := []struct {
+		name        string
+		teamID      uuid.UUID
+		newName     string
+		setup       func(ctx context.Context, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *TeamOut)
+	}{
+		{
+			name:    "successful UpdateTeamName",
+			teamID:  teamID,
+			newName: "payments",
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveTeam(ctx, TeamIn{ID: teamID, Name: "billing"})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *TeamOut) {
+				assert.NotNil(t, result)
+				assert.Equal(t, teamID, result.ID)
+				assert.Equal(t, "payments", result.Name)
+			},
+		},
+		{
+			name:    "UpdateTeamName with taken name returns already exists error",
+			teamID:  teamID,
+			newName: "payments",
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveTeam(ctx, TeamIn{ID: teamID, Name: "billing"})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SaveTeam(ctx, TeamIn{Name: "payments"})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrTeamAlreadyExists)
+			},
+			checkResult: func(t *testing.T, result *TeamOut) {
+				assert.Nil(t, result)
+			},
+		},
+		{
+			name:    "UpdateTeamName with non-existent team returns not found error",
+			teamID:  uuid.New(),
+			newName: "payments",
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrTeamNotFound)
+			},
+			checkResult: func(t *testing.T, result *TeamOut) {
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.UpdateTeamName(ctx, tt.teamID, tt.newName)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *TeamsTest) TestSetTeamArchived() {
+	teamID := uuid.New()
+
+	tests := []struct {
+		name        string
+		teamID      uuid.UUID
+		archived    bool
+		setup       func(ctx context.Context, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *TeamOut)
+	}{
+		{
+			name:     "successful SetTeamArchived archives team",
+			teamID:   teamID,
+			archived: true,
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveTeam(ctx, TeamIn{ID: teamID, Name: "billing"})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *TeamOut) {
+				assert.NotNil(t, result)
+				assert.NotNil(t, result.ArchivedAt)
+			},
+		},
+		{
+			name:     "SetTeamArchived with false restores team",
+			teamID:   teamID,
+			archived: false,
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveTeam(ctx, TeamIn{ID: teamID, Name: "billing"})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SetTeamArchived(ctx, teamID, true)
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *TeamOut) {
+				assert.NotNil(t, result)
+				assert.Nil(t, result.ArchivedAt)
+			},
+		},
+		{
+			name:     "SetTeamArchived with non-existent team returns not found error",
+			teamID:   uuid.New(),
+			archived: true,
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrTeamNotFound)
+			},
+			checkResult: func(t *testing.T, result *TeamOut) {
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.SetTeamArchived(ctx, tt.teamID, tt.archived)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *TeamsTest) TestDeleteTeamByID() {
+	teamID := uuid.New()
+
+	tests := []struct {
+		name     string
+		teamID   uuid.UUID
+		setup    func(ctx context.Context, repo *Repository)
+		checkErr assert.ErrorAssertionFunc
+		check    func(t *testing.T, ctx context.Context, repo *Repository)
+	}{
+		{
+			name:   "successful DeleteTeamByID",
+			teamID: teamID,
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveTeam(ctx, TeamIn{ID: teamID, Name: "billing"})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			check: func(t *testing.T, ctx context.Context, repo *Repository) {
+				_, err := repo.GetTeamByID(ctx, teamID)
+				assert.ErrorIs(t, err, repository.ErrTeamNotFound)
+			},
+		},
+		{
+			name:   "DeleteTeamByID with non-existent team returns not found error",
+			teamID: uuid.New(),
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrTeamNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			err := repo.DeleteTeamByID(ctx, tt.teamID)
+			tt.checkErr(t, err)
+			if tt.check != nil {
+				tt.check(t, ctx, repo)
+			}
+		})
+	}
+}
+
+func (s *TeamsTest) TestGetTeamsPage() {
+	tests := []struct {
+		name       string
+		namePrefix string
+		limit      uint64
+		offset     uint64
+		setup      func(ctx context.Context, repo *Repository)
+		checkErr   assert.ErrorAssertionFunc
+		expected   []string
+	}{
+		{
+			name:   "GetTeamsPage returns page of teams ordered by name",
+			limit:  2,
+			offset: 1,
+			setup: func(ctx context.Context, repo *Repository) {
+				for _, name := range []string{"delta", "alpha", "charlie", "bravo"} {
+					_, err := repo.SaveTeam(ctx, TeamIn{Name: name})
+					assert.NoError(s.T(), err)
+				}
+			},
+			checkErr: assert.NoError,
+			expected: []string{"bravo", "charlie"},
+		},
+		{
+			name:       "GetTeamsPage filters by case insensitive name prefix and skips archived teams",
+			namePrefix: "BACK",
+			limit:      10,
+			setup: func(ctx context.Context, repo *Repository) {
+				for _, name := range []string{"backend", "backoffice", "frontend"} {
+					_, err := repo.SaveTeam(ctx, TeamIn{Name: name})
+					assert.NoError(s.T(), err)
+				}
+				archived, err := repo.SaveTeam(ctx, TeamIn{Name: "backup"})
+				assert.NoError(s.T(), err)
+				_, err = repo.SetTeamArchived(ctx, archived.ID, true)
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			expected: []string{"backend", "backoffice"},
+		},
+		{
+			name:       "GetTeamsPage treats LIKE wildcards in prefix literally",
+			namePrefix: "a_",
+			limit:      10,
+			setup: func(ctx context.Context, repo *Repository) {
+				for _, name := range []string{"a_team", "ab_team"} {
+					_, err := repo.SaveTeam(ctx, TeamIn{Name: name})
+					assert.NoError(s.T(), err)
+				}
+			},
+			checkErr: assert.NoError,
+			expected: []string{"a_team"},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.GetTeamsPage(ctx, tt.namePrefix, tt.limit, tt.offset)
+			tt.checkErr(t, err)
+			names := make([]string, 0, len(*result))
+			for _, team := range *result {
+				names = append(names, team.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+
+			total, err := repo.CountTeams(ctx, tt.namePrefix)
+			assert.NoError(t, err)
+			assert.GreaterOrEqual(t, total, len(names))
+		})
+	}
+}
+
+func (s *TeamsTest) TestCountTeams() {
+	ctx := context.Background()
+	s.SetupTest()
+	repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+	for _, name := range []string{"backend", "backoffice", "frontend"} {
+		_, err := repo.SaveTeam(ctx, TeamIn{Name: name})
+		assert.NoError(s.T(), err)
+	}
+
+	total, err := repo.CountTeams(ctx, "")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 3, total)
+
+	total, err = repo.CountTeams(ctx, "back")
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 2, total)
+}
+
+func (s *TeamsTest) TestGetTeamsLoad() {
+	teamID := uuid.New()
+	otherTeamID := uuid.New()
+	activeID := uuid.New()
+	inactiveID := uuid.New()
+	additionalID := uuid.New()
+	openStatusID := uuid.New()
+	mergedStatusID := uuid.New()
+	openPRID := uuid.New()
+	mergedPRID := uuid.New()
+	now := time.Now()
+
+	ctx := context.Background()
+	s.SetupTest()
+	repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+	_, err := repo.SaveTeam(ctx, TeamIn{ID: teamID, Name: "backend"})
+	assert.NoError(s.T(), err)
+	_, err = repo.SaveTeam(ctx, TeamIn{ID: otherTeamID, Name: "frontend"})
+	assert.NoError(s.T(), err)
+
+	seed := []struct {
+		query string
+		args  []any
+	}{
+		{"INSERT INTO users (id, name, is_active, team_id, created_at) VALUES ($1, 'active', TRUE, $2, $3)",
+			[]any{activeID, teamID, now}},
+		{"INSERT INTO users (id, name, is_active, team_id, created_at) VALUES ($1, 'inactive', FALSE, $2, $3)",
+			[]any{inactiveID, teamID, now}},
+		{"INSERT INTO users (id, name, is_active, team_id, created_at) VALUES ($1, 'additional', TRUE, $2, $3)",
+			[]any{additionalID, otherTeamID, now}},
+		{"INSERT INTO team_memberships (id, user_id, team_id, is_primary, created_at) VALUES ($1, $2, $3, FALSE, $4)",
+			[]any{uuid.New(), additionalID, teamID, now}},
+		{"INSERT INTO pr_statuses (id, status) VALUES ($1, 'OPEN'), ($2, 'MERGED')",
+			[]any{openStatusID, mergedStatusID}},
+		{"INSERT INTO pull_requests (id, name, author_id, status_id, created_at) VALUES ($1, 'open', $2, $3, $4)",
+			[]any{openPRID, activeID, openStatusID, now}},
+		{"INSERT INTO pull_requests (id, name, author_id, status_id, created_at) VALUES ($1, 'merged', $2, $3, $4)",
+			[]any{mergedPRID, activeID, mergedStatusID, now}},
+		{"INSERT INTO pr_reviewers (id, pr_id, reviewer_id) VALUES ($1, $2, $3), ($4, $2, $5)",
+			[]any{uuid.New(), openPRID, additionalID, uuid.New(), inactiveID}},
+		{"INSERT INTO pr_reviewers (id, pr_id, reviewer_id) VALUES ($1, $2, $3)",
+			[]any{uuid.New(), mergedPRID, additionalID}},
+	}
+	for _, step := range seed {
+		_, err = suite2.GlobalPool.Exec(ctx, step.query, step.args...)
+		assert.NoError(s.T(), err)
+	}
+
+	result, err := repo.GetTeamsLoad(ctx, []uuid.UUID{teamID}, "OPEN")
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), *result, 1) {
+		assert.Equal(s.T(), TeamLoadOut{
+			TeamID:                        teamID,
+			MembersCount:                  3,
+			ActiveMembersCount:            2,
+			OpenPullRequestsCount:         1,
+			ActiveMembersOpenReviewsCount: 1,
+		}, (*result)[0])
+	}
+
+	empty, err := repo.GetTeamsLoad(ctx, []uuid.UUID{}, "OPEN")
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), *empty)
+}
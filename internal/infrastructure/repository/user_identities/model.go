@@ -0,0 +1,31 @@
+package user_identities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserIdentityIn struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Provider   string
+	ExternalID string
+	CreatedAt  time.Time
+}
+
+type UserIdentityOut struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Provider   string
+	ExternalID string
+	CreatedAt  time.Time
+}
+
+type userIdentityDB struct {
+	ID         uuid.UUID `db:"id"`
+	UserID     uuid.UUID `db:"user_id"`
+	Provider   string    `db:"provider"`
+	ExternalID string    `db:"external_id"`
+	CreatedAt  time.Time `db:"created_at"`
+}
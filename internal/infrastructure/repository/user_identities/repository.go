@@ -0,0 +1,203 @@
+package user_identities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	nower2 "pr-reviewers-service/internal/usecase/contract/nower"
+
+	"github.com/Masterminds/squirrel"
+	trm "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	uniqueViolationCode = "23505"
+
+	userIdentitiesTableName = "user_identities"
+	idColumnName            = "id"
+	userIdColumnName        = "user_id"
+	providerColumnName      = "provider"
+	externalIdColumnName    = "external_id"
+	createdAtColumnName     = "created_at"
+
+	returnAll = "RETURNING *"
+)
+
+type Repository struct {
+	db    *pgxpool.Pool
+	nower nower2.Nower
+}
+
+func NewRepository(pool *pgxpool.Pool, nower nower2.Nower) *Repository {
+	return &Repository{db: pool, nower: nower}
+}
+
+// SaveUserIdentitiesBatch inserts identities, an identity that is already linked to any user fails the whole batch
+// with ErrIdentityExists.
+func (r *Repository) SaveUserIdentitiesBatch(ctx context.Context, identities []UserIdentityIn) (*[]UserIdentityOut, error) {
+	if len(identities) == 0 {
+		return &[]UserIdentityOut{}, nil
+	}
+
+	queryBuilder := squirrel.Insert(userIdentitiesTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Columns(idColumnName, userIdColumnName, providerColumnName, externalIdColumnName, createdAtColumnName)
+
+	now := r.nower.Now()
+	for _, identity := range identities {
+		identityID := identity.ID
+		if identityID == uuid.Nil {
+			identityID = uuid.New()
+		}
+
+		queryBuilder = queryBuilder.Values(identityID, identity.UserID, identity.Provider, identity.ExternalID, now)
+	}
+	queryBuilder = queryBuilder.Suffix(returnAll)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[userIdentityDB])
+	if err != nil {
+		slog.DebugContext(ctx, err.Error())
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return nil, fmt.Errorf("%w: %v", repository.ErrIdentityExists, err)
+		}
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	identityOuts := make([]UserIdentityOut, 0, len(results))
+	for _, result := range results {
+		identityOuts = append(identityOuts, UserIdentityOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository SaveUserIdentitiesBatch success", "count", len(identityOuts))
+	return &identityOuts, nil
+}
+
+func (r *Repository) GetUserIdentity(ctx context.Context, provider, externalID string) (*UserIdentityOut, error) {
+	selectBuilder := squirrel.
+		Select(idColumnName, userIdColumnName, providerColumnName, externalIdColumnName, createdAtColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(userIdentitiesTableName).
+		Where(squirrel.Eq{
+			providerColumnName:   provider,
+			externalIdColumnName: externalID,
+		})
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[userIdentityDB])
+	if err != nil {
+		slog.DebugContext(ctx, err.Error())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %v", repository.ErrIdentityNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository GetUserIdentity success")
+	identity := UserIdentityOut(result)
+	return &identity, nil
+}
+
+func (r *Repository) GetUserIdentitiesByUserIDs(ctx context.Context, userIDs []uuid.UUID) (*[]UserIdentityOut, error) {
+	if len(userIDs) == 0 {
+		return &[]UserIdentityOut{}, nil
+	}
+
+	selectBuilder := squirrel.
+		Select(idColumnName, userIdColumnName, providerColumnName, externalIdColumnName, createdAtColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(userIdentitiesTableName).
+		Where(squirrel.Eq{userIdColumnName: userIDs}).
+		OrderBy(providerColumnName, externalIdColumnName)
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[userIdentityDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	identityOuts := make([]UserIdentityOut, 0, len(results))
+	for _, result := range results {
+		identityOuts = append(identityOuts, UserIdentityOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository GetUserIdentitiesByUserIDs success", "count", len(identityOuts))
+	return &identityOuts, nil
+}
+
+func (r *Repository) DeleteUserIdentity(ctx context.Context, userID uuid.UUID, provider, externalID string) error {
+	queryBuilder := squirrel.Delete(userIdentitiesTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{
+			userIdColumnName:     userID,
+			providerColumnName:   provider,
+			externalIdColumnName: externalID,
+		})
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	tag, err := q.Exec(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: %s:%s", repository.ErrIdentityNotFound, provider, externalID)
+	}
+
+	slog.DebugContext(ctx, "Repository DeleteUserIdentity success")
+	return nil
+}
@@ -0,0 +1,230 @@
+package user_identities
+
+import (
+	"context"
+	"testing"
+
+	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/infrastructure/repository/teams"
+	"pr-reviewers-service/internal/infrastructure/repository/users"
+	suite2 "pr-reviewers-service/test/suite"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *UserIdentitiesTest) seedUsers(ctx context.Context, userIDs []uuid.UUID) {
+	teamID := uuid.New()
+	teamRepo := teams.NewRepository(suite2.GlobalPool, nower2.Nower{})
+	_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{ID: teamID, Name: "Team A"})
+	assert.NoError(s.T(), err)
+
+	userRepo := users.NewRepository(suite2.GlobalPool, nower2.Nower{})
+	usersIn := make([]users.UserIn, 0, len(userIDs))
+	for i, userID := range userIDs {
+		usersIn = append(usersIn, users.UserIn{
+			ID:       userID,
+			Name:     "User " + string(rune('A'+i)),
+			IsActive: true,
+			TeamID:   teamID,
+		})
+	}
+	_, err = userRepo.SaveUsersBatch(ctx, usersIn)
+	assert.NoError(s.T(), err)
+}
+
+func (s *UserIdentitiesTest) TestSaveUserIdentitiesBatch() {
+	userID1 := uuid.New()
+	userID2 := uuid.New()
+
+	tests := []struct {
+		name        string
+		input       []UserIdentityIn
+		setup       func(ctx context.Context, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *[]UserIdentityOut)
+	}{
+		{
+			name: "successful SaveUserIdentitiesBatch returns created identities",
+			input: []UserIdentityIn{
+				{UserID: userID1, Provider: "github", ExternalID: "alice"},
+				{UserID: userID1, Provider: "email", ExternalID: "alice@example.com"},
+				{UserID: userID2, Provider: "github", ExternalID: "bob"},
+			},
+			setup: func(ctx context.Context, repo *Repository) {
+				s.seedUsers(ctx, []uuid.UUID{userID1, userID2})
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]UserIdentityOut) {
+				assert.NotNil(t, result)
+				assert.Len(t, *result, 3)
+				for _, identity := range *result {
+					assert.NotEqual(t, uuid.Nil, identity.ID)
+					assert.False(t, identity.CreatedAt.IsZero())
+				}
+			},
+		},
+		{
+			name: "SaveUserIdentitiesBatch with identity of another user returns error",
+			input: []UserIdentityIn{
+				{UserID: userID2, Provider: "github", ExternalID: "alice"},
+			},
+			setup: func(ctx context.Context, repo *Repository) {
+				s.seedUsers(ctx, []uuid.UUID{userID1, userID2})
+				_, err := repo.SaveUserIdentitiesBatch(ctx, []UserIdentityIn{
+					{UserID: userID1, Provider: "github", ExternalID: "alice"},
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrIdentityExists, i...)
+			},
+		},
+		{
+			name: "same external id is allowed for different providers",
+			input: []UserIdentityIn{
+				{UserID: userID2, Provider: "gitlab", ExternalID: "alice"},
+			},
+			setup: func(ctx context.Context, repo *Repository) {
+				s.seedUsers(ctx, []uuid.UUID{userID1, userID2})
+				_, err := repo.SaveUserIdentitiesBatch(ctx, []UserIdentityIn{
+					{UserID: userID1, Provider: "github", ExternalID: "alice"},
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]UserIdentityOut) {
+				assert.Len(t, *result, 1)
+			},
+		},
+		{
+			name:     "SaveUserIdentitiesBatch with empty input returns empty result",
+			input:    []UserIdentityIn{},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]UserIdentityOut) {
+				assert.NotNil(t, result)
+				assert.Empty(t, *result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.SaveUserIdentitiesBatch(ctx, tt.input)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *UserIdentitiesTest) TestGetUserIdentity() {
+	userID := uuid.New()
+
+	tests := []struct {
+		name        string
+		provider    string
+		externalID  string
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *UserIdentityOut)
+	}{
+		{
+			name:       "successful GetUserIdentity returns identity",
+			provider:   "github",
+			externalID: "alice",
+			checkErr:   assert.NoError,
+			checkResult: func(t *testing.T, result *UserIdentityOut) {
+				assert.NotNil(t, result)
+				assert.Equal(t, userID, result.UserID)
+			},
+		},
+		{
+			name:       "GetUserIdentity with unknown provider returns not found",
+			provider:   "gitlab",
+			externalID: "alice",
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrIdentityNotFound, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+			s.seedUsers(ctx, []uuid.UUID{userID})
+			_, err := repo.SaveUserIdentitiesBatch(ctx, []UserIdentityIn{
+				{UserID: userID, Provider: "github", ExternalID: "alice"},
+			})
+			assert.NoError(t, err)
+
+			result, err := repo.GetUserIdentity(ctx, tt.provider, tt.externalID)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *UserIdentitiesTest) TestGetUserIdentitiesByUserIDs() {
+	ctx := context.Background()
+	s.SetupTest()
+	repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+	userID1 := uuid.New()
+	userID2 := uuid.New()
+	s.seedUsers(ctx, []uuid.UUID{userID1, userID2})
+	_, err := repo.SaveUserIdentitiesBatch(ctx, []UserIdentityIn{
+		{UserID: userID1, Provider: "github", ExternalID: "alice"},
+		{UserID: userID1, Provider: "email", ExternalID: "alice@example.com"},
+		{UserID: userID2, Provider: "github", ExternalID: "bob"},
+	})
+	assert.NoError(s.T(), err)
+
+	result, err := repo.GetUserIdentitiesByUserIDs(ctx, []uuid.UUID{userID1})
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), *result, 2) {
+		assert.Equal(s.T(), "email", (*result)[0].Provider)
+		assert.Equal(s.T(), "github", (*result)[1].Provider)
+	}
+
+	empty, err := repo.GetUserIdentitiesByUserIDs(ctx, []uuid.UUID{})
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), *empty)
+}
+
+func (s *UserIdentitiesTest) TestDeleteUserIdentity() {
+	ctx := context.Background()
+	s.SetupTest()
+	repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+	userID := uuid.New()
+	s.seedUsers(ctx, []uuid.UUID{userID})
+	_, err := repo.SaveUserIdentitiesBatch(ctx, []UserIdentityIn{
+		{UserID: userID, Provider: "github", ExternalID: "alice"},
+	})
+	assert.NoError(s.T(), err)
+
+	err = repo.DeleteUserIdentity(ctx, uuid.New(), "github", "alice")
+	assert.ErrorIs(s.T(), err, repository.ErrIdentityNotFound)
+
+	err = repo.DeleteUserIdentity(ctx, userID, "github", "alice")
+	assert.NoError(s.T(), err)
+
+	_, err = repo.GetUserIdentity(ctx, "github", "alice")
+	assert.ErrorIs(s.T(), err, repository.ErrIdentityNotFound)
+}
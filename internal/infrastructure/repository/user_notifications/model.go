@@ -0,0 +1,13 @@
+package user_notifications
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserNotificationIn struct {
+	Kind   string
+	UserID uuid.UUID
+	SentOn time.Time
+}
@@ -0,0 +1,61 @@
+package user_notifications
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	nower2 "pr-reviewers-service/internal/usecase/contract/nower"
+
+	"github.com/Masterminds/squirrel"
+	trm "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	userNotificationsTableName = "user_notifications"
+	kindColumnName             = "kind"
+	userIDColumnName           = "user_id"
+	sentOnColumnName           = "sent_on"
+	createdAtColumnName        = "created_at"
+)
+
+type Repository struct {
+	db    *pgxpool.Pool
+	nower nower2.Nower
+}
+
+func NewRepository(pool *pgxpool.Pool, nower nower2.Nower) *Repository {
+	return &Repository{db: pool, nower: nower}
+}
+
+// ClaimUserNotification records that the notification of the kind is sent to the user on the day of SentOn.
+// It returns false if the notification was already claimed for that day, so at most one is sent per day.
+func (r *Repository) ClaimUserNotification(ctx context.Context, notification UserNotificationIn) (bool, error) {
+	// The day is written as text so that the date is taken in the location of SentOn and not converted to UTC.
+	sentOn := notification.SentOn.Format(time.DateOnly)
+	queryBuilder := squirrel.Insert(userNotificationsTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Columns(kindColumnName, userIDColumnName, sentOnColumnName, createdAtColumnName).
+		Values(notification.Kind, notification.UserID, sentOn, r.nower.Now()).
+		Suffix("ON CONFLICT DO NOTHING")
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return false, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	tag, err := q.Exec(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return false, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+
+	claimed := tag.RowsAffected() == 1
+	slog.DebugContext(ctx, "Repository ClaimUserNotification success", "kind", notification.Kind, "claimed", claimed)
+	return claimed, nil
+}
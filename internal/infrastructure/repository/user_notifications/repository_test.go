@@ -0,0 +1,110 @@
+package user_notifications
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/repository/teams"
+	"pr-reviewers-service/internal/infrastructure/repository/users"
+	suite2 "pr-reviewers-service/test/suite"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+const digestKind = "email_digest"
+
+func (s *UserNotificationsTest) seedUsers(ctx context.Context, userIDs []uuid.UUID) {
+	teamID := uuid.New()
+	teamRepo := teams.NewRepository(suite2.GlobalPool, nower2.Nower{})
+	_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{ID: teamID, Name: "Team A"})
+	assert.NoError(s.T(), err)
+
+	userRepo := users.NewRepository(suite2.GlobalPool, nower2.Nower{})
+	usersIn := make([]users.UserIn, 0, len(userIDs))
+	for i, userID := range userIDs {
+		usersIn = append(usersIn, users.UserIn{
+			ID:       userID,
+			Name:     "User " + string(rune('A'+i)),
+			IsActive: true,
+			TeamID:   teamID,
+		})
+	}
+	_, err = userRepo.SaveUsersBatch(ctx, usersIn)
+	assert.NoError(s.T(), err)
+}
+
+func (s *UserNotificationsTest) TestClaimUserNotification() {
+	userID1 := uuid.New()
+	userID2 := uuid.New()
+	day := time.Date(2025, 12, 12, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		input         UserNotificationIn
+		setup         func(ctx context.Context, repo *Repository)
+		checkErr      assert.ErrorAssertionFunc
+		expectClaimed bool
+	}{
+		{
+			name:          "first ClaimUserNotification of the day claims notification",
+			input:         UserNotificationIn{Kind: digestKind, UserID: userID1, SentOn: day},
+			checkErr:      assert.NoError,
+			expectClaimed: true,
+		},
+		{
+			name:  "second ClaimUserNotification of the same day is not claimed",
+			input: UserNotificationIn{Kind: digestKind, UserID: userID1, SentOn: day.Add(8 * time.Hour)},
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.ClaimUserNotification(ctx, UserNotificationIn{Kind: digestKind, UserID: userID1, SentOn: day})
+				assert.NoError(s.T(), err)
+			},
+			checkErr:      assert.NoError,
+			expectClaimed: false,
+		},
+		{
+			name:  "ClaimUserNotification on the next day claims notification",
+			input: UserNotificationIn{Kind: digestKind, UserID: userID1, SentOn: day.AddDate(0, 0, 1)},
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.ClaimUserNotification(ctx, UserNotificationIn{Kind: digestKind, UserID: userID1, SentOn: day})
+				assert.NoError(s.T(), err)
+			},
+			checkErr:      assert.NoError,
+			expectClaimed: true,
+		},
+		{
+			name:  "ClaimUserNotification for another user or kind claims notification",
+			input: UserNotificationIn{Kind: "other", UserID: userID2, SentOn: day},
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.ClaimUserNotification(ctx, UserNotificationIn{Kind: digestKind, UserID: userID2, SentOn: day})
+				assert.NoError(s.T(), err)
+			},
+			checkErr:      assert.NoError,
+			expectClaimed: true,
+		},
+		{
+			name:     "ClaimUserNotification for unknown user returns error",
+			input:    UserNotificationIn{Kind: digestKind, UserID: uuid.New(), SentOn: day},
+			checkErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+			s.seedUsers(ctx, []uuid.UUID{userID1, userID2})
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			claimed, err := repo.ClaimUserNotification(ctx, tt.input)
+			tt.checkErr(t, err)
+			assert.Equal(t, tt.expectClaimed, claimed)
+		})
+	}
+}
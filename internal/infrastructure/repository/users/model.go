@@ -21,12 +21,15 @@ type UserOut struct {
 	TeamID    uuid.UUID
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// UnavailableUntil is set while the user is away, reviewers are not picked among unavailable users.
+	UnavailableUntil *time.Time
 }
 
 type userDB struct {
-	ID        uuid.UUID `db:"id"`
-	Name      string    `db:"name"`
-	IsActive  bool      `db:"is_active"`
-	TeamID    uuid.UUID `db:"team_id"`
-	CreatedAt time.Time `db:"created_at"`
+	ID               uuid.UUID  `db:"id"`
+	Name             string     `db:"name"`
+	IsActive         bool       `db:"is_active"`
+	TeamID           uuid.UUID  `db:"team_id"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UnavailableUntil *time.Time `db:"unavailable_until"`
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"pr-reviewers-service/internal/infrastructure/repository"
 	nower2 "pr-reviewers-service/internal/usecase/contract/nower"
@@ -24,6 +25,16 @@ const (
 	teamIdColumnName    = "team_id"
 	createdAtColumnName = "created_at"
 
+	unavailableUntilColumnName = "unavailable_until"
+
+	teamMembershipsTableName   = "team_memberships"
+	membershipUserIdColumnName = "user_id"
+	membershipTeamIdColumnName = "team_id"
+
+	teamsTableName           = "teams"
+	teamIdInTeamsColumnName  = "id"
+	teamArchivedAtColumnName = "archived_at"
+
 	returnAll = "RETURNING *"
 )
 
@@ -36,9 +47,33 @@ func NewRepository(pool *pgxpool.Pool, nower nower2.Nower) *Repository {
 	return &Repository{db: pool, nower: nower}
 }
 
+// teamMemberCondition matches users whose primary team is teamID or who hold an additional membership in it.
+func teamMemberCondition(teamID uuid.UUID) squirrel.Sqlizer {
+	return squirrel.Or{
+		squirrel.Eq{teamIdColumnName: teamID},
+		squirrel.Expr(fmt.Sprintf("%s IN (SELECT %s FROM %s WHERE %s = ?)",
+			idColumnName, membershipUserIdColumnName, teamMembershipsTableName, membershipTeamIdColumnName), teamID),
+	}
+}
+
+// notArchivedTeamCondition excludes everyone when teamID, the team candidates are selected from, is archived.
+func notArchivedTeamCondition(teamID uuid.UUID) squirrel.Sqlizer {
+	return squirrel.Expr(fmt.Sprintf("? NOT IN (SELECT %s FROM %s WHERE %s IS NOT NULL)",
+		teamIdInTeamsColumnName, teamsTableName, teamArchivedAtColumnName), teamID)
+}
+
+// availableCondition excludes users that marked themselves unavailable until a moment after now.
+func availableCondition(now time.Time) squirrel.Sqlizer {
+	return squirrel.Or{
+		squirrel.Eq{unavailableUntilColumnName: nil},
+		squirrel.LtOrEq{unavailableUntilColumnName: now},
+	}
+}
+
 func (r *Repository) GetUserByID(ctx context.Context, userId uuid.UUID) (*UserOut, error) {
 	selectBuilder := squirrel.
-		Select(idColumnName, nameColumnName, isActiveColumnName, teamIdColumnName, createdAtColumnName).
+		Select(idColumnName, nameColumnName, isActiveColumnName, teamIdColumnName, createdAtColumnName,
+			unavailableUntilColumnName).
 		PlaceholderFormat(squirrel.Dollar).
 		From(usersTableName).
 		Where(squirrel.Eq{idColumnName: userId})
@@ -68,11 +103,12 @@ func (r *Repository) GetUserByID(ctx context.Context, userId uuid.UUID) (*UserOu
 
 	slog.DebugContext(ctx, "Repository GetUserByID success")
 	return &UserOut{
-		ID:        result.ID,
-		Name:      result.Name,
-		IsActive:  result.IsActive,
-		TeamID:    result.TeamID,
-		CreatedAt: result.CreatedAt,
+		ID:               result.ID,
+		Name:             result.Name,
+		IsActive:         result.IsActive,
+		TeamID:           result.TeamID,
+		CreatedAt:        result.CreatedAt,
+		UnavailableUntil: result.UnavailableUntil,
 	}, nil
 }
 
@@ -82,7 +118,8 @@ func (r *Repository) GetUsersByIDs(ctx context.Context, userIds []uuid.UUID) (*[
 	}
 
 	selectBuilder := squirrel.
-		Select(idColumnName, nameColumnName, isActiveColumnName, teamIdColumnName, createdAtColumnName).
+		Select(idColumnName, nameColumnName, isActiveColumnName, teamIdColumnName, createdAtColumnName,
+			unavailableUntilColumnName).
 		PlaceholderFormat(squirrel.Dollar).
 		From(usersTableName).
 		Where(squirrel.Eq{idColumnName: userIds})
@@ -110,11 +147,12 @@ func (r *Repository) GetUsersByIDs(ctx context.Context, userIds []uuid.UUID) (*[
 	users := make([]UserOut, 0, len(results))
 	for _, result := range results {
 		users = append(users, UserOut{
-			ID:        result.ID,
-			Name:      result.Name,
-			IsActive:  result.IsActive,
-			TeamID:    result.TeamID,
-			CreatedAt: result.CreatedAt,
+			ID:               result.ID,
+			Name:             result.Name,
+			IsActive:         result.IsActive,
+			TeamID:           result.TeamID,
+			CreatedAt:        result.CreatedAt,
+			UnavailableUntil: result.UnavailableUntil,
 		})
 	}
 
@@ -124,10 +162,11 @@ func (r *Repository) GetUsersByIDs(ctx context.Context, userIds []uuid.UUID) (*[
 
 func (r *Repository) GetUsersByTeamID(ctx context.Context, teamID uuid.UUID) (*[]UserOut, error) {
 	selectBuilder := squirrel.
-		Select(idColumnName, nameColumnName, isActiveColumnName, teamIdColumnName, createdAtColumnName).
+		Select(idColumnName, nameColumnName, isActiveColumnName, teamIdColumnName, createdAtColumnName,
+			unavailableUntilColumnName).
 		PlaceholderFormat(squirrel.Dollar).
 		From(usersTableName).
-		Where(squirrel.Eq{teamIdColumnName: teamID})
+		Where(teamMemberCondition(teamID))
 
 	sql, args, err := selectBuilder.ToSql()
 	if err != nil {
@@ -152,11 +191,12 @@ func (r *Repository) GetUsersByTeamID(ctx context.Context, teamID uuid.UUID) (*[
 	users := make([]UserOut, 0, len(results))
 	for _, result := range results {
 		users = append(users, UserOut{
-			ID:        result.ID,
-			Name:      result.Name,
-			IsActive:  result.IsActive,
-			TeamID:    result.TeamID,
-			CreatedAt: result.CreatedAt,
+			ID:               result.ID,
+			Name:             result.Name,
+			IsActive:         result.IsActive,
+			TeamID:           result.TeamID,
+			CreatedAt:        result.CreatedAt,
+			UnavailableUntil: result.UnavailableUntil,
 		})
 	}
 
@@ -166,10 +206,14 @@ func (r *Repository) GetUsersByTeamID(ctx context.Context, teamID uuid.UUID) (*[
 
 func (r *Repository) GetActiveUsersByTeamID(ctx context.Context, teamID uuid.UUID) (*[]UserOut, error) {
 	selectBuilder := squirrel.
-		Select(idColumnName, nameColumnName, isActiveColumnName, teamIdColumnName, createdAtColumnName).
+		Select(idColumnName, nameColumnName, isActiveColumnName, teamIdColumnName, createdAtColumnName,
+			unavailableUntilColumnName).
 		PlaceholderFormat(squirrel.Dollar).
 		From(usersTableName).
-		Where(squirrel.Eq{teamIdColumnName: teamID, isActiveColumnName: true})
+		Where(teamMemberCondition(teamID)).
+		Where(squirrel.Eq{isActiveColumnName: true}).
+		Where(availableCondition(r.nower.Now())).
+		Where(notArchivedTeamCondition(teamID))
 
 	sql, args, err := selectBuilder.ToSql()
 	if err != nil {
@@ -194,11 +238,12 @@ func (r *Repository) GetActiveUsersByTeamID(ctx context.Context, teamID uuid.UUI
 	users := make([]UserOut, 0, len(results))
 	for _, result := range results {
 		users = append(users, UserOut{
-			ID:        result.ID,
-			Name:      result.Name,
-			IsActive:  result.IsActive,
-			TeamID:    result.TeamID,
-			CreatedAt: result.CreatedAt,
+			ID:               result.ID,
+			Name:             result.Name,
+			IsActive:         result.IsActive,
+			TeamID:           result.TeamID,
+			CreatedAt:        result.CreatedAt,
+			UnavailableUntil: result.UnavailableUntil,
 		})
 	}
 
@@ -237,11 +282,54 @@ func (r *Repository) UpdateUser(ctx context.Context, user UserIn) (*UserOut, err
 
 	slog.DebugContext(ctx, "Repository UpdateUser success")
 	return &UserOut{
-		ID:        result.ID,
-		Name:      result.Name,
-		IsActive:  result.IsActive,
-		TeamID:    result.TeamID,
-		CreatedAt: result.CreatedAt,
+		ID:               result.ID,
+		Name:             result.Name,
+		IsActive:         result.IsActive,
+		TeamID:           result.TeamID,
+		CreatedAt:        result.CreatedAt,
+		UnavailableUntil: result.UnavailableUntil,
+	}, nil
+}
+
+// SetUserUnavailableUntil marks the user unavailable until the given moment, nil makes the user available again.
+func (r *Repository) SetUserUnavailableUntil(ctx context.Context, userID uuid.UUID, until *time.Time) (*UserOut, error) {
+	queryBuilder := squirrel.Update(usersTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Set(unavailableUntilColumnName, until).
+		Where(squirrel.Eq{idColumnName: userID}).
+		Suffix(returnAll)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[userDB])
+	if err != nil {
+		slog.DebugContext(ctx, err.Error())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %v", repository.ErrUserNotFound, err)
+		}
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository SetUserUnavailableUntil success")
+	return &UserOut{
+		ID:               result.ID,
+		Name:             result.Name,
+		IsActive:         result.IsActive,
+		TeamID:           result.TeamID,
+		CreatedAt:        result.CreatedAt,
+		UnavailableUntil: result.UnavailableUntil,
 	}, nil
 }
 
@@ -288,11 +376,12 @@ func (r *Repository) SaveUsersBatch(ctx context.Context, users []UserIn) (*[]Use
 	userOuts := make([]UserOut, 0, len(results))
 	for _, result := range results {
 		userOuts = append(userOuts, UserOut{
-			ID:        result.ID,
-			Name:      result.Name,
-			IsActive:  result.IsActive,
-			TeamID:    result.TeamID,
-			CreatedAt: result.CreatedAt,
+			ID:               result.ID,
+			Name:             result.Name,
+			IsActive:         result.IsActive,
+			TeamID:           result.TeamID,
+			CreatedAt:        result.CreatedAt,
+			UnavailableUntil: result.UnavailableUntil,
 		})
 	}
 
@@ -353,14 +442,204 @@ func (r *Repository) UpdateUsersBatch(ctx context.Context, users []UserIn) (*[]U
 	userOuts := make([]UserOut, 0, len(results))
 	for _, result := range results {
 		userOuts = append(userOuts, UserOut{
-			ID:        result.ID,
-			Name:      result.Name,
-			IsActive:  result.IsActive,
-			TeamID:    result.TeamID,
-			CreatedAt: result.CreatedAt,
+			ID:               result.ID,
+			Name:             result.Name,
+			IsActive:         result.IsActive,
+			TeamID:           result.TeamID,
+			CreatedAt:        result.CreatedAt,
+			UnavailableUntil: result.UnavailableUntil,
 		})
 	}
 
 	slog.DebugContext(ctx, "Repository UpdateUsersBatch success", "count", len(userOuts))
 	return &userOuts, nil
 }
+
+// GetUsersByName returns users with the name compared case insensitively.
+func (r *Repository) GetUsersByName(ctx context.Context, name string) (*[]UserOut, error) {
+	selectBuilder := squirrel.
+		Select(idColumnName, nameColumnName, isActiveColumnName, teamIdColumnName, createdAtColumnName,
+			unavailableUntilColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(usersTableName).
+		Where(squirrel.Expr(fmt.Sprintf("LOWER(%s) = LOWER(?)", nameColumnName), name)).
+		OrderBy(createdAtColumnName, idColumnName)
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[userDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	users := make([]UserOut, 0, len(results))
+	for _, result := range results {
+		users = append(users, UserOut{
+			ID:               result.ID,
+			Name:             result.Name,
+			IsActive:         result.IsActive,
+			TeamID:           result.TeamID,
+			CreatedAt:        result.CreatedAt,
+			UnavailableUntil: result.UnavailableUntil,
+		})
+	}
+
+	slog.DebugContext(ctx, "Repository GetUsersByName success", "count", len(users))
+	return &users, nil
+}
+
+// GetAllUsers returns every user ordered by creation time.
+func (r *Repository) GetAllUsers(ctx context.Context) (*[]UserOut, error) {
+	selectBuilder := squirrel.
+		Select(idColumnName, nameColumnName, isActiveColumnName, teamIdColumnName, createdAtColumnName,
+			unavailableUntilColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(usersTableName).
+		OrderBy(createdAtColumnName, idColumnName)
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[userDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	users := make([]UserOut, 0, len(results))
+	for _, result := range results {
+		users = append(users, UserOut{
+			ID:               result.ID,
+			Name:             result.Name,
+			IsActive:         result.IsActive,
+			TeamID:           result.TeamID,
+			CreatedAt:        result.CreatedAt,
+			UnavailableUntil: result.UnavailableUntil,
+		})
+	}
+
+	slog.DebugContext(ctx, "Repository GetAllUsers success", "count", len(users))
+	return &users, nil
+}
+
+// GetUsersPage returns users ordered by creation time.
+func (r *Repository) GetUsersPage(ctx context.Context, limit, offset uint64) (*[]UserOut, error) {
+	selectBuilder := squirrel.
+		Select(idColumnName, nameColumnName, isActiveColumnName, teamIdColumnName, createdAtColumnName,
+			unavailableUntilColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(usersTableName).
+		OrderBy(createdAtColumnName, idColumnName).
+		Limit(limit).
+		Offset(offset)
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[userDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	users := make([]UserOut, 0, len(results))
+	for _, result := range results {
+		users = append(users, UserOut{
+			ID:               result.ID,
+			Name:             result.Name,
+			IsActive:         result.IsActive,
+			TeamID:           result.TeamID,
+			CreatedAt:        result.CreatedAt,
+			UnavailableUntil: result.UnavailableUntil,
+		})
+	}
+
+	slog.DebugContext(ctx, "Repository GetUsersPage success", "count", len(users))
+	return &users, nil
+}
+
+func (r *Repository) CountUsers(ctx context.Context) (int, error) {
+	selectBuilder := squirrel.
+		Select("COUNT(*)").
+		PlaceholderFormat(squirrel.Dollar).
+		From(usersTableName)
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return 0, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	var cnt int
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	err = q.QueryRow(ctx, sql, args...).Scan(&cnt)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return 0, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+
+	slog.DebugContext(ctx, "Repository CountUsers success", "count", cnt)
+	return cnt, nil
+}
+
+// DeleteUserByID removes the user, authored pull requests, review assignments, memberships and identities
+// are removed with it by the foreign keys.
+func (r *Repository) DeleteUserByID(ctx context.Context, userID uuid.UUID) error {
+	queryBuilder := squirrel.Delete(usersTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{idColumnName: userID})
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	tag, err := q.Exec(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: %s", repository.ErrUserNotFound, userID)
+	}
+
+	slog.DebugContext(ctx, "Repository DeleteUserByID success")
+	return nil
+}
@@ -3,8 +3,11 @@ package users
 import (
 	"context"
 	"testing"
+	"time"
 
 	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/infrastructure/repository/team_memberships"
 	"pr-reviewers-service/internal/infrastructure/repository/teams"
 	suite2 "pr-reviewers-service/test/suite"
 
@@ -427,6 +430,193 @@ func (s *UsersTest) TestGetActiveUsersByTeamID() {
 				}
 			},
 		},
+		{
+			name:  "GetActiveUsersByTeamID includes active additional members of the team",
+			input: teamID1,
+			setup: func(ctx context.Context, teamRepo *teams.Repository, repo *Repository) {
+				_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{
+					ID:   teamID1,
+					Name: "Team 1",
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = teamRepo.SaveTeam(ctx, teams.TeamIn{
+					ID:   teamID2,
+					Name: "Team 2",
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SaveUsersBatch(ctx, []UserIn{
+					{
+						ID:       userID1,
+						Name:     "Primary Member",
+						IsActive: true,
+						TeamID:   teamID1,
+					},
+					{
+						ID:       userID2,
+						Name:     "Additional Member",
+						IsActive: true,
+						TeamID:   teamID2,
+					},
+					{
+						ID:       userID3,
+						Name:     "Inactive Additional Member",
+						IsActive: false,
+						TeamID:   teamID2,
+					},
+				})
+				assert.NoError(s.T(), err)
+
+				membershipRepo := team_memberships.NewRepository(suite2.GlobalPool, nower2.Nower{})
+				_, err = membershipRepo.SaveTeamMembershipsBatch(ctx, []team_memberships.TeamMembershipIn{
+					{UserID: userID2, TeamID: teamID1, IsPrimary: false},
+					{UserID: userID3, TeamID: teamID1, IsPrimary: false},
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]UserOut) {
+				assert.NotNil(t, result)
+				assert.Len(t, *result, 2)
+
+				ids := make([]uuid.UUID, 0, len(*result))
+				for _, user := range *result {
+					assert.True(t, user.IsActive)
+					ids = append(ids, user.ID)
+				}
+				assert.ElementsMatch(t, []uuid.UUID{userID1, userID2}, ids)
+			},
+		},
+		{
+			name:  "GetActiveUsersByTeamID for archived team returns empty result",
+			input: teamID1,
+			setup: func(ctx context.Context, teamRepo *teams.Repository, repo *Repository) {
+				_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{
+					ID:   teamID1,
+					Name: "Team 1",
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SaveUsersBatch(ctx, []UserIn{
+					{
+						ID:       userID1,
+						Name:     "Active User",
+						IsActive: true,
+						TeamID:   teamID1,
+					},
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = teamRepo.SetTeamArchived(ctx, teamID1, true)
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]UserOut) {
+				assert.NotNil(t, result)
+				assert.Empty(t, *result)
+			},
+		},
+		{
+			name:  "GetActiveUsersByTeamID keeps additional members whose primary team is archived",
+			input: teamID1,
+			setup: func(ctx context.Context, teamRepo *teams.Repository, repo *Repository) {
+				_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{
+					ID:   teamID1,
+					Name: "Team 1",
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = teamRepo.SaveTeam(ctx, teams.TeamIn{
+					ID:   teamID2,
+					Name: "Team 2",
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SaveUsersBatch(ctx, []UserIn{
+					{
+						ID:       userID1,
+						Name:     "Primary Member",
+						IsActive: true,
+						TeamID:   teamID1,
+					},
+					{
+						ID:       userID2,
+						Name:     "Additional Member From Archived Team",
+						IsActive: true,
+						TeamID:   teamID2,
+					},
+				})
+				assert.NoError(s.T(), err)
+
+				membershipRepo := team_memberships.NewRepository(suite2.GlobalPool, nower2.Nower{})
+				_, err = membershipRepo.SaveTeamMembershipsBatch(ctx, []team_memberships.TeamMembershipIn{
+					{UserID: userID2, TeamID: teamID1, IsPrimary: false},
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = teamRepo.SetTeamArchived(ctx, teamID2, true)
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]UserOut) {
+				assert.NotNil(t, result)
+				ids := make([]uuid.UUID, 0, len(*result))
+				for _, user := range *result {
+					ids = append(ids, user.ID)
+				}
+				assert.ElementsMatch(t, []uuid.UUID{userID1, userID2}, ids)
+			},
+		},
+		{
+			name:  "GetActiveUsersByTeamID skips users unavailable until a later moment",
+			input: teamID1,
+			setup: func(ctx context.Context, teamRepo *teams.Repository, repo *Repository) {
+				_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{
+					ID:   teamID1,
+					Name: "Team 1",
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SaveUsersBatch(ctx, []UserIn{
+					{
+						ID:       userID1,
+						Name:     "Available User",
+						IsActive: true,
+						TeamID:   teamID1,
+					},
+					{
+						ID:       userID2,
+						Name:     "Away User",
+						IsActive: true,
+						TeamID:   teamID1,
+					},
+					{
+						ID:       userID3,
+						Name:     "Returned User",
+						IsActive: true,
+						TeamID:   teamID1,
+					},
+				})
+				assert.NoError(s.T(), err)
+
+				later := time.Now().Add(time.Hour)
+				_, err = repo.SetUserUnavailableUntil(ctx, userID2, &later)
+				assert.NoError(s.T(), err)
+				earlier := time.Now().Add(-time.Hour)
+				_, err = repo.SetUserUnavailableUntil(ctx, userID3, &earlier)
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]UserOut) {
+				assert.NotNil(t, result)
+				ids := make([]uuid.UUID, 0, len(*result))
+				for _, user := range *result {
+					ids = append(ids, user.ID)
+				}
+				assert.ElementsMatch(t, []uuid.UUID{userID1, userID3}, ids)
+			},
+		},
 		{
 			name:  "GetActiveUsersByTeamID with no active users returns empty result",
 			input: teamID2,
@@ -577,6 +767,111 @@ func (s *UsersTest) TestUpdateUser() {
 	}
 }
 
+func (s *UsersTest) TestSetUserUnavailableUntil() {
+	teamID := uuid.New()
+	userID := uuid.New()
+	until := time.Date(2025, 12, 20, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		userID      uuid.UUID
+		until       *time.Time
+		setup       func(ctx context.Context, teamRepo *teams.Repository, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *UserOut)
+	}{
+		{
+			name:   "successful SetUserUnavailableUntil marks the user unavailable",
+			userID: userID,
+			until:  &until,
+			setup: func(ctx context.Context, teamRepo *teams.Repository, repo *Repository) {
+				_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{
+					ID:   teamID,
+					Name: "Team 1",
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SaveUsersBatch(ctx, []UserIn{
+					{
+						ID:       userID,
+						Name:     "User",
+						IsActive: true,
+						TeamID:   teamID,
+					},
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *UserOut) {
+				assert.NotNil(t, result)
+				assert.NotNil(t, result.UnavailableUntil)
+				assert.True(t, until.Equal(*result.UnavailableUntil))
+				assert.True(t, result.IsActive)
+			},
+		},
+		{
+			name:   "SetUserUnavailableUntil with nil makes the user available again",
+			userID: userID,
+			setup: func(ctx context.Context, teamRepo *teams.Repository, repo *Repository) {
+				_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{
+					ID:   teamID,
+					Name: "Team 1",
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SaveUsersBatch(ctx, []UserIn{
+					{
+						ID:       userID,
+						Name:     "User",
+						IsActive: true,
+						TeamID:   teamID,
+					},
+				})
+				assert.NoError(s.T(), err)
+
+				_, err = repo.SetUserUnavailableUntil(ctx, userID, &until)
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *UserOut) {
+				assert.NotNil(t, result)
+				assert.Nil(t, result.UnavailableUntil)
+			},
+		},
+		{
+			name:   "SetUserUnavailableUntil with non-existent ID returns not found error",
+			userID: uuid.New(),
+			until:  &until,
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrUserNotFound, i...)
+			},
+			checkResult: func(t *testing.T, result *UserOut) {
+				assert.Nil(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			teamRepo := teams.NewRepository(suite2.GlobalPool, nower2.Nower{})
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, teamRepo, repo)
+			}
+
+			result, err := repo.SetUserUnavailableUntil(ctx, tt.userID, tt.until)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
 func (s *UsersTest) TestSaveUsersBatch() {
 	teamID := uuid.New()
 
@@ -815,3 +1110,124 @@ func (s *UsersTest) TestUpdateUsersBatch() {
 		})
 	}
 }
+
+func (s *UsersTest) TestGetUsersByName() {
+	teamID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name        string
+		input       string
+		checkResult func(t *testing.T, result *[]UserOut)
+	}{
+		{
+			name:  "GetUsersByName matches case insensitively",
+			input: "BJENSEN",
+			checkResult: func(t *testing.T, result *[]UserOut) {
+				if assert.Len(t, *result, 1) {
+					assert.Equal(t, userID, (*result)[0].ID)
+					assert.Equal(t, "bjensen", (*result)[0].Name)
+				}
+			},
+		},
+		{
+			name:  "GetUsersByName with unknown name returns empty list",
+			input: "jsmith",
+			checkResult: func(t *testing.T, result *[]UserOut) {
+				assert.Empty(t, *result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			teamRepo := teams.NewRepository(suite2.GlobalPool, nower2.Nower{})
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{ID: teamID, Name: "Test Team"})
+			assert.NoError(t, err)
+			_, err = repo.SaveUsersBatch(ctx, []UserIn{{ID: userID, Name: "bjensen", IsActive: true, TeamID: teamID}})
+			assert.NoError(t, err)
+
+			result, err := repo.GetUsersByName(ctx, tt.input)
+			assert.NoError(t, err)
+			tt.checkResult(t, result)
+		})
+	}
+}
+
+func (s *UsersTest) TestGetUsersPageAndCountUsers() {
+	s.SetupTest()
+
+	ctx := context.Background()
+	teamID := uuid.New()
+	teamRepo := teams.NewRepository(suite2.GlobalPool, nower2.Nower{})
+	repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+	_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{ID: teamID, Name: "Test Team"})
+	assert.NoError(s.T(), err)
+	for _, name := range []string{"User 1", "User 2", "User 3"} {
+		_, err = repo.SaveUsersBatch(ctx, []UserIn{{Name: name, IsActive: true, TeamID: teamID}})
+		assert.NoError(s.T(), err)
+	}
+
+	page, err := repo.GetUsersPage(ctx, 2, 1)
+	assert.NoError(s.T(), err)
+	assert.Len(s.T(), *page, 2)
+
+	all, err := repo.GetAllUsers(ctx)
+	assert.NoError(s.T(), err)
+	if assert.Len(s.T(), *all, 3) {
+		assert.Equal(s.T(), (*page)[0].ID, (*all)[1].ID)
+	}
+
+	cnt, err := repo.CountUsers(ctx)
+	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), 3, cnt)
+}
+
+func (s *UsersTest) TestDeleteUserByID() {
+	teamID := uuid.New()
+	userID := uuid.New()
+
+	tests := []struct {
+		name     string
+		input    uuid.UUID
+		checkErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "successful DeleteUserByID removes user",
+			input:    userID,
+			checkErr: assert.NoError,
+		},
+		{
+			name:     "DeleteUserByID with non-existent ID returns not found error",
+			input:    uuid.New(),
+			checkErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			teamRepo := teams.NewRepository(suite2.GlobalPool, nower2.Nower{})
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			_, err := teamRepo.SaveTeam(ctx, teams.TeamIn{ID: teamID, Name: "Test Team"})
+			assert.NoError(t, err)
+			_, err = repo.SaveUsersBatch(ctx, []UserIn{{ID: userID, Name: "User 1", IsActive: true, TeamID: teamID}})
+			assert.NoError(t, err)
+
+			err = repo.DeleteUserByID(ctx, tt.input)
+			tt.checkErr(t, err)
+
+			_, err = repo.GetUserByID(ctx, userID)
+			assert.Equal(t, tt.input == userID, err != nil)
+		})
+	}
+}
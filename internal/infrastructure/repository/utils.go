@@ -3,12 +3,20 @@ package repository
 import "errors"
 
 var (
-	ErrBuildQuery          = errors.New("failed to build SQL query")
-	ErrExecuteQuery        = errors.New("failed to execute query")
-	ErrScanResult          = errors.New("failed to scan result")
-	ErrUserNotFound        = errors.New("user not found")
-	ErrTeamNotFound        = errors.New("team not found")
-	ErrPullRequestNotFound = errors.New("pull request found")
-	ErrPRStatusNotFound    = errors.New("pr status found")
-	ErrPRReviewerNotFound  = errors.New("pr reviewer found")
+	ErrBuildQuery            = errors.New("failed to build SQL query")
+	ErrExecuteQuery          = errors.New("failed to execute query")
+	ErrScanResult            = errors.New("failed to scan result")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrTeamNotFound          = errors.New("team not found")
+	ErrTeamAlreadyExists     = errors.New("team already exists")
+	ErrPullRequestNotFound   = errors.New("pull request found")
+	ErrPRStatusNotFound      = errors.New("pr status found")
+	ErrPRReviewerNotFound    = errors.New("pr reviewer found")
+	ErrIdentityNotFound      = errors.New("user identity not found")
+	ErrIdentityExists        = errors.New("user identity already exists")
+	ErrDeliveryExists        = errors.New("webhook delivery already exists")
+	ErrSubscriptionNotFound  = errors.New("webhook subscription not found")
+	ErrEventDeliveryNotFound = errors.New("webhook event delivery not found")
+	ErrOutboxEventNotFound   = errors.New("outbox event not found")
+	ErrCodeOwnersNotFound    = errors.New("code owners not found")
 )
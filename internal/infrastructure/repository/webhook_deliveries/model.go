@@ -0,0 +1,30 @@
+package webhook_deliveries
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookDeliveryIn struct {
+	ID         uuid.UUID
+	Provider   string
+	DeliveryID string
+	Event      string
+}
+
+type WebhookDeliveryOut struct {
+	ID         uuid.UUID
+	Provider   string
+	DeliveryID string
+	Event      string
+	ReceivedAt time.Time
+}
+
+type webhookDeliveryDB struct {
+	ID         uuid.UUID `db:"id"`
+	Provider   string    `db:"provider"`
+	DeliveryID string    `db:"delivery_id"`
+	Event      string    `db:"event"`
+	ReceivedAt time.Time `db:"received_at"`
+}
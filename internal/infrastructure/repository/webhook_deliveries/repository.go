@@ -0,0 +1,80 @@
+package webhook_deliveries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	nower2 "pr-reviewers-service/internal/usecase/contract/nower"
+
+	"github.com/Masterminds/squirrel"
+	trm "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	webhookDeliveriesTableName = "webhook_deliveries"
+	idColumnName               = "id"
+	providerColumnName         = "provider"
+	deliveryIdColumnName       = "delivery_id"
+	eventColumnName            = "event"
+	receivedAtColumnName       = "received_at"
+
+	returnAll = "RETURNING *"
+)
+
+type Repository struct {
+	db    *pgxpool.Pool
+	nower nower2.Nower
+}
+
+func NewRepository(pool *pgxpool.Pool, nower nower2.Nower) *Repository {
+	return &Repository{db: pool, nower: nower}
+}
+
+// SaveWebhookDelivery records a delivery, a delivery that was already recorded for the provider
+// fails with ErrDeliveryExists. The conflict is resolved by DO NOTHING so a duplicate does not
+// abort the surrounding transaction.
+func (r *Repository) SaveWebhookDelivery(ctx context.Context, delivery WebhookDeliveryIn) (*WebhookDeliveryOut, error) {
+	deliveryID := delivery.ID
+	if deliveryID == uuid.Nil {
+		deliveryID = uuid.New()
+	}
+
+	queryBuilder := squirrel.Insert(webhookDeliveriesTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Columns(idColumnName, providerColumnName, deliveryIdColumnName, eventColumnName, receivedAtColumnName).
+		Values(deliveryID, delivery.Provider, delivery.DeliveryID, delivery.Event, r.nower.Now()).
+		Suffix(fmt.Sprintf("ON CONFLICT (%s, %s) DO NOTHING %s", providerColumnName, deliveryIdColumnName, returnAll))
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[webhookDeliveryDB])
+	if err != nil {
+		slog.DebugContext(ctx, err.Error())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s %s", repository.ErrDeliveryExists, delivery.Provider, delivery.DeliveryID)
+		}
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository SaveWebhookDelivery success")
+	out := WebhookDeliveryOut(result)
+	return &out, nil
+}
@@ -0,0 +1,99 @@
+package webhook_deliveries
+
+import (
+	"context"
+	"testing"
+
+	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/repository"
+	suite2 "pr-reviewers-service/test/suite"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *WebhookDeliveriesTest) TestSaveWebhookDelivery() {
+	tests := []struct {
+		name        string
+		input       WebhookDeliveryIn
+		setup       func(ctx context.Context, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *WebhookDeliveryOut)
+	}{
+		{
+			name: "successful SaveWebhookDelivery returns saved delivery",
+			input: WebhookDeliveryIn{
+				Provider:   "github",
+				DeliveryID: "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+				Event:      "pull_request",
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *WebhookDeliveryOut) {
+				assert.NotNil(t, result)
+				assert.NotEqual(t, uuid.Nil, result.ID)
+				assert.Equal(t, "github", result.Provider)
+				assert.Equal(t, "72d3162e-cc78-11e3-81ab-4c9367dc0958", result.DeliveryID)
+				assert.Equal(t, "pull_request", result.Event)
+				assert.False(t, result.ReceivedAt.IsZero())
+			},
+		},
+		{
+			name: "SaveWebhookDelivery with recorded delivery returns error",
+			input: WebhookDeliveryIn{
+				Provider:   "github",
+				DeliveryID: "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+				Event:      "pull_request",
+			},
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveWebhookDelivery(ctx, WebhookDeliveryIn{
+					Provider:   "github",
+					DeliveryID: "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+					Event:      "pull_request",
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrDeliveryExists, i...)
+			},
+		},
+		{
+			name: "same delivery id is allowed for different providers",
+			input: WebhookDeliveryIn{
+				Provider:   "gitlab",
+				DeliveryID: "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+				Event:      "merge_request",
+			},
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveWebhookDelivery(ctx, WebhookDeliveryIn{
+					Provider:   "github",
+					DeliveryID: "72d3162e-cc78-11e3-81ab-4c9367dc0958",
+					Event:      "pull_request",
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *WebhookDeliveryOut) {
+				assert.Equal(t, "gitlab", result.Provider)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.SaveWebhookDelivery(ctx, tt.input)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
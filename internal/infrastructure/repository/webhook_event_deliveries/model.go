@@ -0,0 +1,76 @@
+package webhook_event_deliveries
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookEventDeliveryIn struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	Event          string
+	Payload        []byte
+	Status         string
+	NextAttemptAt  time.Time
+}
+
+type WebhookEventDeliveryOut struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	Event          string
+	Payload        []byte
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      *string
+	ResponseCode   *int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// DueWebhookEventDeliveryOut is a claimed delivery together with the endpoint it is sent to.
+type DueWebhookEventDeliveryOut struct {
+	WebhookEventDeliveryOut
+	URL    string
+	Secret string
+}
+
+type WebhookEventDeliveryAttemptIn struct {
+	ID            uuid.UUID
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     *string
+	ResponseCode  *int
+}
+
+type WebhookEventDeliveriesFilter struct {
+	SubscriptionID *uuid.UUID
+	Status         *string
+	Limit          uint64
+	Offset         uint64
+}
+
+type webhookEventDeliveryDB struct {
+	ID             uuid.UUID `db:"id"`
+	SubscriptionID uuid.UUID `db:"subscription_id"`
+	EventID        uuid.UUID `db:"event_id"`
+	Event          string    `db:"event"`
+	Payload        []byte    `db:"payload"`
+	Status         string    `db:"status"`
+	Attempts       int       `db:"attempts"`
+	NextAttemptAt  time.Time `db:"next_attempt_at"`
+	LastError      *string   `db:"last_error"`
+	ResponseCode   *int      `db:"response_code"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
+
+type dueWebhookEventDeliveryDB struct {
+	webhookEventDeliveryDB
+	URL    string `db:"url"`
+	Secret string `db:"secret"`
+}
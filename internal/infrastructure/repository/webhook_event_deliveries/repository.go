@@ -0,0 +1,282 @@
+package webhook_event_deliveries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	nower2 "pr-reviewers-service/internal/usecase/contract/nower"
+
+	"github.com/Masterminds/squirrel"
+	trm "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	webhookEventDeliveriesTableName = "webhook_event_deliveries"
+	idColumnName                    = "id"
+	subscriptionIdColumnName        = "subscription_id"
+	eventIdColumnName               = "event_id"
+	eventColumnName                 = "event"
+	payloadColumnName               = "payload"
+	statusColumnName                = "status"
+	attemptsColumnName              = "attempts"
+	nextAttemptAtColumnName         = "next_attempt_at"
+	lastErrorColumnName             = "last_error"
+	responseCodeColumnName          = "response_code"
+	createdAtColumnName             = "created_at"
+	updatedAtColumnName             = "updated_at"
+
+	webhookSubscriptionsTableName = "webhook_subscriptions"
+	urlColumnName                 = "url"
+	secretColumnName              = "secret"
+
+	returnAll = "RETURNING *"
+)
+
+var deliveryColumns = []string{
+	idColumnName, subscriptionIdColumnName, eventIdColumnName, eventColumnName, payloadColumnName,
+	statusColumnName, attemptsColumnName, nextAttemptAtColumnName, lastErrorColumnName,
+	responseCodeColumnName, createdAtColumnName, updatedAtColumnName,
+}
+
+type Repository struct {
+	db    *pgxpool.Pool
+	nower nower2.Nower
+}
+
+func NewRepository(pool *pgxpool.Pool, nower nower2.Nower) *Repository {
+	return &Repository{db: pool, nower: nower}
+}
+
+func (r *Repository) SaveWebhookEventDeliveriesBatch(ctx context.Context, deliveries []WebhookEventDeliveryIn) (*[]WebhookEventDeliveryOut, error) {
+	if len(deliveries) == 0 {
+		return &[]WebhookEventDeliveryOut{}, nil
+	}
+
+	queryBuilder := squirrel.Insert(webhookEventDeliveriesTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Columns(idColumnName, subscriptionIdColumnName, eventIdColumnName, eventColumnName, payloadColumnName,
+			statusColumnName, nextAttemptAtColumnName, createdAtColumnName, updatedAtColumnName)
+
+	now := r.nower.Now()
+	for _, delivery := range deliveries {
+		deliveryID := delivery.ID
+		if deliveryID == uuid.Nil {
+			deliveryID = uuid.New()
+		}
+		nextAttemptAt := delivery.NextAttemptAt
+		if nextAttemptAt.IsZero() {
+			nextAttemptAt = now
+		}
+
+		queryBuilder = queryBuilder.Values(deliveryID, delivery.SubscriptionID, delivery.EventID, delivery.Event,
+			delivery.Payload, delivery.Status, nextAttemptAt, now, now)
+	}
+	queryBuilder = queryBuilder.Suffix(returnAll)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[webhookEventDeliveryDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	deliveryOuts := make([]WebhookEventDeliveryOut, 0, len(results))
+	for _, result := range results {
+		deliveryOuts = append(deliveryOuts, WebhookEventDeliveryOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository SaveWebhookEventDeliveriesBatch success", "count", len(deliveryOuts))
+	return &deliveryOuts, nil
+}
+
+// ClaimDueWebhookEventDeliveries locks up to limit deliveries in the given status whose next attempt is due,
+// rows locked by another dispatcher are skipped. Must be called inside a transaction to hold the locks.
+func (r *Repository) ClaimDueWebhookEventDeliveries(ctx context.Context, status string, limit uint64) (*[]DueWebhookEventDeliveryOut, error) {
+	columns := make([]string, 0, len(deliveryColumns)+2)
+	for _, column := range deliveryColumns {
+		columns = append(columns, "d."+column)
+	}
+	columns = append(columns, "s."+urlColumnName, "s."+secretColumnName)
+
+	selectBuilder := squirrel.
+		Select(columns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From(webhookEventDeliveriesTableName+" d").
+		Join(fmt.Sprintf("%s s ON s.%s = d.%s", webhookSubscriptionsTableName, idColumnName, subscriptionIdColumnName)).
+		Where(squirrel.Eq{"d." + statusColumnName: status}).
+		Where(squirrel.LtOrEq{"d." + nextAttemptAtColumnName: r.nower.Now()}).
+		OrderBy("d."+nextAttemptAtColumnName, "d."+idColumnName).
+		Limit(limit).
+		Suffix("FOR UPDATE OF d SKIP LOCKED")
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[dueWebhookEventDeliveryDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	deliveryOuts := make([]DueWebhookEventDeliveryOut, 0, len(results))
+	for _, result := range results {
+		deliveryOuts = append(deliveryOuts, DueWebhookEventDeliveryOut{
+			WebhookEventDeliveryOut: WebhookEventDeliveryOut(result.webhookEventDeliveryDB),
+			URL:                     result.URL,
+			Secret:                  result.Secret,
+		})
+	}
+
+	slog.DebugContext(ctx, "Repository ClaimDueWebhookEventDeliveries success", "count", len(deliveryOuts))
+	return &deliveryOuts, nil
+}
+
+func (r *Repository) UpdateWebhookEventDeliveryAttempt(ctx context.Context, attempt WebhookEventDeliveryAttemptIn) (*WebhookEventDeliveryOut, error) {
+	queryBuilder := squirrel.Update(webhookEventDeliveriesTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Set(statusColumnName, attempt.Status).
+		Set(attemptsColumnName, attempt.Attempts).
+		Set(nextAttemptAtColumnName, attempt.NextAttemptAt).
+		Set(lastErrorColumnName, attempt.LastError).
+		Set(responseCodeColumnName, attempt.ResponseCode).
+		Set(updatedAtColumnName, r.nower.Now()).
+		Where(squirrel.Eq{idColumnName: attempt.ID}).
+		Suffix(returnAll)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[webhookEventDeliveryDB])
+	if err != nil {
+		slog.DebugContext(ctx, err.Error())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", repository.ErrEventDeliveryNotFound, attempt.ID)
+		}
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository UpdateWebhookEventDeliveryAttempt success")
+	out := WebhookEventDeliveryOut(result)
+	return &out, nil
+}
+
+func (r *Repository) GetWebhookEventDeliveryByID(ctx context.Context, deliveryID uuid.UUID) (*WebhookEventDeliveryOut, error) {
+	selectBuilder := squirrel.
+		Select(deliveryColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From(webhookEventDeliveriesTableName).
+		Where(squirrel.Eq{idColumnName: deliveryID})
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[webhookEventDeliveryDB])
+	if err != nil {
+		slog.DebugContext(ctx, err.Error())
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", repository.ErrEventDeliveryNotFound, deliveryID)
+		}
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository GetWebhookEventDeliveryByID success")
+	out := WebhookEventDeliveryOut(result)
+	return &out, nil
+}
+
+// GetWebhookEventDeliveries returns a page of the delivery log, newest first.
+func (r *Repository) GetWebhookEventDeliveries(ctx context.Context, filter WebhookEventDeliveriesFilter) (*[]WebhookEventDeliveryOut, error) {
+	selectBuilder := squirrel.
+		Select(deliveryColumns...).
+		PlaceholderFormat(squirrel.Dollar).
+		From(webhookEventDeliveriesTableName).
+		OrderBy(createdAtColumnName+" DESC", idColumnName).
+		Limit(filter.Limit).
+		Offset(filter.Offset)
+	if filter.SubscriptionID != nil {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{subscriptionIdColumnName: *filter.SubscriptionID})
+	}
+	if filter.Status != nil {
+		selectBuilder = selectBuilder.Where(squirrel.Eq{statusColumnName: *filter.Status})
+	}
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[webhookEventDeliveryDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	deliveryOuts := make([]WebhookEventDeliveryOut, 0, len(results))
+	for _, result := range results {
+		deliveryOuts = append(deliveryOuts, WebhookEventDeliveryOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository GetWebhookEventDeliveries success", "count", len(deliveryOuts))
+	return &deliveryOuts, nil
+}
@@ -0,0 +1,348 @@
+package webhook_event_deliveries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/infrastructure/repository/webhook_subscriptions"
+	suite2 "pr-reviewers-service/test/suite"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	pendingStatus   = "PENDING"
+	deliveredStatus = "DELIVERED"
+	failedStatus    = "FAILED"
+)
+
+func (s *WebhookEventDeliveriesTest) saveSubscription(ctx context.Context, url string) uuid.UUID {
+	repo := webhook_subscriptions.NewRepository(suite2.GlobalPool, nower2.Nower{})
+	subscription, err := repo.SaveWebhookSubscription(ctx, webhook_subscriptions.WebhookSubscriptionIn{
+		URL:    url,
+		Secret: "subscriber-secret",
+		Events: []string{"reviewer.assigned"},
+	})
+	require.NoError(s.T(), err)
+	return subscription.ID
+}
+
+func newDelivery(subscriptionID uuid.UUID, status string, nextAttemptAt time.Time) WebhookEventDeliveryIn {
+	return WebhookEventDeliveryIn{
+		SubscriptionID: subscriptionID,
+		EventID:        uuid.New(),
+		Event:          "reviewer.assigned",
+		Payload:        []byte(`{"event":"reviewer.assigned"}`),
+		Status:         status,
+		NextAttemptAt:  nextAttemptAt,
+	}
+}
+
+func (s *WebhookEventDeliveriesTest) TestSaveWebhookEventDeliveriesBatch() {
+	tests := []struct {
+		name        string
+		input       func(subscriptionID uuid.UUID) []WebhookEventDeliveryIn
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, subscriptionID uuid.UUID, result *[]WebhookEventDeliveryOut)
+	}{
+		{
+			name: "successful SaveWebhookEventDeliveriesBatch returns saved deliveries",
+			input: func(subscriptionID uuid.UUID) []WebhookEventDeliveryIn {
+				return []WebhookEventDeliveryIn{
+					newDelivery(subscriptionID, pendingStatus, time.Time{}),
+					newDelivery(subscriptionID, pendingStatus, time.Time{}),
+				}
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, subscriptionID uuid.UUID, result *[]WebhookEventDeliveryOut) {
+				assert.Len(t, *result, 2)
+				for _, delivery := range *result {
+					assert.NotEqual(t, uuid.Nil, delivery.ID)
+					assert.Equal(t, subscriptionID, delivery.SubscriptionID)
+					assert.Equal(t, pendingStatus, delivery.Status)
+					assert.Equal(t, 0, delivery.Attempts)
+					assert.JSONEq(t, `{"event":"reviewer.assigned"}`, string(delivery.Payload))
+					assert.False(t, delivery.NextAttemptAt.IsZero())
+					assert.Nil(t, delivery.LastError)
+					assert.Nil(t, delivery.ResponseCode)
+				}
+			},
+		},
+		{
+			name: "SaveWebhookEventDeliveriesBatch with empty batch returns empty list",
+			input: func(uuid.UUID) []WebhookEventDeliveryIn {
+				return nil
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, _ uuid.UUID, result *[]WebhookEventDeliveryOut) {
+				assert.Empty(t, *result)
+			},
+		},
+		{
+			name: "SaveWebhookEventDeliveriesBatch with unknown subscription returns error",
+			input: func(uuid.UUID) []WebhookEventDeliveryIn {
+				return []WebhookEventDeliveryIn{newDelivery(uuid.New(), pendingStatus, time.Time{})}
+			},
+			checkErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+			subscriptionID := s.saveSubscription(ctx, "https://hooks.example.com")
+
+			result, err := repo.SaveWebhookEventDeliveriesBatch(ctx, tt.input(subscriptionID))
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, subscriptionID, result)
+			}
+		})
+	}
+}
+
+func (s *WebhookEventDeliveriesTest) TestClaimDueWebhookEventDeliveries() {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		limit       uint64
+		input       func(subscriptionID uuid.UUID) []WebhookEventDeliveryIn
+		checkResult func(t *testing.T, result *[]DueWebhookEventDeliveryOut)
+	}{
+		{
+			name:  "ClaimDueWebhookEventDeliveries returns due pending deliveries with endpoint",
+			limit: 10,
+			input: func(subscriptionID uuid.UUID) []WebhookEventDeliveryIn {
+				return []WebhookEventDeliveryIn{
+					newDelivery(subscriptionID, pendingStatus, now.Add(-time.Minute)),
+					newDelivery(subscriptionID, pendingStatus, now.Add(time.Hour)),
+					newDelivery(subscriptionID, deliveredStatus, now.Add(-time.Minute)),
+					newDelivery(subscriptionID, failedStatus, now.Add(-time.Minute)),
+				}
+			},
+			checkResult: func(t *testing.T, result *[]DueWebhookEventDeliveryOut) {
+				require.Len(t, *result, 1)
+				assert.Equal(t, pendingStatus, (*result)[0].Status)
+				assert.Equal(t, "https://hooks.example.com", (*result)[0].URL)
+				assert.Equal(t, "subscriber-secret", (*result)[0].Secret)
+			},
+		},
+		{
+			name:  "ClaimDueWebhookEventDeliveries respects limit and oldest attempt first",
+			limit: 1,
+			input: func(subscriptionID uuid.UUID) []WebhookEventDeliveryIn {
+				older := newDelivery(subscriptionID, pendingStatus, now.Add(-time.Hour))
+				older.Event = "reviewer.unassigned"
+				return []WebhookEventDeliveryIn{
+					newDelivery(subscriptionID, pendingStatus, now.Add(-time.Minute)),
+					older,
+				}
+			},
+			checkResult: func(t *testing.T, result *[]DueWebhookEventDeliveryOut) {
+				require.Len(t, *result, 1)
+				assert.Equal(t, "reviewer.unassigned", (*result)[0].Event)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+			subscriptionID := s.saveSubscription(ctx, "https://hooks.example.com")
+			_, err := repo.SaveWebhookEventDeliveriesBatch(ctx, tt.input(subscriptionID))
+			require.NoError(t, err)
+
+			result, err := repo.ClaimDueWebhookEventDeliveries(ctx, pendingStatus, tt.limit)
+			assert.NoError(t, err)
+			tt.checkResult(t, result)
+		})
+	}
+}
+
+func (s *WebhookEventDeliveriesTest) TestUpdateWebhookEventDeliveryAttempt() {
+	nextAttemptAt := time.Now().Add(time.Minute).Truncate(time.Microsecond)
+	lastError := "receiver returned 500"
+	responseCode := 500
+
+	tests := []struct {
+		name        string
+		known       bool
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *WebhookEventDeliveryOut)
+	}{
+		{
+			name:     "successful UpdateWebhookEventDeliveryAttempt",
+			known:    true,
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *WebhookEventDeliveryOut) {
+				assert.Equal(t, pendingStatus, result.Status)
+				assert.Equal(t, 1, result.Attempts)
+				assert.True(t, nextAttemptAt.Equal(result.NextAttemptAt))
+				assert.Equal(t, &lastError, result.LastError)
+				assert.Equal(t, &responseCode, result.ResponseCode)
+			},
+		},
+		{
+			name: "UpdateWebhookEventDeliveryAttempt with unknown id returns error",
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrEventDeliveryNotFound, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+			deliveryID := uuid.New()
+			if tt.known {
+				subscriptionID := s.saveSubscription(ctx, "https://hooks.example.com")
+				delivery := newDelivery(subscriptionID, pendingStatus, time.Time{})
+				delivery.ID = deliveryID
+				_, err := repo.SaveWebhookEventDeliveriesBatch(ctx, []WebhookEventDeliveryIn{delivery})
+				require.NoError(t, err)
+			}
+
+			result, err := repo.UpdateWebhookEventDeliveryAttempt(ctx, WebhookEventDeliveryAttemptIn{
+				ID:            deliveryID,
+				Status:        pendingStatus,
+				Attempts:      1,
+				NextAttemptAt: nextAttemptAt,
+				LastError:     &lastError,
+				ResponseCode:  &responseCode,
+			})
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *WebhookEventDeliveriesTest) TestGetWebhookEventDeliveryByID() {
+	tests := []struct {
+		name     string
+		known    bool
+		checkErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "successful GetWebhookEventDeliveryByID",
+			known:    true,
+			checkErr: assert.NoError,
+		},
+		{
+			name: "GetWebhookEventDeliveryByID with unknown id returns error",
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrEventDeliveryNotFound, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+			deliveryID := uuid.New()
+			if tt.known {
+				subscriptionID := s.saveSubscription(ctx, "https://hooks.example.com")
+				delivery := newDelivery(subscriptionID, pendingStatus, time.Time{})
+				delivery.ID = deliveryID
+				_, err := repo.SaveWebhookEventDeliveriesBatch(ctx, []WebhookEventDeliveryIn{delivery})
+				require.NoError(t, err)
+			}
+
+			result, err := repo.GetWebhookEventDeliveryByID(ctx, deliveryID)
+			tt.checkErr(t, err)
+			if tt.known {
+				assert.Equal(t, deliveryID, result.ID)
+			}
+		})
+	}
+}
+
+func (s *WebhookEventDeliveriesTest) TestGetWebhookEventDeliveries() {
+	failed := failedStatus
+
+	tests := []struct {
+		name        string
+		filter      func(first, second uuid.UUID) WebhookEventDeliveriesFilter
+		checkResult func(t *testing.T, first, second uuid.UUID, result *[]WebhookEventDeliveryOut)
+	}{
+		{
+			name: "GetWebhookEventDeliveries without filters returns all deliveries",
+			filter: func(uuid.UUID, uuid.UUID) WebhookEventDeliveriesFilter {
+				return WebhookEventDeliveriesFilter{Limit: 10}
+			},
+			checkResult: func(t *testing.T, _, _ uuid.UUID, result *[]WebhookEventDeliveryOut) {
+				assert.Len(t, *result, 3)
+			},
+		},
+		{
+			name: "GetWebhookEventDeliveries filters by subscription",
+			filter: func(_, second uuid.UUID) WebhookEventDeliveriesFilter {
+				return WebhookEventDeliveriesFilter{SubscriptionID: &second, Limit: 10}
+			},
+			checkResult: func(t *testing.T, _, second uuid.UUID, result *[]WebhookEventDeliveryOut) {
+				require.Len(t, *result, 1)
+				assert.Equal(t, second, (*result)[0].SubscriptionID)
+			},
+		},
+		{
+			name: "GetWebhookEventDeliveries filters by status",
+			filter: func(uuid.UUID, uuid.UUID) WebhookEventDeliveriesFilter {
+				return WebhookEventDeliveriesFilter{Status: &failed, Limit: 10}
+			},
+			checkResult: func(t *testing.T, first, _ uuid.UUID, result *[]WebhookEventDeliveryOut) {
+				require.Len(t, *result, 1)
+				assert.Equal(t, first, (*result)[0].SubscriptionID)
+				assert.Equal(t, failedStatus, (*result)[0].Status)
+			},
+		},
+		{
+			name: "GetWebhookEventDeliveries applies limit and offset",
+			filter: func(uuid.UUID, uuid.UUID) WebhookEventDeliveriesFilter {
+				return WebhookEventDeliveriesFilter{Limit: 2, Offset: 2}
+			},
+			checkResult: func(t *testing.T, _, _ uuid.UUID, result *[]WebhookEventDeliveryOut) {
+				assert.Len(t, *result, 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+			first := s.saveSubscription(ctx, "https://first.example.com")
+			second := s.saveSubscription(ctx, "https://second.example.com")
+			_, err := repo.SaveWebhookEventDeliveriesBatch(ctx, []WebhookEventDeliveryIn{
+				newDelivery(first, pendingStatus, time.Time{}),
+				newDelivery(first, failedStatus, time.Time{}),
+				newDelivery(second, deliveredStatus, time.Time{}),
+			})
+			require.NoError(t, err)
+
+			result, err := repo.GetWebhookEventDeliveries(ctx, tt.filter(first, second))
+			assert.NoError(t, err)
+			tt.checkResult(t, first, second, result)
+		})
+	}
+}
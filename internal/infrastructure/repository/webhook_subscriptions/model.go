@@ -0,0 +1,30 @@
+package webhook_subscriptions
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookSubscriptionIn struct {
+	ID     uuid.UUID
+	URL    string
+	Secret string
+	Events []string
+}
+
+type WebhookSubscriptionOut struct {
+	ID        uuid.UUID
+	URL       string
+	Secret    string
+	Events    []string
+	CreatedAt time.Time
+}
+
+type webhookSubscriptionDB struct {
+	ID        uuid.UUID `db:"id"`
+	URL       string    `db:"url"`
+	Secret    string    `db:"secret"`
+	Events    []string  `db:"events"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,136 @@
+package webhook_subscriptions
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	nower2 "pr-reviewers-service/internal/usecase/contract/nower"
+
+	"github.com/Masterminds/squirrel"
+	trm "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	webhookSubscriptionsTableName = "webhook_subscriptions"
+	idColumnName                  = "id"
+	urlColumnName                 = "url"
+	secretColumnName              = "secret"
+	eventsColumnName              = "events"
+	createdAtColumnName           = "created_at"
+
+	returnAll = "RETURNING *"
+)
+
+type Repository struct {
+	db    *pgxpool.Pool
+	nower nower2.Nower
+}
+
+func NewRepository(pool *pgxpool.Pool, nower nower2.Nower) *Repository {
+	return &Repository{db: pool, nower: nower}
+}
+
+func (r *Repository) SaveWebhookSubscription(ctx context.Context, subscription WebhookSubscriptionIn) (*WebhookSubscriptionOut, error) {
+	subscriptionID := subscription.ID
+	if subscriptionID == uuid.Nil {
+		subscriptionID = uuid.New()
+	}
+
+	queryBuilder := squirrel.Insert(webhookSubscriptionsTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Columns(idColumnName, urlColumnName, secretColumnName, eventsColumnName, createdAtColumnName).
+		Values(subscriptionID, subscription.URL, subscription.Secret, subscription.Events, r.nower.Now()).
+		Suffix(returnAll)
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	result, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[webhookSubscriptionDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	slog.DebugContext(ctx, "Repository SaveWebhookSubscription success")
+	out := WebhookSubscriptionOut(result)
+	return &out, nil
+}
+
+// GetWebhookSubscriptions returns all subscriptions, oldest first.
+func (r *Repository) GetWebhookSubscriptions(ctx context.Context) (*[]WebhookSubscriptionOut, error) {
+	selectBuilder := squirrel.
+		Select(idColumnName, urlColumnName, secretColumnName, eventsColumnName, createdAtColumnName).
+		PlaceholderFormat(squirrel.Dollar).
+		From(webhookSubscriptionsTableName).
+		OrderBy(createdAtColumnName, idColumnName)
+
+	sql, args, err := selectBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	defer rows.Close()
+
+	results, err := pgx.CollectRows(rows, pgx.RowToStructByName[webhookSubscriptionDB])
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return nil, fmt.Errorf("%w: %v", repository.ErrScanResult, err)
+	}
+
+	subscriptionOuts := make([]WebhookSubscriptionOut, 0, len(results))
+	for _, result := range results {
+		subscriptionOuts = append(subscriptionOuts, WebhookSubscriptionOut(result))
+	}
+
+	slog.DebugContext(ctx, "Repository GetWebhookSubscriptions success", "count", len(subscriptionOuts))
+	return &subscriptionOuts, nil
+}
+
+// DeleteWebhookSubscription removes the subscription together with its delivery log.
+func (r *Repository) DeleteWebhookSubscription(ctx context.Context, subscriptionID uuid.UUID) error {
+	queryBuilder := squirrel.Delete(webhookSubscriptionsTableName).
+		PlaceholderFormat(squirrel.Dollar).
+		Where(squirrel.Eq{idColumnName: subscriptionID})
+
+	sql, args, err := queryBuilder.ToSql()
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return fmt.Errorf("%w: %v", repository.ErrBuildQuery, err)
+	}
+
+	q := trm.DefaultCtxGetter.DefaultTrOrDB(ctx, r.db)
+	tag, err := q.Exec(ctx, sql, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, err.Error())
+		return fmt.Errorf("%w: %v", repository.ErrExecuteQuery, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: %s", repository.ErrSubscriptionNotFound, subscriptionID)
+	}
+
+	slog.DebugContext(ctx, "Repository DeleteWebhookSubscription success")
+	return nil
+}
@@ -0,0 +1,167 @@
+package webhook_subscriptions
+
+import (
+	"context"
+	"testing"
+
+	nower2 "pr-reviewers-service/internal/infrastructure/nower"
+	"pr-reviewers-service/internal/infrastructure/repository"
+	suite2 "pr-reviewers-service/test/suite"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func (s *WebhookSubscriptionsTest) TestSaveWebhookSubscription() {
+	tests := []struct {
+		name        string
+		input       WebhookSubscriptionIn
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *WebhookSubscriptionOut)
+	}{
+		{
+			name: "successful SaveWebhookSubscription returns saved subscription",
+			input: WebhookSubscriptionIn{
+				URL:    "https://hooks.example.com/reviewers",
+				Secret: "subscriber-secret",
+				Events: []string{"reviewer.assigned", "pull_request.merged"},
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *WebhookSubscriptionOut) {
+				assert.NotNil(t, result)
+				assert.NotEqual(t, uuid.Nil, result.ID)
+				assert.Equal(t, "https://hooks.example.com/reviewers", result.URL)
+				assert.Equal(t, "subscriber-secret", result.Secret)
+				assert.Equal(t, []string{"reviewer.assigned", "pull_request.merged"}, result.Events)
+				assert.False(t, result.CreatedAt.IsZero())
+			},
+		},
+		{
+			name: "SaveWebhookSubscription keeps given id",
+			input: WebhookSubscriptionIn{
+				ID:     uuid.MustParse("3f1b0b7e-2a44-4b5e-9a4f-5f0e8d8b6c11"),
+				URL:    "https://hooks.example.com/reviewers",
+				Secret: "subscriber-secret",
+				Events: []string{"reviewer.unassigned"},
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *WebhookSubscriptionOut) {
+				assert.Equal(t, uuid.MustParse("3f1b0b7e-2a44-4b5e-9a4f-5f0e8d8b6c11"), result.ID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			result, err := repo.SaveWebhookSubscription(ctx, tt.input)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *WebhookSubscriptionsTest) TestGetWebhookSubscriptions() {
+	tests := []struct {
+		name        string
+		setup       func(ctx context.Context, repo *Repository)
+		checkErr    assert.ErrorAssertionFunc
+		checkResult func(t *testing.T, result *[]WebhookSubscriptionOut)
+	}{
+		{
+			name:     "GetWebhookSubscriptions without subscriptions returns empty list",
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]WebhookSubscriptionOut) {
+				assert.NotNil(t, result)
+				assert.Empty(t, *result)
+			},
+		},
+		{
+			name: "GetWebhookSubscriptions returns subscriptions oldest first",
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveWebhookSubscription(ctx, WebhookSubscriptionIn{
+					URL: "https://first.example.com", Secret: "first", Events: []string{"reviewer.assigned"},
+				})
+				assert.NoError(s.T(), err)
+				_, err = repo.SaveWebhookSubscription(ctx, WebhookSubscriptionIn{
+					URL: "https://second.example.com", Secret: "second", Events: []string{"pull_request.merged"},
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+			checkResult: func(t *testing.T, result *[]WebhookSubscriptionOut) {
+				assert.Len(t, *result, 2)
+				assert.Equal(t, "https://first.example.com", (*result)[0].URL)
+				assert.Equal(t, "https://second.example.com", (*result)[1].URL)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			result, err := repo.GetWebhookSubscriptions(ctx)
+			tt.checkErr(t, err)
+			if tt.checkResult != nil {
+				tt.checkResult(t, result)
+			}
+		})
+	}
+}
+
+func (s *WebhookSubscriptionsTest) TestDeleteWebhookSubscription() {
+	subscriptionID := uuid.MustParse("3f1b0b7e-2a44-4b5e-9a4f-5f0e8d8b6c11")
+
+	tests := []struct {
+		name     string
+		setup    func(ctx context.Context, repo *Repository)
+		checkErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "successful DeleteWebhookSubscription",
+			setup: func(ctx context.Context, repo *Repository) {
+				_, err := repo.SaveWebhookSubscription(ctx, WebhookSubscriptionIn{
+					ID: subscriptionID, URL: "https://hooks.example.com", Secret: "secret", Events: []string{"reviewer.assigned"},
+				})
+				assert.NoError(s.T(), err)
+			},
+			checkErr: assert.NoError,
+		},
+		{
+			name: "DeleteWebhookSubscription with unknown id returns error",
+			checkErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, repository.ErrSubscriptionNotFound, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			s.SetupTest()
+
+			ctx := context.Background()
+			repo := NewRepository(suite2.GlobalPool, nower2.Nower{})
+
+			if tt.setup != nil {
+				tt.setup(ctx, repo)
+			}
+
+			err := repo.DeleteWebhookSubscription(ctx, subscriptionID)
+			tt.checkErr(t, err)
+		})
+	}
+}
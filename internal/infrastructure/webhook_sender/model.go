@@ -0,0 +1,11 @@
+package webhook_sender
+
+import "github.com/google/uuid"
+
+type Request struct {
+	URL        string
+	Secret     string
+	DeliveryID uuid.UUID
+	Event      string
+	Payload    []byte
+}
@@ -0,0 +1,99 @@
+package webhook_sender
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	deliveryID := uuid.New()
+	payload := []byte(`{"event":"reviewer.assigned"}`)
+
+	tests := []struct {
+		name      string
+		status    int
+		wantCode  int
+		wantError error
+	}{
+		{
+			name:     "receiver accepts delivery",
+			status:   http.StatusNoContent,
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:      "receiver fails delivery",
+			status:    http.StatusInternalServerError,
+			wantCode:  http.StatusInternalServerError,
+			wantError: ErrUnexpectedStatus,
+		},
+		{
+			name:      "receiver redirects delivery",
+			status:    http.StatusFound,
+			wantCode:  http.StatusFound,
+			wantError: ErrUnexpectedStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *http.Request
+			var gotBody []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r
+				gotBody, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			sender := New(time.Second)
+			code, err := sender.Send(context.Background(), Request{
+				URL:        server.URL,
+				Secret:     "subscriber-secret",
+				DeliveryID: deliveryID,
+				Event:      "reviewer.assigned",
+				Payload:    payload,
+			})
+
+			assert.Equal(t, tt.wantCode, code)
+			if tt.wantError != nil {
+				assert.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NotNil(t, got)
+			assert.Equal(t, http.MethodPost, got.Method)
+			assert.Equal(t, "application/json", got.Header.Get("Content-Type"))
+			assert.Equal(t, "reviewer.assigned", got.Header.Get(EventHeader))
+			assert.Equal(t, deliveryID.String(), got.Header.Get(DeliveryHeader))
+			assert.Equal(t, Sign("subscriber-secret", payload), got.Header.Get(SignatureHeader))
+			assert.Equal(t, payload, gotBody)
+		})
+	}
+}
+
+func TestSendUnreachableReceiver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	code, err := New(time.Second).Send(context.Background(), Request{URL: url, Payload: []byte(`{}`)})
+
+	assert.Equal(t, 0, code)
+	assert.Error(t, err)
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t,
+		"sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17",
+		Sign("It's a Secret to Everybody", []byte("Hello, World!")),
+	)
+}
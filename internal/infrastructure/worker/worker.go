@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Job is one run of a background job, an error is logged and the job runs again on the next tick.
+type Job func(ctx context.Context) error
+
+type Periodic struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+func NewPeriodic(name string, interval time.Duration, job Job) *Periodic {
+	return &Periodic{
+		name:     name,
+		interval: interval,
+		job:      job,
+	}
+}
+
+func (p *Periodic) Name() string {
+	return p.name
+}
+
+// Run calls the job every interval until ctx is cancelled. A run in progress is allowed to finish.
+func (p *Periodic) Run(ctx context.Context) {
+	slog.InfoContext(ctx, "worker started", "worker", p.name, "interval", p.interval.String())
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "worker stopped", "worker", p.name)
+			return
+		case <-ticker.C:
+			if err := p.job(ctx); err != nil {
+				slog.ErrorContext(ctx, "worker run failed", "worker", p.name, "error", err)
+			}
+		}
+	}
+}
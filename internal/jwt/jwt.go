@@ -30,7 +30,8 @@ func GenerateToken(secret, role string, uid uuid.UUID, expiresIn time.Duration)
 	return tokenString, nil
 }
 
-func ParseToken(tokenString, secret string) (string, error) {
+// ParseToken checks the token and returns its role and the id of the user it was issued to.
+func ParseToken(tokenString, secret string) (string, uuid.UUID, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("%w: %v", ErrUnexpSignMethod, token.Header["alg"])
@@ -39,16 +40,24 @@ func ParseToken(tokenString, secret string) (string, error) {
 	})
 
 	if err != nil {
-		return "", fmt.Errorf("%w: %v", ErrFailedParse, err)
+		return "", uuid.Nil, fmt.Errorf("%w: %v", ErrFailedParse, err)
 	}
 
 	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
 		role, ok := claims["role"].(string)
 		if !ok {
-			return "", fmt.Errorf("invalid token: role claim missing or not a string")
+			return "", uuid.Nil, fmt.Errorf("invalid token: role claim missing or not a string")
 		}
-		return role, nil
+		id, ok := claims["id"].(string)
+		if !ok {
+			return "", uuid.Nil, fmt.Errorf("invalid token: id claim missing or not a string")
+		}
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			return "", uuid.Nil, fmt.Errorf("invalid token: id claim is not a uuid: %w", err)
+		}
+		return role, uid, nil
 	}
 
-	return "", jwt.ErrInvalidKey
+	return "", uuid.Nil, jwt.ErrInvalidKey
 }
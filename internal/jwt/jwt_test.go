@@ -21,22 +21,23 @@ func TestGenerateAndParseToken(t *testing.T) {
 		require.NoError(t, err)
 		require.NotEmpty(t, tokenStr)
 
-		parsedRole, err := ParseToken(tokenStr, secret)
+		parsedRole, parsedID, err := ParseToken(tokenStr, secret)
 		require.NoError(t, err)
 		assert.Equal(t, role, parsedRole)
+		assert.Equal(t, uid, parsedID)
 	})
 
 	t.Run("ParseToken with wrong secret", func(t *testing.T) {
 		validToken, err := GenerateToken(secret, role, uid, expiresIn)
 		require.NoError(t, err)
 
-		parsedRole, err := ParseToken(validToken, "wrongsecret")
+		parsedRole, _, err := ParseToken(validToken, "wrongsecret")
 		assert.Error(t, err)
 		assert.Empty(t, parsedRole)
 	})
 
 	t.Run("ParseToken with invalid token format", func(t *testing.T) {
-		parsedRole, err := ParseToken("this.is.not.a.token", secret)
+		parsedRole, _, err := ParseToken("this.is.not.a.token", secret)
 		assert.Error(t, err)
 		assert.Empty(t, parsedRole)
 	})
@@ -50,7 +51,7 @@ func TestGenerateAndParseToken(t *testing.T) {
 		})
 		tokenString, _ := token.SignedString([]byte("dummy"))
 
-		parsedRole, err := ParseToken(tokenString, secret)
+		parsedRole, _, err := ParseToken(tokenString, secret)
 		assert.Error(t, err)
 		assert.Empty(t, parsedRole)
 	})
@@ -65,8 +66,40 @@ func TestGenerateAndParseToken(t *testing.T) {
 		tokenString, err := token.SignedString([]byte(secret))
 		require.NoError(t, err)
 
-		parsedRole, err := ParseToken(tokenString, secret)
+		parsedRole, _, err := ParseToken(tokenString, secret)
 		assert.Error(t, err)
 		assert.Empty(t, parsedRole)
 	})
+
+	t.Run("ParseToken with missing id claim", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"role": role,
+			"iat":  jwt.NewNumericDate(time.Now()),
+			"exp":  jwt.NewNumericDate(time.Now().Add(expiresIn)),
+		})
+
+		tokenString, err := token.SignedString([]byte(secret))
+		require.NoError(t, err)
+
+		parsedRole, parsedID, err := ParseToken(tokenString, secret)
+		assert.Error(t, err)
+		assert.Empty(t, parsedRole)
+		assert.Equal(t, uuid.Nil, parsedID)
+	})
+
+	t.Run("ParseToken with invalid id claim", func(t *testing.T) {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"role": role,
+			"id":   "not-a-uuid",
+			"iat":  jwt.NewNumericDate(time.Now()),
+			"exp":  jwt.NewNumericDate(time.Now().Add(expiresIn)),
+		})
+
+		tokenString, err := token.SignedString([]byte(secret))
+		require.NoError(t, err)
+
+		_, parsedID, err := ParseToken(tokenString, secret)
+		assert.Error(t, err)
+		assert.Equal(t, uuid.Nil, parsedID)
+	})
 }
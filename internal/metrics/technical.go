@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
 )
 
 var (
@@ -32,6 +33,31 @@ var (
 		},
 		[]string{"path", "status"},
 	)
+
+	GrpcRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_hits_total",
+			Help: "Total number of gRPC requests.",
+		},
+		[]string{"method"},
+	)
+
+	GrpcResponseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "grpc_time_hits",
+			Help:    "Duration of gRPC requests.",
+			Buckets: []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 15, 20, 25, 30, 35, 40, 50, 60, 70, 80, 90, 100},
+		},
+		[]string{"method"},
+	)
+
+	GrpcResponsesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_hits_codes",
+			Help: "Status codes for gRPC responses.",
+		},
+		[]string{"method", "code"},
+	)
 )
 
 func IncRestRequestsTotal(path string) {
@@ -45,3 +71,15 @@ func IncRestResponsesDuration(path, method string, timeServe time.Duration) {
 func IncRestResponsesStatusesTotal(path string, status int) {
 	RestEndpointsResponsesTotal.WithLabelValues(path, http.StatusText(status)).Inc()
 }
+
+func IncGrpcRequestsTotal(method string) {
+	GrpcRequestsTotal.WithLabelValues(method).Inc()
+}
+
+func IncGrpcResponsesDuration(method string, timeServe time.Duration) {
+	GrpcResponseDuration.WithLabelValues(method).Observe(float64(timeServe.Milliseconds()))
+}
+
+func IncGrpcResponsesCodesTotal(method string, code codes.Code) {
+	GrpcResponsesTotal.WithLabelValues(method, code.String()).Inc()
+}
@@ -1,19 +1,27 @@
 package add_team
 
-import "github.com/google/uuid"
+import (
+	usecase2 "pr-reviewers-service/internal/usecase"
 
+	"github.com/google/uuid"
+)
+
+// In.ParentTeamName nil keeps the parent of an existing team, an empty name makes the team a root one.
 type In struct {
-	TeamName string
-	Members  []TeamMembers
+	TeamName       string
+	ParentTeamName *string
+	Members        []TeamMembers
 }
 
 type Out struct {
-	TeamName string
-	Members  []TeamMembers
+	TeamName       string
+	ParentTeamName string
+	Members        []TeamMembers
 }
 
 type TeamMembers struct {
-	IsActive bool
-	UserID   uuid.UUID
-	Username string
+	IsActive   bool
+	UserID     uuid.UUID
+	Username   string
+	Identities []usecase2.Identity
 }
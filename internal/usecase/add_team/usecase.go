@@ -7,12 +7,17 @@ import (
 	"log/slog"
 
 	"pr-reviewers-service/internal/infrastructure/repository"
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
 	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
 	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
 	"pr-reviewers-service/internal/logging"
 	"pr-reviewers-service/internal/metrics"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/repository/team_memberships"
 	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
 	"pr-reviewers-service/internal/usecase/contract/repository/users"
 
 	"github.com/avito-tech/go-transaction-manager/trm/v2"
@@ -20,16 +25,29 @@ import (
 )
 
 type usecase struct {
-	repUsers users.RepositoryUsers
-	repTeams teams.RepositoryTeams
-	trm      trm.Manager
+	repUsers       users.RepositoryUsers
+	repTeams       teams.RepositoryTeams
+	repMemberships team_memberships.RepositoryTeamMemberships
+	repIdentities  user_identities.RepositoryUserIdentities
+	publisher      events.Publisher
+	trm            trm.Manager
 }
 
-func Newusecase(repUsers users.RepositoryUsers, repTeams teams.RepositoryTeams, trm trm.Manager) *usecase {
+func Newusecase(
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+	repMemberships team_memberships.RepositoryTeamMemberships,
+	repIdentities user_identities.RepositoryUserIdentities,
+	publisher events.Publisher,
+	trm trm.Manager,
+) *usecase {
 	return &usecase{
-		repUsers: repUsers,
-		repTeams: repTeams,
-		trm:      trm,
+		repUsers:       repUsers,
+		repTeams:       repTeams,
+		repMemberships: repMemberships,
+		repIdentities:  repIdentities,
+		publisher:      publisher,
+		trm:            trm,
 	}
 }
 
@@ -53,6 +71,29 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		userIDSet[member.UserID] = struct{}{}
 	}
 
+	identitiesByUser, err := normalizeIdentities(ctx, req.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentTeam *teams2.TeamOut
+	detachParent := req.ParentTeamName != nil && *req.ParentTeamName == ""
+	if req.ParentTeamName != nil && *req.ParentTeamName != "" {
+		parentTeamName := *req.ParentTeamName
+		if parentTeamName == req.TeamName {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrTeamHierarchyCycle, req.TeamName))
+		}
+
+		slog.DebugContext(ctx, "Call GetTeamByName for parent team", "parent_team_name", parentTeamName)
+		parentTeam, err = u.repTeams.GetTeamByName(ctx, parentTeamName)
+		if err != nil {
+			if errors.Is(err, repository.ErrTeamNotFound) {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrParentTeamNotFound, parentTeamName))
+			}
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, parentTeamName))
+		}
+	}
+
 	slog.DebugContext(ctx, "Call GetTeamByName")
 	existingTeam, err := u.repTeams.GetTeamByName(ctx, req.TeamName)
 	if err != nil && !errors.Is(err, repository.ErrTeamNotFound) {
@@ -61,17 +102,42 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 
 	var teamID uuid.UUID
 	var teamOut *teams2.TeamOut
+	parentChanged := false
 
 	if existingTeam != nil {
 		slog.DebugContext(ctx, "Found team")
 		teamID = existingTeam.ID
 		teamOut = existingTeam
+
+		if parentTeam != nil && (existingTeam.ParentTeamID == nil || *existingTeam.ParentTeamID != parentTeam.ID) {
+			if err = u.checkNotDescendant(ctx, parentTeam, existingTeam.ID); err != nil {
+				return nil, err
+			}
+
+			slog.DebugContext(ctx, "Call UpdateTeamParent", "parent_team_id", parentTeam.ID)
+			teamOut, err = u.repTeams.UpdateTeamParent(ctx, existingTeam.ID, &parentTeam.ID)
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateTeam, req.TeamName))
+			}
+			parentChanged = true
+		}
+		if detachParent && existingTeam.ParentTeamID != nil {
+			slog.DebugContext(ctx, "Call UpdateTeamParent to detach the team")
+			teamOut, err = u.repTeams.UpdateTeamParent(ctx, existingTeam.ID, nil)
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateTeam, req.TeamName))
+			}
+			parentChanged = true
+		}
 	} else {
 		slog.DebugContext(ctx, "Call SaveTeam")
 		teamIn := teams2.TeamIn{
 			ID:   uuid.New(),
 			Name: req.TeamName,
 		}
+		if parentTeam != nil {
+			teamIn.ParentTeamID = &parentTeam.ID
+		}
 		teamOut, err = u.repTeams.SaveTeam(ctx, teamIn)
 		if err != nil {
 			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSaveTeam, req.TeamName))
@@ -99,6 +165,7 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 
 	var usersToUpdate []users2.UserIn
 	var usersToCreate []users2.UserIn
+	var membershipsToCreate []team_memberships2.TeamMembershipIn
 	for _, member := range req.Members {
 		userIn := users2.UserIn{
 			ID:       member.UserID,
@@ -107,6 +174,15 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 			TeamID:   teamID,
 		}
 		if existingUser, exists := existingUsersMap[member.UserID]; exists {
+			// The primary team is kept, listing a user in another team only adds a membership.
+			userIn.TeamID = existingUser.TeamID
+			if existingUser.TeamID != teamID {
+				membershipsToCreate = append(membershipsToCreate, team_memberships2.TeamMembershipIn{
+					UserID:    member.UserID,
+					TeamID:    teamID,
+					IsPrimary: false,
+				})
+			}
 			if !userNeedsUpdate(existingUser, userIn) {
 				slog.DebugContext(ctx, "User dont need update", "user_id", member.UserID)
 				continue
@@ -114,10 +190,16 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 			usersToUpdate = append(usersToUpdate, userIn)
 		} else {
 			usersToCreate = append(usersToCreate, userIn)
+			membershipsToCreate = append(membershipsToCreate, team_memberships2.TeamMembershipIn{
+				UserID:    member.UserID,
+				TeamID:    teamID,
+				IsPrimary: true,
+			})
 		}
 	}
 
 	var allUsers []users2.UserOut
+	processedUserIDs := make(map[uuid.UUID]struct{})
 	if len(usersToCreate) > 0 {
 		slog.DebugContext(ctx, "Call SaveUsersBatch for new users")
 		createdUsers, err := u.repUsers.SaveUsersBatch(ctx, usersToCreate)
@@ -134,27 +216,174 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		}
 		allUsers = append(allUsers, *updatedUsers...)
 	}
+	for _, user := range allUsers {
+		processedUserIDs[user.ID] = struct{}{}
+	}
+
+	if len(membershipsToCreate) > 0 {
+		slog.DebugContext(ctx, "Call SaveTeamMembershipsBatch", "memberships_count", len(membershipsToCreate))
+		createdMemberships, err := u.repMemberships.SaveTeamMembershipsBatch(ctx, membershipsToCreate)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrSaveTeamMemberships))
+		}
+		for _, membership := range *createdMemberships {
+			if _, processed := processedUserIDs[membership.UserID]; processed {
+				continue
+			}
+			slog.DebugContext(ctx, "User joined team as additional member", "user_id", membership.UserID)
+			allUsers = append(allUsers, existingUsersMap[membership.UserID])
+			processedUserIDs[membership.UserID] = struct{}{}
+		}
+	}
+
+	linkedUserIDs, err := u.linkIdentities(ctx, userIDs, identitiesByUser)
+	if err != nil {
+		return nil, err
+	}
+	for _, userID := range linkedUserIDs {
+		if _, processed := processedUserIDs[userID]; processed {
+			continue
+		}
+		slog.DebugContext(ctx, "User got new identities", "user_id", userID)
+		allUsers = append(allUsers, existingUsersMap[userID])
+		processedUserIDs[userID] = struct{}{}
+	}
 
 	processedMembers := make([]TeamMembers, 0, len(allUsers))
 	for _, user := range allUsers {
 		processedMembers = append(processedMembers, TeamMembers{
-			UserID:   user.ID,
-			Username: user.Name,
-			IsActive: user.IsActive,
+			UserID:     user.ID,
+			Username:   user.Name,
+			IsActive:   user.IsActive,
+			Identities: identitiesByUser[user.ID],
 		})
 	}
 
 	slog.DebugContext(ctx, "UseCase AddTeam success")
-	if len(processedMembers) == 0 {
+	if len(processedMembers) == 0 && !parentChanged {
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrNoUsersWereUpdatedAddedTeam))
 	}
 
+	eventType := usecase2.EventTeamUpdated
+	if existingTeam == nil {
+		eventType = usecase2.EventTeamCreated
+	}
+	slog.DebugContext(ctx, "Publish team event", "type", eventType)
+	if err = u.publisher.Publish(ctx, []usecase2.Event{{Type: eventType, TeamName: teamOut.Name}}); err != nil {
+		return nil, err
+	}
+
 	metrics.IncCreatedTeams()
 	metrics.IncCreatedUsers(len(processedMembers))
-	return &Out{
+	out := &Out{
 		TeamName: teamOut.Name,
 		Members:  processedMembers,
-	}, nil
+	}
+	if parentTeam != nil {
+		out.ParentTeamName = parentTeam.Name
+	}
+	return out, nil
+}
+
+// normalizeIdentities validates identities of all members, the same identity given to two members
+// can never be linked and fails the request before anything is written.
+func normalizeIdentities(ctx context.Context, members []TeamMembers) (map[uuid.UUID][]usecase2.Identity, error) {
+	identitiesByUser := make(map[uuid.UUID][]usecase2.Identity)
+	owners := make(map[usecase2.Identity]uuid.UUID)
+	for _, member := range members {
+		for _, raw := range member.Identities {
+			identity, err := usecase2.NewIdentity(raw.Provider, raw.ExternalID)
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", err, member.UserID))
+			}
+			if owner, seen := owners[identity]; seen {
+				if owner != member.UserID {
+					return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrIdentityAlreadyLinked, identity))
+				}
+				continue
+			}
+			owners[identity] = member.UserID
+			identitiesByUser[member.UserID] = append(identitiesByUser[member.UserID], identity)
+		}
+	}
+	return identitiesByUser, nil
+}
+
+// linkIdentities saves identities that are not linked to their users yet and returns the users that got new ones.
+func (u *usecase) linkIdentities(
+	ctx context.Context,
+	userIDs []uuid.UUID,
+	identitiesByUser map[uuid.UUID][]usecase2.Identity,
+) ([]uuid.UUID, error) {
+	if len(identitiesByUser) == 0 {
+		return nil, nil
+	}
+
+	slog.DebugContext(ctx, "Call GetUserIdentitiesByUserIDs")
+	existingIdentities, err := u.repIdentities.GetUserIdentitiesByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetIdentities))
+	}
+	alreadyLinked := make(map[usecase2.Identity]uuid.UUID, len(*existingIdentities))
+	for _, identity := range *existingIdentities {
+		alreadyLinked[usecase2.Identity{Provider: identity.Provider, ExternalID: identity.ExternalID}] = identity.UserID
+	}
+
+	var identitiesToCreate []user_identities2.UserIdentityIn
+	var linkedUserIDs []uuid.UUID
+	for _, userID := range userIDs {
+		userLinked := false
+		for _, identity := range identitiesByUser[userID] {
+			if owner, exists := alreadyLinked[identity]; exists && owner == userID {
+				continue
+			}
+			identitiesToCreate = append(identitiesToCreate, user_identities2.UserIdentityIn{
+				UserID:     userID,
+				Provider:   identity.Provider,
+				ExternalID: identity.ExternalID,
+			})
+			userLinked = true
+		}
+		if userLinked {
+			linkedUserIDs = append(linkedUserIDs, userID)
+		}
+	}
+	if len(identitiesToCreate) == 0 {
+		return nil, nil
+	}
+
+	slog.DebugContext(ctx, "Call SaveUserIdentitiesBatch", "identities_count", len(identitiesToCreate))
+	if _, err = u.repIdentities.SaveUserIdentitiesBatch(ctx, identitiesToCreate); err != nil {
+		if errors.Is(err, repository.ErrIdentityExists) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrIdentityAlreadyLinked))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrSaveIdentities))
+	}
+	return linkedUserIDs, nil
+}
+
+// checkNotDescendant walks up from the new parent and fails if the team itself is met,
+// nesting a team under its own subteam would make the hierarchy cyclic.
+func (u *usecase) checkNotDescendant(ctx context.Context, parentTeam *teams2.TeamOut, teamID uuid.UUID) error {
+	visited := map[uuid.UUID]struct{}{parentTeam.ID: {}}
+	current := parentTeam
+	for current.ParentTeamID != nil {
+		if *current.ParentTeamID == teamID {
+			return logging.WrapError(ctx, fmt.Errorf("%w: parent_team_id %s", usecase2.ErrTeamHierarchyCycle, parentTeam.ID))
+		}
+		if _, seen := visited[*current.ParentTeamID]; seen {
+			return nil
+		}
+		visited[*current.ParentTeamID] = struct{}{}
+
+		slog.DebugContext(ctx, "Call GetTeamByID for ancestor team", "team_id", *current.ParentTeamID)
+		ancestor, err := u.repTeams.GetTeamByID(ctx, *current.ParentTeamID)
+		if err != nil {
+			return logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetTeam, *current.ParentTeamID))
+		}
+		current = ancestor
+	}
+	return nil
 }
 
 func userNeedsUpdate(existingUser users2.UserOut, newUser users2.UserIn) bool {
@@ -6,10 +6,15 @@ import (
 	"testing"
 
 	repository2 "pr-reviewers-service/internal/infrastructure/repository"
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
 	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
 	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	team_memberships "pr-reviewers-service/internal/usecase/contract/repository/team_memberships/mocks"
 	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	user_identities "pr-reviewers-service/internal/usecase/contract/repository/user_identities/mocks"
 	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
 
 	trmgr "github.com/avito-tech/go-transaction-manager/trm/v2"
@@ -48,18 +53,62 @@ func TestAddTeam(t *testing.T) {
 		IsActive: true,
 		TeamID:   teamID,
 	}
+	otherTeamID := uuid.New()
+	userFromOtherTeam := users2.UserOut{
+		ID:       userID,
+		Name:     "user1",
+		IsActive: true,
+		TeamID:   otherTeamID,
+	}
+	primaryMembership := team_memberships2.TeamMembershipOut{
+		ID:        uuid.New(),
+		UserID:    userID,
+		TeamID:    teamID,
+		IsPrimary: true,
+	}
+	additionalMembership := team_memberships2.TeamMembershipOut{
+		ID:        uuid.New(),
+		UserID:    userID,
+		TeamID:    teamID,
+		IsPrimary: false,
+	}
+	parentTeamID := uuid.New()
+	parentTeam := &teams2.TeamOut{
+		ID:   parentTeamID,
+		Name: "platform",
+	}
+	reqWithParent := In{
+		TeamName:       reqData.TeamName,
+		ParentTeamName: &parentTeam.Name,
+		Members:        reqData.Members,
+	}
+	reqDetached := In{
+		TeamName:       reqData.TeamName,
+		ParentTeamName: new(string),
+		Members:        reqData.Members,
+	}
 
 	tests := []struct {
-		name          string
-		req           In
-		setupMock     func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams, trm trmgr.Manager)
+		name      string
+		req       In
+		setupMock func(
+			mockUsers *users.MockRepositoryUsers,
+			mockTeams *teams.MockRepositoryTeams,
+			mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+			trm trmgr.Manager,
+		)
 		expected      *Out
 		expectedError error
 	}{
 		{
 			name: "successful add new team with new user",
 			req:  reqData,
-			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams, trm trmgr.Manager) {
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
 				mockTeams.EXPECT().
 					GetTeamByName(gomock.Any(), reqData.TeamName).
 					Return(nil, repository2.ErrTeamNotFound)
@@ -79,6 +128,12 @@ func TestAddTeam(t *testing.T) {
 					DoAndReturn(func(ctx context.Context, usersToCreate []users2.UserIn) (*[]users2.UserOut, error) {
 						return &[]users2.UserOut{retUser}, nil
 					})
+
+				mockMemberships.EXPECT().
+					SaveTeamMembershipsBatch(gomock.Any(), []team_memberships2.TeamMembershipIn{
+						{UserID: userID, TeamID: teamID, IsPrimary: true},
+					}).
+					Return(&[]team_memberships2.TeamMembershipOut{primaryMembership}, nil)
 			},
 			expected: &Out{
 				TeamName: reqData.TeamName,
@@ -88,7 +143,12 @@ func TestAddTeam(t *testing.T) {
 		{
 			name: "successful update team user",
 			req:  reqData,
-			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams, trm trmgr.Manager) {
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
 				mockTeams.EXPECT().
 					GetTeamByName(gomock.Any(), reqData.TeamName).
 					Return(retTeam, nil)
@@ -124,13 +184,24 @@ func TestAddTeam(t *testing.T) {
 					{UserID: userID, Username: "user1", IsActive: true},
 				},
 			},
-			setupMock:     func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams, trm trmgr.Manager) {},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+			},
 			expectedError: usecase2.ErrDuplicateUsers,
 		},
 		{
 			name: "team already exists",
 			req:  reqData,
-			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams, trm trmgr.Manager) {
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
 				mockTeams.EXPECT().
 					GetTeamByName(gomock.Any(), reqData.TeamName).
 					Return(retTeam, nil)
@@ -142,6 +213,10 @@ func TestAddTeam(t *testing.T) {
 				mockUsers.EXPECT().
 					SaveUsersBatch(gomock.Any(), gomock.Any()).
 					Return(&[]users2.UserOut{retUser}, nil)
+
+				mockMemberships.EXPECT().
+					SaveTeamMembershipsBatch(gomock.Any(), gomock.Any()).
+					Return(&[]team_memberships2.TeamMembershipOut{primaryMembership}, nil)
 			},
 			expected: &Out{
 				TeamName: reqData.TeamName,
@@ -151,7 +226,12 @@ func TestAddTeam(t *testing.T) {
 		{
 			name: "error on get team",
 			req:  reqData,
-			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams, trm trmgr.Manager) {
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
 				mockTeams.EXPECT().
 					GetTeamByName(gomock.Any(), reqData.TeamName).
 					Return(nil, errors.New("some db error"))
@@ -161,7 +241,12 @@ func TestAddTeam(t *testing.T) {
 		{
 			name: "error on save team",
 			req:  reqData,
-			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams, trm trmgr.Manager) {
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
 				mockTeams.EXPECT().
 					GetTeamByName(gomock.Any(), reqData.TeamName).
 					Return(nil, repository2.ErrTeamNotFound)
@@ -175,7 +260,12 @@ func TestAddTeam(t *testing.T) {
 		{
 			name: "no users were added or updated",
 			req:  reqData,
-			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams, trm trmgr.Manager) {
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
 				mockTeams.EXPECT().
 					GetTeamByName(gomock.Any(), reqData.TeamName).
 					Return(nil, repository2.ErrTeamNotFound)
@@ -197,12 +287,310 @@ func TestAddTeam(t *testing.T) {
 			},
 			expectedError: usecase2.ErrNoUsersWereUpdatedAddedTeam,
 		},
+		{
+			name: "user from another team joins as additional member",
+			req:  reqData,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), reqData.TeamName).
+					Return(retTeam, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{userFromOtherTeam}, nil)
+
+				mockMemberships.EXPECT().
+					SaveTeamMembershipsBatch(gomock.Any(), []team_memberships2.TeamMembershipIn{
+						{UserID: userID, TeamID: teamID, IsPrimary: false},
+					}).
+					Return(&[]team_memberships2.TeamMembershipOut{additionalMembership}, nil)
+			},
+			expected: &Out{
+				TeamName: reqData.TeamName,
+				Members:  reqData.Members,
+			},
+		},
+		{
+			name: "user from another team keeps primary team on update",
+			req:  reqData,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), reqData.TeamName).
+					Return(retTeam, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{
+						{
+							ID:       userID,
+							Name:     "diff_name",
+							IsActive: true,
+							TeamID:   otherTeamID,
+						},
+					}, nil)
+
+				mockUsers.EXPECT().
+					UpdateUsersBatch(gomock.Any(), []users2.UserIn{
+						{ID: userID, Name: "user1", IsActive: true, TeamID: otherTeamID},
+					}).
+					Return(&[]users2.UserOut{userFromOtherTeam}, nil)
+
+				mockMemberships.EXPECT().
+					SaveTeamMembershipsBatch(gomock.Any(), []team_memberships2.TeamMembershipIn{
+						{UserID: userID, TeamID: teamID, IsPrimary: false},
+					}).
+					Return(&[]team_memberships2.TeamMembershipOut{additionalMembership}, nil)
+			},
+			expected: &Out{
+				TeamName: reqData.TeamName,
+				Members:  reqData.Members,
+			},
+		},
+		{
+			name: "additional member already in team",
+			req:  reqData,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), reqData.TeamName).
+					Return(retTeam, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{userFromOtherTeam}, nil)
+
+				mockMemberships.EXPECT().
+					SaveTeamMembershipsBatch(gomock.Any(), gomock.Any()).
+					Return(&[]team_memberships2.TeamMembershipOut{}, nil)
+			},
+			expectedError: usecase2.ErrNoUsersWereUpdatedAddedTeam,
+		},
+		{
+			name: "error on save team memberships",
+			req:  reqData,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), reqData.TeamName).
+					Return(retTeam, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(nil, repository2.ErrUserNotFound)
+
+				mockUsers.EXPECT().
+					SaveUsersBatch(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{retUser}, nil)
+
+				mockMemberships.EXPECT().
+					SaveTeamMembershipsBatch(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("some db error"))
+			},
+			expectedError: usecase2.ErrSaveTeamMemberships,
+		},
+		{
+			name: "successful add new team under parent team",
+			req:  reqWithParent,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), parentTeam.Name).
+					Return(parentTeam, nil)
+
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), reqData.TeamName).
+					Return(nil, repository2.ErrTeamNotFound)
+
+				mockTeams.EXPECT().
+					SaveTeam(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, teamIn teams2.TeamIn) (*teams2.TeamOut, error) {
+						require.NotNil(t, teamIn.ParentTeamID)
+						assert.Equal(t, parentTeamID, *teamIn.ParentTeamID)
+						return &teams2.TeamOut{ID: teamID, Name: teamIn.Name, ParentTeamID: teamIn.ParentTeamID}, nil
+					})
+
+				mockUsers.EXPECT().
+					GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(nil, repository2.ErrUserNotFound)
+
+				mockUsers.EXPECT().
+					SaveUsersBatch(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{retUser}, nil)
+
+				mockMemberships.EXPECT().
+					SaveTeamMembershipsBatch(gomock.Any(), gomock.Any()).
+					Return(&[]team_memberships2.TeamMembershipOut{primaryMembership}, nil)
+			},
+			expected: &Out{
+				TeamName:       reqData.TeamName,
+				ParentTeamName: parentTeam.Name,
+				Members:        reqData.Members,
+			},
+		},
+		{
+			name: "existing team is moved under parent team without user changes",
+			req:  reqWithParent,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), parentTeam.Name).
+					Return(parentTeam, nil)
+
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), reqData.TeamName).
+					Return(retTeam, nil)
+
+				mockTeams.EXPECT().
+					UpdateTeamParent(gomock.Any(), teamID, &parentTeamID).
+					Return(&teams2.TeamOut{ID: teamID, Name: reqData.TeamName, ParentTeamID: &parentTeamID}, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{retUser}, nil)
+			},
+			expected: &Out{
+				TeamName:       reqData.TeamName,
+				ParentTeamName: parentTeam.Name,
+				Members:        []TeamMembers{},
+			},
+		},
+		{
+			name: "existing team is detached from its parent",
+			req:  reqDetached,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), reqData.TeamName).
+					Return(&teams2.TeamOut{ID: teamID, Name: reqData.TeamName, ParentTeamID: &parentTeamID}, nil)
+
+				mockTeams.EXPECT().
+					UpdateTeamParent(gomock.Any(), teamID, nil).
+					Return(&teams2.TeamOut{ID: teamID, Name: reqData.TeamName}, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{retUser}, nil)
+			},
+			expected: &Out{
+				TeamName: reqData.TeamName,
+				Members:  []TeamMembers{},
+			},
+		},
+		{
+			name: "existing root team stays root when detached",
+			req:  reqDetached,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), reqData.TeamName).
+					Return(retTeam, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{retUser}, nil)
+			},
+			expectedError: usecase2.ErrNoUsersWereUpdatedAddedTeam,
+		},
+		{
+			name: "parent team not found",
+			req:  reqWithParent,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), parentTeam.Name).
+					Return(nil, repository2.ErrTeamNotFound)
+			},
+			expectedError: usecase2.ErrParentTeamNotFound,
+		},
+		{
+			name: "team cannot be its own parent",
+			req: In{
+				TeamName:       reqData.TeamName,
+				ParentTeamName: &reqData.TeamName,
+				Members:        reqData.Members,
+			},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+			},
+			expectedError: usecase2.ErrTeamHierarchyCycle,
+		},
+		{
+			name: "team cannot be nested under its own subteam",
+			req:  reqWithParent,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				trm trmgr.Manager,
+			) {
+				intermediateTeamID := uuid.New()
+
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), parentTeam.Name).
+					Return(&teams2.TeamOut{ID: parentTeamID, Name: parentTeam.Name, ParentTeamID: &intermediateTeamID}, nil)
+
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), reqData.TeamName).
+					Return(retTeam, nil)
+
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), intermediateTeamID).
+					Return(&teams2.TeamOut{ID: intermediateTeamID, Name: "intermediate", ParentTeamID: &teamID}, nil)
+			},
+			expectedError: usecase2.ErrTeamHierarchyCycle,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
 			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoMemberships := team_memberships.NewMockRepositoryTeamMemberships(ctrl)
+			mockRepoIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 			mockTrm := mock.NewMockManager(ctrl)
 			mockTrm.EXPECT().
@@ -211,14 +599,14 @@ func TestAddTeam(t *testing.T) {
 					return f(ctx)
 				}).AnyTimes()
 
-			tt.setupMock(mockRepoUsers, mockRepoTeams, mockTrm)
+			tt.setupMock(mockRepoUsers, mockRepoTeams, mockRepoMemberships, mockTrm)
 
-			u := Newusecase(mockRepoUsers, mockRepoTeams, mockTrm)
+			u := Newusecase(mockRepoUsers, mockRepoTeams, mockRepoMemberships, mockRepoIdentities, mockPublisher, mockTrm)
 			result, err := u.Run(context.Background(), tt.req)
 
 			if tt.expectedError != nil {
 				require.Error(t, err)
-				assert.ErrorAs(t, err, &tt.expectedError)
+				assert.ErrorIs(t, err, tt.expectedError)
 			} else {
 				require.NoError(t, err)
 			}
@@ -226,6 +614,7 @@ func TestAddTeam(t *testing.T) {
 			if tt.expected != nil {
 				require.NotNil(t, result)
 				assert.Equal(t, tt.expected.TeamName, result.TeamName)
+				assert.Equal(t, tt.expected.ParentTeamName, result.ParentTeamName)
 				assert.Equal(t, len(tt.expected.Members), len(result.Members))
 				for i := range result.Members {
 					assert.Equal(t, tt.expected.Members[i].UserID, result.Members[i].UserID)
@@ -238,3 +627,336 @@ func TestAddTeam(t *testing.T) {
 		})
 	}
 }
+
+func TestAddTeamIdentities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	userID := uuid.New()
+	otherUserID := uuid.New()
+
+	retTeam := &teams2.TeamOut{
+		ID:   teamID,
+		Name: "team-1",
+	}
+	retUser := users2.UserOut{
+		ID:       userID,
+		Name:     "user1",
+		IsActive: true,
+		TeamID:   teamID,
+	}
+	reqWithIdentities := In{
+		TeamName: retTeam.Name,
+		Members: []TeamMembers{
+			{
+				UserID:   userID,
+				Username: "user1",
+				IsActive: true,
+				Identities: []usecase2.Identity{
+					{Provider: "GitHub", ExternalID: "Alice"},
+					{Provider: "email", ExternalID: "alice@example.com"},
+				},
+			},
+		},
+	}
+	normalizedIdentities := []usecase2.Identity{
+		{Provider: "github", ExternalID: "alice"},
+		{Provider: "email", ExternalID: "alice@example.com"},
+	}
+	identitiesToSave := []user_identities2.UserIdentityIn{
+		{UserID: userID, Provider: "github", ExternalID: "alice"},
+		{UserID: userID, Provider: "email", ExternalID: "alice@example.com"},
+	}
+
+	existingTeamWithUser := func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+		mockTeams.EXPECT().
+			GetTeamByName(gomock.Any(), retTeam.Name).
+			Return(retTeam, nil)
+		mockUsers.EXPECT().
+			GetUsersByIDs(gomock.Any(), []uuid.UUID{userID}).
+			Return(&[]users2.UserOut{retUser}, nil)
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockUsers *users.MockRepositoryUsers,
+			mockTeams *teams.MockRepositoryTeams,
+			mockIdentities *user_identities.MockRepositoryUserIdentities,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "identities are linked to unchanged user",
+			req:  reqWithIdentities,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				existingTeamWithUser(mockUsers, mockTeams)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]user_identities2.UserIdentityOut{}, nil)
+				mockIdentities.EXPECT().
+					SaveUserIdentitiesBatch(gomock.Any(), identitiesToSave).
+					Return(&[]user_identities2.UserIdentityOut{}, nil)
+			},
+			expected: &Out{
+				TeamName: retTeam.Name,
+				Members: []TeamMembers{
+					{UserID: userID, Username: "user1", IsActive: true, Identities: normalizedIdentities},
+				},
+			},
+		},
+		{
+			name: "already linked identities are skipped",
+			req:  reqWithIdentities,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				existingTeamWithUser(mockUsers, mockTeams)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]user_identities2.UserIdentityOut{
+						{UserID: userID, Provider: "github", ExternalID: "alice"},
+					}, nil)
+				mockIdentities.EXPECT().
+					SaveUserIdentitiesBatch(gomock.Any(), identitiesToSave[1:]).
+					Return(&[]user_identities2.UserIdentityOut{}, nil)
+			},
+			expected: &Out{
+				TeamName: retTeam.Name,
+				Members: []TeamMembers{
+					{UserID: userID, Username: "user1", IsActive: true, Identities: normalizedIdentities},
+				},
+			},
+		},
+		{
+			name: "all identities already linked",
+			req:  reqWithIdentities,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				existingTeamWithUser(mockUsers, mockTeams)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]user_identities2.UserIdentityOut{
+						{UserID: userID, Provider: "github", ExternalID: "alice"},
+						{UserID: userID, Provider: "email", ExternalID: "alice@example.com"},
+					}, nil)
+			},
+			expectedError: usecase2.ErrNoUsersWereUpdatedAddedTeam,
+		},
+		{
+			name: "identity linked to another user",
+			req:  reqWithIdentities,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				existingTeamWithUser(mockUsers, mockTeams)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]user_identities2.UserIdentityOut{}, nil)
+				mockIdentities.EXPECT().
+					SaveUserIdentitiesBatch(gomock.Any(), identitiesToSave).
+					Return(nil, repository2.ErrIdentityExists)
+			},
+			expectedError: usecase2.ErrIdentityAlreadyLinked,
+		},
+		{
+			name: "error on save identities",
+			req:  reqWithIdentities,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				existingTeamWithUser(mockUsers, mockTeams)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]user_identities2.UserIdentityOut{}, nil)
+				mockIdentities.EXPECT().
+					SaveUserIdentitiesBatch(gomock.Any(), identitiesToSave).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrSaveIdentities,
+		},
+		{
+			name: "error on get identities",
+			req:  reqWithIdentities,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				existingTeamWithUser(mockUsers, mockTeams)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetIdentities,
+		},
+		{
+			name: "unknown identity provider",
+			req: In{
+				TeamName: retTeam.Name,
+				Members: []TeamMembers{
+					{
+						UserID:     userID,
+						Username:   "user1",
+						Identities: []usecase2.Identity{{Provider: "bitbucket", ExternalID: "alice"}},
+					},
+				},
+			},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+			},
+			expectedError: usecase2.ErrInvalidIdentity,
+		},
+		{
+			name: "same identity given to two members",
+			req: In{
+				TeamName: retTeam.Name,
+				Members: []TeamMembers{
+					{
+						UserID:     userID,
+						Username:   "user1",
+						Identities: []usecase2.Identity{{Provider: "github", ExternalID: "alice"}},
+					},
+					{
+						UserID:     otherUserID,
+						Username:   "user2",
+						Identities: []usecase2.Identity{{Provider: "github", ExternalID: "ALICE"}},
+					},
+				},
+			},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+			},
+			expectedError: usecase2.ErrIdentityAlreadyLinked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoMemberships := team_memberships.NewMockRepositoryTeamMemberships(ctrl)
+			mockRepoIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			mockTrm := mock.NewMockManager(ctrl)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				}).AnyTimes()
+
+			tt.setupMock(mockRepoUsers, mockRepoTeams, mockRepoIdentities)
+
+			u := Newusecase(mockRepoUsers, mockRepoTeams, mockRepoMemberships, mockRepoIdentities, mockPublisher, mockTrm)
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestAddTeamPublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	userID := uuid.New()
+	req := In{
+		TeamName: "team-1",
+		Members:  []TeamMembers{{UserID: userID, Username: "user1", IsActive: true}},
+	}
+	team := &teams2.TeamOut{ID: teamID, Name: req.TeamName}
+	publishErr := errors.New("publish error")
+
+	tests := []struct {
+		name          string
+		existingTeam  *teams2.TeamOut
+		expectedType  string
+		publishErr    error
+		expectedError error
+	}{
+		{name: "created event is published for new team", expectedType: usecase2.EventTeamCreated},
+		{name: "updated event is published for existing team", existingTeam: team, expectedType: usecase2.EventTeamUpdated},
+		{
+			name:          "publish error rolls back team changes",
+			expectedType:  usecase2.EventTeamCreated,
+			publishErr:    publishErr,
+			expectedError: publishErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoMemberships := team_memberships.NewMockRepositoryTeamMemberships(ctrl)
+			mockRepoIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			if tt.existingTeam != nil {
+				mockRepoTeams.EXPECT().GetTeamByName(gomock.Any(), req.TeamName).Return(tt.existingTeam, nil)
+			} else {
+				mockRepoTeams.EXPECT().GetTeamByName(gomock.Any(), req.TeamName).Return(nil, repository2.ErrTeamNotFound)
+				mockRepoTeams.EXPECT().SaveTeam(gomock.Any(), gomock.Any()).Return(team, nil)
+			}
+			mockRepoUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{userID}).Return(nil, repository2.ErrUserNotFound)
+			mockRepoUsers.EXPECT().SaveUsersBatch(gomock.Any(), gomock.Any()).
+				Return(&[]users2.UserOut{{ID: userID, Name: "user1", IsActive: true, TeamID: teamID}}, nil)
+			mockRepoMemberships.EXPECT().SaveTeamMembershipsBatch(gomock.Any(), gomock.Any()).
+				Return(&[]team_memberships2.TeamMembershipOut{{UserID: userID, TeamID: teamID, IsPrimary: true}}, nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{{Type: tt.expectedType, TeamName: req.TeamName}}).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := Newusecase(mockRepoUsers, mockRepoTeams, mockRepoMemberships, mockRepoIdentities, mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), req)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, req.TeamName, result.TeamName)
+		})
+	}
+}
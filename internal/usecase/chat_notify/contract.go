@@ -0,0 +1,56 @@
+package chat_notify
+
+import (
+	"text/template"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultAssignedTemplate   = `{{.Mention}} you were assigned to review "{{.PullRequestName}}" by {{.AuthorName}}`
+	DefaultUnassignedTemplate = `{{.Mention}} "{{.PullRequestName}}" by {{.AuthorName}} was reassigned to another reviewer`
+	DefaultReminderTemplate   = `{{.Mention}} "{{.PullRequestName}}" by {{.AuthorName}} is still waiting for your review`
+)
+
+// Templates render the message text of reviewer events, each is executed with a TemplateData.
+type Templates struct {
+	Assigned   *template.Template
+	Unassigned *template.Template
+	Reminder   *template.Template
+}
+
+type TemplateData struct {
+	Mention         string
+	ReviewerName    string
+	AuthorName      string
+	TeamName        string
+	PullRequestID   uuid.UUID
+	PullRequestName string
+}
+
+// NewTemplates parses the message templates, an empty text falls back to the default one.
+func NewTemplates(assigned, unassigned, reminder string) (*Templates, error) {
+	if assigned == "" {
+		assigned = DefaultAssignedTemplate
+	}
+	if unassigned == "" {
+		unassigned = DefaultUnassignedTemplate
+	}
+	if reminder == "" {
+		reminder = DefaultReminderTemplate
+	}
+
+	assignedTemplate, err := template.New("assigned").Option("missingkey=error").Parse(assigned)
+	if err != nil {
+		return nil, err
+	}
+	unassignedTemplate, err := template.New("unassigned").Option("missingkey=error").Parse(unassigned)
+	if err != nil {
+		return nil, err
+	}
+	reminderTemplate, err := template.New("reminder").Option("missingkey=error").Parse(reminder)
+	if err != nil {
+		return nil, err
+	}
+	return &Templates{Assigned: assignedTemplate, Unassigned: unassignedTemplate, Reminder: reminderTemplate}, nil
+}
@@ -0,0 +1,134 @@
+package chat_notify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"text/template"
+
+	chat_sender2 "pr-reviewers-service/internal/infrastructure/chat_sender"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/chat_sender"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/google/uuid"
+)
+
+type usecase struct {
+	repUsers          users.RepositoryUsers
+	repTeams          teams.RepositoryTeams
+	sender            chat_sender.Sender
+	channels          map[string]string
+	defaultChannelURL string
+	mentions          map[string]string
+	templates         *Templates
+}
+
+// NewUsecase builds the chat notifier. channels maps a team name to its incoming webhook URL, reviewers of
+// other teams are notified in defaultChannelURL. mentions maps a user ID to the handle used in messages.
+func NewUsecase(
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+	sender chat_sender.Sender,
+	channels map[string]string,
+	defaultChannelURL string,
+	mentions map[string]string,
+	templates *Templates,
+) *usecase {
+	return &usecase{
+		repUsers:          repUsers,
+		repTeams:          repTeams,
+		sender:            sender,
+		channels:          channels,
+		defaultChannelURL: defaultChannelURL,
+		mentions:          mentions,
+		templates:         templates,
+	}
+}
+
+// Publish messages the reviewer of every assigned, unassigned and reminder event in the channel of the
+// reviewer's team. The relay calls it once the events are committed as published.
+// Chat messages are best effort: a failed lookup or send is logged and not retried, the other events of the batch
+// are still messaged.
+func (u *usecase) Publish(ctx context.Context, events []usecase2.Event) error {
+	for _, event := range events {
+		var tmpl *template.Template
+		switch event.Type {
+		case usecase2.EventReviewerAssigned:
+			tmpl = u.templates.Assigned
+		case usecase2.EventReviewerUnassigned:
+			tmpl = u.templates.Unassigned
+		case usecase2.EventReviewReminder:
+			tmpl = u.templates.Reminder
+		default:
+			continue
+		}
+
+		if err := u.notify(ctx, event, tmpl); err != nil {
+			slog.WarnContext(ctx, "Chat message not sent", "event_id", event.ID, "error", err)
+		}
+	}
+	return nil
+}
+
+func (u *usecase) notify(ctx context.Context, event usecase2.Event, tmpl *template.Template) error {
+	slog.DebugContext(ctx, "Get reviewer and author", "reviewer_id", event.ReviewerID, "author_id", event.AuthorID)
+	found, err := u.repUsers.GetUsersByIDs(ctx, []uuid.UUID{event.ReviewerID, event.AuthorID})
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetUsers, err))
+	}
+	usersByID := make(map[uuid.UUID]users2.UserOut, len(*found))
+	for _, user := range *found {
+		usersByID[user.ID] = user
+	}
+	reviewer, exists := usersByID[event.ReviewerID]
+	if !exists {
+		slog.DebugContext(ctx, "Reviewer not found, chat message skipped", "reviewer_id", event.ReviewerID)
+		return nil
+	}
+
+	slog.DebugContext(ctx, "Get reviewer team", "team_id", reviewer.TeamID)
+	team, err := u.repTeams.GetTeamByID(ctx, reviewer.TeamID)
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetTeam, reviewer.TeamID))
+	}
+
+	url := u.channels[team.Name]
+	if url == "" {
+		url = u.defaultChannelURL
+	}
+	if url == "" {
+		slog.DebugContext(ctx, "No chat channel for team, chat message skipped", "team_name", team.Name)
+		return nil
+	}
+
+	mention := u.mentions[reviewer.ID.String()]
+	if mention == "" {
+		mention = "@" + reviewer.Name
+	}
+	data := TemplateData{
+		Mention:         mention,
+		ReviewerName:    reviewer.Name,
+		AuthorName:      usersByID[event.AuthorID].Name,
+		TeamName:        team.Name,
+		PullRequestID:   event.PullRequestID,
+		PullRequestName: event.PullRequestName,
+	}
+	var text strings.Builder
+	if err = tmpl.Execute(&text, data); err != nil {
+		slog.WarnContext(ctx, "Chat message template failed", "event_type", event.Type, "error", err)
+		return nil
+	}
+
+	if err = u.sender.Send(ctx, url, chat_sender2.Message{Text: text.String()}); err != nil {
+		slog.WarnContext(ctx, "Chat message not sent", "event_id", event.ID, "team_name", team.Name, "error", err)
+		return nil
+	}
+
+	slog.DebugContext(ctx, "UseCase ChatNotify success", "event_id", event.ID, "reviewer_id", reviewer.ID)
+	return nil
+}
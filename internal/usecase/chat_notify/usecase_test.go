@@ -0,0 +1,239 @@
+package chat_notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	chat_sender2 "pr-reviewers-service/internal/infrastructure/chat_sender"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// chatStub records the messages posted to each incoming webhook path.
+type chatStub struct {
+	mu       sync.Mutex
+	messages map[string][]string
+	status   int
+}
+
+func (s *chatStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var message chat_sender2.Message
+	_ = json.NewDecoder(r.Body).Decode(&message)
+
+	s.mu.Lock()
+	s.messages[r.URL.Path] = append(s.messages[r.URL.Path], message.Text)
+	s.mu.Unlock()
+	w.WriteHeader(s.status)
+}
+
+func TestChatNotify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	backendID := uuid.New()
+	frontendID := uuid.New()
+	reviewer := users2.UserOut{ID: uuid.New(), Name: "alice", IsActive: true, TeamID: backendID}
+	otherReviewer := users2.UserOut{ID: uuid.New(), Name: "carol", IsActive: true, TeamID: frontendID}
+	author := users2.UserOut{ID: uuid.New(), Name: "bob", IsActive: true, TeamID: backendID}
+	backend := &teams2.TeamOut{ID: backendID, Name: "backend"}
+	frontend := &teams2.TeamOut{ID: frontendID, Name: "frontend"}
+	prID := uuid.New()
+
+	event := func(eventType string, reviewerID uuid.UUID) usecase2.Event {
+		return usecase2.Event{
+			ID:              uuid.New(),
+			Type:            eventType,
+			PullRequestID:   prID,
+			PullRequestName: "Add feature",
+			AuthorID:        author.ID,
+			ReviewerID:      reviewerID,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		events      []usecase2.Event
+		status      int
+		withDefault bool
+		setupMock   func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams)
+		expected    map[string][]string
+	}{
+		{
+			name:   "assigned reviewer is mentioned in team channel",
+			events: []usecase2.Event{event(usecase2.EventReviewerAssigned, reviewer.ID)},
+			status: http.StatusOK,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{reviewer.ID, author.ID}).
+					Return(&[]users2.UserOut{reviewer, author}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), backendID).Return(backend, nil)
+			},
+			expected: map[string][]string{
+				"/backend": {`<@U024BE7LH> you were assigned to review "Add feature" by bob`},
+			},
+		},
+		{
+			name:   "unassigned reviewer without handle is mentioned by name",
+			events: []usecase2.Event{event(usecase2.EventReviewerUnassigned, otherReviewer.ID)},
+			status: http.StatusOK,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{otherReviewer.ID, author.ID}).
+					Return(&[]users2.UserOut{otherReviewer, author}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), frontendID).Return(frontend, nil)
+			},
+			withDefault: true,
+			expected: map[string][]string{
+				"/default": {`@carol "Add feature" by bob was reassigned to another reviewer`},
+			},
+		},
+		{
+			name:   "reminded reviewer is mentioned in team channel",
+			events: []usecase2.Event{event(usecase2.EventReviewReminder, reviewer.ID)},
+			status: http.StatusOK,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{reviewer.ID, author.ID}).
+					Return(&[]users2.UserOut{reviewer, author}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), backendID).Return(backend, nil)
+			},
+			expected: map[string][]string{
+				"/backend": {`<@U024BE7LH> "Add feature" by bob is still waiting for your review`},
+			},
+		},
+		{
+			name:   "team without channel is skipped",
+			events: []usecase2.Event{event(usecase2.EventReviewerAssigned, otherReviewer.ID)},
+			status: http.StatusOK,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{otherReviewer, author}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), frontendID).Return(frontend, nil)
+			},
+			expected: map[string][]string{},
+		},
+		{
+			name: "other events are ignored",
+			events: []usecase2.Event{
+				{Type: usecase2.EventPullRequestMerged, PullRequestID: prID},
+				{Type: usecase2.EventTeamRenamed, TeamName: "backend"},
+			},
+			status:    http.StatusOK,
+			setupMock: func(*users.MockRepositoryUsers, *teams.MockRepositoryTeams) {},
+			expected:  map[string][]string{},
+		},
+		{
+			name:   "failed send is not retried",
+			events: []usecase2.Event{event(usecase2.EventReviewerAssigned, reviewer.ID)},
+			status: http.StatusInternalServerError,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{reviewer, author}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), backendID).Return(backend, nil)
+			},
+			expected: map[string][]string{
+				"/backend": {`<@U024BE7LH> you were assigned to review "Add feature" by bob`},
+			},
+		},
+		{
+			name: "get users error skips the event only",
+			events: []usecase2.Event{
+				event(usecase2.EventReviewerAssigned, otherReviewer.ID),
+				event(usecase2.EventReviewerAssigned, reviewer.ID),
+			},
+			status: http.StatusOK,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{otherReviewer.ID, author.ID}).
+					Return(nil, errors.New("db error"))
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{reviewer.ID, author.ID}).
+					Return(&[]users2.UserOut{reviewer, author}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), backendID).Return(backend, nil)
+			},
+			expected: map[string][]string{
+				"/backend": {`<@U024BE7LH> you were assigned to review "Add feature" by bob`},
+			},
+		},
+		{
+			name: "get team error skips the event only",
+			events: []usecase2.Event{
+				event(usecase2.EventReviewerAssigned, otherReviewer.ID),
+				event(usecase2.EventReviewerAssigned, reviewer.ID),
+			},
+			status: http.StatusOK,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{otherReviewer.ID, author.ID}).
+					Return(&[]users2.UserOut{otherReviewer, author}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), frontendID).Return(nil, errors.New("db error"))
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{reviewer.ID, author.ID}).
+					Return(&[]users2.UserOut{reviewer, author}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), backendID).Return(backend, nil)
+			},
+			expected: map[string][]string{
+				"/backend": {`<@U024BE7LH> you were assigned to review "Add feature" by bob`},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &chatStub{messages: map[string][]string{}, status: tt.status}
+			server := httptest.NewServer(stub)
+			defer server.Close()
+
+			mockUsers := users.NewMockRepositoryUsers(ctrl)
+			mockTeams := teams.NewMockRepositoryTeams(ctrl)
+			tt.setupMock(mockUsers, mockTeams)
+
+			templates, err := NewTemplates("", "", "")
+			require.NoError(t, err)
+			defaultChannelURL := ""
+			if tt.withDefault {
+				defaultChannelURL = server.URL + "/default"
+			}
+
+			u := NewUsecase(
+				mockUsers,
+				mockTeams,
+				chat_sender2.New(time.Second),
+				map[string]string{"backend": server.URL + "/backend"},
+				defaultChannelURL,
+				map[string]string{reviewer.ID.String(): "<@U024BE7LH>"},
+				templates,
+			)
+
+			err = u.Publish(context.Background(), tt.events)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, stub.messages)
+		})
+	}
+}
+
+func TestNewTemplates(t *testing.T) {
+	templates, err := NewTemplates("{{.Mention}}: {{.PullRequestName}} ({{.TeamName}})", "", "")
+	require.NoError(t, err)
+
+	var text strings.Builder
+	require.NoError(t, templates.Assigned.Execute(&text, TemplateData{
+		Mention:         "@alice",
+		TeamName:        "backend",
+		PullRequestName: "Add feature",
+	}))
+	assert.Equal(t, "@alice: Add feature (backend)", text.String())
+	assert.Equal(t, "unassigned", templates.Unassigned.Name())
+	assert.Equal(t, "reminder", templates.Reminder.Name())
+
+	_, err = NewTemplates("{{.Mention", "", "")
+	assert.Error(t, err)
+}
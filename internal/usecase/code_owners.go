@@ -0,0 +1,101 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	code_owners2 "pr-reviewers-service/internal/infrastructure/repository/code_owners"
+	"pr-reviewers-service/internal/logging"
+	"pr-reviewers-service/internal/usecase/codeowners"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/google/uuid"
+)
+
+// CodeOwners is the CODEOWNERS file uploaded for a repository, Rules are in the file order.
+type CodeOwners struct {
+	Repository string
+	Rules      []CodeOwnersRule
+	UpdatedAt  time.Time
+}
+
+type CodeOwnersRule struct {
+	Line    int
+	Pattern string
+	Owners  []string
+}
+
+// NewCodeOwners parses the stored file, it was validated when uploaded.
+func NewCodeOwners(ctx context.Context, stored code_owners2.CodeOwnersOut) (CodeOwners, *codeowners.Ruleset, error) {
+	ruleset, err := codeowners.Parse(stored.Content)
+	if err != nil {
+		return CodeOwners{}, nil, logging.WrapError(ctx, fmt.Errorf("%w: %s: %v", ErrInvalidCodeOwners, stored.Repository, err))
+	}
+
+	rules := make([]CodeOwnersRule, 0, len(ruleset.Rules))
+	for _, rule := range ruleset.Rules {
+		owners := make([]string, 0, len(rule.Owners))
+		for _, owner := range rule.Owners {
+			owners = append(owners, owner.Raw)
+		}
+		rules = append(rules, CodeOwnersRule{Line: rule.Line, Pattern: rule.Pattern, Owners: owners})
+	}
+	return CodeOwners{Repository: stored.Repository, Rules: rules, UpdatedAt: stored.UpdatedAt}, ruleset, nil
+}
+
+// CodeOwner is a CODEOWNERS owner found in the database, exactly one of UserID and TeamID is set.
+type CodeOwner struct {
+	UserID uuid.UUID
+	TeamID uuid.UUID
+}
+
+// ResolveCodeOwner finds the user or the team the owner refers to: @login is looked up as a github identity
+// and then as a username, an email as an email identity and @org/team as a team name, the organization is ignored.
+func ResolveCodeOwner(
+	ctx context.Context,
+	repIdentities user_identities.RepositoryUserIdentities,
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+	owner codeowners.Owner,
+) (CodeOwner, error) {
+	switch owner.Kind {
+	case codeowners.OwnerTeam:
+		slog.DebugContext(ctx, "Call GetTeamByName", "team_name", owner.Name)
+		team, err := repTeams.GetTeamByName(ctx, owner.Name)
+		if err != nil {
+			if errors.Is(err, repository.ErrTeamNotFound) {
+				return CodeOwner{}, logging.WrapError(ctx, fmt.Errorf("%w: %s", ErrCodeOwnerNotFound, owner.Raw))
+			}
+			return CodeOwner{}, logging.WrapError(ctx, fmt.Errorf("%w: %s", ErrGetTeam, owner.Name))
+		}
+		return CodeOwner{TeamID: team.ID}, nil
+
+	case codeowners.OwnerEmail:
+		userID, err := ResolveIdentity(ctx, repIdentities, Identity{Provider: IdentityProviderEmail, ExternalID: owner.Name})
+		if errors.Is(err, ErrIdentityNotFound) {
+			return CodeOwner{}, logging.WrapError(ctx, fmt.Errorf("%w: %s", ErrCodeOwnerNotFound, owner.Raw))
+		}
+		return CodeOwner{UserID: userID}, err
+	}
+
+	userID, err := ResolveIdentity(ctx, repIdentities, Identity{Provider: IdentityProviderGithub, ExternalID: owner.Name})
+	if !errors.Is(err, ErrIdentityNotFound) {
+		return CodeOwner{UserID: userID}, err
+	}
+
+	slog.DebugContext(ctx, "Call GetUsersByName", "username", owner.Name)
+	byName, err := repUsers.GetUsersByName(ctx, owner.Name)
+	if err != nil {
+		return CodeOwner{}, logging.WrapError(ctx, fmt.Errorf("%w: username %s", ErrGetUsers, owner.Name))
+	}
+	if len(*byName) != 1 {
+		return CodeOwner{}, logging.WrapError(ctx, fmt.Errorf("%w: %s", ErrCodeOwnerNotFound, owner.Raw))
+	}
+	return CodeOwner{UserID: (*byName)[0].ID}, nil
+}
@@ -0,0 +1,15 @@
+package code_owners_upload
+
+import (
+	usecase2 "pr-reviewers-service/internal/usecase"
+)
+
+// In is a CODEOWNERS file of the repository, it replaces the previously uploaded one.
+type In struct {
+	Repository string
+	Content    string
+}
+
+type Out struct {
+	CodeOwners usecase2.CodeOwners
+}
@@ -0,0 +1,87 @@
+package code_owners_upload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	code_owners2 "pr-reviewers-service/internal/infrastructure/repository/code_owners"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/codeowners"
+	"pr-reviewers-service/internal/usecase/contract/repository/code_owners"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+)
+
+type usecase struct {
+	repCodeOwners code_owners.RepositoryCodeOwners
+	repIdentities user_identities.RepositoryUserIdentities
+	repUsers      users.RepositoryUsers
+	repTeams      teams.RepositoryTeams
+}
+
+func NewUsecase(
+	repCodeOwners code_owners.RepositoryCodeOwners,
+	repIdentities user_identities.RepositoryUserIdentities,
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+) *usecase {
+	return &usecase{
+		repCodeOwners: repCodeOwners,
+		repIdentities: repIdentities,
+		repUsers:      repUsers,
+		repTeams:      repTeams,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	ruleset, err := codeowners.Parse(req.Content)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrInvalidCodeOwners, err))
+	}
+
+	// Every owner must be known now, so that a typo does not silently drop a required reviewer later.
+	var unknown []string
+	resolved := make(map[string]struct{})
+	for _, rule := range ruleset.Rules {
+		for _, owner := range rule.Owners {
+			if _, seen := resolved[owner.Raw]; seen {
+				continue
+			}
+			resolved[owner.Raw] = struct{}{}
+
+			_, err = usecase2.ResolveCodeOwner(ctx, u.repIdentities, u.repUsers, u.repTeams, owner)
+			if errors.Is(err, usecase2.ErrCodeOwnerNotFound) {
+				unknown = append(unknown, owner.Raw)
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(unknown) != 0 {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrCodeOwnerNotFound, strings.Join(unknown, ", ")))
+	}
+
+	slog.DebugContext(ctx, "Call SaveCodeOwners", "repository", req.Repository, "rules", len(ruleset.Rules))
+	saved, err := u.repCodeOwners.SaveCodeOwners(ctx, code_owners2.CodeOwnersIn{
+		Repository: req.Repository,
+		Content:    req.Content,
+	})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSaveCodeOwners, req.Repository))
+	}
+
+	codeOwners, _, err := usecase2.NewCodeOwners(ctx, *saved)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase CodeOwnersUpload success", "repository", req.Repository)
+	return &Out{CodeOwners: codeOwners}, nil
+}
@@ -0,0 +1,156 @@
+package code_owners_upload
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	code_owners2 "pr-reviewers-service/internal/infrastructure/repository/code_owners"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	code_owners "pr-reviewers-service/internal/usecase/contract/repository/code_owners/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	user_identities "pr-reviewers-service/internal/usecase/contract/repository/user_identities/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeOwnersUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	updatedAt := time.Now()
+	content := "# owners\n*.go @alice @bob\n/docs/ @org/backend docs@example.com\n/docs/legacy/\n"
+	req := In{Repository: "org/service", Content: content}
+
+	type mocks struct {
+		codeOwners *code_owners.MockRepositoryCodeOwners
+		identities *user_identities.MockRepositoryUserIdentities
+		users      *users.MockRepositoryUsers
+		teams      *teams.MockRepositoryTeams
+	}
+	expectOwners := func(m mocks) {
+		m.identities.EXPECT().GetUserIdentity(gomock.Any(), usecase2.IdentityProviderGithub, "alice").
+			Return(&user_identities2.UserIdentityOut{UserID: aliceID}, nil)
+		m.identities.EXPECT().GetUserIdentity(gomock.Any(), usecase2.IdentityProviderGithub, "bob").
+			Return(nil, repository.ErrIdentityNotFound)
+		m.users.EXPECT().GetUsersByName(gomock.Any(), "bob").
+			Return(&[]users2.UserOut{{ID: bobID, Name: "bob"}}, nil)
+		m.teams.EXPECT().GetTeamByName(gomock.Any(), "backend").
+			Return(&teams2.TeamOut{ID: uuid.New(), Name: "backend"}, nil)
+		m.identities.EXPECT().GetUserIdentity(gomock.Any(), usecase2.IdentityProviderEmail, "docs@example.com").
+			Return(&user_identities2.UserIdentityOut{UserID: aliceID}, nil)
+	}
+
+	tests := []struct {
+		name          string
+		req           In
+		setupMock     func(m mocks)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "success",
+			req:  req,
+			setupMock: func(m mocks) {
+				expectOwners(m)
+				m.codeOwners.EXPECT().
+					SaveCodeOwners(gomock.Any(), code_owners2.CodeOwnersIn{Repository: "org/service", Content: content}).
+					Return(&code_owners2.CodeOwnersOut{Repository: "org/service", Content: content, UpdatedAt: updatedAt}, nil)
+			},
+			expected: &Out{CodeOwners: usecase2.CodeOwners{
+				Repository: "org/service",
+				Rules: []usecase2.CodeOwnersRule{
+					{Line: 2, Pattern: "*.go", Owners: []string{"@alice", "@bob"}},
+					{Line: 3, Pattern: "/docs/", Owners: []string{"@org/backend", "docs@example.com"}},
+					{Line: 4, Pattern: "/docs/legacy/", Owners: []string{}},
+				},
+				UpdatedAt: updatedAt,
+			}},
+		},
+		{
+			name:          "invalid file",
+			req:           In{Repository: "org/service", Content: "*.go @alice\n!main.go @bob\n"},
+			setupMock:     func(m mocks) {},
+			expectedError: usecase2.ErrInvalidCodeOwners,
+		},
+		{
+			name: "unknown owners are reported together",
+			req:  In{Repository: "org/service", Content: "*.go @ghost @org/ghosts\n*.md @ghost\n"},
+			setupMock: func(m mocks) {
+				m.identities.EXPECT().GetUserIdentity(gomock.Any(), usecase2.IdentityProviderGithub, "ghost").
+					Return(nil, repository.ErrIdentityNotFound)
+				m.users.EXPECT().GetUsersByName(gomock.Any(), "ghost").Return(&[]users2.UserOut{}, nil)
+				m.teams.EXPECT().GetTeamByName(gomock.Any(), "ghosts").Return(nil, repository.ErrTeamNotFound)
+			},
+			expectedError: usecase2.ErrCodeOwnerNotFound,
+		},
+		{
+			name: "get team error",
+			req:  In{Repository: "org/service", Content: "*.go @org/backend\n"},
+			setupMock: func(m mocks) {
+				m.teams.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
+		{
+			name: "save error",
+			req:  req,
+			setupMock: func(m mocks) {
+				expectOwners(m)
+				m.codeOwners.EXPECT().SaveCodeOwners(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrSaveCodeOwners,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks{
+				codeOwners: code_owners.NewMockRepositoryCodeOwners(ctrl),
+				identities: user_identities.NewMockRepositoryUserIdentities(ctrl),
+				users:      users.NewMockRepositoryUsers(ctrl),
+				teams:      teams.NewMockRepositoryTeams(ctrl),
+			}
+			tt.setupMock(m)
+
+			u := NewUsecase(m.codeOwners, m.identities, m.users, m.teams)
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("unknown owners are listed in the error", func(t *testing.T) {
+		m := mocks{
+			codeOwners: code_owners.NewMockRepositoryCodeOwners(ctrl),
+			identities: user_identities.NewMockRepositoryUserIdentities(ctrl),
+			users:      users.NewMockRepositoryUsers(ctrl),
+			teams:      teams.NewMockRepositoryTeams(ctrl),
+		}
+		m.identities.EXPECT().GetUserIdentity(gomock.Any(), usecase2.IdentityProviderEmail, "ghost@example.com").
+			Return(nil, repository.ErrIdentityNotFound)
+		m.teams.EXPECT().GetTeamByName(gomock.Any(), "ghosts").Return(nil, repository.ErrTeamNotFound)
+
+		u := NewUsecase(m.codeOwners, m.identities, m.users, m.teams)
+		_, err := u.Run(context.Background(), In{Repository: "org/service", Content: "* ghost@example.com @org/ghosts\n"})
+		require.ErrorIs(t, err, usecase2.ErrCodeOwnerNotFound)
+		assert.Contains(t, err.Error(), "ghost@example.com, @org/ghosts")
+	})
+}
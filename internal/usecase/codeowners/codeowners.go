@@ -0,0 +1,179 @@
+// Package codeowners parses CODEOWNERS files and finds the owners of changed paths the way GitHub does:
+// every line is a gitignore-style pattern followed by owners, and for each path the last matching line wins,
+// even when it lists no owners. Negation with "!" and character ranges with "[ ]" are not supported by GitHub
+// and are rejected.
+package codeowners
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	OwnerUser  = "user"
+	OwnerTeam  = "team"
+	OwnerEmail = "email"
+)
+
+var ErrInvalidLine = errors.New("invalid codeowners line")
+
+// Owner is written as @login for a user, @org/team for a team or as an email. Name holds the login,
+// the team name without the organization, or the email; logins and emails are lower-cased.
+type Owner struct {
+	Kind string
+	Name string
+	Raw  string
+}
+
+// Rule is a non-empty, non-comment line of the file, Line is 1-based.
+type Rule struct {
+	Line    int
+	Pattern string
+	Owners  []Owner
+
+	re *regexp.Regexp
+}
+
+type Ruleset struct {
+	Rules []Rule
+}
+
+// Parse parses a CODEOWNERS file, the first invalid line is reported with its number.
+func Parse(content string) (*Ruleset, error) {
+	ruleset := &Ruleset{}
+	for i, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		for j, field := range fields {
+			if strings.HasPrefix(field, "#") {
+				fields = fields[:j]
+				break
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		rule, err := parseRule(i+1, fields[0], fields[1:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidLine, i+1, err)
+		}
+		ruleset.Rules = append(ruleset.Rules, rule)
+	}
+	return ruleset, nil
+}
+
+// Match returns the last rule matching the path or nil, the path is relative to the repository root.
+func (r *Ruleset) Match(path string) *Rule {
+	path = strings.TrimPrefix(path, "/")
+	for i := len(r.Rules) - 1; i >= 0; i-- {
+		if r.Rules[i].re.MatchString(path) {
+			return &r.Rules[i]
+		}
+	}
+	return nil
+}
+
+func parseRule(line int, pattern string, rawOwners []string) (Rule, error) {
+	re, err := compilePattern(pattern)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	owners := make([]Owner, 0, len(rawOwners))
+	for _, raw := range rawOwners {
+		owner, err := parseOwner(raw)
+		if err != nil {
+			return Rule{}, err
+		}
+		owners = append(owners, owner)
+	}
+	return Rule{Line: line, Pattern: pattern, Owners: owners, re: re}, nil
+}
+
+func parseOwner(raw string) (Owner, error) {
+	if name, found := strings.CutPrefix(raw, "@"); found {
+		if org, team, isTeam := strings.Cut(name, "/"); isTeam {
+			if org == "" || team == "" || strings.Contains(team, "/") {
+				return Owner{}, fmt.Errorf("invalid team owner %q", raw)
+			}
+			return Owner{Kind: OwnerTeam, Name: team, Raw: raw}, nil
+		}
+		if name == "" {
+			return Owner{}, fmt.Errorf("invalid user owner %q", raw)
+		}
+		return Owner{Kind: OwnerUser, Name: strings.ToLower(name), Raw: raw}, nil
+	}
+
+	if local, domain, found := strings.Cut(raw, "@"); found && local != "" && domain != "" {
+		return Owner{Kind: OwnerEmail, Name: strings.ToLower(raw), Raw: raw}, nil
+	}
+	return Owner{}, fmt.Errorf("owner %q is neither @login, @org/team nor email", raw)
+}
+
+// compilePattern turns a gitignore-style pattern into a regexp matching file paths:
+//   - a pattern with a slash at the beginning or in the middle is relative to the root, otherwise it matches
+//     at any depth;
+//   - a trailing slash matches directories only, so the pattern applies to the files inside them;
+//   - "*" and "?" do not cross directories, "**" matches any number of them;
+//   - a pattern matching a directory applies to everything inside it, except a trailing "/*" which only
+//     matches the files directly in the directory.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if strings.HasPrefix(pattern, "!") {
+		return nil, fmt.Errorf("negated pattern %q is not supported", pattern)
+	}
+	if strings.ContainsAny(pattern, "[]") {
+		return nil, fmt.Errorf("character ranges in pattern %q are not supported", pattern)
+	}
+
+	dirOnly := strings.HasSuffix(pattern, "/")
+	anchored := strings.Contains(strings.TrimSuffix(pattern, "/"), "/")
+	trimmed := strings.Trim(pattern, "/")
+	if trimmed == "" {
+		return nil, fmt.Errorf("empty pattern %q", pattern)
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored {
+		b.WriteString("(?:.*/)?")
+	}
+
+	segments := strings.Split(trimmed, "/")
+	last := len(segments) - 1
+	for i, segment := range segments {
+		switch {
+		case segment == "**" && i == last:
+			b.WriteString(".+")
+		case segment == "**":
+			b.WriteString("(?:.+/)?")
+			continue
+		default:
+			for _, c := range segment {
+				switch c {
+				case '*':
+					b.WriteString("[^/]*")
+				case '?':
+					b.WriteString("[^/]")
+				default:
+					b.WriteString(regexp.QuoteMeta(string(c)))
+				}
+			}
+		}
+		if i != last {
+			b.WriteString("/")
+		}
+	}
+
+	switch {
+	case segments[last] == "**", segments[last] == "*" && last > 0:
+	case dirOnly:
+		b.WriteString("/.+")
+	default:
+		b.WriteString("(?:/.+)?")
+	}
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
@@ -0,0 +1,230 @@
+package codeowners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// githubExample is the example file from the GitHub documentation on code owners.
+const githubExample = `# This is a comment.
+# Each line is a file pattern followed by one or more owners.
+
+# These owners will be the default owners for everything in
+# the repo. Unless a later match takes precedence,
+# @global-owner1 and @global-owner2 will be requested for
+# review when someone opens a pull request.
+*       @global-owner1 @global-owner2
+
+# Order is important; the last matching pattern takes the most
+# precedence. When someone opens a pull request that only
+# modifies JS files, only @js-owner and not the global
+# owner(s) will be requested for a review.
+*.js    @js-owner #This is an inline comment.
+
+# You can also use email addresses if you prefer. They'll be
+# used to look up users just like we do for commit author
+# emails.
+*.go docs@example.com
+
+# Teams can be specified as code owners as well.
+*.txt @octo-org/octocats
+
+# In this example, @doctocat owns any files in the build/logs
+# directory at the root of the repository and any of its
+# subdirectories.
+/build/logs/ @doctocat
+
+# The ` + "`docs/*`" + ` pattern will match files like
+# ` + "`docs/getting-started.md`" + ` but not further nested files like
+# ` + "`docs/build-app/troubleshooting.md`" + `.
+docs/*  docs@example.com
+
+# In this example, @octocat owns any file in an apps directory
+# anywhere in your repository.
+apps/ @octocat
+
+# In this example, @doctocat owns any file in the ` + "`/docs`" + `
+# directory in the root of your repository and any of its
+# subdirectories.
+/docs/ @doctocat
+
+# In this example, any change inside the ` + "`/scripts`" + ` directory
+# will require approval from @doctocat or @octocat.
+/scripts/ @doctocat @octocat
+
+# In this example, @octocat owns any file in a ` + "`/logs`" + ` directory such as
+# ` + "`/build/logs`" + `, ` + "`/scripts/logs`" + `, and ` + "`/deeply/nested/logs`" + `. Any changes
+# in a ` + "`/logs`" + ` directory will require approval from @octocat.
+**/logs @octocat
+
+# In this example, @octocat owns any file in the ` + "`/apps`" + `
+# directory in the root of your repository except for the ` + "`/apps/github`" + `
+# subdirectory, as its owners are left empty.
+/apps/ @octocat
+/apps/github
+`
+
+func TestParse(t *testing.T) {
+	ruleset, err := Parse(githubExample)
+	require.NoError(t, err)
+	require.Len(t, ruleset.Rules, 12)
+
+	assert.Equal(t, 8, ruleset.Rules[0].Line)
+	assert.Equal(t, "*", ruleset.Rules[0].Pattern)
+	assert.Equal(t, []Owner{
+		{Kind: OwnerUser, Name: "global-owner1", Raw: "@global-owner1"},
+		{Kind: OwnerUser, Name: "global-owner2", Raw: "@global-owner2"},
+	}, ruleset.Rules[0].Owners)
+	assert.Equal(t, []Owner{{Kind: OwnerUser, Name: "js-owner", Raw: "@js-owner"}}, ruleset.Rules[1].Owners)
+	assert.Equal(t, []Owner{{Kind: OwnerEmail, Name: "docs@example.com", Raw: "docs@example.com"}}, ruleset.Rules[2].Owners)
+	assert.Equal(t, []Owner{{Kind: OwnerTeam, Name: "octocats", Raw: "@octo-org/octocats"}}, ruleset.Rules[3].Owners)
+	assert.Empty(t, ruleset.Rules[11].Owners)
+
+	t.Run("owners are normalized", func(t *testing.T) {
+		ruleset, err := Parse("*.md @Alice Docs@Example.com @Org/Backend\n")
+		require.NoError(t, err)
+		assert.Equal(t, []Owner{
+			{Kind: OwnerUser, Name: "alice", Raw: "@Alice"},
+			{Kind: OwnerEmail, Name: "docs@example.com", Raw: "Docs@Example.com"},
+			{Kind: OwnerTeam, Name: "Backend", Raw: "@Org/Backend"},
+		}, ruleset.Rules[0].Owners)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		ruleset, err := Parse("# only comments\n\n   \n")
+		require.NoError(t, err)
+		assert.Empty(t, ruleset.Rules)
+		assert.Nil(t, ruleset.Match("main.go"))
+	})
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{name: "negation", content: "*.go @alice\n!main.go @bob", wantErr: "line 2"},
+		{name: "character range", content: "*.[ch] @alice", wantErr: "line 1"},
+		{name: "owner without @", content: "*.go alice", wantErr: "line 1"},
+		{name: "team without name", content: "*.go @org/", wantErr: "line 1"},
+		{name: "nested team", content: "*.go @org/a/b", wantErr: "line 1"},
+		{name: "bare @", content: "*.go @", wantErr: "line 1"},
+		{name: "root only", content: "/ @alice", wantErr: "line 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.content)
+			require.ErrorIs(t, err, ErrInvalidLine)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	ruleset, err := Parse(githubExample)
+	require.NoError(t, err)
+
+	tests := []struct {
+		path        string
+		wantPattern string
+	}{
+		{path: "README.md", wantPattern: "*"},
+		{path: "src/index.js", wantPattern: "*.js"},
+		{path: "/src/index.js", wantPattern: "*.js"},
+		{path: "cmd/main.go", wantPattern: "*.go"},
+		{path: "notes/todo.txt", wantPattern: "*.txt"},
+		{path: "build/logs/today.log", wantPattern: "**/logs"},
+		{path: "build/logs/archive/old.log", wantPattern: "**/logs"},
+		{path: "build/output.log", wantPattern: "*"},
+		{path: "docs/getting-started.md", wantPattern: "/docs/"},
+		{path: "src/docs/getting-started.md", wantPattern: "*"},
+		{path: "src/apps/main.js", wantPattern: "apps/"},
+		{path: "apps/main.js", wantPattern: "/apps/"},
+		{path: "apps/github/main.js", wantPattern: "/apps/github"},
+		{path: "apps/github", wantPattern: "/apps/github"},
+		{path: "apps/githubber/main.js", wantPattern: "/apps/"},
+		{path: "scripts/deploy.sh", wantPattern: "/scripts/"},
+		{path: "scripts/logs/deploy.log", wantPattern: "**/logs"},
+		{path: "deeply/nested/logs/x.log", wantPattern: "**/logs"},
+		{path: "scripts", wantPattern: "*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rule := ruleset.Match(tt.path)
+			require.NotNil(t, rule)
+			assert.Equal(t, tt.wantPattern, rule.Pattern)
+		})
+	}
+}
+
+func TestMatchPatterns(t *testing.T) {
+	tests := []struct {
+		pattern string
+		matches []string
+		misses  []string
+	}{
+		{
+			pattern: "docs/*",
+			matches: []string{"docs/getting-started.md", "docs/.keep"},
+			misses:  []string{"docs/build-app/troubleshooting.md", "src/docs/readme.md", "docs"},
+		},
+		{
+			pattern: "docs/",
+			matches: []string{"docs/a.md", "docs/build-app/troubleshooting.md", "src/docs/a.md"},
+			misses:  []string{"docs", "mydocs/a.md"},
+		},
+		{
+			pattern: "docs",
+			matches: []string{"docs", "docs/a.md", "src/docs", "src/docs/a/b.md"},
+			misses:  []string{"docs.md", "src/mydocs/a.md"},
+		},
+		{
+			pattern: "/docs",
+			matches: []string{"docs", "docs/a/b.md"},
+			misses:  []string{"src/docs/a.md"},
+		},
+		{
+			pattern: "src/**/test",
+			matches: []string{"src/test/a.go", "src/a/test/a.go", "src/a/b/test"},
+			misses:  []string{"test/a.go", "lib/src/test/a.go", "src/atest/a.go"},
+		},
+		{
+			pattern: "src/**",
+			matches: []string{"src/a.go", "src/a/b/c.go"},
+			misses:  []string{"src", "lib/src/a.go"},
+		},
+		{
+			pattern: "*.go",
+			matches: []string{"main.go", "a/b/c.go"},
+			misses:  []string{"main.go.txt", "main.Go"},
+		},
+		{
+			pattern: "cmd/?.go",
+			matches: []string{"cmd/a.go"},
+			misses:  []string{"cmd/ab.go", "cmd/a/b.go"},
+		},
+		{
+			pattern: "a+b(c).txt",
+			matches: []string{"a+b(c).txt", "x/a+b(c).txt"},
+			misses:  []string{"aab(c).txt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			ruleset, err := Parse(tt.pattern + " @owner")
+			require.NoError(t, err)
+			for _, path := range tt.matches {
+				assert.NotNil(t, ruleset.Match(path), "%s should match %s", tt.pattern, path)
+			}
+			for _, path := range tt.misses {
+				assert.Nil(t, ruleset.Match(path), "%s should not match %s", tt.pattern, path)
+			}
+		})
+	}
+}
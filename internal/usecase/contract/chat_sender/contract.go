@@ -0,0 +1,12 @@
+package chat_sender
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/chat_sender"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=chat_sender Sender
+type Sender interface {
+	Send(ctx context.Context, url string, message chat_sender.Message) error
+}
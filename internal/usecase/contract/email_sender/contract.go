@@ -0,0 +1,12 @@
+package email_sender
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/email_sender"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=email_sender Sender
+type Sender interface {
+	Send(ctx context.Context, email email_sender.Email) error
+}
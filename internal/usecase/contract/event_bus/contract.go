@@ -0,0 +1,20 @@
+package event_bus
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/event_bus"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=event_bus Bus,Notifier
+type Bus interface {
+	Publish(topic uuid.UUID, message event_bus.Message)
+	Subscribe(topic uuid.UUID, lastID uuid.UUID) *event_bus.Subscription
+}
+
+// Notifier publishes a message to the buses of every instance once the transaction of ctx commits.
+type Notifier interface {
+	Notify(ctx context.Context, topics []uuid.UUID, message event_bus.Message) error
+}
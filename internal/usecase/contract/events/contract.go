@@ -0,0 +1,12 @@
+package events
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=events Publisher
+type Publisher interface {
+	Publish(ctx context.Context, events []usecase.Event) error
+}
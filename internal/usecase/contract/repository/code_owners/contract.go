@@ -0,0 +1,13 @@
+package code_owners
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/repository/code_owners"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=code_owners RepositoryCodeOwners
+type RepositoryCodeOwners interface {
+	SaveCodeOwners(ctx context.Context, codeOwners code_owners.CodeOwnersIn) (*code_owners.CodeOwnersOut, error)
+	GetCodeOwnersByRepository(ctx context.Context, repositoryName string) (*code_owners.CodeOwnersOut, error)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package code_owners is a generated GoMock package.
+package code_owners
+
+import (
+	context "context"
+	code_owners "pr-reviewers-service/internal/infrastructure/repository/code_owners"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepositoryCodeOwners is a mock of RepositoryCodeOwners interface.
+type MockRepositoryCodeOwners struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryCodeOwnersMockRecorder
+}
+
+// MockRepositoryCodeOwnersMockRecorder is the mock recorder for MockRepositoryCodeOwners.
+type MockRepositoryCodeOwnersMockRecorder struct {
+	mock *MockRepositoryCodeOwners
+}
+
+// NewMockRepositoryCodeOwners creates a new mock instance.
+func NewMockRepositoryCodeOwners(ctrl *gomock.Controller) *MockRepositoryCodeOwners {
+	mock := &MockRepositoryCodeOwners{ctrl: ctrl}
+	mock.recorder = &MockRepositoryCodeOwnersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryCodeOwners) EXPECT() *MockRepositoryCodeOwnersMockRecorder {
+	return m.recorder
+}
+
+// GetCodeOwnersByRepository mocks base method.
+func (m *MockRepositoryCodeOwners) GetCodeOwnersByRepository(ctx context.Context, repositoryName string) (*code_owners.CodeOwnersOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeOwnersByRepository", ctx, repositoryName)
+	ret0, _ := ret[0].(*code_owners.CodeOwnersOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeOwnersByRepository indicates an expected call of GetCodeOwnersByRepository.
+func (mr *MockRepositoryCodeOwnersMockRecorder) GetCodeOwnersByRepository(ctx, repositoryName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeOwnersByRepository", reflect.TypeOf((*MockRepositoryCodeOwners)(nil).GetCodeOwnersByRepository), ctx, repositoryName)
+}
+
+// SaveCodeOwners mocks base method.
+func (m *MockRepositoryCodeOwners) SaveCodeOwners(ctx context.Context, codeOwners code_owners.CodeOwnersIn) (*code_owners.CodeOwnersOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCodeOwners", ctx, codeOwners)
+	ret0, _ := ret[0].(*code_owners.CodeOwnersOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveCodeOwners indicates an expected call of SaveCodeOwners.
+func (mr *MockRepositoryCodeOwnersMockRecorder) SaveCodeOwners(ctx, codeOwners interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCodeOwners", reflect.TypeOf((*MockRepositoryCodeOwners)(nil).SaveCodeOwners), ctx, codeOwners)
+}
@@ -0,0 +1,14 @@
+package outbox
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/repository/outbox"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=outbox RepositoryOutbox
+type RepositoryOutbox interface {
+	SaveOutboxEventsBatch(ctx context.Context, events []outbox.OutboxEventIn) (*[]outbox.OutboxEventOut, error)
+	ClaimDueOutboxEvents(ctx context.Context, status string, limit uint64) (*[]outbox.OutboxEventOut, error)
+	UpdateOutboxEventAttempt(ctx context.Context, attempt outbox.OutboxEventAttemptIn) (*outbox.OutboxEventOut, error)
+}
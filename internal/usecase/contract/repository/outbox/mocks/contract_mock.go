@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package outbox is a generated GoMock package.
+package outbox
+
+import (
+	context "context"
+	outbox "pr-reviewers-service/internal/infrastructure/repository/outbox"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepositoryOutbox is a mock of RepositoryOutbox interface.
+type MockRepositoryOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryOutboxMockRecorder
+}
+
+// MockRepositoryOutboxMockRecorder is the mock recorder for MockRepositoryOutbox.
+type MockRepositoryOutboxMockRecorder struct {
+	mock *MockRepositoryOutbox
+}
+
+// NewMockRepositoryOutbox creates a new mock instance.
+func NewMockRepositoryOutbox(ctrl *gomock.Controller) *MockRepositoryOutbox {
+	mock := &MockRepositoryOutbox{ctrl: ctrl}
+	mock.recorder = &MockRepositoryOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryOutbox) EXPECT() *MockRepositoryOutboxMockRecorder {
+	return m.recorder
+}
+
+// ClaimDueOutboxEvents mocks base method.
+func (m *MockRepositoryOutbox) ClaimDueOutboxEvents(ctx context.Context, status string, limit uint64) (*[]outbox.OutboxEventOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueOutboxEvents", ctx, status, limit)
+	ret0, _ := ret[0].(*[]outbox.OutboxEventOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueOutboxEvents indicates an expected call of ClaimDueOutboxEvents.
+func (mr *MockRepositoryOutboxMockRecorder) ClaimDueOutboxEvents(ctx, status, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueOutboxEvents", reflect.TypeOf((*MockRepositoryOutbox)(nil).ClaimDueOutboxEvents), ctx, status, limit)
+}
+
+// SaveOutboxEventsBatch mocks base method.
+func (m *MockRepositoryOutbox) SaveOutboxEventsBatch(ctx context.Context, events []outbox.OutboxEventIn) (*[]outbox.OutboxEventOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveOutboxEventsBatch", ctx, events)
+	ret0, _ := ret[0].(*[]outbox.OutboxEventOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveOutboxEventsBatch indicates an expected call of SaveOutboxEventsBatch.
+func (mr *MockRepositoryOutboxMockRecorder) SaveOutboxEventsBatch(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveOutboxEventsBatch", reflect.TypeOf((*MockRepositoryOutbox)(nil).SaveOutboxEventsBatch), ctx, events)
+}
+
+// UpdateOutboxEventAttempt mocks base method.
+func (m *MockRepositoryOutbox) UpdateOutboxEventAttempt(ctx context.Context, attempt outbox.OutboxEventAttemptIn) (*outbox.OutboxEventOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOutboxEventAttempt", ctx, attempt)
+	ret0, _ := ret[0].(*outbox.OutboxEventOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOutboxEventAttempt indicates an expected call of UpdateOutboxEventAttempt.
+func (mr *MockRepositoryOutboxMockRecorder) UpdateOutboxEventAttempt(ctx, attempt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboxEventAttempt", reflect.TypeOf((*MockRepositoryOutbox)(nil).UpdateOutboxEventAttempt), ctx, attempt)
+}
@@ -2,6 +2,7 @@ package pr_reviewers
 
 import (
 	"context"
+	"time"
 
 	"pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
 
@@ -16,4 +17,5 @@ type RepositoryPrReviewers interface {
 	GetPRReviewersByReviewerIDs(ctx context.Context, reviewerIDs []uuid.UUID) (*[]pr_reviewers.PrReviewerOut, error)
 	GetAllPRReviewers(ctx context.Context) (*[]pr_reviewers.PrReviewerOut, error)
 	DeletePRReviewerByPRAndReviewer(ctx context.Context, prID, reviewerID uuid.UUID) error
+	CountReviewsByReviewerID(ctx context.Context, reviewerID uuid.UUID, status string, mergedSince *time.Time) (int, error)
 }
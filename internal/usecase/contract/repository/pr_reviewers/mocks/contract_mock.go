@@ -8,6 +8,7 @@ import (
 	context "context"
 	pr_reviewers "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,6 +37,21 @@ func (m *MockRepositoryPrReviewers) EXPECT() *MockRepositoryPrReviewersMockRecor
 	return m.recorder
 }
 
+// CountReviewsByReviewerID mocks base method.
+func (m *MockRepositoryPrReviewers) CountReviewsByReviewerID(ctx context.Context, reviewerID uuid.UUID, status string, mergedSince *time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReviewsByReviewerID", ctx, reviewerID, status, mergedSince)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountReviewsByReviewerID indicates an expected call of CountReviewsByReviewerID.
+func (mr *MockRepositoryPrReviewersMockRecorder) CountReviewsByReviewerID(ctx, reviewerID, status, mergedSince interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReviewsByReviewerID", reflect.TypeOf((*MockRepositoryPrReviewers)(nil).CountReviewsByReviewerID), ctx, reviewerID, status, mergedSince)
+}
+
 // DeletePRReviewerByPRAndReviewer mocks base method.
 func (m *MockRepositoryPrReviewers) DeletePRReviewerByPRAndReviewer(ctx context.Context, prID, reviewerID uuid.UUID) error {
 	m.ctrl.T.Helper()
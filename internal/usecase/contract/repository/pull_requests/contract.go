@@ -13,5 +13,7 @@ type RepositoryPullRequests interface {
 	SavePullRequest(ctx context.Context, pr pull_requests.PullRequestIn) (*pull_requests.PullRequestOut, error)
 	GetPullRequestByID(ctx context.Context, prID uuid.UUID) (*pull_requests.PullRequestOut, error)
 	GetPullRequestsByPrIDs(ctx context.Context, prIDs []uuid.UUID) (*[]pull_requests.PullRequestOut, error)
+	GetPullRequestsByAuthorIDs(ctx context.Context, authorIDs []uuid.UUID) (*[]pull_requests.PullRequestOut, error)
+	CountPullRequestsByAuthorID(ctx context.Context, authorID uuid.UUID, status string) (int, error)
 	MarkPullRequestMergedByID(ctx context.Context, prID uuid.UUID) (*pull_requests.PullRequestOut, error)
 }
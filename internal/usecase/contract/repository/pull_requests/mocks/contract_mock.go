@@ -36,6 +36,21 @@ func (m *MockRepositoryPullRequests) EXPECT() *MockRepositoryPullRequestsMockRec
 	return m.recorder
 }
 
+// CountPullRequestsByAuthorID mocks base method.
+func (m *MockRepositoryPullRequests) CountPullRequestsByAuthorID(ctx context.Context, authorID uuid.UUID, status string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPullRequestsByAuthorID", ctx, authorID, status)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPullRequestsByAuthorID indicates an expected call of CountPullRequestsByAuthorID.
+func (mr *MockRepositoryPullRequestsMockRecorder) CountPullRequestsByAuthorID(ctx, authorID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPullRequestsByAuthorID", reflect.TypeOf((*MockRepositoryPullRequests)(nil).CountPullRequestsByAuthorID), ctx, authorID, status)
+}
+
 // GetPullRequestByID mocks base method.
 func (m *MockRepositoryPullRequests) GetPullRequestByID(ctx context.Context, prID uuid.UUID) (*pull_requests.PullRequestOut, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +66,21 @@ func (mr *MockRepositoryPullRequestsMockRecorder) GetPullRequestByID(ctx, prID i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestByID", reflect.TypeOf((*MockRepositoryPullRequests)(nil).GetPullRequestByID), ctx, prID)
 }
 
+// GetPullRequestsByAuthorIDs mocks base method.
+func (m *MockRepositoryPullRequests) GetPullRequestsByAuthorIDs(ctx context.Context, authorIDs []uuid.UUID) (*[]pull_requests.PullRequestOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestsByAuthorIDs", ctx, authorIDs)
+	ret0, _ := ret[0].(*[]pull_requests.PullRequestOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequestsByAuthorIDs indicates an expected call of GetPullRequestsByAuthorIDs.
+func (mr *MockRepositoryPullRequestsMockRecorder) GetPullRequestsByAuthorIDs(ctx, authorIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestsByAuthorIDs", reflect.TypeOf((*MockRepositoryPullRequests)(nil).GetPullRequestsByAuthorIDs), ctx, authorIDs)
+}
+
 // GetPullRequestsByPrIDs mocks base method.
 func (m *MockRepositoryPullRequests) GetPullRequestsByPrIDs(ctx context.Context, prIDs []uuid.UUID) (*[]pull_requests.PullRequestOut, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,13 @@
+package review_snoozes
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/repository/review_snoozes"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=review_snoozes RepositoryReviewSnoozes
+type RepositoryReviewSnoozes interface {
+	SaveReviewSnooze(ctx context.Context, snooze review_snoozes.ReviewSnoozeIn) (*review_snoozes.ReviewSnoozeOut, error)
+	GetActiveReviewSnoozes(ctx context.Context) (*[]review_snoozes.ReviewSnoozeOut, error)
+}
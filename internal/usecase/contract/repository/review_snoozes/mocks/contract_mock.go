@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package review_snoozes is a generated GoMock package.
+package review_snoozes
+
+import (
+	context "context"
+	review_snoozes "pr-reviewers-service/internal/infrastructure/repository/review_snoozes"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepositoryReviewSnoozes is a mock of RepositoryReviewSnoozes interface.
+type MockRepositoryReviewSnoozes struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryReviewSnoozesMockRecorder
+}
+
+// MockRepositoryReviewSnoozesMockRecorder is the mock recorder for MockRepositoryReviewSnoozes.
+type MockRepositoryReviewSnoozesMockRecorder struct {
+	mock *MockRepositoryReviewSnoozes
+}
+
+// NewMockRepositoryReviewSnoozes creates a new mock instance.
+func NewMockRepositoryReviewSnoozes(ctrl *gomock.Controller) *MockRepositoryReviewSnoozes {
+	mock := &MockRepositoryReviewSnoozes{ctrl: ctrl}
+	mock.recorder = &MockRepositoryReviewSnoozesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryReviewSnoozes) EXPECT() *MockRepositoryReviewSnoozesMockRecorder {
+	return m.recorder
+}
+
+// GetActiveReviewSnoozes mocks base method.
+func (m *MockRepositoryReviewSnoozes) GetActiveReviewSnoozes(ctx context.Context) (*[]review_snoozes.ReviewSnoozeOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveReviewSnoozes", ctx)
+	ret0, _ := ret[0].(*[]review_snoozes.ReviewSnoozeOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveReviewSnoozes indicates an expected call of GetActiveReviewSnoozes.
+func (mr *MockRepositoryReviewSnoozesMockRecorder) GetActiveReviewSnoozes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveReviewSnoozes", reflect.TypeOf((*MockRepositoryReviewSnoozes)(nil).GetActiveReviewSnoozes), ctx)
+}
+
+// SaveReviewSnooze mocks base method.
+func (m *MockRepositoryReviewSnoozes) SaveReviewSnooze(ctx context.Context, snooze review_snoozes.ReviewSnoozeIn) (*review_snoozes.ReviewSnoozeOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReviewSnooze", ctx, snooze)
+	ret0, _ := ret[0].(*review_snoozes.ReviewSnoozeOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveReviewSnooze indicates an expected call of SaveReviewSnooze.
+func (mr *MockRepositoryReviewSnoozesMockRecorder) SaveReviewSnooze(ctx, snooze interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReviewSnooze", reflect.TypeOf((*MockRepositoryReviewSnoozes)(nil).SaveReviewSnooze), ctx, snooze)
+}
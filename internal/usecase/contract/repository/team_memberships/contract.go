@@ -0,0 +1,17 @@
+package team_memberships
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=team_memberships RepositoryTeamMemberships
+type RepositoryTeamMemberships interface {
+	SaveTeamMembershipsBatch(ctx context.Context, memberships []team_memberships.TeamMembershipIn) (*[]team_memberships.TeamMembershipOut, error)
+	GetTeamMembershipsByUserIDs(ctx context.Context, userIDs []uuid.UUID) (*[]team_memberships.TeamMembershipOut, error)
+	SetPrimaryTeamMembership(ctx context.Context, userID, teamID uuid.UUID) (*team_memberships.TeamMembershipOut, error)
+	DeleteTeamMembership(ctx context.Context, userID, teamID uuid.UUID) error
+}
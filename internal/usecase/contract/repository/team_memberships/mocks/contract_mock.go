@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package team_memberships is a generated GoMock package.
+package team_memberships
+
+import (
+	context "context"
+	team_memberships "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockRepositoryTeamMemberships is a mock of RepositoryTeamMemberships interface.
+type MockRepositoryTeamMemberships struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryTeamMembershipsMockRecorder
+}
+
+// MockRepositoryTeamMembershipsMockRecorder is the mock recorder for MockRepositoryTeamMemberships.
+type MockRepositoryTeamMembershipsMockRecorder struct {
+	mock *MockRepositoryTeamMemberships
+}
+
+// NewMockRepositoryTeamMemberships creates a new mock instance.
+func NewMockRepositoryTeamMemberships(ctrl *gomock.Controller) *MockRepositoryTeamMemberships {
+	mock := &MockRepositoryTeamMemberships{ctrl: ctrl}
+	mock.recorder = &MockRepositoryTeamMembershipsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryTeamMemberships) EXPECT() *MockRepositoryTeamMembershipsMockRecorder {
+	return m.recorder
+}
+
+// DeleteTeamMembership mocks base method.
+func (m *MockRepositoryTeamMemberships) DeleteTeamMembership(ctx context.Context, userID, teamID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeamMembership", ctx, userID, teamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeamMembership indicates an expected call of DeleteTeamMembership.
+func (mr *MockRepositoryTeamMembershipsMockRecorder) DeleteTeamMembership(ctx, userID, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeamMembership", reflect.TypeOf((*MockRepositoryTeamMemberships)(nil).DeleteTeamMembership), ctx, userID, teamID)
+}
+
+// GetTeamMembershipsByUserIDs mocks base method.
+func (m *MockRepositoryTeamMemberships) GetTeamMembershipsByUserIDs(ctx context.Context, userIDs []uuid.UUID) (*[]team_memberships.TeamMembershipOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamMembershipsByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].(*[]team_memberships.TeamMembershipOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamMembershipsByUserIDs indicates an expected call of GetTeamMembershipsByUserIDs.
+func (mr *MockRepositoryTeamMembershipsMockRecorder) GetTeamMembershipsByUserIDs(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamMembershipsByUserIDs", reflect.TypeOf((*MockRepositoryTeamMemberships)(nil).GetTeamMembershipsByUserIDs), ctx, userIDs)
+}
+
+// SaveTeamMembershipsBatch mocks base method.
+func (m *MockRepositoryTeamMemberships) SaveTeamMembershipsBatch(ctx context.Context, memberships []team_memberships.TeamMembershipIn) (*[]team_memberships.TeamMembershipOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTeamMembershipsBatch", ctx, memberships)
+	ret0, _ := ret[0].(*[]team_memberships.TeamMembershipOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTeamMembershipsBatch indicates an expected call of SaveTeamMembershipsBatch.
+func (mr *MockRepositoryTeamMembershipsMockRecorder) SaveTeamMembershipsBatch(ctx, memberships interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTeamMembershipsBatch", reflect.TypeOf((*MockRepositoryTeamMemberships)(nil).SaveTeamMembershipsBatch), ctx, memberships)
+}
+
+// SetPrimaryTeamMembership mocks base method.
+func (m *MockRepositoryTeamMemberships) SetPrimaryTeamMembership(ctx context.Context, userID, teamID uuid.UUID) (*team_memberships.TeamMembershipOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrimaryTeamMembership", ctx, userID, teamID)
+	ret0, _ := ret[0].(*team_memberships.TeamMembershipOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPrimaryTeamMembership indicates an expected call of SetPrimaryTeamMembership.
+func (mr *MockRepositoryTeamMembershipsMockRecorder) SetPrimaryTeamMembership(ctx, userID, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimaryTeamMembership", reflect.TypeOf((*MockRepositoryTeamMemberships)(nil).SetPrimaryTeamMembership), ctx, userID, teamID)
+}
@@ -13,4 +13,13 @@ type RepositoryTeams interface {
 	SaveTeam(ctx context.Context, team teams.TeamIn) (*teams.TeamOut, error)
 	GetTeamByID(ctx context.Context, team uuid.UUID) (*teams.TeamOut, error)
 	GetTeamByName(ctx context.Context, name string) (*teams.TeamOut, error)
+	GetAllTeams(ctx context.Context) (*[]teams.TeamOut, error)
+	GetAllTeamsWithArchived(ctx context.Context) (*[]teams.TeamOut, error)
+	GetTeamsPage(ctx context.Context, namePrefix string, limit, offset uint64) (*[]teams.TeamOut, error)
+	CountTeams(ctx context.Context, namePrefix string) (int, error)
+	GetTeamsLoad(ctx context.Context, teamIDs []uuid.UUID, openStatus string) (*[]teams.TeamLoadOut, error)
+	UpdateTeamParent(ctx context.Context, teamID uuid.UUID, parentTeamID *uuid.UUID) (*teams.TeamOut, error)
+	UpdateTeamName(ctx context.Context, teamID uuid.UUID, name string) (*teams.TeamOut, error)
+	SetTeamArchived(ctx context.Context, teamID uuid.UUID, archived bool) (*teams.TeamOut, error)
+	DeleteTeamByID(ctx context.Context, teamID uuid.UUID) error
 }
@@ -36,6 +36,65 @@ func (m *MockRepositoryTeams) EXPECT() *MockRepositoryTeamsMockRecorder {
 	return m.recorder
 }
 
+// CountTeams mocks base method.
+func (m *MockRepositoryTeams) CountTeams(ctx context.Context, namePrefix string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTeams", ctx, namePrefix)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTeams indicates an expected call of CountTeams.
+func (mr *MockRepositoryTeamsMockRecorder) CountTeams(ctx, namePrefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTeams", reflect.TypeOf((*MockRepositoryTeams)(nil).CountTeams), ctx, namePrefix)
+}
+
+// DeleteTeamByID mocks base method.
+func (m *MockRepositoryTeams) DeleteTeamByID(ctx context.Context, teamID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeamByID", ctx, teamID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeamByID indicates an expected call of DeleteTeamByID.
+func (mr *MockRepositoryTeamsMockRecorder) DeleteTeamByID(ctx, teamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeamByID", reflect.TypeOf((*MockRepositoryTeams)(nil).DeleteTeamByID), ctx, teamID)
+}
+
+// GetAllTeams mocks base method.
+func (m *MockRepositoryTeams) GetAllTeams(ctx context.Context) (*[]teams.TeamOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTeams", ctx)
+	ret0, _ := ret[0].(*[]teams.TeamOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllTeams indicates an expected call of GetAllTeams.
+func (mr *MockRepositoryTeamsMockRecorder) GetAllTeams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTeams", reflect.TypeOf((*MockRepositoryTeams)(nil).GetAllTeams), ctx)
+}
+
+// GetAllTeamsWithArchived mocks base method.
+func (m *MockRepositoryTeams) GetAllTeamsWithArchived(ctx context.Context) (*[]teams.TeamOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllTeamsWithArchived", ctx)
+	ret0, _ := ret[0].(*[]teams.TeamOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllTeamsWithArchived indicates an expected call of GetAllTeamsWithArchived.
+func (mr *MockRepositoryTeamsMockRecorder) GetAllTeamsWithArchived(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTeamsWithArchived", reflect.TypeOf((*MockRepositoryTeams)(nil).GetAllTeamsWithArchived), ctx)
+}
+
 // GetTeamByID mocks base method.
 func (m *MockRepositoryTeams) GetTeamByID(ctx context.Context, team uuid.UUID) (*teams.TeamOut, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +125,36 @@ func (mr *MockRepositoryTeamsMockRecorder) GetTeamByName(ctx, name interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamByName", reflect.TypeOf((*MockRepositoryTeams)(nil).GetTeamByName), ctx, name)
 }
 
+// GetTeamsLoad mocks base method.
+func (m *MockRepositoryTeams) GetTeamsLoad(ctx context.Context, teamIDs []uuid.UUID, openStatus string) (*[]teams.TeamLoadOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamsLoad", ctx, teamIDs, openStatus)
+	ret0, _ := ret[0].(*[]teams.TeamLoadOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamsLoad indicates an expected call of GetTeamsLoad.
+func (mr *MockRepositoryTeamsMockRecorder) GetTeamsLoad(ctx, teamIDs, openStatus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamsLoad", reflect.TypeOf((*MockRepositoryTeams)(nil).GetTeamsLoad), ctx, teamIDs, openStatus)
+}
+
+// GetTeamsPage mocks base method.
+func (m *MockRepositoryTeams) GetTeamsPage(ctx context.Context, namePrefix string, limit, offset uint64) (*[]teams.TeamOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamsPage", ctx, namePrefix, limit, offset)
+	ret0, _ := ret[0].(*[]teams.TeamOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamsPage indicates an expected call of GetTeamsPage.
+func (mr *MockRepositoryTeamsMockRecorder) GetTeamsPage(ctx, namePrefix, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamsPage", reflect.TypeOf((*MockRepositoryTeams)(nil).GetTeamsPage), ctx, namePrefix, limit, offset)
+}
+
 // SaveTeam mocks base method.
 func (m *MockRepositoryTeams) SaveTeam(ctx context.Context, team teams.TeamIn) (*teams.TeamOut, error) {
 	m.ctrl.T.Helper()
@@ -80,3 +169,48 @@ func (mr *MockRepositoryTeamsMockRecorder) SaveTeam(ctx, team interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTeam", reflect.TypeOf((*MockRepositoryTeams)(nil).SaveTeam), ctx, team)
 }
+
+// SetTeamArchived mocks base method.
+func (m *MockRepositoryTeams) SetTeamArchived(ctx context.Context, teamID uuid.UUID, archived bool) (*teams.TeamOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTeamArchived", ctx, teamID, archived)
+	ret0, _ := ret[0].(*teams.TeamOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTeamArchived indicates an expected call of SetTeamArchived.
+func (mr *MockRepositoryTeamsMockRecorder) SetTeamArchived(ctx, teamID, archived interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTeamArchived", reflect.TypeOf((*MockRepositoryTeams)(nil).SetTeamArchived), ctx, teamID, archived)
+}
+
+// UpdateTeamName mocks base method.
+func (m *MockRepositoryTeams) UpdateTeamName(ctx context.Context, teamID uuid.UUID, name string) (*teams.TeamOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamName", ctx, teamID, name)
+	ret0, _ := ret[0].(*teams.TeamOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamName indicates an expected call of UpdateTeamName.
+func (mr *MockRepositoryTeamsMockRecorder) UpdateTeamName(ctx, teamID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamName", reflect.TypeOf((*MockRepositoryTeams)(nil).UpdateTeamName), ctx, teamID, name)
+}
+
+// UpdateTeamParent mocks base method.
+func (m *MockRepositoryTeams) UpdateTeamParent(ctx context.Context, teamID uuid.UUID, parentTeamID *uuid.UUID) (*teams.TeamOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamParent", ctx, teamID, parentTeamID)
+	ret0, _ := ret[0].(*teams.TeamOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamParent indicates an expected call of UpdateTeamParent.
+func (mr *MockRepositoryTeamsMockRecorder) UpdateTeamParent(ctx, teamID, parentTeamID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamParent", reflect.TypeOf((*MockRepositoryTeams)(nil).UpdateTeamParent), ctx, teamID, parentTeamID)
+}
@@ -0,0 +1,17 @@
+package user_identities
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/repository/user_identities"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=user_identities RepositoryUserIdentities
+type RepositoryUserIdentities interface {
+	SaveUserIdentitiesBatch(ctx context.Context, identities []user_identities.UserIdentityIn) (*[]user_identities.UserIdentityOut, error)
+	GetUserIdentity(ctx context.Context, provider, externalID string) (*user_identities.UserIdentityOut, error)
+	GetUserIdentitiesByUserIDs(ctx context.Context, userIDs []uuid.UUID) (*[]user_identities.UserIdentityOut, error)
+	DeleteUserIdentity(ctx context.Context, userID uuid.UUID, provider, externalID string) error
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package user_identities is a generated GoMock package.
+package user_identities
+
+import (
+	context "context"
+	user_identities "pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockRepositoryUserIdentities is a mock of RepositoryUserIdentities interface.
+type MockRepositoryUserIdentities struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryUserIdentitiesMockRecorder
+}
+
+// MockRepositoryUserIdentitiesMockRecorder is the mock recorder for MockRepositoryUserIdentities.
+type MockRepositoryUserIdentitiesMockRecorder struct {
+	mock *MockRepositoryUserIdentities
+}
+
+// NewMockRepositoryUserIdentities creates a new mock instance.
+func NewMockRepositoryUserIdentities(ctrl *gomock.Controller) *MockRepositoryUserIdentities {
+	mock := &MockRepositoryUserIdentities{ctrl: ctrl}
+	mock.recorder = &MockRepositoryUserIdentitiesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryUserIdentities) EXPECT() *MockRepositoryUserIdentitiesMockRecorder {
+	return m.recorder
+}
+
+// DeleteUserIdentity mocks base method.
+func (m *MockRepositoryUserIdentities) DeleteUserIdentity(ctx context.Context, userID uuid.UUID, provider, externalID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserIdentity", ctx, userID, provider, externalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserIdentity indicates an expected call of DeleteUserIdentity.
+func (mr *MockRepositoryUserIdentitiesMockRecorder) DeleteUserIdentity(ctx, userID, provider, externalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserIdentity", reflect.TypeOf((*MockRepositoryUserIdentities)(nil).DeleteUserIdentity), ctx, userID, provider, externalID)
+}
+
+// GetUserIdentitiesByUserIDs mocks base method.
+func (m *MockRepositoryUserIdentities) GetUserIdentitiesByUserIDs(ctx context.Context, userIDs []uuid.UUID) (*[]user_identities.UserIdentityOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentitiesByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].(*[]user_identities.UserIdentityOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdentitiesByUserIDs indicates an expected call of GetUserIdentitiesByUserIDs.
+func (mr *MockRepositoryUserIdentitiesMockRecorder) GetUserIdentitiesByUserIDs(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentitiesByUserIDs", reflect.TypeOf((*MockRepositoryUserIdentities)(nil).GetUserIdentitiesByUserIDs), ctx, userIDs)
+}
+
+// GetUserIdentity mocks base method.
+func (m *MockRepositoryUserIdentities) GetUserIdentity(ctx context.Context, provider, externalID string) (*user_identities.UserIdentityOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentity", ctx, provider, externalID)
+	ret0, _ := ret[0].(*user_identities.UserIdentityOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdentity indicates an expected call of GetUserIdentity.
+func (mr *MockRepositoryUserIdentitiesMockRecorder) GetUserIdentity(ctx, provider, externalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentity", reflect.TypeOf((*MockRepositoryUserIdentities)(nil).GetUserIdentity), ctx, provider, externalID)
+}
+
+// SaveUserIdentitiesBatch mocks base method.
+func (m *MockRepositoryUserIdentities) SaveUserIdentitiesBatch(ctx context.Context, identities []user_identities.UserIdentityIn) (*[]user_identities.UserIdentityOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveUserIdentitiesBatch", ctx, identities)
+	ret0, _ := ret[0].(*[]user_identities.UserIdentityOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveUserIdentitiesBatch indicates an expected call of SaveUserIdentitiesBatch.
+func (mr *MockRepositoryUserIdentitiesMockRecorder) SaveUserIdentitiesBatch(ctx, identities interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUserIdentitiesBatch", reflect.TypeOf((*MockRepositoryUserIdentities)(nil).SaveUserIdentitiesBatch), ctx, identities)
+}
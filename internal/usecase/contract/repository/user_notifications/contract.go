@@ -0,0 +1,12 @@
+package user_notifications
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/repository/user_notifications"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=user_notifications RepositoryUserNotifications
+type RepositoryUserNotifications interface {
+	ClaimUserNotification(ctx context.Context, notification user_notifications.UserNotificationIn) (bool, error)
+}
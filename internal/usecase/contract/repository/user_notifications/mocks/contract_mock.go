@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package user_notifications is a generated GoMock package.
+package user_notifications
+
+import (
+	context "context"
+	user_notifications "pr-reviewers-service/internal/infrastructure/repository/user_notifications"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepositoryUserNotifications is a mock of RepositoryUserNotifications interface.
+type MockRepositoryUserNotifications struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryUserNotificationsMockRecorder
+}
+
+// MockRepositoryUserNotificationsMockRecorder is the mock recorder for MockRepositoryUserNotifications.
+type MockRepositoryUserNotificationsMockRecorder struct {
+	mock *MockRepositoryUserNotifications
+}
+
+// NewMockRepositoryUserNotifications creates a new mock instance.
+func NewMockRepositoryUserNotifications(ctrl *gomock.Controller) *MockRepositoryUserNotifications {
+	mock := &MockRepositoryUserNotifications{ctrl: ctrl}
+	mock.recorder = &MockRepositoryUserNotificationsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryUserNotifications) EXPECT() *MockRepositoryUserNotificationsMockRecorder {
+	return m.recorder
+}
+
+// ClaimUserNotification mocks base method.
+func (m *MockRepositoryUserNotifications) ClaimUserNotification(ctx context.Context, notification user_notifications.UserNotificationIn) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimUserNotification", ctx, notification)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimUserNotification indicates an expected call of ClaimUserNotification.
+func (mr *MockRepositoryUserNotificationsMockRecorder) ClaimUserNotification(ctx, notification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimUserNotification", reflect.TypeOf((*MockRepositoryUserNotifications)(nil).ClaimUserNotification), ctx, notification)
+}
@@ -2,6 +2,7 @@ package users
 
 import (
 	"context"
+	"time"
 
 	"pr-reviewers-service/internal/infrastructure/repository/users"
 
@@ -15,6 +16,12 @@ type RepositoryUsers interface {
 	GetUsersByTeamID(ctx context.Context, teamID uuid.UUID) (*[]users.UserOut, error)
 	GetActiveUsersByTeamID(ctx context.Context, teamID uuid.UUID) (*[]users.UserOut, error)
 	UpdateUser(ctx context.Context, user users.UserIn) (*users.UserOut, error)
+	SetUserUnavailableUntil(ctx context.Context, userID uuid.UUID, until *time.Time) (*users.UserOut, error)
 	SaveUsersBatch(ctx context.Context, urs []users.UserIn) (*[]users.UserOut, error)
 	UpdateUsersBatch(ctx context.Context, urs []users.UserIn) (*[]users.UserOut, error)
+	GetUsersByName(ctx context.Context, name string) (*[]users.UserOut, error)
+	GetAllUsers(ctx context.Context) (*[]users.UserOut, error)
+	GetUsersPage(ctx context.Context, limit, offset uint64) (*[]users.UserOut, error)
+	CountUsers(ctx context.Context) (int, error)
+	DeleteUserByID(ctx context.Context, userID uuid.UUID) error
 }
@@ -8,6 +8,7 @@ import (
 	context "context"
 	users "pr-reviewers-service/internal/infrastructure/repository/users"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -36,6 +37,35 @@ func (m *MockRepositoryUsers) EXPECT() *MockRepositoryUsersMockRecorder {
 	return m.recorder
 }
 
+// CountUsers mocks base method.
+func (m *MockRepositoryUsers) CountUsers(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsers", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsers indicates an expected call of CountUsers.
+func (mr *MockRepositoryUsersMockRecorder) CountUsers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsers", reflect.TypeOf((*MockRepositoryUsers)(nil).CountUsers), ctx)
+}
+
+// DeleteUserByID mocks base method.
+func (m *MockRepositoryUsers) DeleteUserByID(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserByID", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserByID indicates an expected call of DeleteUserByID.
+func (mr *MockRepositoryUsersMockRecorder) DeleteUserByID(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserByID", reflect.TypeOf((*MockRepositoryUsers)(nil).DeleteUserByID), ctx, userID)
+}
+
 // GetActiveUsersByTeamID mocks base method.
 func (m *MockRepositoryUsers) GetActiveUsersByTeamID(ctx context.Context, teamID uuid.UUID) (*[]users.UserOut, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +81,21 @@ func (mr *MockRepositoryUsersMockRecorder) GetActiveUsersByTeamID(ctx, teamID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveUsersByTeamID", reflect.TypeOf((*MockRepositoryUsers)(nil).GetActiveUsersByTeamID), ctx, teamID)
 }
 
+// GetAllUsers mocks base method.
+func (m *MockRepositoryUsers) GetAllUsers(ctx context.Context) (*[]users.UserOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUsers", ctx)
+	ret0, _ := ret[0].(*[]users.UserOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUsers indicates an expected call of GetAllUsers.
+func (mr *MockRepositoryUsersMockRecorder) GetAllUsers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockRepositoryUsers)(nil).GetAllUsers), ctx)
+}
+
 // GetUserByID mocks base method.
 func (m *MockRepositoryUsers) GetUserByID(ctx context.Context, userId uuid.UUID) (*users.UserOut, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +126,21 @@ func (mr *MockRepositoryUsersMockRecorder) GetUsersByIDs(ctx, userIds interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*MockRepositoryUsers)(nil).GetUsersByIDs), ctx, userIds)
 }
 
+// GetUsersByName mocks base method.
+func (m *MockRepositoryUsers) GetUsersByName(ctx context.Context, name string) (*[]users.UserOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByName", ctx, name)
+	ret0, _ := ret[0].(*[]users.UserOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByName indicates an expected call of GetUsersByName.
+func (mr *MockRepositoryUsersMockRecorder) GetUsersByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByName", reflect.TypeOf((*MockRepositoryUsers)(nil).GetUsersByName), ctx, name)
+}
+
 // GetUsersByTeamID mocks base method.
 func (m *MockRepositoryUsers) GetUsersByTeamID(ctx context.Context, teamID uuid.UUID) (*[]users.UserOut, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +156,21 @@ func (mr *MockRepositoryUsersMockRecorder) GetUsersByTeamID(ctx, teamID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByTeamID", reflect.TypeOf((*MockRepositoryUsers)(nil).GetUsersByTeamID), ctx, teamID)
 }
 
+// GetUsersPage mocks base method.
+func (m *MockRepositoryUsers) GetUsersPage(ctx context.Context, limit, offset uint64) (*[]users.UserOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersPage", ctx, limit, offset)
+	ret0, _ := ret[0].(*[]users.UserOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersPage indicates an expected call of GetUsersPage.
+func (mr *MockRepositoryUsersMockRecorder) GetUsersPage(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersPage", reflect.TypeOf((*MockRepositoryUsers)(nil).GetUsersPage), ctx, limit, offset)
+}
+
 // SaveUsersBatch mocks base method.
 func (m *MockRepositoryUsers) SaveUsersBatch(ctx context.Context, urs []users.UserIn) (*[]users.UserOut, error) {
 	m.ctrl.T.Helper()
@@ -111,6 +186,21 @@ func (mr *MockRepositoryUsersMockRecorder) SaveUsersBatch(ctx, urs interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUsersBatch", reflect.TypeOf((*MockRepositoryUsers)(nil).SaveUsersBatch), ctx, urs)
 }
 
+// SetUserUnavailableUntil mocks base method.
+func (m *MockRepositoryUsers) SetUserUnavailableUntil(ctx context.Context, userID uuid.UUID, until *time.Time) (*users.UserOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserUnavailableUntil", ctx, userID, until)
+	ret0, _ := ret[0].(*users.UserOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserUnavailableUntil indicates an expected call of SetUserUnavailableUntil.
+func (mr *MockRepositoryUsersMockRecorder) SetUserUnavailableUntil(ctx, userID, until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserUnavailableUntil", reflect.TypeOf((*MockRepositoryUsers)(nil).SetUserUnavailableUntil), ctx, userID, until)
+}
+
 // UpdateUser mocks base method.
 func (m *MockRepositoryUsers) UpdateUser(ctx context.Context, user users.UserIn) (*users.UserOut, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,12 @@
+package webhook_deliveries
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/repository/webhook_deliveries"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=webhook_deliveries RepositoryWebhookDeliveries
+type RepositoryWebhookDeliveries interface {
+	SaveWebhookDelivery(ctx context.Context, delivery webhook_deliveries.WebhookDeliveryIn) (*webhook_deliveries.WebhookDeliveryOut, error)
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package webhook_deliveries is a generated GoMock package.
+package webhook_deliveries
+
+import (
+	context "context"
+	webhook_deliveries "pr-reviewers-service/internal/infrastructure/repository/webhook_deliveries"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRepositoryWebhookDeliveries is a mock of RepositoryWebhookDeliveries interface.
+type MockRepositoryWebhookDeliveries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryWebhookDeliveriesMockRecorder
+}
+
+// MockRepositoryWebhookDeliveriesMockRecorder is the mock recorder for MockRepositoryWebhookDeliveries.
+type MockRepositoryWebhookDeliveriesMockRecorder struct {
+	mock *MockRepositoryWebhookDeliveries
+}
+
+// NewMockRepositoryWebhookDeliveries creates a new mock instance.
+func NewMockRepositoryWebhookDeliveries(ctrl *gomock.Controller) *MockRepositoryWebhookDeliveries {
+	mock := &MockRepositoryWebhookDeliveries{ctrl: ctrl}
+	mock.recorder = &MockRepositoryWebhookDeliveriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryWebhookDeliveries) EXPECT() *MockRepositoryWebhookDeliveriesMockRecorder {
+	return m.recorder
+}
+
+// SaveWebhookDelivery mocks base method.
+func (m *MockRepositoryWebhookDeliveries) SaveWebhookDelivery(ctx context.Context, delivery webhook_deliveries.WebhookDeliveryIn) (*webhook_deliveries.WebhookDeliveryOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWebhookDelivery", ctx, delivery)
+	ret0, _ := ret[0].(*webhook_deliveries.WebhookDeliveryOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveWebhookDelivery indicates an expected call of SaveWebhookDelivery.
+func (mr *MockRepositoryWebhookDeliveriesMockRecorder) SaveWebhookDelivery(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWebhookDelivery", reflect.TypeOf((*MockRepositoryWebhookDeliveries)(nil).SaveWebhookDelivery), ctx, delivery)
+}
@@ -0,0 +1,18 @@
+package webhook_event_deliveries
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=webhook_event_deliveries RepositoryWebhookEventDeliveries
+type RepositoryWebhookEventDeliveries interface {
+	SaveWebhookEventDeliveriesBatch(ctx context.Context, deliveries []webhook_event_deliveries.WebhookEventDeliveryIn) (*[]webhook_event_deliveries.WebhookEventDeliveryOut, error)
+	ClaimDueWebhookEventDeliveries(ctx context.Context, status string, limit uint64) (*[]webhook_event_deliveries.DueWebhookEventDeliveryOut, error)
+	UpdateWebhookEventDeliveryAttempt(ctx context.Context, attempt webhook_event_deliveries.WebhookEventDeliveryAttemptIn) (*webhook_event_deliveries.WebhookEventDeliveryOut, error)
+	GetWebhookEventDeliveryByID(ctx context.Context, deliveryID uuid.UUID) (*webhook_event_deliveries.WebhookEventDeliveryOut, error)
+	GetWebhookEventDeliveries(ctx context.Context, filter webhook_event_deliveries.WebhookEventDeliveriesFilter) (*[]webhook_event_deliveries.WebhookEventDeliveryOut, error)
+}
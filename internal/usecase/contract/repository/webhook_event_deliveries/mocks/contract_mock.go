@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package webhook_event_deliveries is a generated GoMock package.
+package webhook_event_deliveries
+
+import (
+	context "context"
+	webhook_event_deliveries "pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockRepositoryWebhookEventDeliveries is a mock of RepositoryWebhookEventDeliveries interface.
+type MockRepositoryWebhookEventDeliveries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryWebhookEventDeliveriesMockRecorder
+}
+
+// MockRepositoryWebhookEventDeliveriesMockRecorder is the mock recorder for MockRepositoryWebhookEventDeliveries.
+type MockRepositoryWebhookEventDeliveriesMockRecorder struct {
+	mock *MockRepositoryWebhookEventDeliveries
+}
+
+// NewMockRepositoryWebhookEventDeliveries creates a new mock instance.
+func NewMockRepositoryWebhookEventDeliveries(ctrl *gomock.Controller) *MockRepositoryWebhookEventDeliveries {
+	mock := &MockRepositoryWebhookEventDeliveries{ctrl: ctrl}
+	mock.recorder = &MockRepositoryWebhookEventDeliveriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryWebhookEventDeliveries) EXPECT() *MockRepositoryWebhookEventDeliveriesMockRecorder {
+	return m.recorder
+}
+
+// ClaimDueWebhookEventDeliveries mocks base method.
+func (m *MockRepositoryWebhookEventDeliveries) ClaimDueWebhookEventDeliveries(ctx context.Context, status string, limit uint64) (*[]webhook_event_deliveries.DueWebhookEventDeliveryOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueWebhookEventDeliveries", ctx, status, limit)
+	ret0, _ := ret[0].(*[]webhook_event_deliveries.DueWebhookEventDeliveryOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueWebhookEventDeliveries indicates an expected call of ClaimDueWebhookEventDeliveries.
+func (mr *MockRepositoryWebhookEventDeliveriesMockRecorder) ClaimDueWebhookEventDeliveries(ctx, status, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueWebhookEventDeliveries", reflect.TypeOf((*MockRepositoryWebhookEventDeliveries)(nil).ClaimDueWebhookEventDeliveries), ctx, status, limit)
+}
+
+// GetWebhookEventDeliveries mocks base method.
+func (m *MockRepositoryWebhookEventDeliveries) GetWebhookEventDeliveries(ctx context.Context, filter webhook_event_deliveries.WebhookEventDeliveriesFilter) (*[]webhook_event_deliveries.WebhookEventDeliveryOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookEventDeliveries", ctx, filter)
+	ret0, _ := ret[0].(*[]webhook_event_deliveries.WebhookEventDeliveryOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookEventDeliveries indicates an expected call of GetWebhookEventDeliveries.
+func (mr *MockRepositoryWebhookEventDeliveriesMockRecorder) GetWebhookEventDeliveries(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookEventDeliveries", reflect.TypeOf((*MockRepositoryWebhookEventDeliveries)(nil).GetWebhookEventDeliveries), ctx, filter)
+}
+
+// GetWebhookEventDeliveryByID mocks base method.
+func (m *MockRepositoryWebhookEventDeliveries) GetWebhookEventDeliveryByID(ctx context.Context, deliveryID uuid.UUID) (*webhook_event_deliveries.WebhookEventDeliveryOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookEventDeliveryByID", ctx, deliveryID)
+	ret0, _ := ret[0].(*webhook_event_deliveries.WebhookEventDeliveryOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookEventDeliveryByID indicates an expected call of GetWebhookEventDeliveryByID.
+func (mr *MockRepositoryWebhookEventDeliveriesMockRecorder) GetWebhookEventDeliveryByID(ctx, deliveryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookEventDeliveryByID", reflect.TypeOf((*MockRepositoryWebhookEventDeliveries)(nil).GetWebhookEventDeliveryByID), ctx, deliveryID)
+}
+
+// SaveWebhookEventDeliveriesBatch mocks base method.
+func (m *MockRepositoryWebhookEventDeliveries) SaveWebhookEventDeliveriesBatch(ctx context.Context, deliveries []webhook_event_deliveries.WebhookEventDeliveryIn) (*[]webhook_event_deliveries.WebhookEventDeliveryOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWebhookEventDeliveriesBatch", ctx, deliveries)
+	ret0, _ := ret[0].(*[]webhook_event_deliveries.WebhookEventDeliveryOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveWebhookEventDeliveriesBatch indicates an expected call of SaveWebhookEventDeliveriesBatch.
+func (mr *MockRepositoryWebhookEventDeliveriesMockRecorder) SaveWebhookEventDeliveriesBatch(ctx, deliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWebhookEventDeliveriesBatch", reflect.TypeOf((*MockRepositoryWebhookEventDeliveries)(nil).SaveWebhookEventDeliveriesBatch), ctx, deliveries)
+}
+
+// UpdateWebhookEventDeliveryAttempt mocks base method.
+func (m *MockRepositoryWebhookEventDeliveries) UpdateWebhookEventDeliveryAttempt(ctx context.Context, attempt webhook_event_deliveries.WebhookEventDeliveryAttemptIn) (*webhook_event_deliveries.WebhookEventDeliveryOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookEventDeliveryAttempt", ctx, attempt)
+	ret0, _ := ret[0].(*webhook_event_deliveries.WebhookEventDeliveryOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhookEventDeliveryAttempt indicates an expected call of UpdateWebhookEventDeliveryAttempt.
+func (mr *MockRepositoryWebhookEventDeliveriesMockRecorder) UpdateWebhookEventDeliveryAttempt(ctx, attempt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookEventDeliveryAttempt", reflect.TypeOf((*MockRepositoryWebhookEventDeliveries)(nil).UpdateWebhookEventDeliveryAttempt), ctx, attempt)
+}
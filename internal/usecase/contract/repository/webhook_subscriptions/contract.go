@@ -0,0 +1,16 @@
+package webhook_subscriptions
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/repository/webhook_subscriptions"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=webhook_subscriptions RepositoryWebhookSubscriptions
+type RepositoryWebhookSubscriptions interface {
+	SaveWebhookSubscription(ctx context.Context, subscription webhook_subscriptions.WebhookSubscriptionIn) (*webhook_subscriptions.WebhookSubscriptionOut, error)
+	GetWebhookSubscriptions(ctx context.Context) (*[]webhook_subscriptions.WebhookSubscriptionOut, error)
+	DeleteWebhookSubscription(ctx context.Context, subscriptionID uuid.UUID) error
+}
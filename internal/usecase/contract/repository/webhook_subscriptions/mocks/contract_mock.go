@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package webhook_subscriptions is a generated GoMock package.
+package webhook_subscriptions
+
+import (
+	context "context"
+	webhook_subscriptions "pr-reviewers-service/internal/infrastructure/repository/webhook_subscriptions"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockRepositoryWebhookSubscriptions is a mock of RepositoryWebhookSubscriptions interface.
+type MockRepositoryWebhookSubscriptions struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryWebhookSubscriptionsMockRecorder
+}
+
+// MockRepositoryWebhookSubscriptionsMockRecorder is the mock recorder for MockRepositoryWebhookSubscriptions.
+type MockRepositoryWebhookSubscriptionsMockRecorder struct {
+	mock *MockRepositoryWebhookSubscriptions
+}
+
+// NewMockRepositoryWebhookSubscriptions creates a new mock instance.
+func NewMockRepositoryWebhookSubscriptions(ctrl *gomock.Controller) *MockRepositoryWebhookSubscriptions {
+	mock := &MockRepositoryWebhookSubscriptions{ctrl: ctrl}
+	mock.recorder = &MockRepositoryWebhookSubscriptionsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepositoryWebhookSubscriptions) EXPECT() *MockRepositoryWebhookSubscriptionsMockRecorder {
+	return m.recorder
+}
+
+// DeleteWebhookSubscription mocks base method.
+func (m *MockRepositoryWebhookSubscriptions) DeleteWebhookSubscription(ctx context.Context, subscriptionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookSubscription", ctx, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhookSubscription indicates an expected call of DeleteWebhookSubscription.
+func (mr *MockRepositoryWebhookSubscriptionsMockRecorder) DeleteWebhookSubscription(ctx, subscriptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookSubscription", reflect.TypeOf((*MockRepositoryWebhookSubscriptions)(nil).DeleteWebhookSubscription), ctx, subscriptionID)
+}
+
+// GetWebhookSubscriptions mocks base method.
+func (m *MockRepositoryWebhookSubscriptions) GetWebhookSubscriptions(ctx context.Context) (*[]webhook_subscriptions.WebhookSubscriptionOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscriptions", ctx)
+	ret0, _ := ret[0].(*[]webhook_subscriptions.WebhookSubscriptionOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscriptions indicates an expected call of GetWebhookSubscriptions.
+func (mr *MockRepositoryWebhookSubscriptionsMockRecorder) GetWebhookSubscriptions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscriptions", reflect.TypeOf((*MockRepositoryWebhookSubscriptions)(nil).GetWebhookSubscriptions), ctx)
+}
+
+// SaveWebhookSubscription mocks base method.
+func (m *MockRepositoryWebhookSubscriptions) SaveWebhookSubscription(ctx context.Context, subscription webhook_subscriptions.WebhookSubscriptionIn) (*webhook_subscriptions.WebhookSubscriptionOut, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveWebhookSubscription", ctx, subscription)
+	ret0, _ := ret[0].(*webhook_subscriptions.WebhookSubscriptionOut)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveWebhookSubscription indicates an expected call of SaveWebhookSubscription.
+func (mr *MockRepositoryWebhookSubscriptionsMockRecorder) SaveWebhookSubscription(ctx, subscription interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWebhookSubscription", reflect.TypeOf((*MockRepositoryWebhookSubscriptions)(nil).SaveWebhookSubscription), ctx, subscription)
+}
@@ -0,0 +1,12 @@
+package webhook_sender
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/infrastructure/webhook_sender"
+)
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=webhook_sender Sender
+type Sender interface {
+	Send(ctx context.Context, req webhook_sender.Request) (int, error)
+}
@@ -0,0 +1,7 @@
+package email_digest
+
+type Out struct {
+	Sent    int
+	Skipped int
+	Failed  int
+}
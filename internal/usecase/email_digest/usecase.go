@@ -0,0 +1,279 @@
+package email_digest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	email_sender2 "pr-reviewers-service/internal/infrastructure/email_sender"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	user_notifications2 "pr-reviewers-service/internal/infrastructure/repository/user_notifications"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/email_sender"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
+	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_notifications"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+	"pr-reviewers-service/internal/usecase/email_templates"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+)
+
+var errDigestNotSent = errors.New("digest not sent")
+
+type usecase struct {
+	repPRReviewers   pr_reviewers.RepositoryPrReviewers
+	repPullRequests  pull_requests.RepositoryPullRequests
+	repPRStatuses    pr_statuses.RepositoryPrStatuses
+	repUsers         users.RepositoryUsers
+	repIdentities    user_identities.RepositoryUserIdentities
+	repNotifications user_notifications.RepositoryUserNotifications
+	sender           email_sender.Sender
+	templates        *email_templates.Templates
+	nower            nower.Nower
+	sendAt           time.Duration
+	location         *time.Location
+	trm              trm.Manager
+
+	// completedDay is the last day every digest was sent or found already sent, the worker does not
+	// query the reviews again until the next day. The user_notifications table stays the source of truth
+	// across restarts and replicas.
+	completedDay time.Time
+}
+
+// NewUsecase builds the daily digest. sendAt is the time of day, counted from midnight in location,
+// after which the digest of the day is sent.
+func NewUsecase(
+	repPRReviewers pr_reviewers.RepositoryPrReviewers,
+	repPullRequests pull_requests.RepositoryPullRequests,
+	repPRStatuses pr_statuses.RepositoryPrStatuses,
+	repUsers users.RepositoryUsers,
+	repIdentities user_identities.RepositoryUserIdentities,
+	repNotifications user_notifications.RepositoryUserNotifications,
+	sender email_sender.Sender,
+	templates *email_templates.Templates,
+	nower nower.Nower,
+	sendAt time.Duration,
+	location *time.Location,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repPRReviewers:   repPRReviewers,
+		repPullRequests:  repPullRequests,
+		repPRStatuses:    repPRStatuses,
+		repUsers:         repUsers,
+		repIdentities:    repIdentities,
+		repNotifications: repNotifications,
+		sender:           sender,
+		templates:        templates,
+		nower:            nower,
+		sendAt:           sendAt,
+		location:         location,
+		trm:              trm,
+	}
+}
+
+// Run emails every active reviewer with an email identity the list of their open reviews, the pull requests
+// waiting longest first. Each reviewer gets at most one digest a day: the day is claimed and the email is sent
+// in one transaction, so a failed send releases the claim and is retried on the next run.
+func (u *usecase) Run(ctx context.Context) (*Out, error) {
+	now := u.nower.Now().In(u.location)
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, u.location)
+	if now.Before(day.Add(u.sendAt)) || u.completedDay.Equal(day) {
+		return &Out{}, nil
+	}
+
+	reviews, err := u.openReviews(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]uuid.UUID, 0, len(reviews))
+	reviewerIDs := make([]uuid.UUID, 0, len(reviews))
+	for reviewerID, prs := range reviews {
+		reviewerIDs = append(reviewerIDs, reviewerID)
+		userIDs = append(userIDs, reviewerID)
+		for _, pr := range prs {
+			userIDs = append(userIDs, pr.AuthorID)
+		}
+	}
+	sort.Slice(reviewerIDs, func(i, j int) bool { return reviewerIDs[i].String() < reviewerIDs[j].String() })
+
+	slog.DebugContext(ctx, "Get reviewers and authors", "count", len(userIDs))
+	found, err := u.repUsers.GetUsersByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetUsers, err))
+	}
+	usersByID := make(map[uuid.UUID]users2.UserOut, len(*found))
+	for _, user := range *found {
+		usersByID[user.ID] = user
+	}
+
+	slog.DebugContext(ctx, "Get reviewer identities", "count", len(reviewerIDs))
+	identities, err := u.repIdentities.GetUserIdentitiesByUserIDs(ctx, reviewerIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetIdentities, err))
+	}
+	addresses := make(map[uuid.UUID]string, len(*identities))
+	for _, identity := range *identities {
+		if identity.Provider == usecase2.IdentityProviderEmail {
+			addresses[identity.UserID] = identity.ExternalID
+		}
+	}
+
+	out := &Out{}
+	for _, reviewerID := range reviewerIDs {
+		reviewer, exists := usersByID[reviewerID]
+		address := addresses[reviewerID]
+		if !exists || !reviewer.IsActive || address == "" {
+			continue
+		}
+
+		data := digestData(reviewer, reviews[reviewerID], usersByID, day, now)
+		sent := false
+		err = u.trm.Do(ctx, func(ctx context.Context) error {
+			sent, err = u.send(ctx, reviewer, address, day, data)
+			return err
+		})
+		switch {
+		case errors.Is(err, errDigestNotSent):
+			slog.WarnContext(ctx, "Email digest not sent", "reviewer_id", reviewer.ID, "error", err)
+			out.Failed++
+		case err != nil:
+			return nil, err
+		case sent:
+			out.Sent++
+		default:
+			out.Skipped++
+		}
+	}
+
+	if out.Failed == 0 {
+		u.completedDay = day
+	}
+	slog.DebugContext(ctx, "UseCase EmailDigest success", "sent", out.Sent, "skipped", out.Skipped, "failed", out.Failed)
+	return out, nil
+}
+
+func (u *usecase) send(
+	ctx context.Context,
+	reviewer users2.UserOut,
+	address string,
+	day time.Time,
+	data email_templates.DigestData,
+) (bool, error) {
+	claimed, err := u.repNotifications.ClaimUserNotification(ctx, user_notifications2.UserNotificationIn{
+		Kind:   usecase2.NotificationKindEmailDigest,
+		UserID: reviewer.ID,
+		SentOn: day,
+	})
+	if err != nil {
+		return false, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrClaimUserNotification, reviewer.ID))
+	}
+	if !claimed {
+		slog.DebugContext(ctx, "Email digest already sent today", "reviewer_id", reviewer.ID)
+		return false, nil
+	}
+
+	message, err := u.templates.Digest.Execute(data)
+	if err != nil {
+		return false, fmt.Errorf("%w: template: %v", errDigestNotSent, err)
+	}
+	email := email_sender2.Email{To: address, Subject: message.Subject, Text: message.Text, HTML: message.HTML}
+	if err = u.sender.Send(ctx, email); err != nil {
+		return false, fmt.Errorf("%w: %v", errDigestNotSent, err)
+	}
+	return true, nil
+}
+
+// openReviews returns the open pull requests of every reviewer assigned to at least one.
+func (u *usecase) openReviews(ctx context.Context) (map[uuid.UUID][]pull_requests2.PullRequestOut, error) {
+	slog.DebugContext(ctx, "Get all PR reviewers")
+	assignments, err := u.repPRReviewers.GetAllPRReviewers(ctx)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetPRReviewers, err))
+	}
+
+	reviewersByPR := make(map[uuid.UUID][]uuid.UUID)
+	prIDs := make([]uuid.UUID, 0, len(*assignments))
+	for _, assignment := range *assignments {
+		if _, exists := reviewersByPR[assignment.PRID]; !exists {
+			prIDs = append(prIDs, assignment.PRID)
+		}
+		reviewersByPR[assignment.PRID] = append(reviewersByPR[assignment.PRID], assignment.ReviewerID)
+	}
+
+	prs, err := u.repPullRequests.GetPullRequestsByPrIDs(ctx, prIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPullRequest))
+	}
+	statusIDs := make([]uuid.UUID, 0, len(*prs))
+	for _, pr := range *prs {
+		statusIDs = append(statusIDs, pr.StatusID)
+	}
+	prStatuses, err := u.repPRStatuses.GetPRStatusesByIDs(ctx, statusIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPRStatus))
+	}
+	statusMap := make(map[uuid.UUID]string)
+	if prStatuses != nil {
+		for _, status := range *prStatuses {
+			statusMap[status.ID] = status.Status
+		}
+	}
+
+	reviews := make(map[uuid.UUID][]pull_requests2.PullRequestOut)
+	for _, pr := range *prs {
+		if statusMap[pr.StatusID] != usecase2.OpenStatusValue {
+			continue
+		}
+		for _, reviewerID := range reviewersByPR[pr.ID] {
+			reviews[reviewerID] = append(reviews[reviewerID], pr)
+		}
+	}
+
+	slog.DebugContext(ctx, "Found open reviews", "total_prs", len(*prs), "reviewers", len(reviews))
+	return reviews, nil
+}
+
+// digestData lists the reviews ordered by the time the pull request was opened, the longest waiting first.
+func digestData(
+	reviewer users2.UserOut,
+	prs []pull_requests2.PullRequestOut,
+	usersByID map[uuid.UUID]users2.UserOut,
+	day time.Time,
+	now time.Time,
+) email_templates.DigestData {
+	sorted := append([]pull_requests2.PullRequestOut(nil), prs...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].CreatedAt.Equal(sorted[j].CreatedAt) {
+			return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+		}
+		return sorted[i].ID.String() < sorted[j].ID.String()
+	})
+
+	reviews := make([]email_templates.DigestReview, 0, len(sorted))
+	for _, pr := range sorted {
+		reviews = append(reviews, email_templates.DigestReview{
+			PullRequestID:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorName:      usersByID[pr.AuthorID].Name,
+			OpenedAt:        pr.CreatedAt.In(day.Location()),
+			WaitingDays:     int(now.Sub(pr.CreatedAt).Hours() / 24),
+		})
+	}
+	return email_templates.DigestData{
+		ReviewerName: reviewer.Name,
+		Date:         day.Format(time.DateOnly),
+		Reviews:      reviews,
+	}
+}
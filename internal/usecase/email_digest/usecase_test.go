@@ -0,0 +1,252 @@
+package email_digest
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	email_sender2 "pr-reviewers-service/internal/infrastructure/email_sender"
+	"pr-reviewers-service/internal/infrastructure/email_sender/smtptest"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	user_notifications2 "pr-reviewers-service/internal/infrastructure/repository/user_notifications"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
+	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
+	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+	user_identities "pr-reviewers-service/internal/usecase/contract/repository/user_identities/mocks"
+	user_notifications "pr-reviewers-service/internal/usecase/contract/repository/user_notifications/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+	"pr-reviewers-service/internal/usecase/email_templates"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sendAt = 9 * time.Hour
+
+type digestMocks struct {
+	prReviewers   *pr_reviewers.MockRepositoryPrReviewers
+	pullRequests  *pull_requests.MockRepositoryPullRequests
+	prStatuses    *pr_statuses.MockRepositoryPrStatuses
+	users         *users.MockRepositoryUsers
+	identities    *user_identities.MockRepositoryUserIdentities
+	notifications *user_notifications.MockRepositoryUserNotifications
+}
+
+func TestEmailDigest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 12, 12, 9, 30, 0, 0, time.UTC)
+	day := time.Date(2025, 12, 12, 0, 0, 0, 0, time.UTC)
+
+	alice := users2.UserOut{ID: uuid.New(), Name: "alice", IsActive: true}
+	bob := users2.UserOut{ID: uuid.New(), Name: "bob", IsActive: true}
+	carol := users2.UserOut{ID: uuid.New(), Name: "carol", IsActive: false}
+	dave := users2.UserOut{ID: uuid.New(), Name: "dave", IsActive: true}
+
+	openStatus := pr_statuses2.PRStatusOut{ID: uuid.New(), Status: usecase2.OpenStatusValue}
+	mergedStatus := pr_statuses2.PRStatusOut{ID: uuid.New(), Status: usecase2.MergedStatusValue}
+	oldPR := pull_requests2.PullRequestOut{ID: uuid.New(), Name: "Old fix", AuthorID: dave.ID,
+		StatusID: openStatus.ID, CreatedAt: time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)}
+	newPR := pull_requests2.PullRequestOut{ID: uuid.New(), Name: "New feature", AuthorID: dave.ID,
+		StatusID: openStatus.ID, CreatedAt: time.Date(2025, 12, 10, 10, 0, 0, 0, time.UTC)}
+	mergedPR := pull_requests2.PullRequestOut{ID: uuid.New(), Name: "Merged change", AuthorID: dave.ID,
+		StatusID: mergedStatus.ID, CreatedAt: time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)}
+
+	loadReviews := func(m digestMocks) {
+		m.prReviewers.EXPECT().GetAllPRReviewers(gomock.Any()).Return(&[]pr_reviewers2.PrReviewerOut{
+			{PRID: newPR.ID, ReviewerID: alice.ID},
+			{PRID: oldPR.ID, ReviewerID: alice.ID},
+			{PRID: mergedPR.ID, ReviewerID: alice.ID},
+			{PRID: oldPR.ID, ReviewerID: bob.ID},
+			{PRID: newPR.ID, ReviewerID: carol.ID},
+		}, nil)
+		m.pullRequests.EXPECT().GetPullRequestsByPrIDs(gomock.Any(), gomock.Any()).
+			Return(&[]pull_requests2.PullRequestOut{newPR, oldPR, mergedPR}, nil)
+		m.prStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), gomock.Any()).
+			Return(&[]pr_statuses2.PRStatusOut{openStatus, mergedStatus}, nil)
+		m.users.EXPECT().GetUsersByIDs(gomock.Any(), gomock.Any()).
+			Return(&[]users2.UserOut{alice, bob, carol, dave}, nil)
+		m.identities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), gomock.Any()).
+			Return(&[]user_identities2.UserIdentityOut{
+				{UserID: alice.ID, Provider: usecase2.IdentityProviderEmail, ExternalID: "alice@example.com"},
+				{UserID: bob.ID, Provider: usecase2.IdentityProviderGithub, ExternalID: "bob"},
+				{UserID: carol.ID, Provider: usecase2.IdentityProviderEmail, ExternalID: "carol@example.com"},
+			}, nil)
+	}
+	aliceClaim := user_notifications2.UserNotificationIn{
+		Kind:   usecase2.NotificationKindEmailDigest,
+		UserID: alice.ID,
+		SentOn: day,
+	}
+
+	tests := []struct {
+		name             string
+		now              time.Time
+		rejectRecipients bool
+		setupMock        func(m digestMocks)
+		expected         *Out
+		expectedMessages int
+		expectedError    error
+	}{
+		{
+			name: "digest lists open reviews of active reviewer with email, waiting longest first",
+			now:  now,
+			setupMock: func(m digestMocks) {
+				loadReviews(m)
+				m.notifications.EXPECT().ClaimUserNotification(gomock.Any(), aliceClaim).Return(true, nil)
+			},
+			expected:         &Out{Sent: 1},
+			expectedMessages: 1,
+		},
+		{
+			name:      "digest is not sent before the time of day",
+			now:       day.Add(sendAt - time.Minute),
+			setupMock: func(digestMocks) {},
+			expected:  &Out{},
+		},
+		{
+			name: "digest already sent today is skipped",
+			now:  now,
+			setupMock: func(m digestMocks) {
+				loadReviews(m)
+				m.notifications.EXPECT().ClaimUserNotification(gomock.Any(), aliceClaim).Return(false, nil)
+			},
+			expected: &Out{Skipped: 1},
+		},
+		{
+			name:             "failed send is counted and released for the next run",
+			now:              now,
+			rejectRecipients: true,
+			setupMock: func(m digestMocks) {
+				loadReviews(m)
+				m.notifications.EXPECT().ClaimUserNotification(gomock.Any(), aliceClaim).Return(true, nil)
+			},
+			expected: &Out{Failed: 1},
+		},
+		{
+			name: "claim error",
+			now:  now,
+			setupMock: func(m digestMocks) {
+				loadReviews(m)
+				m.notifications.EXPECT().ClaimUserNotification(gomock.Any(), gomock.Any()).Return(false, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrClaimUserNotification,
+		},
+		{
+			name: "get PR reviewers error",
+			now:  now,
+			setupMock: func(m digestMocks) {
+				m.prReviewers.EXPECT().GetAllPRReviewers(gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetPRReviewers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := smtptest.NewServer()
+			defer server.Close()
+			server.RejectRecipients(tt.rejectRecipients)
+
+			m := digestMocks{
+				prReviewers:   pr_reviewers.NewMockRepositoryPrReviewers(ctrl),
+				pullRequests:  pull_requests.NewMockRepositoryPullRequests(ctrl),
+				prStatuses:    pr_statuses.NewMockRepositoryPrStatuses(ctrl),
+				users:         users.NewMockRepositoryUsers(ctrl),
+				identities:    user_identities.NewMockRepositoryUserIdentities(ctrl),
+				notifications: user_notifications.NewMockRepositoryUserNotifications(ctrl),
+			}
+			tt.setupMock(m)
+			mockNower := nower.NewMockNower(ctrl)
+			mockNower.EXPECT().Now().Return(tt.now)
+			mockTrm := mock.NewMockManager(ctrl)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				}).AnyTimes()
+
+			templates, err := email_templates.Load("")
+			require.NoError(t, err)
+			sender := email_sender2.New(email_sender2.Config{
+				Host:    server.Host,
+				Port:    server.Port,
+				From:    "reviews@example.com",
+				Timeout: time.Second,
+			})
+
+			u := NewUsecase(m.prReviewers, m.pullRequests, m.prStatuses, m.users, m.identities, m.notifications,
+				sender, templates, mockNower, sendAt, time.UTC, mockTrm)
+
+			result, err := u.Run(context.Background())
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+
+			messages := server.Messages()
+			require.Len(t, messages, tt.expectedMessages)
+			if tt.expectedMessages == 0 {
+				return
+			}
+			assert.Equal(t, []string{"alice@example.com"}, messages[0].To)
+			assert.Equal(t, "2 open reviews waiting for you", messages[0].Subject)
+			assert.Contains(t, messages[0].Text, "Your open reviews on 2025-12-12")
+			assert.NotContains(t, messages[0].Text, "Merged change")
+			oldAt := strings.Index(messages[0].Text, `"Old fix" by dave, opened 2025-12-01, waiting 10 day(s)`)
+			newAt := strings.Index(messages[0].Text, `"New feature" by dave, opened 2025-12-10, waiting 1 day(s)`)
+			require.NotEqual(t, -1, oldAt)
+			require.NotEqual(t, -1, newAt)
+			assert.Less(t, oldAt, newAt)
+		})
+	}
+}
+
+func TestEmailDigestRunsOncePerDay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 12, 12, 9, 30, 0, 0, time.UTC)
+	mockPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+	mockPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+	mockPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+	mockUsers := users.NewMockRepositoryUsers(ctrl)
+	mockIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
+	mockNower := nower.NewMockNower(ctrl)
+
+	mockNower.EXPECT().Now().Return(now)
+	mockNower.EXPECT().Now().Return(now.Add(time.Hour))
+	mockNower.EXPECT().Now().Return(now.AddDate(0, 0, 1))
+	mockPRReviewers.EXPECT().GetAllPRReviewers(gomock.Any()).Return(&[]pr_reviewers2.PrReviewerOut{}, nil).Times(2)
+	mockPullRequests.EXPECT().GetPullRequestsByPrIDs(gomock.Any(), gomock.Any()).Return(&[]pull_requests2.PullRequestOut{}, nil).Times(2)
+	mockPRStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), gomock.Any()).Return(&[]pr_statuses2.PRStatusOut{}, nil).Times(2)
+	mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), gomock.Any()).Return(&[]users2.UserOut{}, nil).Times(2)
+	mockIdentities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), gomock.Any()).Return(&[]user_identities2.UserIdentityOut{}, nil).Times(2)
+
+	u := NewUsecase(mockPRReviewers, mockPullRequests, mockPRStatuses, mockUsers, mockIdentities,
+		user_notifications.NewMockRepositoryUserNotifications(ctrl), nil, nil, mockNower, sendAt, time.UTC,
+		mock.NewMockManager(ctrl))
+
+	for range 3 {
+		result, err := u.Run(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, &Out{}, result)
+	}
+}
@@ -0,0 +1,115 @@
+package email_notify
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	email_sender2 "pr-reviewers-service/internal/infrastructure/email_sender"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/email_sender"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+	"pr-reviewers-service/internal/usecase/email_templates"
+
+	"github.com/google/uuid"
+)
+
+type usecase struct {
+	repUsers      users.RepositoryUsers
+	repIdentities user_identities.RepositoryUserIdentities
+	sender        email_sender.Sender
+	templates     *email_templates.Templates
+}
+
+func NewUsecase(
+	repUsers users.RepositoryUsers,
+	repIdentities user_identities.RepositoryUserIdentities,
+	sender email_sender.Sender,
+	templates *email_templates.Templates,
+) *usecase {
+	return &usecase{
+		repUsers:      repUsers,
+		repIdentities: repIdentities,
+		sender:        sender,
+		templates:     templates,
+	}
+}
+
+// Publish emails the reviewer of every assigned and reminder event to the address of the reviewer's email
+// identity, reviewers without one are skipped. The relay calls it once the events are committed as published.
+// Emails are best effort like chat messages: a failed lookup or send is logged and not retried.
+func (u *usecase) Publish(ctx context.Context, events []usecase2.Event) error {
+	for _, event := range events {
+		var tmpl *email_templates.Template
+		switch event.Type {
+		case usecase2.EventReviewerAssigned:
+			tmpl = u.templates.Assigned
+		case usecase2.EventReviewReminder:
+			tmpl = u.templates.Reminder
+		default:
+			continue
+		}
+
+		if err := u.notify(ctx, event, tmpl); err != nil {
+			slog.WarnContext(ctx, "Email not sent", "event_id", event.ID, "error", err)
+		}
+	}
+	return nil
+}
+
+func (u *usecase) notify(ctx context.Context, event usecase2.Event, tmpl *email_templates.Template) error {
+	slog.DebugContext(ctx, "Get reviewer and author", "reviewer_id", event.ReviewerID, "author_id", event.AuthorID)
+	found, err := u.repUsers.GetUsersByIDs(ctx, []uuid.UUID{event.ReviewerID, event.AuthorID})
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetUsers, err))
+	}
+	usersByID := make(map[uuid.UUID]users2.UserOut, len(*found))
+	for _, user := range *found {
+		usersByID[user.ID] = user
+	}
+	reviewer, exists := usersByID[event.ReviewerID]
+	if !exists {
+		slog.DebugContext(ctx, "Reviewer not found, email skipped", "reviewer_id", event.ReviewerID)
+		return nil
+	}
+
+	slog.DebugContext(ctx, "Get reviewer identities", "reviewer_id", reviewer.ID)
+	identities, err := u.repIdentities.GetUserIdentitiesByUserIDs(ctx, []uuid.UUID{reviewer.ID})
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrGetIdentities, reviewer.ID))
+	}
+	address := ""
+	for _, identity := range *identities {
+		if identity.Provider == usecase2.IdentityProviderEmail {
+			address = identity.ExternalID
+			break
+		}
+	}
+	if address == "" {
+		slog.DebugContext(ctx, "Reviewer has no email identity, email skipped", "reviewer_id", reviewer.ID)
+		return nil
+	}
+
+	message, err := tmpl.Execute(email_templates.ReviewData{
+		ReviewerName:    reviewer.Name,
+		AuthorName:      usersByID[event.AuthorID].Name,
+		PullRequestID:   event.PullRequestID,
+		PullRequestName: event.PullRequestName,
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "Email template failed", "event_type", event.Type, "error", err)
+		return nil
+	}
+
+	email := email_sender2.Email{To: address, Subject: message.Subject, Text: message.Text, HTML: message.HTML}
+	if err = u.sender.Send(ctx, email); err != nil {
+		slog.WarnContext(ctx, "Email not sent", "event_id", event.ID, "reviewer_id", reviewer.ID, "error", err)
+		return nil
+	}
+
+	slog.DebugContext(ctx, "UseCase EmailNotify success", "event_id", event.ID, "reviewer_id", reviewer.ID)
+	return nil
+}
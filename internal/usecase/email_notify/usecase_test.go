@@ -0,0 +1,178 @@
+package email_notify
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	email_sender2 "pr-reviewers-service/internal/infrastructure/email_sender"
+	"pr-reviewers-service/internal/infrastructure/email_sender/smtptest"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	user_identities "pr-reviewers-service/internal/usecase/contract/repository/user_identities/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+	"pr-reviewers-service/internal/usecase/email_templates"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEmailNotify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reviewer := users2.UserOut{ID: uuid.New(), Name: "alice", IsActive: true}
+	author := users2.UserOut{ID: uuid.New(), Name: "bob", IsActive: true}
+	prID := uuid.New()
+	emailIdentity := user_identities2.UserIdentityOut{UserID: reviewer.ID, Provider: usecase2.IdentityProviderEmail, ExternalID: "alice@example.com"}
+	githubIdentity := user_identities2.UserIdentityOut{UserID: reviewer.ID, Provider: usecase2.IdentityProviderGithub, ExternalID: "alice"}
+
+	event := func(eventType string) usecase2.Event {
+		return usecase2.Event{
+			ID:              uuid.New(),
+			Type:            eventType,
+			PullRequestID:   prID,
+			PullRequestName: "Add feature",
+			AuthorID:        author.ID,
+			ReviewerID:      reviewer.ID,
+		}
+	}
+
+	tests := []struct {
+		name             string
+		events           []usecase2.Event
+		rejectRecipients bool
+		setupMock        func(mockUsers *users.MockRepositoryUsers, mockIdentities *user_identities.MockRepositoryUserIdentities)
+		expectedTo       []string
+		expectedSubject  string
+		expectedText     string
+	}{
+		{
+			name:   "assigned reviewer is emailed",
+			events: []usecase2.Event{event(usecase2.EventReviewerAssigned)},
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockIdentities *user_identities.MockRepositoryUserIdentities) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{reviewer.ID, author.ID}).
+					Return(&[]users2.UserOut{reviewer, author}, nil)
+				mockIdentities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{reviewer.ID}).
+					Return(&[]user_identities2.UserIdentityOut{githubIdentity, emailIdentity}, nil)
+			},
+			expectedTo:      []string{"alice@example.com"},
+			expectedSubject: "Review requested: Add feature",
+			expectedText:    `bob asked you to review "Add feature".`,
+		},
+		{
+			name:   "reminded reviewer is emailed",
+			events: []usecase2.Event{event(usecase2.EventReviewReminder)},
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockIdentities *user_identities.MockRepositoryUserIdentities) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{reviewer.ID, author.ID}).
+					Return(&[]users2.UserOut{reviewer, author}, nil)
+				mockIdentities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{reviewer.ID}).
+					Return(&[]user_identities2.UserIdentityOut{emailIdentity}, nil)
+			},
+			expectedTo:      []string{"alice@example.com"},
+			expectedSubject: "Still waiting for your review: Add feature",
+			expectedText:    `"Add feature" by bob is still waiting for your review.`,
+		},
+		{
+			name:   "reviewer without email identity is skipped",
+			events: []usecase2.Event{event(usecase2.EventReviewerAssigned)},
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockIdentities *user_identities.MockRepositoryUserIdentities) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), gomock.Any()).Return(&[]users2.UserOut{reviewer, author}, nil)
+				mockIdentities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), gomock.Any()).
+					Return(&[]user_identities2.UserIdentityOut{githubIdentity}, nil)
+			},
+		},
+		{
+			name: "other events are ignored",
+			events: []usecase2.Event{
+				event(usecase2.EventReviewerUnassigned),
+				{Type: usecase2.EventPullRequestMerged, PullRequestID: prID},
+			},
+			setupMock: func(*users.MockRepositoryUsers, *user_identities.MockRepositoryUserIdentities) {},
+		},
+		{
+			name:             "failed send is not retried",
+			events:           []usecase2.Event{event(usecase2.EventReviewerAssigned)},
+			rejectRecipients: true,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockIdentities *user_identities.MockRepositoryUserIdentities) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), gomock.Any()).Return(&[]users2.UserOut{reviewer, author}, nil)
+				mockIdentities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), gomock.Any()).
+					Return(&[]user_identities2.UserIdentityOut{emailIdentity}, nil)
+			},
+		},
+		{
+			name:   "get users error skips the event only",
+			events: []usecase2.Event{event(usecase2.EventReviewerAssigned), event(usecase2.EventReviewerAssigned)},
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockIdentities *user_identities.MockRepositoryUserIdentities) {
+				gomock.InOrder(
+					mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error")),
+					mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), gomock.Any()).Return(&[]users2.UserOut{reviewer, author}, nil),
+				)
+				mockIdentities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), gomock.Any()).
+					Return(&[]user_identities2.UserIdentityOut{emailIdentity}, nil)
+			},
+			expectedTo:      []string{"alice@example.com"},
+			expectedSubject: "Review requested: Add feature",
+			expectedText:    `bob asked you to review "Add feature".`,
+		},
+		{
+			name:   "get identities error skips the event only",
+			events: []usecase2.Event{event(usecase2.EventReviewerAssigned), event(usecase2.EventReviewerAssigned)},
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockIdentities *user_identities.MockRepositoryUserIdentities) {
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{reviewer, author}, nil).
+					Times(2)
+				gomock.InOrder(
+					mockIdentities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), gomock.Any()).
+						Return(nil, errors.New("db error")),
+					mockIdentities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), gomock.Any()).
+						Return(&[]user_identities2.UserIdentityOut{emailIdentity}, nil),
+				)
+			},
+			expectedTo:      []string{"alice@example.com"},
+			expectedSubject: "Review requested: Add feature",
+			expectedText:    `bob asked you to review "Add feature".`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := smtptest.NewServer()
+			defer server.Close()
+			server.RejectRecipients(tt.rejectRecipients)
+
+			mockUsers := users.NewMockRepositoryUsers(ctrl)
+			mockIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
+			tt.setupMock(mockUsers, mockIdentities)
+
+			templates, err := email_templates.Load("")
+			require.NoError(t, err)
+			sender := email_sender2.New(email_sender2.Config{
+				Host:    server.Host,
+				Port:    server.Port,
+				From:    "reviews@example.com",
+				Timeout: time.Second,
+			})
+
+			u := NewUsecase(mockUsers, mockIdentities, sender, templates)
+
+			err = u.Publish(context.Background(), tt.events)
+			require.NoError(t, err)
+
+			messages := server.Messages()
+			if tt.expectedTo == nil {
+				assert.Empty(t, messages)
+				return
+			}
+			require.Len(t, messages, 1)
+			assert.Equal(t, tt.expectedTo, messages[0].To)
+			assert.Equal(t, tt.expectedSubject, messages[0].Subject)
+			assert.Contains(t, messages[0].Text, tt.expectedText)
+			assert.Contains(t, messages[0].HTML, "<b>Add feature</b>")
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Package email_templates renders the emails sent to reviewers. The text part is a text/template that must define
+// a "subject" template, the HTML part is an html/template. Defaults are embedded and can be overridden per file
+// from a directory on disk.
+package email_templates
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	AssignedName = "assigned"
+	ReminderName = "reminder"
+	DigestName   = "digest"
+
+	subjectTemplateName = "subject"
+	textSuffix          = ".txt.tmpl"
+	htmlSuffix          = ".html.tmpl"
+)
+
+//go:embed templates/*.tmpl
+var defaults embed.FS
+
+// Templates hold the assigned and the stale-review reminder emails, executed with ReviewData, and the digest
+// email, executed with DigestData.
+type Templates struct {
+	Assigned *Template
+	Reminder *Template
+	Digest   *Template
+}
+
+type Template struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// Message is a rendered email.
+type Message struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+type ReviewData struct {
+	ReviewerName    string
+	AuthorName      string
+	PullRequestID   uuid.UUID
+	PullRequestName string
+}
+
+type DigestData struct {
+	ReviewerName string
+	Date         string
+	Reviews      []DigestReview
+}
+
+type DigestReview struct {
+	PullRequestID   uuid.UUID
+	PullRequestName string
+	AuthorName      string
+	OpenedAt        time.Time
+	WaitingDays     int
+}
+
+// Load parses the templates. A file found in dir, e.g. digest.html.tmpl, replaces the embedded default,
+// the other files keep their defaults. An empty dir means the defaults only.
+func Load(dir string) (*Templates, error) {
+	assigned, err := load(dir, AssignedName)
+	if err != nil {
+		return nil, err
+	}
+	reminder, err := load(dir, ReminderName)
+	if err != nil {
+		return nil, err
+	}
+	digest, err := load(dir, DigestName)
+	if err != nil {
+		return nil, err
+	}
+	return &Templates{Assigned: assigned, Reminder: reminder, Digest: digest}, nil
+}
+
+func load(dir, name string) (*Template, error) {
+	textSource, err := read(dir, name+textSuffix)
+	if err != nil {
+		return nil, err
+	}
+	text, err := texttemplate.New(name + textSuffix).Option("missingkey=error").Parse(textSource)
+	if err != nil {
+		return nil, err
+	}
+	if text.Lookup(subjectTemplateName) == nil {
+		return nil, fmt.Errorf("template %s%s does not define %q", name, textSuffix, subjectTemplateName)
+	}
+
+	htmlSource, err := read(dir, name+htmlSuffix)
+	if err != nil {
+		return nil, err
+	}
+	html, err := htmltemplate.New(name + htmlSuffix).Option("missingkey=error").Parse(htmlSource)
+	if err != nil {
+		return nil, err
+	}
+	return &Template{text: text, html: html}, nil
+}
+
+func read(dir, file string) (string, error) {
+	if dir != "" {
+		content, err := os.ReadFile(filepath.Join(dir, file))
+		if err == nil {
+			return string(content), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+	content, err := defaults.ReadFile("templates/" + file)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// Execute renders the subject, the text and the HTML body of the email.
+func (t *Template) Execute(data any) (Message, error) {
+	var subject, text, html strings.Builder
+	if err := t.text.ExecuteTemplate(&subject, subjectTemplateName, data); err != nil {
+		return Message{}, err
+	}
+	if err := t.text.Execute(&text, data); err != nil {
+		return Message{}, err
+	}
+	if err := t.html.Execute(&html, data); err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,100 @@
+package email_templates
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadDefaults(t *testing.T) {
+	templates, err := Load("")
+	require.NoError(t, err)
+
+	assigned, err := templates.Assigned.Execute(ReviewData{
+		ReviewerName:    "Bob",
+		AuthorName:      "Alice",
+		PullRequestID:   uuid.New(),
+		PullRequestName: "Add <feature>",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Review requested: Add <feature>", assigned.Subject)
+	assert.Contains(t, assigned.Text, `Alice asked you to review "Add <feature>".`)
+	assert.Contains(t, assigned.HTML, "<b>Add &lt;feature&gt;</b>")
+
+	reminder, err := templates.Reminder.Execute(ReviewData{ReviewerName: "Bob", AuthorName: "Alice", PullRequestName: "Add feature"})
+	require.NoError(t, err)
+	assert.Equal(t, "Still waiting for your review: Add feature", reminder.Subject)
+	assert.Contains(t, reminder.Text, `"Add feature" by Alice is still waiting for your review.`)
+
+	openedAt := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	digest, err := templates.Digest.Execute(DigestData{
+		ReviewerName: "Bob",
+		Date:         "2025-12-12",
+		Reviews: []DigestReview{
+			{PullRequestName: "Old fix", AuthorName: "Alice", OpenedAt: openedAt, WaitingDays: 11},
+			{PullRequestName: "New feature", AuthorName: "Carol", OpenedAt: openedAt.AddDate(0, 0, 10), WaitingDays: 1},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2 open reviews waiting for you", digest.Subject)
+	assert.Contains(t, digest.Text, `- "Old fix" by Alice, opened 2025-12-01, waiting 11 day(s)`)
+	assert.Less(t, strings.Index(digest.Text, "Old fix"), strings.Index(digest.Text, "New feature"))
+	assert.Contains(t, digest.HTML, "<td>New feature</td><td>Carol</td><td>2025-12-11</td><td>1</td>")
+}
+
+func TestLoadOverrides(t *testing.T) {
+	tests := []struct {
+		name        string
+		files       map[string]string
+		wantErr     bool
+		wantSubject string
+		wantText    string
+	}{
+		{
+			name: "override replaces only the given file",
+			files: map[string]string{
+				"assigned.txt.tmpl": `{{define "subject"}}[review] {{.PullRequestName}}{{end}}{{.ReviewerName}}, please review`,
+			},
+			wantSubject: "[review] Add feature",
+			wantText:    "Bob, please review",
+		},
+		{
+			name:    "text template without subject returns error",
+			files:   map[string]string{"assigned.txt.tmpl": `{{.ReviewerName}}, please review`},
+			wantErr: true,
+		},
+		{
+			name:    "malformed html template returns error",
+			files:   map[string]string{"digest.html.tmpl": `{{range .Reviews}}`},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for file, content := range tt.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte(content), 0o600))
+			}
+
+			templates, err := Load(dir)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			message, err := templates.Assigned.Execute(ReviewData{ReviewerName: "Bob", PullRequestName: "Add feature"})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSubject, message.Subject)
+			assert.Equal(t, tt.wantText, message.Text)
+			assert.Contains(t, message.HTML, "<b>Add feature</b>")
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventReviewerAssigned   = "reviewer.assigned"
+	EventReviewerUnassigned = "reviewer.unassigned"
+	EventPullRequestCreated = "pull_request.created"
+	EventPullRequestMerged  = "pull_request.merged"
+	EventPullRequestClosed  = "pull_request.closed"
+	EventReviewReminder     = "review.reminder"
+
+	EventUserActivated   = "user.activated"
+	EventUserDeactivated = "user.deactivated"
+
+	EventTeamCreated    = "team.created"
+	EventTeamUpdated    = "team.updated"
+	EventTeamRenamed    = "team.renamed"
+	EventTeamArchived   = "team.archived"
+	EventTeamUnarchived = "team.unarchived"
+	EventTeamDeleted    = "team.deleted"
+)
+
+// EventTypes lists every event a webhook subscriber can filter on. Closed pull request, user, team and reminder
+// events are only handed to the publishers behind the outbox relay.
+var EventTypes = []string{EventReviewerAssigned, EventReviewerUnassigned, EventPullRequestCreated, EventPullRequestMerged}
+
+// Event is a domain event. Pull request fields are set for pull request, reviewer and reminder events, ReviewerID
+// for reviewer and reminder events only, UserID for user events and TeamName for team and user events. A zero ID or
+// OccurredAt is filled in by the publisher.
+type Event struct {
+	ID              uuid.UUID `json:"id"`
+	Type            string    `json:"type"`
+	PullRequestID   uuid.UUID `json:"pull_request_id,omitzero"`
+	PullRequestName string    `json:"pull_request_name,omitempty"`
+	AuthorID        uuid.UUID `json:"author_id,omitzero"`
+	ReviewerID      uuid.UUID `json:"reviewer_id,omitzero"`
+	UserID          uuid.UUID `json:"user_id,omitzero"`
+	TeamName        string    `json:"team_name,omitempty"`
+	OccurredAt      time.Time `json:"occurred_at"`
+}
+
+func IsKnownEventType(eventType string) bool {
+	for _, known := range EventTypes {
+		if known == eventType {
+			return true
+		}
+	}
+	return false
+}
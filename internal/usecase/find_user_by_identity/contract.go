@@ -0,0 +1,15 @@
+package find_user_by_identity
+
+import "github.com/google/uuid"
+
+type In struct {
+	Provider   string
+	ExternalID string
+}
+
+type Out struct {
+	UserID   uuid.UUID
+	Username string
+	TeamName string
+	IsActive bool
+}
@@ -0,0 +1,68 @@
+package find_user_by_identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+)
+
+type usecase struct {
+	repUsers      users.RepositoryUsers
+	repTeams      teams.RepositoryTeams
+	repIdentities user_identities.RepositoryUserIdentities
+}
+
+func NewUsecase(
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+	repIdentities user_identities.RepositoryUserIdentities,
+) *usecase {
+	return &usecase{
+		repUsers:      repUsers,
+		repTeams:      repTeams,
+		repIdentities: repIdentities,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	identity, err := usecase2.NewIdentity(req.Provider, req.ExternalID)
+	if err != nil {
+		return nil, logging.WrapError(ctx, err)
+	}
+
+	userID, err := usecase2.ResolveIdentity(ctx, u.repIdentities, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Call GetUserByID", "user_id", userID)
+	user, err := u.repUsers.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrUserNotFound, userID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, userID))
+	}
+
+	slog.DebugContext(ctx, "Call GetTeamByID", "team_id", user.TeamID)
+	team, err := u.repTeams.GetTeamByID(ctx, user.TeamID)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetTeam, user.TeamID))
+	}
+
+	slog.DebugContext(ctx, "UseCase FindUserByIdentity success", "user_id", user.ID)
+	return &Out{
+		UserID:   user.ID,
+		Username: user.Name,
+		TeamName: team.Name,
+		IsActive: user.IsActive,
+	}, nil
+}
@@ -0,0 +1,185 @@
+package find_user_by_identity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	user_identities "pr-reviewers-service/internal/usecase/contract/repository/user_identities/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindUserByIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	teamID := uuid.New()
+
+	req := In{
+		Provider:   "GitLab",
+		ExternalID: "Alice",
+	}
+	linked := &user_identities2.UserIdentityOut{
+		UserID:     userID,
+		Provider:   "gitlab",
+		ExternalID: "alice",
+	}
+	user := &users2.UserOut{
+		ID:       userID,
+		Name:     "alice",
+		IsActive: true,
+		TeamID:   teamID,
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockUsers *users.MockRepositoryUsers,
+			mockTeams *teams.MockRepositoryTeams,
+			mockIdentities *user_identities.MockRepositoryUserIdentities,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "user found",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "gitlab", "alice").
+					Return(linked, nil)
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(user, nil)
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(&teams2.TeamOut{ID: teamID, Name: "backend"}, nil)
+			},
+			expected: &Out{
+				UserID:   userID,
+				Username: "alice",
+				TeamName: "backend",
+				IsActive: true,
+			},
+		},
+		{
+			name: "identity is not linked",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "gitlab", "alice").
+					Return(nil, repository.ErrIdentityNotFound)
+			},
+			expectedError: usecase2.ErrIdentityNotFound,
+		},
+		{
+			name: "empty external id",
+			req: In{
+				Provider:   "gitlab",
+				ExternalID: " ",
+			},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+			},
+			expectedError: usecase2.ErrInvalidIdentity,
+		},
+		{
+			name: "error getting identity",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "gitlab", "alice").
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetIdentities,
+		},
+		{
+			name: "error getting user",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "gitlab", "alice").
+					Return(linked, nil)
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetUser,
+		},
+		{
+			name: "error getting team",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "gitlab", "alice").
+					Return(linked, nil)
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(user, nil)
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsers := users.NewMockRepositoryUsers(ctrl)
+			mockTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
+
+			tt.setupMock(mockUsers, mockTeams, mockIdentities)
+
+			uc := NewUsecase(mockUsers, mockTeams, mockIdentities)
+			result, err := uc.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package get_code_owners
+
+import (
+	usecase2 "pr-reviewers-service/internal/usecase"
+)
+
+type In struct {
+	Repository string
+}
+
+type Out struct {
+	CodeOwners usecase2.CodeOwners
+}
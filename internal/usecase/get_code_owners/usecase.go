@@ -0,0 +1,42 @@
+package get_code_owners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/code_owners"
+)
+
+type usecase struct {
+	repCodeOwners code_owners.RepositoryCodeOwners
+}
+
+func NewUsecase(repCodeOwners code_owners.RepositoryCodeOwners) *usecase {
+	return &usecase{
+		repCodeOwners: repCodeOwners,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Call GetCodeOwnersByRepository", "repository", req.Repository)
+	stored, err := u.repCodeOwners.GetCodeOwnersByRepository(ctx, req.Repository)
+	if err != nil {
+		if errors.Is(err, repository.ErrCodeOwnersNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrCodeOwnersNotFound, req.Repository))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetCodeOwners, req.Repository))
+	}
+
+	codeOwners, _, err := usecase2.NewCodeOwners(ctx, *stored)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase GetCodeOwners success", "rules", len(codeOwners.Rules))
+	return &Out{CodeOwners: codeOwners}, nil
+}
@@ -0,0 +1,85 @@
+package get_code_owners
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	code_owners2 "pr-reviewers-service/internal/infrastructure/repository/code_owners"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	code_owners "pr-reviewers-service/internal/usecase/contract/repository/code_owners/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCodeOwners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	updatedAt := time.Now()
+
+	tests := []struct {
+		name          string
+		setupMock     func(mockCodeOwners *code_owners.MockRepositoryCodeOwners)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "success",
+			setupMock: func(mockCodeOwners *code_owners.MockRepositoryCodeOwners) {
+				mockCodeOwners.EXPECT().GetCodeOwnersByRepository(gomock.Any(), "org/service").
+					Return(&code_owners2.CodeOwnersOut{
+						Repository: "org/service",
+						Content:    "* @alice\n\n/docs/ @org/docs # docs team\n",
+						UpdatedAt:  updatedAt,
+					}, nil)
+			},
+			expected: &Out{CodeOwners: usecase2.CodeOwners{
+				Repository: "org/service",
+				Rules: []usecase2.CodeOwnersRule{
+					{Line: 1, Pattern: "*", Owners: []string{"@alice"}},
+					{Line: 3, Pattern: "/docs/", Owners: []string{"@org/docs"}},
+				},
+				UpdatedAt: updatedAt,
+			}},
+		},
+		{
+			name: "not found",
+			setupMock: func(mockCodeOwners *code_owners.MockRepositoryCodeOwners) {
+				mockCodeOwners.EXPECT().GetCodeOwnersByRepository(gomock.Any(), "org/service").
+					Return(nil, repository.ErrCodeOwnersNotFound)
+			},
+			expectedError: usecase2.ErrCodeOwnersNotFound,
+		},
+		{
+			name: "repository error",
+			setupMock: func(mockCodeOwners *code_owners.MockRepositoryCodeOwners) {
+				mockCodeOwners.EXPECT().GetCodeOwnersByRepository(gomock.Any(), "org/service").
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetCodeOwners,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCodeOwners := code_owners.NewMockRepositoryCodeOwners(ctrl)
+			tt.setupMock(mockCodeOwners)
+
+			u := NewUsecase(mockCodeOwners)
+			result, err := u.Run(context.Background(), In{Repository: "org/service"})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -7,8 +7,9 @@ type In struct {
 }
 
 type Out struct {
-	TeamName string
-	Members  []TeamMembers
+	TeamName       string
+	ParentTeamName string
+	Members        []TeamMembers
 }
 
 type TeamMembers struct {
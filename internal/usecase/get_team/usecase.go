@@ -35,6 +35,16 @@ func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, req.TeamName))
 	}
 
+	var parentTeamName string
+	if team.ParentTeamID != nil {
+		slog.DebugContext(ctx, "Call GetTeamByID for parent team", "parent_team_id", *team.ParentTeamID)
+		parentTeam, err := u.repTeams.GetTeamByID(ctx, *team.ParentTeamID)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetTeam, *team.ParentTeamID))
+		}
+		parentTeamName = parentTeam.Name
+	}
+
 	slog.DebugContext(ctx, "Call GetUsersByTeamID")
 	users, err := u.repUsers.GetUsersByTeamID(ctx, team.ID)
 	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
@@ -54,7 +64,8 @@ func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
 
 	slog.DebugContext(ctx, "UseCase GetTeam success")
 	return &Out{
-		TeamName: team.Name,
-		Members:  members,
+		TeamName:       team.Name,
+		ParentTeamName: parentTeamName,
+		Members:        members,
 	}, nil
 }
@@ -50,6 +50,13 @@ func TestGetTeam(t *testing.T) {
 		},
 	}
 
+	parentTeamID := uuid.New()
+	childTeamOut := &teams2.TeamOut{
+		ID:           teamID,
+		Name:         req.TeamName,
+		ParentTeamID: &parentTeamID,
+	}
+
 	tests := []struct {
 		name      string
 		req       In
@@ -91,6 +98,48 @@ func TestGetTeam(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "successful get team with parent team",
+			req:  req,
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), req.TeamName).
+					Return(childTeamOut, nil)
+
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), parentTeamID).
+					Return(&teams2.TeamOut{ID: parentTeamID, Name: "platform"}, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{}, nil)
+			},
+			expected: &Out{
+				TeamName:       req.TeamName,
+				ParentTeamName: "platform",
+				Members:        []TeamMembers{},
+			},
+		},
+		{
+			name: "error getting parent team",
+			req:  req,
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), req.TeamName).
+					Return(childTeamOut, nil)
+
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), parentTeamID).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
 		{
 			name: "team not found",
 			req:  req,
@@ -255,6 +304,7 @@ func TestGetTeam(t *testing.T) {
 			if tt.expected != nil {
 				require.NotNil(t, result)
 				assert.Equal(t, tt.expected.TeamName, result.TeamName)
+				assert.Equal(t, tt.expected.ParentTeamName, result.ParentTeamName)
 				assert.Equal(t, len(tt.expected.Members), len(result.Members))
 
 				for i, expectedMember := range tt.expected.Members {
@@ -0,0 +1,22 @@
+package get_team_list
+
+type In struct {
+	NamePrefix string
+	Limit      int
+	Offset     int
+}
+
+type Out struct {
+	Teams  []TeamSummary
+	Total  int
+	Limit  int
+	Offset int
+}
+
+type TeamSummary struct {
+	TeamName                      string
+	MembersCount                  int
+	ActiveMembersCount            int
+	OpenPullRequestsCount         int
+	AvgOpenReviewsPerActiveMember float64
+}
@@ -0,0 +1,83 @@
+package get_team_list
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+type usecase struct {
+	repTeams teams.RepositoryTeams
+}
+
+func NewUsecase(repTeams teams.RepositoryTeams) *usecase {
+	return &usecase{
+		repTeams: repTeams,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Call CountTeams")
+	total, err := u.repTeams.CountTeams(ctx, req.NamePrefix)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetTeam))
+	}
+
+	slog.DebugContext(ctx, "Call GetTeamsPage")
+	page, err := u.repTeams.GetTeamsPage(ctx, req.NamePrefix, uint64(req.Limit), uint64(req.Offset))
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetTeam))
+	}
+
+	teamIDs := make([]uuid.UUID, 0, len(*page))
+	for _, team := range *page {
+		teamIDs = append(teamIDs, team.ID)
+	}
+
+	slog.DebugContext(ctx, "Call GetTeamsLoad")
+	loads, err := u.repTeams.GetTeamsLoad(ctx, teamIDs, usecase2.OpenStatusValue)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetTeamsLoad))
+	}
+
+	loadByTeamID := make(map[uuid.UUID]teams2.TeamLoadOut, len(*loads))
+	for _, load := range *loads {
+		loadByTeamID[load.TeamID] = load
+	}
+
+	summaries := make([]TeamSummary, 0, len(*page))
+	for _, team := range *page {
+		load := loadByTeamID[team.ID]
+		summary := TeamSummary{
+			TeamName:              team.Name,
+			MembersCount:          load.MembersCount,
+			ActiveMembersCount:    load.ActiveMembersCount,
+			OpenPullRequestsCount: load.OpenPullRequestsCount,
+		}
+		if load.ActiveMembersCount > 0 {
+			summary.AvgOpenReviewsPerActiveMember =
+				float64(load.ActiveMembersOpenReviewsCount) / float64(load.ActiveMembersCount)
+		}
+		summaries = append(summaries, summary)
+	}
+
+	slog.DebugContext(ctx, "UseCase GetTeamList success", "count", len(summaries), "total", total)
+	return &Out{
+		Teams:  summaries,
+		Total:  total,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package get_team_list
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTeamList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	backendID := uuid.New()
+	backofficeID := uuid.New()
+	page := []teams2.TeamOut{
+		{ID: backendID, Name: "backend"},
+		{ID: backofficeID, Name: "backoffice"},
+	}
+
+	tests := []struct {
+		name          string
+		req           In
+		setupMock     func(mockTeams *teams.MockRepositoryTeams)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "successful list with load",
+			req:  In{NamePrefix: "back", Limit: 2, Offset: 0},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams) {
+				mockTeams.EXPECT().CountTeams(gomock.Any(), "back").Return(3, nil)
+				mockTeams.EXPECT().GetTeamsPage(gomock.Any(), "back", uint64(2), uint64(0)).Return(&page, nil)
+				mockTeams.EXPECT().
+					GetTeamsLoad(gomock.Any(), []uuid.UUID{backendID, backofficeID}, usecase2.OpenStatusValue).
+					Return(&[]teams2.TeamLoadOut{
+						{
+							TeamID:                        backendID,
+							MembersCount:                  5,
+							ActiveMembersCount:            4,
+							OpenPullRequestsCount:         3,
+							ActiveMembersOpenReviewsCount: 6,
+						},
+						{
+							TeamID:       backofficeID,
+							MembersCount: 1,
+						},
+					}, nil)
+			},
+			expected: &Out{
+				Teams: []TeamSummary{
+					{
+						TeamName:                      "backend",
+						MembersCount:                  5,
+						ActiveMembersCount:            4,
+						OpenPullRequestsCount:         3,
+						AvgOpenReviewsPerActiveMember: 1.5,
+					},
+					{
+						TeamName:     "backoffice",
+						MembersCount: 1,
+					},
+				},
+				Total:  3,
+				Limit:  2,
+				Offset: 0,
+			},
+		},
+		{
+			name: "successful empty page",
+			req:  In{Limit: 20, Offset: 40},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams) {
+				mockTeams.EXPECT().CountTeams(gomock.Any(), "").Return(2, nil)
+				mockTeams.EXPECT().GetTeamsPage(gomock.Any(), "", uint64(20), uint64(40)).
+					Return(&[]teams2.TeamOut{}, nil)
+				mockTeams.EXPECT().GetTeamsLoad(gomock.Any(), []uuid.UUID{}, usecase2.OpenStatusValue).
+					Return(&[]teams2.TeamLoadOut{}, nil)
+			},
+			expected: &Out{
+				Teams:  []TeamSummary{},
+				Total:  2,
+				Limit:  20,
+				Offset: 40,
+			},
+		},
+		{
+			name: "error counting teams",
+			req:  In{Limit: 20},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams) {
+				mockTeams.EXPECT().CountTeams(gomock.Any(), "").Return(0, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
+		{
+			name: "error getting teams page",
+			req:  In{Limit: 20},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams) {
+				mockTeams.EXPECT().CountTeams(gomock.Any(), "").Return(2, nil)
+				mockTeams.EXPECT().GetTeamsPage(gomock.Any(), "", uint64(20), uint64(0)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
+		{
+			name: "error getting teams load",
+			req:  In{Limit: 20},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams) {
+				mockTeams.EXPECT().CountTeams(gomock.Any(), "").Return(2, nil)
+				mockTeams.EXPECT().GetTeamsPage(gomock.Any(), "", uint64(20), uint64(0)).Return(&page, nil)
+				mockTeams.EXPECT().
+					GetTeamsLoad(gomock.Any(), []uuid.UUID{backendID, backofficeID}, usecase2.OpenStatusValue).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetTeamsLoad,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			tt.setupMock(mockRepoTeams)
+
+			u := NewUsecase(mockRepoTeams)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package get_team_tree
+
+type In struct {
+	TeamName string
+}
+
+type Out struct {
+	Teams []TeamNode
+}
+
+type TeamNode struct {
+	TeamName string
+	Subteams []TeamNode
+}
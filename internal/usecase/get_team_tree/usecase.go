@@ -0,0 +1,86 @@
+package get_team_tree
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+
+	"github.com/google/uuid"
+)
+
+type usecase struct {
+	repTeams teams.RepositoryTeams
+}
+
+func NewUsecase(repTeams teams.RepositoryTeams) *usecase {
+	return &usecase{
+		repTeams: repTeams,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Call GetAllTeams")
+	allTeams, err := u.repTeams.GetAllTeams(ctx)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetTeam))
+	}
+
+	teamsByID := make(map[uuid.UUID]teams2.TeamOut, len(*allTeams))
+	for _, team := range *allTeams {
+		teamsByID[team.ID] = team
+	}
+
+	childrenByParent := make(map[uuid.UUID][]teams2.TeamOut)
+	var roots []teams2.TeamOut
+	for _, team := range *allTeams {
+		if req.TeamName != "" {
+			if team.Name == req.TeamName {
+				roots = append(roots, team)
+			}
+		} else if team.ParentTeamID == nil {
+			roots = append(roots, team)
+		} else if _, parentExists := teamsByID[*team.ParentTeamID]; !parentExists {
+			roots = append(roots, team)
+		}
+
+		if team.ParentTeamID != nil {
+			childrenByParent[*team.ParentTeamID] = append(childrenByParent[*team.ParentTeamID], team)
+		}
+	}
+	if req.TeamName != "" && len(roots) == 0 {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamNotFound, req.TeamName))
+	}
+
+	nodes := make([]TeamNode, 0, len(roots))
+	for _, root := range roots {
+		nodes = append(nodes, buildNode(root, childrenByParent, map[uuid.UUID]struct{}{}))
+	}
+
+	slog.DebugContext(ctx, "UseCase GetTeamTree success", "roots_count", len(nodes))
+	return &Out{
+		Teams: nodes,
+	}, nil
+}
+
+// buildNode relies on GetAllTeams ordering by name, so subteams come out sorted.
+func buildNode(team teams2.TeamOut, childrenByParent map[uuid.UUID][]teams2.TeamOut, visited map[uuid.UUID]struct{}) TeamNode {
+	visited[team.ID] = struct{}{}
+
+	subteams := make([]TeamNode, 0, len(childrenByParent[team.ID]))
+	for _, child := range childrenByParent[team.ID] {
+		if _, seen := visited[child.ID]; seen {
+			continue
+		}
+		subteams = append(subteams, buildNode(child, childrenByParent, visited))
+	}
+
+	return TeamNode{
+		TeamName: team.Name,
+		Subteams: subteams,
+	}
+}
@@ -0,0 +1,134 @@
+package get_team_tree
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTeamTree(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	platformID := uuid.New()
+	platformDBID := uuid.New()
+	platformInfraID := uuid.New()
+	paymentsID := uuid.New()
+
+	allTeams := []teams2.TeamOut{
+		{ID: paymentsID, Name: "payments"},
+		{ID: platformID, Name: "platform"},
+		{ID: platformDBID, Name: "platform-db", ParentTeamID: &platformID},
+		{ID: platformInfraID, Name: "platform-infra", ParentTeamID: &platformID},
+	}
+
+	tests := []struct {
+		name          string
+		req           In
+		setupMock     func(mockTeams *teams.MockRepositoryTeams)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "successful get whole tree",
+			req:  In{},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams) {
+				mockTeams.EXPECT().
+					GetAllTeams(gomock.Any()).
+					Return(&allTeams, nil)
+			},
+			expected: &Out{
+				Teams: []TeamNode{
+					{TeamName: "payments", Subteams: []TeamNode{}},
+					{
+						TeamName: "platform",
+						Subteams: []TeamNode{
+							{TeamName: "platform-db", Subteams: []TeamNode{}},
+							{TeamName: "platform-infra", Subteams: []TeamNode{}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "successful get subtree of team",
+			req:  In{TeamName: "platform-db"},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams) {
+				mockTeams.EXPECT().
+					GetAllTeams(gomock.Any()).
+					Return(&allTeams, nil)
+			},
+			expected: &Out{
+				Teams: []TeamNode{
+					{TeamName: "platform-db", Subteams: []TeamNode{}},
+				},
+			},
+		},
+		{
+			name: "successful get empty tree",
+			req:  In{},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams) {
+				mockTeams.EXPECT().
+					GetAllTeams(gomock.Any()).
+					Return(&[]teams2.TeamOut{}, nil)
+			},
+			expected: &Out{
+				Teams: []TeamNode{},
+			},
+		},
+		{
+			name: "team not found",
+			req:  In{TeamName: "unknown"},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams) {
+				mockTeams.EXPECT().
+					GetAllTeams(gomock.Any()).
+					Return(&allTeams, nil)
+			},
+			expectedError: usecase2.ErrTeamNotFound,
+		},
+		{
+			name: "error getting teams",
+			req:  In{},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams) {
+				mockTeams.EXPECT().
+					GetAllTeams(gomock.Any()).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+
+			tt.setupMock(mockRepoTeams)
+
+			u := NewUsecase(mockRepoTeams)
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.expected != nil {
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expected, result)
+			} else {
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
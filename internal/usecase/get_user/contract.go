@@ -0,0 +1,35 @@
+package get_user
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type In struct {
+	UserID uuid.UUID
+}
+
+type TeamMembership struct {
+	TeamName  string
+	IsPrimary bool
+}
+
+type Workload struct {
+	OpenReviews              int
+	AuthoredOpenPullRequests int
+	CompletedReviews         int
+}
+
+// Out.IsAvailable is false for inactive users and while the unavailability lasts, Out.UnavailableUntil is set
+// only for the latter.
+type Out struct {
+	UserID           uuid.UUID
+	Username         string
+	IsActive         bool
+	IsAvailable      bool
+	UnavailableUntil *time.Time
+	TeamName         string
+	Teams            []TeamMembership
+	Workload         Workload
+}
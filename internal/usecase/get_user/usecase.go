@@ -0,0 +1,122 @@
+package get_user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
+	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+	"pr-reviewers-service/internal/usecase/contract/repository/team_memberships"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/google/uuid"
+)
+
+// CompletedReviewsPeriod is how far back merged PRs are counted as completed reviews.
+const CompletedReviewsPeriod = 30 * 24 * time.Hour
+
+type usecase struct {
+	repUsers        users.RepositoryUsers
+	repTeams        teams.RepositoryTeams
+	repMemberships  team_memberships.RepositoryTeamMemberships
+	repPullRequests pull_requests.RepositoryPullRequests
+	repPRReviewers  pr_reviewers.RepositoryPrReviewers
+	nower           nower.Nower
+}
+
+func NewUsecase(
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+	repMemberships team_memberships.RepositoryTeamMemberships,
+	repPullRequests pull_requests.RepositoryPullRequests,
+	repPRReviewers pr_reviewers.RepositoryPrReviewers,
+	nower nower.Nower,
+) *usecase {
+	return &usecase{
+		repUsers:        repUsers,
+		repTeams:        repTeams,
+		repMemberships:  repMemberships,
+		repPullRequests: repPullRequests,
+		repPRReviewers:  repPRReviewers,
+		nower:           nower,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Call GetUserByID", "user_id", req.UserID)
+	user, err := u.repUsers.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrUserNotFound, req.UserID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, req.UserID))
+	}
+
+	slog.DebugContext(ctx, "Call GetTeamByID", "team_id", user.TeamID)
+	primaryTeam, err := u.repTeams.GetTeamByID(ctx, user.TeamID)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetTeam, user.TeamID))
+	}
+
+	slog.DebugContext(ctx, "Get team memberships", "user_id", user.ID)
+	memberships, err := u.repMemberships.GetTeamMembershipsByUserIDs(ctx, []uuid.UUID{user.ID})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrGetTeamMemberships, user.ID))
+	}
+	userTeams := []TeamMembership{{TeamName: primaryTeam.Name, IsPrimary: true}}
+	for _, membership := range *memberships {
+		if membership.TeamID == user.TeamID {
+			continue
+		}
+		team, err := u.repTeams.GetTeamByID(ctx, membership.TeamID)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetTeam, membership.TeamID))
+		}
+		userTeams = append(userTeams, TeamMembership{TeamName: team.Name, IsPrimary: false})
+	}
+
+	slog.DebugContext(ctx, "Count user workload", "user_id", user.ID)
+	openReviews, err := u.repPRReviewers.CountReviewsByReviewerID(ctx, user.ID, usecase2.OpenStatusValue, nil)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: open reviews of %s", usecase2.ErrGetWorkload, user.ID))
+	}
+	authoredOpenPRs, err := u.repPullRequests.CountPullRequestsByAuthorID(ctx, user.ID, usecase2.OpenStatusValue)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: authored pull requests of %s", usecase2.ErrGetWorkload, user.ID))
+	}
+	now := u.nower.Now()
+	completedSince := now.Add(-CompletedReviewsPeriod)
+	completedReviews, err := u.repPRReviewers.CountReviewsByReviewerID(ctx, user.ID, usecase2.MergedStatusValue, &completedSince)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: completed reviews of %s", usecase2.ErrGetWorkload, user.ID))
+	}
+
+	var unavailableUntil *time.Time
+	if usecase2.IsUnavailable(*user, now) {
+		unavailableUntil = user.UnavailableUntil
+	}
+
+	slog.DebugContext(ctx, "UseCase GetUser success", "user_id", user.ID, "teams", len(userTeams))
+	return &Out{
+		UserID:           user.ID,
+		Username:         user.Name,
+		IsActive:         user.IsActive,
+		IsAvailable:      user.IsActive && unavailableUntil == nil,
+		UnavailableUntil: unavailableUntil,
+		TeamName:         primaryTeam.Name,
+		Teams:            userTeams,
+		Workload: Workload{
+			OpenReviews:              openReviews,
+			AuthoredOpenPullRequests: authoredOpenPRs,
+			CompletedReviews:         completedReviews,
+		},
+	}, nil
+}
@@ -0,0 +1,329 @@
+package get_user
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
+	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+	team_memberships "pr-reviewers-service/internal/usecase/contract/repository/team_memberships/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	primaryTeamID := uuid.New()
+	additionalTeamID := uuid.New()
+	now := time.Date(2025, 12, 10, 12, 0, 0, 0, time.UTC)
+	completedSince := now.Add(-CompletedReviewsPeriod)
+	unavailableUntil := now.Add(72 * time.Hour)
+	unavailabilityEnded := now.Add(-time.Hour)
+
+	user := &users2.UserOut{ID: userID, Name: "Alice", IsActive: true, TeamID: primaryTeamID}
+	primaryTeam := &teams2.TeamOut{ID: primaryTeamID, Name: "backend"}
+	additionalTeam := &teams2.TeamOut{ID: additionalTeamID, Name: "platform"}
+	memberships := []team_memberships2.TeamMembershipOut{
+		{ID: uuid.New(), UserID: userID, TeamID: primaryTeamID, IsPrimary: true},
+		{ID: uuid.New(), UserID: userID, TeamID: additionalTeamID, IsPrimary: false},
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockUsers *users.MockRepositoryUsers,
+			mockTeams *teams.MockRepositoryTeams,
+			mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+			mockPullRequests *pull_requests.MockRepositoryPullRequests,
+			mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+			mockNower *nower.MockNower,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "successful get user with teams and workload",
+			req:  In{UserID: userID},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockNower *nower.MockNower,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), primaryTeamID).Return(primaryTeam, nil)
+				mockMemberships.EXPECT().GetTeamMembershipsByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&memberships, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), additionalTeamID).Return(additionalTeam, nil)
+				mockPRReviewers.EXPECT().
+					CountReviewsByReviewerID(gomock.Any(), userID, usecase2.OpenStatusValue, nil).
+					Return(3, nil)
+				mockPullRequests.EXPECT().
+					CountPullRequestsByAuthorID(gomock.Any(), userID, usecase2.OpenStatusValue).
+					Return(1, nil)
+				mockNower.EXPECT().Now().Return(now)
+				mockPRReviewers.EXPECT().
+					CountReviewsByReviewerID(gomock.Any(), userID, usecase2.MergedStatusValue, &completedSince).
+					Return(5, nil)
+			},
+			expected: &Out{
+				UserID:      userID,
+				Username:    "Alice",
+				IsActive:    true,
+				IsAvailable: true,
+				TeamName:    "backend",
+				Teams: []TeamMembership{
+					{TeamName: "backend", IsPrimary: true},
+					{TeamName: "platform", IsPrimary: false},
+				},
+				Workload: Workload{
+					OpenReviews:              3,
+					AuthoredOpenPullRequests: 1,
+					CompletedReviews:         5,
+				},
+			},
+		},
+		{
+			name: "successful get user without memberships rows",
+			req:  In{UserID: userID},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockNower *nower.MockNower,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), primaryTeamID).Return(primaryTeam, nil)
+				mockMemberships.EXPECT().GetTeamMembershipsByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]team_memberships2.TeamMembershipOut{}, nil)
+				mockPRReviewers.EXPECT().
+					CountReviewsByReviewerID(gomock.Any(), userID, usecase2.OpenStatusValue, nil).
+					Return(0, nil)
+				mockPullRequests.EXPECT().
+					CountPullRequestsByAuthorID(gomock.Any(), userID, usecase2.OpenStatusValue).
+					Return(0, nil)
+				mockNower.EXPECT().Now().Return(now)
+				mockPRReviewers.EXPECT().
+					CountReviewsByReviewerID(gomock.Any(), userID, usecase2.MergedStatusValue, &completedSince).
+					Return(0, nil)
+			},
+			expected: &Out{
+				UserID:      userID,
+				Username:    "Alice",
+				IsActive:    true,
+				IsAvailable: true,
+				TeamName:    "backend",
+				Teams: []TeamMembership{
+					{TeamName: "backend", IsPrimary: true},
+				},
+			},
+		},
+		{
+			name: "unavailable user",
+			req:  In{UserID: userID},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockNower *nower.MockNower,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(&users2.UserOut{
+					ID: userID, Name: "Alice", IsActive: true, TeamID: primaryTeamID, UnavailableUntil: &unavailableUntil,
+				}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), primaryTeamID).Return(primaryTeam, nil)
+				mockMemberships.EXPECT().GetTeamMembershipsByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]team_memberships2.TeamMembershipOut{}, nil)
+				mockPRReviewers.EXPECT().
+					CountReviewsByReviewerID(gomock.Any(), userID, usecase2.OpenStatusValue, nil).
+					Return(0, nil)
+				mockPullRequests.EXPECT().
+					CountPullRequestsByAuthorID(gomock.Any(), userID, usecase2.OpenStatusValue).
+					Return(0, nil)
+				mockNower.EXPECT().Now().Return(now)
+				mockPRReviewers.EXPECT().
+					CountReviewsByReviewerID(gomock.Any(), userID, usecase2.MergedStatusValue, &completedSince).
+					Return(0, nil)
+			},
+			expected: &Out{
+				UserID:           userID,
+				Username:         "Alice",
+				IsActive:         true,
+				UnavailableUntil: &unavailableUntil,
+				TeamName:         "backend",
+				Teams: []TeamMembership{
+					{TeamName: "backend", IsPrimary: true},
+				},
+			},
+		},
+		{
+			name: "inactive user with ended unavailability",
+			req:  In{UserID: userID},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockNower *nower.MockNower,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(&users2.UserOut{
+					ID: userID, Name: "Alice", IsActive: false, TeamID: primaryTeamID, UnavailableUntil: &unavailabilityEnded,
+				}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), primaryTeamID).Return(primaryTeam, nil)
+				mockMemberships.EXPECT().GetTeamMembershipsByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]team_memberships2.TeamMembershipOut{}, nil)
+				mockPRReviewers.EXPECT().
+					CountReviewsByReviewerID(gomock.Any(), userID, usecase2.OpenStatusValue, nil).
+					Return(0, nil)
+				mockPullRequests.EXPECT().
+					CountPullRequestsByAuthorID(gomock.Any(), userID, usecase2.OpenStatusValue).
+					Return(0, nil)
+				mockNower.EXPECT().Now().Return(now)
+				mockPRReviewers.EXPECT().
+					CountReviewsByReviewerID(gomock.Any(), userID, usecase2.MergedStatusValue, &completedSince).
+					Return(0, nil)
+			},
+			expected: &Out{
+				UserID:   userID,
+				Username: "Alice",
+				IsActive: false,
+				TeamName: "backend",
+				Teams: []TeamMembership{
+					{TeamName: "backend", IsPrimary: true},
+				},
+			},
+		},
+		{
+			name: "user not found",
+			req:  In{UserID: userID},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockNower *nower.MockNower,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(nil, repository.ErrUserNotFound)
+			},
+			expectedError: usecase2.ErrUserNotFound,
+		},
+		{
+			name: "error getting user",
+			req:  In{UserID: userID},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockNower *nower.MockNower,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetUser,
+		},
+		{
+			name: "error getting memberships",
+			req:  In{UserID: userID},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockNower *nower.MockNower,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), primaryTeamID).Return(primaryTeam, nil)
+				mockMemberships.EXPECT().GetTeamMembershipsByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetTeamMemberships,
+		},
+		{
+			name: "error counting open reviews",
+			req:  In{UserID: userID},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockNower *nower.MockNower,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), primaryTeamID).Return(primaryTeam, nil)
+				mockMemberships.EXPECT().GetTeamMembershipsByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]team_memberships2.TeamMembershipOut{}, nil)
+				mockPRReviewers.EXPECT().
+					CountReviewsByReviewerID(gomock.Any(), userID, usecase2.OpenStatusValue, nil).
+					Return(0, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetWorkload,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoMemberships := team_memberships.NewMockRepositoryTeamMemberships(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+
+			tt.setupMock(
+				mockRepoUsers,
+				mockRepoTeams,
+				mockRepoMemberships,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockNower,
+			)
+
+			u := NewUsecase(
+				mockRepoUsers,
+				mockRepoTeams,
+				mockRepoMemberships,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockNower,
+			)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package get_user_identities
+
+import (
+	usecase2 "pr-reviewers-service/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+type In struct {
+	UserID uuid.UUID
+}
+
+type Out struct {
+	UserID     uuid.UUID
+	Identities []usecase2.Identity
+}
@@ -0,0 +1,47 @@
+package get_user_identities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+)
+
+type usecase struct {
+	repUsers      users.RepositoryUsers
+	repIdentities user_identities.RepositoryUserIdentities
+}
+
+func NewUsecase(repUsers users.RepositoryUsers, repIdentities user_identities.RepositoryUserIdentities) *usecase {
+	return &usecase{
+		repUsers:      repUsers,
+		repIdentities: repIdentities,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Call GetUserByID", "user_id", req.UserID)
+	if _, err := u.repUsers.GetUserByID(ctx, req.UserID); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrUserNotFound, req.UserID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, req.UserID))
+	}
+
+	identities, err := usecase2.GetUserIdentities(ctx, u.repIdentities, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase GetUserIdentities success", "user_id", req.UserID, "count", len(identities))
+	return &Out{
+		UserID:     req.UserID,
+		Identities: identities,
+	}, nil
+}
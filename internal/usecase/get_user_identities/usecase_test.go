@@ -0,0 +1,146 @@
+package get_user_identities
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	user_identities "pr-reviewers-service/internal/usecase/contract/repository/user_identities/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserIdentities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	user := &users2.UserOut{
+		ID:       userID,
+		Name:     "alice",
+		IsActive: true,
+		TeamID:   uuid.New(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(
+			mockUsers *users.MockRepositoryUsers,
+			mockIdentities *user_identities.MockRepositoryUserIdentities,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "user with identities",
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(user, nil)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]user_identities2.UserIdentityOut{
+						{UserID: userID, Provider: "email", ExternalID: "alice@example.com"},
+						{UserID: userID, Provider: "github", ExternalID: "alice"},
+					}, nil)
+			},
+			expected: &Out{
+				UserID: userID,
+				Identities: []usecase2.Identity{
+					{Provider: "email", ExternalID: "alice@example.com"},
+					{Provider: "github", ExternalID: "alice"},
+				},
+			},
+		},
+		{
+			name: "user without identities",
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(user, nil)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]user_identities2.UserIdentityOut{}, nil)
+			},
+			expected: &Out{
+				UserID:     userID,
+				Identities: []usecase2.Identity{},
+			},
+		},
+		{
+			name: "user not found",
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(nil, repository.ErrUserNotFound)
+			},
+			expectedError: usecase2.ErrUserNotFound,
+		},
+		{
+			name: "error getting user",
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetUser,
+		},
+		{
+			name: "error getting identities",
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(user, nil)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetIdentities,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsers := users.NewMockRepositoryUsers(ctrl)
+			mockIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
+
+			tt.setupMock(mockUsers, mockIdentities)
+
+			uc := NewUsecase(mockUsers, mockIdentities)
+			result, err := uc.Run(context.Background(), In{UserID: userID})
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
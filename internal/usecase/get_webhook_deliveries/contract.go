@@ -0,0 +1,20 @@
+package get_webhook_deliveries
+
+import (
+	usecase2 "pr-reviewers-service/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+type In struct {
+	SubscriptionID *uuid.UUID
+	Status         *string
+	Limit          int
+	Offset         int
+}
+
+type Out struct {
+	Deliveries []usecase2.WebhookDelivery
+	Limit      int
+	Offset     int
+}
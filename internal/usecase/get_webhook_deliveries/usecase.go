@@ -0,0 +1,52 @@
+package get_webhook_deliveries
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	webhook_event_deliveries2 "pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/webhook_event_deliveries"
+)
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+type usecase struct {
+	repDeliveries webhook_event_deliveries.RepositoryWebhookEventDeliveries
+}
+
+func NewUsecase(repDeliveries webhook_event_deliveries.RepositoryWebhookEventDeliveries) *usecase {
+	return &usecase{
+		repDeliveries: repDeliveries,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Call GetWebhookEventDeliveries")
+	deliveries, err := u.repDeliveries.GetWebhookEventDeliveries(ctx, webhook_event_deliveries2.WebhookEventDeliveriesFilter{
+		SubscriptionID: req.SubscriptionID,
+		Status:         req.Status,
+		Limit:          uint64(req.Limit),
+		Offset:         uint64(req.Offset),
+	})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetWebhookDeliveries))
+	}
+
+	result := make([]usecase2.WebhookDelivery, 0, len(*deliveries))
+	for _, delivery := range *deliveries {
+		result = append(result, usecase2.NewWebhookDelivery(delivery))
+	}
+
+	slog.DebugContext(ctx, "UseCase GetWebhookDeliveries success", "count", len(result))
+	return &Out{
+		Deliveries: result,
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+	}, nil
+}
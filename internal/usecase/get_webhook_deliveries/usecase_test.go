@@ -0,0 +1,117 @@
+package get_webhook_deliveries
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	webhook_event_deliveries2 "pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	webhook_event_deliveries "pr-reviewers-service/internal/usecase/contract/repository/webhook_event_deliveries/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWebhookDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	subscriptionID := uuid.New()
+	deliveryID := uuid.New()
+	eventID := uuid.New()
+	now := time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+	failed := usecase2.WebhookDeliveryFailedStatus
+	lastError := "unexpected webhook response status: 500"
+	responseCode := 500
+
+	tests := []struct {
+		name          string
+		req           In
+		setupMock     func(mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "filtered page",
+			req:  In{SubscriptionID: &subscriptionID, Status: &failed, Limit: 10, Offset: 20},
+			setupMock: func(mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries) {
+				mockDeliveries.EXPECT().
+					GetWebhookEventDeliveries(gomock.Any(), webhook_event_deliveries2.WebhookEventDeliveriesFilter{
+						SubscriptionID: &subscriptionID,
+						Status:         &failed,
+						Limit:          10,
+						Offset:         20,
+					}).
+					Return(&[]webhook_event_deliveries2.WebhookEventDeliveryOut{
+						{
+							ID:             deliveryID,
+							SubscriptionID: subscriptionID,
+							EventID:        eventID,
+							Event:          usecase2.EventReviewerAssigned,
+							Payload:        []byte(`{}`),
+							Status:         failed,
+							Attempts:       5,
+							NextAttemptAt:  now,
+							LastError:      &lastError,
+							ResponseCode:   &responseCode,
+							CreatedAt:      now,
+							UpdatedAt:      now,
+						},
+					}, nil)
+			},
+			expected: &Out{
+				Deliveries: []usecase2.WebhookDelivery{
+					{
+						ID:             deliveryID,
+						SubscriptionID: subscriptionID,
+						EventID:        eventID,
+						Event:          usecase2.EventReviewerAssigned,
+						Status:         failed,
+						Attempts:       5,
+						NextAttemptAt:  now,
+						LastError:      &lastError,
+						ResponseCode:   &responseCode,
+						CreatedAt:      now,
+						UpdatedAt:      now,
+					},
+				},
+				Limit:  10,
+				Offset: 20,
+			},
+		},
+		{
+			name: "get deliveries error",
+			req:  In{Limit: DefaultLimit},
+			setupMock: func(mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries) {
+				mockDeliveries.EXPECT().
+					GetWebhookEventDeliveries(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetWebhookDeliveries,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDeliveries := webhook_event_deliveries.NewMockRepositoryWebhookEventDeliveries(ctrl)
+			tt.setupMock(mockDeliveries)
+
+			u := NewUsecase(mockDeliveries)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package get_webhook_subscriptions
+
+import (
+	usecase2 "pr-reviewers-service/internal/usecase"
+)
+
+type Out struct {
+	Subscriptions []usecase2.WebhookSubscription
+}
@@ -0,0 +1,39 @@
+package get_webhook_subscriptions
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/webhook_subscriptions"
+)
+
+type usecase struct {
+	repSubscriptions webhook_subscriptions.RepositoryWebhookSubscriptions
+}
+
+func NewUsecase(repSubscriptions webhook_subscriptions.RepositoryWebhookSubscriptions) *usecase {
+	return &usecase{
+		repSubscriptions: repSubscriptions,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context) (*Out, error) {
+	slog.DebugContext(ctx, "Call GetWebhookSubscriptions")
+	subscriptions, err := u.repSubscriptions.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetWebhookSubscriptions))
+	}
+
+	result := make([]usecase2.WebhookSubscription, 0, len(*subscriptions))
+	for _, subscription := range *subscriptions {
+		result = append(result, usecase2.NewWebhookSubscription(subscription))
+	}
+
+	slog.DebugContext(ctx, "UseCase GetWebhookSubscriptions success", "count", len(result))
+	return &Out{
+		Subscriptions: result,
+	}, nil
+}
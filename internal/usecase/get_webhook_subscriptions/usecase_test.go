@@ -0,0 +1,93 @@
+package get_webhook_subscriptions
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	webhook_subscriptions2 "pr-reviewers-service/internal/infrastructure/repository/webhook_subscriptions"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	webhook_subscriptions "pr-reviewers-service/internal/usecase/contract/repository/webhook_subscriptions/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWebhookSubscriptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	subscriptionID := uuid.New()
+	createdAt := time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		setupMock     func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "secrets are not exposed",
+			setupMock: func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions) {
+				mockSubscriptions.EXPECT().GetWebhookSubscriptions(gomock.Any()).
+					Return(&[]webhook_subscriptions2.WebhookSubscriptionOut{
+						{
+							ID:        subscriptionID,
+							URL:       "https://hooks.example.com",
+							Secret:    "subscriber-secret",
+							Events:    []string{usecase2.EventReviewerAssigned},
+							CreatedAt: createdAt,
+						},
+					}, nil)
+			},
+			expected: &Out{
+				Subscriptions: []usecase2.WebhookSubscription{
+					{
+						ID:        subscriptionID,
+						URL:       "https://hooks.example.com",
+						Events:    []string{usecase2.EventReviewerAssigned},
+						CreatedAt: createdAt,
+					},
+				},
+			},
+		},
+		{
+			name: "no subscriptions",
+			setupMock: func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions) {
+				mockSubscriptions.EXPECT().GetWebhookSubscriptions(gomock.Any()).
+					Return(&[]webhook_subscriptions2.WebhookSubscriptionOut{}, nil)
+			},
+			expected: &Out{Subscriptions: []usecase2.WebhookSubscription{}},
+		},
+		{
+			name: "get subscriptions error",
+			setupMock: func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions) {
+				mockSubscriptions.EXPECT().GetWebhookSubscriptions(gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetWebhookSubscriptions,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSubscriptions := webhook_subscriptions.NewMockRepositoryWebhookSubscriptions(ctrl)
+			tt.setupMock(mockSubscriptions)
+
+			u := NewUsecase(mockSubscriptions)
+
+			result, err := u.Run(context.Background())
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package handover_reviews
+
+import "github.com/google/uuid"
+
+const (
+	// OutcomeHandedOver means the review went to the requested target user.
+	OutcomeHandedOver = "HANDED_OVER"
+	// OutcomeReassigned means the target could not take the review and another team member was selected.
+	OutcomeReassigned = "REASSIGNED"
+	// OutcomeUnassigned means nobody could take the review and the PR lost a reviewer.
+	OutcomeUnassigned = "UNASSIGNED"
+)
+
+type In struct {
+	FromUserID uuid.UUID
+	ToUserID   uuid.UUID
+}
+
+type HandoverPullRequest struct {
+	PullRequestID   uuid.UUID
+	PullRequestName string
+	AuthorID        uuid.UUID
+	NewReviewerID   *uuid.UUID
+	Outcome         string
+}
+
+type Out struct {
+	FromUserID   uuid.UUID
+	ToUserID     uuid.UUID
+	PullRequests []HandoverPullRequest
+}
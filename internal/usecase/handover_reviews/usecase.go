@@ -0,0 +1,275 @@
+package handover_reviews
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/randomizer"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
+	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+)
+
+type usecase struct {
+	repUsers        users.RepositoryUsers
+	repTeams        teams.RepositoryTeams
+	repPullRequests pull_requests.RepositoryPullRequests
+	repPRReviewers  pr_reviewers.RepositoryPrReviewers
+	repPRStatuses   pr_statuses.RepositoryPrStatuses
+	selector        usecase2.ReviewerSelector
+	publisher       events.Publisher
+	trm             trm.Manager
+}
+
+func NewUsecase(
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+	repPullRequests pull_requests.RepositoryPullRequests,
+	repPRReviewers pr_reviewers.RepositoryPrReviewers,
+	repPRStatuses pr_statuses.RepositoryPrStatuses,
+	randomizer randomizer.Randomizer,
+	publisher events.Publisher,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repUsers:        repUsers,
+		repTeams:        repTeams,
+		repPullRequests: repPullRequests,
+		repPRReviewers:  repPRReviewers,
+		repPRStatuses:   repPRStatuses,
+		selector:        usecase2.NewReviewerSelector(repUsers, repTeams, randomizer),
+		publisher:       publisher,
+		trm:             trm,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	if req.FromUserID == req.ToUserID {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrHandoverToSameUser, req.FromUserID))
+	}
+
+	slog.DebugContext(ctx, "Get handover users", "from_user_id", req.FromUserID, "to_user_id", req.ToUserID)
+	if _, err := u.getUser(ctx, req.FromUserID); err != nil {
+		return nil, err
+	}
+	target, err := u.getUser(ctx, req.ToUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !target.IsActive {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrHandoverTargetInactive, target.ID))
+	}
+
+	slog.DebugContext(ctx, "Find open reviews of source user")
+	openPRs, err := u.findOpenReviews(ctx, req.FromUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]HandoverPullRequest, 0, len(openPRs))
+	for _, pr := range openPRs {
+		item, err := u.handoverPR(ctx, pr, req.FromUserID, target.ID)
+		if err != nil {
+			return nil, err
+		}
+		report = append(report, item)
+	}
+
+	domainEvents := reviewerEvents(req.FromUserID, report)
+	slog.DebugContext(ctx, "Publish handover events", "count", len(domainEvents))
+	if err = u.publisher.Publish(ctx, domainEvents); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase HandoverReviews success", "handed_over_prs", len(report))
+	return &Out{
+		FromUserID:   req.FromUserID,
+		ToUserID:     req.ToUserID,
+		PullRequests: report,
+	}, nil
+}
+
+func (u *usecase) getUser(ctx context.Context, userID uuid.UUID) (*users2.UserOut, error) {
+	user, err := u.repUsers.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUserNotFound, userID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, userID))
+	}
+	return user, nil
+}
+
+func (u *usecase) findOpenReviews(ctx context.Context, reviewerID uuid.UUID) ([]pull_requests2.PullRequestOut, error) {
+	assignments, err := u.repPRReviewers.GetPRReviewersByReviewerID(ctx, reviewerID)
+	if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer_id %s", usecase2.ErrGetPRReviewers, reviewerID))
+	}
+	if assignments == nil || len(*assignments) == 0 {
+		slog.DebugContext(ctx, "User is not assigned to any PR")
+		return []pull_requests2.PullRequestOut{}, nil
+	}
+
+	prIDs := make([]uuid.UUID, 0, len(*assignments))
+	for _, assignment := range *assignments {
+		prIDs = append(prIDs, assignment.PRID)
+	}
+	prs, err := u.repPullRequests.GetPullRequestsByPrIDs(ctx, prIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPullRequest))
+	}
+	statusIDs := make([]uuid.UUID, 0, len(*prs))
+	for _, pr := range *prs {
+		statusIDs = append(statusIDs, pr.StatusID)
+	}
+	prStatuses, err := u.repPRStatuses.GetPRStatusesByIDs(ctx, statusIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPRStatus))
+	}
+	statusMap := make(map[uuid.UUID]string)
+	if prStatuses != nil {
+		for _, status := range *prStatuses {
+			statusMap[status.ID] = status.Status
+		}
+	}
+
+	openPRs := make([]pull_requests2.PullRequestOut, 0, len(*prs))
+	for _, pr := range *prs {
+		if statusMap[pr.StatusID] == usecase2.OpenStatusValue {
+			openPRs = append(openPRs, pr)
+		}
+	}
+
+	slog.DebugContext(ctx, "Found open reviews", "total_prs", len(*prs), "open_prs", len(openPRs))
+	return openPRs, nil
+}
+
+func (u *usecase) handoverPR(
+	ctx context.Context,
+	pr pull_requests2.PullRequestOut,
+	fromUserID uuid.UUID,
+	toUserID uuid.UUID,
+) (HandoverPullRequest, error) {
+	item := HandoverPullRequest{
+		PullRequestID:   pr.ID,
+		PullRequestName: pr.Name,
+		AuthorID:        pr.AuthorID,
+	}
+
+	currentReviewers, err := u.repPRReviewers.GetPRReviewersByPRID(ctx, pr.ID)
+	if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
+		return item, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrGetPRReviewers, pr.ID))
+	}
+	var reviewers []pr_reviewers2.PrReviewerOut
+	if currentReviewers != nil {
+		reviewers = *currentReviewers
+	}
+
+	targetAssigned := false
+	for _, reviewer := range reviewers {
+		if reviewer.ReviewerID == toUserID {
+			targetAssigned = true
+			break
+		}
+	}
+
+	var newReviewerID *uuid.UUID
+	if pr.AuthorID != toUserID && !targetAssigned {
+		newReviewerID = &toUserID
+		item.Outcome = OutcomeHandedOver
+	} else {
+		slog.DebugContext(ctx, "Target cannot review PR, falling back to team selection",
+			"pr_id", pr.ID, "target_is_author", pr.AuthorID == toUserID, "target_assigned", targetAssigned)
+		author, err := u.repUsers.GetUserByID(ctx, pr.AuthorID)
+		if err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				return item, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrAuthorPrNotFound, pr.AuthorID))
+			}
+			return item, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, pr.AuthorID))
+		}
+		excluded := []uuid.UUID{author.ID}
+		for _, reviewer := range reviewers {
+			excluded = append(excluded, reviewer.ReviewerID)
+		}
+		selected, err := u.selector.Select(ctx, author.TeamID, 1, excluded...)
+		if err != nil {
+			return item, err
+		}
+		if len(selected) == 0 {
+			slog.WarnContext(ctx, "No available reviewers found for PR", "pr_id", pr.ID, "team_id", author.TeamID)
+			item.Outcome = OutcomeUnassigned
+		} else {
+			newReviewerID = &selected[0].User.ID
+			item.Outcome = OutcomeReassigned
+		}
+	}
+
+	err = u.repPRReviewers.DeletePRReviewerByPRAndReviewer(ctx, pr.ID, fromUserID)
+	if err != nil {
+		return item, logging.WrapError(ctx, fmt.Errorf("%w: reviewer_id %s", usecase2.ErrRemoveReviewer, fromUserID))
+	}
+	if newReviewerID != nil {
+		_, err = u.repPRReviewers.SavePRReviewer(ctx, pr_reviewers2.PrReviewerIn{
+			PrID:       pr.ID,
+			ReviewerID: *newReviewerID,
+		})
+		if err != nil {
+			return item, logging.WrapError(ctx, fmt.Errorf("%w: reviewer %s", usecase2.ErrAssignReviewer, *newReviewerID))
+		}
+	}
+	item.NewReviewerID = newReviewerID
+
+	slog.DebugContext(ctx, "PR review handed over", "pr_id", pr.ID, "outcome", item.Outcome)
+	return item, nil
+}
+
+// reviewerEvents reports every handed over review as the source user being unassigned and, unless nobody could take
+// the review, the new reviewer being assigned.
+func reviewerEvents(fromUserID uuid.UUID, handedOver []HandoverPullRequest) []usecase2.Event {
+	var reviewerEvents []usecase2.Event
+	for _, pr := range handedOver {
+		reviewerEvents = append(reviewerEvents, usecase2.Event{
+			Type:            usecase2.EventReviewerUnassigned,
+			PullRequestID:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorID:        pr.AuthorID,
+			ReviewerID:      fromUserID,
+		})
+		if pr.NewReviewerID != nil {
+			reviewerEvents = append(reviewerEvents, usecase2.Event{
+				Type:            usecase2.EventReviewerAssigned,
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				ReviewerID:      *pr.NewReviewerID,
+			})
+		}
+	}
+	return reviewerEvents
+}
@@ -0,0 +1,646 @@
+package handover_reviews
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	randomizer2 "pr-reviewers-service/internal/usecase/contract/randomizer/mocks"
+	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
+	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
+	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandoverReviews(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	parentTeamID := uuid.New()
+	user1ID := uuid.New()
+	user2ID := uuid.New()
+	user3ID := uuid.New()
+	user4ID := uuid.New()
+	pr1ID := uuid.New()
+	pr2ID := uuid.New()
+	pr3ID := uuid.New()
+	pr4ID := uuid.New()
+	openStatusID := uuid.New()
+	mergedStatusID := uuid.New()
+
+	user1 := users2.UserOut{ID: user1ID, Name: "user1", IsActive: true, TeamID: teamID}
+	user2 := users2.UserOut{ID: user2ID, Name: "user2", IsActive: true, TeamID: teamID}
+	user3 := users2.UserOut{ID: user3ID, Name: "user3", IsActive: true, TeamID: teamID}
+	user4 := users2.UserOut{ID: user4ID, Name: "user4", IsActive: true, TeamID: teamID}
+	inactiveUser2 := users2.UserOut{ID: user2ID, Name: "user2", IsActive: false, TeamID: teamID}
+
+	newPR := func(id uuid.UUID, name string, authorID, statusID uuid.UUID) pull_requests2.PullRequestOut {
+		return pull_requests2.PullRequestOut{
+			ID:        id,
+			Name:      name,
+			AuthorID:  authorID,
+			StatusID:  statusID,
+			CreatedAt: time.Now(),
+		}
+	}
+	newReviewer := func(prID, reviewerID uuid.UUID) pr_reviewers2.PrReviewerOut {
+		return pr_reviewers2.PrReviewerOut{
+			ID:         uuid.New(),
+			PRID:       prID,
+			ReviewerID: reviewerID,
+		}
+	}
+
+	openStatus := pr_statuses2.PRStatusOut{ID: openStatusID, Status: usecase2.OpenStatusValue}
+	mergedStatus := pr_statuses2.PRStatusOut{ID: mergedStatusID, Status: usecase2.MergedStatusValue}
+
+	req := In{FromUserID: user1ID, ToUserID: user2ID}
+
+	expectUsers := func(mockUsers *users.MockRepositoryUsers) {
+		mockUsers.EXPECT().GetUserByID(gomock.Any(), user1ID).Return(&user1, nil)
+		mockUsers.EXPECT().GetUserByID(gomock.Any(), user2ID).Return(&user2, nil)
+	}
+	expectOpenReviews := func(
+		mockPullRequests *pull_requests.MockRepositoryPullRequests,
+		mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+		mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+		prs []pull_requests2.PullRequestOut,
+	) {
+		assignments := make([]pr_reviewers2.PrReviewerOut, 0, len(prs))
+		prIDs := make([]uuid.UUID, 0, len(prs))
+		statusIDs := make([]uuid.UUID, 0, len(prs))
+		for _, pr := range prs {
+			assignments = append(assignments, newReviewer(pr.ID, user1ID))
+			prIDs = append(prIDs, pr.ID)
+			statusIDs = append(statusIDs, pr.StatusID)
+		}
+		mockPRReviewers.EXPECT().
+			GetPRReviewersByReviewerID(gomock.Any(), user1ID).
+			Return(&assignments, nil)
+		mockPullRequests.EXPECT().
+			GetPullRequestsByPrIDs(gomock.Any(), prIDs).
+			Return(&prs, nil)
+		mockPRStatuses.EXPECT().
+			GetPRStatusesByIDs(gomock.Any(), statusIDs).
+			Return(&[]pr_statuses2.PRStatusOut{openStatus, mergedStatus}, nil)
+	}
+	expectTrm := func(mockTrm *mock.MockManager) {
+		mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, f func(context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockUsers *users.MockRepositoryUsers,
+			mockTeams *teams.MockRepositoryTeams,
+			mockPullRequests *pull_requests.MockRepositoryPullRequests,
+			mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+			mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+			mockRandomizer *randomizer2.MockRandomizer,
+			mockTrm *mock.MockManager,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "hands over to target and falls back where target is author or already assigned",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				expectUsers(mockUsers)
+				expectOpenReviews(mockPullRequests, mockPRReviewers, mockPRStatuses, []pull_requests2.PullRequestOut{
+					newPR(pr1ID, "PR 1", user3ID, openStatusID),
+					newPR(pr2ID, "PR 2", user2ID, openStatusID),
+					newPR(pr3ID, "PR 3", user3ID, openStatusID),
+					newPR(pr4ID, "PR 4", user3ID, mergedStatusID),
+				})
+
+				// PR 1: target is free, takes the review.
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), pr1ID).
+					Return(&[]pr_reviewers2.PrReviewerOut{newReviewer(pr1ID, user1ID)}, nil)
+				mockPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), pr1ID, user1ID).Return(nil)
+				mockPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr1ID, ReviewerID: user2ID}).
+					Return(&pr_reviewers2.PrReviewerOut{}, nil)
+
+				// PR 2: target is the author, fall back to user3 or user4.
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), pr2ID).
+					Return(&[]pr_reviewers2.PrReviewerOut{newReviewer(pr2ID, user1ID)}, nil)
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), user2ID).Return(&user2, nil)
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{user1, user2, user3, user4}, nil)
+				mockRandomizer.EXPECT().Shuffle(2, gomock.Any())
+				mockPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), pr2ID, user1ID).Return(nil)
+				mockPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr2ID, ReviewerID: user3ID}).
+					Return(&pr_reviewers2.PrReviewerOut{}, nil)
+
+				// PR 3: target already reviews it, only user4 is left.
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), pr3ID).
+					Return(&[]pr_reviewers2.PrReviewerOut{newReviewer(pr3ID, user1ID), newReviewer(pr3ID, user2ID)}, nil)
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), user3ID).Return(&user3, nil)
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{user1, user2, user3, user4}, nil)
+				mockPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), pr3ID, user1ID).Return(nil)
+				mockPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr3ID, ReviewerID: user4ID}).
+					Return(&pr_reviewers2.PrReviewerOut{}, nil)
+
+				expectTrm(mockTrm)
+			},
+			expected: &Out{
+				FromUserID: user1ID,
+				ToUserID:   user2ID,
+				PullRequests: []HandoverPullRequest{
+					{PullRequestID: pr1ID, PullRequestName: "PR 1", AuthorID: user3ID, NewReviewerID: &user2ID, Outcome: OutcomeHandedOver},
+					{PullRequestID: pr2ID, PullRequestName: "PR 2", AuthorID: user2ID, NewReviewerID: &user3ID, Outcome: OutcomeReassigned},
+					{PullRequestID: pr3ID, PullRequestName: "PR 3", AuthorID: user3ID, NewReviewerID: &user4ID, Outcome: OutcomeReassigned},
+				},
+			},
+		},
+		{
+			name: "removes reviewer without replacement when nobody is available",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				expectUsers(mockUsers)
+				expectOpenReviews(mockPullRequests, mockPRReviewers, mockPRStatuses, []pull_requests2.PullRequestOut{
+					newPR(pr1ID, "PR 1", user2ID, openStatusID),
+				})
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), pr1ID).
+					Return(&[]pr_reviewers2.PrReviewerOut{newReviewer(pr1ID, user1ID)}, nil)
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), user2ID).Return(&user2, nil)
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{user1, user2}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), teamID).Return(&teams2.TeamOut{ID: teamID}, nil)
+				mockPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), pr1ID, user1ID).Return(nil)
+
+				expectTrm(mockTrm)
+			},
+			expected: &Out{
+				FromUserID: user1ID,
+				ToUserID:   user2ID,
+				PullRequests: []HandoverPullRequest{
+					{PullRequestID: pr1ID, PullRequestName: "PR 1", AuthorID: user2ID, Outcome: OutcomeUnassigned},
+				},
+			},
+		},
+		{
+			name: "falls back to the parent team when the author's team has nobody available",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				expectUsers(mockUsers)
+				expectOpenReviews(mockPullRequests, mockPRReviewers, mockPRStatuses, []pull_requests2.PullRequestOut{
+					newPR(pr1ID, "PR 1", user2ID, openStatusID),
+				})
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), pr1ID).
+					Return(&[]pr_reviewers2.PrReviewerOut{newReviewer(pr1ID, user1ID)}, nil)
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), user2ID).Return(&user2, nil)
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{user1, user2}, nil)
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(&teams2.TeamOut{ID: teamID, ParentTeamID: &parentTeamID}, nil)
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), parentTeamID).
+					Return(&[]users2.UserOut{user4}, nil)
+				mockPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), pr1ID, user1ID).Return(nil)
+				mockPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr1ID, ReviewerID: user4ID}).
+					Return(&pr_reviewers2.PrReviewerOut{}, nil)
+
+				expectTrm(mockTrm)
+			},
+			expected: &Out{
+				FromUserID: user1ID,
+				ToUserID:   user2ID,
+				PullRequests: []HandoverPullRequest{
+					{
+						PullRequestID:   pr1ID,
+						PullRequestName: "PR 1",
+						AuthorID:        user2ID,
+						NewReviewerID:   &user4ID,
+						Outcome:         OutcomeReassigned,
+					},
+				},
+			},
+		},
+		{
+			name: "user without reviews",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				expectUsers(mockUsers)
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByReviewerID(gomock.Any(), user1ID).
+					Return(&[]pr_reviewers2.PrReviewerOut{}, nil)
+
+				expectTrm(mockTrm)
+			},
+			expected: &Out{
+				FromUserID:   user1ID,
+				ToUserID:     user2ID,
+				PullRequests: []HandoverPullRequest{},
+			},
+		},
+		{
+			name: "same source and target",
+			req:  In{FromUserID: user1ID, ToUserID: user1ID},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				expectTrm(mockTrm)
+			},
+			expectedError: usecase2.ErrHandoverToSameUser,
+		},
+		{
+			name: "source user not found",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), user1ID).Return(nil, repository.ErrUserNotFound)
+				expectTrm(mockTrm)
+			},
+			expectedError: usecase2.ErrUserNotFound,
+		},
+		{
+			name: "target user not found",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), user1ID).Return(&user1, nil)
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), user2ID).Return(nil, repository.ErrUserNotFound)
+				expectTrm(mockTrm)
+			},
+			expectedError: usecase2.ErrUserNotFound,
+		},
+		{
+			name: "get user error",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), user1ID).Return(nil, errors.New("db error"))
+				expectTrm(mockTrm)
+			},
+			expectedError: usecase2.ErrGetUser,
+		},
+		{
+			name: "target user inactive",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), user1ID).Return(&user1, nil)
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), user2ID).Return(&inactiveUser2, nil)
+				expectTrm(mockTrm)
+			},
+			expectedError: usecase2.ErrHandoverTargetInactive,
+		},
+		{
+			name: "get reviews error",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				expectUsers(mockUsers)
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByReviewerID(gomock.Any(), user1ID).
+					Return(nil, errors.New("db error"))
+				expectTrm(mockTrm)
+			},
+			expectedError: usecase2.ErrGetPRReviewers,
+		},
+		{
+			name: "remove reviewer error",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				expectUsers(mockUsers)
+				expectOpenReviews(mockPullRequests, mockPRReviewers, mockPRStatuses, []pull_requests2.PullRequestOut{
+					newPR(pr1ID, "PR 1", user3ID, openStatusID),
+				})
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), pr1ID).
+					Return(&[]pr_reviewers2.PrReviewerOut{newReviewer(pr1ID, user1ID)}, nil)
+				mockPRReviewers.EXPECT().
+					DeletePRReviewerByPRAndReviewer(gomock.Any(), pr1ID, user1ID).
+					Return(errors.New("db error"))
+				expectTrm(mockTrm)
+			},
+			expectedError: usecase2.ErrRemoveReviewer,
+		},
+		{
+			name: "assign reviewer error",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				expectUsers(mockUsers)
+				expectOpenReviews(mockPullRequests, mockPRReviewers, mockPRStatuses, []pull_requests2.PullRequestOut{
+					newPR(pr1ID, "PR 1", user3ID, openStatusID),
+				})
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), pr1ID).
+					Return(&[]pr_reviewers2.PrReviewerOut{newReviewer(pr1ID, user1ID)}, nil)
+				mockPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), pr1ID, user1ID).Return(nil)
+				mockPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr1ID, ReviewerID: user2ID}).
+					Return(nil, errors.New("db error"))
+				expectTrm(mockTrm)
+			},
+			expectedError: usecase2.ErrAssignReviewer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockRandomizer := randomizer2.NewMockRandomizer(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockTrm := mock.NewMockManager(ctrl)
+
+			tt.setupMock(
+				mockRepoUsers,
+				mockRepoTeams,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockRandomizer,
+				mockTrm,
+			)
+
+			u := NewUsecase(
+				mockRepoUsers,
+				mockRepoTeams,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockRandomizer,
+				mockPublisher,
+				mockTrm,
+			)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestHandoverReviewsPublishesEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	fromID := uuid.New()
+	toID := uuid.New()
+	authorID := uuid.New()
+	prID := uuid.New()
+	openStatusID := uuid.New()
+	publishErr := errors.New("publish error")
+
+	from := users2.UserOut{ID: fromID, Name: "from", IsActive: true, TeamID: teamID}
+	to := users2.UserOut{ID: toID, Name: "to", IsActive: true, TeamID: teamID}
+
+	tests := []struct {
+		name          string
+		publishErr    error
+		expectedError error
+	}{
+		{name: "unassigned and assigned events are published"},
+		{name: "publish error rolls back handover", publishErr: publishErr, expectedError: publishErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoUsers.EXPECT().GetUserByID(gomock.Any(), fromID).Return(&from, nil)
+			mockRepoUsers.EXPECT().GetUserByID(gomock.Any(), toID).Return(&to, nil)
+			mockRepoPRReviewers.EXPECT().GetPRReviewersByReviewerID(gomock.Any(), fromID).
+				Return(&[]pr_reviewers2.PrReviewerOut{{PRID: prID, ReviewerID: fromID}}, nil)
+			mockRepoPullRequests.EXPECT().GetPullRequestsByPrIDs(gomock.Any(), []uuid.UUID{prID}).
+				Return(&[]pull_requests2.PullRequestOut{{ID: prID, Name: "PR 1", AuthorID: authorID, StatusID: openStatusID}}, nil)
+			mockRepoPRStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), []uuid.UUID{openStatusID}).
+				Return(&[]pr_statuses2.PRStatusOut{{ID: openStatusID, Status: usecase2.OpenStatusValue}}, nil)
+			mockRepoPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).
+				Return(&[]pr_reviewers2.PrReviewerOut{{PRID: prID, ReviewerID: fromID}}, nil)
+			mockRepoPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), prID, fromID).Return(nil)
+			mockRepoPRReviewers.EXPECT().
+				SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: prID, ReviewerID: toID}).
+				Return(&pr_reviewers2.PrReviewerOut{PRID: prID, ReviewerID: toID}, nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{
+					{
+						Type:            usecase2.EventReviewerUnassigned,
+						PullRequestID:   prID,
+						PullRequestName: "PR 1",
+						AuthorID:        authorID,
+						ReviewerID:      fromID,
+					},
+					{
+						Type:            usecase2.EventReviewerAssigned,
+						PullRequestID:   prID,
+						PullRequestName: "PR 1",
+						AuthorID:        authorID,
+						ReviewerID:      toID,
+					},
+				}).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockRepoUsers, teams.NewMockRepositoryTeams(ctrl), mockRepoPullRequests,
+				mockRepoPRReviewers, mockRepoPRStatuses, randomizer2.NewMockRandomizer(ctrl), mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), In{FromUserID: fromID, ToUserID: toID})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, result.PullRequests, 1)
+		})
+	}
+}
+
+func TestReviewerEvents(t *testing.T) {
+	fromID := uuid.New()
+	authorID := uuid.New()
+	newReviewerID := uuid.New()
+	reassignedPRID := uuid.New()
+	unassignedPRID := uuid.New()
+
+	result := reviewerEvents(fromID, []HandoverPullRequest{
+		{
+			PullRequestID:   reassignedPRID,
+			PullRequestName: "reassigned",
+			AuthorID:        authorID,
+			NewReviewerID:   &newReviewerID,
+			Outcome:         OutcomeReassigned,
+		},
+		{
+			PullRequestID:   unassignedPRID,
+			PullRequestName: "unassigned",
+			AuthorID:        authorID,
+			Outcome:         OutcomeUnassigned,
+		},
+	})
+
+	assert.Equal(t, []usecase2.Event{
+		{
+			Type:            usecase2.EventReviewerUnassigned,
+			PullRequestID:   reassignedPRID,
+			PullRequestName: "reassigned",
+			AuthorID:        authorID,
+			ReviewerID:      fromID,
+		},
+		{
+			Type:            usecase2.EventReviewerAssigned,
+			PullRequestID:   reassignedPRID,
+			PullRequestName: "reassigned",
+			AuthorID:        authorID,
+			ReviewerID:      newReviewerID,
+		},
+		{
+			Type:            usecase2.EventReviewerUnassigned,
+			PullRequestID:   unassignedPRID,
+			PullRequestName: "unassigned",
+			AuthorID:        authorID,
+			ReviewerID:      fromID,
+		},
+	}, result)
+}
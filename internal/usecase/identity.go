@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/logging"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
+
+	"github.com/google/uuid"
+)
+
+const (
+	IdentityProviderGithub = "github"
+	IdentityProviderGitlab = "gitlab"
+	IdentityProviderEmail  = "email"
+)
+
+var identityProviders = map[string]struct{}{
+	IdentityProviderGithub: {},
+	IdentityProviderGitlab: {},
+	IdentityProviderEmail:  {},
+}
+
+// Identity is a user account in an external system. Logins and emails are case insensitive
+// in all supported providers, so both parts are stored lower-cased.
+type Identity struct {
+	Provider   string
+	ExternalID string
+}
+
+// NewIdentity validates the provider and normalizes the identity.
+func NewIdentity(provider, externalID string) (Identity, error) {
+	identity := Identity{
+		Provider:   strings.ToLower(strings.TrimSpace(provider)),
+		ExternalID: strings.ToLower(strings.TrimSpace(externalID)),
+	}
+	if _, known := identityProviders[identity.Provider]; !known {
+		return Identity{}, fmt.Errorf("%w: unknown provider %q", ErrInvalidIdentity, provider)
+	}
+	if identity.ExternalID == "" {
+		return Identity{}, fmt.Errorf("%w: empty external id", ErrInvalidIdentity)
+	}
+	return identity, nil
+}
+
+func (i Identity) String() string {
+	return i.Provider + ":" + i.ExternalID
+}
+
+// ParseUserRef accepts either a user UUID or an identity written as provider:external_id.
+// Exactly one of the returned values is set.
+func ParseUserRef(raw string) (uuid.UUID, *Identity, error) {
+	raw = strings.TrimSpace(raw)
+	if id, err := uuid.Parse(raw); err == nil {
+		return id, nil, nil
+	}
+
+	provider, externalID, found := strings.Cut(raw, ":")
+	if !found {
+		return uuid.Nil, nil, fmt.Errorf("%w: %q is neither uuid nor provider:external_id", ErrInvalidIdentity, raw)
+	}
+	identity, err := NewIdentity(provider, externalID)
+	if err != nil {
+		return uuid.Nil, nil, err
+	}
+	return uuid.Nil, &identity, nil
+}
+
+// ResolveIdentity returns the id of the user the identity is linked to.
+func ResolveIdentity(ctx context.Context, repIdentities user_identities.RepositoryUserIdentities, identity Identity) (uuid.UUID, error) {
+	slog.DebugContext(ctx, "Call GetUserIdentity", "identity", identity.String())
+	linked, err := repIdentities.GetUserIdentity(ctx, identity.Provider, identity.ExternalID)
+	if err != nil {
+		if errors.Is(err, repository.ErrIdentityNotFound) {
+			return uuid.Nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", ErrIdentityNotFound, identity))
+		}
+		return uuid.Nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", ErrGetIdentities, identity))
+	}
+	return linked.UserID, nil
+}
+
+// GetUserIdentities returns all identities linked to the user ordered by provider and external id.
+func GetUserIdentities(ctx context.Context, repIdentities user_identities.RepositoryUserIdentities, userID uuid.UUID) ([]Identity, error) {
+	slog.DebugContext(ctx, "Call GetUserIdentitiesByUserIDs", "user_id", userID)
+	linked, err := repIdentities.GetUserIdentitiesByUserIDs(ctx, []uuid.UUID{userID})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", ErrGetIdentities, userID))
+	}
+
+	identities := make([]Identity, 0, len(*linked))
+	for _, identity := range *linked {
+		identities = append(identities, Identity{Provider: identity.Provider, ExternalID: identity.ExternalID})
+	}
+	return identities, nil
+}
@@ -0,0 +1,66 @@
+package outbox_publish
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	outbox2 "pr-reviewers-service/internal/infrastructure/repository/outbox"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/outbox"
+
+	"github.com/google/uuid"
+)
+
+type usecase struct {
+	repOutbox outbox.RepositoryOutbox
+	nower     nower.Nower
+}
+
+func NewUsecase(repOutbox outbox.RepositoryOutbox, nower nower.Nower) *usecase {
+	return &usecase{
+		repOutbox: repOutbox,
+		nower:     nower,
+	}
+}
+
+// Publish writes the events to the outbox in the caller's transaction, so they are relayed if and only if
+// the change that produced them is committed. ID and OccurredAt are fixed here and survive relay retries.
+func (u *usecase) Publish(ctx context.Context, events []usecase2.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := u.nower.Now()
+	rows := make([]outbox2.OutboxEventIn, 0, len(events))
+	for _, event := range events {
+		if event.ID == uuid.Nil {
+			event.ID = uuid.New()
+		}
+		if event.OccurredAt.IsZero() {
+			event.OccurredAt = now
+		}
+
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrSaveOutboxEvents, err))
+		}
+		rows = append(rows, outbox2.OutboxEventIn{
+			ID:            event.ID,
+			EventType:     event.Type,
+			Payload:       payload,
+			Status:        usecase2.OutboxPendingStatus,
+			NextAttemptAt: now,
+		})
+	}
+
+	slog.DebugContext(ctx, "Save outbox events", "count", len(rows))
+	if _, err := u.repOutbox.SaveOutboxEventsBatch(ctx, rows); err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrSaveOutboxEvents, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package outbox_publish
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	outbox2 "pr-reviewers-service/internal/infrastructure/repository/outbox"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	outbox "pr-reviewers-service/internal/usecase/contract/repository/outbox/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxPublish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 12, 11, 9, 0, 0, 0, time.UTC)
+	eventID := uuid.New()
+	prID := uuid.New()
+	authorID := uuid.New()
+	reviewerID := uuid.New()
+	userID := uuid.New()
+
+	assigned := usecase2.Event{
+		ID:              eventID,
+		Type:            usecase2.EventReviewerAssigned,
+		PullRequestID:   prID,
+		PullRequestName: "Add feature",
+		AuthorID:        authorID,
+		ReviewerID:      reviewerID,
+	}
+	deactivated := usecase2.Event{
+		Type:       usecase2.EventUserDeactivated,
+		UserID:     userID,
+		TeamName:   "backend",
+		OccurredAt: now.Add(-time.Minute),
+	}
+
+	tests := []struct {
+		name          string
+		events        []usecase2.Event
+		setupMock     func(mockOutbox *outbox.MockRepositoryOutbox, mockNower *nower.MockNower)
+		expectedError error
+	}{
+		{
+			name:   "events are written to the outbox",
+			events: []usecase2.Event{assigned, deactivated},
+			setupMock: func(mockOutbox *outbox.MockRepositoryOutbox, mockNower *nower.MockNower) {
+				mockNower.EXPECT().Now().Return(now)
+				mockOutbox.EXPECT().
+					SaveOutboxEventsBatch(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, rows []outbox2.OutboxEventIn) (*[]outbox2.OutboxEventOut, error) {
+						require.Len(t, rows, 2)
+						assert.Equal(t, eventID, rows[0].ID)
+						assert.Equal(t, usecase2.EventReviewerAssigned, rows[0].EventType)
+						assert.NotEqual(t, uuid.Nil, rows[1].ID)
+						assert.Equal(t, usecase2.EventUserDeactivated, rows[1].EventType)
+						for _, row := range rows {
+							assert.Equal(t, usecase2.OutboxPendingStatus, row.Status)
+							assert.Equal(t, now, row.NextAttemptAt)
+						}
+
+						var event usecase2.Event
+						require.NoError(t, json.Unmarshal(rows[0].Payload, &event))
+						expected := assigned
+						expected.OccurredAt = now
+						assert.Equal(t, expected, event)
+
+						var userEvent usecase2.Event
+						require.NoError(t, json.Unmarshal(rows[1].Payload, &userEvent))
+						assert.Equal(t, rows[1].ID, userEvent.ID)
+						assert.Equal(t, userID, userEvent.UserID)
+						assert.Equal(t, "backend", userEvent.TeamName)
+						assert.Equal(t, now.Add(-time.Minute), userEvent.OccurredAt)
+						return &[]outbox2.OutboxEventOut{}, nil
+					})
+			},
+		},
+		{
+			name:      "no events write nothing",
+			events:    nil,
+			setupMock: func(*outbox.MockRepositoryOutbox, *nower.MockNower) {},
+		},
+		{
+			name:   "outbox write fails",
+			events: []usecase2.Event{assigned},
+			setupMock: func(mockOutbox *outbox.MockRepositoryOutbox, mockNower *nower.MockNower) {
+				mockNower.EXPECT().Now().Return(now)
+				mockOutbox.EXPECT().
+					SaveOutboxEventsBatch(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrSaveOutboxEvents,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOutbox := outbox.NewMockRepositoryOutbox(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+			tt.setupMock(mockOutbox, mockNower)
+
+			u := NewUsecase(mockOutbox, mockNower)
+			err := u.Publish(context.Background(), tt.events)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package outbox_relay
+
+type Out struct {
+	Published int
+	Retried   int
+	Failed    int
+}
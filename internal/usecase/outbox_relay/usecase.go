@@ -0,0 +1,162 @@
+package outbox_relay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	outbox2 "pr-reviewers-service/internal/infrastructure/repository/outbox"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/outbox"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/settings"
+)
+
+// eventSettings runs the publishers of one event in a savepoint of the relay transaction.
+var eventSettings = settings.Must(settings.WithPropagation(trm.PropagationNested))
+
+type usecase struct {
+	repOutbox   outbox.RepositoryOutbox
+	publishers  []events.Publisher
+	notifiers   []events.Publisher
+	nower       nower.Nower
+	batchSize   uint64
+	maxAttempts int
+	baseBackoff time.Duration
+	maxBackoff  time.Duration
+	trm         trm.Manager
+}
+
+func NewUsecase(
+	repOutbox outbox.RepositoryOutbox,
+	publishers []events.Publisher,
+	notifiers []events.Publisher,
+	nower nower.Nower,
+	batchSize uint64,
+	maxAttempts int,
+	baseBackoff time.Duration,
+	maxBackoff time.Duration,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repOutbox:   repOutbox,
+		publishers:  publishers,
+		notifiers:   notifiers,
+		nower:       nower,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		baseBackoff: baseBackoff,
+		maxBackoff:  maxBackoff,
+		trm:         trm,
+	}
+}
+
+// Run hands one batch of due outbox events to every publisher. Publishers run in the relay transaction, so
+// a publisher that writes through the same database commits together with the event being marked published.
+// The publishers of one event share a nested transaction: when any of them fails, the writes of the others are
+// rolled back to the savepoint and the event is retried as a whole without leaving duplicates behind.
+// Notifiers reach systems outside the database, they get the published events once the relay transaction has
+// committed and released the claimed rows. Notifications are best effort, a failed notifier is logged and the
+// event is not relayed again.
+func (u *usecase) Run(ctx context.Context) (*Out, error) {
+	var result *Out
+	var published []usecase2.Event
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, published, err = u.run(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(published) > 0 {
+		for _, notifier := range u.notifiers {
+			if err = notifier.Publish(ctx, published); err != nil {
+				slog.WarnContext(ctx, "Outbox events not notified", "events", len(published), "error", err)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (u *usecase) run(ctx context.Context) (*Out, []usecase2.Event, error) {
+	rows, err := u.repOutbox.ClaimDueOutboxEvents(ctx, usecase2.OutboxPendingStatus, u.batchSize)
+	if err != nil {
+		return nil, nil, logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetOutboxEvents, err))
+	}
+
+	out := &Out{}
+	var published []usecase2.Event
+	for _, row := range *rows {
+		attempt := outbox2.OutboxEventAttemptIn{
+			ID:            row.ID,
+			Status:        usecase2.OutboxPublishedStatus,
+			Attempts:      row.Attempts + 1,
+			NextAttemptAt: row.NextAttemptAt,
+		}
+
+		event, publishErr := u.publish(ctx, row)
+		switch {
+		case publishErr == nil:
+			publishedAt := u.nower.Now()
+			attempt.PublishedAt = &publishedAt
+			published = append(published, event)
+			out.Published++
+		case attempt.Attempts >= u.maxAttempts:
+			slog.WarnContext(ctx, "Outbox event failed", "event_id", row.ID, "attempts", attempt.Attempts, "error", publishErr)
+			lastError := publishErr.Error()
+			attempt.Status = usecase2.OutboxFailedStatus
+			attempt.LastError = &lastError
+			out.Failed++
+		default:
+			slog.InfoContext(ctx, "Outbox event will be retried", "event_id", row.ID, "attempts", attempt.Attempts, "error", publishErr)
+			lastError := publishErr.Error()
+			attempt.Status = usecase2.OutboxPendingStatus
+			attempt.NextAttemptAt = u.nower.Now().Add(u.backoff(attempt.Attempts))
+			attempt.LastError = &lastError
+			out.Retried++
+		}
+
+		if _, err = u.repOutbox.UpdateOutboxEventAttempt(ctx, attempt); err != nil {
+			return nil, nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateOutboxEvent, row.ID))
+		}
+	}
+
+	if len(*rows) > 0 {
+		slog.DebugContext(ctx, "UseCase OutboxRelay success", "published", out.Published, "retried", out.Retried, "failed", out.Failed)
+	}
+	return out, published, nil
+}
+
+func (u *usecase) publish(ctx context.Context, row outbox2.OutboxEventOut) (usecase2.Event, error) {
+	var event usecase2.Event
+	if err := json.Unmarshal(row.Payload, &event); err != nil {
+		return event, fmt.Errorf("decode payload: %w", err)
+	}
+
+	return event, u.trm.DoWithSettings(ctx, eventSettings, func(ctx context.Context) error {
+		for _, publisher := range u.publishers {
+			if err := publisher.Publish(ctx, []usecase2.Event{event}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// backoff doubles the delay after every failed attempt, starting from baseBackoff and capped by maxBackoff.
+func (u *usecase) backoff(attempts int) time.Duration {
+	delay := u.baseBackoff
+	for i := 1; i < attempts && delay < u.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, u.maxBackoff)
+}
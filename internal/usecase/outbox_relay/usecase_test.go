@@ -0,0 +1,385 @@
+package outbox_relay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	outbox2 "pr-reviewers-service/internal/infrastructure/repository/outbox"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events2 "pr-reviewers-service/internal/usecase/contract/events"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	outbox "pr-reviewers-service/internal/usecase/contract/repository/outbox/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	batchSize   = 10
+	maxAttempts = 3
+	baseBackoff = 30 * time.Second
+	maxBackoff  = time.Minute
+)
+
+func TestOutboxRelay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 12, 11, 9, 0, 0, 0, time.UTC)
+	publisherErr := errors.New("publisher unavailable")
+	lastError := publisherErr.Error()
+
+	newRow := func(attempts int) (outbox2.OutboxEventOut, usecase2.Event) {
+		event := usecase2.Event{
+			ID:              uuid.New(),
+			Type:            usecase2.EventReviewerAssigned,
+			PullRequestID:   uuid.New(),
+			PullRequestName: "Add feature",
+			AuthorID:        uuid.New(),
+			ReviewerID:      uuid.New(),
+			OccurredAt:      now.Add(-time.Minute),
+		}
+		payload, err := json.Marshal(event)
+		require.NoError(t, err)
+		return outbox2.OutboxEventOut{
+			ID:            event.ID,
+			EventType:     event.Type,
+			Payload:       payload,
+			Status:        usecase2.OutboxPendingStatus,
+			Attempts:      attempts,
+			NextAttemptAt: now.Add(-time.Second),
+		}, event
+	}
+	first, firstEvent := newRow(0)
+	second, secondEvent := newRow(1)
+	last, lastEvent := newRow(maxAttempts - 1)
+	broken := outbox2.OutboxEventOut{
+		ID:            uuid.New(),
+		EventType:     usecase2.EventReviewerAssigned,
+		Payload:       []byte(`"not an event"`),
+		Status:        usecase2.OutboxPendingStatus,
+		Attempts:      maxAttempts - 1,
+		NextAttemptAt: now.Add(-time.Second),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(
+			mockOutbox *outbox.MockRepositoryOutbox,
+			mockFirst *events.MockPublisher,
+			mockSecond *events.MockPublisher,
+			mockNotifier *events.MockPublisher,
+			mockNower *nower.MockNower,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "events are published, retried with backoff and failed after last attempt",
+			setupMock: func(
+				mockOutbox *outbox.MockRepositoryOutbox,
+				mockFirst *events.MockPublisher,
+				mockSecond *events.MockPublisher,
+				mockNotifier *events.MockPublisher,
+				mockNower *nower.MockNower,
+			) {
+				mockOutbox.EXPECT().
+					ClaimDueOutboxEvents(gomock.Any(), usecase2.OutboxPendingStatus, uint64(batchSize)).
+					Return(&[]outbox2.OutboxEventOut{first, second, last}, nil)
+
+				mockFirst.EXPECT().Publish(gomock.Any(), []usecase2.Event{firstEvent}).Return(nil)
+				mockSecond.EXPECT().Publish(gomock.Any(), []usecase2.Event{firstEvent}).Return(nil)
+				mockNower.EXPECT().Now().Return(now)
+				mockOutbox.EXPECT().
+					UpdateOutboxEventAttempt(gomock.Any(), outbox2.OutboxEventAttemptIn{
+						ID:            first.ID,
+						Status:        usecase2.OutboxPublishedStatus,
+						Attempts:      1,
+						NextAttemptAt: first.NextAttemptAt,
+						PublishedAt:   &now,
+					}).
+					Return(&outbox2.OutboxEventOut{}, nil)
+
+				mockFirst.EXPECT().Publish(gomock.Any(), []usecase2.Event{secondEvent}).Return(nil)
+				mockSecond.EXPECT().Publish(gomock.Any(), []usecase2.Event{secondEvent}).Return(publisherErr)
+				mockNower.EXPECT().Now().Return(now)
+				mockOutbox.EXPECT().
+					UpdateOutboxEventAttempt(gomock.Any(), outbox2.OutboxEventAttemptIn{
+						ID:            second.ID,
+						Status:        usecase2.OutboxPendingStatus,
+						Attempts:      2,
+						NextAttemptAt: now.Add(2 * baseBackoff),
+						LastError:     &lastError,
+					}).
+					Return(&outbox2.OutboxEventOut{}, nil)
+
+				mockFirst.EXPECT().Publish(gomock.Any(), []usecase2.Event{lastEvent}).Return(publisherErr)
+				mockOutbox.EXPECT().
+					UpdateOutboxEventAttempt(gomock.Any(), outbox2.OutboxEventAttemptIn{
+						ID:            last.ID,
+						Status:        usecase2.OutboxFailedStatus,
+						Attempts:      maxAttempts,
+						NextAttemptAt: last.NextAttemptAt,
+						LastError:     &lastError,
+					}).
+					Return(&outbox2.OutboxEventOut{}, nil)
+
+				mockNotifier.EXPECT().Publish(gomock.Any(), []usecase2.Event{firstEvent}).Return(nil)
+			},
+			expected: &Out{Published: 1, Retried: 1, Failed: 1},
+		},
+		{
+			name: "notifier error does not fail published events",
+			setupMock: func(
+				mockOutbox *outbox.MockRepositoryOutbox,
+				mockFirst *events.MockPublisher,
+				mockSecond *events.MockPublisher,
+				mockNotifier *events.MockPublisher,
+				mockNower *nower.MockNower,
+			) {
+				mockOutbox.EXPECT().
+					ClaimDueOutboxEvents(gomock.Any(), usecase2.OutboxPendingStatus, uint64(batchSize)).
+					Return(&[]outbox2.OutboxEventOut{first}, nil)
+				mockFirst.EXPECT().Publish(gomock.Any(), []usecase2.Event{firstEvent}).Return(nil)
+				mockSecond.EXPECT().Publish(gomock.Any(), []usecase2.Event{firstEvent}).Return(nil)
+				mockNower.EXPECT().Now().Return(now)
+				mockOutbox.EXPECT().
+					UpdateOutboxEventAttempt(gomock.Any(), gomock.Any()).
+					Return(&outbox2.OutboxEventOut{}, nil)
+				mockNotifier.EXPECT().Publish(gomock.Any(), []usecase2.Event{firstEvent}).Return(publisherErr)
+			},
+			expected: &Out{Published: 1},
+		},
+		{
+			name: "undecodable payload is not handed to publishers",
+			setupMock: func(
+				mockOutbox *outbox.MockRepositoryOutbox,
+				mockFirst *events.MockPublisher,
+				mockSecond *events.MockPublisher,
+				mockNotifier *events.MockPublisher,
+				mockNower *nower.MockNower,
+			) {
+				mockOutbox.EXPECT().
+					ClaimDueOutboxEvents(gomock.Any(), usecase2.OutboxPendingStatus, uint64(batchSize)).
+					Return(&[]outbox2.OutboxEventOut{broken}, nil)
+				mockOutbox.EXPECT().
+					UpdateOutboxEventAttempt(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, attempt outbox2.OutboxEventAttemptIn) (*outbox2.OutboxEventOut, error) {
+						assert.Equal(t, usecase2.OutboxFailedStatus, attempt.Status)
+						require.NotNil(t, attempt.LastError)
+						assert.Contains(t, *attempt.LastError, "decode payload")
+						return &outbox2.OutboxEventOut{}, nil
+					})
+			},
+			expected: &Out{Failed: 1},
+		},
+		{
+			name: "no due events",
+			setupMock: func(
+				mockOutbox *outbox.MockRepositoryOutbox,
+				mockFirst *events.MockPublisher,
+				mockSecond *events.MockPublisher,
+				mockNotifier *events.MockPublisher,
+				mockNower *nower.MockNower,
+			) {
+				mockOutbox.EXPECT().
+					ClaimDueOutboxEvents(gomock.Any(), usecase2.OutboxPendingStatus, uint64(batchSize)).
+					Return(&[]outbox2.OutboxEventOut{}, nil)
+			},
+			expected: &Out{},
+		},
+		{
+			name: "claim events error",
+			setupMock: func(
+				mockOutbox *outbox.MockRepositoryOutbox,
+				mockFirst *events.MockPublisher,
+				mockSecond *events.MockPublisher,
+				mockNotifier *events.MockPublisher,
+				mockNower *nower.MockNower,
+			) {
+				mockOutbox.EXPECT().
+					ClaimDueOutboxEvents(gomock.Any(), usecase2.OutboxPendingStatus, uint64(batchSize)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetOutboxEvents,
+		},
+		{
+			name: "update event error",
+			setupMock: func(
+				mockOutbox *outbox.MockRepositoryOutbox,
+				mockFirst *events.MockPublisher,
+				mockSecond *events.MockPublisher,
+				mockNotifier *events.MockPublisher,
+				mockNower *nower.MockNower,
+			) {
+				mockOutbox.EXPECT().
+					ClaimDueOutboxEvents(gomock.Any(), usecase2.OutboxPendingStatus, uint64(batchSize)).
+					Return(&[]outbox2.OutboxEventOut{first}, nil)
+				mockFirst.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+				mockSecond.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+				mockNower.EXPECT().Now().Return(now)
+				mockOutbox.EXPECT().
+					UpdateOutboxEventAttempt(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrUpdateOutboxEvent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOutbox := outbox.NewMockRepositoryOutbox(ctrl)
+			mockFirst := events.NewMockPublisher(ctrl)
+			mockSecond := events.NewMockPublisher(ctrl)
+			mockNotifier := events.NewMockPublisher(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			tt.setupMock(mockOutbox, mockFirst, mockSecond, mockNotifier, mockNower)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+			mockTrm.EXPECT().
+				DoWithSettings(gomock.Any(), eventSettings, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, _ trm.Settings, f func(ctx context.Context) error) error {
+					return f(ctx)
+				}).
+				AnyTimes()
+
+			u := NewUsecase(mockOutbox, []events2.Publisher{mockFirst, mockSecond},
+				[]events2.Publisher{mockNotifier}, mockNower, batchSize, maxAttempts, baseBackoff, maxBackoff, mockTrm)
+
+			result, err := u.Run(context.Background())
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+// TestOutboxRelay_RetryRollsBackPublishers relays an event whose second publisher fails once. The savepoint
+// of the event is emulated by a store that drops the writes of a failed attempt, so the first publisher's delivery
+// must be kept exactly once after the retry.
+func TestOutboxRelay_RetryRollsBackPublishers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 12, 11, 9, 0, 0, 0, time.UTC)
+	event := usecase2.Event{
+		ID:            uuid.New(),
+		Type:          usecase2.EventReviewerAssigned,
+		PullRequestID: uuid.New(),
+		ReviewerID:    uuid.New(),
+		OccurredAt:    now.Add(-time.Minute),
+	}
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+	row := outbox2.OutboxEventOut{
+		ID:            event.ID,
+		EventType:     event.Type,
+		Payload:       payload,
+		Status:        usecase2.OutboxPendingStatus,
+		NextAttemptAt: now.Add(-time.Second),
+	}
+
+	mockOutbox := outbox.NewMockRepositoryOutbox(ctrl)
+	mockFirst := events.NewMockPublisher(ctrl)
+	mockSecond := events.NewMockPublisher(ctrl)
+	mockNower := nower.NewMockNower(ctrl)
+	mockTrm := mock.NewMockManager(ctrl)
+
+	var delivered []uuid.UUID
+	mockTrm.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		}).
+		Times(2)
+	mockTrm.EXPECT().
+		DoWithSettings(gomock.Any(), eventSettings, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _ trm.Settings, f func(ctx context.Context) error) error {
+			savepoint := len(delivered)
+			if err := f(ctx); err != nil {
+				delivered = delivered[:savepoint]
+				return err
+			}
+			return nil
+		}).
+		Times(2)
+	mockFirst.EXPECT().
+		Publish(gomock.Any(), []usecase2.Event{event}).
+		DoAndReturn(func(_ context.Context, events []usecase2.Event) error {
+			delivered = append(delivered, events[0].ID)
+			return nil
+		}).
+		Times(2)
+	gomock.InOrder(
+		mockSecond.EXPECT().Publish(gomock.Any(), []usecase2.Event{event}).Return(errors.New("publisher unavailable")),
+		mockSecond.EXPECT().Publish(gomock.Any(), []usecase2.Event{event}).Return(nil),
+	)
+	mockNower.EXPECT().Now().Return(now).Times(2)
+
+	retried := row
+	gomock.InOrder(
+		mockOutbox.EXPECT().
+			ClaimDueOutboxEvents(gomock.Any(), usecase2.OutboxPendingStatus, uint64(batchSize)).
+			Return(&[]outbox2.OutboxEventOut{row}, nil),
+		mockOutbox.EXPECT().
+			UpdateOutboxEventAttempt(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, attempt outbox2.OutboxEventAttemptIn) (*outbox2.OutboxEventOut, error) {
+				assert.Equal(t, usecase2.OutboxPendingStatus, attempt.Status)
+				retried.Attempts = attempt.Attempts
+				return &retried, nil
+			}),
+		mockOutbox.EXPECT().
+			ClaimDueOutboxEvents(gomock.Any(), usecase2.OutboxPendingStatus, uint64(batchSize)).
+			DoAndReturn(func(context.Context, string, uint64) (*[]outbox2.OutboxEventOut, error) {
+				return &[]outbox2.OutboxEventOut{retried}, nil
+			}),
+		mockOutbox.EXPECT().
+			UpdateOutboxEventAttempt(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, attempt outbox2.OutboxEventAttemptIn) (*outbox2.OutboxEventOut, error) {
+				assert.Equal(t, usecase2.OutboxPublishedStatus, attempt.Status)
+				assert.Equal(t, 2, attempt.Attempts)
+				return &outbox2.OutboxEventOut{}, nil
+			}),
+	)
+
+	u := NewUsecase(mockOutbox, []events2.Publisher{mockFirst, mockSecond}, nil, mockNower,
+		batchSize, maxAttempts, baseBackoff, maxBackoff, mockTrm)
+
+	result, err := u.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Out{Retried: 1}, result)
+	assert.Empty(t, delivered)
+
+	result, err = u.Run(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &Out{Published: 1}, result)
+	assert.Equal(t, []uuid.UUID{event.ID}, delivered)
+}
+
+func TestBackoff(t *testing.T) {
+	u := &usecase{baseBackoff: 30 * time.Second, maxBackoff: 5 * time.Minute}
+
+	assert.Equal(t, 30*time.Second, u.backoff(1))
+	assert.Equal(t, time.Minute, u.backoff(2))
+	assert.Equal(t, 2*time.Minute, u.backoff(3))
+	assert.Equal(t, 5*time.Minute, u.backoff(50))
+}
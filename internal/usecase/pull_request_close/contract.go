@@ -0,0 +1,19 @@
+package pull_request_close
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type In struct {
+	PullRequestID uuid.UUID
+}
+
+type Out struct {
+	PullRequestID   uuid.UUID
+	PullRequestName string
+	AuthorID        uuid.UUID
+	Status          string
+	CreatedAt       time.Time
+}
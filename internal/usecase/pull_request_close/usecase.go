@@ -0,0 +1,104 @@
+package pull_request_close
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
+	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+type usecase struct {
+	repPullRequests pull_requests.RepositoryPullRequests
+	repPRStatuses   pr_statuses.RepositoryPrStatuses
+	publisher       events.Publisher
+	trm             trm.Manager
+}
+
+func NewUsecase(
+	repPullRequests pull_requests.RepositoryPullRequests,
+	repPRStatuses pr_statuses.RepositoryPrStatuses,
+	publisher events.Publisher,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repPullRequests: repPullRequests,
+		repPRStatuses:   repPRStatuses,
+		publisher:       publisher,
+		trm:             trm,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Get pull request", "pull_request_id", req.PullRequestID)
+	existingPR, err := u.repPullRequests.GetPullRequestByID(ctx, req.PullRequestID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrPullRequestNotFound, req.PullRequestID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetPullRequest, req.PullRequestID))
+	}
+
+	currentStatus, err := u.repPRStatuses.GetPRStatusByID(ctx, pr_statuses2.PRStatusIn{ID: existingPR.StatusID})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: status_id %s", usecase2.ErrGetPRStatus, existingPR.StatusID))
+	}
+
+	slog.DebugContext(ctx, "Check PR status", "current_status", currentStatus.Status)
+	switch currentStatus.Status {
+	case usecase2.MergedStatusValue:
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrPullRequestAlreadyMerged, existingPR.ID))
+	case usecase2.ClosedStatusValue:
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrPullRequestClosed, existingPR.ID))
+	}
+
+	slog.DebugContext(ctx, "Update pull request status to CLOSED")
+	statusOut, err := u.repPRStatuses.UpdatePRStatusByID(ctx, pr_statuses2.PRStatusIn{
+		ID:     currentStatus.ID,
+		Status: usecase2.ClosedStatusValue,
+	})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdatePrStatus, req.PullRequestID))
+	}
+
+	slog.DebugContext(ctx, "Publish closed event")
+	err = u.publisher.Publish(ctx, []usecase2.Event{{
+		Type:            usecase2.EventPullRequestClosed,
+		PullRequestID:   existingPR.ID,
+		PullRequestName: existingPR.Name,
+		AuthorID:        existingPR.AuthorID,
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase ClosePullRequest success")
+	return &Out{
+		PullRequestID:   existingPR.ID,
+		PullRequestName: existingPR.Name,
+		AuthorID:        existingPR.AuthorID,
+		Status:          statusOut.Status,
+		CreatedAt:       existingPR.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,305 @@
+package pull_request_close
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
+	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequestClose(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prID := uuid.New()
+	authorID := uuid.New()
+	statusID := uuid.New()
+
+	req := In{
+		PullRequestID: prID,
+	}
+	existingPR := &pull_requests2.PullRequestOut{
+		ID:        prID,
+		Name:      "Test PR",
+		AuthorID:  authorID,
+		StatusID:  statusID,
+		CreatedAt: time.Now(),
+	}
+	openStatus := &pr_statuses2.PRStatusOut{
+		ID:     statusID,
+		Status: "OPEN",
+	}
+
+	trmDo := func(mockTrm *mock.MockManager) {
+		mockTrm.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+				return f(ctx)
+			})
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockPullRequests *pull_requests.MockRepositoryPullRequests,
+			mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+			mockPublisher *events.MockPublisher,
+			mockTrm *mock.MockManager,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "successful close pull request",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPublisher *events.MockPublisher,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(existingPR, nil)
+
+				mockPRStatuses.EXPECT().
+					GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+					Return(openStatus, nil)
+
+				mockPRStatuses.EXPECT().
+					UpdatePRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{
+						ID:     statusID,
+						Status: usecase2.ClosedStatusValue,
+					}).
+					Return(&pr_statuses2.PRStatusOut{ID: statusID, Status: usecase2.ClosedStatusValue}, nil)
+
+				mockPublisher.EXPECT().
+					Publish(gomock.Any(), []usecase2.Event{{
+						Type:            usecase2.EventPullRequestClosed,
+						PullRequestID:   prID,
+						PullRequestName: "Test PR",
+						AuthorID:        authorID,
+					}}).
+					Return(nil)
+
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				PullRequestID:   prID,
+				PullRequestName: "Test PR",
+				AuthorID:        authorID,
+				Status:          usecase2.ClosedStatusValue,
+				CreatedAt:       existingPR.CreatedAt,
+			},
+		},
+		{
+			name: "pull request not found",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPublisher *events.MockPublisher,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(nil, repository.ErrPullRequestNotFound)
+
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrPullRequestNotFound,
+		},
+		{
+			name: "get pull request error",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPublisher *events.MockPublisher,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(nil, errors.New("db error"))
+
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrGetPullRequest,
+		},
+		{
+			name: "get pr status error",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPublisher *events.MockPublisher,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(existingPR, nil)
+
+				mockPRStatuses.EXPECT().
+					GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+					Return(nil, errors.New("db error"))
+
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrGetPRStatus,
+		},
+		{
+			name: "pull request already merged",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPublisher *events.MockPublisher,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(existingPR, nil)
+
+				mockPRStatuses.EXPECT().
+					GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+					Return(&pr_statuses2.PRStatusOut{ID: statusID, Status: usecase2.MergedStatusValue}, nil)
+
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrPullRequestAlreadyMerged,
+		},
+		{
+			name: "pull request already closed",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPublisher *events.MockPublisher,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(existingPR, nil)
+
+				mockPRStatuses.EXPECT().
+					GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+					Return(&pr_statuses2.PRStatusOut{ID: statusID, Status: usecase2.ClosedStatusValue}, nil)
+
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrPullRequestClosed,
+		},
+		{
+			name: "update pr status error",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPublisher *events.MockPublisher,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(existingPR, nil)
+
+				mockPRStatuses.EXPECT().
+					GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+					Return(openStatus, nil)
+
+				mockPRStatuses.EXPECT().
+					UpdatePRStatusByID(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrUpdatePrStatus,
+		},
+		{
+			name: "publish closed event error",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPublisher *events.MockPublisher,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(existingPR, nil)
+
+				mockPRStatuses.EXPECT().
+					GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+					Return(openStatus, nil)
+
+				mockPRStatuses.EXPECT().
+					UpdatePRStatusByID(gomock.Any(), gomock.Any()).
+					Return(&pr_statuses2.PRStatusOut{ID: statusID, Status: usecase2.ClosedStatusValue}, nil)
+
+				mockPublisher.EXPECT().
+					Publish(gomock.Any(), gomock.Any()).
+					Return(usecase2.ErrSaveOutboxEvents)
+
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrSaveOutboxEvents,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			tt.setupMock(
+				mockRepoPullRequests,
+				mockRepoPRStatuses,
+				mockPublisher,
+				mockTrm,
+			)
+
+			u := NewUsecase(
+				mockRepoPullRequests,
+				mockRepoPRStatuses,
+				mockPublisher,
+				mockTrm,
+			)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tt.expected != nil {
+				require.NotNil(t, result)
+				assert.Equal(t, tt.expected.PullRequestID, result.PullRequestID)
+				assert.Equal(t, tt.expected.PullRequestName, result.PullRequestName)
+				assert.Equal(t, tt.expected.AuthorID, result.AuthorID)
+				assert.Equal(t, tt.expected.Status, result.Status)
+				assert.Equal(t, tt.expected.CreatedAt, result.CreatedAt)
+			} else {
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package pull_request_create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/codeowners"
+
+	"github.com/google/uuid"
+)
+
+type codeOwnersReviewer struct {
+	user  users2.UserOut
+	rule  *codeowners.Rule
+	owner codeowners.Owner
+}
+
+// getCodeOwnersReviewers returns the required reviewers: the owners of the last rule matching each changed path,
+// rules are taken in the file order. A user owner is required unless they are the author or inactive, a team owner
+// is satisfied by one active member, picked randomly unless one of them is already required. Owners missing from
+// the database are skipped, the same as GitHub does.
+func (u *usecase) getCodeOwnersReviewers(ctx context.Context, req In) ([]codeOwnersReviewer, error) {
+	if req.Repository == "" || len(req.ChangedFiles) == 0 {
+		return nil, nil
+	}
+
+	slog.DebugContext(ctx, "Get code owners", "repository", req.Repository)
+	stored, err := u.repCodeOwners.GetCodeOwnersByRepository(ctx, req.Repository)
+	if err != nil {
+		if errors.Is(err, repository.ErrCodeOwnersNotFound) {
+			slog.DebugContext(ctx, "No code owners uploaded", "repository", req.Repository)
+			return nil, nil
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetCodeOwners, req.Repository))
+	}
+	_, ruleset, err := usecase2.NewCodeOwners(ctx, *stored)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []*codeowners.Rule
+	for _, path := range req.ChangedFiles {
+		if rule := ruleset.Match(path); rule != nil && !slices.Contains(rules, rule) {
+			rules = append(rules, rule)
+		}
+	}
+	slices.SortFunc(rules, func(a, b *codeowners.Rule) int { return a.Line - b.Line })
+
+	var selected []codeOwnersReviewer
+	isSelected := func(userID uuid.UUID) bool {
+		return slices.ContainsFunc(selected, func(r codeOwnersReviewer) bool { return r.user.ID == userID })
+	}
+	for _, rule := range rules {
+		for _, owner := range rule.Owners {
+			resolved, err := usecase2.ResolveCodeOwner(ctx, u.repIdentities, u.repUsers, u.repTeams, owner)
+			if errors.Is(err, usecase2.ErrCodeOwnerNotFound) {
+				slog.WarnContext(ctx, "Code owner not found", "repository", req.Repository, "owner", owner.Raw)
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			if resolved.UserID != uuid.Nil {
+				if resolved.UserID == req.AuthorID || isSelected(resolved.UserID) {
+					continue
+				}
+				slog.DebugContext(ctx, "Get code owner", "user_id", resolved.UserID)
+				user, err := u.repUsers.GetUserByID(ctx, resolved.UserID)
+				if err != nil {
+					return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, resolved.UserID))
+				}
+				if user.IsActive {
+					selected = append(selected, codeOwnersReviewer{user: *user, rule: rule, owner: owner})
+				}
+				continue
+			}
+
+			slog.DebugContext(ctx, "Get active code owner team members", "team_id", resolved.TeamID)
+			members, err := u.repUsers.GetActiveUsersByTeamID(ctx, resolved.TeamID)
+			if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, resolved.TeamID))
+			}
+			var available []users2.UserOut
+			if members != nil {
+				available = usecase2.ReviewerCandidates(*members, req.AuthorID)
+			}
+			if slices.ContainsFunc(available, func(member users2.UserOut) bool { return isSelected(member.ID) }) {
+				continue
+			}
+			for _, member := range u.selector.PickRandom(available, 1) {
+				selected = append(selected, codeOwnersReviewer{user: member, rule: rule, owner: owner})
+			}
+		}
+	}
+	return selected, nil
+}
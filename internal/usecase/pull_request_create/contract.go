@@ -3,13 +3,27 @@ package pull_request_create
 import (
 	"time"
 
+	usecase2 "pr-reviewers-service/internal/usecase"
+
 	"github.com/google/uuid"
 )
 
+// Reviewer sources.
+const (
+	SourceCodeOwners = "CODEOWNERS"
+	SourceTeam       = "TEAM"
+	SourceParentTeam = "PARENT_TEAM"
+)
+
+// In identifies the author either by AuthorID or, when it is set, by AuthorIdentity. ChangedFiles are matched
+// against the CODEOWNERS file uploaded for Repository, the matched owners are required reviewers.
 type In struct {
 	PullRequestID   uuid.UUID
 	PullRequestName string
 	AuthorID        uuid.UUID
+	AuthorIdentity  *usecase2.Identity
+	Repository      string
+	ChangedFiles    []string
 }
 
 type Out struct {
@@ -18,6 +32,16 @@ type Out struct {
 	AuthorID          uuid.UUID
 	Status            string
 	AssignedReviewers []uuid.UUID
+	Reviewers         []AssignedReviewer
 	CreatedAt         time.Time
 	MergedAt          time.Time
 }
+
+// AssignedReviewer tells why the reviewer was picked, the rule and the owner are set for SourceCodeOwners.
+type AssignedReviewer struct {
+	ReviewerID  uuid.UUID
+	Source      string
+	RuleLine    int
+	RulePattern string
+	Owner       string
+}
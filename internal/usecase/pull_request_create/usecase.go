@@ -14,10 +14,14 @@ import (
 	"pr-reviewers-service/internal/logging"
 	"pr-reviewers-service/internal/metrics"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
 	"pr-reviewers-service/internal/usecase/contract/randomizer"
+	"pr-reviewers-service/internal/usecase/contract/repository/code_owners"
 	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
 	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
 	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
 	"pr-reviewers-service/internal/usecase/contract/repository/users"
 
 	"github.com/avito-tech/go-transaction-manager/trm/v2"
@@ -26,30 +30,42 @@ import (
 
 type usecase struct {
 	repUsers        users.RepositoryUsers
+	repTeams        teams.RepositoryTeams
 	repPullRequests pull_requests.RepositoryPullRequests
 	repPRReviewers  pr_reviewers.RepositoryPrReviewers
 	repPRStatuses   pr_statuses.RepositoryPrStatuses
-	randomizer      randomizer.Randomizer
+	repIdentities   user_identities.RepositoryUserIdentities
+	repCodeOwners   code_owners.RepositoryCodeOwners
+	selector        usecase2.ReviewerSelector
 	maxCntReviewers int
+	publisher       events.Publisher
 	trm             trm.Manager
 }
 
 func NewUsecase(
 	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
 	repPullRequests pull_requests.RepositoryPullRequests,
 	repPRReviewers pr_reviewers.RepositoryPrReviewers,
 	repPRStatuses pr_statuses.RepositoryPrStatuses,
+	repIdentities user_identities.RepositoryUserIdentities,
+	repCodeOwners code_owners.RepositoryCodeOwners,
 	randomizer randomizer.Randomizer,
 	maxCntReviewers int,
+	publisher events.Publisher,
 	trm trm.Manager,
 ) *usecase {
 	return &usecase{
 		repUsers:        repUsers,
+		repTeams:        repTeams,
 		repPullRequests: repPullRequests,
 		repPRReviewers:  repPRReviewers,
 		repPRStatuses:   repPRStatuses,
-		randomizer:      randomizer,
+		repIdentities:   repIdentities,
+		repCodeOwners:   repCodeOwners,
+		selector:        usecase2.NewReviewerSelector(repUsers, repTeams, randomizer),
 		maxCntReviewers: maxCntReviewers,
+		publisher:       publisher,
 		trm:             trm,
 	}
 }
@@ -67,6 +83,15 @@ func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
 }
 
 func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	var err error
+	if req.AuthorIdentity != nil {
+		req.AuthorID, err = usecase2.ResolveIdentity(ctx, u.repIdentities, *req.AuthorIdentity)
+		if err != nil {
+			return nil, err
+		}
+		ctx = logging.WithLogAuthorID(ctx, req.AuthorID)
+	}
+
 	slog.DebugContext(ctx, "Check if PR already exists")
 	existingPR, err := u.repPullRequests.GetPullRequestByID(ctx, req.PullRequestID)
 	if err != nil && !errors.Is(err, repository.ErrPullRequestNotFound) {
@@ -85,10 +110,9 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, req.AuthorID))
 	}
 
-	slog.DebugContext(ctx, "Get active team members", "team_id", author.TeamID)
-	teamMembers, err := u.repUsers.GetActiveUsersByTeamID(ctx, author.TeamID)
-	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
-		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, author.TeamID))
+	requiredReviewers, err := u.getCodeOwnersReviewers(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
 	statusIn := pr_statuses2.PRStatusIn{
@@ -111,10 +135,41 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSavePullRequest, req.PullRequestID))
 	}
 
-	availableReviewers := u.getAvailableReviewersFromTeam(teamMembers, req.AuthorID)
-	selectedReviewers := u.selectRandomReviewers(availableReviewers, u.maxCntReviewers)
+	// Code owners are required even above the limit, free slots are filled from the author's team hierarchy.
+	var selectedReviewers []users2.UserOut
+	var reviewers []AssignedReviewer
+	excluded := []uuid.UUID{req.AuthorID}
+	for _, required := range requiredReviewers {
+		selectedReviewers = append(selectedReviewers, required.user)
+		excluded = append(excluded, required.user.ID)
+		reviewers = append(reviewers, AssignedReviewer{
+			ReviewerID:  required.user.ID,
+			Source:      SourceCodeOwners,
+			RuleLine:    required.rule.Line,
+			RulePattern: required.rule.Pattern,
+			Owner:       required.owner.Raw,
+		})
+	}
+	teamReviewers, err := u.selector.Select(ctx, author.TeamID, u.maxCntReviewers-len(selectedReviewers), excluded...)
+	if err != nil {
+		return nil, err
+	}
+	for _, reviewer := range teamReviewers {
+		source := SourceTeam
+		if reviewer.FromParentTeam {
+			source = SourceParentTeam
+		}
+		selectedReviewers = append(selectedReviewers, reviewer.User)
+		reviewers = append(reviewers, AssignedReviewer{ReviewerID: reviewer.User.ID, Source: source})
+	}
 	slog.DebugContext(ctx, "Assign reviewers", "count", len(selectedReviewers))
 	var assignedReviewers []uuid.UUID
+	createdEvents := []usecase2.Event{{
+		Type:            usecase2.EventPullRequestCreated,
+		PullRequestID:   createdPR.ID,
+		PullRequestName: createdPR.Name,
+		AuthorID:        createdPR.AuthorID,
+	}}
 	for _, reviewer := range selectedReviewers {
 		reviewerIn := pr_reviewers2.PrReviewerIn{
 			PrID:       createdPR.ID,
@@ -125,6 +180,18 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 			return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer %s", usecase2.ErrAssignReviewer, reviewer.ID))
 		}
 		assignedReviewers = append(assignedReviewers, reviewer.ID)
+		createdEvents = append(createdEvents, usecase2.Event{
+			Type:            usecase2.EventReviewerAssigned,
+			PullRequestID:   createdPR.ID,
+			PullRequestName: createdPR.Name,
+			AuthorID:        createdPR.AuthorID,
+			ReviewerID:      reviewer.ID,
+		})
+	}
+
+	slog.DebugContext(ctx, "Publish created and assigned events", "count", len(createdEvents))
+	if err = u.publisher.Publish(ctx, createdEvents); err != nil {
+		return nil, err
 	}
 
 	metrics.IncCreatedPRs()
@@ -135,36 +202,8 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		AuthorID:          createdPR.AuthorID,
 		Status:            prStatusOut.Status,
 		AssignedReviewers: assignedReviewers,
+		Reviewers:         reviewers,
 		CreatedAt:         createdPR.CreatedAt,
 		MergedAt:          createdPR.MergedAt,
 	}, nil
 }
-
-func (u *usecase) getAvailableReviewersFromTeam(teamMembers *[]users2.UserOut, authorID uuid.UUID) []users2.UserOut {
-	if teamMembers == nil {
-		return []users2.UserOut{}
-	}
-
-	var available []users2.UserOut
-	for _, member := range *teamMembers {
-		if member.ID != authorID && member.IsActive {
-			available = append(available, member)
-		}
-	}
-	return available
-}
-
-func (u *usecase) selectRandomReviewers(available []users2.UserOut, maxCount int) []users2.UserOut {
-	if len(available) <= maxCount {
-		return available
-	}
-
-	shuffled := make([]users2.UserOut, len(available))
-	copy(shuffled, available)
-
-	u.randomizer.Shuffle(len(shuffled), func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
-
-	return shuffled[:maxCount]
-}
@@ -7,15 +7,22 @@ import (
 	"time"
 
 	"pr-reviewers-service/internal/infrastructure/repository"
+	code_owners2 "pr-reviewers-service/internal/infrastructure/repository/code_owners"
 	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
 	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
 	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
 	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
 	randomizer "pr-reviewers-service/internal/usecase/contract/randomizer/mocks"
+	code_owners "pr-reviewers-service/internal/usecase/contract/repository/code_owners/mocks"
 	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
 	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
 	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	user_identities "pr-reviewers-service/internal/usecase/contract/repository/user_identities/mocks"
 	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
 
 	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
@@ -78,6 +85,12 @@ func TestPullRequestCreate(t *testing.T) {
 			TeamID:   teamID,
 		},
 	}
+	parentTeamID := uuid.New()
+	parentReviewerID := uuid.New()
+	teamOut := &teams2.TeamOut{
+		ID:   teamID,
+		Name: "backend",
+	}
 	prStatusOut := &pr_statuses2.PRStatusOut{
 		ID:     statusID,
 		Status: "OPEN",
@@ -95,6 +108,7 @@ func TestPullRequestCreate(t *testing.T) {
 		setupMock func(
 			mockPullRequests *pull_requests.MockRepositoryPullRequests,
 			mockUsers *users.MockRepositoryUsers,
+			mockTeams *teams.MockRepositoryTeams,
 			mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 			mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 			mockRandomizer *randomizer.MockRandomizer,
@@ -109,6 +123,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -169,6 +184,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -192,6 +208,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -215,6 +232,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -242,6 +260,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -269,6 +288,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -294,6 +314,10 @@ func TestPullRequestCreate(t *testing.T) {
 					SavePullRequest(gomock.Any(), gomock.Any()).
 					Return(createdPR, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(teamOut, nil)
+
 				mockTrm.EXPECT().
 					Do(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
@@ -316,6 +340,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -329,6 +354,14 @@ func TestPullRequestCreate(t *testing.T) {
 					GetUserByID(gomock.Any(), authorID).
 					Return(author, nil)
 
+				mockPRStatuses.EXPECT().
+					SavePRStatus(gomock.Any(), gomock.Any()).
+					Return(prStatusOut, nil)
+
+				mockPullRequests.EXPECT().
+					SavePullRequest(gomock.Any(), gomock.Any()).
+					Return(createdPR, nil)
+
 				mockUsers.EXPECT().
 					GetActiveUsersByTeamID(gomock.Any(), teamID).
 					Return(nil, errors.New("database error"))
@@ -347,6 +380,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -360,10 +394,6 @@ func TestPullRequestCreate(t *testing.T) {
 					GetUserByID(gomock.Any(), authorID).
 					Return(author, nil)
 
-				mockUsers.EXPECT().
-					GetActiveUsersByTeamID(gomock.Any(), teamID).
-					Return(&teamMembers, nil)
-
 				mockPRStatuses.EXPECT().
 					SavePRStatus(gomock.Any(), gomock.Any()).
 					Return(nil, errors.New("database error"))
@@ -382,6 +412,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -395,10 +426,6 @@ func TestPullRequestCreate(t *testing.T) {
 					GetUserByID(gomock.Any(), authorID).
 					Return(author, nil)
 
-				mockUsers.EXPECT().
-					GetActiveUsersByTeamID(gomock.Any(), teamID).
-					Return(&teamMembers, nil)
-
 				mockPRStatuses.EXPECT().
 					SavePRStatus(gomock.Any(), gomock.Any()).
 					Return(prStatusOut, nil)
@@ -421,6 +448,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -469,6 +497,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -508,6 +537,10 @@ func TestPullRequestCreate(t *testing.T) {
 					SavePullRequest(gomock.Any(), gomock.Any()).
 					Return(createdPR, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(teamOut, nil)
+
 				mockPRReviewers.EXPECT().
 					SavePRReviewer(gomock.Any(), gomock.Any()).
 					Times(1).Return(&pr_reviewers2.PrReviewerOut{}, nil)
@@ -534,6 +567,7 @@ func TestPullRequestCreate(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer.MockRandomizer,
@@ -567,6 +601,10 @@ func TestPullRequestCreate(t *testing.T) {
 					SavePullRequest(gomock.Any(), gomock.Any()).
 					Return(createdPR, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(teamOut, nil)
+
 				mockTrm.EXPECT().
 					Do(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
@@ -583,20 +621,139 @@ func TestPullRequestCreate(t *testing.T) {
 				MergedAt:          createdPR.MergedAt,
 			},
 		},
+		{
+			name: "successful create fills free slots from parent team",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockRandomizer *randomizer.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(nil, repository.ErrPullRequestNotFound)
+
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), authorID).
+					Return(author, nil)
+
+				fewTeamMembers := []users2.UserOut{
+					{ID: reviewerID1, Name: "reviewer1", IsActive: true, TeamID: teamID},
+					{ID: authorID, Name: "author", IsActive: true, TeamID: teamID},
+				}
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&fewTeamMembers, nil)
+
+				mockPRStatuses.EXPECT().
+					SavePRStatus(gomock.Any(), gomock.Any()).
+					Return(prStatusOut, nil)
+
+				mockPullRequests.EXPECT().
+					SavePullRequest(gomock.Any(), gomock.Any()).
+					Return(createdPR, nil)
+
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(&teams2.TeamOut{ID: teamID, Name: "backend", ParentTeamID: &parentTeamID}, nil)
+
+				parentTeamMembers := []users2.UserOut{
+					{ID: reviewerID1, Name: "reviewer1", IsActive: true, TeamID: teamID},
+					{ID: authorID, Name: "author", IsActive: true, TeamID: teamID},
+					{ID: parentReviewerID, Name: "parent_reviewer", IsActive: true, TeamID: parentTeamID},
+				}
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), parentTeamID).
+					Return(&parentTeamMembers, nil)
+
+				mockPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), gomock.Any()).
+					Times(cntReviewers).Return(&pr_reviewers2.PrReviewerOut{}, nil)
+
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expected: &Out{
+				PullRequestID:     prID,
+				PullRequestName:   req.PullRequestName,
+				AuthorID:          authorID,
+				Status:            "OPEN",
+				AssignedReviewers: []uuid.UUID{reviewerID1, parentReviewerID},
+				CreatedAt:         createdPR.CreatedAt,
+				MergedAt:          createdPR.MergedAt,
+			},
+		},
+		{
+			name: "error getting team while falling back to parent team",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockRandomizer *randomizer.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(nil, repository.ErrPullRequestNotFound)
+
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), authorID).
+					Return(author, nil)
+
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{}, nil)
+
+				mockPRStatuses.EXPECT().
+					SavePRStatus(gomock.Any(), gomock.Any()).
+					Return(prStatusOut, nil)
+
+				mockPullRequests.EXPECT().
+					SavePullRequest(gomock.Any(), gomock.Any()).
+					Return(createdPR, nil)
+
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(nil, errors.New("database error"))
+
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
 			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
 			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
 			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
 			mockRandomizer := randomizer.NewMockRandomizer(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
 			mockTrm := mock.NewMockManager(ctrl)
 
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
 			tt.setupMock(
 				mockRepoPullRequests,
 				mockRepoUsers,
+				mockRepoTeams,
 				mockRepoPRStatuses,
 				mockRepoPRReviewers,
 				mockRandomizer,
@@ -605,11 +762,15 @@ func TestPullRequestCreate(t *testing.T) {
 
 			u := NewUsecase(
 				mockRepoUsers,
+				mockRepoTeams,
 				mockRepoPullRequests,
 				mockRepoPRReviewers,
 				mockRepoPRStatuses,
+				mockRepoIdentities,
+				code_owners.NewMockRepositoryCodeOwners(ctrl),
 				mockRandomizer,
 				cntReviewers,
+				mockPublisher,
 				mockTrm,
 			)
 
@@ -641,3 +802,454 @@ func TestPullRequestCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestPullRequestCreateByAuthorIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prID := uuid.New()
+	authorID := uuid.New()
+	teamID := uuid.New()
+	statusID := uuid.New()
+	identity := usecase2.Identity{Provider: usecase2.IdentityProviderGithub, ExternalID: "alice"}
+	req := In{
+		PullRequestID:   prID,
+		PullRequestName: "Test PR",
+		AuthorIdentity:  &identity,
+	}
+	createdPR := &pull_requests2.PullRequestOut{
+		ID:       prID,
+		Name:     req.PullRequestName,
+		AuthorID: authorID,
+		StatusID: statusID,
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(
+			mockIdentities *user_identities.MockRepositoryUserIdentities,
+			mockPullRequests *pull_requests.MockRepositoryPullRequests,
+			mockUsers *users.MockRepositoryUsers,
+			mockTeams *teams.MockRepositoryTeams,
+			mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+		)
+		expectedAuthorID uuid.UUID
+		expectedError    error
+	}{
+		{
+			name: "author is resolved by identity",
+			setupMock: func(
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+			) {
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "github", "alice").
+					Return(&user_identities2.UserIdentityOut{UserID: authorID, Provider: "github", ExternalID: "alice"}, nil)
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(nil, repository.ErrPullRequestNotFound)
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), authorID).
+					Return(&users2.UserOut{ID: authorID, Name: "alice", IsActive: true, TeamID: teamID}, nil)
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{}, nil)
+				mockPRStatuses.EXPECT().
+					SavePRStatus(gomock.Any(), gomock.Any()).
+					Return(&pr_statuses2.PRStatusOut{ID: statusID, Status: "OPEN"}, nil)
+				mockPullRequests.EXPECT().
+					SavePullRequest(gomock.Any(), pull_requests2.PullRequestIn{
+						ID:       prID,
+						Name:     req.PullRequestName,
+						AuthorID: authorID,
+						StatusID: statusID,
+					}).
+					Return(createdPR, nil)
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(&teams2.TeamOut{ID: teamID, Name: "backend"}, nil)
+			},
+			expectedAuthorID: authorID,
+		},
+		{
+			name: "identity is not linked to any user",
+			setupMock: func(
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+			) {
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "github", "alice").
+					Return(nil, repository.ErrIdentityNotFound)
+			},
+			expectedError: usecase2.ErrIdentityNotFound,
+		},
+		{
+			name: "error getting identity",
+			setupMock: func(
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+			) {
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "github", "alice").
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetIdentities,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRandomizer := randomizer.NewMockRandomizer(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+			tt.setupMock(mockRepoIdentities, mockRepoPullRequests, mockRepoUsers, mockRepoTeams, mockRepoPRStatuses)
+
+			u := NewUsecase(
+				mockRepoUsers,
+				mockRepoTeams,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockRepoIdentities,
+				code_owners.NewMockRepositoryCodeOwners(ctrl),
+				mockRandomizer,
+				cntReviewers,
+				mockPublisher,
+				mockTrm,
+			)
+
+			result, err := u.Run(context.Background(), req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAuthorID, result.AuthorID)
+		})
+	}
+}
+
+func TestPullRequestCreatePublishesEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prID := uuid.New()
+	authorID := uuid.New()
+	reviewerID := uuid.New()
+	teamID := uuid.New()
+	statusID := uuid.New()
+	req := In{
+		PullRequestID:   prID,
+		PullRequestName: "Test PR",
+		AuthorID:        authorID,
+	}
+	publishErr := errors.New("publish error")
+	author := users2.UserOut{ID: authorID, IsActive: true, TeamID: teamID}
+	reviewer := users2.UserOut{ID: reviewerID, IsActive: true, TeamID: teamID}
+	createdEvent := usecase2.Event{
+		Type:            usecase2.EventPullRequestCreated,
+		PullRequestID:   prID,
+		PullRequestName: "Test PR",
+		AuthorID:        authorID,
+	}
+	assignedEvent := usecase2.Event{
+		Type:            usecase2.EventReviewerAssigned,
+		PullRequestID:   prID,
+		PullRequestName: "Test PR",
+		AuthorID:        authorID,
+		ReviewerID:      reviewerID,
+	}
+
+	tests := []struct {
+		name              string
+		members           []users2.UserOut
+		publishErr        error
+		expectedReviewers []uuid.UUID
+		expectedEvents    []usecase2.Event
+		expectedError     error
+	}{
+		{
+			name:              "created and assigned events are published",
+			members:           []users2.UserOut{author, reviewer},
+			expectedReviewers: []uuid.UUID{reviewerID},
+			expectedEvents:    []usecase2.Event{createdEvent, assignedEvent},
+		},
+		{
+			name:           "created event is published without eligible reviewers",
+			members:        []users2.UserOut{author},
+			expectedEvents: []usecase2.Event{createdEvent},
+		},
+		{
+			name:              "publish error rolls back creation",
+			members:           []users2.UserOut{author, reviewer},
+			publishErr:        publishErr,
+			expectedReviewers: []uuid.UUID{reviewerID},
+			expectedEvents:    []usecase2.Event{createdEvent, assignedEvent},
+			expectedError:     publishErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
+			mockRandomizer := randomizer.NewMockRandomizer(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoPullRequests.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(nil, repository.ErrPullRequestNotFound)
+			mockRepoUsers.EXPECT().GetUserByID(gomock.Any(), authorID).
+				Return(&users2.UserOut{ID: authorID, IsActive: true, TeamID: teamID}, nil)
+			mockRepoUsers.EXPECT().GetActiveUsersByTeamID(gomock.Any(), teamID).
+				Return(&tt.members, nil)
+			mockRepoPRStatuses.EXPECT().SavePRStatus(gomock.Any(), gomock.Any()).
+				Return(&pr_statuses2.PRStatusOut{ID: statusID, Status: usecase2.OpenStatusValue}, nil)
+			mockRepoPullRequests.EXPECT().SavePullRequest(gomock.Any(), gomock.Any()).
+				Return(&pull_requests2.PullRequestOut{ID: prID, Name: "Test PR", AuthorID: authorID, StatusID: statusID}, nil)
+			mockRepoTeams.EXPECT().GetTeamByID(gomock.Any(), teamID).
+				Return(&teams2.TeamOut{ID: teamID, Name: "backend"}, nil)
+			for _, reviewerID := range tt.expectedReviewers {
+				mockRepoPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: prID, ReviewerID: reviewerID}).
+					Return(&pr_reviewers2.PrReviewerOut{PRID: prID, ReviewerID: reviewerID}, nil)
+			}
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), tt.expectedEvents).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(
+				mockRepoUsers,
+				mockRepoTeams,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockRepoIdentities,
+				code_owners.NewMockRepositoryCodeOwners(ctrl),
+				mockRandomizer,
+				cntReviewers,
+				mockPublisher,
+				mockTrm,
+			)
+
+			result, err := u.Run(context.Background(), req)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedReviewers, result.AssignedReviewers)
+		})
+	}
+}
+
+func TestPullRequestCreateWithCodeOwners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prID := uuid.New()
+	authorID := uuid.New()
+	teamID := uuid.New()
+	backendTeamID := uuid.New()
+	statusID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	carolID := uuid.New()
+
+	content := "*       @org/backend\n" +
+		"*.go    @alice docs@example.com\n" +
+		"/docs/  @ghost\n"
+
+	type mocks struct {
+		codeOwners *code_owners.MockRepositoryCodeOwners
+		identities *user_identities.MockRepositoryUserIdentities
+		users      *users.MockRepositoryUsers
+		teams      *teams.MockRepositoryTeams
+	}
+	expectAlice := func(m mocks) {
+		m.identities.EXPECT().GetUserIdentity(gomock.Any(), usecase2.IdentityProviderGithub, "alice").
+			Return(&user_identities2.UserIdentityOut{UserID: aliceID}, nil)
+		m.identities.EXPECT().GetUserIdentity(gomock.Any(), usecase2.IdentityProviderEmail, "docs@example.com").
+			Return(&user_identities2.UserIdentityOut{UserID: authorID}, nil)
+		m.users.EXPECT().GetUserByID(gomock.Any(), aliceID).
+			Return(&users2.UserOut{ID: aliceID, IsActive: true, TeamID: backendTeamID}, nil)
+	}
+
+	tests := []struct {
+		name          string
+		changedFiles  []string
+		teamMembers   []users2.UserOut
+		setupMock     func(m mocks)
+		expected      []AssignedReviewer
+		expectedError error
+	}{
+		{
+			name:         "owners of all matched rules are required",
+			changedFiles: []string{"cmd/main.go", "docs/readme.md", "README.md"},
+			setupMock: func(m mocks) {
+				m.codeOwners.EXPECT().GetCodeOwnersByRepository(gomock.Any(), "org/service").
+					Return(&code_owners2.CodeOwnersOut{Repository: "org/service", Content: content}, nil)
+				m.teams.EXPECT().GetTeamByName(gomock.Any(), "backend").
+					Return(&teams2.TeamOut{ID: backendTeamID, Name: "backend"}, nil)
+				m.users.EXPECT().GetActiveUsersByTeamID(gomock.Any(), backendTeamID).
+					Return(&[]users2.UserOut{{ID: bobID, IsActive: true, TeamID: backendTeamID}}, nil)
+				expectAlice(m)
+				m.identities.EXPECT().GetUserIdentity(gomock.Any(), usecase2.IdentityProviderGithub, "ghost").
+					Return(nil, repository.ErrIdentityNotFound)
+				m.users.EXPECT().GetUsersByName(gomock.Any(), "ghost").Return(&[]users2.UserOut{}, nil)
+			},
+			expected: []AssignedReviewer{
+				{ReviewerID: bobID, Source: SourceCodeOwners, RuleLine: 1, RulePattern: "*", Owner: "@org/backend"},
+				{ReviewerID: aliceID, Source: SourceCodeOwners, RuleLine: 2, RulePattern: "*.go", Owner: "@alice"},
+			},
+		},
+		{
+			name:         "team strategy fills the free slots",
+			changedFiles: []string{"cmd/main.go"},
+			teamMembers: []users2.UserOut{
+				{ID: authorID, IsActive: true},
+				{ID: aliceID, IsActive: true},
+				{ID: carolID, IsActive: true},
+			},
+			setupMock: func(m mocks) {
+				m.codeOwners.EXPECT().GetCodeOwnersByRepository(gomock.Any(), "org/service").
+					Return(&code_owners2.CodeOwnersOut{Repository: "org/service", Content: content}, nil)
+				expectAlice(m)
+			},
+			expected: []AssignedReviewer{
+				{ReviewerID: aliceID, Source: SourceCodeOwners, RuleLine: 2, RulePattern: "*.go", Owner: "@alice"},
+				{ReviewerID: carolID, Source: SourceTeam},
+			},
+		},
+		{
+			name:         "no code owners uploaded",
+			changedFiles: []string{"cmd/main.go"},
+			teamMembers:  []users2.UserOut{{ID: authorID, IsActive: true}, {ID: carolID, IsActive: true}},
+			setupMock: func(m mocks) {
+				m.codeOwners.EXPECT().GetCodeOwnersByRepository(gomock.Any(), "org/service").
+					Return(nil, repository.ErrCodeOwnersNotFound)
+				m.teams.EXPECT().GetTeamByID(gomock.Any(), teamID).
+					Return(&teams2.TeamOut{ID: teamID, Name: "frontend"}, nil)
+			},
+			expected: []AssignedReviewer{{ReviewerID: carolID, Source: SourceTeam}},
+		},
+		{
+			name:         "get code owners error",
+			changedFiles: []string{"cmd/main.go"},
+			setupMock: func(m mocks) {
+				m.codeOwners.EXPECT().GetCodeOwnersByRepository(gomock.Any(), "org/service").
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetCodeOwners,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocks{
+				codeOwners: code_owners.NewMockRepositoryCodeOwners(ctrl),
+				identities: user_identities.NewMockRepositoryUserIdentities(ctrl),
+				users:      users.NewMockRepositoryUsers(ctrl),
+				teams:      teams.NewMockRepositoryTeams(ctrl),
+			}
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+			mockRepoPullRequests.EXPECT().GetPullRequestByID(gomock.Any(), prID).
+				Return(nil, repository.ErrPullRequestNotFound)
+			m.users.EXPECT().GetUserByID(gomock.Any(), authorID).
+				Return(&users2.UserOut{ID: authorID, IsActive: true, TeamID: teamID}, nil)
+			tt.setupMock(m)
+			if tt.teamMembers != nil {
+				m.users.EXPECT().GetActiveUsersByTeamID(gomock.Any(), teamID).Return(&tt.teamMembers, nil)
+			}
+			if tt.expectedError == nil {
+				mockRepoPRStatuses.EXPECT().SavePRStatus(gomock.Any(), gomock.Any()).
+					Return(&pr_statuses2.PRStatusOut{ID: statusID, Status: usecase2.OpenStatusValue}, nil)
+				mockRepoPullRequests.EXPECT().SavePullRequest(gomock.Any(), gomock.Any()).
+					Return(&pull_requests2.PullRequestOut{ID: prID, Name: "Test PR", AuthorID: authorID, StatusID: statusID}, nil)
+				mockRepoPRReviewers.EXPECT().SavePRReviewer(gomock.Any(), gomock.Any()).
+					Return(&pr_reviewers2.PrReviewerOut{}, nil).Times(len(tt.expected))
+				mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			u := NewUsecase(
+				m.users,
+				m.teams,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				m.identities,
+				m.codeOwners,
+				randomizer.NewMockRandomizer(ctrl),
+				cntReviewers,
+				mockPublisher,
+				mockTrm,
+			)
+
+			result, err := u.Run(context.Background(), In{
+				PullRequestID:   prID,
+				PullRequestName: "Test PR",
+				AuthorID:        authorID,
+				Repository:      "org/service",
+				ChangedFiles:    tt.changedFiles,
+			})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Reviewers)
+			for i, reviewer := range tt.expected {
+				assert.Equal(t, reviewer.ReviewerID, result.AssignedReviewers[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package pull_request_event
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/pull_request_close"
+	"pr-reviewers-service/internal/usecase/pull_request_create"
+	"pr-reviewers-service/internal/usecase/pull_request_merge"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ActionOpen  = "open"
+	ActionMerge = "merge"
+	ActionClose = "close"
+)
+
+const (
+	ResultProcessed = "processed"
+	ResultDuplicate = "duplicate"
+	ResultIgnored   = "ignored"
+)
+
+// In is a provider-agnostic pull request lifecycle event. An empty Action means the event
+// is not handled and is only recorded to deduplicate its delivery.
+type In struct {
+	Provider        string
+	DeliveryID      string
+	Event           string
+	Action          string
+	PullRequestURL  string
+	PullRequestName string
+	AuthorLogin     string
+}
+
+type Out struct {
+	Result        string
+	Reason        string
+	PullRequestID uuid.UUID
+}
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=pull_request_event pullRequestCreator,pullRequestMerger,pullRequestCloser
+type pullRequestCreator interface {
+	Run(ctx context.Context, req pull_request_create.In) (*pull_request_create.Out, error)
+}
+
+type pullRequestMerger interface {
+	Run(ctx context.Context, req pull_request_merge.In) (*pull_request_merge.Out, error)
+}
+
+type pullRequestCloser interface {
+	Run(ctx context.Context, req pull_request_close.In) (*pull_request_close.Out, error)
+}
@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package pull_request_event is a generated GoMock package.
+package pull_request_event
+
+import (
+	context "context"
+	pull_request_close "pr-reviewers-service/internal/usecase/pull_request_close"
+	pull_request_create "pr-reviewers-service/internal/usecase/pull_request_create"
+	pull_request_merge "pr-reviewers-service/internal/usecase/pull_request_merge"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockpullRequestCreator is a mock of pullRequestCreator interface.
+type MockpullRequestCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockpullRequestCreatorMockRecorder
+}
+
+// MockpullRequestCreatorMockRecorder is the mock recorder for MockpullRequestCreator.
+type MockpullRequestCreatorMockRecorder struct {
+	mock *MockpullRequestCreator
+}
+
+// NewMockpullRequestCreator creates a new mock instance.
+func NewMockpullRequestCreator(ctrl *gomock.Controller) *MockpullRequestCreator {
+	mock := &MockpullRequestCreator{ctrl: ctrl}
+	mock.recorder = &MockpullRequestCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpullRequestCreator) EXPECT() *MockpullRequestCreatorMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockpullRequestCreator) Run(ctx context.Context, req pull_request_create.In) (*pull_request_create.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*pull_request_create.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockpullRequestCreatorMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockpullRequestCreator)(nil).Run), ctx, req)
+}
+
+// MockpullRequestMerger is a mock of pullRequestMerger interface.
+type MockpullRequestMerger struct {
+	ctrl     *gomock.Controller
+	recorder *MockpullRequestMergerMockRecorder
+}
+
+// MockpullRequestMergerMockRecorder is the mock recorder for MockpullRequestMerger.
+type MockpullRequestMergerMockRecorder struct {
+	mock *MockpullRequestMerger
+}
+
+// NewMockpullRequestMerger creates a new mock instance.
+func NewMockpullRequestMerger(ctrl *gomock.Controller) *MockpullRequestMerger {
+	mock := &MockpullRequestMerger{ctrl: ctrl}
+	mock.recorder = &MockpullRequestMergerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpullRequestMerger) EXPECT() *MockpullRequestMergerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockpullRequestMerger) Run(ctx context.Context, req pull_request_merge.In) (*pull_request_merge.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*pull_request_merge.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockpullRequestMergerMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockpullRequestMerger)(nil).Run), ctx, req)
+}
+
+// MockpullRequestCloser is a mock of pullRequestCloser interface.
+type MockpullRequestCloser struct {
+	ctrl     *gomock.Controller
+	recorder *MockpullRequestCloserMockRecorder
+}
+
+// MockpullRequestCloserMockRecorder is the mock recorder for MockpullRequestCloser.
+type MockpullRequestCloserMockRecorder struct {
+	mock *MockpullRequestCloser
+}
+
+// NewMockpullRequestCloser creates a new mock instance.
+func NewMockpullRequestCloser(ctrl *gomock.Controller) *MockpullRequestCloser {
+	mock := &MockpullRequestCloser{ctrl: ctrl}
+	mock.recorder = &MockpullRequestCloserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpullRequestCloser) EXPECT() *MockpullRequestCloserMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockpullRequestCloser) Run(ctx context.Context, req pull_request_close.In) (*pull_request_close.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*pull_request_close.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockpullRequestCloserMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockpullRequestCloser)(nil).Run), ctx, req)
+}
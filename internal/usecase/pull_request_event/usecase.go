@@ -0,0 +1,131 @@
+package pull_request_event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	webhook_deliveries2 "pr-reviewers-service/internal/infrastructure/repository/webhook_deliveries"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/webhook_deliveries"
+	"pr-reviewers-service/internal/usecase/pull_request_close"
+	"pr-reviewers-service/internal/usecase/pull_request_create"
+	"pr-reviewers-service/internal/usecase/pull_request_merge"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+)
+
+// ignoredErrors are outcomes caused by the event content rather than by the service,
+// such events are acknowledged so the provider does not retry them.
+var ignoredErrors = []error{
+	usecase2.ErrInvalidIdentity,
+	usecase2.ErrIdentityNotFound,
+	usecase2.ErrAuthorPrNotFound,
+	usecase2.ErrPullRequestExists,
+	usecase2.ErrPullRequestNotFound,
+	usecase2.ErrPullRequestAlreadyMerged,
+	usecase2.ErrPullRequestClosed,
+}
+
+type usecase struct {
+	repDeliveries webhook_deliveries.RepositoryWebhookDeliveries
+	creator       pullRequestCreator
+	merger        pullRequestMerger
+	closer        pullRequestCloser
+	trm           trm.Manager
+}
+
+func NewUsecase(
+	repDeliveries webhook_deliveries.RepositoryWebhookDeliveries,
+	creator pullRequestCreator,
+	merger pullRequestMerger,
+	closer pullRequestCloser,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repDeliveries: repDeliveries,
+		creator:       creator,
+		merger:        merger,
+		closer:        closer,
+		trm:           trm,
+	}
+}
+
+// PullRequestID derives a stable pull request id from its provider url, so every event
+// of the same pull request addresses the same record.
+func PullRequestID(pullRequestURL string) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(pullRequestURL))
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Save webhook delivery", "provider", req.Provider, "delivery_id", req.DeliveryID, "event", req.Event)
+	_, err := u.repDeliveries.SaveWebhookDelivery(ctx, webhook_deliveries2.WebhookDeliveryIn{
+		Provider:   req.Provider,
+		DeliveryID: req.DeliveryID,
+		Event:      req.Event,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrDeliveryExists) {
+			slog.InfoContext(ctx, "Webhook delivery already processed", "provider", req.Provider, "delivery_id", req.DeliveryID)
+			return &Out{Result: ResultDuplicate, Reason: "delivery already processed"}, nil
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s %s", usecase2.ErrSaveWebhookDelivery, req.Provider, req.DeliveryID))
+	}
+
+	if req.Action == "" {
+		slog.InfoContext(ctx, "Webhook event ignored", "provider", req.Provider, "event", req.Event)
+		return &Out{Result: ResultIgnored, Reason: fmt.Sprintf("event %s is not handled", req.Event)}, nil
+	}
+
+	prID := PullRequestID(req.PullRequestURL)
+	switch req.Action {
+	case ActionOpen:
+		slog.DebugContext(ctx, "Create pull request from webhook", "pull_request_id", prID, "author", req.AuthorLogin)
+		var identity usecase2.Identity
+		identity, err = usecase2.NewIdentity(req.Provider, req.AuthorLogin)
+		if err == nil {
+			_, err = u.creator.Run(ctx, pull_request_create.In{
+				PullRequestID:   prID,
+				PullRequestName: req.PullRequestName,
+				AuthorIdentity:  &identity,
+			})
+		}
+	case ActionMerge:
+		slog.DebugContext(ctx, "Merge pull request from webhook", "pull_request_id", prID)
+		_, err = u.merger.Run(ctx, pull_request_merge.In{PullRequestID: prID})
+	case ActionClose:
+		slog.DebugContext(ctx, "Close pull request from webhook", "pull_request_id", prID)
+		_, err = u.closer.Run(ctx, pull_request_close.In{PullRequestID: prID})
+	default:
+		slog.InfoContext(ctx, "Webhook action ignored", "provider", req.Provider, "action", req.Action)
+		return &Out{Result: ResultIgnored, Reason: fmt.Sprintf("action %s is not handled", req.Action)}, nil
+	}
+	if err != nil {
+		for _, ignored := range ignoredErrors {
+			if errors.Is(err, ignored) {
+				slog.InfoContext(ctx, "Webhook event ignored", "provider", req.Provider, "event", req.Event, "reason", err.Error())
+				return &Out{Result: ResultIgnored, Reason: ignored.Error(), PullRequestID: prID}, nil
+			}
+		}
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase PullRequestEvent success")
+	return &Out{Result: ResultProcessed, PullRequestID: prID}, nil
+}
@@ -0,0 +1,207 @@
+package pull_request_event
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	webhook_deliveries2 "pr-reviewers-service/internal/infrastructure/repository/webhook_deliveries"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	webhook_deliveries "pr-reviewers-service/internal/usecase/contract/repository/webhook_deliveries/mocks"
+	"pr-reviewers-service/internal/usecase/pull_request_close"
+	"pr-reviewers-service/internal/usecase/pull_request_create"
+	mocks "pr-reviewers-service/internal/usecase/pull_request_event/mocks"
+	"pr-reviewers-service/internal/usecase/pull_request_merge"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequestEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prURL := "https://github.com/acme/service/pull/42"
+	prID := PullRequestID(prURL)
+
+	newReq := func(action string) In {
+		return In{
+			Provider:        "github",
+			DeliveryID:      "delivery-1",
+			Event:           "pull_request",
+			Action:          action,
+			PullRequestURL:  prURL,
+			PullRequestName: "Add feature",
+			AuthorLogin:     "Octocat",
+		}
+	}
+	deliveryIn := webhook_deliveries2.WebhookDeliveryIn{
+		Provider:   "github",
+		DeliveryID: "delivery-1",
+		Event:      "pull_request",
+	}
+
+	type mocksSet struct {
+		deliveries *webhook_deliveries.MockRepositoryWebhookDeliveries
+		creator    *mocks.MockpullRequestCreator
+		merger     *mocks.MockpullRequestMerger
+		closer     *mocks.MockpullRequestCloser
+		trm        *mock.MockManager
+	}
+	saveDelivery := func(m mocksSet) {
+		m.trm.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+				return f(ctx)
+			})
+		m.deliveries.EXPECT().
+			SaveWebhookDelivery(gomock.Any(), deliveryIn).
+			Return(&webhook_deliveries2.WebhookDeliveryOut{DeliveryID: "delivery-1"}, nil)
+	}
+
+	tests := []struct {
+		name          string
+		req           In
+		setupMock     func(m mocksSet)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "opened pull request is created by author identity",
+			req:  newReq(ActionOpen),
+			setupMock: func(m mocksSet) {
+				saveDelivery(m)
+				m.creator.EXPECT().
+					Run(gomock.Any(), pull_request_create.In{
+						PullRequestID:   prID,
+						PullRequestName: "Add feature",
+						AuthorIdentity:  &usecase2.Identity{Provider: "github", ExternalID: "octocat"},
+					}).
+					Return(&pull_request_create.Out{PullRequestID: prID}, nil)
+			},
+			expected: &Out{Result: ResultProcessed, PullRequestID: prID},
+		},
+		{
+			name: "merged pull request is merged",
+			req:  newReq(ActionMerge),
+			setupMock: func(m mocksSet) {
+				saveDelivery(m)
+				m.merger.EXPECT().
+					Run(gomock.Any(), pull_request_merge.In{PullRequestID: prID}).
+					Return(&pull_request_merge.Out{PullRequestID: prID}, nil)
+			},
+			expected: &Out{Result: ResultProcessed, PullRequestID: prID},
+		},
+		{
+			name: "closed pull request is closed",
+			req:  newReq(ActionClose),
+			setupMock: func(m mocksSet) {
+				saveDelivery(m)
+				m.closer.EXPECT().
+					Run(gomock.Any(), pull_request_close.In{PullRequestID: prID}).
+					Return(&pull_request_close.Out{PullRequestID: prID}, nil)
+			},
+			expected: &Out{Result: ResultProcessed, PullRequestID: prID},
+		},
+		{
+			name: "duplicate delivery is acknowledged",
+			req:  newReq(ActionOpen),
+			setupMock: func(m mocksSet) {
+				m.trm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+				m.deliveries.EXPECT().
+					SaveWebhookDelivery(gomock.Any(), deliveryIn).
+					Return(nil, repository.ErrDeliveryExists)
+			},
+			expected: &Out{Result: ResultDuplicate, Reason: "delivery already processed"},
+		},
+		{
+			name: "save delivery error",
+			req:  newReq(ActionOpen),
+			setupMock: func(m mocksSet) {
+				m.trm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+				m.deliveries.EXPECT().
+					SaveWebhookDelivery(gomock.Any(), deliveryIn).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrSaveWebhookDelivery,
+		},
+		{
+			name: "event without action is ignored",
+			req:  newReq(""),
+			setupMock: func(m mocksSet) {
+				saveDelivery(m)
+			},
+			expected: &Out{Result: ResultIgnored, Reason: "event pull_request is not handled"},
+		},
+		{
+			name: "unknown author is ignored",
+			req:  newReq(ActionOpen),
+			setupMock: func(m mocksSet) {
+				saveDelivery(m)
+				m.creator.EXPECT().
+					Run(gomock.Any(), gomock.Any()).
+					Return(nil, usecase2.ErrIdentityNotFound)
+			},
+			expected: &Out{Result: ResultIgnored, Reason: usecase2.ErrIdentityNotFound.Error(), PullRequestID: prID},
+		},
+		{
+			name: "merge of unknown pull request is ignored",
+			req:  newReq(ActionMerge),
+			setupMock: func(m mocksSet) {
+				saveDelivery(m)
+				m.merger.EXPECT().
+					Run(gomock.Any(), pull_request_merge.In{PullRequestID: prID}).
+					Return(nil, usecase2.ErrPullRequestNotFound)
+			},
+			expected: &Out{Result: ResultIgnored, Reason: usecase2.ErrPullRequestNotFound.Error(), PullRequestID: prID},
+		},
+		{
+			name: "internal error is returned",
+			req:  newReq(ActionClose),
+			setupMock: func(m mocksSet) {
+				saveDelivery(m)
+				m.closer.EXPECT().
+					Run(gomock.Any(), pull_request_close.In{PullRequestID: prID}).
+					Return(nil, usecase2.ErrUpdatePrStatus)
+			},
+			expectedError: usecase2.ErrUpdatePrStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := mocksSet{
+				deliveries: webhook_deliveries.NewMockRepositoryWebhookDeliveries(ctrl),
+				creator:    mocks.NewMockpullRequestCreator(ctrl),
+				merger:     mocks.NewMockpullRequestMerger(ctrl),
+				closer:     mocks.NewMockpullRequestCloser(ctrl),
+				trm:        mock.NewMockManager(ctrl),
+			}
+			tt.setupMock(m)
+
+			u := NewUsecase(m.deliveries, m.creator, m.merger, m.closer, m.trm)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
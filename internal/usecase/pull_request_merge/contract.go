@@ -6,8 +6,10 @@ import (
 	"github.com/google/uuid"
 )
 
+// RequesterID, when set, must be the author of the pull request; uuid.Nil means the requester has full access.
 type In struct {
 	PullRequestID uuid.UUID
+	RequesterID   uuid.UUID
 }
 
 type Out struct {
@@ -10,6 +10,7 @@ import (
 	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
 	"pr-reviewers-service/internal/logging"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
 	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
 	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
 	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
@@ -22,6 +23,7 @@ type usecase struct {
 	repPullRequests pull_requests.RepositoryPullRequests
 	repPRReviewers  pr_reviewers.RepositoryPrReviewers
 	repPRStatuses   pr_statuses.RepositoryPrStatuses
+	publisher       events.Publisher
 	trm             trm.Manager
 }
 
@@ -29,12 +31,14 @@ func NewUsecase(
 	repPullRequests pull_requests.RepositoryPullRequests,
 	repPRReviewers pr_reviewers.RepositoryPrReviewers,
 	repPRStatuses pr_statuses.RepositoryPrStatuses,
+	publisher events.Publisher,
 	trm trm.Manager,
 ) *usecase {
 	return &usecase{
 		repPullRequests: repPullRequests,
 		repPRReviewers:  repPRReviewers,
 		repPRStatuses:   repPRStatuses,
+		publisher:       publisher,
 		trm:             trm,
 	}
 }
@@ -60,6 +64,9 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		}
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetPullRequest, req.PullRequestID))
 	}
+	if req.RequesterID != uuid.Nil && req.RequesterID != existingPR.AuthorID {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s, user_id %s", usecase2.ErrNotPullRequestAuthor, existingPR.ID, req.RequesterID))
+	}
 
 	currentStatus, err := u.repPRStatuses.GetPRStatusByID(ctx, pr_statuses2.PRStatusIn{ID: existingPR.StatusID})
 	if err != nil {
@@ -93,6 +100,10 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		}, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrPullRequestAlreadyMerged, existingPR.ID))
 	}
 
+	if currentStatus.Status == usecase2.ClosedStatusValue {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrPullRequestClosed, existingPR.ID))
+	}
+
 	statusIn := pr_statuses2.PRStatusIn{
 		ID:     currentStatus.ID,
 		Status: usecase2.MergedStatusValue,
@@ -108,6 +119,17 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdatePrMergeTime, req.PullRequestID))
 	}
 
+	slog.DebugContext(ctx, "Publish merged event")
+	err = u.publisher.Publish(ctx, []usecase2.Event{{
+		Type:            usecase2.EventPullRequestMerged,
+		PullRequestID:   updatedPR.ID,
+		PullRequestName: updatedPR.Name,
+		AuthorID:        updatedPR.AuthorID,
+	}})
+	if err != nil {
+		return nil, err
+	}
+
 	slog.DebugContext(ctx, "UseCase MergePullRequest success")
 	return &Out{
 		PullRequestID:   updatedPR.ID,
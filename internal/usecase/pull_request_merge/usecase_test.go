@@ -11,6 +11,7 @@ import (
 	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
 	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
 	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
 	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
 	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
@@ -157,6 +158,27 @@ func TestPullRequestMerge(t *testing.T) {
 			},
 			expectedError: usecase2.ErrPullRequestNotFound,
 		},
+		{
+			name: "requester is not the author",
+			req:  In{PullRequestID: prID, RequesterID: reviewerID1},
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(existingPR, nil)
+
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrNotPullRequestAuthor,
+		},
 		{
 			name: "error getting pull request",
 			req:  req,
@@ -277,6 +299,39 @@ func TestPullRequestMerge(t *testing.T) {
 			},
 			expectedError: usecase2.ErrPullRequestAlreadyMerged,
 		},
+		{
+			name: "pull request closed",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(existingPR, nil)
+
+				closedStatus := &pr_statuses2.PRStatusOut{
+					ID:     statusID,
+					Status: usecase2.ClosedStatusValue,
+				}
+				mockPRStatuses.EXPECT().
+					GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+					Return(closedStatus, nil)
+
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), prID).
+					Return(&reviewers, nil)
+
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrPullRequestClosed,
+		},
 		{
 			name: "no reviewers found - empty list",
 			req:  req,
@@ -456,8 +511,10 @@ func TestPullRequestMerge(t *testing.T) {
 			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
 			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
 			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
 			mockTrm := mock.NewMockManager(ctrl)
 
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			tt.setupMock(
 				mockRepoPullRequests,
 				mockRepoPRReviewers,
@@ -469,6 +526,7 @@ func TestPullRequestMerge(t *testing.T) {
 				mockRepoPullRequests,
 				mockRepoPRReviewers,
 				mockRepoPRStatuses,
+				mockPublisher,
 				mockTrm,
 			)
 
@@ -500,3 +558,68 @@ func TestPullRequestMerge(t *testing.T) {
 		})
 	}
 }
+
+func TestPullRequestMergePublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prID := uuid.New()
+	authorID := uuid.New()
+	statusID := uuid.New()
+	openPR := &pull_requests2.PullRequestOut{ID: prID, Name: "Test PR", AuthorID: authorID, StatusID: statusID}
+	mergedPR := &pull_requests2.PullRequestOut{ID: prID, Name: "Test PR", AuthorID: authorID, StatusID: statusID, MergedAt: time.Now()}
+	publishErr := errors.New("publish error")
+
+	tests := []struct {
+		name          string
+		publishErr    error
+		expectedError error
+	}{
+		{name: "merged event is published"},
+		{name: "publish error rolls back merge", publishErr: publishErr, expectedError: publishErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoPullRequests.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(openPR, nil)
+			mockRepoPRStatuses.EXPECT().GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+				Return(&pr_statuses2.PRStatusOut{ID: statusID, Status: usecase2.OpenStatusValue}, nil)
+			mockRepoPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).
+				Return(&[]pr_reviewers2.PrReviewerOut{}, nil)
+			mockRepoPRStatuses.EXPECT().UpdatePRStatusByID(gomock.Any(), gomock.Any()).
+				Return(&pr_statuses2.PRStatusOut{ID: statusID, Status: usecase2.MergedStatusValue}, nil)
+			mockRepoPullRequests.EXPECT().MarkPullRequestMergedByID(gomock.Any(), prID).Return(mergedPR, nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{{
+					Type:            usecase2.EventPullRequestMerged,
+					PullRequestID:   prID,
+					PullRequestName: "Test PR",
+					AuthorID:        authorID,
+				}}).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockRepoPullRequests, mockRepoPRReviewers, mockRepoPRStatuses, mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), In{PullRequestID: prID})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, usecase2.MergedStatusValue, result.Status)
+		})
+	}
+}
@@ -9,13 +9,14 @@ import (
 	"pr-reviewers-service/internal/infrastructure/repository"
 	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
 	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
-	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
 	"pr-reviewers-service/internal/logging"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
 	"pr-reviewers-service/internal/usecase/contract/randomizer"
 	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
 	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
 	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
 	"pr-reviewers-service/internal/usecase/contract/repository/users"
 
 	"github.com/avito-tech/go-transaction-manager/trm/v2"
@@ -24,30 +25,36 @@ import (
 
 type usecase struct {
 	repUsers        users.RepositoryUsers
+	repTeams        teams.RepositoryTeams
 	repPullRequests pull_requests.RepositoryPullRequests
 	repPRReviewers  pr_reviewers.RepositoryPrReviewers
 	repPRStatuses   pr_statuses.RepositoryPrStatuses
-	randomizer      randomizer.Randomizer
+	selector        usecase2.ReviewerSelector
 	maxCntReviewers int
+	publisher       events.Publisher
 	trm             trm.Manager
 }
 
 func NewUsecase(
 	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
 	repPullRequests pull_requests.RepositoryPullRequests,
 	repPRReviewers pr_reviewers.RepositoryPrReviewers,
 	repPRStatuses pr_statuses.RepositoryPrStatuses,
 	randomizer randomizer.Randomizer,
 	maxCntReviewers int,
+	publisher events.Publisher,
 	trm trm.Manager,
 ) *usecase {
 	return &usecase{
 		repUsers:        repUsers,
+		repTeams:        repTeams,
 		repPullRequests: repPullRequests,
 		repPRReviewers:  repPRReviewers,
 		repPRStatuses:   repPRStatuses,
-		randomizer:      randomizer,
+		selector:        usecase2.NewReviewerSelector(repUsers, repTeams, randomizer),
 		maxCntReviewers: maxCntReviewers,
+		publisher:       publisher,
 		trm:             trm,
 	}
 }
@@ -81,6 +88,9 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 	if currentStatus.Status == usecase2.MergedStatusValue {
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrPullRequestAlreadyMerged, existingPR.ID))
 	}
+	if currentStatus.Status == usecase2.ClosedStatusValue {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrPullRequestClosed, existingPR.ID))
+	}
 
 	slog.DebugContext(ctx, "Get current reviewers")
 	currentReviewers, err := u.repPRReviewers.GetPRReviewersByPRID(ctx, existingPR.ID)
@@ -116,16 +126,18 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		}
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, existingPR.AuthorID))
 	}
-	slog.DebugContext(ctx, "Get active team members", "team_id", author.TeamID)
-	teamMembers, err := u.repUsers.GetActiveUsersByTeamID(ctx, author.TeamID)
-	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
-		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, author.TeamID))
+	excluded := []uuid.UUID{existingPR.AuthorID}
+	for _, reviewer := range *currentReviewers {
+		excluded = append(excluded, reviewer.ReviewerID)
+	}
+	selected, err := u.selector.Select(ctx, author.TeamID, 1, excluded...)
+	if err != nil {
+		return nil, err
 	}
-	availableReviewers := u.getAvailableReviewersFromTeam(*teamMembers, existingPR.AuthorID, *currentReviewers)
-	if len(availableReviewers) == 0 {
+	if len(selected) == 0 {
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrNoAvailableReviewers, author.TeamID))
 	}
-	newReviewer := u.selectRandomReviewer(availableReviewers)
+	newReviewer := selected[0].User
 
 	slog.DebugContext(ctx, "Remove old reviewer", "old_reviewer_id", req.OldUserId)
 	err = u.repPRReviewers.DeletePRReviewerByPRAndReviewer(ctx, existingPR.ID, req.OldUserId)
@@ -154,6 +166,27 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		}
 	}
 
+	slog.DebugContext(ctx, "Publish reassign events")
+	err = u.publisher.Publish(ctx, []usecase2.Event{
+		{
+			Type:            usecase2.EventReviewerUnassigned,
+			PullRequestID:   existingPR.ID,
+			PullRequestName: existingPR.Name,
+			AuthorID:        existingPR.AuthorID,
+			ReviewerID:      req.OldUserId,
+		},
+		{
+			Type:            usecase2.EventReviewerAssigned,
+			PullRequestID:   existingPR.ID,
+			PullRequestName: existingPR.Name,
+			AuthorID:        existingPR.AuthorID,
+			ReviewerID:      newReviewer.ID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	slog.DebugContext(ctx, "UseCase ReassignPullRequest success", "replaced_by", newReviewer.ID)
 	return &Out{
 		PullRequestID:     existingPR.ID,
@@ -166,39 +199,3 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		ReplacedBy:        newReviewer.ID,
 	}, nil
 }
-
-func (u *usecase) getAvailableReviewersFromTeam(
-	teamMembers []users2.UserOut,
-	authorID uuid.UUID,
-	currentReviewers []pr_reviewers2.PrReviewerOut,
-) []users2.UserOut {
-	var available []users2.UserOut
-
-	currentReviewerMap := make(map[uuid.UUID]bool)
-	for _, reviewer := range currentReviewers {
-		currentReviewerMap[reviewer.ReviewerID] = true
-	}
-
-	for _, member := range teamMembers {
-		if member.ID == authorID || !member.IsActive || currentReviewerMap[member.ID] {
-			continue
-		}
-		available = append(available, member)
-	}
-	return available
-}
-
-func (u *usecase) selectRandomReviewer(available []users2.UserOut) users2.UserOut {
-	if len(available) == 1 {
-		return available[0]
-	}
-
-	shuffled := make([]users2.UserOut, len(available))
-	copy(shuffled, available)
-
-	u.randomizer.Shuffle(len(shuffled), func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
-
-	return shuffled[0]
-}
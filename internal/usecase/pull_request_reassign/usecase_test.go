@@ -10,12 +10,15 @@ import (
 	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
 	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
 	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
 	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
 	randomizer2 "pr-reviewers-service/internal/usecase/contract/randomizer/mocks"
 	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
 	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
 	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
 	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
 
 	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
@@ -68,6 +71,11 @@ func TestPullRequestReassign(t *testing.T) {
 		IsActive: true,
 		TeamID:   teamID,
 	}
+	parentTeamID := uuid.New()
+	teamOut := &teams2.TeamOut{
+		ID:   teamID,
+		Name: "backend",
+	}
 	currentReviewers := []pr_reviewers2.PrReviewerOut{
 		{
 			ID:         uuid.New(),
@@ -131,6 +139,7 @@ func TestPullRequestReassign(t *testing.T) {
 		setupMock func(
 			mockPullRequests *pull_requests.MockRepositoryPullRequests,
 			mockUsers *users.MockRepositoryUsers,
+			mockTeams *teams.MockRepositoryTeams,
 			mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 			mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 			mockRandomizer *randomizer2.MockRandomizer,
@@ -145,6 +154,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -210,6 +220,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -299,6 +310,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -322,6 +334,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -345,6 +358,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -372,6 +386,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -393,12 +408,41 @@ func TestPullRequestReassign(t *testing.T) {
 			},
 			expectedError: usecase2.ErrPullRequestAlreadyMerged,
 		},
+		{
+			name: "pull request closed",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(existingPR, nil)
+
+				mockPRStatuses.EXPECT().
+					GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+					Return(&pr_statuses2.PRStatusOut{ID: statusID, Status: usecase2.ClosedStatusValue}, nil)
+
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrPullRequestClosed,
+		},
 		{
 			name: "error getting current reviewers",
 			req:  req,
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -430,6 +474,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -473,6 +518,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -508,6 +554,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -543,6 +590,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -582,6 +630,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -627,6 +676,10 @@ func TestPullRequestReassign(t *testing.T) {
 					GetActiveUsersByTeamID(gomock.Any(), teamID).
 					Return(&limitedTeam, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(teamOut, nil)
+
 				mockTrm.EXPECT().
 					Do(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
@@ -641,6 +694,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -686,6 +740,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -735,6 +790,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -788,6 +844,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -850,6 +907,7 @@ func TestPullRequestReassign(t *testing.T) {
 			setupMock: func(
 				mockPullRequests *pull_requests.MockRepositoryPullRequests,
 				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
 				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
 				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
 				mockRandomizer *randomizer2.MockRandomizer,
@@ -933,20 +991,150 @@ func TestPullRequestReassign(t *testing.T) {
 				ReplacedBy: newUserID,
 			},
 		},
+		{
+			name: "successful reassign falls back to parent team",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(existingPR, nil)
+
+				mockPRStatuses.EXPECT().
+					GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+					Return(currentStatus, nil)
+
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), prID).
+					Return(&currentReviewers, nil)
+
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), authorID).
+					Return(author, nil)
+
+				exhaustedTeam := []users2.UserOut{
+					{ID: authorID, Name: "author", IsActive: true, TeamID: teamID},
+					{ID: oldUserID, Name: "old_reviewer", IsActive: true, TeamID: teamID},
+					{ID: reviewerID1, Name: "reviewer1", IsActive: true, TeamID: teamID},
+				}
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&exhaustedTeam, nil)
+
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(&teams2.TeamOut{ID: teamID, Name: "backend", ParentTeamID: &parentTeamID}, nil)
+
+				parentTeamMembers := []users2.UserOut{
+					{ID: reviewerID1, Name: "reviewer1", IsActive: true, TeamID: teamID},
+					{ID: newUserID, Name: "parent_reviewer", IsActive: true, TeamID: parentTeamID},
+				}
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), parentTeamID).
+					Return(&parentTeamMembers, nil)
+
+				mockPRReviewers.EXPECT().
+					DeletePRReviewerByPRAndReviewer(gomock.Any(), prID, oldUserID).
+					Return(nil)
+
+				mockPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: prID, ReviewerID: newUserID}).
+					Return(&pr_reviewers2.PrReviewerOut{}, nil)
+
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), prID).
+					Return(&updatedReviewers, nil)
+
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expected: &Out{
+				PullRequestID:   prID,
+				PullRequestName: "Test PR",
+				AuthorID:        authorID,
+				Status:          "OPEN",
+				AssignedReviewers: []uuid.UUID{
+					reviewerID1,
+					newUserID,
+				},
+				CreatedAt:  existingPR.CreatedAt,
+				MergedAt:   existingPR.MergedAt,
+				ReplacedBy: newUserID,
+			},
+		},
+		{
+			name: "error getting team while falling back to parent team",
+			req:  req,
+			setupMock: func(
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), prID).
+					Return(existingPR, nil)
+
+				mockPRStatuses.EXPECT().
+					GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+					Return(currentStatus, nil)
+
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), prID).
+					Return(&currentReviewers, nil)
+
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), authorID).
+					Return(author, nil)
+
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{}, nil)
+
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(nil, errors.New("database error"))
+
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
 			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
 			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
 			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
 			mockRandomizer := randomizer2.NewMockRandomizer(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
 			mockTrm := mock.NewMockManager(ctrl)
 
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
 			tt.setupMock(
 				mockRepoPullRequests,
 				mockRepoUsers,
+				mockRepoTeams,
 				mockRepoPRStatuses,
 				mockRepoPRReviewers,
 				mockRandomizer,
@@ -955,11 +1143,13 @@ func TestPullRequestReassign(t *testing.T) {
 
 			u := NewUsecase(
 				mockRepoUsers,
+				mockRepoTeams,
 				mockRepoPullRequests,
 				mockRepoPRReviewers,
 				mockRepoPRStatuses,
 				mockRandomizer,
 				cntReviewers,
+				mockPublisher,
 				mockTrm,
 			)
 
@@ -992,3 +1182,103 @@ func TestPullRequestReassign(t *testing.T) {
 		})
 	}
 }
+
+func TestPullRequestReassignPublishesEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prID := uuid.New()
+	authorID := uuid.New()
+	teamID := uuid.New()
+	statusID := uuid.New()
+	oldUserID := uuid.New()
+	newUserID := uuid.New()
+	existingPR := &pull_requests2.PullRequestOut{ID: prID, Name: "Test PR", AuthorID: authorID, StatusID: statusID}
+	publishErr := errors.New("publish error")
+
+	tests := []struct {
+		name          string
+		publishErr    error
+		expectedError error
+	}{
+		{name: "unassigned and assigned events are published"},
+		{name: "publish error rolls back reassign", publishErr: publishErr, expectedError: publishErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRandomizer := randomizer2.NewMockRandomizer(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoPullRequests.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(existingPR, nil)
+			mockRepoPRStatuses.EXPECT().GetPRStatusByID(gomock.Any(), pr_statuses2.PRStatusIn{ID: statusID}).
+				Return(&pr_statuses2.PRStatusOut{ID: statusID, Status: usecase2.OpenStatusValue}, nil)
+			mockRepoPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).
+				Return(&[]pr_reviewers2.PrReviewerOut{{PRID: prID, ReviewerID: oldUserID}}, nil)
+			mockRepoUsers.EXPECT().GetUserByID(gomock.Any(), authorID).
+				Return(&users2.UserOut{ID: authorID, IsActive: true, TeamID: teamID}, nil)
+			mockRepoUsers.EXPECT().GetActiveUsersByTeamID(gomock.Any(), teamID).
+				Return(&[]users2.UserOut{
+					{ID: authorID, IsActive: true, TeamID: teamID},
+					{ID: oldUserID, IsActive: true, TeamID: teamID},
+					{ID: newUserID, IsActive: true, TeamID: teamID},
+				}, nil)
+			mockRepoPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), prID, oldUserID).Return(nil)
+			mockRepoPRReviewers.EXPECT().SavePRReviewer(gomock.Any(), gomock.Any()).
+				Return(&pr_reviewers2.PrReviewerOut{}, nil)
+			mockRepoPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).
+				Return(&[]pr_reviewers2.PrReviewerOut{{PRID: prID, ReviewerID: newUserID}}, nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{
+					{
+						Type:            usecase2.EventReviewerUnassigned,
+						PullRequestID:   prID,
+						PullRequestName: "Test PR",
+						AuthorID:        authorID,
+						ReviewerID:      oldUserID,
+					},
+					{
+						Type:            usecase2.EventReviewerAssigned,
+						PullRequestID:   prID,
+						PullRequestName: "Test PR",
+						AuthorID:        authorID,
+						ReviewerID:      newUserID,
+					},
+				}).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(
+				mockRepoUsers,
+				mockRepoTeams,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockRandomizer,
+				cntReviewers,
+				mockPublisher,
+				mockTrm,
+			)
+
+			result, err := u.Run(context.Background(), In{PullRequestID: prID, OldUserId: oldUserID})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, newUserID, result.ReplacedBy)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package review_snooze
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type In struct {
+	UserID        uuid.UUID
+	PullRequestID uuid.UUID
+	Until         time.Time
+}
+
+type Out struct {
+	UserID        uuid.UUID
+	PullRequestID uuid.UUID
+	SnoozedUntil  time.Time
+}
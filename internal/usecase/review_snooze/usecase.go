@@ -0,0 +1,100 @@
+package review_snooze
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	review_snoozes2 "pr-reviewers-service/internal/infrastructure/repository/review_snoozes"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
+	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+	"pr-reviewers-service/internal/usecase/contract/repository/review_snoozes"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+)
+
+type usecase struct {
+	repUsers         users.RepositoryUsers
+	repPullRequests  pull_requests.RepositoryPullRequests
+	repPRReviewers   pr_reviewers.RepositoryPrReviewers
+	repReviewSnoozes review_snoozes.RepositoryReviewSnoozes
+	nower            nower.Nower
+}
+
+func NewUsecase(
+	repUsers users.RepositoryUsers,
+	repPullRequests pull_requests.RepositoryPullRequests,
+	repPRReviewers pr_reviewers.RepositoryPrReviewers,
+	repReviewSnoozes review_snoozes.RepositoryReviewSnoozes,
+	nower nower.Nower,
+) *usecase {
+	return &usecase{
+		repUsers:         repUsers,
+		repPullRequests:  repPullRequests,
+		repPRReviewers:   repPRReviewers,
+		repReviewSnoozes: repReviewSnoozes,
+		nower:            nower,
+	}
+}
+
+// Run stops stale-review reminders about one PR for the reviewer until the given time,
+// a repeated call moves the end of the snooze.
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	if !req.Until.After(u.nower.Now()) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSnoozeInPast, req.Until))
+	}
+
+	slog.DebugContext(ctx, "Get user", "user_id", req.UserID)
+	if _, err := u.repUsers.GetUserByID(ctx, req.UserID); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUserNotFound, req.UserID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, req.UserID))
+	}
+
+	slog.DebugContext(ctx, "Get pull request", "pr_id", req.PullRequestID)
+	if _, err := u.repPullRequests.GetPullRequestByID(ctx, req.PullRequestID); err != nil {
+		if errors.Is(err, repository.ErrPullRequestNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrPullRequestNotFound, req.PullRequestID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetPullRequest, req.PullRequestID))
+	}
+
+	slog.DebugContext(ctx, "Get PR reviewers", "pr_id", req.PullRequestID)
+	reviewers, err := u.repPRReviewers.GetPRReviewersByPRID(ctx, req.PullRequestID)
+	if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrGetPRReviewers, req.PullRequestID))
+	}
+	assigned := false
+	if reviewers != nil {
+		for _, reviewer := range *reviewers {
+			if reviewer.ReviewerID == req.UserID {
+				assigned = true
+				break
+			}
+		}
+	}
+	if !assigned {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer_id %s", usecase2.ErrReviewerNotFound, req.UserID))
+	}
+
+	snooze, err := u.repReviewSnoozes.SaveReviewSnooze(ctx, review_snoozes2.ReviewSnoozeIn{
+		PRID:         req.PullRequestID,
+		ReviewerID:   req.UserID,
+		SnoozedUntil: req.Until,
+	})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrSaveReviewSnooze, err))
+	}
+
+	slog.DebugContext(ctx, "UseCase ReviewSnooze success", "pr_id", snooze.PRID, "until", snooze.SnoozedUntil)
+	return &Out{
+		UserID:        snooze.ReviewerID,
+		PullRequestID: snooze.PRID,
+		SnoozedUntil:  snooze.SnoozedUntil,
+	}, nil
+}
@@ -0,0 +1,180 @@
+package review_snooze
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	review_snoozes2 "pr-reviewers-service/internal/infrastructure/repository/review_snoozes"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
+	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+	review_snoozes "pr-reviewers-service/internal/usecase/contract/repository/review_snoozes/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewSnooze(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 12, 13, 9, 0, 0, 0, time.UTC)
+	until := now.Add(48 * time.Hour)
+	userID := uuid.New()
+	prID := uuid.New()
+	req := In{UserID: userID, PullRequestID: prID, Until: until}
+
+	user := &users2.UserOut{ID: userID, Name: "alice", IsActive: true}
+	pr := &pull_requests2.PullRequestOut{ID: prID, Name: "Add feature", AuthorID: uuid.New()}
+	assigned := &[]pr_reviewers2.PrReviewerOut{{PRID: prID, ReviewerID: uuid.New()}, {PRID: prID, ReviewerID: userID}}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockUsers *users.MockRepositoryUsers,
+			mockPullRequests *pull_requests.MockRepositoryPullRequests,
+			mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+			mockSnoozes *review_snoozes.MockRepositoryReviewSnoozes,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "assigned reviewer snoozes pull request",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockSnoozes *review_snoozes.MockRepositoryReviewSnoozes,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockPullRequests.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(pr, nil)
+				mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).Return(assigned, nil)
+				mockSnoozes.EXPECT().SaveReviewSnooze(gomock.Any(), review_snoozes2.ReviewSnoozeIn{
+					PRID:         prID,
+					ReviewerID:   userID,
+					SnoozedUntil: until,
+				}).Return(&review_snoozes2.ReviewSnoozeOut{PRID: prID, ReviewerID: userID, SnoozedUntil: until, CreatedAt: now}, nil)
+			},
+			expected: &Out{UserID: userID, PullRequestID: prID, SnoozedUntil: until},
+		},
+		{
+			name: "snooze in the past",
+			req:  In{UserID: userID, PullRequestID: prID, Until: now},
+			setupMock: func(*users.MockRepositoryUsers, *pull_requests.MockRepositoryPullRequests,
+				*pr_reviewers.MockRepositoryPrReviewers, *review_snoozes.MockRepositoryReviewSnoozes) {
+			},
+			expectedError: usecase2.ErrSnoozeInPast,
+		},
+		{
+			name: "user not found",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				_ *pull_requests.MockRepositoryPullRequests,
+				_ *pr_reviewers.MockRepositoryPrReviewers,
+				_ *review_snoozes.MockRepositoryReviewSnoozes,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(nil, repository.ErrUserNotFound)
+			},
+			expectedError: usecase2.ErrUserNotFound,
+		},
+		{
+			name: "pull request not found",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				_ *pr_reviewers.MockRepositoryPrReviewers,
+				_ *review_snoozes.MockRepositoryReviewSnoozes,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockPullRequests.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(nil, repository.ErrPullRequestNotFound)
+			},
+			expectedError: usecase2.ErrPullRequestNotFound,
+		},
+		{
+			name: "user is not a reviewer of pull request",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				_ *review_snoozes.MockRepositoryReviewSnoozes,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockPullRequests.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(pr, nil)
+				mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).Return(nil, repository.ErrPRReviewerNotFound)
+			},
+			expectedError: usecase2.ErrReviewerNotFound,
+		},
+		{
+			name: "get PR reviewers error",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				_ *review_snoozes.MockRepositoryReviewSnoozes,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockPullRequests.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(pr, nil)
+				mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetPRReviewers,
+		},
+		{
+			name: "save snooze error",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockSnoozes *review_snoozes.MockRepositoryReviewSnoozes,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockPullRequests.EXPECT().GetPullRequestByID(gomock.Any(), prID).Return(pr, nil)
+				mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).Return(assigned, nil)
+				mockSnoozes.EXPECT().SaveReviewSnooze(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrSaveReviewSnooze,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsers := users.NewMockRepositoryUsers(ctrl)
+			mockPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockSnoozes := review_snoozes.NewMockRepositoryReviewSnoozes(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+			mockNower.EXPECT().Now().Return(now)
+			tt.setupMock(mockUsers, mockPullRequests, mockPRReviewers, mockSnoozes)
+
+			u := NewUsecase(mockUsers, mockPullRequests, mockPRReviewers, mockSnoozes, mockNower)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package review_stream
+
+import (
+	"pr-reviewers-service/internal/infrastructure/event_bus"
+
+	"github.com/google/uuid"
+)
+
+type In struct {
+	UserID uuid.UUID
+	// LastEventID is the last event the client has seen, zero for a new stream.
+	LastEventID uuid.UUID
+}
+
+type Out struct {
+	Subscription *event_bus.Subscription
+}
@@ -0,0 +1,42 @@
+package review_stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/event_bus"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+)
+
+type usecase struct {
+	repUsers users.RepositoryUsers
+	bus      event_bus.Bus
+}
+
+func NewUsecase(repUsers users.RepositoryUsers, bus event_bus.Bus) *usecase {
+	return &usecase{
+		repUsers: repUsers,
+		bus:      bus,
+	}
+}
+
+// Run subscribes to the review stream of the user. The caller must close the subscription.
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Get user", "user_id", req.UserID)
+	if _, err := u.repUsers.GetUserByID(ctx, req.UserID); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUserNotFound, req.UserID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, req.UserID))
+	}
+
+	subscription := u.bus.Subscribe(req.UserID, req.LastEventID)
+
+	slog.DebugContext(ctx, "UseCase ReviewStream success", "replayed", len(subscription.Replay()))
+	return &Out{Subscription: subscription}, nil
+}
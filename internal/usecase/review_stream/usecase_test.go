@@ -0,0 +1,81 @@
+package review_stream
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	event_bus2 "pr-reviewers-service/internal/infrastructure/event_bus"
+	"pr-reviewers-service/internal/infrastructure/repository"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	event_bus "pr-reviewers-service/internal/usecase/contract/event_bus/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewStream(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	lastEventID := uuid.New()
+	subscription := event_bus2.New(10, 10).Subscribe(userID, uuid.Nil)
+	defer subscription.Close()
+
+	tests := []struct {
+		name          string
+		setupMock     func(mockUsers *users.MockRepositoryUsers, mockBus *event_bus.MockBus)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "subscribes to the user stream from the last event",
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockBus *event_bus.MockBus) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(&users2.UserOut{ID: userID}, nil)
+				mockBus.EXPECT().Subscribe(userID, lastEventID).Return(subscription)
+			},
+			expected: &Out{Subscription: subscription},
+		},
+		{
+			name: "user not found",
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockBus *event_bus.MockBus) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(nil, repository.ErrUserNotFound)
+			},
+			expectedError: usecase2.ErrUserNotFound,
+		},
+		{
+			name: "get user error",
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockBus *event_bus.MockBus) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetUser,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsers := users.NewMockRepositoryUsers(ctrl)
+			mockBus := event_bus.NewMockBus(ctrl)
+			tt.setupMock(mockUsers, mockBus)
+
+			result, err := NewUsecase(mockUsers, mockBus).Run(context.Background(), In{
+				UserID:      userID,
+				LastEventID: lastEventID,
+			})
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
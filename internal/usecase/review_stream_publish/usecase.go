@@ -0,0 +1,84 @@
+package review_stream_publish
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	event_bus2 "pr-reviewers-service/internal/infrastructure/event_bus"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/event_bus"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
+
+	"github.com/google/uuid"
+)
+
+type usecase struct {
+	repPRReviewers pr_reviewers.RepositoryPrReviewers
+	notifier       event_bus.Notifier
+}
+
+func NewUsecase(repPRReviewers pr_reviewers.RepositoryPrReviewers, notifier event_bus.Notifier) *usecase {
+	return &usecase{
+		repPRReviewers: repPRReviewers,
+		notifier:       notifier,
+	}
+}
+
+// Publish hands every assignment and pull request status event to the review streams of the reviewers it
+// concerns, the topic of a stream is the reviewer ID. The notification is sent in the relay transaction, so the
+// streams of every instance get the event once it is committed as published and never for a rolled back attempt.
+func (u *usecase) Publish(ctx context.Context, events []usecase2.Event) error {
+	for _, event := range events {
+		var reviewerIDs []uuid.UUID
+		switch event.Type {
+		case usecase2.EventReviewerAssigned, usecase2.EventReviewerUnassigned:
+			reviewerIDs = []uuid.UUID{event.ReviewerID}
+		case usecase2.EventPullRequestMerged, usecase2.EventPullRequestClosed:
+			slog.DebugContext(ctx, "Get PR reviewers", "pr_id", event.PullRequestID)
+			reviewers, err := u.repPRReviewers.GetPRReviewersByPRID(ctx, event.PullRequestID)
+			if err != nil {
+				return logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrGetPRReviewers, event.PullRequestID))
+			}
+			for _, reviewer := range *reviewers {
+				reviewerIDs = append(reviewerIDs, reviewer.ReviewerID)
+			}
+		default:
+			continue
+		}
+
+		data, err := json.Marshal(newPayload(event))
+		if err != nil {
+			return fmt.Errorf("encode payload: %w", err)
+		}
+		if len(reviewerIDs) == 0 {
+			continue
+		}
+		err = u.notifier.Notify(ctx, reviewerIDs, event_bus2.Message{ID: event.ID, Type: event.Type, Data: data})
+		if err != nil {
+			return logging.WrapError(ctx, fmt.Errorf("%w: event_id %s: %v", usecase2.ErrNotifyReviewStreams, event.ID, err))
+		}
+		slog.DebugContext(ctx, "Event handed to review streams", "event_id", event.ID, "reviewers", len(reviewerIDs))
+	}
+	return nil
+}
+
+func newPayload(event usecase2.Event) Payload {
+	payload := Payload{
+		EventID:    event.ID,
+		Event:      event.Type,
+		OccurredAt: event.OccurredAt,
+		PullRequest: PayloadPullRequest{
+			PullRequestID:   event.PullRequestID,
+			PullRequestName: event.PullRequestName,
+			AuthorID:        event.AuthorID,
+		},
+	}
+	if event.ReviewerID != uuid.Nil {
+		reviewerID := event.ReviewerID
+		payload.ReviewerID = &reviewerID
+	}
+	return payload
+}
@@ -0,0 +1,160 @@
+package review_stream_publish
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	event_bus2 "pr-reviewers-service/internal/infrastructure/event_bus"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	event_bus "pr-reviewers-service/internal/usecase/contract/event_bus/mocks"
+	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewStreamPublish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	occurredAt := time.Date(2025, 12, 14, 9, 0, 0, 0, time.UTC)
+	prID := uuid.New()
+	authorID := uuid.New()
+	alice := uuid.New()
+	bob := uuid.New()
+
+	assigned := usecase2.Event{
+		ID:              uuid.New(),
+		Type:            usecase2.EventReviewerAssigned,
+		PullRequestID:   prID,
+		PullRequestName: "Add feature",
+		AuthorID:        authorID,
+		ReviewerID:      alice,
+		OccurredAt:      occurredAt,
+	}
+	merged := usecase2.Event{
+		ID:              uuid.New(),
+		Type:            usecase2.EventPullRequestMerged,
+		PullRequestID:   prID,
+		PullRequestName: "Add feature",
+		AuthorID:        authorID,
+		OccurredAt:      occurredAt,
+	}
+	closed := merged
+	closed.ID = uuid.New()
+	closed.Type = usecase2.EventPullRequestClosed
+	teamRenamed := usecase2.Event{ID: uuid.New(), Type: usecase2.EventTeamRenamed, TeamName: "backend"}
+
+	message := func(event usecase2.Event) event_bus2.Message {
+		data, err := json.Marshal(newPayload(event))
+		require.NoError(t, err)
+		return event_bus2.Message{ID: event.ID, Type: event.Type, Data: data}
+	}
+
+	tests := []struct {
+		name          string
+		events        []usecase2.Event
+		setupMock     func(mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers, mockNotifier *event_bus.MockNotifier)
+		expectedError error
+	}{
+		{
+			name:   "assignment goes to the reviewer stream",
+			events: []usecase2.Event{assigned},
+			setupMock: func(mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers, mockNotifier *event_bus.MockNotifier) {
+				mockNotifier.EXPECT().Notify(gomock.Any(), []uuid.UUID{alice}, message(assigned)).Return(nil)
+			},
+		},
+		{
+			name:   "status change goes to every reviewer of the pull request",
+			events: []usecase2.Event{merged, closed},
+			setupMock: func(mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers, mockNotifier *event_bus.MockNotifier) {
+				mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).Return(&[]pr_reviewers2.PrReviewerOut{
+					{PRID: prID, ReviewerID: alice},
+					{PRID: prID, ReviewerID: bob},
+				}, nil).Times(2)
+				mockNotifier.EXPECT().Notify(gomock.Any(), []uuid.UUID{alice, bob}, message(merged)).Return(nil)
+				mockNotifier.EXPECT().Notify(gomock.Any(), []uuid.UUID{alice, bob}, message(closed)).Return(nil)
+			},
+		},
+		{
+			name:      "other events are skipped",
+			events:    []usecase2.Event{teamRenamed},
+			setupMock: func(*pr_reviewers.MockRepositoryPrReviewers, *event_bus.MockNotifier) {},
+		},
+		{
+			name:   "get PR reviewers error",
+			events: []usecase2.Event{merged},
+			setupMock: func(mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers, mockNotifier *event_bus.MockNotifier) {
+				mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetPRReviewers,
+		},
+		{
+			name:   "pull request without reviewers is skipped",
+			events: []usecase2.Event{merged},
+			setupMock: func(mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers, mockNotifier *event_bus.MockNotifier) {
+				mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).Return(&[]pr_reviewers2.PrReviewerOut{}, nil)
+			},
+		},
+		{
+			name:   "notify error",
+			events: []usecase2.Event{assigned},
+			setupMock: func(mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers, mockNotifier *event_bus.MockNotifier) {
+				mockNotifier.EXPECT().Notify(gomock.Any(), []uuid.UUID{alice}, message(assigned)).
+					Return(errors.New("payload string too long"))
+			},
+			expectedError: usecase2.ErrNotifyReviewStreams,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockNotifier := event_bus.NewMockNotifier(ctrl)
+			tt.setupMock(mockPRReviewers, mockNotifier)
+
+			err := NewUsecase(mockPRReviewers, mockNotifier).Publish(context.Background(), tt.events)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReviewStreamPayload(t *testing.T) {
+	reviewerID := uuid.New()
+	event := usecase2.Event{
+		ID:              uuid.New(),
+		Type:            usecase2.EventReviewerUnassigned,
+		PullRequestID:   uuid.New(),
+		PullRequestName: "Fix bug",
+		AuthorID:        uuid.New(),
+		ReviewerID:      reviewerID,
+		OccurredAt:      time.Date(2025, 12, 14, 9, 0, 0, 0, time.UTC),
+	}
+
+	data, err := json.Marshal(newPayload(event))
+	require.NoError(t, err)
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(data, &payload))
+	assert.Equal(t, event.ID.String(), payload["event_id"])
+	assert.Equal(t, usecase2.EventReviewerUnassigned, payload["event"])
+	assert.Equal(t, "2025-12-14T09:00:00Z", payload["occurred_at"])
+	assert.Equal(t, reviewerID.String(), payload["reviewer_id"])
+	assert.Equal(t, map[string]any{
+		"pull_request_id":   event.PullRequestID.String(),
+		"pull_request_name": "Fix bug",
+		"author_id":         event.AuthorID.String(),
+	}, payload["pull_request"])
+}
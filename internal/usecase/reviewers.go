@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	"pr-reviewers-service/internal/usecase/contract/randomizer"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/google/uuid"
+)
+
+// SelectedReviewer is a reviewer picked for a pull request, FromParentTeam is set when the pull request team
+// ran out of candidates and the reviewer comes from one of its ancestors.
+type SelectedReviewer struct {
+	User           users2.UserOut
+	FromParentTeam bool
+}
+
+// ReviewerSelector is the single place reviewers are picked from: every path that assigns or replaces reviewers
+// goes through it, so they all share the candidate rules and the parent team fallback.
+type ReviewerSelector struct {
+	repUsers   users.RepositoryUsers
+	repTeams   teams.RepositoryTeams
+	randomizer randomizer.Randomizer
+}
+
+func NewReviewerSelector(
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+	randomizer randomizer.Randomizer,
+) ReviewerSelector {
+	return ReviewerSelector{
+		repUsers:   repUsers,
+		repTeams:   repTeams,
+		randomizer: randomizer,
+	}
+}
+
+// ReviewerCandidates keeps the active users that are not excluded. Callers exclude the author and the reviewers
+// already assigned to the pull request.
+func ReviewerCandidates(members []users2.UserOut, excluded ...uuid.UUID) []users2.UserOut {
+	skip := make(map[uuid.UUID]struct{}, len(excluded))
+	for _, userID := range excluded {
+		skip[userID] = struct{}{}
+	}
+
+	var candidates []users2.UserOut
+	for _, member := range members {
+		if _, skipped := skip[member.ID]; skipped || !member.IsActive {
+			continue
+		}
+		candidates = append(candidates, member)
+	}
+	return candidates
+}
+
+// IsUnavailable reports whether the user marked themselves unavailable until a moment after now. Unavailable users
+// are not picked as reviewers.
+func IsUnavailable(user users2.UserOut, now time.Time) bool {
+	return user.UnavailableUntil != nil && user.UnavailableUntil.After(now)
+}
+
+// Select picks up to cnt random reviewers among the active members of teamID. Slots left free are filled from
+// the parent teams, starting from the closest ancestor. Fewer than cnt reviewers are returned when the whole
+// hierarchy runs out of candidates.
+func (s ReviewerSelector) Select(ctx context.Context, teamID uuid.UUID, cnt int, excluded ...uuid.UUID) ([]SelectedReviewer, error) {
+	if cnt <= 0 {
+		return []SelectedReviewer{}, nil
+	}
+	skip := make([]uuid.UUID, len(excluded), len(excluded)+cnt)
+	copy(skip, excluded)
+
+	selected := make([]SelectedReviewer, 0, cnt)
+	visited := map[uuid.UUID]struct{}{teamID: {}}
+	currentTeamID := teamID
+	for {
+		slog.DebugContext(ctx, "Get active team members", "team_id", currentTeamID)
+		members, err := s.repUsers.GetActiveUsersByTeamID(ctx, currentTeamID)
+		if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", ErrGetUsers, currentTeamID))
+		}
+		var candidates []users2.UserOut
+		if members != nil {
+			candidates = ReviewerCandidates(*members, skip...)
+		}
+		for _, reviewer := range s.PickRandom(candidates, cnt-len(selected)) {
+			selected = append(selected, SelectedReviewer{User: reviewer, FromParentTeam: currentTeamID != teamID})
+			skip = append(skip, reviewer.ID)
+		}
+		if len(selected) >= cnt {
+			return selected, nil
+		}
+
+		slog.DebugContext(ctx, "Get team to find parent", "team_id", currentTeamID)
+		team, err := s.repTeams.GetTeamByID(ctx, currentTeamID)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", ErrGetTeam, currentTeamID))
+		}
+		if team.ParentTeamID == nil {
+			break
+		}
+		if _, seen := visited[*team.ParentTeamID]; seen {
+			break
+		}
+		currentTeamID = *team.ParentTeamID
+		visited[currentTeamID] = struct{}{}
+	}
+
+	slog.WarnContext(ctx, "Not enough available reviewers found", "team_id", teamID, "need", cnt, "found", len(selected))
+	return selected, nil
+}
+
+// PickRandom picks cnt of the candidates at random, all of them when there are not more than cnt.
+func (s ReviewerSelector) PickRandom(candidates []users2.UserOut, cnt int) []users2.UserOut {
+	if len(candidates) <= cnt {
+		return candidates
+	}
+
+	shuffled := make([]users2.UserOut, len(candidates))
+	copy(shuffled, candidates)
+
+	s.randomizer.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	return shuffled[:cnt]
+}
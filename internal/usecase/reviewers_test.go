@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	randomizer "pr-reviewers-service/internal/usecase/contract/randomizer/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewerSelectorSelect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	parentTeamID := uuid.New()
+	rootTeamID := uuid.New()
+	authorID := uuid.New()
+	reviewerID := uuid.New()
+	mateID := uuid.New()
+	parentMateID := uuid.New()
+	rootMateID := uuid.New()
+
+	author := users2.UserOut{ID: authorID, IsActive: true, TeamID: teamID}
+	reviewer := users2.UserOut{ID: reviewerID, IsActive: true, TeamID: teamID}
+	mate := users2.UserOut{ID: mateID, IsActive: true, TeamID: teamID}
+	inactiveMate := users2.UserOut{ID: uuid.New(), IsActive: false, TeamID: teamID}
+	parentMate := users2.UserOut{ID: parentMateID, IsActive: true, TeamID: parentTeamID}
+	rootMate := users2.UserOut{ID: rootMateID, IsActive: true, TeamID: rootTeamID}
+
+	tests := []struct {
+		name          string
+		cnt           int
+		setupMock     func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams)
+		expected      []SelectedReviewer
+		expectedError error
+	}{
+		{
+			name: "team members are enough",
+			cnt:  1,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{author, reviewer, inactiveMate, mate}, nil)
+			},
+			expected: []SelectedReviewer{{User: mate}},
+		},
+		{
+			name: "free slots are filled from the closest ancestor on",
+			cnt:  3,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{author, reviewer, mate}, nil)
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(&teams2.TeamOut{ID: teamID, ParentTeamID: &parentTeamID}, nil)
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), parentTeamID).
+					Return(&[]users2.UserOut{mate, parentMate}, nil)
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), parentTeamID).
+					Return(&teams2.TeamOut{ID: parentTeamID, ParentTeamID: &rootTeamID}, nil)
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), rootTeamID).
+					Return(&[]users2.UserOut{rootMate}, nil)
+			},
+			expected: []SelectedReviewer{
+				{User: mate},
+				{User: parentMate, FromParentTeam: true},
+				{User: rootMate, FromParentTeam: true},
+			},
+		},
+		{
+			name: "hierarchy runs out of candidates",
+			cnt:  2,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(nil, repository.ErrUserNotFound)
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(&teams2.TeamOut{ID: teamID, ParentTeamID: &parentTeamID}, nil)
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), parentTeamID).
+					Return(&[]users2.UserOut{parentMate}, nil)
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), parentTeamID).
+					Return(&teams2.TeamOut{ID: parentTeamID}, nil)
+			},
+			expected: []SelectedReviewer{{User: parentMate, FromParentTeam: true}},
+		},
+		{
+			name: "nothing to select",
+			cnt:  0,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+			},
+			expected: []SelectedReviewer{},
+		},
+		{
+			name: "get team members error",
+			cnt:  1,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: ErrGetUsers,
+		},
+		{
+			name: "get team error",
+			cnt:  1,
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{author}, nil)
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: ErrGetTeam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsers := users.NewMockRepositoryUsers(ctrl)
+			mockTeams := teams.NewMockRepositoryTeams(ctrl)
+			tt.setupMock(mockUsers, mockTeams)
+
+			s := NewReviewerSelector(mockUsers, mockTeams, randomizer.NewMockRandomizer(ctrl))
+			result, err := s.Select(context.Background(), teamID, tt.cnt, authorID, reviewerID)
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestReviewerSelectorPickRandom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	first := users2.UserOut{ID: uuid.New(), IsActive: true}
+	second := users2.UserOut{ID: uuid.New(), IsActive: true}
+
+	mockRandomizer := randomizer.NewMockRandomizer(ctrl)
+	mockRandomizer.EXPECT().
+		Shuffle(2, gomock.Any()).
+		Do(func(n int, swap func(i, j int)) { swap(0, 1) })
+
+	s := NewReviewerSelector(users.NewMockRepositoryUsers(ctrl), teams.NewMockRepositoryTeams(ctrl), mockRandomizer)
+
+	assert.Equal(t, []users2.UserOut{first, second}, s.PickRandom([]users2.UserOut{first, second}, 2))
+	assert.Equal(t, []users2.UserOut{second}, s.PickRandom([]users2.UserOut{first, second}, 1))
+}
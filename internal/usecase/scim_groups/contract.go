@@ -0,0 +1,47 @@
+package scim_groups
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateIn struct {
+	DisplayName string
+	MemberIDs   []uuid.UUID
+}
+
+// PatchIn holds the changes to apply. ReplaceMemberIDs replaces the whole member list when set, additions and
+// removals are applied after it.
+type PatchIn struct {
+	GroupID          uuid.UUID
+	DisplayName      *string
+	ReplaceMemberIDs *[]uuid.UUID
+	AddMemberIDs     []uuid.UUID
+	RemoveMemberIDs  []uuid.UUID
+}
+
+// ListIn filters by DisplayName when set. StartIndex is 1-based as in SCIM.
+type ListIn struct {
+	DisplayName string
+	StartIndex  int
+	Count       int
+}
+
+type ListOut struct {
+	Groups       []Group
+	TotalResults int
+	StartIndex   int
+}
+
+type Member struct {
+	ID       uuid.UUID
+	UserName string
+}
+
+type Group struct {
+	ID          uuid.UUID
+	DisplayName string
+	Members     []Member
+	CreatedAt   time.Time
+}
@@ -0,0 +1,424 @@
+package scim_groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/repository/team_memberships"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+)
+
+// usecase maps SCIM Groups onto teams. Joining a group makes it the primary team of a user who is still in the
+// default team and an additional team otherwise, leaving the primary team moves the user back to the default team.
+type usecase struct {
+	repUsers           users.RepositoryUsers
+	repTeams           teams.RepositoryTeams
+	repTeamMemberships team_memberships.RepositoryTeamMemberships
+	publisher          events.Publisher
+	defaultTeam        string
+	trm                trm.Manager
+}
+
+func NewUsecase(
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+	repTeamMemberships team_memberships.RepositoryTeamMemberships,
+	publisher events.Publisher,
+	defaultTeam string,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repUsers:           repUsers,
+		repTeams:           repTeams,
+		repTeamMemberships: repTeamMemberships,
+		publisher:          publisher,
+		defaultTeam:        defaultTeam,
+		trm:                trm,
+	}
+}
+
+func (u *usecase) Create(ctx context.Context, req CreateIn) (*Group, error) {
+	var result *Group
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.create(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) create(ctx context.Context, req CreateIn) (*Group, error) {
+	if err := u.checkTeamNameFree(ctx, req.DisplayName); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Save team", "team_name", req.DisplayName)
+	team, err := u.repTeams.SaveTeam(ctx, teams2.TeamIn{Name: req.DisplayName})
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamAlreadyExists) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamAlreadyExists, req.DisplayName))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSaveTeam, req.DisplayName))
+	}
+
+	for _, memberID := range req.MemberIDs {
+		if err = u.addMember(ctx, team, memberID); err != nil {
+			return nil, err
+		}
+	}
+
+	slog.DebugContext(ctx, "Publish created event")
+	if err = u.publisher.Publish(ctx, []usecase2.Event{{Type: usecase2.EventTeamCreated, TeamName: team.Name}}); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase ScimCreateGroup success", "team_id", team.ID)
+	return u.toGroup(ctx, team)
+}
+
+func (u *usecase) Get(ctx context.Context, groupID uuid.UUID) (*Group, error) {
+	team, err := u.getTeam(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return u.toGroup(ctx, team)
+}
+
+func (u *usecase) List(ctx context.Context, req ListIn) (*ListOut, error) {
+	startIndex := max(req.StartIndex, 1)
+
+	var (
+		found []teams2.TeamOut
+		total int
+	)
+	if req.DisplayName != "" {
+		slog.DebugContext(ctx, "Get team by name", "team_name", req.DisplayName)
+		team, err := u.repTeams.GetTeamByName(ctx, req.DisplayName)
+		if err != nil && !errors.Is(err, repository.ErrTeamNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, req.DisplayName))
+		}
+		if team != nil {
+			total = 1
+			if startIndex == 1 && req.Count > 0 {
+				found = []teams2.TeamOut{*team}
+			}
+		}
+	} else {
+		var err error
+		total, err = u.repTeams.CountTeams(ctx, "")
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetTeam))
+		}
+		if req.Count > 0 {
+			slog.DebugContext(ctx, "Get teams page", "start_index", startIndex, "count", req.Count)
+			teamsPage, err := u.repTeams.GetTeamsPage(ctx, "", uint64(req.Count), uint64(startIndex-1))
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetTeam))
+			}
+			found = *teamsPage
+		}
+	}
+
+	groups := make([]Group, 0, len(found))
+	for i := range found {
+		group, err := u.toGroup(ctx, &found[i])
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, *group)
+	}
+
+	slog.DebugContext(ctx, "UseCase ScimListGroups success", "total", total, "returned", len(groups))
+	return &ListOut{
+		Groups:       groups,
+		TotalResults: total,
+		StartIndex:   startIndex,
+	}, nil
+}
+
+func (u *usecase) Patch(ctx context.Context, req PatchIn) (*Group, error) {
+	var result *Group
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.patch(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) patch(ctx context.Context, req PatchIn) (*Group, error) {
+	team, err := u.getTeam(ctx, req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	domainEvents := make([]usecase2.Event, 0)
+	if req.DisplayName != nil && *req.DisplayName != team.Name {
+		if team.Name == u.defaultTeam {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrDefaultTeamChange, team.Name))
+		}
+		if err = u.checkTeamNameFree(ctx, *req.DisplayName); err != nil {
+			return nil, err
+		}
+		slog.DebugContext(ctx, "Rename team", "team_id", team.ID, "new_team_name", *req.DisplayName)
+		team, err = u.repTeams.UpdateTeamName(ctx, team.ID, *req.DisplayName)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrUpdateTeam, req.GroupID))
+		}
+		domainEvents = append(domainEvents, usecase2.Event{Type: usecase2.EventTeamRenamed, TeamName: team.Name})
+	}
+
+	removeIDs := req.RemoveMemberIDs
+	if req.ReplaceMemberIDs != nil {
+		members, err := u.getMembers(ctx, team.ID)
+		if err != nil {
+			return nil, err
+		}
+		keep := make(map[uuid.UUID]bool, len(*req.ReplaceMemberIDs))
+		for _, memberID := range *req.ReplaceMemberIDs {
+			keep[memberID] = true
+		}
+		for _, member := range members {
+			if !keep[member.ID] {
+				removeIDs = append(removeIDs, member.ID)
+			}
+		}
+	}
+	addIDs := req.AddMemberIDs
+	if req.ReplaceMemberIDs != nil {
+		addIDs = append(append([]uuid.UUID{}, *req.ReplaceMemberIDs...), addIDs...)
+	}
+
+	for _, memberID := range addIDs {
+		if err = u.addMember(ctx, team, memberID); err != nil {
+			return nil, err
+		}
+	}
+	for _, memberID := range removeIDs {
+		if err = u.removeMember(ctx, team, memberID); err != nil {
+			return nil, err
+		}
+	}
+	if len(addIDs) > 0 || len(removeIDs) > 0 {
+		domainEvents = append(domainEvents, usecase2.Event{Type: usecase2.EventTeamUpdated, TeamName: team.Name})
+	}
+
+	if len(domainEvents) > 0 {
+		slog.DebugContext(ctx, "Publish team events", "events_count", len(domainEvents))
+		if err = u.publisher.Publish(ctx, domainEvents); err != nil {
+			return nil, err
+		}
+	}
+
+	slog.DebugContext(ctx, "UseCase ScimPatchGroup success", "team_id", team.ID)
+	return u.toGroup(ctx, team)
+}
+
+// Delete moves the members whose primary team is the group back to the default team and removes the team.
+func (u *usecase) Delete(ctx context.Context, groupID uuid.UUID) error {
+	return u.trm.Do(ctx, func(ctx context.Context) error {
+		team, err := u.getTeam(ctx, groupID)
+		if err != nil {
+			return err
+		}
+		if team.Name == u.defaultTeam {
+			return logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrDefaultTeamChange, team.Name))
+		}
+
+		members, err := u.getMembers(ctx, team.ID)
+		if err != nil {
+			return err
+		}
+		for _, member := range members {
+			if member.TeamID == team.ID {
+				if err = u.moveToDefaultTeam(ctx, member); err != nil {
+					return err
+				}
+			}
+		}
+
+		slog.DebugContext(ctx, "Delete team", "team_id", team.ID)
+		if err = u.repTeams.DeleteTeamByID(ctx, team.ID); err != nil {
+			return logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrDeleteTeam, team.ID))
+		}
+
+		slog.DebugContext(ctx, "Publish deleted event")
+		return u.publisher.Publish(ctx, []usecase2.Event{{Type: usecase2.EventTeamDeleted, TeamName: team.Name}})
+	})
+}
+
+func (u *usecase) addMember(ctx context.Context, team *teams2.TeamOut, userID uuid.UUID) error {
+	user, err := u.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.TeamID == team.ID {
+		return nil
+	}
+
+	primaryTeam, err := u.repTeams.GetTeamByID(ctx, user.TeamID)
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetTeam, user.TeamID))
+	}
+	if primaryTeam.Name == u.defaultTeam {
+		slog.DebugContext(ctx, "Move user from default team", "user_id", user.ID, "team_id", team.ID)
+		return u.setPrimaryTeam(ctx, user, team.ID)
+	}
+
+	slog.DebugContext(ctx, "Add additional team membership", "user_id", user.ID, "team_id", team.ID)
+	_, err = u.repTeamMemberships.SaveTeamMembershipsBatch(ctx, []team_memberships2.TeamMembershipIn{{
+		UserID: user.ID,
+		TeamID: team.ID,
+	}})
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrSaveTeamMemberships, user.ID))
+	}
+	return nil
+}
+
+func (u *usecase) removeMember(ctx context.Context, team *teams2.TeamOut, userID uuid.UUID) error {
+	user, err := u.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.TeamID == team.ID {
+		if team.Name == u.defaultTeam {
+			return nil
+		}
+		return u.moveToDefaultTeam(ctx, *user)
+	}
+
+	slog.DebugContext(ctx, "Delete additional team membership", "user_id", user.ID, "team_id", team.ID)
+	err = u.repTeamMemberships.DeleteTeamMembership(ctx, user.ID, team.ID)
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrDeleteTeamMembership, user.ID))
+	}
+	return nil
+}
+
+func (u *usecase) moveToDefaultTeam(ctx context.Context, user users2.UserOut) error {
+	defaultTeam, err := u.getOrCreateDefaultTeam(ctx)
+	if err != nil {
+		return err
+	}
+	slog.DebugContext(ctx, "Move user to default team", "user_id", user.ID, "team_id", defaultTeam.ID)
+	return u.setPrimaryTeam(ctx, &user, defaultTeam.ID)
+}
+
+func (u *usecase) setPrimaryTeam(ctx context.Context, user *users2.UserOut, teamID uuid.UUID) error {
+	_, err := u.repUsers.UpdateUser(ctx, users2.UserIn{
+		ID:       user.ID,
+		Name:     user.Name,
+		IsActive: user.IsActive,
+		TeamID:   teamID,
+	})
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateUser, user.ID))
+	}
+	_, err = u.repTeamMemberships.SetPrimaryTeamMembership(ctx, user.ID, teamID)
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrSaveTeamMemberships, user.ID))
+	}
+	return nil
+}
+
+func (u *usecase) getOrCreateDefaultTeam(ctx context.Context) (*teams2.TeamOut, error) {
+	slog.DebugContext(ctx, "Get default team", "team_name", u.defaultTeam)
+	team, err := u.repTeams.GetTeamByName(ctx, u.defaultTeam)
+	if err == nil {
+		return team, nil
+	}
+	if !errors.Is(err, repository.ErrTeamNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, u.defaultTeam))
+	}
+
+	slog.DebugContext(ctx, "Create default team", "team_name", u.defaultTeam)
+	team, err = u.repTeams.SaveTeam(ctx, teams2.TeamIn{Name: u.defaultTeam})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSaveTeam, u.defaultTeam))
+	}
+	return team, nil
+}
+
+func (u *usecase) checkTeamNameFree(ctx context.Context, name string) error {
+	slog.DebugContext(ctx, "Check team name is free", "team_name", name)
+	_, err := u.repTeams.GetTeamByName(ctx, name)
+	if err == nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamAlreadyExists, name))
+	}
+	if !errors.Is(err, repository.ErrTeamNotFound) {
+		return logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, name))
+	}
+	return nil
+}
+
+func (u *usecase) getTeam(ctx context.Context, teamID uuid.UUID) (*teams2.TeamOut, error) {
+	slog.DebugContext(ctx, "Get team by ID", "team_id", teamID)
+	team, err := u.repTeams.GetTeamByID(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrTeamNotFound, teamID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetTeam, teamID))
+	}
+	return team, nil
+}
+
+func (u *usecase) getUser(ctx context.Context, userID uuid.UUID) (*users2.UserOut, error) {
+	slog.DebugContext(ctx, "Get user by ID", "user_id", userID)
+	user, err := u.repUsers.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUserNotFound, userID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, userID))
+	}
+	return user, nil
+}
+
+func (u *usecase) getMembers(ctx context.Context, teamID uuid.UUID) ([]users2.UserOut, error) {
+	slog.DebugContext(ctx, "Get team members", "team_id", teamID)
+	members, err := u.repUsers.GetUsersByTeamID(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return []users2.UserOut{}, nil
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, teamID))
+	}
+	return *members, nil
+}
+
+func (u *usecase) toGroup(ctx context.Context, team *teams2.TeamOut) (*Group, error) {
+	members, err := u.getMembers(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupMembers := make([]Member, 0, len(members))
+	for _, member := range members {
+		groupMembers = append(groupMembers, Member{ID: member.ID, UserName: member.Name})
+	}
+	return &Group{
+		ID:          team.ID,
+		DisplayName: team.Name,
+		Members:     groupMembers,
+		CreatedAt:   team.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,267 @@
+package scim_groups
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	team_memberships "pr-reviewers-service/internal/usecase/contract/repository/team_memberships/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const defaultTeamName = "scim"
+
+type mocksSet struct {
+	users       *users.MockRepositoryUsers
+	teams       *teams.MockRepositoryTeams
+	memberships *team_memberships.MockRepositoryTeamMemberships
+	publisher   *events.MockPublisher
+	trm         *mock.MockManager
+}
+
+func newMocks(ctrl *gomock.Controller) mocksSet {
+	m := mocksSet{
+		users:       users.NewMockRepositoryUsers(ctrl),
+		teams:       teams.NewMockRepositoryTeams(ctrl),
+		memberships: team_memberships.NewMockRepositoryTeamMemberships(ctrl),
+		publisher:   events.NewMockPublisher(ctrl),
+		trm:         mock.NewMockManager(ctrl),
+	}
+	m.trm.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		}).AnyTimes()
+	return m
+}
+
+func (m mocksSet) usecase() *usecase {
+	return NewUsecase(m.users, m.teams, m.memberships, m.publisher, defaultTeamName, m.trm)
+}
+
+func TestCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	defaultTeam := teams2.TeamOut{ID: uuid.New(), Name: defaultTeamName}
+	otherTeam := teams2.TeamOut{ID: uuid.New(), Name: "frontend"}
+	team := teams2.TeamOut{ID: uuid.New(), Name: "Tour Guides"}
+	newcomer := users2.UserOut{ID: uuid.New(), Name: "bjensen", IsActive: true, TeamID: defaultTeam.ID}
+	veteran := users2.UserOut{ID: uuid.New(), Name: "jsmith", IsActive: true, TeamID: otherTeam.ID}
+
+	t.Run("members join as primary or additional team", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.teams.EXPECT().GetTeamByName(gomock.Any(), "Tour Guides").Return(nil, repository.ErrTeamNotFound)
+		m.teams.EXPECT().SaveTeam(gomock.Any(), teams2.TeamIn{Name: "Tour Guides"}).Return(&team, nil)
+
+		m.users.EXPECT().GetUserByID(gomock.Any(), newcomer.ID).Return(&newcomer, nil)
+		m.teams.EXPECT().GetTeamByID(gomock.Any(), defaultTeam.ID).Return(&defaultTeam, nil)
+		m.users.EXPECT().UpdateUser(gomock.Any(), users2.UserIn{
+			ID:       newcomer.ID,
+			Name:     "bjensen",
+			IsActive: true,
+			TeamID:   team.ID,
+		}).Return(&newcomer, nil)
+		m.memberships.EXPECT().SetPrimaryTeamMembership(gomock.Any(), newcomer.ID, team.ID).
+			Return(&team_memberships2.TeamMembershipOut{}, nil)
+
+		m.users.EXPECT().GetUserByID(gomock.Any(), veteran.ID).Return(&veteran, nil)
+		m.teams.EXPECT().GetTeamByID(gomock.Any(), otherTeam.ID).Return(&otherTeam, nil)
+		m.memberships.EXPECT().SaveTeamMembershipsBatch(gomock.Any(), []team_memberships2.TeamMembershipIn{{
+			UserID: veteran.ID,
+			TeamID: team.ID,
+		}}).Return(&[]team_memberships2.TeamMembershipOut{}, nil)
+
+		m.publisher.EXPECT().
+			Publish(gomock.Any(), []usecase2.Event{{Type: usecase2.EventTeamCreated, TeamName: "Tour Guides"}}).
+			Return(nil)
+		m.users.EXPECT().GetUsersByTeamID(gomock.Any(), team.ID).Return(&[]users2.UserOut{newcomer, veteran}, nil)
+
+		result, err := m.usecase().Create(context.Background(), CreateIn{
+			DisplayName: "Tour Guides",
+			MemberIDs:   []uuid.UUID{newcomer.ID, veteran.ID},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &Group{
+			ID:          team.ID,
+			DisplayName: "Tour Guides",
+			Members:     []Member{{ID: newcomer.ID, UserName: "bjensen"}, {ID: veteran.ID, UserName: "jsmith"}},
+		}, result)
+	})
+
+	t.Run("team already exists", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.teams.EXPECT().GetTeamByName(gomock.Any(), "Tour Guides").Return(&team, nil)
+
+		result, err := m.usecase().Create(context.Background(), CreateIn{DisplayName: "Tour Guides"})
+		assert.ErrorIs(t, err, usecase2.ErrTeamAlreadyExists)
+		assert.Nil(t, result)
+	})
+
+	t.Run("unknown member", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.teams.EXPECT().GetTeamByName(gomock.Any(), "Tour Guides").Return(nil, repository.ErrTeamNotFound)
+		m.teams.EXPECT().SaveTeam(gomock.Any(), gomock.Any()).Return(&team, nil)
+		m.users.EXPECT().GetUserByID(gomock.Any(), newcomer.ID).Return(nil, repository.ErrUserNotFound)
+
+		result, err := m.usecase().Create(context.Background(), CreateIn{
+			DisplayName: "Tour Guides",
+			MemberIDs:   []uuid.UUID{newcomer.ID},
+		})
+		assert.ErrorIs(t, err, usecase2.ErrUserNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestPatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	defaultTeam := teams2.TeamOut{ID: uuid.New(), Name: defaultTeamName}
+	otherTeam := teams2.TeamOut{ID: uuid.New(), Name: "frontend"}
+	team := teams2.TeamOut{ID: uuid.New(), Name: "Tour Guides"}
+	primary := users2.UserOut{ID: uuid.New(), Name: "bjensen", IsActive: true, TeamID: team.ID}
+	additional := users2.UserOut{ID: uuid.New(), Name: "jsmith", IsActive: true, TeamID: otherTeam.ID}
+
+	t.Run("removing primary member moves them to the default team", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.teams.EXPECT().GetTeamByID(gomock.Any(), team.ID).Return(&team, nil)
+		m.users.EXPECT().GetUserByID(gomock.Any(), primary.ID).Return(&primary, nil)
+		m.teams.EXPECT().GetTeamByName(gomock.Any(), defaultTeamName).Return(&defaultTeam, nil)
+		m.users.EXPECT().UpdateUser(gomock.Any(), users2.UserIn{
+			ID:       primary.ID,
+			Name:     "bjensen",
+			IsActive: true,
+			TeamID:   defaultTeam.ID,
+		}).Return(&primary, nil)
+		m.memberships.EXPECT().SetPrimaryTeamMembership(gomock.Any(), primary.ID, defaultTeam.ID).
+			Return(&team_memberships2.TeamMembershipOut{}, nil)
+		m.publisher.EXPECT().
+			Publish(gomock.Any(), []usecase2.Event{{Type: usecase2.EventTeamUpdated, TeamName: "Tour Guides"}}).
+			Return(nil)
+		m.users.EXPECT().GetUsersByTeamID(gomock.Any(), team.ID).Return(nil, repository.ErrUserNotFound)
+
+		result, err := m.usecase().Patch(context.Background(), PatchIn{
+			GroupID:         team.ID,
+			RemoveMemberIDs: []uuid.UUID{primary.ID},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result.Members)
+	})
+
+	t.Run("replace drops additional membership", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.teams.EXPECT().GetTeamByID(gomock.Any(), team.ID).Return(&team, nil)
+		m.users.EXPECT().GetUsersByTeamID(gomock.Any(), team.ID).Return(&[]users2.UserOut{primary, additional}, nil)
+		m.users.EXPECT().GetUserByID(gomock.Any(), primary.ID).Return(&primary, nil)
+		m.users.EXPECT().GetUserByID(gomock.Any(), additional.ID).Return(&additional, nil)
+		m.memberships.EXPECT().DeleteTeamMembership(gomock.Any(), additional.ID, team.ID).Return(nil)
+		m.publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil)
+		m.users.EXPECT().GetUsersByTeamID(gomock.Any(), team.ID).Return(&[]users2.UserOut{primary}, nil)
+
+		replace := []uuid.UUID{primary.ID}
+		result, err := m.usecase().Patch(context.Background(), PatchIn{
+			GroupID:          team.ID,
+			ReplaceMemberIDs: &replace,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []Member{{ID: primary.ID, UserName: "bjensen"}}, result.Members)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		m := newMocks(ctrl)
+		newName := "Guides"
+		renamed := teams2.TeamOut{ID: team.ID, Name: newName}
+		m.teams.EXPECT().GetTeamByID(gomock.Any(), team.ID).Return(&team, nil)
+		m.teams.EXPECT().GetTeamByName(gomock.Any(), newName).Return(nil, repository.ErrTeamNotFound)
+		m.teams.EXPECT().UpdateTeamName(gomock.Any(), team.ID, newName).Return(&renamed, nil)
+		m.publisher.EXPECT().
+			Publish(gomock.Any(), []usecase2.Event{{Type: usecase2.EventTeamRenamed, TeamName: newName}}).
+			Return(nil)
+		m.users.EXPECT().GetUsersByTeamID(gomock.Any(), team.ID).Return(&[]users2.UserOut{primary}, nil)
+
+		result, err := m.usecase().Patch(context.Background(), PatchIn{GroupID: team.ID, DisplayName: &newName})
+		require.NoError(t, err)
+		assert.Equal(t, newName, result.DisplayName)
+	})
+
+	t.Run("default team cannot be renamed", func(t *testing.T) {
+		m := newMocks(ctrl)
+		newName := "Guides"
+		m.teams.EXPECT().GetTeamByID(gomock.Any(), defaultTeam.ID).Return(&defaultTeam, nil)
+
+		result, err := m.usecase().Patch(context.Background(), PatchIn{GroupID: defaultTeam.ID, DisplayName: &newName})
+		assert.ErrorIs(t, err, usecase2.ErrDefaultTeamChange)
+		assert.Nil(t, result)
+	})
+
+	t.Run("group not found", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.teams.EXPECT().GetTeamByID(gomock.Any(), team.ID).Return(nil, repository.ErrTeamNotFound)
+
+		result, err := m.usecase().Patch(context.Background(), PatchIn{GroupID: team.ID})
+		assert.ErrorIs(t, err, usecase2.ErrTeamNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	defaultTeam := teams2.TeamOut{ID: uuid.New(), Name: defaultTeamName}
+	otherTeam := teams2.TeamOut{ID: uuid.New(), Name: "frontend"}
+	team := teams2.TeamOut{ID: uuid.New(), Name: "Tour Guides"}
+	primary := users2.UserOut{ID: uuid.New(), Name: "bjensen", IsActive: true, TeamID: team.ID}
+	additional := users2.UserOut{ID: uuid.New(), Name: "jsmith", IsActive: true, TeamID: otherTeam.ID}
+
+	t.Run("primary members are kept in the default team", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.teams.EXPECT().GetTeamByID(gomock.Any(), team.ID).Return(&team, nil)
+		m.users.EXPECT().GetUsersByTeamID(gomock.Any(), team.ID).Return(&[]users2.UserOut{primary, additional}, nil)
+		m.teams.EXPECT().GetTeamByName(gomock.Any(), defaultTeamName).Return(&defaultTeam, nil)
+		m.users.EXPECT().UpdateUser(gomock.Any(), users2.UserIn{
+			ID:       primary.ID,
+			Name:     "bjensen",
+			IsActive: true,
+			TeamID:   defaultTeam.ID,
+		}).Return(&primary, nil)
+		m.memberships.EXPECT().SetPrimaryTeamMembership(gomock.Any(), primary.ID, defaultTeam.ID).
+			Return(&team_memberships2.TeamMembershipOut{}, nil)
+		m.teams.EXPECT().DeleteTeamByID(gomock.Any(), team.ID).Return(nil)
+		m.publisher.EXPECT().
+			Publish(gomock.Any(), []usecase2.Event{{Type: usecase2.EventTeamDeleted, TeamName: "Tour Guides"}}).
+			Return(nil)
+
+		require.NoError(t, m.usecase().Delete(context.Background(), team.ID))
+	})
+
+	t.Run("default team cannot be deleted", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.teams.EXPECT().GetTeamByID(gomock.Any(), defaultTeam.ID).Return(&defaultTeam, nil)
+
+		assert.ErrorIs(t, m.usecase().Delete(context.Background(), defaultTeam.ID), usecase2.ErrDefaultTeamChange)
+	})
+
+	t.Run("error deleting team", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.teams.EXPECT().GetTeamByID(gomock.Any(), team.ID).Return(&team, nil)
+		m.users.EXPECT().GetUsersByTeamID(gomock.Any(), team.ID).Return(&[]users2.UserOut{additional}, nil)
+		m.teams.EXPECT().DeleteTeamByID(gomock.Any(), team.ID).Return(errors.New("db error"))
+
+		assert.ErrorIs(t, m.usecase().Delete(context.Background(), team.ID), usecase2.ErrDeleteTeam)
+	})
+}
@@ -0,0 +1,66 @@
+package scim_users
+
+import (
+	"context"
+	"time"
+
+	"pr-reviewers-service/internal/usecase/team_activate_users"
+	"pr-reviewers-service/internal/usecase/team_deactivate_users"
+
+	"github.com/google/uuid"
+)
+
+// IdentityProvider keeps the externalId assigned by the identity provider as a user identity.
+const IdentityProvider = "scim"
+
+type CreateIn struct {
+	UserName   string
+	ExternalID string
+	Active     bool
+}
+
+// PatchIn holds the attributes to change, nil means unchanged. An empty ExternalID removes it.
+type PatchIn struct {
+	UserID     uuid.UUID
+	UserName   *string
+	ExternalID *string
+	Active     *bool
+}
+
+// ListIn filters by UserName or ExternalID when set. StartIndex is 1-based as in SCIM.
+type ListIn struct {
+	UserName   string
+	ExternalID string
+	StartIndex int
+	Count      int
+}
+
+type ListOut struct {
+	Users        []User
+	TotalResults int
+	StartIndex   int
+}
+
+type Group struct {
+	ID        uuid.UUID
+	Name      string
+	IsPrimary bool
+}
+
+type User struct {
+	ID         uuid.UUID
+	UserName   string
+	ExternalID string
+	Active     bool
+	Groups     []Group
+	CreatedAt  time.Time
+}
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=scim_users usersDeactivator,usersActivator
+type usersDeactivator interface {
+	Run(ctx context.Context, req team_deactivate_users.In) (*team_deactivate_users.Out, error)
+}
+
+type usersActivator interface {
+	Run(ctx context.Context, req team_activate_users.In) (*team_activate_users.Out, error)
+}
@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+
+// Package scim_users is a generated GoMock package.
+package scim_users
+
+import (
+	context "context"
+	team_activate_users "pr-reviewers-service/internal/usecase/team_activate_users"
+	team_deactivate_users "pr-reviewers-service/internal/usecase/team_deactivate_users"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockusersDeactivator is a mock of usersDeactivator interface.
+type MockusersDeactivator struct {
+	ctrl     *gomock.Controller
+	recorder *MockusersDeactivatorMockRecorder
+}
+
+// MockusersDeactivatorMockRecorder is the mock recorder for MockusersDeactivator.
+type MockusersDeactivatorMockRecorder struct {
+	mock *MockusersDeactivator
+}
+
+// NewMockusersDeactivator creates a new mock instance.
+func NewMockusersDeactivator(ctrl *gomock.Controller) *MockusersDeactivator {
+	mock := &MockusersDeactivator{ctrl: ctrl}
+	mock.recorder = &MockusersDeactivatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockusersDeactivator) EXPECT() *MockusersDeactivatorMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockusersDeactivator) Run(ctx context.Context, req team_deactivate_users.In) (*team_deactivate_users.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*team_deactivate_users.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockusersDeactivatorMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockusersDeactivator)(nil).Run), ctx, req)
+}
+
+// MockusersActivator is a mock of usersActivator interface.
+type MockusersActivator struct {
+	ctrl     *gomock.Controller
+	recorder *MockusersActivatorMockRecorder
+}
+
+// MockusersActivatorMockRecorder is the mock recorder for MockusersActivator.
+type MockusersActivatorMockRecorder struct {
+	mock *MockusersActivator
+}
+
+// NewMockusersActivator creates a new mock instance.
+func NewMockusersActivator(ctrl *gomock.Controller) *MockusersActivator {
+	mock := &MockusersActivator{ctrl: ctrl}
+	mock.recorder = &MockusersActivatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockusersActivator) EXPECT() *MockusersActivatorMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockusersActivator) Run(ctx context.Context, req team_activate_users.In) (*team_activate_users.Out, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Run", ctx, req)
+	ret0, _ := ret[0].(*team_activate_users.Out)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockusersActivatorMockRecorder) Run(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockusersActivator)(nil).Run), ctx, req)
+}
@@ -0,0 +1,438 @@
+package scim_users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/team_memberships"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+	"pr-reviewers-service/internal/usecase/team_activate_users"
+	"pr-reviewers-service/internal/usecase/team_deactivate_users"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+)
+
+// usecase maps SCIM Users onto users. A provisioned user starts in the default team, SCIM groups
+// move them into real teams.
+type usecase struct {
+	repUsers           users.RepositoryUsers
+	repTeams           teams.RepositoryTeams
+	repTeamMemberships team_memberships.RepositoryTeamMemberships
+	repUserIdentities  user_identities.RepositoryUserIdentities
+	deactivator        usersDeactivator
+	activator          usersActivator
+	defaultTeam        string
+	trm                trm.Manager
+}
+
+func NewUsecase(
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+	repTeamMemberships team_memberships.RepositoryTeamMemberships,
+	repUserIdentities user_identities.RepositoryUserIdentities,
+	deactivator usersDeactivator,
+	activator usersActivator,
+	defaultTeam string,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repUsers:           repUsers,
+		repTeams:           repTeams,
+		repTeamMemberships: repTeamMemberships,
+		repUserIdentities:  repUserIdentities,
+		deactivator:        deactivator,
+		activator:          activator,
+		defaultTeam:        defaultTeam,
+		trm:                trm,
+	}
+}
+
+func (u *usecase) Create(ctx context.Context, req CreateIn) (*User, error) {
+	var result *User
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.create(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) create(ctx context.Context, req CreateIn) (*User, error) {
+	if err := u.checkUserNameFree(ctx, req.UserName, uuid.Nil); err != nil {
+		return nil, err
+	}
+	if req.ExternalID != "" {
+		if err := u.checkExternalIDFree(ctx, req.ExternalID, uuid.Nil); err != nil {
+			return nil, err
+		}
+	}
+
+	team, err := u.getOrCreateDefaultTeam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Save user", "team_id", team.ID)
+	savedUsers, err := u.repUsers.SaveUsersBatch(ctx, []users2.UserIn{{
+		Name:     req.UserName,
+		IsActive: req.Active,
+		TeamID:   team.ID,
+	}})
+	if err != nil || len(*savedUsers) != 1 {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSaveUsersBatch, req.UserName))
+	}
+	user := (*savedUsers)[0]
+
+	_, err = u.repTeamMemberships.SaveTeamMembershipsBatch(ctx, []team_memberships2.TeamMembershipIn{{
+		UserID:    user.ID,
+		TeamID:    team.ID,
+		IsPrimary: true,
+	}})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrSaveTeamMemberships, user.ID))
+	}
+
+	if req.ExternalID != "" {
+		if err = u.saveExternalID(ctx, user.ID, req.ExternalID); err != nil {
+			return nil, err
+		}
+	}
+
+	slog.DebugContext(ctx, "UseCase ScimCreateUser success", "user_id", user.ID)
+	return u.get(ctx, user.ID)
+}
+
+func (u *usecase) Get(ctx context.Context, userID uuid.UUID) (*User, error) {
+	return u.get(ctx, userID)
+}
+
+func (u *usecase) get(ctx context.Context, userID uuid.UUID) (*User, error) {
+	slog.DebugContext(ctx, "Get user by ID", "user_id", userID)
+	user, err := u.repUsers.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUserNotFound, userID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, userID))
+	}
+
+	result, err := u.toUsers(ctx, []users2.UserOut{*user})
+	if err != nil {
+		return nil, err
+	}
+	return &result[0], nil
+}
+
+func (u *usecase) List(ctx context.Context, req ListIn) (*ListOut, error) {
+	startIndex := max(req.StartIndex, 1)
+
+	var (
+		found []users2.UserOut
+		total int
+	)
+	switch {
+	case req.UserName != "":
+		slog.DebugContext(ctx, "Get users by name")
+		byName, err := u.repUsers.GetUsersByName(ctx, req.UserName)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetUsers))
+		}
+		total = len(*byName)
+		found = page(*byName, startIndex, req.Count)
+	case req.ExternalID != "":
+		slog.DebugContext(ctx, "Get user by external id")
+		identity, err := u.repUserIdentities.GetUserIdentity(ctx, IdentityProvider, req.ExternalID)
+		if err != nil && !errors.Is(err, repository.ErrIdentityNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetIdentities))
+		}
+		if identity != nil {
+			user, err := u.repUsers.GetUserByID(ctx, identity.UserID)
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, identity.UserID))
+			}
+			total = 1
+			found = page([]users2.UserOut{*user}, startIndex, req.Count)
+		}
+	default:
+		var err error
+		total, err = u.repUsers.CountUsers(ctx)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetUsers))
+		}
+		if req.Count > 0 {
+			slog.DebugContext(ctx, "Get users page", "start_index", startIndex, "count", req.Count)
+			usersPage, err := u.repUsers.GetUsersPage(ctx, uint64(req.Count), uint64(startIndex-1))
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetUsers))
+			}
+			found = *usersPage
+		}
+	}
+
+	result, err := u.toUsers(ctx, found)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase ScimListUsers success", "total", total, "returned", len(result))
+	return &ListOut{
+		Users:        result,
+		TotalResults: total,
+		StartIndex:   startIndex,
+	}, nil
+}
+
+// Patch changes the user attributes. Deactivation goes through team_deactivate_users so open reviews
+// are handed over to other members of the user's team the same way.
+func (u *usecase) Patch(ctx context.Context, req PatchIn) (*User, error) {
+	var result *User
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.patch(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) patch(ctx context.Context, req PatchIn) (*User, error) {
+	user, err := u.get(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.UserName != nil && *req.UserName != user.UserName {
+		if err = u.checkUserNameFree(ctx, *req.UserName, user.ID); err != nil {
+			return nil, err
+		}
+		stored, err := u.repUsers.GetUserByID(ctx, user.ID)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, user.ID))
+		}
+		slog.DebugContext(ctx, "Rename user", "user_id", user.ID)
+		_, err = u.repUsers.UpdateUser(ctx, users2.UserIn{
+			ID:       stored.ID,
+			Name:     *req.UserName,
+			IsActive: stored.IsActive,
+			TeamID:   stored.TeamID,
+		})
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateUser, user.ID))
+		}
+	}
+
+	if req.ExternalID != nil && *req.ExternalID != user.ExternalID {
+		if *req.ExternalID != "" {
+			if err = u.checkExternalIDFree(ctx, *req.ExternalID, user.ID); err != nil {
+				return nil, err
+			}
+		}
+		if user.ExternalID != "" {
+			err = u.repUserIdentities.DeleteUserIdentity(ctx, user.ID, IdentityProvider, user.ExternalID)
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrDeleteIdentity, user.ID))
+			}
+		}
+		if *req.ExternalID != "" {
+			if err = u.saveExternalID(ctx, user.ID, *req.ExternalID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if req.Active != nil && *req.Active != user.Active {
+		if err = u.setActive(ctx, user, *req.Active); err != nil {
+			return nil, err
+		}
+	}
+
+	slog.DebugContext(ctx, "UseCase ScimPatchUser success", "user_id", user.ID)
+	return u.get(ctx, user.ID)
+}
+
+// Delete hands over the open reviews of the user like a deactivation and removes the user.
+// Pull requests authored by the user are removed with them.
+func (u *usecase) Delete(ctx context.Context, userID uuid.UUID) error {
+	return u.trm.Do(ctx, func(ctx context.Context) error {
+		user, err := u.get(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if user.Active {
+			if err = u.setActive(ctx, user, false); err != nil {
+				return err
+			}
+		}
+
+		slog.DebugContext(ctx, "Delete user", "user_id", userID)
+		if err = u.repUsers.DeleteUserByID(ctx, userID); err != nil {
+			return logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrDeleteUser, userID))
+		}
+		return nil
+	})
+}
+
+func (u *usecase) setActive(ctx context.Context, user *User, active bool) error {
+	var primaryTeam string
+	for _, group := range user.Groups {
+		if group.IsPrimary {
+			primaryTeam = group.Name
+		}
+	}
+
+	slog.DebugContext(ctx, "Set user activity", "user_id", user.ID, "active", active, "team_name", primaryTeam)
+	var err error
+	if active {
+		_, err = u.activator.Run(ctx, team_activate_users.In{
+			TeamName: primaryTeam,
+			UserIDs:  []uuid.UUID{user.ID},
+		})
+	} else {
+		_, err = u.deactivator.Run(ctx, team_deactivate_users.In{
+			TeamName: primaryTeam,
+			UserIDs:  []uuid.UUID{user.ID},
+		})
+	}
+	return err
+}
+
+func (u *usecase) checkUserNameFree(ctx context.Context, userName string, owner uuid.UUID) error {
+	slog.DebugContext(ctx, "Check user name is free")
+	sameName, err := u.repUsers.GetUsersByName(ctx, userName)
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetUsers))
+	}
+	for _, user := range *sameName {
+		if user.ID != owner {
+			return logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUserNameAlreadyExists, userName))
+		}
+	}
+	return nil
+}
+
+func (u *usecase) checkExternalIDFree(ctx context.Context, externalID string, owner uuid.UUID) error {
+	slog.DebugContext(ctx, "Check external id is free")
+	identity, err := u.repUserIdentities.GetUserIdentity(ctx, IdentityProvider, externalID)
+	if err != nil {
+		if errors.Is(err, repository.ErrIdentityNotFound) {
+			return nil
+		}
+		return logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetIdentities))
+	}
+	if identity.UserID != owner {
+		return logging.WrapError(ctx, fmt.Errorf("%w: externalId %s", usecase2.ErrIdentityAlreadyLinked, externalID))
+	}
+	return nil
+}
+
+func (u *usecase) saveExternalID(ctx context.Context, userID uuid.UUID, externalID string) error {
+	_, err := u.repUserIdentities.SaveUserIdentitiesBatch(ctx, []user_identities2.UserIdentityIn{{
+		UserID:     userID,
+		Provider:   IdentityProvider,
+		ExternalID: externalID,
+	}})
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrSaveIdentities, userID))
+	}
+	return nil
+}
+
+func (u *usecase) getOrCreateDefaultTeam(ctx context.Context) (*teams2.TeamOut, error) {
+	slog.DebugContext(ctx, "Get default team", "team_name", u.defaultTeam)
+	team, err := u.repTeams.GetTeamByName(ctx, u.defaultTeam)
+	if err == nil {
+		return team, nil
+	}
+	if !errors.Is(err, repository.ErrTeamNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, u.defaultTeam))
+	}
+
+	slog.DebugContext(ctx, "Create default team", "team_name", u.defaultTeam)
+	team, err = u.repTeams.SaveTeam(ctx, teams2.TeamIn{Name: u.defaultTeam})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSaveTeam, u.defaultTeam))
+	}
+	return team, nil
+}
+
+// toUsers loads the externalId and the teams of every user.
+func (u *usecase) toUsers(ctx context.Context, found []users2.UserOut) ([]User, error) {
+	userIDs := make([]uuid.UUID, 0, len(found))
+	for _, user := range found {
+		userIDs = append(userIDs, user.ID)
+	}
+
+	identities, err := u.repUserIdentities.GetUserIdentitiesByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetIdentities))
+	}
+	externalIDs := make(map[uuid.UUID]string)
+	for _, identity := range *identities {
+		if identity.Provider == IdentityProvider {
+			externalIDs[identity.UserID] = identity.ExternalID
+		}
+	}
+
+	memberships, err := u.repTeamMemberships.GetTeamMembershipsByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetTeamMemberships))
+	}
+	teamNames := make(map[uuid.UUID]string)
+	groups := make(map[uuid.UUID][]Group)
+	for _, membership := range *memberships {
+		name, known := teamNames[membership.TeamID]
+		if !known {
+			team, err := u.repTeams.GetTeamByID(ctx, membership.TeamID)
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, membership.TeamID))
+			}
+			name = team.Name
+			teamNames[membership.TeamID] = name
+		}
+		groups[membership.UserID] = append(groups[membership.UserID], Group{
+			ID:        membership.TeamID,
+			Name:      name,
+			IsPrimary: membership.IsPrimary,
+		})
+	}
+
+	result := make([]User, 0, len(found))
+	for _, user := range found {
+		userGroups := groups[user.ID]
+		if userGroups == nil {
+			userGroups = []Group{}
+		}
+		result = append(result, User{
+			ID:         user.ID,
+			UserName:   user.Name,
+			ExternalID: externalIDs[user.ID],
+			Active:     user.IsActive,
+			Groups:     userGroups,
+			CreatedAt:  user.CreatedAt,
+		})
+	}
+	return result, nil
+}
+
+func page(found []users2.UserOut, startIndex, count int) []users2.UserOut {
+	if startIndex > len(found) {
+		return nil
+	}
+	found = found[startIndex-1:]
+	return found[:min(count, len(found))]
+}
@@ -0,0 +1,348 @@
+package scim_users
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	team_memberships "pr-reviewers-service/internal/usecase/contract/repository/team_memberships/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	user_identities "pr-reviewers-service/internal/usecase/contract/repository/user_identities/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+	mocks "pr-reviewers-service/internal/usecase/scim_users/mocks"
+	"pr-reviewers-service/internal/usecase/team_activate_users"
+	"pr-reviewers-service/internal/usecase/team_deactivate_users"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const defaultTeamName = "scim"
+
+type mocksSet struct {
+	users       *users.MockRepositoryUsers
+	teams       *teams.MockRepositoryTeams
+	memberships *team_memberships.MockRepositoryTeamMemberships
+	identities  *user_identities.MockRepositoryUserIdentities
+	deactivator *mocks.MockusersDeactivator
+	activator   *mocks.MockusersActivator
+	trm         *mock.MockManager
+}
+
+func newMocks(ctrl *gomock.Controller) mocksSet {
+	return mocksSet{
+		users:       users.NewMockRepositoryUsers(ctrl),
+		teams:       teams.NewMockRepositoryTeams(ctrl),
+		memberships: team_memberships.NewMockRepositoryTeamMemberships(ctrl),
+		identities:  user_identities.NewMockRepositoryUserIdentities(ctrl),
+		deactivator: mocks.NewMockusersDeactivator(ctrl),
+		activator:   mocks.NewMockusersActivator(ctrl),
+		trm:         mock.NewMockManager(ctrl),
+	}
+}
+
+func (m mocksSet) usecase() *usecase {
+	return NewUsecase(m.users, m.teams, m.memberships, m.identities, m.deactivator, m.activator, defaultTeamName, m.trm)
+}
+
+func (m mocksSet) inTransaction() {
+	m.trm.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
+
+// expectLoad expects the externalId and teams of a single user to be loaded.
+func (m mocksSet) expectLoad(user users2.UserOut, externalID string, team teams2.TeamOut) {
+	m.users.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(&user, nil)
+	identities := []user_identities2.UserIdentityOut{}
+	if externalID != "" {
+		identities = append(identities, user_identities2.UserIdentityOut{
+			UserID:     user.ID,
+			Provider:   IdentityProvider,
+			ExternalID: externalID,
+		})
+	}
+	m.identities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{user.ID}).Return(&identities, nil)
+	m.memberships.EXPECT().GetTeamMembershipsByUserIDs(gomock.Any(), []uuid.UUID{user.ID}).
+		Return(&[]team_memberships2.TeamMembershipOut{{UserID: user.ID, TeamID: team.ID, IsPrimary: true}}, nil)
+	m.teams.EXPECT().GetTeamByID(gomock.Any(), team.ID).Return(&team, nil)
+}
+
+func TestCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	defaultTeam := teams2.TeamOut{ID: uuid.New(), Name: defaultTeamName}
+	user := users2.UserOut{ID: uuid.New(), Name: "bjensen", IsActive: true, TeamID: defaultTeam.ID}
+	req := CreateIn{UserName: "bjensen", ExternalID: "bjensen", Active: true}
+
+	tests := []struct {
+		name          string
+		setupMock     func(m mocksSet)
+		expected      *User
+		expectedError error
+	}{
+		{
+			name: "user is created in the default team",
+			setupMock: func(m mocksSet) {
+				m.inTransaction()
+				m.users.EXPECT().GetUsersByName(gomock.Any(), "bjensen").Return(&[]users2.UserOut{}, nil)
+				m.identities.EXPECT().GetUserIdentity(gomock.Any(), IdentityProvider, "bjensen").
+					Return(nil, repository.ErrIdentityNotFound)
+				m.teams.EXPECT().GetTeamByName(gomock.Any(), defaultTeamName).Return(nil, repository.ErrTeamNotFound)
+				m.teams.EXPECT().SaveTeam(gomock.Any(), teams2.TeamIn{Name: defaultTeamName}).Return(&defaultTeam, nil)
+				m.users.EXPECT().SaveUsersBatch(gomock.Any(), []users2.UserIn{{
+					Name:     "bjensen",
+					IsActive: true,
+					TeamID:   defaultTeam.ID,
+				}}).Return(&[]users2.UserOut{user}, nil)
+				m.memberships.EXPECT().SaveTeamMembershipsBatch(gomock.Any(), []team_memberships2.TeamMembershipIn{{
+					UserID:    user.ID,
+					TeamID:    defaultTeam.ID,
+					IsPrimary: true,
+				}}).Return(&[]team_memberships2.TeamMembershipOut{}, nil)
+				m.identities.EXPECT().SaveUserIdentitiesBatch(gomock.Any(), []user_identities2.UserIdentityIn{{
+					UserID:     user.ID,
+					Provider:   IdentityProvider,
+					ExternalID: "bjensen",
+				}}).Return(&[]user_identities2.UserIdentityOut{}, nil)
+				m.expectLoad(user, "bjensen", defaultTeam)
+			},
+			expected: &User{
+				ID:         user.ID,
+				UserName:   "bjensen",
+				ExternalID: "bjensen",
+				Active:     true,
+				Groups:     []Group{{ID: defaultTeam.ID, Name: defaultTeamName, IsPrimary: true}},
+			},
+		},
+		{
+			name: "user name is taken",
+			setupMock: func(m mocksSet) {
+				m.inTransaction()
+				m.users.EXPECT().GetUsersByName(gomock.Any(), "bjensen").Return(&[]users2.UserOut{user}, nil)
+			},
+			expectedError: usecase2.ErrUserNameAlreadyExists,
+		},
+		{
+			name: "external id is linked to another user",
+			setupMock: func(m mocksSet) {
+				m.inTransaction()
+				m.users.EXPECT().GetUsersByName(gomock.Any(), "bjensen").Return(&[]users2.UserOut{}, nil)
+				m.identities.EXPECT().GetUserIdentity(gomock.Any(), IdentityProvider, "bjensen").
+					Return(&user_identities2.UserIdentityOut{UserID: uuid.New()}, nil)
+			},
+			expectedError: usecase2.ErrIdentityAlreadyLinked,
+		},
+		{
+			name: "error saving user",
+			setupMock: func(m mocksSet) {
+				m.inTransaction()
+				m.users.EXPECT().GetUsersByName(gomock.Any(), "bjensen").Return(&[]users2.UserOut{}, nil)
+				m.identities.EXPECT().GetUserIdentity(gomock.Any(), IdentityProvider, "bjensen").
+					Return(nil, repository.ErrIdentityNotFound)
+				m.teams.EXPECT().GetTeamByName(gomock.Any(), defaultTeamName).Return(&defaultTeam, nil)
+				m.users.EXPECT().SaveUsersBatch(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrSaveUsersBatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMocks(ctrl)
+			tt.setupMock(m)
+
+			result, err := m.usecase().Create(context.Background(), req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	team := teams2.TeamOut{ID: uuid.New(), Name: "backend"}
+	user := users2.UserOut{ID: uuid.New(), Name: "bjensen", IsActive: true, TeamID: team.ID}
+
+	t.Run("filter by external id", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.identities.EXPECT().GetUserIdentity(gomock.Any(), IdentityProvider, "701984").
+			Return(&user_identities2.UserIdentityOut{UserID: user.ID}, nil)
+		m.expectLoad(user, "701984", team)
+
+		result, err := m.usecase().List(context.Background(), ListIn{ExternalID: "701984", Count: 100})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.TotalResults)
+		assert.Equal(t, 1, result.StartIndex)
+		require.Len(t, result.Users, 1)
+		assert.Equal(t, "701984", result.Users[0].ExternalID)
+	})
+
+	t.Run("unknown external id", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.identities.EXPECT().GetUserIdentity(gomock.Any(), IdentityProvider, "missing").
+			Return(nil, repository.ErrIdentityNotFound)
+		m.identities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), gomock.Any()).
+			Return(&[]user_identities2.UserIdentityOut{}, nil)
+		m.memberships.EXPECT().GetTeamMembershipsByUserIDs(gomock.Any(), gomock.Any()).
+			Return(&[]team_memberships2.TeamMembershipOut{}, nil)
+
+		result, err := m.usecase().List(context.Background(), ListIn{ExternalID: "missing", Count: 100})
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.TotalResults)
+		assert.Empty(t, result.Users)
+	})
+
+	t.Run("count zero returns only the total", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.users.EXPECT().CountUsers(gomock.Any()).Return(7, nil)
+		m.identities.EXPECT().GetUserIdentitiesByUserIDs(gomock.Any(), gomock.Any()).
+			Return(&[]user_identities2.UserIdentityOut{}, nil)
+		m.memberships.EXPECT().GetTeamMembershipsByUserIDs(gomock.Any(), gomock.Any()).
+			Return(&[]team_memberships2.TeamMembershipOut{}, nil)
+
+		result, err := m.usecase().List(context.Background(), ListIn{StartIndex: 3})
+		require.NoError(t, err)
+		assert.Equal(t, 7, result.TotalResults)
+		assert.Equal(t, 3, result.StartIndex)
+		assert.Empty(t, result.Users)
+	})
+}
+
+func TestPatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	team := teams2.TeamOut{ID: uuid.New(), Name: "backend"}
+	user := users2.UserOut{ID: uuid.New(), Name: "bjensen", IsActive: true, TeamID: team.ID}
+	inactive := false
+
+	t.Run("deactivation reassigns reviews within the primary team", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.inTransaction()
+		m.expectLoad(user, "", team)
+		m.deactivator.EXPECT().Run(gomock.Any(), team_deactivate_users.In{
+			TeamName: "backend",
+			UserIDs:  []uuid.UUID{user.ID},
+		}).Return(&team_deactivate_users.Out{}, nil)
+		deactivated := user
+		deactivated.IsActive = false
+		m.expectLoad(deactivated, "", team)
+
+		result, err := m.usecase().Patch(context.Background(), PatchIn{UserID: user.ID, Active: &inactive})
+		require.NoError(t, err)
+		assert.False(t, result.Active)
+	})
+
+	t.Run("deactivation error", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.inTransaction()
+		m.expectLoad(user, "", team)
+		m.deactivator.EXPECT().Run(gomock.Any(), gomock.Any()).Return(nil, usecase2.ErrUpdateUsersBatch)
+
+		result, err := m.usecase().Patch(context.Background(), PatchIn{UserID: user.ID, Active: &inactive})
+		assert.ErrorIs(t, err, usecase2.ErrUpdateUsersBatch)
+		assert.Nil(t, result)
+	})
+
+	t.Run("activation", func(t *testing.T) {
+		m := newMocks(ctrl)
+		active := true
+		stored := user
+		stored.IsActive = false
+		m.inTransaction()
+		m.expectLoad(stored, "", team)
+		m.activator.EXPECT().Run(gomock.Any(), team_activate_users.In{
+			TeamName: "backend",
+			UserIDs:  []uuid.UUID{user.ID},
+		}).Return(&team_activate_users.Out{}, nil)
+		m.expectLoad(user, "", team)
+
+		result, err := m.usecase().Patch(context.Background(), PatchIn{UserID: user.ID, Active: &active})
+		require.NoError(t, err)
+		assert.True(t, result.Active)
+	})
+
+	t.Run("external id is replaced", func(t *testing.T) {
+		m := newMocks(ctrl)
+		externalID := "new-id"
+		m.inTransaction()
+		m.expectLoad(user, "old-id", team)
+		m.identities.EXPECT().GetUserIdentity(gomock.Any(), IdentityProvider, "new-id").
+			Return(nil, repository.ErrIdentityNotFound)
+		m.identities.EXPECT().DeleteUserIdentity(gomock.Any(), user.ID, IdentityProvider, "old-id").Return(nil)
+		m.identities.EXPECT().SaveUserIdentitiesBatch(gomock.Any(), []user_identities2.UserIdentityIn{{
+			UserID:     user.ID,
+			Provider:   IdentityProvider,
+			ExternalID: "new-id",
+		}}).Return(&[]user_identities2.UserIdentityOut{}, nil)
+		m.expectLoad(user, "new-id", team)
+
+		result, err := m.usecase().Patch(context.Background(), PatchIn{UserID: user.ID, ExternalID: &externalID})
+		require.NoError(t, err)
+		assert.Equal(t, "new-id", result.ExternalID)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.inTransaction()
+		m.users.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(nil, repository.ErrUserNotFound)
+
+		result, err := m.usecase().Patch(context.Background(), PatchIn{UserID: user.ID, Active: &inactive})
+		assert.ErrorIs(t, err, usecase2.ErrUserNotFound)
+		assert.Nil(t, result)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	team := teams2.TeamOut{ID: uuid.New(), Name: "backend"}
+	user := users2.UserOut{ID: uuid.New(), Name: "bjensen", IsActive: true, TeamID: team.ID}
+
+	t.Run("active user is deactivated before removal", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.inTransaction()
+		m.expectLoad(user, "", team)
+		m.deactivator.EXPECT().Run(gomock.Any(), team_deactivate_users.In{
+			TeamName: "backend",
+			UserIDs:  []uuid.UUID{user.ID},
+		}).Return(&team_deactivate_users.Out{}, nil)
+		m.users.EXPECT().DeleteUserByID(gomock.Any(), user.ID).Return(nil)
+
+		require.NoError(t, m.usecase().Delete(context.Background(), user.ID))
+	})
+
+	t.Run("inactive user is removed right away", func(t *testing.T) {
+		m := newMocks(ctrl)
+		inactive := user
+		inactive.IsActive = false
+		m.inTransaction()
+		m.expectLoad(inactive, "", team)
+		m.users.EXPECT().DeleteUserByID(gomock.Any(), user.ID).Return(errors.New("db error"))
+
+		assert.ErrorIs(t, m.usecase().Delete(context.Background(), user.ID), usecase2.ErrDeleteUser)
+	})
+}
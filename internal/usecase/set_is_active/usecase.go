@@ -10,6 +10,7 @@ import (
 	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
 	"pr-reviewers-service/internal/logging"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
 	"pr-reviewers-service/internal/usecase/contract/repository/teams"
 	"pr-reviewers-service/internal/usecase/contract/repository/users"
 
@@ -17,16 +18,23 @@ import (
 )
 
 type usecase struct {
-	repTeams teams.RepositoryTeams
-	repUsers users.RepositoryUsers
-	trm      trm.Manager
+	repTeams  teams.RepositoryTeams
+	repUsers  users.RepositoryUsers
+	publisher events.Publisher
+	trm       trm.Manager
 }
 
-func NewUsecase(repTeams teams.RepositoryTeams, repUsers users.RepositoryUsers, trm trm.Manager) *usecase {
+func NewUsecase(
+	repTeams teams.RepositoryTeams,
+	repUsers users.RepositoryUsers,
+	publisher events.Publisher,
+	trm trm.Manager,
+) *usecase {
 	return &usecase{
-		repTeams: repTeams,
-		repUsers: repUsers,
-		trm:      trm,
+		repTeams:  repTeams,
+		repUsers:  repUsers,
+		publisher: publisher,
+		trm:       trm,
 	}
 }
 
@@ -79,6 +87,20 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateUser, req.UserID))
 	}
 
+	eventType := usecase2.EventUserDeactivated
+	if updatedUser.IsActive {
+		eventType = usecase2.EventUserActivated
+	}
+	slog.DebugContext(ctx, "Publish user event", "type", eventType)
+	err = u.publisher.Publish(ctx, []usecase2.Event{{
+		Type:     eventType,
+		UserID:   updatedUser.ID,
+		TeamName: team.Name,
+	}})
+	if err != nil {
+		return nil, err
+	}
+
 	slog.DebugContext(ctx, "UseCase SetIsActive success", "user_id", req.UserID)
 	return &Out{
 		UserId:   updatedUser.ID,
@@ -9,6 +9,7 @@ import (
 	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
 	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
 	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
 	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
 
@@ -281,11 +282,13 @@ func TestSetIsActive(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
 			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
 			mockTrm := mock.NewMockManager(ctrl)
 
 			tt.setupMock(mockRepoTeams, mockRepoUsers, mockTrm)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
-			u := NewUsecase(mockRepoTeams, mockRepoUsers, mockTrm)
+			u := NewUsecase(mockRepoTeams, mockRepoUsers, mockPublisher, mockTrm)
 			result, err := u.Run(context.Background(), tt.req)
 
 			if tt.expectedError != nil {
@@ -307,3 +310,70 @@ func TestSetIsActive(t *testing.T) {
 		})
 	}
 }
+
+func TestSetIsActivePublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	teamID := uuid.New()
+	publishErr := errors.New("publish error")
+
+	tests := []struct {
+		name          string
+		isActive      bool
+		expectedType  string
+		publishErr    error
+		expectedError error
+	}{
+		{name: "deactivated event is published", isActive: false, expectedType: usecase2.EventUserDeactivated},
+		{name: "activated event is published", isActive: true, expectedType: usecase2.EventUserActivated},
+		{
+			name:          "publish error rolls back change",
+			isActive:      false,
+			expectedType:  usecase2.EventUserDeactivated,
+			publishErr:    publishErr,
+			expectedError: publishErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoUsers.EXPECT().GetUserByID(gomock.Any(), userID).
+				Return(&users2.UserOut{ID: userID, Name: "test-user", IsActive: !tt.isActive, TeamID: teamID}, nil)
+			mockRepoTeams.EXPECT().GetTeamByID(gomock.Any(), teamID).
+				Return(&teams2.TeamOut{ID: teamID, Name: "backend"}, nil)
+			mockRepoUsers.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).
+				Return(&users2.UserOut{ID: userID, Name: "test-user", IsActive: tt.isActive, TeamID: teamID}, nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{{
+					Type:     tt.expectedType,
+					UserID:   userID,
+					TeamName: "backend",
+				}}).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockRepoTeams, mockRepoUsers, mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), In{UserID: userID, IsActive: tt.isActive})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.isActive, result.IsActive)
+		})
+	}
+}
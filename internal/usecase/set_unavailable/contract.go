@@ -0,0 +1,21 @@
+package set_unavailable
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// In.Until nil makes the user available again.
+type In struct {
+	UserID uuid.UUID
+	Until  *time.Time
+}
+
+type Out struct {
+	UserID           uuid.UUID
+	Username         string
+	TeamName         string
+	IsActive         bool
+	UnavailableUntil *time.Time
+}
@@ -0,0 +1,71 @@
+package set_unavailable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+)
+
+type usecase struct {
+	repUsers users.RepositoryUsers
+	repTeams teams.RepositoryTeams
+	nower    nower.Nower
+}
+
+func NewUsecase(
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+	nower nower.Nower,
+) *usecase {
+	return &usecase{
+		repUsers: repUsers,
+		repTeams: repTeams,
+		nower:    nower,
+	}
+}
+
+// Run marks the user unavailable until the given time, reviewers are not picked among unavailable users.
+// Without the time the user becomes available again. The user stays active and keeps the assigned reviews.
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	if req.Until != nil && !req.Until.After(u.nower.Now()) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUnavailableInPast, req.Until))
+	}
+
+	slog.DebugContext(ctx, "Call GetUserByID", "user_id", req.UserID)
+	user, err := u.repUsers.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrUserNotFound, req.UserID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, req.UserID))
+	}
+
+	slog.DebugContext(ctx, "Call GetTeamByID", "team_id", user.TeamID)
+	team, err := u.repTeams.GetTeamByID(ctx, user.TeamID)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetTeam, user.TeamID))
+	}
+
+	slog.DebugContext(ctx, "Call SetUserUnavailableUntil", "user_id", user.ID, "until", req.Until)
+	updatedUser, err := u.repUsers.SetUserUnavailableUntil(ctx, user.ID, req.Until)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateUser, user.ID))
+	}
+
+	slog.DebugContext(ctx, "UseCase SetUnavailable success", "user_id", user.ID)
+	return &Out{
+		UserID:           updatedUser.ID,
+		Username:         updatedUser.Name,
+		TeamName:         team.Name,
+		IsActive:         updatedUser.IsActive,
+		UnavailableUntil: updatedUser.UnavailableUntil,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package set_unavailable
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetUnavailable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC)
+	until := now.Add(7 * 24 * time.Hour)
+	past := now.Add(-time.Hour)
+	userID := uuid.New()
+	teamID := uuid.New()
+
+	user := &users2.UserOut{ID: userID, Name: "alice", IsActive: true, TeamID: teamID}
+	team := &teams2.TeamOut{ID: teamID, Name: "backend"}
+
+	tests := []struct {
+		name          string
+		req           In
+		setupMock     func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "user is marked unavailable",
+			req:  In{UserID: userID, Until: &until},
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), teamID).Return(team, nil)
+				mockUsers.EXPECT().
+					SetUserUnavailableUntil(gomock.Any(), userID, &until).
+					Return(&users2.UserOut{ID: userID, Name: "alice", IsActive: true, TeamID: teamID, UnavailableUntil: &until}, nil)
+			},
+			expected: &Out{UserID: userID, Username: "alice", TeamName: "backend", IsActive: true, UnavailableUntil: &until},
+		},
+		{
+			name: "user becomes available again",
+			req:  In{UserID: userID},
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), teamID).Return(team, nil)
+				mockUsers.EXPECT().
+					SetUserUnavailableUntil(gomock.Any(), userID, nil).
+					Return(user, nil)
+			},
+			expected: &Out{UserID: userID, Username: "alice", TeamName: "backend", IsActive: true},
+		},
+		{
+			name:          "unavailability end in the past",
+			req:           In{UserID: userID, Until: &past},
+			setupMock:     func(*users.MockRepositoryUsers, *teams.MockRepositoryTeams) {},
+			expectedError: usecase2.ErrUnavailableInPast,
+		},
+		{
+			name: "user not found",
+			req:  In{UserID: userID, Until: &until},
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(nil, repository.ErrUserNotFound)
+			},
+			expectedError: usecase2.ErrUserNotFound,
+		},
+		{
+			name: "get user error",
+			req:  In{UserID: userID, Until: &until},
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetUser,
+		},
+		{
+			name: "get team error",
+			req:  In{UserID: userID, Until: &until},
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), teamID).Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
+		{
+			name: "update user error",
+			req:  In{UserID: userID, Until: &until},
+			setupMock: func(mockUsers *users.MockRepositoryUsers, mockTeams *teams.MockRepositoryTeams) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), teamID).Return(team, nil)
+				mockUsers.EXPECT().
+					SetUserUnavailableUntil(gomock.Any(), userID, &until).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrUpdateUser,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsers := users.NewMockRepositoryUsers(ctrl)
+			mockTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+			mockNower.EXPECT().Now().Return(now).AnyTimes()
+			tt.setupMock(mockUsers, mockTeams)
+
+			u := NewUsecase(mockUsers, mockTeams, mockNower)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package stale_reminders
+
+type Out struct {
+	// Reminded is the number of reviewers nudged in this run, Reminders the number of pull requests they were
+	// nudged about.
+	Reminded  int
+	Reminders int
+	// Skipped is the number of reviewers already nudged today.
+	Skipped int
+}
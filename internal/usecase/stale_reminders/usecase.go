@@ -0,0 +1,241 @@
+package stale_reminders
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	user_notifications2 "pr-reviewers-service/internal/infrastructure/repository/user_notifications"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
+	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+	"pr-reviewers-service/internal/usecase/contract/repository/review_snoozes"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_notifications"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+)
+
+type reviewKey struct {
+	prID       uuid.UUID
+	reviewerID uuid.UUID
+}
+
+type usecase struct {
+	repPRReviewers   pr_reviewers.RepositoryPrReviewers
+	repPullRequests  pull_requests.RepositoryPullRequests
+	repPRStatuses    pr_statuses.RepositoryPrStatuses
+	repUsers         users.RepositoryUsers
+	repReviewSnoozes review_snoozes.RepositoryReviewSnoozes
+	repNotifications user_notifications.RepositoryUserNotifications
+	publisher        events.Publisher
+	nower            nower.Nower
+	staleAfter       time.Duration
+	trm              trm.Manager
+}
+
+// NewUsecase builds the stale-review reminder. A review is stale when its pull request has been open
+// for longer than staleAfter.
+func NewUsecase(
+	repPRReviewers pr_reviewers.RepositoryPrReviewers,
+	repPullRequests pull_requests.RepositoryPullRequests,
+	repPRStatuses pr_statuses.RepositoryPrStatuses,
+	repUsers users.RepositoryUsers,
+	repReviewSnoozes review_snoozes.RepositoryReviewSnoozes,
+	repNotifications user_notifications.RepositoryUserNotifications,
+	publisher events.Publisher,
+	nower nower.Nower,
+	staleAfter time.Duration,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repPRReviewers:   repPRReviewers,
+		repPullRequests:  repPullRequests,
+		repPRStatuses:    repPRStatuses,
+		repUsers:         repUsers,
+		repReviewSnoozes: repReviewSnoozes,
+		repNotifications: repNotifications,
+		publisher:        publisher,
+		nower:            nower,
+		staleAfter:       staleAfter,
+		trm:              trm,
+	}
+}
+
+// Run publishes a review.reminder event for every stale review that the reviewer has not snoozed, the
+// notification channels behind the outbox relay deliver them. An active reviewer is nudged at most once per
+// UTC day: the day is claimed in the transaction that writes the events, reviews that become stale later
+// that day wait until the next one.
+func (u *usecase) Run(ctx context.Context) (*Out, error) {
+	now := u.nower.Now()
+	day := now.UTC().Truncate(24 * time.Hour)
+
+	reviews, err := u.staleReviews(ctx, now.Add(-u.staleAfter))
+	if err != nil {
+		return nil, err
+	}
+	if len(reviews) == 0 {
+		return &Out{}, nil
+	}
+
+	reviewerIDs := make([]uuid.UUID, 0, len(reviews))
+	for reviewerID := range reviews {
+		reviewerIDs = append(reviewerIDs, reviewerID)
+	}
+	sort.Slice(reviewerIDs, func(i, j int) bool { return reviewerIDs[i].String() < reviewerIDs[j].String() })
+
+	slog.DebugContext(ctx, "Get reviewers", "count", len(reviewerIDs))
+	found, err := u.repUsers.GetUsersByIDs(ctx, reviewerIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetUsers, err))
+	}
+	active := make(map[uuid.UUID]struct{}, len(*found))
+	for _, user := range *found {
+		if user.IsActive {
+			active[user.ID] = struct{}{}
+		}
+	}
+
+	out := &Out{}
+	for _, reviewerID := range reviewerIDs {
+		if _, isActive := active[reviewerID]; !isActive {
+			continue
+		}
+
+		reminded := false
+		err = u.trm.Do(ctx, func(ctx context.Context) error {
+			reminded, err = u.remind(ctx, reviewerID, reviews[reviewerID], day)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		if reminded {
+			out.Reminded++
+			out.Reminders += len(reviews[reviewerID])
+		} else {
+			out.Skipped++
+		}
+	}
+
+	slog.DebugContext(ctx, "UseCase StaleReminders success",
+		"reminded", out.Reminded, "reminders", out.Reminders, "skipped", out.Skipped)
+	return out, nil
+}
+
+func (u *usecase) remind(
+	ctx context.Context,
+	reviewerID uuid.UUID,
+	prs []pull_requests2.PullRequestOut,
+	day time.Time,
+) (bool, error) {
+	claimed, err := u.repNotifications.ClaimUserNotification(ctx, user_notifications2.UserNotificationIn{
+		Kind:   usecase2.NotificationKindStaleReminder,
+		UserID: reviewerID,
+		SentOn: day,
+	})
+	if err != nil {
+		return false, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrClaimUserNotification, reviewerID))
+	}
+	if !claimed {
+		slog.DebugContext(ctx, "Reviewer already reminded today", "reviewer_id", reviewerID)
+		return false, nil
+	}
+
+	reminders := make([]usecase2.Event, 0, len(prs))
+	for _, pr := range prs {
+		reminders = append(reminders, usecase2.Event{
+			Type:            usecase2.EventReviewReminder,
+			PullRequestID:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorID:        pr.AuthorID,
+			ReviewerID:      reviewerID,
+		})
+	}
+	if err = u.publisher.Publish(ctx, reminders); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// staleReviews returns the open pull requests created before cutoff of every reviewer, oldest first,
+// without the reviews that are snoozed.
+func (u *usecase) staleReviews(ctx context.Context, cutoff time.Time) (map[uuid.UUID][]pull_requests2.PullRequestOut, error) {
+	slog.DebugContext(ctx, "Get all PR reviewers")
+	assignments, err := u.repPRReviewers.GetAllPRReviewers(ctx)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetPRReviewers, err))
+	}
+
+	reviewersByPR := make(map[uuid.UUID][]uuid.UUID)
+	prIDs := make([]uuid.UUID, 0, len(*assignments))
+	for _, assignment := range *assignments {
+		if _, exists := reviewersByPR[assignment.PRID]; !exists {
+			prIDs = append(prIDs, assignment.PRID)
+		}
+		reviewersByPR[assignment.PRID] = append(reviewersByPR[assignment.PRID], assignment.ReviewerID)
+	}
+
+	prs, err := u.repPullRequests.GetPullRequestsByPrIDs(ctx, prIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPullRequest))
+	}
+	statusIDs := make([]uuid.UUID, 0, len(*prs))
+	for _, pr := range *prs {
+		statusIDs = append(statusIDs, pr.StatusID)
+	}
+	prStatuses, err := u.repPRStatuses.GetPRStatusesByIDs(ctx, statusIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPRStatus))
+	}
+	statusMap := make(map[uuid.UUID]string)
+	if prStatuses != nil {
+		for _, status := range *prStatuses {
+			statusMap[status.ID] = status.Status
+		}
+	}
+
+	slog.DebugContext(ctx, "Get active review snoozes")
+	snoozes, err := u.repReviewSnoozes.GetActiveReviewSnoozes(ctx)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetReviewSnoozes, err))
+	}
+	snoozed := make(map[reviewKey]struct{}, len(*snoozes))
+	for _, snooze := range *snoozes {
+		snoozed[reviewKey{prID: snooze.PRID, reviewerID: snooze.ReviewerID}] = struct{}{}
+	}
+
+	stale := make([]pull_requests2.PullRequestOut, 0, len(*prs))
+	for _, pr := range *prs {
+		if statusMap[pr.StatusID] == usecase2.OpenStatusValue && !pr.CreatedAt.After(cutoff) {
+			stale = append(stale, pr)
+		}
+	}
+	sort.Slice(stale, func(i, j int) bool {
+		if !stale[i].CreatedAt.Equal(stale[j].CreatedAt) {
+			return stale[i].CreatedAt.Before(stale[j].CreatedAt)
+		}
+		return stale[i].ID.String() < stale[j].ID.String()
+	})
+
+	reviews := make(map[uuid.UUID][]pull_requests2.PullRequestOut)
+	for _, pr := range stale {
+		for _, reviewerID := range reviewersByPR[pr.ID] {
+			if _, isSnoozed := snoozed[reviewKey{prID: pr.ID, reviewerID: reviewerID}]; isSnoozed {
+				continue
+			}
+			reviews[reviewerID] = append(reviews[reviewerID], pr)
+		}
+	}
+
+	slog.DebugContext(ctx, "Found stale reviews", "stale_prs", len(stale), "reviewers", len(reviews))
+	return reviews, nil
+}
@@ -0,0 +1,230 @@
+package stale_reminders
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	review_snoozes2 "pr-reviewers-service/internal/infrastructure/repository/review_snoozes"
+	user_notifications2 "pr-reviewers-service/internal/infrastructure/repository/user_notifications"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
+	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
+	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+	review_snoozes "pr-reviewers-service/internal/usecase/contract/repository/review_snoozes/mocks"
+	user_notifications "pr-reviewers-service/internal/usecase/contract/repository/user_notifications/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const staleAfter = 48 * time.Hour
+
+type remindersMocks struct {
+	prReviewers   *pr_reviewers.MockRepositoryPrReviewers
+	pullRequests  *pull_requests.MockRepositoryPullRequests
+	prStatuses    *pr_statuses.MockRepositoryPrStatuses
+	users         *users.MockRepositoryUsers
+	snoozes       *review_snoozes.MockRepositoryReviewSnoozes
+	notifications *user_notifications.MockRepositoryUserNotifications
+	publisher     *events.MockPublisher
+}
+
+func TestStaleReminders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 12, 13, 10, 0, 0, 0, time.UTC)
+	day := time.Date(2025, 12, 13, 0, 0, 0, 0, time.UTC)
+
+	alice := users2.UserOut{ID: uuid.New(), Name: "alice", IsActive: true}
+	bob := users2.UserOut{ID: uuid.New(), Name: "bob", IsActive: true}
+	carol := users2.UserOut{ID: uuid.New(), Name: "carol", IsActive: false}
+	dave := users2.UserOut{ID: uuid.New(), Name: "dave", IsActive: true}
+
+	openStatus := pr_statuses2.PRStatusOut{ID: uuid.New(), Status: usecase2.OpenStatusValue}
+	mergedStatus := pr_statuses2.PRStatusOut{ID: uuid.New(), Status: usecase2.MergedStatusValue}
+	oldestPR := pull_requests2.PullRequestOut{ID: uuid.New(), Name: "Oldest fix", AuthorID: dave.ID,
+		StatusID: openStatus.ID, CreatedAt: time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)}
+	stalePR := pull_requests2.PullRequestOut{ID: uuid.New(), Name: "Stale feature", AuthorID: dave.ID,
+		StatusID: openStatus.ID, CreatedAt: now.Add(-staleAfter)}
+	snoozedPR := pull_requests2.PullRequestOut{ID: uuid.New(), Name: "Snoozed refactoring", AuthorID: dave.ID,
+		StatusID: openStatus.ID, CreatedAt: time.Date(2025, 12, 5, 10, 0, 0, 0, time.UTC)}
+	freshPR := pull_requests2.PullRequestOut{ID: uuid.New(), Name: "Fresh change", AuthorID: dave.ID,
+		StatusID: openStatus.ID, CreatedAt: now.Add(-staleAfter + time.Minute)}
+	mergedPR := pull_requests2.PullRequestOut{ID: uuid.New(), Name: "Merged change", AuthorID: dave.ID,
+		StatusID: mergedStatus.ID, CreatedAt: time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)}
+
+	loadReviews := func(m remindersMocks) {
+		m.prReviewers.EXPECT().GetAllPRReviewers(gomock.Any()).Return(&[]pr_reviewers2.PrReviewerOut{
+			{PRID: stalePR.ID, ReviewerID: alice.ID},
+			{PRID: oldestPR.ID, ReviewerID: alice.ID},
+			{PRID: snoozedPR.ID, ReviewerID: alice.ID},
+			{PRID: freshPR.ID, ReviewerID: alice.ID},
+			{PRID: mergedPR.ID, ReviewerID: alice.ID},
+			{PRID: snoozedPR.ID, ReviewerID: bob.ID},
+			{PRID: oldestPR.ID, ReviewerID: carol.ID},
+		}, nil)
+		m.pullRequests.EXPECT().GetPullRequestsByPrIDs(gomock.Any(), gomock.Any()).
+			Return(&[]pull_requests2.PullRequestOut{stalePR, oldestPR, snoozedPR, freshPR, mergedPR}, nil)
+		m.prStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), gomock.Any()).
+			Return(&[]pr_statuses2.PRStatusOut{openStatus, mergedStatus}, nil)
+		m.snoozes.EXPECT().GetActiveReviewSnoozes(gomock.Any()).Return(&[]review_snoozes2.ReviewSnoozeOut{
+			{PRID: snoozedPR.ID, ReviewerID: alice.ID, SnoozedUntil: now.Add(time.Hour)},
+		}, nil)
+	}
+	loadReviewers := func(m remindersMocks) {
+		loadReviews(m)
+		m.users.EXPECT().GetUsersByIDs(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, ids []uuid.UUID) (*[]users2.UserOut, error) {
+				assert.ElementsMatch(t, []uuid.UUID{alice.ID, bob.ID, carol.ID}, ids)
+				return &[]users2.UserOut{alice, bob, carol}, nil
+			})
+	}
+	claim := func(user users2.UserOut) user_notifications2.UserNotificationIn {
+		return user_notifications2.UserNotificationIn{
+			Kind:   usecase2.NotificationKindStaleReminder,
+			UserID: user.ID,
+			SentOn: day,
+		}
+	}
+	reminder := func(pr pull_requests2.PullRequestOut, reviewer users2.UserOut) usecase2.Event {
+		return usecase2.Event{
+			Type:            usecase2.EventReviewReminder,
+			PullRequestID:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorID:        pr.AuthorID,
+			ReviewerID:      reviewer.ID,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		setupMock     func(m remindersMocks)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "active reviewers are reminded of stale not snoozed reviews, oldest first",
+			setupMock: func(m remindersMocks) {
+				loadReviewers(m)
+				m.notifications.EXPECT().ClaimUserNotification(gomock.Any(), claim(alice)).Return(true, nil)
+				m.notifications.EXPECT().ClaimUserNotification(gomock.Any(), claim(bob)).Return(true, nil)
+				m.publisher.EXPECT().Publish(gomock.Any(), []usecase2.Event{
+					reminder(oldestPR, alice),
+					reminder(stalePR, alice),
+				}).Return(nil)
+				m.publisher.EXPECT().Publish(gomock.Any(), []usecase2.Event{
+					reminder(snoozedPR, bob),
+				}).Return(nil)
+			},
+			expected: &Out{Reminded: 2, Reminders: 3},
+		},
+		{
+			name: "reviewer already reminded today is skipped",
+			setupMock: func(m remindersMocks) {
+				loadReviewers(m)
+				m.notifications.EXPECT().ClaimUserNotification(gomock.Any(), claim(alice)).Return(true, nil)
+				m.notifications.EXPECT().ClaimUserNotification(gomock.Any(), claim(bob)).Return(false, nil)
+				m.publisher.EXPECT().Publish(gomock.Any(), gomock.Len(2)).Return(nil)
+			},
+			expected: &Out{Reminded: 1, Reminders: 2, Skipped: 1},
+		},
+		{
+			name: "no stale reviews",
+			setupMock: func(m remindersMocks) {
+				m.prReviewers.EXPECT().GetAllPRReviewers(gomock.Any()).Return(&[]pr_reviewers2.PrReviewerOut{
+					{PRID: freshPR.ID, ReviewerID: alice.ID},
+				}, nil)
+				m.pullRequests.EXPECT().GetPullRequestsByPrIDs(gomock.Any(), gomock.Any()).
+					Return(&[]pull_requests2.PullRequestOut{freshPR}, nil)
+				m.prStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]pr_statuses2.PRStatusOut{openStatus}, nil)
+				m.snoozes.EXPECT().GetActiveReviewSnoozes(gomock.Any()).Return(&[]review_snoozes2.ReviewSnoozeOut{}, nil)
+			},
+			expected: &Out{},
+		},
+		{
+			name: "publish error",
+			setupMock: func(m remindersMocks) {
+				loadReviewers(m)
+				m.notifications.EXPECT().ClaimUserNotification(gomock.Any(), gomock.Any()).Return(true, nil)
+				m.publisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(usecase2.ErrSaveOutboxEvents)
+			},
+			expectedError: usecase2.ErrSaveOutboxEvents,
+		},
+		{
+			name: "claim error",
+			setupMock: func(m remindersMocks) {
+				loadReviewers(m)
+				m.notifications.EXPECT().ClaimUserNotification(gomock.Any(), gomock.Any()).Return(false, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrClaimUserNotification,
+		},
+		{
+			name: "get review snoozes error",
+			setupMock: func(m remindersMocks) {
+				m.prReviewers.EXPECT().GetAllPRReviewers(gomock.Any()).Return(&[]pr_reviewers2.PrReviewerOut{}, nil)
+				m.pullRequests.EXPECT().GetPullRequestsByPrIDs(gomock.Any(), gomock.Any()).Return(&[]pull_requests2.PullRequestOut{}, nil)
+				m.prStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), gomock.Any()).Return(&[]pr_statuses2.PRStatusOut{}, nil)
+				m.snoozes.EXPECT().GetActiveReviewSnoozes(gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetReviewSnoozes,
+		},
+		{
+			name: "get PR reviewers error",
+			setupMock: func(m remindersMocks) {
+				m.prReviewers.EXPECT().GetAllPRReviewers(gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetPRReviewers,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := remindersMocks{
+				prReviewers:   pr_reviewers.NewMockRepositoryPrReviewers(ctrl),
+				pullRequests:  pull_requests.NewMockRepositoryPullRequests(ctrl),
+				prStatuses:    pr_statuses.NewMockRepositoryPrStatuses(ctrl),
+				users:         users.NewMockRepositoryUsers(ctrl),
+				snoozes:       review_snoozes.NewMockRepositoryReviewSnoozes(ctrl),
+				notifications: user_notifications.NewMockRepositoryUserNotifications(ctrl),
+				publisher:     events.NewMockPublisher(ctrl),
+			}
+			tt.setupMock(m)
+			mockNower := nower.NewMockNower(ctrl)
+			mockNower.EXPECT().Now().Return(now)
+			mockTrm := mock.NewMockManager(ctrl)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				}).AnyTimes()
+
+			u := NewUsecase(m.prReviewers, m.pullRequests, m.prStatuses, m.users, m.snoozes, m.notifications,
+				m.publisher, mockNower, staleAfter, mockTrm)
+
+			result, err := u.Run(context.Background())
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -2,7 +2,10 @@ package stats_pr_assignments
 
 import "github.com/google/uuid"
 
-type In struct{}
+type In struct {
+	TeamName        string
+	IncludeSubteams bool
+}
 
 type Out struct {
 	Reviewers []ReviewerStats
@@ -10,21 +10,40 @@ import (
 	"pr-reviewers-service/internal/logging"
 	usecase2 "pr-reviewers-service/internal/usecase"
 	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
 
 	"github.com/google/uuid"
 )
 
 type usecase struct {
 	repPRReviewers pr_reviewers.RepositoryPrReviewers
+	repTeams       teams.RepositoryTeams
+	repUsers       users.RepositoryUsers
 }
 
-func NewUsecase(repPRReviewers pr_reviewers.RepositoryPrReviewers) *usecase {
+func NewUsecase(
+	repPRReviewers pr_reviewers.RepositoryPrReviewers,
+	repTeams teams.RepositoryTeams,
+	repUsers users.RepositoryUsers,
+) *usecase {
 	return &usecase{
 		repPRReviewers: repPRReviewers,
+		repTeams:       repTeams,
+		repUsers:       repUsers,
 	}
 }
 
-func (u *usecase) Run(ctx context.Context, _ In) (*Out, error) {
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var teamReviewers map[uuid.UUID]struct{}
+	if req.TeamName != "" {
+		var err error
+		teamReviewers, err = u.getTeamReviewers(ctx, req.TeamName, req.IncludeSubteams)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	slog.DebugContext(ctx, "Call GetAllPRReviewers")
 	allReviewers, err := u.repPRReviewers.GetAllPRReviewers(ctx)
 	if err != nil {
@@ -37,6 +56,11 @@ func (u *usecase) Run(ctx context.Context, _ In) (*Out, error) {
 	slog.DebugContext(ctx, "Calculate reviewers statistics", "total_assignments", len(*allReviewers))
 	statsMap := make(map[uuid.UUID]int)
 	for _, assignment := range *allReviewers {
+		if teamReviewers != nil {
+			if _, inTeam := teamReviewers[assignment.ReviewerID]; !inTeam {
+				continue
+			}
+		}
 		statsMap[assignment.ReviewerID]++
 	}
 
@@ -53,3 +77,60 @@ func (u *usecase) Run(ctx context.Context, _ In) (*Out, error) {
 		Reviewers: reviewers,
 	}, nil
 }
+
+// getTeamReviewers returns members of the team, with includeSubteams members of every team below it are added too.
+func (u *usecase) getTeamReviewers(ctx context.Context, teamName string, includeSubteams bool) (map[uuid.UUID]struct{}, error) {
+	slog.DebugContext(ctx, "Call GetTeamByName", "team_name", teamName)
+	team, err := u.repTeams.GetTeamByName(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamNotFound, teamName))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, teamName))
+	}
+
+	teamIDs := []uuid.UUID{team.ID}
+	if includeSubteams {
+		slog.DebugContext(ctx, "Call GetAllTeams")
+		allTeams, err := u.repTeams.GetAllTeams(ctx)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetTeam))
+		}
+
+		childrenByParent := make(map[uuid.UUID][]uuid.UUID)
+		for _, t := range *allTeams {
+			if t.ParentTeamID != nil {
+				childrenByParent[*t.ParentTeamID] = append(childrenByParent[*t.ParentTeamID], t.ID)
+			}
+		}
+
+		visited := map[uuid.UUID]struct{}{team.ID: {}}
+		for i := 0; i < len(teamIDs); i++ {
+			for _, childID := range childrenByParent[teamIDs[i]] {
+				if _, seen := visited[childID]; seen {
+					continue
+				}
+				visited[childID] = struct{}{}
+				teamIDs = append(teamIDs, childID)
+			}
+		}
+	}
+
+	reviewers := make(map[uuid.UUID]struct{})
+	for _, teamID := range teamIDs {
+		slog.DebugContext(ctx, "Call GetUsersByTeamID", "team_id", teamID)
+		members, err := u.repUsers.GetUsersByTeamID(ctx, teamID)
+		if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, teamID))
+		}
+		if members == nil {
+			continue
+		}
+		for _, member := range *members {
+			reviewers[member.ID] = struct{}{}
+		}
+	}
+
+	slog.DebugContext(ctx, "Team reviewers collected", "teams_count", len(teamIDs), "reviewers_count", len(reviewers))
+	return reviewers, nil
+}
@@ -0,0 +1,212 @@
+package stats_pr_assignments
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsPRAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	platformID := uuid.New()
+	platformDBID := uuid.New()
+	paymentsID := uuid.New()
+
+	platformUserID := uuid.New()
+	platformDBUserID := uuid.New()
+	paymentsUserID := uuid.New()
+
+	platformTeam := &teams2.TeamOut{ID: platformID, Name: "platform"}
+	allTeams := []teams2.TeamOut{
+		{ID: paymentsID, Name: "payments"},
+		*platformTeam,
+		{ID: platformDBID, Name: "platform-db", ParentTeamID: &platformID},
+	}
+
+	assignments := []pr_reviewers2.PrReviewerOut{
+		{ID: uuid.New(), PRID: uuid.New(), ReviewerID: platformUserID},
+		{ID: uuid.New(), PRID: uuid.New(), ReviewerID: platformUserID},
+		{ID: uuid.New(), PRID: uuid.New(), ReviewerID: platformDBUserID},
+		{ID: uuid.New(), PRID: uuid.New(), ReviewerID: paymentsUserID},
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+			mockTeams *teams.MockRepositoryTeams,
+			mockUsers *users.MockRepositoryUsers,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "successful stats for all reviewers",
+			req:  In{},
+			setupMock: func(
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+			) {
+				mockPRReviewers.EXPECT().
+					GetAllPRReviewers(gomock.Any()).
+					Return(&assignments, nil)
+			},
+			expected: &Out{
+				Reviewers: []ReviewerStats{
+					{ReviewerID: platformUserID, AssignmentCount: 2},
+					{ReviewerID: platformDBUserID, AssignmentCount: 1},
+					{ReviewerID: paymentsUserID, AssignmentCount: 1},
+				},
+			},
+		},
+		{
+			name: "successful stats for single team",
+			req:  In{TeamName: "platform"},
+			setupMock: func(
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), "platform").
+					Return(platformTeam, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), platformID).
+					Return(&[]users2.UserOut{{ID: platformUserID, TeamID: platformID}}, nil)
+
+				mockPRReviewers.EXPECT().
+					GetAllPRReviewers(gomock.Any()).
+					Return(&assignments, nil)
+			},
+			expected: &Out{
+				Reviewers: []ReviewerStats{
+					{ReviewerID: platformUserID, AssignmentCount: 2},
+				},
+			},
+		},
+		{
+			name: "successful stats rolled up over subtree",
+			req:  In{TeamName: "platform", IncludeSubteams: true},
+			setupMock: func(
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), "platform").
+					Return(platformTeam, nil)
+
+				mockTeams.EXPECT().
+					GetAllTeams(gomock.Any()).
+					Return(&allTeams, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), platformID).
+					Return(&[]users2.UserOut{{ID: platformUserID, TeamID: platformID}}, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), platformDBID).
+					Return(&[]users2.UserOut{{ID: platformDBUserID, TeamID: platformDBID}}, nil)
+
+				mockPRReviewers.EXPECT().
+					GetAllPRReviewers(gomock.Any()).
+					Return(&assignments, nil)
+			},
+			expected: &Out{
+				Reviewers: []ReviewerStats{
+					{ReviewerID: platformUserID, AssignmentCount: 2},
+					{ReviewerID: platformDBUserID, AssignmentCount: 1},
+				},
+			},
+		},
+		{
+			name: "team not found",
+			req:  In{TeamName: "unknown"},
+			setupMock: func(
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), "unknown").
+					Return(nil, repository.ErrTeamNotFound)
+			},
+			expectedError: usecase2.ErrTeamNotFound,
+		},
+		{
+			name: "error getting team members",
+			req:  In{TeamName: "platform"},
+			setupMock: func(
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), "platform").
+					Return(platformTeam, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), platformID).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetUsers,
+		},
+		{
+			name: "no assignments found",
+			req:  In{},
+			setupMock: func(
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+			) {
+				mockPRReviewers.EXPECT().
+					GetAllPRReviewers(gomock.Any()).
+					Return(nil, repository.ErrPRReviewerNotFound)
+			},
+			expectedError: usecase2.ErrPRsReviewersNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+
+			tt.setupMock(mockRepoPRReviewers, mockRepoTeams, mockRepoUsers)
+
+			u := NewUsecase(mockRepoPRReviewers, mockRepoTeams, mockRepoUsers)
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.ElementsMatch(t, tt.expected.Reviewers, result.Reviewers)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package team_activate_users
+
+import "github.com/google/uuid"
+
+type In struct {
+	TeamName string
+	UserIDs  []uuid.UUID
+	Backfill bool
+}
+
+type TeamMember struct {
+	UserID   uuid.UUID
+	Username string
+	IsActive bool
+}
+
+type Team struct {
+	TeamName string
+	Members  []TeamMember
+}
+
+type BackfilledPullRequest struct {
+	PullRequestID   uuid.UUID
+	PullRequestName string
+	AuthorID        uuid.UUID
+	AddedReviewers  []uuid.UUID
+	Understaffed    bool
+}
+
+type Out struct {
+	Team                   Team
+	BackfilledPullRequests []BackfilledPullRequest
+}
@@ -0,0 +1,355 @@
+package team_activate_users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
+	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+)
+
+type usecase struct {
+	repTeams        teams.RepositoryTeams
+	repUsers        users.RepositoryUsers
+	repPullRequests pull_requests.RepositoryPullRequests
+	repPRReviewers  pr_reviewers.RepositoryPrReviewers
+	repPRStatuses   pr_statuses.RepositoryPrStatuses
+	maxCntReviewers int
+	nower           nower.Nower
+	publisher       events.Publisher
+	trm             trm.Manager
+}
+
+func NewUsecase(
+	repTeams teams.RepositoryTeams,
+	repUsers users.RepositoryUsers,
+	repPullRequests pull_requests.RepositoryPullRequests,
+	repPRReviewers pr_reviewers.RepositoryPrReviewers,
+	repPRStatuses pr_statuses.RepositoryPrStatuses,
+	maxCntReviewers int,
+	nower nower.Nower,
+	publisher events.Publisher,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repTeams:        repTeams,
+		repUsers:        repUsers,
+		repPullRequests: repPullRequests,
+		repPRReviewers:  repPRReviewers,
+		repPRStatuses:   repPRStatuses,
+		maxCntReviewers: maxCntReviewers,
+		nower:           nower,
+		publisher:       publisher,
+		trm:             trm,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Get team by name", "team_name", req.TeamName)
+	team, err := u.repTeams.GetTeamByName(ctx, req.TeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamNotFound, req.TeamName))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, req.TeamName))
+	}
+
+	slog.DebugContext(ctx, "Get users by IDs", "user_ids_count", len(req.UserIDs))
+	existingUsers, err := u.repUsers.GetUsersByIDs(ctx, req.UserIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetUsers))
+	}
+	if existingUsers == nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrUsersByIDsNotFound))
+	}
+
+	slog.DebugContext(ctx, "Get team members", "team_id", team.ID)
+	teamMembers, err := u.repUsers.GetUsersByTeamID(ctx, team.ID)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, team.ID))
+	}
+	memberIDs := make(map[uuid.UUID]struct{})
+	if teamMembers != nil {
+		for _, member := range *teamMembers {
+			memberIDs[member.ID] = struct{}{}
+		}
+	}
+
+	userIDs := make(map[uuid.UUID]struct{})
+	for _, user := range *existingUsers {
+		if _, isMember := memberIDs[user.ID]; user.TeamID != team.ID && !isMember {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: user %s not in team %s", usecase2.ErrUserNotBelongsToTeam, user.ID, req.TeamName))
+		}
+		userIDs[user.ID] = struct{}{}
+	}
+	for _, userID := range req.UserIDs {
+		if _, exist := userIDs[userID]; !exist {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: user %s", usecase2.ErrUserNotFound, userID))
+		}
+	}
+
+	slog.DebugContext(ctx, "Activate users", "users_count", len(req.UserIDs))
+	var usersToUpdate []users2.UserIn
+	for _, user := range *existingUsers {
+		if !user.IsActive {
+			usersToUpdate = append(usersToUpdate, users2.UserIn{
+				ID:       user.ID,
+				Name:     user.Name,
+				IsActive: true,
+				TeamID:   user.TeamID,
+			})
+		}
+	}
+
+	returningUsers := make([]users2.UserOut, 0, len(usersToUpdate))
+	if len(usersToUpdate) > 0 {
+		activatedUsers, err := u.repUsers.UpdateUsersBatch(ctx, usersToUpdate)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrUpdateUser))
+		}
+		activatedByID := make(map[uuid.UUID]users2.UserOut, len(*activatedUsers))
+		for _, user := range *activatedUsers {
+			activatedByID[user.ID] = user
+		}
+		for _, user := range usersToUpdate {
+			if activated, exist := activatedByID[user.ID]; exist {
+				returningUsers = append(returningUsers, activated)
+			}
+		}
+	}
+
+	backfilledPRs := make([]BackfilledPullRequest, 0)
+	if req.Backfill && len(returningUsers) > 0 {
+		if team.ArchivedAt != nil {
+			slog.DebugContext(ctx, "Team is archived, skipping backfill", "team_id", team.ID)
+		} else {
+			backfilledPRs, err = u.backfillPullRequests(ctx, team, teamMembers, returningUsers)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var domainEvents []usecase2.Event
+	for _, user := range usersToUpdate {
+		domainEvents = append(domainEvents, usecase2.Event{
+			Type:     usecase2.EventUserActivated,
+			UserID:   user.ID,
+			TeamName: team.Name,
+		})
+	}
+	domainEvents = append(domainEvents, reviewerEvents(backfilledPRs)...)
+	slog.DebugContext(ctx, "Publish activation events", "count", len(domainEvents))
+	if err = u.publisher.Publish(ctx, domainEvents); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "Get updated team members")
+	updatedUsers, err := u.repUsers.GetUsersByTeamID(ctx, team.ID)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, team.ID))
+	}
+
+	members := make([]TeamMember, 0)
+	if updatedUsers != nil {
+		for _, user := range *updatedUsers {
+			members = append(members, TeamMember{
+				UserID:   user.ID,
+				Username: user.Name,
+				IsActive: user.IsActive,
+			})
+		}
+	}
+
+	slog.DebugContext(ctx, "UseCase ActivateTeamUsers success",
+		"activated_users", len(usersToUpdate),
+		"backfilled_prs", len(backfilledPRs))
+	return &Out{
+		Team: Team{
+			TeamName: team.Name,
+			Members:  members,
+		},
+		BackfilledPullRequests: backfilledPRs,
+	}, nil
+}
+
+// backfillPullRequests assigns the returning users to open PRs authored by the team's primary members that have
+// fewer reviewers than required. Oldest PRs are served first, and every PR gets the returning users with the fewest
+// backfill assignments so far, so the new load is spread evenly. Returning users pass the same candidate rules as
+// every other reviewer selection, those still unavailable are not assigned.
+func (u *usecase) backfillPullRequests(
+	ctx context.Context,
+	team *teams2.TeamOut,
+	teamMembers *[]users2.UserOut,
+	returningUsers []users2.UserOut,
+) ([]BackfilledPullRequest, error) {
+	backfilled := make([]BackfilledPullRequest, 0)
+	if teamMembers == nil {
+		return backfilled, nil
+	}
+
+	now := u.nower.Now()
+	availableUsers := make([]users2.UserOut, 0, len(returningUsers))
+	for _, user := range returningUsers {
+		if usecase2.IsUnavailable(user, now) {
+			slog.DebugContext(ctx, "Returning user is unavailable, skipping backfill", "user_id", user.ID)
+			continue
+		}
+		availableUsers = append(availableUsers, user)
+	}
+	if len(availableUsers) == 0 {
+		return backfilled, nil
+	}
+
+	authorIDs := make([]uuid.UUID, 0, len(*teamMembers))
+	for _, member := range *teamMembers {
+		if member.TeamID == team.ID {
+			authorIDs = append(authorIDs, member.ID)
+		}
+	}
+
+	slog.DebugContext(ctx, "Find open PRs of team authors", "authors_count", len(authorIDs))
+	openPRs, err := u.findOpenPullRequests(ctx, authorIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	assignedCnt := make(map[uuid.UUID]int, len(returningUsers))
+	for _, pr := range openPRs {
+		currentReviewers, err := u.repPRReviewers.GetPRReviewersByPRID(ctx, pr.ID)
+		if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrGetPRReviewers, pr.ID))
+		}
+		assigned := make(map[uuid.UUID]struct{})
+		if currentReviewers != nil {
+			for _, reviewer := range *currentReviewers {
+				assigned[reviewer.ReviewerID] = struct{}{}
+			}
+		}
+		missing := u.maxCntReviewers - len(assigned)
+		if missing <= 0 {
+			continue
+		}
+
+		excluded := []uuid.UUID{pr.AuthorID}
+		for reviewerID := range assigned {
+			excluded = append(excluded, reviewerID)
+		}
+		candidates := usecase2.ReviewerCandidates(availableUsers, excluded...)
+		if len(candidates) == 0 {
+			continue
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return assignedCnt[candidates[i].ID] < assignedCnt[candidates[j].ID]
+		})
+
+		report := BackfilledPullRequest{
+			PullRequestID:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorID:        pr.AuthorID,
+			AddedReviewers:  []uuid.UUID{},
+		}
+		for _, reviewer := range candidates[:min(missing, len(candidates))] {
+			reviewerID := reviewer.ID
+			_, err = u.repPRReviewers.SavePRReviewer(ctx, pr_reviewers2.PrReviewerIn{
+				PrID:       pr.ID,
+				ReviewerID: reviewerID,
+			})
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer %s", usecase2.ErrAssignReviewer, reviewerID))
+			}
+			assignedCnt[reviewerID]++
+			report.AddedReviewers = append(report.AddedReviewers, reviewerID)
+			slog.DebugContext(ctx, "Backfilled reviewer", "pr_id", pr.ID, "reviewer_id", reviewerID)
+		}
+		report.Understaffed = len(assigned)+len(report.AddedReviewers) < u.maxCntReviewers
+
+		backfilled = append(backfilled, report)
+	}
+
+	return backfilled, nil
+}
+
+// findOpenPullRequests returns open PRs of the given authors ordered from oldest to newest.
+func (u *usecase) findOpenPullRequests(ctx context.Context, authorIDs []uuid.UUID) ([]pull_requests2.PullRequestOut, error) {
+	prs, err := u.repPullRequests.GetPullRequestsByAuthorIDs(ctx, authorIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPullRequest))
+	}
+	if len(*prs) == 0 {
+		return []pull_requests2.PullRequestOut{}, nil
+	}
+
+	statusIDs := make([]uuid.UUID, 0, len(*prs))
+	for _, pr := range *prs {
+		statusIDs = append(statusIDs, pr.StatusID)
+	}
+	prStatuses, err := u.repPRStatuses.GetPRStatusesByIDs(ctx, statusIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPRStatus))
+	}
+	statusMap := make(map[uuid.UUID]string)
+	if prStatuses != nil {
+		for _, status := range *prStatuses {
+			statusMap[status.ID] = status.Status
+		}
+	}
+
+	openPRs := make([]pull_requests2.PullRequestOut, 0)
+	for _, pr := range *prs {
+		if statusMap[pr.StatusID] == usecase2.OpenStatusValue {
+			openPRs = append(openPRs, pr)
+		}
+	}
+	sort.SliceStable(openPRs, func(i, j int) bool {
+		return openPRs[i].CreatedAt.Before(openPRs[j].CreatedAt)
+	})
+
+	return openPRs, nil
+}
+
+func reviewerEvents(backfilledPRs []BackfilledPullRequest) []usecase2.Event {
+	var reviewerEvents []usecase2.Event
+	for _, pr := range backfilledPRs {
+		for _, reviewerID := range pr.AddedReviewers {
+			reviewerEvents = append(reviewerEvents, usecase2.Event{
+				Type:            usecase2.EventReviewerAssigned,
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				ReviewerID:      reviewerID,
+			})
+		}
+	}
+	return reviewerEvents
+}
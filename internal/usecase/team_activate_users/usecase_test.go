@@ -0,0 +1,587 @@
+package team_activate_users
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
+	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
+	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	cntReviewers = 2
+)
+
+func TestTeamActivateUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	otherTeamID := uuid.New()
+	teamName := "backend"
+	returning1ID := uuid.New()
+	returning2ID := uuid.New()
+	authorID := uuid.New()
+	outsiderID := uuid.New()
+	externalReviewerID := uuid.New()
+	openStatusID := uuid.New()
+	mergedStatusID := uuid.New()
+	pr1ID := uuid.New()
+	pr2ID := uuid.New()
+	pr3ID := uuid.New()
+	mergedPRID := uuid.New()
+	now := time.Date(2025, 12, 10, 12, 0, 0, 0, time.UTC)
+
+	team := &teams2.TeamOut{ID: teamID, Name: teamName}
+
+	returning1 := users2.UserOut{ID: returning1ID, Name: "alice", IsActive: false, TeamID: teamID}
+	returning2 := users2.UserOut{ID: returning2ID, Name: "bob", IsActive: false, TeamID: otherTeamID}
+	author := users2.UserOut{ID: authorID, Name: "charlie", IsActive: true, TeamID: teamID}
+	outsider := users2.UserOut{ID: outsiderID, Name: "dave", IsActive: false, TeamID: otherTeamID}
+
+	teamMembers := []users2.UserOut{returning1, returning2, author}
+	activatedMembers := []users2.UserOut{
+		{ID: returning1ID, Name: "alice", IsActive: true, TeamID: teamID},
+		{ID: returning2ID, Name: "bob", IsActive: true, TeamID: otherTeamID},
+		author,
+	}
+	unavailableUntil := now.Add(72 * time.Hour)
+	activatedUnavailable := []users2.UserOut{
+		{ID: returning1ID, Name: "alice", IsActive: true, TeamID: teamID, UnavailableUntil: &unavailableUntil},
+		{ID: returning2ID, Name: "bob", IsActive: true, TeamID: otherTeamID},
+	}
+	usersToUpdate := []users2.UserIn{
+		{ID: returning1ID, Name: "alice", IsActive: true, TeamID: teamID},
+		{ID: returning2ID, Name: "bob", IsActive: true, TeamID: otherTeamID},
+	}
+
+	teamPRs := []pull_requests2.PullRequestOut{
+		{ID: pr3ID, Name: "pr3", AuthorID: returning1ID, StatusID: openStatusID, CreatedAt: now.Add(-time.Hour)},
+		{ID: mergedPRID, Name: "merged", AuthorID: authorID, StatusID: mergedStatusID, CreatedAt: now.Add(-4 * time.Hour)},
+		{ID: pr1ID, Name: "pr1", AuthorID: authorID, StatusID: openStatusID, CreatedAt: now.Add(-3 * time.Hour)},
+		{ID: pr2ID, Name: "pr2", AuthorID: authorID, StatusID: openStatusID, CreatedAt: now.Add(-2 * time.Hour)},
+	}
+	prStatuses := []pr_statuses2.PRStatusOut{
+		{ID: openStatusID, Status: usecase2.OpenStatusValue},
+		{ID: mergedStatusID, Status: usecase2.MergedStatusValue},
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockTeams *teams.MockRepositoryTeams,
+			mockUsers *users.MockRepositoryUsers,
+			mockPullRequests *pull_requests.MockRepositoryPullRequests,
+			mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+			mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+			mockTrm *mock.MockManager,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "successful activation without backfill",
+			req:  In{TeamName: teamName, UserIDs: []uuid.UUID{returning1ID, returning2ID}},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{returning1ID, returning2ID}).
+					Return(&[]users2.UserOut{returning1, returning2}, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&teamMembers, nil)
+				mockUsers.EXPECT().UpdateUsersBatch(gomock.Any(), usersToUpdate).Return(&activatedMembers, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&activatedMembers, nil)
+			},
+			expected: &Out{
+				Team: Team{
+					TeamName: teamName,
+					Members: []TeamMember{
+						{UserID: returning1ID, Username: "alice", IsActive: true},
+						{UserID: returning2ID, Username: "bob", IsActive: true},
+						{UserID: authorID, Username: "charlie", IsActive: true},
+					},
+				},
+				BackfilledPullRequests: []BackfilledPullRequest{},
+			},
+		},
+		{
+			name: "successful activation with backfill spreads returning users over understaffed PRs",
+			req:  In{TeamName: teamName, UserIDs: []uuid.UUID{returning1ID, returning2ID}, Backfill: true},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{returning1ID, returning2ID}).
+					Return(&[]users2.UserOut{returning1, returning2}, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&teamMembers, nil)
+				mockUsers.EXPECT().UpdateUsersBatch(gomock.Any(), usersToUpdate).Return(&activatedMembers, nil)
+
+				mockPullRequests.EXPECT().GetPullRequestsByAuthorIDs(gomock.Any(), []uuid.UUID{returning1ID, authorID}).
+					Return(&teamPRs, nil)
+				mockPRStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), gomock.Any()).Return(&prStatuses, nil)
+
+				gomock.InOrder(
+					mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), pr1ID).
+						Return(nil, repository.ErrPRReviewerNotFound),
+					mockPRReviewers.EXPECT().
+						SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr1ID, ReviewerID: returning1ID}).
+						Return(&pr_reviewers2.PrReviewerOut{}, nil),
+					mockPRReviewers.EXPECT().
+						SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr1ID, ReviewerID: returning2ID}).
+						Return(&pr_reviewers2.PrReviewerOut{}, nil),
+					mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), pr2ID).
+						Return(&[]pr_reviewers2.PrReviewerOut{{PRID: pr2ID, ReviewerID: externalReviewerID}}, nil),
+					mockPRReviewers.EXPECT().
+						SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr2ID, ReviewerID: returning1ID}).
+						Return(&pr_reviewers2.PrReviewerOut{}, nil),
+					mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), pr3ID).
+						Return(&[]pr_reviewers2.PrReviewerOut{}, nil),
+					mockPRReviewers.EXPECT().
+						SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr3ID, ReviewerID: returning2ID}).
+						Return(&pr_reviewers2.PrReviewerOut{}, nil),
+				)
+
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&activatedMembers, nil)
+			},
+			expected: &Out{
+				Team: Team{
+					TeamName: teamName,
+					Members: []TeamMember{
+						{UserID: returning1ID, Username: "alice", IsActive: true},
+						{UserID: returning2ID, Username: "bob", IsActive: true},
+						{UserID: authorID, Username: "charlie", IsActive: true},
+					},
+				},
+				BackfilledPullRequests: []BackfilledPullRequest{
+					{
+						PullRequestID:   pr1ID,
+						PullRequestName: "pr1",
+						AuthorID:        authorID,
+						AddedReviewers:  []uuid.UUID{returning1ID, returning2ID},
+					},
+					{
+						PullRequestID:   pr2ID,
+						PullRequestName: "pr2",
+						AuthorID:        authorID,
+						AddedReviewers:  []uuid.UUID{returning1ID},
+					},
+					{
+						PullRequestID:   pr3ID,
+						PullRequestName: "pr3",
+						AuthorID:        returning1ID,
+						AddedReviewers:  []uuid.UUID{returning2ID},
+						Understaffed:    true,
+					},
+				},
+			},
+		},
+		{
+			name: "backfill is skipped for archived team",
+			req:  In{TeamName: teamName, UserIDs: []uuid.UUID{returning1ID}, Backfill: true},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+				archivedTeam := &teams2.TeamOut{ID: teamID, Name: teamName, ArchivedAt: &now}
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(archivedTeam, nil)
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{returning1ID}).
+					Return(&[]users2.UserOut{returning1}, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&teamMembers, nil)
+				mockUsers.EXPECT().UpdateUsersBatch(gomock.Any(), usersToUpdate[:1]).Return(&activatedMembers, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&activatedMembers, nil)
+			},
+			expected: &Out{
+				Team: Team{
+					TeamName: teamName,
+					Members: []TeamMember{
+						{UserID: returning1ID, Username: "alice", IsActive: true},
+						{UserID: returning2ID, Username: "bob", IsActive: true},
+						{UserID: authorID, Username: "charlie", IsActive: true},
+					},
+				},
+				BackfilledPullRequests: []BackfilledPullRequest{},
+			},
+		},
+		{
+			name: "backfill skips returning users that are still unavailable",
+			req:  In{TeamName: teamName, UserIDs: []uuid.UUID{returning1ID, returning2ID}, Backfill: true},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{returning1ID, returning2ID}).
+					Return(&[]users2.UserOut{returning1, returning2}, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&teamMembers, nil)
+				mockUsers.EXPECT().UpdateUsersBatch(gomock.Any(), usersToUpdate).Return(&activatedUnavailable, nil)
+
+				mockPullRequests.EXPECT().GetPullRequestsByAuthorIDs(gomock.Any(), []uuid.UUID{returning1ID, authorID}).
+					Return(&teamPRs, nil)
+				mockPRStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), gomock.Any()).Return(&prStatuses, nil)
+
+				gomock.InOrder(
+					mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), pr1ID).
+						Return(nil, repository.ErrPRReviewerNotFound),
+					mockPRReviewers.EXPECT().
+						SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr1ID, ReviewerID: returning2ID}).
+						Return(&pr_reviewers2.PrReviewerOut{}, nil),
+					mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), pr2ID).
+						Return(&[]pr_reviewers2.PrReviewerOut{{PRID: pr2ID, ReviewerID: externalReviewerID}}, nil),
+					mockPRReviewers.EXPECT().
+						SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr2ID, ReviewerID: returning2ID}).
+						Return(&pr_reviewers2.PrReviewerOut{}, nil),
+					mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), pr3ID).
+						Return(&[]pr_reviewers2.PrReviewerOut{}, nil),
+					mockPRReviewers.EXPECT().
+						SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr3ID, ReviewerID: returning2ID}).
+						Return(&pr_reviewers2.PrReviewerOut{}, nil),
+				)
+
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&activatedMembers, nil)
+			},
+			expected: &Out{
+				Team: Team{
+					TeamName: teamName,
+					Members: []TeamMember{
+						{UserID: returning1ID, Username: "alice", IsActive: true},
+						{UserID: returning2ID, Username: "bob", IsActive: true},
+						{UserID: authorID, Username: "charlie", IsActive: true},
+					},
+				},
+				BackfilledPullRequests: []BackfilledPullRequest{
+					{
+						PullRequestID:   pr1ID,
+						PullRequestName: "pr1",
+						AuthorID:        authorID,
+						AddedReviewers:  []uuid.UUID{returning2ID},
+						Understaffed:    true,
+					},
+					{
+						PullRequestID:   pr2ID,
+						PullRequestName: "pr2",
+						AuthorID:        authorID,
+						AddedReviewers:  []uuid.UUID{returning2ID},
+					},
+					{
+						PullRequestID:   pr3ID,
+						PullRequestName: "pr3",
+						AuthorID:        returning1ID,
+						AddedReviewers:  []uuid.UUID{returning2ID},
+						Understaffed:    true,
+					},
+				},
+			},
+		},
+		{
+			name: "team not found",
+			req:  In{TeamName: teamName, UserIDs: []uuid.UUID{returning1ID}},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(nil, repository.ErrTeamNotFound)
+			},
+			expectedError: usecase2.ErrTeamNotFound,
+		},
+		{
+			name: "user not belongs to team",
+			req:  In{TeamName: teamName, UserIDs: []uuid.UUID{outsiderID}},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{outsiderID}).
+					Return(&[]users2.UserOut{outsider}, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&teamMembers, nil)
+			},
+			expectedError: usecase2.ErrUserNotBelongsToTeam,
+		},
+		{
+			name: "user not found",
+			req:  In{TeamName: teamName, UserIDs: []uuid.UUID{returning1ID, uuid.New()}},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{returning1}, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&teamMembers, nil)
+			},
+			expectedError: usecase2.ErrUserNotFound,
+		},
+		{
+			name: "error updating users",
+			req:  In{TeamName: teamName, UserIDs: []uuid.UUID{returning1ID}},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{returning1ID}).
+					Return(&[]users2.UserOut{returning1}, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&teamMembers, nil)
+				mockUsers.EXPECT().UpdateUsersBatch(gomock.Any(), usersToUpdate[:1]).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrUpdateUser,
+		},
+		{
+			name: "error assigning backfilled reviewer",
+			req:  In{TeamName: teamName, UserIDs: []uuid.UUID{returning1ID}, Backfill: true},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{returning1ID}).
+					Return(&[]users2.UserOut{returning1}, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&teamMembers, nil)
+				mockUsers.EXPECT().UpdateUsersBatch(gomock.Any(), usersToUpdate[:1]).Return(&activatedMembers, nil)
+				mockPullRequests.EXPECT().GetPullRequestsByAuthorIDs(gomock.Any(), gomock.Any()).
+					Return(&[]pull_requests2.PullRequestOut{teamPRs[2]}, nil)
+				mockPRStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), gomock.Any()).Return(&prStatuses, nil)
+				mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), pr1ID).
+					Return(&[]pr_reviewers2.PrReviewerOut{}, nil)
+				mockPRReviewers.EXPECT().SavePRReviewer(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrAssignReviewer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+			mockNower.EXPECT().Now().Return(now).AnyTimes()
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockTrm := mock.NewMockManager(ctrl)
+
+			tt.setupMock(
+				mockRepoTeams,
+				mockRepoUsers,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockTrm,
+			)
+
+			u := NewUsecase(
+				mockRepoTeams,
+				mockRepoUsers,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				cntReviewers,
+				mockNower,
+				mockPublisher,
+				mockTrm,
+			)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestTeamActivateUsersPublishesEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	userID := uuid.New()
+	authorID := uuid.New()
+	prID := uuid.New()
+	openStatusID := uuid.New()
+	team := &teams2.TeamOut{ID: teamID, Name: "backend"}
+	inactiveUser := users2.UserOut{ID: userID, Name: "alice", IsActive: false, TeamID: teamID}
+	activeUser := users2.UserOut{ID: userID, Name: "alice", IsActive: true, TeamID: teamID}
+	author := users2.UserOut{ID: authorID, Name: "bob", IsActive: true, TeamID: teamID}
+	publishErr := errors.New("publish error")
+
+	tests := []struct {
+		name          string
+		publishErr    error
+		expectedError error
+	}{
+		{name: "activated and assigned events are published"},
+		{name: "publish error rolls back activation", publishErr: publishErr, expectedError: publishErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+			mockNower.EXPECT().Now().Return(time.Now()).AnyTimes()
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoTeams.EXPECT().GetTeamByName(gomock.Any(), "backend").Return(team, nil)
+			mockRepoUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{userID}).
+				Return(&[]users2.UserOut{inactiveUser}, nil)
+			mockRepoUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).
+				Return(&[]users2.UserOut{inactiveUser, author}, nil)
+			mockRepoUsers.EXPECT().UpdateUsersBatch(gomock.Any(), gomock.Any()).
+				Return(&[]users2.UserOut{activeUser}, nil)
+			mockRepoPullRequests.EXPECT().GetPullRequestsByAuthorIDs(gomock.Any(), []uuid.UUID{userID, authorID}).
+				Return(&[]pull_requests2.PullRequestOut{{ID: prID, Name: "PR 1", AuthorID: authorID, StatusID: openStatusID}}, nil)
+			mockRepoPRStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), []uuid.UUID{openStatusID}).
+				Return(&[]pr_statuses2.PRStatusOut{{ID: openStatusID, Status: usecase2.OpenStatusValue}}, nil)
+			mockRepoPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).
+				Return(nil, repository.ErrPRReviewerNotFound)
+			mockRepoPRReviewers.EXPECT().
+				SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: prID, ReviewerID: userID}).
+				Return(&pr_reviewers2.PrReviewerOut{PRID: prID, ReviewerID: userID}, nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{
+					{
+						Type:     usecase2.EventUserActivated,
+						UserID:   userID,
+						TeamName: "backend",
+					},
+					{
+						Type:            usecase2.EventReviewerAssigned,
+						PullRequestID:   prID,
+						PullRequestName: "PR 1",
+						AuthorID:        authorID,
+						ReviewerID:      userID,
+					},
+				}).
+				Return(tt.publishErr)
+			if tt.publishErr == nil {
+				mockRepoUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{activeUser, author}, nil)
+			}
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockRepoTeams, mockRepoUsers, mockRepoPullRequests, mockRepoPRReviewers,
+				mockRepoPRStatuses, cntReviewers, mockNower, mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), In{TeamName: "backend", UserIDs: []uuid.UUID{userID}, Backfill: true})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, result.BackfilledPullRequests, 1)
+		})
+	}
+}
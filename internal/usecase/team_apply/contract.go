@@ -0,0 +1,78 @@
+package team_apply
+
+import (
+	"context"
+
+	"pr-reviewers-service/internal/usecase/team_activate_users"
+	"pr-reviewers-service/internal/usecase/team_deactivate_users"
+	"pr-reviewers-service/internal/usecase/user_move_team"
+
+	"github.com/google/uuid"
+)
+
+// Change actions in the order they are applied.
+const (
+	ActionCreateTeam       = "create_team"
+	ActionSetParent        = "set_parent"
+	ActionUnarchiveTeam    = "unarchive_team"
+	ActionCreateUser       = "create_user"
+	ActionRenameUser       = "rename_user"
+	ActionMoveUser         = "move_user"
+	ActionRemoveMembership = "remove_membership"
+	ActionAddMembership    = "add_membership"
+	ActionActivateUser     = "activate_user"
+	ActionDeactivateUser   = "deactivate_user"
+	ActionArchiveTeam      = "archive_team"
+)
+
+// In is the full desired state of teams and users, route policies stay in the app.policies configuration. Teams
+// missing from it are archived and users missing from it are deactivated.
+type In struct {
+	Teams []Team
+	Plan  bool
+}
+
+type Team struct {
+	TeamName            string
+	ParentTeamName      string
+	IsArchived          bool
+	Members             []Member
+	AdditionalMemberIDs []uuid.UUID
+}
+
+// Member is a user whose primary team is the team.
+type Member struct {
+	UserID   uuid.UUID
+	Username string
+	IsActive bool
+}
+
+// Change is a single step from the current state to the desired one. UserID is uuid.Nil for team changes,
+// From and To hold the previous and new value of set_parent, rename_user and move_user.
+type Change struct {
+	Action   string
+	TeamName string
+	UserID   uuid.UUID
+	From     string
+	To       string
+}
+
+type Out struct {
+	Changes              []Change
+	AffectedPullRequests []team_deactivate_users.AffectedPullRequest
+	ReassignedReviews    []user_move_team.ReassignedReview
+	Plan                 bool
+}
+
+//go:generate mockgen -source=contract.go -destination=mocks/contract_mock.go -package=team_apply usersDeactivator,usersActivator,userMover
+type usersDeactivator interface {
+	Run(ctx context.Context, req team_deactivate_users.In) (*team_deactivate_users.Out, error)
+}
+
+type usersActivator interface {
+	Run(ctx context.Context, req team_activate_users.In) (*team_activate_users.Out, error)
+}
+
+type userMover interface {
+	Run(ctx context.Context, req user_move_team.In) (*user_move_team.Out, error)
+}
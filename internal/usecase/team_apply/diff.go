@@ -0,0 +1,229 @@
+package team_apply
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+// state is what the desired state is compared with: every team including archived ones ordered by name,
+// every user and the additional memberships of the users.
+type state struct {
+	teams      []teams2.TeamOut
+	users      []users2.UserOut
+	additional map[uuid.UUID][]uuid.UUID
+}
+
+// validate checks the desired state on its own, before it is compared with the database.
+func validate(ctx context.Context, req In) error {
+	teamsByName := make(map[string]Team, len(req.Teams))
+	for _, team := range req.Teams {
+		if team.TeamName == "" {
+			return logging.WrapError(ctx, fmt.Errorf("%w: team without a name", usecase2.ErrInvalidTeamsState))
+		}
+		if _, exists := teamsByName[team.TeamName]; exists {
+			return logging.WrapError(ctx, fmt.Errorf("%w: team %s is listed twice", usecase2.ErrInvalidTeamsState, team.TeamName))
+		}
+		teamsByName[team.TeamName] = team
+	}
+
+	primaryTeams := make(map[uuid.UUID]string)
+	for _, team := range req.Teams {
+		if team.ParentTeamName == team.TeamName {
+			return logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrTeamHierarchyCycle, team.TeamName))
+		}
+		if _, exists := teamsByName[team.ParentTeamName]; team.ParentTeamName != "" && !exists {
+			return logging.WrapError(ctx, fmt.Errorf("%w: %s is not listed", usecase2.ErrParentTeamNotFound, team.ParentTeamName))
+		}
+		for _, member := range team.Members {
+			if member.UserID == uuid.Nil || member.Username == "" {
+				return logging.WrapError(ctx, fmt.Errorf("%w: member of team %s without user_id or username",
+					usecase2.ErrInvalidTeamsState, team.TeamName))
+			}
+			if _, exists := primaryTeams[member.UserID]; exists {
+				return logging.WrapError(ctx, fmt.Errorf("%w: user_id %s is a member of several teams",
+					usecase2.ErrDuplicateUsers, member.UserID))
+			}
+			primaryTeams[member.UserID] = team.TeamName
+		}
+	}
+
+	for _, team := range req.Teams {
+		for i, userID := range team.AdditionalMemberIDs {
+			primaryTeam, exists := primaryTeams[userID]
+			if !exists {
+				return logging.WrapError(ctx, fmt.Errorf("%w: additional member %s of team %s is not a member of any team",
+					usecase2.ErrInvalidTeamsState, userID, team.TeamName))
+			}
+			if primaryTeam == team.TeamName {
+				return logging.WrapError(ctx, fmt.Errorf("%w: user_id %s is already a member of team %s",
+					usecase2.ErrInvalidTeamsState, userID, team.TeamName))
+			}
+			if slices.Contains(team.AdditionalMemberIDs[:i], userID) {
+				return logging.WrapError(ctx, fmt.Errorf("%w: additional member %s of team %s",
+					usecase2.ErrDuplicateUsers, userID, team.TeamName))
+			}
+		}
+
+		// Every parent is listed, so walking up more steps than there are teams means a cycle.
+		parent := team.ParentTeamName
+		for range req.Teams {
+			if parent == "" {
+				break
+			}
+			if parent == team.TeamName {
+				return logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrTeamHierarchyCycle, team.TeamName))
+			}
+			parent = teamsByName[parent].ParentTeamName
+		}
+	}
+	return nil
+}
+
+// diff lists the changes turning current into the desired state in the order they are applied:
+// teams are created and unarchived first so that users can be moved into them, and archived last.
+func diff(req In, current state) []Change {
+	teamsByName := make(map[string]teams2.TeamOut, len(current.teams))
+	teamNames := make(map[uuid.UUID]string, len(current.teams))
+	for _, team := range current.teams {
+		teamsByName[team.Name] = team
+		teamNames[team.ID] = team.Name
+	}
+
+	var createTeams, setParents, unarchiveTeams, archiveTeams []Change
+	desiredTeams := make(map[string]struct{}, len(req.Teams))
+	for _, team := range req.Teams {
+		desiredTeams[team.TeamName] = struct{}{}
+		existing, exists := teamsByName[team.TeamName]
+		if !exists {
+			createTeams = append(createTeams, Change{Action: ActionCreateTeam, TeamName: team.TeamName})
+			if team.ParentTeamName != "" {
+				setParents = append(setParents, Change{Action: ActionSetParent, TeamName: team.TeamName, To: team.ParentTeamName})
+			}
+			if team.IsArchived {
+				archiveTeams = append(archiveTeams, Change{Action: ActionArchiveTeam, TeamName: team.TeamName})
+			}
+			continue
+		}
+
+		parentName := ""
+		if existing.ParentTeamID != nil {
+			parentName = teamNames[*existing.ParentTeamID]
+		}
+		if parentName != team.ParentTeamName {
+			setParents = append(setParents, Change{
+				Action:   ActionSetParent,
+				TeamName: team.TeamName,
+				From:     parentName,
+				To:       team.ParentTeamName,
+			})
+		}
+
+		isArchived := existing.ArchivedAt != nil
+		if isArchived && !team.IsArchived {
+			unarchiveTeams = append(unarchiveTeams, Change{Action: ActionUnarchiveTeam, TeamName: team.TeamName})
+		}
+		if !isArchived && team.IsArchived {
+			archiveTeams = append(archiveTeams, Change{Action: ActionArchiveTeam, TeamName: team.TeamName})
+		}
+	}
+	for _, team := range current.teams {
+		if _, desired := desiredTeams[team.Name]; !desired && team.ArchivedAt == nil {
+			archiveTeams = append(archiveTeams, Change{Action: ActionArchiveTeam, TeamName: team.Name})
+		}
+	}
+
+	usersByID := make(map[uuid.UUID]users2.UserOut, len(current.users))
+	for _, user := range current.users {
+		usersByID[user.ID] = user
+	}
+
+	var createUsers, renameUsers, moveUsers, activateUsers, deactivateUsers []Change
+	primaryTeams := make(map[uuid.UUID]string)
+	for _, team := range req.Teams {
+		for _, member := range team.Members {
+			primaryTeams[member.UserID] = team.TeamName
+			existing, exists := usersByID[member.UserID]
+			if !exists {
+				createUsers = append(createUsers, Change{
+					Action:   ActionCreateUser,
+					TeamName: team.TeamName,
+					UserID:   member.UserID,
+					To:       member.Username,
+				})
+				continue
+			}
+
+			if existing.Name != member.Username {
+				renameUsers = append(renameUsers, Change{
+					Action:   ActionRenameUser,
+					TeamName: team.TeamName,
+					UserID:   member.UserID,
+					From:     existing.Name,
+					To:       member.Username,
+				})
+			}
+			if currentTeam := teamNames[existing.TeamID]; currentTeam != team.TeamName {
+				moveUsers = append(moveUsers, Change{
+					Action:   ActionMoveUser,
+					TeamName: team.TeamName,
+					UserID:   member.UserID,
+					From:     currentTeam,
+					To:       team.TeamName,
+				})
+			}
+			switch {
+			case member.IsActive && !existing.IsActive:
+				activateUsers = append(activateUsers, Change{Action: ActionActivateUser, TeamName: team.TeamName, UserID: member.UserID})
+			case !member.IsActive && existing.IsActive:
+				deactivateUsers = append(deactivateUsers, Change{Action: ActionDeactivateUser, TeamName: team.TeamName, UserID: member.UserID})
+			}
+		}
+	}
+	for _, user := range current.users {
+		if _, desired := primaryTeams[user.ID]; !desired && user.IsActive {
+			deactivateUsers = append(deactivateUsers, Change{
+				Action:   ActionDeactivateUser,
+				TeamName: teamNames[user.TeamID],
+				UserID:   user.ID,
+			})
+		}
+	}
+
+	var addMemberships, removeMemberships []Change
+	desiredAdditional := make(map[uuid.UUID][]string)
+	for _, team := range req.Teams {
+		for _, userID := range team.AdditionalMemberIDs {
+			desiredAdditional[userID] = append(desiredAdditional[userID], team.TeamName)
+			existing, exists := teamsByName[team.TeamName]
+			if exists && slices.Contains(current.additional[userID], existing.ID) {
+				continue
+			}
+			addMemberships = append(addMemberships, Change{Action: ActionAddMembership, TeamName: team.TeamName, UserID: userID})
+		}
+	}
+	for _, user := range current.users {
+		primaryTeam, desired := primaryTeams[user.ID]
+		if !desired {
+			continue
+		}
+		// Moving the user into one of their additional teams replaces that membership.
+		for _, team := range current.teams {
+			if !slices.Contains(current.additional[user.ID], team.ID) ||
+				team.Name == primaryTeam || slices.Contains(desiredAdditional[user.ID], team.Name) {
+				continue
+			}
+			removeMemberships = append(removeMemberships, Change{Action: ActionRemoveMembership, TeamName: team.Name, UserID: user.ID})
+		}
+	}
+
+	return slices.Concat(createTeams, setParents, unarchiveTeams, createUsers, renameUsers, moveUsers,
+		removeMemberships, addMemberships, activateUsers, deactivateUsers, archiveTeams)
+}
@@ -0,0 +1,395 @@
+package team_apply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/repository/team_memberships"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+	"pr-reviewers-service/internal/usecase/team_activate_users"
+	"pr-reviewers-service/internal/usecase/team_deactivate_users"
+	"pr-reviewers-service/internal/usecase/user_move_team"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+)
+
+// usecase brings teams and users to a declared state. Activity changes and moves go through
+// team_activate_users, team_deactivate_users and user_move_team so that reviews are reassigned the same way
+// as through their own endpoints.
+type usecase struct {
+	repTeams           teams.RepositoryTeams
+	repUsers           users.RepositoryUsers
+	repTeamMemberships team_memberships.RepositoryTeamMemberships
+	deactivator        usersDeactivator
+	activator          usersActivator
+	mover              userMover
+	publisher          events.Publisher
+	trm                trm.Manager
+}
+
+func NewUsecase(
+	repTeams teams.RepositoryTeams,
+	repUsers users.RepositoryUsers,
+	repTeamMemberships team_memberships.RepositoryTeamMemberships,
+	deactivator usersDeactivator,
+	activator usersActivator,
+	mover userMover,
+	publisher events.Publisher,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repTeams:           repTeams,
+		repUsers:           repUsers,
+		repTeamMemberships: repTeamMemberships,
+		deactivator:        deactivator,
+		activator:          activator,
+		mover:              mover,
+		publisher:          publisher,
+		trm:                trm,
+	}
+}
+
+// Run applies the desired state in one transaction. In plan mode only the changes are computed.
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if req.Plan {
+		current, err := u.loadState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		changes := diff(req, current)
+		slog.DebugContext(ctx, "UseCase ApplyTeams planned", "changes", len(changes))
+		return &Out{
+			Changes:              changes,
+			AffectedPullRequests: []team_deactivate_users.AffectedPullRequest{},
+			ReassignedReviews:    []user_move_team.ReassignedReview{},
+			Plan:                 true,
+		}, nil
+	}
+
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	current, err := u.loadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	changes := diff(req, current)
+	out := &Out{
+		Changes:              changes,
+		AffectedPullRequests: []team_deactivate_users.AffectedPullRequest{},
+		ReassignedReviews:    []user_move_team.ReassignedReview{},
+	}
+	if len(changes) == 0 {
+		slog.DebugContext(ctx, "Teams already have the desired state")
+		return out, nil
+	}
+
+	teamIDs := make(map[string]uuid.UUID, len(current.teams))
+	for _, team := range current.teams {
+		teamIDs[team.Name] = team.ID
+	}
+	usersByID := make(map[uuid.UUID]users2.UserOut, len(current.users))
+	for _, user := range current.users {
+		usersByID[user.ID] = user
+	}
+	members := make(map[uuid.UUID]Member)
+	for _, team := range req.Teams {
+		for _, member := range team.Members {
+			members[member.UserID] = member
+		}
+	}
+
+	if err = u.applyTeams(ctx, changes, teamIDs); err != nil {
+		return nil, err
+	}
+	if err = u.createUsers(ctx, changes, teamIDs, members); err != nil {
+		return nil, err
+	}
+
+	for _, change := range byAction(changes, ActionRenameUser) {
+		user := usersByID[change.UserID]
+		slog.DebugContext(ctx, "Rename user", "user_id", user.ID, "username", change.To)
+		_, err = u.repUsers.UpdateUser(ctx, users2.UserIn{
+			ID:       user.ID,
+			Name:     change.To,
+			IsActive: user.IsActive,
+			TeamID:   user.TeamID,
+		})
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateUser, user.ID))
+		}
+	}
+	for _, change := range byAction(changes, ActionMoveUser) {
+		slog.DebugContext(ctx, "Move user", "user_id", change.UserID, "team_name", change.To)
+		moved, err := u.mover.Run(ctx, user_move_team.In{
+			UserID:          change.UserID,
+			TeamName:        change.To,
+			ReassignReviews: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		out.ReassignedReviews = append(out.ReassignedReviews, moved.ReassignedReviews...)
+	}
+
+	if err = u.applyMemberships(ctx, changes, teamIDs); err != nil {
+		return nil, err
+	}
+
+	for _, group := range byTeam(byAction(changes, ActionActivateUser)) {
+		slog.DebugContext(ctx, "Activate users", "team_name", group.teamName, "users_count", len(group.userIDs))
+		if _, err = u.activator.Run(ctx, team_activate_users.In{TeamName: group.teamName, UserIDs: group.userIDs}); err != nil {
+			return nil, err
+		}
+	}
+	for _, group := range byTeam(byAction(changes, ActionDeactivateUser)) {
+		slog.DebugContext(ctx, "Deactivate users", "team_name", group.teamName, "users_count", len(group.userIDs))
+		deactivated, err := u.deactivator.Run(ctx, team_deactivate_users.In{TeamName: group.teamName, UserIDs: group.userIDs})
+		if err != nil {
+			return nil, err
+		}
+		out.AffectedPullRequests = append(out.AffectedPullRequests, deactivated.AffectedPullRequests...)
+	}
+
+	for _, change := range byAction(changes, ActionArchiveTeam) {
+		slog.DebugContext(ctx, "Archive team", "team_name", change.TeamName)
+		if _, err = u.repTeams.SetTeamArchived(ctx, teamIDs[change.TeamName], true); err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateTeam, change.TeamName))
+		}
+	}
+
+	domainEvents := teamEvents(changes)
+	slog.DebugContext(ctx, "Publish team events", "count", len(domainEvents))
+	if err = u.publisher.Publish(ctx, domainEvents); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase ApplyTeams success",
+		"changes", len(changes),
+		"affected_prs", len(out.AffectedPullRequests),
+		"reassigned_reviews", len(out.ReassignedReviews))
+	return out, nil
+}
+
+func (u *usecase) loadState(ctx context.Context) (state, error) {
+	slog.DebugContext(ctx, "Get all teams")
+	allTeams, err := u.repTeams.GetAllTeamsWithArchived(ctx)
+	if err != nil {
+		return state{}, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetTeam))
+	}
+
+	slog.DebugContext(ctx, "Get all users")
+	allUsers, err := u.repUsers.GetAllUsers(ctx)
+	if err != nil {
+		return state{}, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetUsers))
+	}
+	current := state{teams: *allTeams, users: *allUsers, additional: make(map[uuid.UUID][]uuid.UUID)}
+
+	userIDs := make([]uuid.UUID, 0, len(current.users))
+	for _, user := range current.users {
+		userIDs = append(userIDs, user.ID)
+	}
+	slog.DebugContext(ctx, "Get team memberships", "users_count", len(userIDs))
+	memberships, err := u.repTeamMemberships.GetTeamMembershipsByUserIDs(ctx, userIDs)
+	if err != nil {
+		return state{}, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetTeamMemberships))
+	}
+	for _, membership := range *memberships {
+		if !membership.IsPrimary {
+			current.additional[membership.UserID] = append(current.additional[membership.UserID], membership.TeamID)
+		}
+	}
+	return current, nil
+}
+
+// applyTeams creates teams, sets parents once every team exists and unarchives teams, teamIDs gets the created teams.
+func (u *usecase) applyTeams(ctx context.Context, changes []Change, teamIDs map[string]uuid.UUID) error {
+	for _, change := range byAction(changes, ActionCreateTeam) {
+		slog.DebugContext(ctx, "Save team", "team_name", change.TeamName)
+		team, err := u.repTeams.SaveTeam(ctx, teams2.TeamIn{ID: uuid.New(), Name: change.TeamName})
+		if err != nil {
+			if errors.Is(err, repository.ErrTeamAlreadyExists) {
+				return logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamAlreadyExists, change.TeamName))
+			}
+			return logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSaveTeam, change.TeamName))
+		}
+		teamIDs[team.Name] = team.ID
+	}
+
+	for _, change := range byAction(changes, ActionSetParent) {
+		var parentTeamID *uuid.UUID
+		if change.To != "" {
+			parentID := teamIDs[change.To]
+			parentTeamID = &parentID
+		}
+		slog.DebugContext(ctx, "Set team parent", "team_name", change.TeamName, "parent_team_name", change.To)
+		if _, err := u.repTeams.UpdateTeamParent(ctx, teamIDs[change.TeamName], parentTeamID); err != nil {
+			return logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateTeam, change.TeamName))
+		}
+	}
+
+	for _, change := range byAction(changes, ActionUnarchiveTeam) {
+		slog.DebugContext(ctx, "Unarchive team", "team_name", change.TeamName)
+		if _, err := u.repTeams.SetTeamArchived(ctx, teamIDs[change.TeamName], false); err != nil {
+			return logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateTeam, change.TeamName))
+		}
+	}
+	return nil
+}
+
+func (u *usecase) createUsers(ctx context.Context, changes []Change, teamIDs map[string]uuid.UUID, members map[uuid.UUID]Member) error {
+	created := byAction(changes, ActionCreateUser)
+	if len(created) == 0 {
+		return nil
+	}
+
+	usersToCreate := make([]users2.UserIn, 0, len(created))
+	memberships := make([]team_memberships2.TeamMembershipIn, 0, len(created))
+	for _, change := range created {
+		usersToCreate = append(usersToCreate, users2.UserIn{
+			ID:       change.UserID,
+			Name:     change.To,
+			IsActive: members[change.UserID].IsActive,
+			TeamID:   teamIDs[change.TeamName],
+		})
+		memberships = append(memberships, team_memberships2.TeamMembershipIn{
+			UserID:    change.UserID,
+			TeamID:    teamIDs[change.TeamName],
+			IsPrimary: true,
+		})
+	}
+
+	slog.DebugContext(ctx, "Call SaveUsersBatch", "users_count", len(usersToCreate))
+	if _, err := u.repUsers.SaveUsersBatch(ctx, usersToCreate); err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrSaveUsersBatch))
+	}
+	slog.DebugContext(ctx, "Call SaveTeamMembershipsBatch for primary memberships")
+	if _, err := u.repTeamMemberships.SaveTeamMembershipsBatch(ctx, memberships); err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrSaveTeamMemberships))
+	}
+	return nil
+}
+
+func (u *usecase) applyMemberships(ctx context.Context, changes []Change, teamIDs map[string]uuid.UUID) error {
+	for _, change := range byAction(changes, ActionRemoveMembership) {
+		slog.DebugContext(ctx, "Delete additional team membership", "user_id", change.UserID, "team_name", change.TeamName)
+		if err := u.repTeamMemberships.DeleteTeamMembership(ctx, change.UserID, teamIDs[change.TeamName]); err != nil {
+			return logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrDeleteTeamMembership, change.UserID))
+		}
+	}
+
+	added := byAction(changes, ActionAddMembership)
+	if len(added) == 0 {
+		return nil
+	}
+	memberships := make([]team_memberships2.TeamMembershipIn, 0, len(added))
+	for _, change := range added {
+		memberships = append(memberships, team_memberships2.TeamMembershipIn{
+			UserID: change.UserID,
+			TeamID: teamIDs[change.TeamName],
+		})
+	}
+	slog.DebugContext(ctx, "Call SaveTeamMembershipsBatch", "memberships_count", len(memberships))
+	if _, err := u.repTeamMemberships.SaveTeamMembershipsBatch(ctx, memberships); err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrSaveTeamMemberships))
+	}
+	return nil
+}
+
+func byAction(changes []Change, action string) []Change {
+	var matched []Change
+	for _, change := range changes {
+		if change.Action == action {
+			matched = append(matched, change)
+		}
+	}
+	return matched
+}
+
+type teamUsers struct {
+	teamName string
+	userIDs  []uuid.UUID
+}
+
+// byTeam groups the users of the changes by team, keeping the order teams first appear in.
+func byTeam(changes []Change) []teamUsers {
+	var groups []teamUsers
+	index := make(map[string]int)
+	for _, change := range changes {
+		i, exists := index[change.TeamName]
+		if !exists {
+			i = len(groups)
+			index[change.TeamName] = i
+			groups = append(groups, teamUsers{teamName: change.TeamName})
+		}
+		groups[i].userIDs = append(groups[i].userIDs, change.UserID)
+	}
+	return groups
+}
+
+// teamEvents describes the team changes. A created team gets only team.created, a team whose parent or
+// members changed gets team.updated. User activity events are published by the activation usecases.
+func teamEvents(changes []Change) []usecase2.Event {
+	var domainEvents []usecase2.Event
+	created := make(map[string]struct{})
+	updated := make(map[string]struct{})
+	var updatedOrder []string
+	markUpdated := func(teamName string) {
+		if _, isCreated := created[teamName]; isCreated || teamName == "" {
+			return
+		}
+		if _, seen := updated[teamName]; !seen {
+			updated[teamName] = struct{}{}
+			updatedOrder = append(updatedOrder, teamName)
+		}
+	}
+
+	for _, change := range changes {
+		switch change.Action {
+		case ActionCreateTeam:
+			created[change.TeamName] = struct{}{}
+			domainEvents = append(domainEvents, usecase2.Event{Type: usecase2.EventTeamCreated, TeamName: change.TeamName})
+		case ActionSetParent, ActionCreateUser, ActionRenameUser, ActionAddMembership, ActionRemoveMembership:
+			markUpdated(change.TeamName)
+		case ActionMoveUser:
+			markUpdated(change.From)
+			markUpdated(change.To)
+		}
+	}
+	for _, teamName := range updatedOrder {
+		domainEvents = append(domainEvents, usecase2.Event{Type: usecase2.EventTeamUpdated, TeamName: teamName})
+	}
+	for _, change := range changes {
+		switch change.Action {
+		case ActionUnarchiveTeam:
+			domainEvents = append(domainEvents, usecase2.Event{Type: usecase2.EventTeamUnarchived, TeamName: change.TeamName})
+		case ActionArchiveTeam:
+			domainEvents = append(domainEvents, usecase2.Event{Type: usecase2.EventTeamArchived, TeamName: change.TeamName})
+		}
+	}
+	return domainEvents
+}
@@ -0,0 +1,407 @@
+package team_apply
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	team_memberships "pr-reviewers-service/internal/usecase/contract/repository/team_memberships/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+	"pr-reviewers-service/internal/usecase/team_activate_users"
+	"pr-reviewers-service/internal/usecase/team_apply/mocks"
+	"pr-reviewers-service/internal/usecase/team_deactivate_users"
+	"pr-reviewers-service/internal/usecase/user_move_team"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mocksSet struct {
+	teams       *teams.MockRepositoryTeams
+	users       *users.MockRepositoryUsers
+	memberships *team_memberships.MockRepositoryTeamMemberships
+	deactivator *team_apply.MockusersDeactivator
+	activator   *team_apply.MockusersActivator
+	mover       *team_apply.MockuserMover
+	publisher   *events.MockPublisher
+	trm         *mock.MockManager
+}
+
+func newMocks(ctrl *gomock.Controller) mocksSet {
+	return mocksSet{
+		teams:       teams.NewMockRepositoryTeams(ctrl),
+		users:       users.NewMockRepositoryUsers(ctrl),
+		memberships: team_memberships.NewMockRepositoryTeamMemberships(ctrl),
+		deactivator: team_apply.NewMockusersDeactivator(ctrl),
+		activator:   team_apply.NewMockusersActivator(ctrl),
+		mover:       team_apply.NewMockuserMover(ctrl),
+		publisher:   events.NewMockPublisher(ctrl),
+		trm:         mock.NewMockManager(ctrl),
+	}
+}
+
+func (m mocksSet) usecase() *usecase {
+	return NewUsecase(m.teams, m.users, m.memberships, m.deactivator, m.activator, m.mover, m.publisher, m.trm)
+}
+
+func (m mocksSet) inTransaction() {
+	m.trm.EXPECT().
+		Do(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
+
+func (m mocksSet) expectState(current state) {
+	m.teams.EXPECT().GetAllTeamsWithArchived(gomock.Any()).Return(&current.teams, nil)
+	m.users.EXPECT().GetAllUsers(gomock.Any()).Return(&current.users, nil)
+	var memberships []team_memberships2.TeamMembershipOut
+	for _, user := range current.users {
+		memberships = append(memberships, team_memberships2.TeamMembershipOut{UserID: user.ID, TeamID: user.TeamID, IsPrimary: true})
+		for _, teamID := range current.additional[user.ID] {
+			memberships = append(memberships, team_memberships2.TeamMembershipOut{UserID: user.ID, TeamID: teamID})
+		}
+	}
+	m.memberships.EXPECT().GetTeamMembershipsByUserIDs(gomock.Any(), gomock.Any()).Return(&memberships, nil)
+}
+
+func TestValidate(t *testing.T) {
+	alice := Member{UserID: uuid.New(), Username: "alice", IsActive: true}
+	bob := Member{UserID: uuid.New(), Username: "bob", IsActive: true}
+
+	tests := []struct {
+		name    string
+		teams   []Team
+		wantErr error
+	}{
+		{
+			name: "valid state",
+			teams: []Team{
+				{TeamName: "platform", Members: []Member{alice}},
+				{TeamName: "backend", ParentTeamName: "platform", Members: []Member{bob}, AdditionalMemberIDs: []uuid.UUID{alice.UserID}},
+			},
+		},
+		{
+			name:    "team without a name",
+			teams:   []Team{{Members: []Member{alice}}},
+			wantErr: usecase2.ErrInvalidTeamsState,
+		},
+		{
+			name:    "team listed twice",
+			teams:   []Team{{TeamName: "platform"}, {TeamName: "platform"}},
+			wantErr: usecase2.ErrInvalidTeamsState,
+		},
+		{
+			name:    "parent is not listed",
+			teams:   []Team{{TeamName: "backend", ParentTeamName: "platform"}},
+			wantErr: usecase2.ErrParentTeamNotFound,
+		},
+		{
+			name: "parents form a cycle",
+			teams: []Team{
+				{TeamName: "platform", ParentTeamName: "backend"},
+				{TeamName: "backend", ParentTeamName: "platform"},
+			},
+			wantErr: usecase2.ErrTeamHierarchyCycle,
+		},
+		{
+			name:    "member without username",
+			teams:   []Team{{TeamName: "platform", Members: []Member{{UserID: alice.UserID}}}},
+			wantErr: usecase2.ErrInvalidTeamsState,
+		},
+		{
+			name:    "user is a primary member of two teams",
+			teams:   []Team{{TeamName: "platform", Members: []Member{alice}}, {TeamName: "backend", Members: []Member{alice}}},
+			wantErr: usecase2.ErrDuplicateUsers,
+		},
+		{
+			name:    "additional member is not listed",
+			teams:   []Team{{TeamName: "platform", Members: []Member{alice}, AdditionalMemberIDs: []uuid.UUID{bob.UserID}}},
+			wantErr: usecase2.ErrInvalidTeamsState,
+		},
+		{
+			name:    "additional member of own primary team",
+			teams:   []Team{{TeamName: "platform", Members: []Member{alice}, AdditionalMemberIDs: []uuid.UUID{alice.UserID}}},
+			wantErr: usecase2.ErrInvalidTeamsState,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validate(context.Background(), In{Teams: tt.teams})
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	platform := teams2.TeamOut{ID: uuid.New(), Name: "platform"}
+	backend := teams2.TeamOut{ID: uuid.New(), Name: "backend", ParentTeamID: &platform.ID}
+	archivedAt := time.Now()
+	legacy := teams2.TeamOut{ID: uuid.New(), Name: "legacy", ArchivedAt: &archivedAt}
+	alice := users2.UserOut{ID: uuid.New(), Name: "alice", IsActive: true, TeamID: platform.ID}
+	bob := users2.UserOut{ID: uuid.New(), Name: "bob", IsActive: true, TeamID: backend.ID}
+	carol := users2.UserOut{ID: uuid.New(), Name: "carol", IsActive: false, TeamID: backend.ID}
+	current := state{
+		teams:      []teams2.TeamOut{backend, legacy, platform},
+		users:      []users2.UserOut{alice, bob, carol},
+		additional: map[uuid.UUID][]uuid.UUID{alice.ID: {backend.ID}},
+	}
+	unchanged := []Team{
+		{
+			TeamName:   "platform",
+			Members:    []Member{{UserID: alice.ID, Username: "alice", IsActive: true}},
+			IsArchived: false,
+		},
+		{
+			TeamName:       "backend",
+			ParentTeamName: "platform",
+			Members: []Member{
+				{UserID: bob.ID, Username: "bob", IsActive: true},
+				{UserID: carol.ID, Username: "carol", IsActive: false},
+			},
+			AdditionalMemberIDs: []uuid.UUID{alice.ID},
+		},
+		{TeamName: "legacy", IsArchived: true},
+	}
+	daveID := uuid.New()
+
+	tests := []struct {
+		name  string
+		teams func() []Team
+		want  []Change
+	}{
+		{
+			name:  "desired state matches current",
+			teams: func() []Team { return unchanged },
+		},
+		{
+			name: "teams are created, reparented, unarchived and archived",
+			teams: func() []Team {
+				return []Team{
+					{TeamName: "platform", ParentTeamName: "infra", Members: unchanged[0].Members},
+					{
+						TeamName:            "backend",
+						Members:             unchanged[1].Members,
+						AdditionalMemberIDs: []uuid.UUID{alice.ID},
+					},
+					{TeamName: "legacy"},
+					{TeamName: "infra", IsArchived: true},
+				}
+			},
+			want: []Change{
+				{Action: ActionCreateTeam, TeamName: "infra"},
+				{Action: ActionSetParent, TeamName: "platform", To: "infra"},
+				{Action: ActionSetParent, TeamName: "backend", From: "platform"},
+				{Action: ActionUnarchiveTeam, TeamName: "legacy"},
+				{Action: ActionArchiveTeam, TeamName: "infra"},
+			},
+		},
+		{
+			name: "missing team is archived and its missing members are deactivated",
+			teams: func() []Team {
+				return []Team{unchanged[0], unchanged[2]}
+			},
+			want: []Change{
+				{Action: ActionRemoveMembership, TeamName: "backend", UserID: alice.ID},
+				{Action: ActionDeactivateUser, TeamName: "backend", UserID: bob.ID},
+				{Action: ActionArchiveTeam, TeamName: "backend"},
+			},
+		},
+		{
+			name: "users are created, renamed, moved and change activity",
+			teams: func() []Team {
+				return []Team{
+					{
+						TeamName: "platform",
+						Members: []Member{
+							{UserID: daveID, Username: "dave", IsActive: true},
+							{UserID: bob.ID, Username: "bob", IsActive: false},
+						},
+						AdditionalMemberIDs: []uuid.UUID{alice.ID},
+					},
+					{
+						TeamName:       "backend",
+						ParentTeamName: "platform",
+						Members: []Member{
+							{UserID: alice.ID, Username: "alice.smith", IsActive: true},
+							{UserID: carol.ID, Username: "carol", IsActive: true},
+						},
+					},
+					unchanged[2],
+				}
+			},
+			want: []Change{
+				{Action: ActionCreateUser, TeamName: "platform", UserID: daveID, To: "dave"},
+				{Action: ActionRenameUser, TeamName: "backend", UserID: alice.ID, From: "alice", To: "alice.smith"},
+				{Action: ActionMoveUser, TeamName: "platform", UserID: bob.ID, From: "backend", To: "platform"},
+				{Action: ActionMoveUser, TeamName: "backend", UserID: alice.ID, From: "platform", To: "backend"},
+				{Action: ActionAddMembership, TeamName: "platform", UserID: alice.ID},
+				{Action: ActionActivateUser, TeamName: "backend", UserID: carol.ID},
+				{Action: ActionDeactivateUser, TeamName: "platform", UserID: bob.ID},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := In{Teams: tt.teams()}
+			require.NoError(t, validate(context.Background(), req))
+			assert.Equal(t, tt.want, diff(req, current))
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	platform := teams2.TeamOut{ID: uuid.New(), Name: "platform"}
+	backend := teams2.TeamOut{ID: uuid.New(), Name: "backend"}
+	alice := users2.UserOut{ID: uuid.New(), Name: "alice", IsActive: true, TeamID: platform.ID}
+	bob := users2.UserOut{ID: uuid.New(), Name: "bob", IsActive: true, TeamID: backend.ID}
+	current := state{
+		teams: []teams2.TeamOut{backend, platform},
+		users: []users2.UserOut{alice, bob},
+	}
+	daveID := uuid.New()
+	prID := uuid.New()
+	desired := []Team{
+		{
+			TeamName: "platform",
+			Members: []Member{
+				{UserID: alice.ID, Username: "alice", IsActive: true},
+				{UserID: bob.ID, Username: "bob", IsActive: true},
+			},
+		},
+		{
+			TeamName:            "frontend",
+			ParentTeamName:      "platform",
+			Members:             []Member{{UserID: daveID, Username: "dave", IsActive: true}},
+			AdditionalMemberIDs: []uuid.UUID{alice.ID},
+		},
+	}
+	wantChanges := []Change{
+		{Action: ActionCreateTeam, TeamName: "frontend"},
+		{Action: ActionSetParent, TeamName: "frontend", To: "platform"},
+		{Action: ActionCreateUser, TeamName: "frontend", UserID: daveID, To: "dave"},
+		{Action: ActionMoveUser, TeamName: "platform", UserID: bob.ID, From: "backend", To: "platform"},
+		{Action: ActionAddMembership, TeamName: "frontend", UserID: alice.ID},
+		{Action: ActionArchiveTeam, TeamName: "backend"},
+	}
+
+	t.Run("plan returns changes without applying them", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.expectState(current)
+
+		result, err := m.usecase().Run(context.Background(), In{Teams: desired, Plan: true})
+		require.NoError(t, err)
+		assert.True(t, result.Plan)
+		assert.Equal(t, wantChanges, result.Changes)
+	})
+
+	t.Run("apply changes in one transaction", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.inTransaction()
+		m.expectState(current)
+
+		frontendID := uuid.Nil
+		m.teams.EXPECT().SaveTeam(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, team teams2.TeamIn) (*teams2.TeamOut, error) {
+				assert.Equal(t, "frontend", team.Name)
+				frontendID = team.ID
+				return &teams2.TeamOut{ID: team.ID, Name: team.Name}, nil
+			})
+		m.teams.EXPECT().UpdateTeamParent(gomock.Any(), gomock.Any(), &platform.ID).
+			DoAndReturn(func(_ context.Context, teamID uuid.UUID, _ *uuid.UUID) (*teams2.TeamOut, error) {
+				assert.Equal(t, frontendID, teamID)
+				return &teams2.TeamOut{}, nil
+			})
+		m.users.EXPECT().SaveUsersBatch(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, urs []users2.UserIn) (*[]users2.UserOut, error) {
+				assert.Equal(t, []users2.UserIn{{ID: daveID, Name: "dave", IsActive: true, TeamID: frontendID}}, urs)
+				return &[]users2.UserOut{}, nil
+			})
+		m.memberships.EXPECT().SaveTeamMembershipsBatch(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, memberships []team_memberships2.TeamMembershipIn) (*[]team_memberships2.TeamMembershipOut, error) {
+				assert.Equal(t, []team_memberships2.TeamMembershipIn{{UserID: daveID, TeamID: frontendID, IsPrimary: true}}, memberships)
+				return &[]team_memberships2.TeamMembershipOut{}, nil
+			})
+		m.mover.EXPECT().Run(gomock.Any(), user_move_team.In{UserID: bob.ID, TeamName: "platform", ReassignReviews: true}).
+			Return(&user_move_team.Out{ReassignedReviews: []user_move_team.ReassignedReview{{PullRequestID: prID}}}, nil)
+		m.memberships.EXPECT().SaveTeamMembershipsBatch(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, memberships []team_memberships2.TeamMembershipIn) (*[]team_memberships2.TeamMembershipOut, error) {
+				assert.Equal(t, []team_memberships2.TeamMembershipIn{{UserID: alice.ID, TeamID: frontendID}}, memberships)
+				return &[]team_memberships2.TeamMembershipOut{}, nil
+			})
+		m.teams.EXPECT().SetTeamArchived(gomock.Any(), backend.ID, true).Return(&teams2.TeamOut{}, nil)
+		m.publisher.EXPECT().Publish(gomock.Any(), []usecase2.Event{
+			{Type: usecase2.EventTeamCreated, TeamName: "frontend"},
+			{Type: usecase2.EventTeamUpdated, TeamName: "backend"},
+			{Type: usecase2.EventTeamUpdated, TeamName: "platform"},
+			{Type: usecase2.EventTeamArchived, TeamName: "backend"},
+		}).Return(nil)
+
+		result, err := m.usecase().Run(context.Background(), In{Teams: desired})
+		require.NoError(t, err)
+		assert.False(t, result.Plan)
+		assert.Equal(t, wantChanges, result.Changes)
+		assert.Equal(t, []user_move_team.ReassignedReview{{PullRequestID: prID}}, result.ReassignedReviews)
+	})
+
+	t.Run("activity changes go through activation usecases", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.inTransaction()
+		inactiveBob := bob
+		inactiveBob.IsActive = false
+		m.expectState(state{teams: current.teams, users: []users2.UserOut{alice, inactiveBob}})
+
+		m.activator.EXPECT().Run(gomock.Any(), team_activate_users.In{TeamName: "backend", UserIDs: []uuid.UUID{bob.ID}}).
+			Return(&team_activate_users.Out{}, nil)
+		m.deactivator.EXPECT().Run(gomock.Any(), team_deactivate_users.In{TeamName: "platform", UserIDs: []uuid.UUID{alice.ID}}).
+			Return(&team_deactivate_users.Out{
+				AffectedPullRequests: []team_deactivate_users.AffectedPullRequest{{PullRequestID: prID}},
+			}, nil)
+		m.publisher.EXPECT().Publish(gomock.Any(), gomock.Len(0)).Return(nil)
+
+		result, err := m.usecase().Run(context.Background(), In{Teams: []Team{
+			{TeamName: "platform", Members: []Member{{UserID: alice.ID, Username: "alice", IsActive: false}}},
+			{TeamName: "backend", Members: []Member{{UserID: bob.ID, Username: "bob", IsActive: true}}},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t, []team_deactivate_users.AffectedPullRequest{{PullRequestID: prID}}, result.AffectedPullRequests)
+	})
+
+	t.Run("failed step rolls the transaction back", func(t *testing.T) {
+		m := newMocks(ctrl)
+		m.inTransaction()
+		m.expectState(current)
+		m.teams.EXPECT().SaveTeam(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+		result, err := m.usecase().Run(context.Background(), In{Teams: desired})
+		assert.ErrorIs(t, err, usecase2.ErrSaveTeam)
+		assert.Nil(t, result)
+	})
+
+	t.Run("invalid state is rejected before reading the database", func(t *testing.T) {
+		m := newMocks(ctrl)
+
+		result, err := m.usecase().Run(context.Background(), In{Teams: []Team{{TeamName: "platform", ParentTeamName: "platform"}}})
+		assert.ErrorIs(t, err, usecase2.ErrTeamHierarchyCycle)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,14 @@
+package team_archive
+
+import "time"
+
+type In struct {
+	TeamName   string
+	IsArchived bool
+}
+
+type Out struct {
+	TeamName   string
+	IsArchived bool
+	ArchivedAt *time.Time
+}
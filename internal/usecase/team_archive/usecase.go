@@ -0,0 +1,81 @@
+package team_archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+type usecase struct {
+	repTeams  teams.RepositoryTeams
+	publisher events.Publisher
+	trm       trm.Manager
+}
+
+func NewUsecase(repTeams teams.RepositoryTeams, publisher events.Publisher, trm trm.Manager) *usecase {
+	return &usecase{
+		repTeams:  repTeams,
+		publisher: publisher,
+		trm:       trm,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Get team by name", "team_name", req.TeamName)
+	team, err := u.repTeams.GetTeamByName(ctx, req.TeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamNotFound, req.TeamName))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, req.TeamName))
+	}
+
+	if (team.ArchivedAt != nil) == req.IsArchived {
+		slog.DebugContext(ctx, "Team already has required archive state",
+			"team_name", req.TeamName, "is_archived", req.IsArchived)
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamDontNeedChange, req.TeamName))
+	}
+
+	slog.DebugContext(ctx, "Set team archive state", "team_id", team.ID, "is_archived", req.IsArchived)
+	updatedTeam, err := u.repTeams.SetTeamArchived(ctx, team.ID, req.IsArchived)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrUpdateTeam, team.ID))
+	}
+
+	eventType := usecase2.EventTeamUnarchived
+	if updatedTeam.ArchivedAt != nil {
+		eventType = usecase2.EventTeamArchived
+	}
+	slog.DebugContext(ctx, "Publish team event", "type", eventType)
+	if err = u.publisher.Publish(ctx, []usecase2.Event{{Type: eventType, TeamName: updatedTeam.Name}}); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase ArchiveTeam success", "is_archived", req.IsArchived)
+	return &Out{
+		TeamName:   updatedTeam.Name,
+		IsArchived: updatedTeam.ArchivedAt != nil,
+		ArchivedAt: updatedTeam.ArchivedAt,
+	}, nil
+}
@@ -0,0 +1,237 @@
+package team_archive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	archivedAt := time.Now()
+	activeTeam := &teams2.TeamOut{
+		ID:   teamID,
+		Name: "billing",
+	}
+	archivedTeam := &teams2.TeamOut{
+		ID:         teamID,
+		Name:       "billing",
+		ArchivedAt: &archivedAt,
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockTeams *teams.MockRepositoryTeams,
+			mockTrm *mock.MockManager,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "successful archive",
+			req:  In{TeamName: "billing", IsArchived: true},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(activeTeam, nil)
+				mockTeams.EXPECT().SetTeamArchived(gomock.Any(), teamID, true).Return(archivedTeam, nil)
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expected: &Out{
+				TeamName:   "billing",
+				IsArchived: true,
+				ArchivedAt: &archivedAt,
+			},
+		},
+		{
+			name: "successful restore",
+			req:  In{TeamName: "billing", IsArchived: false},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(archivedTeam, nil)
+				mockTeams.EXPECT().SetTeamArchived(gomock.Any(), teamID, false).Return(activeTeam, nil)
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expected: &Out{
+				TeamName:   "billing",
+				IsArchived: false,
+			},
+		},
+		{
+			name: "team already archived",
+			req:  In{TeamName: "billing", IsArchived: true},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(archivedTeam, nil)
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrTeamDontNeedChange,
+		},
+		{
+			name: "team not found",
+			req:  In{TeamName: "billing", IsArchived: true},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(nil, repository.ErrTeamNotFound)
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrTeamNotFound,
+		},
+		{
+			name: "error getting team",
+			req:  In{TeamName: "billing", IsArchived: true},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(nil, errors.New("db error"))
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
+		{
+			name: "error updating team",
+			req:  In{TeamName: "billing", IsArchived: true},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(activeTeam, nil)
+				mockTeams.EXPECT().SetTeamArchived(gomock.Any(), teamID, true).Return(nil, errors.New("db error"))
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrUpdateTeam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			tt.setupMock(mockRepoTeams, mockTrm)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			u := NewUsecase(mockRepoTeams, mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestArchiveTeamPublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	archivedAt := time.Now()
+	activeTeam := &teams2.TeamOut{ID: teamID, Name: "billing"}
+	archivedTeam := &teams2.TeamOut{ID: teamID, Name: "billing", ArchivedAt: &archivedAt}
+	publishErr := errors.New("publish error")
+
+	tests := []struct {
+		name          string
+		isArchived    bool
+		existing      *teams2.TeamOut
+		updated       *teams2.TeamOut
+		expectedType  string
+		publishErr    error
+		expectedError error
+	}{
+		{
+			name:         "archived event is published",
+			isArchived:   true,
+			existing:     activeTeam,
+			updated:      archivedTeam,
+			expectedType: usecase2.EventTeamArchived,
+		},
+		{
+			name:         "unarchived event is published",
+			isArchived:   false,
+			existing:     archivedTeam,
+			updated:      activeTeam,
+			expectedType: usecase2.EventTeamUnarchived,
+		},
+		{
+			name:          "publish error rolls back archive",
+			isArchived:    true,
+			existing:      activeTeam,
+			updated:       archivedTeam,
+			expectedType:  usecase2.EventTeamArchived,
+			publishErr:    publishErr,
+			expectedError: publishErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(tt.existing, nil)
+			mockRepoTeams.EXPECT().SetTeamArchived(gomock.Any(), teamID, tt.isArchived).Return(tt.updated, nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{{Type: tt.expectedType, TeamName: "billing"}}).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockRepoTeams, mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), In{TeamName: "billing", IsArchived: tt.isArchived})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.isArchived, result.IsArchived)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import "github.com/google/uuid"
 type In struct {
 	TeamName string
 	UserIDs  []uuid.UUID
+	DryRun   bool
 }
 
 type TeamMember struct {
@@ -25,7 +26,18 @@ type PullRequestShort struct {
 	Status          string
 }
 
+type AffectedPullRequest struct {
+	PullRequestID    uuid.UUID
+	PullRequestName  string
+	AuthorID         uuid.UUID
+	Status           string
+	RemovedReviewers []uuid.UUID
+	AddedReviewers   []uuid.UUID
+	Understaffed     bool
+}
+
 type Out struct {
 	Team                 Team
-	AffectedPullRequests []PullRequestShort
+	AffectedPullRequests []AffectedPullRequest
+	DryRun               bool
 }
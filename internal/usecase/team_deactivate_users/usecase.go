@@ -11,6 +11,7 @@ import (
 	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
 	"pr-reviewers-service/internal/logging"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
 	"pr-reviewers-service/internal/usecase/contract/randomizer"
 	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
 	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
@@ -22,13 +23,18 @@ import (
 	"github.com/google/uuid"
 )
 
+// errDryRunRollback aborts the transaction after the report is built so that nothing is committed.
+var errDryRunRollback = errors.New("dry run rollback")
+
 type usecase struct {
 	repTeams        teams.RepositoryTeams
 	repUsers        users.RepositoryUsers
 	repPullRequests pull_requests.RepositoryPullRequests
 	repPRReviewers  pr_reviewers.RepositoryPrReviewers
 	repPRStatuses   pr_statuses.RepositoryPrStatuses
-	randomizer      randomizer.Randomizer
+	selector        usecase2.ReviewerSelector
+	maxCntReviewers int
+	publisher       events.Publisher
 	trm             trm.Manager
 }
 
@@ -39,6 +45,8 @@ func NewUsecase(
 	repPRReviewers pr_reviewers.RepositoryPrReviewers,
 	repPRStatuses pr_statuses.RepositoryPrStatuses,
 	randomizer randomizer.Randomizer,
+	maxCntReviewers int,
+	publisher events.Publisher,
 	trm trm.Manager,
 ) *usecase {
 	return &usecase{
@@ -47,7 +55,9 @@ func NewUsecase(
 		repPullRequests: repPullRequests,
 		repPRReviewers:  repPRReviewers,
 		repPRStatuses:   repPRStatuses,
-		randomizer:      randomizer,
+		selector:        usecase2.NewReviewerSelector(repUsers, repTeams, randomizer),
+		maxCntReviewers: maxCntReviewers,
+		publisher:       publisher,
 		trm:             trm,
 	}
 }
@@ -58,8 +68,18 @@ func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
 
 	err = u.trm.Do(ctx, func(ctx context.Context) error {
 		result, err = u.run(ctx, req)
-		return err
+		if err != nil {
+			return err
+		}
+		if req.DryRun {
+			return errDryRunRollback
+		}
+		return nil
 	})
+	if errors.Is(err, errDryRunRollback) {
+		slog.DebugContext(ctx, "Dry run finished, changes rolled back")
+		return result, nil
+	}
 
 	return result, err
 }
@@ -83,12 +103,18 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrUsersByIDsNotFound))
 	}
 	userIDs := make(map[uuid.UUID]struct{})
+	var additionalMembers []uuid.UUID
 	for _, user := range *existingUsers {
 		if user.TeamID != team.ID {
-			return nil, logging.WrapError(ctx, fmt.Errorf("%w: user %s not in team %s", usecase2.ErrUserNotBelongsToTeam, user.ID, req.TeamName))
+			additionalMembers = append(additionalMembers, user.ID)
 		}
 		userIDs[user.ID] = struct{}{}
 	}
+	if len(additionalMembers) > 0 {
+		if err = u.checkTeamMembership(ctx, team.ID, req.TeamName, additionalMembers); err != nil {
+			return nil, err
+		}
+	}
 	for _, userID := range req.UserIDs {
 		if _, exist := userIDs[userID]; !exist {
 			return nil, logging.WrapError(ctx, fmt.Errorf("%w: user %s", usecase2.ErrUserNotFound, userID))
@@ -100,9 +126,6 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 	if err != nil {
 		return nil, err
 	}
-	if len(prsToAffect) == 0 {
-		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrNoPRsToAffect))
-	}
 
 	slog.DebugContext(ctx, "Deactivate users", "users_count", len(req.UserIDs))
 	var usersToUpdate []users2.UserIn
@@ -147,25 +170,62 @@ func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
 		}
 	}
 
+	domainEvents := make([]usecase2.Event, 0, len(usersToUpdate))
+	for _, user := range usersToUpdate {
+		domainEvents = append(domainEvents, usecase2.Event{
+			Type:     usecase2.EventUserDeactivated,
+			UserID:   user.ID,
+			TeamName: team.Name,
+		})
+	}
+	domainEvents = append(domainEvents, reviewerEvents(reassignedPRs)...)
+	slog.DebugContext(ctx, "Publish deactivation events", "count", len(domainEvents))
+	if err = u.publisher.Publish(ctx, domainEvents); err != nil {
+		return nil, err
+	}
+
 	slog.DebugContext(ctx, "UseCase DeactivateTeamUsers success",
 		"deactivated_users", len(usersToUpdate),
-		"affected_prs", len(reassignedPRs))
+		"affected_prs", len(reassignedPRs),
+		"dry_run", req.DryRun)
 	return &Out{
 		Team: Team{
 			TeamName: team.Name,
 			Members:  members,
 		},
 		AffectedPullRequests: reassignedPRs,
+		DryRun:               req.DryRun,
 	}, nil
 }
 
+// checkTeamMembership verifies that users whose primary team differs still belong to the team as additional members.
+func (u *usecase) checkTeamMembership(ctx context.Context, teamID uuid.UUID, teamName string, userIDs []uuid.UUID) error {
+	teamMembers, err := u.repUsers.GetUsersByTeamID(ctx, teamID)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, teamID))
+	}
+	memberIDs := make(map[uuid.UUID]struct{})
+	if teamMembers != nil {
+		for _, member := range *teamMembers {
+			memberIDs[member.ID] = struct{}{}
+		}
+	}
+	for _, userID := range userIDs {
+		if _, isMember := memberIDs[userID]; !isMember {
+			return logging.WrapError(ctx, fmt.Errorf("%w: user %s not in team %s", usecase2.ErrUserNotBelongsToTeam, userID, teamName))
+		}
+	}
+	return nil
+}
+
 func (u *usecase) findPRsToAffect(ctx context.Context, userIDs []uuid.UUID) ([]PullRequestShort, error) {
 	allReviewers, err := u.repPRReviewers.GetPRReviewersByReviewerIDs(ctx, userIDs)
 	if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
 		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPRReviewers))
 	}
 	if allReviewers == nil || len(*allReviewers) == 0 {
-		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrNoUsersAssignedToPRs))
+		slog.DebugContext(ctx, "Users are not assigned to any PR")
+		return []PullRequestShort{}, nil
 	}
 
 	prIDs := make([]uuid.UUID, 0, len(*allReviewers))
@@ -217,22 +277,31 @@ func (u *usecase) findPRsToAffect(ctx context.Context, userIDs []uuid.UUID) ([]P
 	return affectedPRs, nil
 }
 
-func (u *usecase) reassignPRReviewers(ctx context.Context, affectedPRs []PullRequestShort, deactivatedUserIDs []uuid.UUID) ([]PullRequestShort, error) {
+func (u *usecase) reassignPRReviewers(ctx context.Context, affectedPRs []PullRequestShort, deactivatedUserIDs []uuid.UUID) ([]AffectedPullRequest, error) {
 	usersToDeactivateMap := make(map[uuid.UUID]struct{})
 	for _, userID := range deactivatedUserIDs {
 		usersToDeactivateMap[userID] = struct{}{}
 	}
 
-	reassignedPRs := make([]PullRequestShort, 0, len(affectedPRs))
+	reassignedPRs := make([]AffectedPullRequest, 0, len(affectedPRs))
 	for _, pr := range affectedPRs {
 		slog.DebugContext(ctx, "Processing PR for reviewer reassignment", "pr_id", pr.PullRequestID)
+		report := AffectedPullRequest{
+			PullRequestID:    pr.PullRequestID,
+			PullRequestName:  pr.PullRequestName,
+			AuthorID:         pr.AuthorID,
+			Status:           pr.Status,
+			RemovedReviewers: []uuid.UUID{},
+			AddedReviewers:   []uuid.UUID{},
+		}
 
 		currentReviewers, err := u.repPRReviewers.GetPRReviewersByPRID(ctx, pr.PullRequestID)
 		if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
 			return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrGetPRReviewers, pr.PullRequestID))
 		}
 		if currentReviewers == nil || len(*currentReviewers) == 0 {
-			reassignedPRs = append(reassignedPRs, pr)
+			report.Understaffed = 0 < u.maxCntReviewers
+			reassignedPRs = append(reassignedPRs, report)
 			continue
 		}
 
@@ -254,34 +323,26 @@ func (u *usecase) reassignPRReviewers(ctx context.Context, affectedPRs []PullReq
 		if err != nil {
 			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, prInfo.AuthorID))
 		}
-		teamMembers, err := u.repUsers.GetActiveUsersByTeamID(ctx, author.TeamID)
-		if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
-			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, author.TeamID))
+		excluded := []uuid.UUID{author.ID}
+		for _, reviewer := range *currentReviewers {
+			excluded = append(excluded, reviewer.ReviewerID)
 		}
-		availableReviewers := u.getAvailableReviewersFromTeam(*teamMembers, author.ID, *currentReviewers)
-		if len(availableReviewers) == 0 {
-			slog.WarnContext(ctx, "No available reviewers found for PR", "pr_id", pr.PullRequestID, "team_id", author.TeamID)
-			for _, reviewerID := range usersToDeactivate {
-				err := u.repPRReviewers.DeletePRReviewerByPRAndReviewer(ctx, pr.PullRequestID, reviewerID)
-				if err != nil {
-					return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer_id %s", usecase2.ErrRemoveReviewer, reviewerID))
-				}
-			}
-			reassignedPRs = append(reassignedPRs, pr)
-			continue
+		newReviewers, err := u.selector.Select(ctx, author.TeamID, len(usersToDeactivate), excluded...)
+		if err != nil {
+			return nil, err
 		}
-		newReviewers := u.selectRandomReviewers(availableReviewers, len(usersToDeactivate))
 
 		for _, reviewer := range newReviewers {
 			reviewerIn := pr_reviewers2.PrReviewerIn{
 				PrID:       pr.PullRequestID,
-				ReviewerID: reviewer.ID,
+				ReviewerID: reviewer.User.ID,
 			}
 			_, err = u.repPRReviewers.SavePRReviewer(ctx, reviewerIn)
 			if err != nil {
-				return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer %s", usecase2.ErrAssignReviewer, reviewer.ID))
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer %s", usecase2.ErrAssignReviewer, reviewer.User.ID))
 			}
-			slog.DebugContext(ctx, "Assigned new reviewer", "pr_id", pr.PullRequestID, "reviewer_id", reviewer.ID)
+			report.AddedReviewers = append(report.AddedReviewers, reviewer.User.ID)
+			slog.DebugContext(ctx, "Assigned new reviewer", "pr_id", pr.PullRequestID, "reviewer_id", reviewer.User.ID)
 		}
 
 		for _, reviewerID := range usersToDeactivate {
@@ -289,55 +350,45 @@ func (u *usecase) reassignPRReviewers(ctx context.Context, affectedPRs []PullReq
 			if err != nil {
 				return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer_id %s", usecase2.ErrRemoveReviewer, reviewerID))
 			}
+			report.RemovedReviewers = append(report.RemovedReviewers, reviewerID)
 		}
 
-		reassignedPRs = append(reassignedPRs, pr)
+		remainingReviewers := len(*currentReviewers) - len(report.RemovedReviewers) + len(report.AddedReviewers)
+		report.Understaffed = remainingReviewers < u.maxCntReviewers
+
+		reassignedPRs = append(reassignedPRs, report)
 		slog.DebugContext(ctx, "PR reassignment completed",
 			"pr_id", pr.PullRequestID,
-			"removed_reviewers", len(usersToDeactivate),
-			"added_reviewers", func() int {
-				if teamMembers == nil || len(availableReviewers) == 0 {
-					return 0
-				}
-				return min(len(usersToDeactivate), len(availableReviewers))
-			}())
+			"removed_reviewers", len(report.RemovedReviewers),
+			"added_reviewers", len(report.AddedReviewers),
+			"understaffed", report.Understaffed)
 	}
 
 	return reassignedPRs, nil
 }
 
-func (u *usecase) getAvailableReviewersFromTeam(
-	teamMembers []users2.UserOut,
-	authorID uuid.UUID,
-	currentReviewers []pr_reviewers2.PrReviewerOut,
-) []users2.UserOut {
-	var available []users2.UserOut
-
-	currentReviewerMap := make(map[uuid.UUID]bool)
-	for _, reviewer := range currentReviewers {
-		currentReviewerMap[reviewer.ReviewerID] = true
-	}
-
-	for _, member := range teamMembers {
-		if member.ID == authorID || !member.IsActive || currentReviewerMap[member.ID] {
-			continue
+// reviewerEvents describes every reviewer change of the affected pull requests, removals go first.
+func reviewerEvents(affectedPRs []AffectedPullRequest) []usecase2.Event {
+	var reviewerEvents []usecase2.Event
+	for _, pr := range affectedPRs {
+		for _, reviewerID := range pr.RemovedReviewers {
+			reviewerEvents = append(reviewerEvents, usecase2.Event{
+				Type:            usecase2.EventReviewerUnassigned,
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				ReviewerID:      reviewerID,
+			})
+		}
+		for _, reviewerID := range pr.AddedReviewers {
+			reviewerEvents = append(reviewerEvents, usecase2.Event{
+				Type:            usecase2.EventReviewerAssigned,
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				ReviewerID:      reviewerID,
+			})
 		}
-		available = append(available, member)
-	}
-	return available
-}
-
-func (u *usecase) selectRandomReviewers(available []users2.UserOut, cnt int) []users2.UserOut {
-	if len(available) == 1 {
-		return available
 	}
-
-	shuffled := make([]users2.UserOut, len(available))
-	copy(shuffled, available)
-
-	u.randomizer.Shuffle(len(shuffled), func(i, j int) {
-		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
-	})
-
-	return shuffled[:cnt]
+	return reviewerEvents
 }
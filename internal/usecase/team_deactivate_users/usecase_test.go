@@ -13,6 +13,7 @@ import (
 	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
 	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
 	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
 	randomizer2 "pr-reviewers-service/internal/usecase/contract/randomizer/mocks"
 	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
 	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
@@ -27,6 +28,10 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+const (
+	cntReviewers = 2
+)
+
 func TestTeamDeactivateUsers(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -36,6 +41,7 @@ func TestTeamDeactivateUsers(t *testing.T) {
 	user1ID := uuid.New()
 	user2ID := uuid.New()
 	user3ID := uuid.New()
+	user4ID := uuid.New()
 	pr1ID := uuid.New()
 	pr2ID := uuid.New()
 	statusID := uuid.New()
@@ -217,6 +223,10 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					GetActiveUsersByTeamID(gomock.Any(), teamID).
 					Return(&[]users2.UserOut{activeUsers[2]}, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(team, nil)
+
 				mockPRReviewers.EXPECT().
 					DeletePRReviewerByPRAndReviewer(gomock.Any(), pr1ID, user1ID).
 					Return(nil)
@@ -300,10 +310,132 @@ func TestTeamDeactivateUsers(t *testing.T) {
 						{UserID: uuid.MustParse("4a9a18c2-7c90-42a5-b839-7c052fbae8e0"), Username: "user4", IsActive: true},
 					},
 				},
-				AffectedPullRequests: []PullRequestShort{
-					{PullRequestID: pr1ID, PullRequestName: "PR 1", AuthorID: user3ID, Status: usecase2.OpenStatusValue},
-					{PullRequestID: pr2ID, PullRequestName: "PR 2", AuthorID: user2ID, Status: usecase2.OpenStatusValue},
+				AffectedPullRequests: []AffectedPullRequest{
+					{
+						PullRequestID:    pr1ID,
+						PullRequestName:  "PR 1",
+						AuthorID:         user3ID,
+						Status:           usecase2.OpenStatusValue,
+						RemovedReviewers: []uuid.UUID{user1ID, user2ID},
+						AddedReviewers:   []uuid.UUID{},
+						Understaffed:     true,
+					},
+					{
+						PullRequestID:    pr2ID,
+						PullRequestName:  "PR 2",
+						AuthorID:         user2ID,
+						Status:           usecase2.OpenStatusValue,
+						RemovedReviewers: []uuid.UUID{user1ID},
+						AddedReviewers:   []uuid.UUID{uuid.MustParse("4a9a18c2-7c90-42a5-b839-7c052fbae8e0")},
+						Understaffed:     true,
+					},
+				},
+			},
+		},
+		{
+			name: "dry run returns full report and rolls back",
+			req: In{
+				TeamName: teamName,
+				UserIDs:  []uuid.UUID{user1ID},
+				DryRun:   true,
+			},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				activeUser4 := users2.UserOut{ID: user4ID, Name: "user4", IsActive: true, TeamID: teamID}
+				pr2Reviewers := []pr_reviewers2.PrReviewerOut{
+					{ID: uuid.New(), PRID: pr2ID, ReviewerID: user1ID},
+					{ID: uuid.New(), PRID: pr2ID, ReviewerID: user3ID},
+				}
+
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), teamName).
+					Return(team, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByIDs(gomock.Any(), []uuid.UUID{user1ID}).
+					Return(&[]users2.UserOut{activeUsers[0]}, nil)
+
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByReviewerIDs(gomock.Any(), []uuid.UUID{user1ID}).
+					Return(&[]pr_reviewers2.PrReviewerOut{pr2Reviewers[0]}, nil)
+
+				mockPullRequests.EXPECT().
+					GetPullRequestsByPrIDs(gomock.Any(), []uuid.UUID{pr2ID}).
+					Return(&[]pull_requests2.PullRequestOut{openPRs[1]}, nil)
+
+				mockPRStatuses.EXPECT().
+					GetPRStatusesByIDs(gomock.Any(), []uuid.UUID{statusID}).
+					Return(&[]pr_statuses2.PRStatusOut{*openStatus}, nil)
+
+				mockUsers.EXPECT().
+					UpdateUsersBatch(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{inactiveUser}, nil)
+
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), pr2ID).
+					Return(&pr2Reviewers, nil)
+
+				mockPullRequests.EXPECT().
+					GetPullRequestByID(gomock.Any(), pr2ID).
+					Return(&openPRs[1], nil)
+
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), user2ID).
+					Return(&activeUsers[1], nil)
+
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{activeUsers[1], activeUsers[2], activeUser4}, nil)
+
+				mockPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr2ID, ReviewerID: user4ID}).
+					Return(&pr_reviewers2.PrReviewerOut{ID: uuid.New(), PRID: pr2ID, ReviewerID: user4ID}, nil)
+
+				mockPRReviewers.EXPECT().
+					DeletePRReviewerByPRAndReviewer(gomock.Any(), pr2ID, user1ID).
+					Return(nil)
+
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{inactiveUser, activeUsers[1], activeUsers[2], activeUser4}, nil)
+
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						err := f(ctx)
+						assert.ErrorIs(t, err, errDryRunRollback)
+						return err
+					})
+			},
+			expected: &Out{
+				Team: Team{
+					TeamName: teamName,
+					Members: []TeamMember{
+						{UserID: user1ID, Username: "user1", IsActive: false},
+						{UserID: user2ID, Username: "user2", IsActive: true},
+						{UserID: user3ID, Username: "user3", IsActive: true},
+						{UserID: user4ID, Username: "user4", IsActive: true},
+					},
+				},
+				AffectedPullRequests: []AffectedPullRequest{
+					{
+						PullRequestID:    pr2ID,
+						PullRequestName:  "PR 2",
+						AuthorID:         user2ID,
+						Status:           usecase2.OpenStatusValue,
+						RemovedReviewers: []uuid.UUID{user1ID},
+						AddedReviewers:   []uuid.UUID{user4ID},
+						Understaffed:     false,
+					},
 				},
+				DryRun: true,
 			},
 		},
 		{
@@ -452,6 +584,10 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					GetUsersByIDs(gomock.Any(), []uuid.UUID{user1ID}).
 					Return(&[]users2.UserOut{userFromOtherTeam}, nil)
 
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{activeUsers[1], activeUsers[2]}, nil)
+
 				mockTrm.EXPECT().
 					Do(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
@@ -461,7 +597,75 @@ func TestTeamDeactivateUsers(t *testing.T) {
 			expectedError: usecase2.ErrUserNotBelongsToTeam,
 		},
 		{
-			name: "no PRs to affect",
+			name: "additional team member is deactivated",
+			req: In{
+				TeamName: teamName,
+				UserIDs:  []uuid.UUID{user4ID},
+			},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockRandomizer *randomizer2.MockRandomizer,
+				mockTrm *mock.MockManager,
+			) {
+				otherTeamID := uuid.New()
+				additionalMember := users2.UserOut{
+					ID:       user4ID,
+					Name:     "user4",
+					IsActive: true,
+					TeamID:   otherTeamID,
+				}
+				deactivatedMember := additionalMember
+				deactivatedMember.IsActive = false
+
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), teamName).
+					Return(team, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByIDs(gomock.Any(), []uuid.UUID{user4ID}).
+					Return(&[]users2.UserOut{additionalMember}, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{activeUsers[0], additionalMember}, nil)
+
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByReviewerIDs(gomock.Any(), []uuid.UUID{user4ID}).
+					Return(nil, repository.ErrPRReviewerNotFound)
+
+				mockUsers.EXPECT().
+					UpdateUsersBatch(gomock.Any(), []users2.UserIn{
+						{ID: user4ID, Name: "user4", IsActive: false, TeamID: otherTeamID},
+					}).
+					Return(&[]users2.UserOut{deactivatedMember}, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{activeUsers[0], deactivatedMember}, nil)
+
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expected: &Out{
+				Team: Team{
+					TeamName: teamName,
+					Members: []TeamMember{
+						{UserID: user1ID, Username: "user1", IsActive: true},
+						{UserID: user4ID, Username: "user4", IsActive: false},
+					},
+				},
+				AffectedPullRequests: []AffectedPullRequest{},
+			},
+		},
+		{
+			name: "users without reviews are still deactivated",
 			req: In{
 				TeamName: teamName,
 				UserIDs:  []uuid.UUID{user1ID},
@@ -487,16 +691,36 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					GetPRReviewersByReviewerIDs(gomock.Any(), []uuid.UUID{user1ID}).
 					Return(nil, repository.ErrPRReviewerNotFound)
 
+				mockUsers.EXPECT().
+					UpdateUsersBatch(gomock.Any(), []users2.UserIn{
+						{ID: user1ID, Name: "user1", IsActive: false, TeamID: teamID},
+					}).
+					Return(&[]users2.UserOut{inactiveUser}, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{inactiveUser, activeUsers[1], activeUsers[2]}, nil)
+
 				mockTrm.EXPECT().
 					Do(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
 						return f(ctx)
 					})
 			},
-			expectedError: usecase2.ErrNoUsersAssignedToPRs,
+			expected: &Out{
+				Team: Team{
+					TeamName: teamName,
+					Members: []TeamMember{
+						{UserID: user1ID, Username: "user1", IsActive: false},
+						{UserID: user2ID, Username: "user2", IsActive: true},
+						{UserID: user3ID, Username: "user3", IsActive: true},
+					},
+				},
+				AffectedPullRequests: []AffectedPullRequest{},
+			},
 		},
 		{
-			name: "no users assigned to pr",
+			name: "users reviewing only closed PRs are still deactivated",
 			req: In{
 				TeamName: teamName,
 				UserIDs:  []uuid.UUID{user1ID},
@@ -530,13 +754,31 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					GetPRStatusesByIDs(gomock.Any(), []uuid.UUID{closedPR.StatusID}).
 					Return(&[]pr_statuses2.PRStatusOut{*closedStatus}, nil)
 
+				mockUsers.EXPECT().
+					UpdateUsersBatch(gomock.Any(), gomock.Any()).
+					Return(&[]users2.UserOut{inactiveUser}, nil)
+
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{inactiveUser, activeUsers[1], activeUsers[2]}, nil)
+
 				mockTrm.EXPECT().
 					Do(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
 						return f(ctx)
 					})
 			},
-			expectedError: usecase2.ErrNoPRsToAffect,
+			expected: &Out{
+				Team: Team{
+					TeamName: teamName,
+					Members: []TeamMember{
+						{UserID: user1ID, Username: "user1", IsActive: false},
+						{UserID: user2ID, Username: "user2", IsActive: true},
+						{UserID: user3ID, Username: "user3", IsActive: true},
+					},
+				},
+				AffectedPullRequests: []AffectedPullRequest{},
+			},
 		},
 		{
 			name: "error getting PR reviewers",
@@ -764,7 +1006,7 @@ func TestTeamDeactivateUsers(t *testing.T) {
 						{UserID: user3ID, Username: "user3", IsActive: true},
 					},
 				},
-				AffectedPullRequests: []PullRequestShort{},
+				AffectedPullRequests: []AffectedPullRequest{},
 			},
 		},
 		{
@@ -999,6 +1241,10 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					GetActiveUsersByTeamID(gomock.Any(), teamID).
 					Return(&[]users2.UserOut{activeUsers[2]}, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(team, nil)
+
 				mockRandomizer.EXPECT().Shuffle(gomock.Any(), gomock.Any()).AnyTimes()
 
 				mockPRReviewers.EXPECT().
@@ -1068,6 +1314,10 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					GetActiveUsersByTeamID(gomock.Any(), teamID).
 					Return(&[]users2.UserOut{activeUsers[2]}, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(team, nil)
+
 				mockPRReviewers.EXPECT().
 					DeletePRReviewerByPRAndReviewer(gomock.Any(), pr1ID, user1ID).
 					Return(nil)
@@ -1091,8 +1341,16 @@ func TestTeamDeactivateUsers(t *testing.T) {
 						{UserID: user3ID, Username: "user3", IsActive: true},
 					},
 				},
-				AffectedPullRequests: []PullRequestShort{
-					{PullRequestID: pr1ID, PullRequestName: "PR 1", AuthorID: user3ID, Status: usecase2.OpenStatusValue},
+				AffectedPullRequests: []AffectedPullRequest{
+					{
+						PullRequestID:    pr1ID,
+						PullRequestName:  "PR 1",
+						AuthorID:         user3ID,
+						Status:           usecase2.OpenStatusValue,
+						RemovedReviewers: []uuid.UUID{user1ID},
+						AddedReviewers:   []uuid.UUID{},
+						Understaffed:     true,
+					},
 				},
 			},
 		},
@@ -1151,6 +1409,10 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					GetActiveUsersByTeamID(gomock.Any(), teamID).
 					Return(&[]users2.UserOut{activeUsers[2]}, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(team, nil)
+
 				mockRandomizer.EXPECT().Shuffle(gomock.Any(), gomock.Any()).AnyTimes()
 
 				mockPRReviewers.EXPECT().
@@ -1239,6 +1501,10 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					GetActiveUsersByTeamID(gomock.Any(), gomock.Any()).
 					Return(&[]users2.UserOut{activeUsers[2]}, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), gomock.Any()).
+					Return(team, nil)
+
 				mockPRReviewers.EXPECT().
 					DeletePRReviewerByPRAndReviewer(gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(nil)
@@ -1262,8 +1528,16 @@ func TestTeamDeactivateUsers(t *testing.T) {
 						{UserID: user3ID, Username: "user3", IsActive: true},
 					},
 				},
-				AffectedPullRequests: []PullRequestShort{
-					{PullRequestID: pr1ID, PullRequestName: "PR 1", AuthorID: user3ID, Status: usecase2.OpenStatusValue},
+				AffectedPullRequests: []AffectedPullRequest{
+					{
+						PullRequestID:    pr1ID,
+						PullRequestName:  "PR 1",
+						AuthorID:         user3ID,
+						Status:           usecase2.OpenStatusValue,
+						RemovedReviewers: []uuid.UUID{user1ID},
+						AddedReviewers:   []uuid.UUID{},
+						Understaffed:     true,
+					},
 				},
 			},
 		},
@@ -1347,6 +1621,10 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					GetActiveUsersByTeamID(gomock.Any(), teamID).
 					Return(&[]users2.UserOut{activeUsers[2]}, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(team, nil)
+
 				mockPRReviewers.EXPECT().
 					DeletePRReviewerByPRAndReviewer(gomock.Any(), pr1ID, user2ID).
 					Return(nil)
@@ -1375,8 +1653,16 @@ func TestTeamDeactivateUsers(t *testing.T) {
 						{UserID: user3ID, Username: "user3", IsActive: true},
 					},
 				},
-				AffectedPullRequests: []PullRequestShort{
-					{PullRequestID: pr1ID, PullRequestName: "PR 1", AuthorID: user3ID, Status: usecase2.OpenStatusValue},
+				AffectedPullRequests: []AffectedPullRequest{
+					{
+						PullRequestID:    pr1ID,
+						PullRequestName:  "PR 1",
+						AuthorID:         user3ID,
+						Status:           usecase2.OpenStatusValue,
+						RemovedReviewers: []uuid.UUID{user2ID},
+						AddedReviewers:   []uuid.UUID{},
+						Understaffed:     true,
+					},
 				},
 			},
 		},
@@ -1435,6 +1721,10 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					GetActiveUsersByTeamID(gomock.Any(), teamID).
 					Return(&[]users2.UserOut{}, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(team, nil)
+
 				mockPRReviewers.EXPECT().
 					DeletePRReviewerByPRAndReviewer(gomock.Any(), pr1ID, user1ID).
 					Return(nil)
@@ -1459,6 +1749,10 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					GetActiveUsersByTeamID(gomock.Any(), teamID).
 					Return(&[]users2.UserOut{}, nil)
 
+				mockTeams.EXPECT().
+					GetTeamByID(gomock.Any(), teamID).
+					Return(team, nil)
+
 				mockPRReviewers.EXPECT().
 					DeletePRReviewerByPRAndReviewer(gomock.Any(), pr2ID, user1ID).
 					Return(nil)
@@ -1487,9 +1781,25 @@ func TestTeamDeactivateUsers(t *testing.T) {
 						{UserID: user3ID, Username: "user3", IsActive: false},
 					},
 				},
-				AffectedPullRequests: []PullRequestShort{
-					{PullRequestID: pr1ID, PullRequestName: "PR 1", AuthorID: user3ID, Status: usecase2.OpenStatusValue},
-					{PullRequestID: pr2ID, PullRequestName: "PR 2", AuthorID: user2ID, Status: usecase2.OpenStatusValue},
+				AffectedPullRequests: []AffectedPullRequest{
+					{
+						PullRequestID:    pr1ID,
+						PullRequestName:  "PR 1",
+						AuthorID:         user3ID,
+						Status:           usecase2.OpenStatusValue,
+						RemovedReviewers: []uuid.UUID{user1ID, user2ID},
+						AddedReviewers:   []uuid.UUID{},
+						Understaffed:     true,
+					},
+					{
+						PullRequestID:    pr2ID,
+						PullRequestName:  "PR 2",
+						AuthorID:         user2ID,
+						Status:           usecase2.OpenStatusValue,
+						RemovedReviewers: []uuid.UUID{user1ID},
+						AddedReviewers:   []uuid.UUID{},
+						Understaffed:     true,
+					},
 				},
 			},
 		},
@@ -1603,8 +1913,16 @@ func TestTeamDeactivateUsers(t *testing.T) {
 						{UserID: user3ID, Username: "user3", IsActive: false},
 					},
 				},
-				AffectedPullRequests: []PullRequestShort{
-					{PullRequestID: pr1ID, PullRequestName: "PR 1", AuthorID: user3ID, Status: usecase2.OpenStatusValue},
+				AffectedPullRequests: []AffectedPullRequest{
+					{
+						PullRequestID:    pr1ID,
+						PullRequestName:  "PR 1",
+						AuthorID:         user3ID,
+						Status:           usecase2.OpenStatusValue,
+						RemovedReviewers: []uuid.UUID{user3ID},
+						AddedReviewers:   []uuid.UUID{user2ID},
+						Understaffed:     true,
+					},
 				},
 			},
 		},
@@ -1618,6 +1936,8 @@ func TestTeamDeactivateUsers(t *testing.T) {
 			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
 			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
 			mockRandomizer := randomizer2.NewMockRandomizer(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 			mockTrm := mock.NewMockManager(ctrl)
 
 			tt.setupMock(
@@ -1637,6 +1957,8 @@ func TestTeamDeactivateUsers(t *testing.T) {
 				mockRepoPRReviewers,
 				mockRepoPRStatuses,
 				mockRandomizer,
+				cntReviewers,
+				mockPublisher,
 				mockTrm,
 			)
 
@@ -1666,10 +1988,118 @@ func TestTeamDeactivateUsers(t *testing.T) {
 					assert.Equal(t, expectedPR.PullRequestName, result.AffectedPullRequests[i].PullRequestName)
 					assert.Equal(t, expectedPR.AuthorID, result.AffectedPullRequests[i].AuthorID)
 					assert.Equal(t, expectedPR.Status, result.AffectedPullRequests[i].Status)
+					assert.ElementsMatch(t, expectedPR.RemovedReviewers, result.AffectedPullRequests[i].RemovedReviewers)
+					assert.ElementsMatch(t, expectedPR.AddedReviewers, result.AffectedPullRequests[i].AddedReviewers)
+					assert.Equal(t, expectedPR.Understaffed, result.AffectedPullRequests[i].Understaffed)
 				}
+				assert.Equal(t, tt.expected.DryRun, result.DryRun)
 			} else {
 				assert.Nil(t, result)
 			}
 		})
 	}
 }
+
+func TestTeamDeactivateUsersPublishesEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	userID := uuid.New()
+	team := &teams2.TeamOut{ID: teamID, Name: "test-team"}
+	activeUser := users2.UserOut{ID: userID, Name: "user1", IsActive: true, TeamID: teamID}
+	inactiveUser := users2.UserOut{ID: userID, Name: "user1", IsActive: false, TeamID: teamID}
+	publishErr := errors.New("publish error")
+
+	tests := []struct {
+		name          string
+		publishErr    error
+		expectedError error
+	}{
+		{name: "deactivated event is published"},
+		{name: "publish error rolls back deactivation", publishErr: publishErr, expectedError: publishErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockRandomizer := randomizer2.NewMockRandomizer(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoTeams.EXPECT().GetTeamByName(gomock.Any(), "test-team").Return(team, nil)
+			mockRepoUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{userID}).
+				Return(&[]users2.UserOut{activeUser}, nil)
+			mockRepoPRReviewers.EXPECT().GetPRReviewersByReviewerIDs(gomock.Any(), []uuid.UUID{userID}).
+				Return(nil, repository.ErrPRReviewerNotFound)
+			mockRepoUsers.EXPECT().UpdateUsersBatch(gomock.Any(), gomock.Any()).
+				Return(&[]users2.UserOut{inactiveUser}, nil)
+			mockRepoUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).
+				Return(&[]users2.UserOut{inactiveUser}, nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{{
+					Type:     usecase2.EventUserDeactivated,
+					UserID:   userID,
+					TeamName: "test-team",
+				}}).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockRepoTeams, mockRepoUsers, mockRepoPullRequests, mockRepoPRReviewers,
+				mockRepoPRStatuses, mockRandomizer, cntReviewers, mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), In{TeamName: "test-team", UserIDs: []uuid.UUID{userID}})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "test-team", result.Team.TeamName)
+		})
+	}
+}
+
+func TestReviewerEvents(t *testing.T) {
+	prID := uuid.New()
+	authorID := uuid.New()
+	removedID := uuid.New()
+	addedID := uuid.New()
+
+	result := reviewerEvents([]AffectedPullRequest{
+		{PullRequestID: uuid.New(), RemovedReviewers: []uuid.UUID{}, AddedReviewers: []uuid.UUID{}},
+		{
+			PullRequestID:    prID,
+			PullRequestName:  "PR",
+			AuthorID:         authorID,
+			RemovedReviewers: []uuid.UUID{removedID},
+			AddedReviewers:   []uuid.UUID{addedID},
+		},
+	})
+
+	assert.Equal(t, []usecase2.Event{
+		{
+			Type:            usecase2.EventReviewerUnassigned,
+			PullRequestID:   prID,
+			PullRequestName: "PR",
+			AuthorID:        authorID,
+			ReviewerID:      removedID,
+		},
+		{
+			Type:            usecase2.EventReviewerAssigned,
+			PullRequestID:   prID,
+			PullRequestName: "PR",
+			AuthorID:        authorID,
+			ReviewerID:      addedID,
+		},
+	}, result)
+}
@@ -0,0 +1,40 @@
+package team_delete
+
+import "github.com/google/uuid"
+
+type In struct {
+	TeamName        string
+	CascadeReassign bool
+}
+
+type PullRequestShort struct {
+	PullRequestID   uuid.UUID
+	PullRequestName string
+	AuthorID        uuid.UUID
+	Status          string
+}
+
+type AffectedPullRequest struct {
+	PullRequestID    uuid.UUID
+	PullRequestName  string
+	AuthorID         uuid.UUID
+	Status           string
+	RemovedReviewers []uuid.UUID
+	AddedReviewers   []uuid.UUID
+	Understaffed     bool
+}
+
+// PromotedMember is a primary member of the deleted team who stays in TeamName. For promoted members it is their
+// oldest other team, detached members with pull request history are moved to the default team.
+type PromotedMember struct {
+	UserID   uuid.UUID
+	TeamName string
+}
+
+type Out struct {
+	TeamName             string
+	DeletedUserIDs       []uuid.UUID
+	PromotedMembers      []PromotedMember
+	DetachedMembers      []PromotedMember
+	AffectedPullRequests []AffectedPullRequest
+}
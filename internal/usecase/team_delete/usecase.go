@@ -0,0 +1,506 @@
+package team_delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/randomizer"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
+	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+	"pr-reviewers-service/internal/usecase/contract/repository/team_memberships"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+)
+
+type usecase struct {
+	repTeams           teams.RepositoryTeams
+	repUsers           users.RepositoryUsers
+	repPullRequests    pull_requests.RepositoryPullRequests
+	repPRReviewers     pr_reviewers.RepositoryPrReviewers
+	repPRStatuses      pr_statuses.RepositoryPrStatuses
+	repTeamMemberships team_memberships.RepositoryTeamMemberships
+	selector           usecase2.ReviewerSelector
+	maxCntReviewers    int
+	publisher          events.Publisher
+	defaultTeam        string
+	trm                trm.Manager
+}
+
+func NewUsecase(
+	repTeams teams.RepositoryTeams,
+	repUsers users.RepositoryUsers,
+	repPullRequests pull_requests.RepositoryPullRequests,
+	repPRReviewers pr_reviewers.RepositoryPrReviewers,
+	repPRStatuses pr_statuses.RepositoryPrStatuses,
+	repTeamMemberships team_memberships.RepositoryTeamMemberships,
+	randomizer randomizer.Randomizer,
+	maxCntReviewers int,
+	publisher events.Publisher,
+	defaultTeam string,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repTeams:           repTeams,
+		repUsers:           repUsers,
+		repPullRequests:    repPullRequests,
+		repPRReviewers:     repPRReviewers,
+		repPRStatuses:      repPRStatuses,
+		repTeamMemberships: repTeamMemberships,
+		selector:           usecase2.NewReviewerSelector(repUsers, repTeams, randomizer),
+		maxCntReviewers:    maxCntReviewers,
+		publisher:          publisher,
+		defaultTeam:        defaultTeam,
+		trm:                trm,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Get team by name", "team_name", req.TeamName)
+	team, err := u.repTeams.GetTeamByName(ctx, req.TeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamNotFound, req.TeamName))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, req.TeamName))
+	}
+
+	slog.DebugContext(ctx, "Get team members", "team_id", team.ID)
+	teamMembers, err := u.repUsers.GetUsersByTeamID(ctx, team.ID)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, team.ID))
+	}
+	primaryMembers := make([]users2.UserOut, 0)
+	additionalMembers := make([]users2.UserOut, 0)
+	if teamMembers != nil {
+		for _, member := range *teamMembers {
+			if member.TeamID == team.ID {
+				primaryMembers = append(primaryMembers, member)
+			} else {
+				additionalMembers = append(additionalMembers, member)
+			}
+		}
+	}
+
+	// Primary members who belong to other teams keep their account, one of the other memberships becomes primary.
+	// The rest leaves the team.
+	promotions, err := u.findPromotions(ctx, team.ID, primaryMembers)
+	if err != nil {
+		return nil, err
+	}
+	leavingUserIDs := make([]uuid.UUID, 0)
+	for _, member := range primaryMembers {
+		if _, promoted := promotions[member.ID]; !promoted {
+			leavingUserIDs = append(leavingUserIDs, member.ID)
+		}
+	}
+
+	deletedUserIDs := make([]uuid.UUID, 0)
+	detachedMembers := make([]PromotedMember, 0)
+	affectedPRs := make([]AffectedPullRequest, 0)
+	if len(leavingUserIDs) > 0 {
+		authoredPRs, reviewedPRs, historyUserIDs, err := u.findMembersPRs(ctx, leavingUserIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		leavingPRs := append(authoredPRs, reviewedPRs...)
+		openPRsCnt := 0
+		for _, pr := range leavingPRs {
+			if pr.Status == usecase2.OpenStatusValue {
+				openPRsCnt++
+			}
+		}
+		if openPRsCnt > 0 && !req.CascadeReassign {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s has %d open pull requests",
+				usecase2.ErrTeamHasOpenPullRequests, req.TeamName, openPRsCnt))
+		}
+
+		// Users who authored pull requests or reviewed closed ones are moved to the default team, deleting them
+		// would delete the history as well.
+		var defaultTeam *teams2.TeamOut
+		for _, member := range primaryMembers {
+			if _, promoted := promotions[member.ID]; promoted {
+				continue
+			}
+			if _, hasHistory := historyUserIDs[member.ID]; !hasHistory {
+				deletedUserIDs = append(deletedUserIDs, member.ID)
+				continue
+			}
+			if defaultTeam == nil {
+				if defaultTeam, err = u.getOrCreateDefaultTeam(ctx, team); err != nil {
+					return nil, err
+				}
+			}
+			if err = u.promote(ctx, member, defaultTeam.ID); err != nil {
+				return nil, err
+			}
+			detachedMembers = append(detachedMembers, PromotedMember{UserID: member.ID, TeamName: defaultTeam.Name})
+		}
+
+		slog.DebugContext(ctx, "Reassign reviewers of open PRs",
+			"authored_prs_count", len(authoredPRs), "reviewed_prs_count", len(reviewedPRs))
+		affectedPRs, err = u.reassignPRReviewers(ctx, leavingPRs, leavingUserIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	promotedMembers := make([]PromotedMember, 0, len(promotions))
+	for _, member := range primaryMembers {
+		promotedTeam, promoted := promotions[member.ID]
+		if !promoted {
+			continue
+		}
+		if err = u.promote(ctx, member, promotedTeam.ID); err != nil {
+			return nil, err
+		}
+		promotedMembers = append(promotedMembers, PromotedMember{UserID: member.ID, TeamName: promotedTeam.Name})
+	}
+
+	for _, member := range additionalMembers {
+		slog.DebugContext(ctx, "Delete additional team membership", "user_id", member.ID, "team_id", team.ID)
+		if err = u.repTeamMemberships.DeleteTeamMembership(ctx, member.ID, team.ID); err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrDeleteTeamMembership, member.ID))
+		}
+	}
+
+	for _, userID := range deletedUserIDs {
+		slog.DebugContext(ctx, "Delete user", "user_id", userID)
+		if err = u.repUsers.DeleteUserByID(ctx, userID); err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrDeleteUser, userID))
+		}
+	}
+
+	slog.DebugContext(ctx, "Delete team", "team_id", team.ID)
+	err = u.repTeams.DeleteTeamByID(ctx, team.ID)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrDeleteTeam, team.ID))
+	}
+
+	domainEvents := append([]usecase2.Event{{Type: usecase2.EventTeamDeleted, TeamName: team.Name}},
+		reviewerEvents(affectedPRs)...)
+	slog.DebugContext(ctx, "Publish team deletion events", "count", len(domainEvents))
+	if err = u.publisher.Publish(ctx, domainEvents); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase DeleteTeam success",
+		"deleted_users", len(deletedUserIDs),
+		"promoted_members", len(promotedMembers),
+		"detached_members", len(detachedMembers),
+		"affected_prs", len(affectedPRs))
+	return &Out{
+		TeamName:             team.Name,
+		DeletedUserIDs:       deletedUserIDs,
+		PromotedMembers:      promotedMembers,
+		DetachedMembers:      detachedMembers,
+		AffectedPullRequests: affectedPRs,
+	}, nil
+}
+
+// findPromotions picks the team that becomes primary for every member with another membership, the oldest
+// membership wins.
+func (u *usecase) findPromotions(ctx context.Context, teamID uuid.UUID, members []users2.UserOut) (map[uuid.UUID]teams2.TeamOut, error) {
+	promotions := make(map[uuid.UUID]teams2.TeamOut)
+	if len(members) == 0 {
+		return promotions, nil
+	}
+
+	memberIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.ID)
+	}
+	slog.DebugContext(ctx, "Get memberships of primary members", "users_count", len(memberIDs))
+	memberships, err := u.repTeamMemberships.GetTeamMembershipsByUserIDs(ctx, memberIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetTeamMemberships, err))
+	}
+
+	oldest := make(map[uuid.UUID]team_memberships2.TeamMembershipOut)
+	for _, membership := range *memberships {
+		if membership.TeamID == teamID {
+			continue
+		}
+		if current, exists := oldest[membership.UserID]; !exists || membership.CreatedAt.Before(current.CreatedAt) {
+			oldest[membership.UserID] = membership
+		}
+	}
+
+	for _, member := range members {
+		membership, exists := oldest[member.ID]
+		if !exists {
+			continue
+		}
+		team, err := u.repTeams.GetTeamByID(ctx, membership.TeamID)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetTeam, membership.TeamID))
+		}
+		promotions[member.ID] = *team
+	}
+	return promotions, nil
+}
+
+func (u *usecase) promote(ctx context.Context, member users2.UserOut, teamID uuid.UUID) error {
+	slog.DebugContext(ctx, "Make another membership primary", "user_id", member.ID, "team_id", teamID)
+	_, err := u.repUsers.UpdateUser(ctx, users2.UserIn{
+		ID:       member.ID,
+		Name:     member.Name,
+		IsActive: member.IsActive,
+		TeamID:   teamID,
+	})
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateUser, member.ID))
+	}
+	_, err = u.repTeamMemberships.SetPrimaryTeamMembership(ctx, member.ID, teamID)
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrSaveTeamMemberships, member.ID))
+	}
+	return nil
+}
+
+// findMembersPRs returns PRs authored by the members, PRs of other authors where the members are assigned as
+// reviewers and the members who authored a PR or reviewed a closed one.
+func (u *usecase) findMembersPRs(ctx context.Context, memberIDs []uuid.UUID) ([]PullRequestShort, []PullRequestShort, map[uuid.UUID]struct{}, error) {
+	authored, err := u.repPullRequests.GetPullRequestsByAuthorIDs(ctx, memberIDs)
+	if err != nil {
+		return nil, nil, nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPullRequest))
+	}
+	authoredIDs := make(map[uuid.UUID]struct{}, len(*authored))
+	for _, pr := range *authored {
+		authoredIDs[pr.ID] = struct{}{}
+	}
+
+	reviewers, err := u.repPRReviewers.GetPRReviewersByReviewerIDs(ctx, memberIDs)
+	if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
+		return nil, nil, nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPRReviewers))
+	}
+	reviewedIDs := make([]uuid.UUID, 0)
+	reviewedSet := make(map[uuid.UUID]struct{})
+	membersByPR := make(map[uuid.UUID][]uuid.UUID)
+	if reviewers != nil {
+		for _, reviewer := range *reviewers {
+			membersByPR[reviewer.PRID] = append(membersByPR[reviewer.PRID], reviewer.ReviewerID)
+			if _, isAuthored := authoredIDs[reviewer.PRID]; isAuthored {
+				continue
+			}
+			if _, exist := reviewedSet[reviewer.PRID]; !exist {
+				reviewedSet[reviewer.PRID] = struct{}{}
+				reviewedIDs = append(reviewedIDs, reviewer.PRID)
+			}
+		}
+	}
+	reviewed, err := u.repPullRequests.GetPullRequestsByPrIDs(ctx, reviewedIDs)
+	if err != nil {
+		return nil, nil, nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPullRequest))
+	}
+
+	statusIDs := make([]uuid.UUID, 0, len(*authored)+len(*reviewed))
+	for _, pr := range *authored {
+		statusIDs = append(statusIDs, pr.StatusID)
+	}
+	for _, pr := range *reviewed {
+		statusIDs = append(statusIDs, pr.StatusID)
+	}
+	prStatuses, err := u.repPRStatuses.GetPRStatusesByIDs(ctx, statusIDs)
+	if err != nil {
+		return nil, nil, nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPRStatus))
+	}
+	statusMap := make(map[uuid.UUID]string)
+	if prStatuses != nil {
+		for _, status := range *prStatuses {
+			statusMap[status.ID] = status.Status
+		}
+	}
+
+	historyUserIDs := make(map[uuid.UUID]struct{})
+	for _, pr := range *authored {
+		historyUserIDs[pr.AuthorID] = struct{}{}
+	}
+	for _, pr := range append(*authored, *reviewed...) {
+		if statusMap[pr.StatusID] == usecase2.OpenStatusValue {
+			continue
+		}
+		for _, reviewerID := range membersByPR[pr.ID] {
+			historyUserIDs[reviewerID] = struct{}{}
+		}
+	}
+
+	toShort := func(prs []pull_requests2.PullRequestOut) []PullRequestShort {
+		result := make([]PullRequestShort, 0, len(prs))
+		for _, pr := range prs {
+			result = append(result, PullRequestShort{
+				PullRequestID:   pr.ID,
+				PullRequestName: pr.Name,
+				AuthorID:        pr.AuthorID,
+				Status:          statusMap[pr.StatusID],
+			})
+		}
+		return result
+	}
+
+	slog.DebugContext(ctx, "Found members PRs", "authored_prs", len(*authored), "reviewed_prs", len(*reviewed))
+	return toShort(*authored), toShort(*reviewed), historyUserIDs, nil
+}
+
+// getOrCreateDefaultTeam returns the team that keeps the users with pull request history, members of the default
+// team itself have nowhere to go.
+func (u *usecase) getOrCreateDefaultTeam(ctx context.Context, deletedTeam *teams2.TeamOut) (*teams2.TeamOut, error) {
+	if deletedTeam.Name == u.defaultTeam {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: default team %s", usecase2.ErrTeamHasPullRequestHistory,
+			deletedTeam.Name))
+	}
+
+	slog.DebugContext(ctx, "Get default team", "team_name", u.defaultTeam)
+	team, err := u.repTeams.GetTeamByName(ctx, u.defaultTeam)
+	if err == nil {
+		return team, nil
+	}
+	if !errors.Is(err, repository.ErrTeamNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, u.defaultTeam))
+	}
+
+	slog.DebugContext(ctx, "Create default team", "team_name", u.defaultTeam)
+	team, err = u.repTeams.SaveTeam(ctx, teams2.TeamIn{Name: u.defaultTeam})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSaveTeam, u.defaultTeam))
+	}
+	return team, nil
+}
+
+func (u *usecase) reassignPRReviewers(ctx context.Context, prs []PullRequestShort, leavingUserIDs []uuid.UUID) ([]AffectedPullRequest, error) {
+	leavingUsersMap := make(map[uuid.UUID]struct{})
+	for _, userID := range leavingUserIDs {
+		leavingUsersMap[userID] = struct{}{}
+	}
+
+	affectedPRs := make([]AffectedPullRequest, 0)
+	for _, pr := range prs {
+		if pr.Status != usecase2.OpenStatusValue {
+			continue
+		}
+		slog.DebugContext(ctx, "Processing PR for reviewer reassignment", "pr_id", pr.PullRequestID)
+		report := AffectedPullRequest{
+			PullRequestID:    pr.PullRequestID,
+			PullRequestName:  pr.PullRequestName,
+			AuthorID:         pr.AuthorID,
+			Status:           pr.Status,
+			RemovedReviewers: []uuid.UUID{},
+			AddedReviewers:   []uuid.UUID{},
+		}
+
+		currentReviewers, err := u.repPRReviewers.GetPRReviewersByPRID(ctx, pr.PullRequestID)
+		if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrGetPRReviewers, pr.PullRequestID))
+		}
+		if currentReviewers == nil {
+			continue
+		}
+		reviewersToRemove := make([]uuid.UUID, 0)
+		for _, reviewer := range *currentReviewers {
+			if _, isLeaving := leavingUsersMap[reviewer.ReviewerID]; isLeaving {
+				reviewersToRemove = append(reviewersToRemove, reviewer.ReviewerID)
+			}
+		}
+		if len(reviewersToRemove) == 0 {
+			continue
+		}
+
+		author, err := u.repUsers.GetUserByID(ctx, pr.AuthorID)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, pr.AuthorID))
+		}
+		excluded := append([]uuid.UUID{author.ID}, leavingUserIDs...)
+		for _, reviewer := range *currentReviewers {
+			excluded = append(excluded, reviewer.ReviewerID)
+		}
+		newReviewers, err := u.selector.Select(ctx, author.TeamID, len(reviewersToRemove), excluded...)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, reviewer := range newReviewers {
+			reviewerIn := pr_reviewers2.PrReviewerIn{
+				PrID:       pr.PullRequestID,
+				ReviewerID: reviewer.User.ID,
+			}
+			_, err = u.repPRReviewers.SavePRReviewer(ctx, reviewerIn)
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer %s", usecase2.ErrAssignReviewer, reviewer.User.ID))
+			}
+			report.AddedReviewers = append(report.AddedReviewers, reviewer.User.ID)
+		}
+
+		for _, reviewerID := range reviewersToRemove {
+			err = u.repPRReviewers.DeletePRReviewerByPRAndReviewer(ctx, pr.PullRequestID, reviewerID)
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer_id %s", usecase2.ErrRemoveReviewer, reviewerID))
+			}
+			report.RemovedReviewers = append(report.RemovedReviewers, reviewerID)
+		}
+
+		remainingReviewers := len(*currentReviewers) - len(report.RemovedReviewers) + len(report.AddedReviewers)
+		report.Understaffed = remainingReviewers < u.maxCntReviewers
+
+		affectedPRs = append(affectedPRs, report)
+		slog.DebugContext(ctx, "PR reassignment completed",
+			"pr_id", pr.PullRequestID,
+			"removed_reviewers", len(report.RemovedReviewers),
+			"added_reviewers", len(report.AddedReviewers),
+			"understaffed", report.Understaffed)
+	}
+
+	return affectedPRs, nil
+}
+
+// reviewerEvents describes every reviewer change of the affected pull requests, removals go first.
+func reviewerEvents(affectedPRs []AffectedPullRequest) []usecase2.Event {
+	var reviewerEvents []usecase2.Event
+	for _, pr := range affectedPRs {
+		for _, reviewerID := range pr.RemovedReviewers {
+			reviewerEvents = append(reviewerEvents, usecase2.Event{
+				Type:            usecase2.EventReviewerUnassigned,
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				ReviewerID:      reviewerID,
+			})
+		}
+		for _, reviewerID := range pr.AddedReviewers {
+			reviewerEvents = append(reviewerEvents, usecase2.Event{
+				Type:            usecase2.EventReviewerAssigned,
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				ReviewerID:      reviewerID,
+			})
+		}
+	}
+	return reviewerEvents
+}
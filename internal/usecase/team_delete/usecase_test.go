@@ -0,0 +1,723 @@
+package team_delete
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	randomizer2 "pr-reviewers-service/internal/usecase/contract/randomizer/mocks"
+	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
+	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
+	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+	team_memberships "pr-reviewers-service/internal/usecase/contract/repository/team_memberships/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	cntReviewers    = 2
+	defaultTeamName = "scim"
+)
+
+func TestDeleteTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	otherTeamID := uuid.New()
+	olderTeamID := uuid.New()
+	teamName := "billing"
+	memberID := uuid.New()
+	multiTeamMemberID := uuid.New()
+	additionalMemberID := uuid.New()
+	outsiderAuthorID := uuid.New()
+	candidateID := uuid.New()
+	authoredPRID := uuid.New()
+	reviewedPRID := uuid.New()
+	openStatusID := uuid.New()
+	mergedStatusID := uuid.New()
+	createdAt := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+
+	team := &teams2.TeamOut{
+		ID:   teamID,
+		Name: teamName,
+	}
+	olderTeam := &teams2.TeamOut{ID: olderTeamID, Name: "payments"}
+	defaultTeam := &teams2.TeamOut{ID: uuid.New(), Name: defaultTeamName}
+
+	member := users2.UserOut{ID: memberID, Name: "member", IsActive: true, TeamID: teamID}
+	multiTeamMember := users2.UserOut{ID: multiTeamMemberID, Name: "multi", IsActive: true, TeamID: teamID}
+	additionalMember := users2.UserOut{ID: additionalMemberID, Name: "additional", IsActive: true, TeamID: otherTeamID}
+	outsiderAuthor := users2.UserOut{ID: outsiderAuthorID, Name: "author", IsActive: true, TeamID: otherTeamID}
+	candidate := users2.UserOut{ID: candidateID, Name: "candidate", IsActive: true, TeamID: otherTeamID}
+	teamMembers := []users2.UserOut{member, additionalMember}
+
+	memberMemberships := []team_memberships2.TeamMembershipOut{
+		{UserID: memberID, TeamID: teamID, IsPrimary: true, CreatedAt: createdAt},
+	}
+	multiTeamMemberships := []team_memberships2.TeamMembershipOut{
+		{UserID: multiTeamMemberID, TeamID: teamID, IsPrimary: true, CreatedAt: createdAt},
+		{UserID: multiTeamMemberID, TeamID: otherTeamID, CreatedAt: createdAt.Add(time.Hour)},
+		{UserID: multiTeamMemberID, TeamID: olderTeamID, CreatedAt: createdAt.Add(time.Minute)},
+	}
+
+	authoredPR := pull_requests2.PullRequestOut{
+		ID:        authoredPRID,
+		Name:      "Authored PR",
+		AuthorID:  memberID,
+		StatusID:  openStatusID,
+		CreatedAt: time.Now(),
+	}
+	mergedAuthoredPR := authoredPR
+	mergedAuthoredPR.StatusID = mergedStatusID
+	reviewedPR := pull_requests2.PullRequestOut{
+		ID:        reviewedPRID,
+		Name:      "Reviewed PR",
+		AuthorID:  outsiderAuthorID,
+		StatusID:  openStatusID,
+		CreatedAt: time.Now(),
+	}
+	mergedReviewedPR := reviewedPR
+	mergedReviewedPR.StatusID = mergedStatusID
+	reviewedPRReviewers := []pr_reviewers2.PrReviewerOut{
+		{ID: uuid.New(), PRID: reviewedPRID, ReviewerID: memberID},
+		{ID: uuid.New(), PRID: reviewedPRID, ReviewerID: additionalMemberID},
+	}
+	openStatus := pr_statuses2.PRStatusOut{ID: openStatusID, Status: usecase2.OpenStatusValue}
+	mergedStatus := pr_statuses2.PRStatusOut{ID: mergedStatusID, Status: usecase2.MergedStatusValue}
+
+	trmDo := func(mockTrm *mock.MockManager) {
+		mockTrm.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+				return f(ctx)
+			})
+	}
+	expectDetach := func(mockUsers *users.MockRepositoryUsers, mockMemberships *team_memberships.MockRepositoryTeamMemberships) {
+		mockUsers.EXPECT().
+			UpdateUser(gomock.Any(), users2.UserIn{ID: memberID, Name: "member", IsActive: true, TeamID: defaultTeam.ID}).
+			Return(&users2.UserOut{}, nil)
+		mockMemberships.EXPECT().
+			SetPrimaryTeamMembership(gomock.Any(), memberID, defaultTeam.ID).
+			Return(&team_memberships2.TeamMembershipOut{}, nil)
+	}
+	// expectMemberPRs makes member the only user to delete, with the given authored and reviewed pull requests.
+	expectMemberPRs := func(
+		mockTeams *teams.MockRepositoryTeams,
+		mockUsers *users.MockRepositoryUsers,
+		mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+		mockPullRequests *pull_requests.MockRepositoryPullRequests,
+		mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+		mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+		authored []pull_requests2.PullRequestOut,
+		reviewed []pull_requests2.PullRequestOut,
+		statuses []pr_statuses2.PRStatusOut,
+	) {
+		mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+		mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&teamMembers, nil)
+		mockMemberships.EXPECT().
+			GetTeamMembershipsByUserIDs(gomock.Any(), []uuid.UUID{memberID}).
+			Return(&memberMemberships, nil)
+		mockPullRequests.EXPECT().
+			GetPullRequestsByAuthorIDs(gomock.Any(), []uuid.UUID{memberID}).
+			Return(&authored, nil)
+		reviewedIDs := make([]uuid.UUID, 0)
+		var reviews []pr_reviewers2.PrReviewerOut
+		for _, pr := range reviewed {
+			reviewedIDs = append(reviewedIDs, pr.ID)
+			reviews = append(reviews, pr_reviewers2.PrReviewerOut{ID: uuid.New(), PRID: pr.ID, ReviewerID: memberID})
+		}
+		if len(reviews) == 0 {
+			mockPRReviewers.EXPECT().
+				GetPRReviewersByReviewerIDs(gomock.Any(), []uuid.UUID{memberID}).
+				Return(nil, repository.ErrPRReviewerNotFound)
+		} else {
+			mockPRReviewers.EXPECT().
+				GetPRReviewersByReviewerIDs(gomock.Any(), []uuid.UUID{memberID}).
+				Return(&reviews, nil)
+		}
+		mockPullRequests.EXPECT().
+			GetPullRequestsByPrIDs(gomock.Any(), reviewedIDs).
+			Return(&reviewed, nil)
+		mockPRStatuses.EXPECT().
+			GetPRStatusesByIDs(gomock.Any(), gomock.Any()).
+			Return(&statuses, nil)
+	}
+
+	tests := []struct {
+		name        string
+		req         In
+		defaultTeam string
+		setupMock   func(
+			mockTeams *teams.MockRepositoryTeams,
+			mockUsers *users.MockRepositoryUsers,
+			mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+			mockPullRequests *pull_requests.MockRepositoryPullRequests,
+			mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+			mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+			mockTrm *mock.MockManager,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "successful delete of team without members",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&[]users2.UserOut{}, nil)
+				mockTeams.EXPECT().DeleteTeamByID(gomock.Any(), teamID).Return(nil)
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				TeamName:             teamName,
+				DeletedUserIDs:       []uuid.UUID{},
+				PromotedMembers:      []PromotedMember{},
+				DetachedMembers:      []PromotedMember{},
+				AffectedPullRequests: []AffectedPullRequest{},
+			},
+		},
+		{
+			name: "members are deleted explicitly and additional members lose the membership",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectMemberPRs(mockTeams, mockUsers, mockMemberships, mockPullRequests, mockPRReviewers, mockPRStatuses,
+					[]pull_requests2.PullRequestOut{}, []pull_requests2.PullRequestOut{}, []pr_statuses2.PRStatusOut{})
+				mockMemberships.EXPECT().DeleteTeamMembership(gomock.Any(), additionalMemberID, teamID).Return(nil)
+				mockUsers.EXPECT().DeleteUserByID(gomock.Any(), memberID).Return(nil)
+				mockTeams.EXPECT().DeleteTeamByID(gomock.Any(), teamID).Return(nil)
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				TeamName:             teamName,
+				DeletedUserIDs:       []uuid.UUID{memberID},
+				PromotedMembers:      []PromotedMember{},
+				DetachedMembers:      []PromotedMember{},
+				AffectedPullRequests: []AffectedPullRequest{},
+			},
+		},
+		{
+			name: "member of several teams is kept in the oldest other team",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{multiTeamMember, additionalMember}, nil)
+				mockMemberships.EXPECT().
+					GetTeamMembershipsByUserIDs(gomock.Any(), []uuid.UUID{multiTeamMemberID}).
+					Return(&multiTeamMemberships, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), olderTeamID).Return(olderTeam, nil)
+				mockUsers.EXPECT().
+					UpdateUser(gomock.Any(), users2.UserIn{ID: multiTeamMemberID, Name: "multi", IsActive: true, TeamID: olderTeamID}).
+					Return(&users2.UserOut{}, nil)
+				mockMemberships.EXPECT().
+					SetPrimaryTeamMembership(gomock.Any(), multiTeamMemberID, olderTeamID).
+					Return(&team_memberships2.TeamMembershipOut{}, nil)
+				mockMemberships.EXPECT().DeleteTeamMembership(gomock.Any(), additionalMemberID, teamID).Return(nil)
+				mockTeams.EXPECT().DeleteTeamByID(gomock.Any(), teamID).Return(nil)
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				TeamName:             teamName,
+				DeletedUserIDs:       []uuid.UUID{},
+				PromotedMembers:      []PromotedMember{{UserID: multiTeamMemberID, TeamName: "payments"}},
+				DetachedMembers:      []PromotedMember{},
+				AffectedPullRequests: []AffectedPullRequest{},
+			},
+		},
+		{
+			name: "cascade delete keeps member who authored merged PRs in the default team",
+			req:  In{TeamName: teamName, CascadeReassign: true},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectMemberPRs(mockTeams, mockUsers, mockMemberships, mockPullRequests, mockPRReviewers, mockPRStatuses,
+					[]pull_requests2.PullRequestOut{mergedAuthoredPR}, []pull_requests2.PullRequestOut{},
+					[]pr_statuses2.PRStatusOut{mergedStatus})
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), defaultTeamName).Return(defaultTeam, nil)
+				expectDetach(mockUsers, mockMemberships)
+				mockMemberships.EXPECT().DeleteTeamMembership(gomock.Any(), additionalMemberID, teamID).Return(nil)
+				mockTeams.EXPECT().DeleteTeamByID(gomock.Any(), teamID).Return(nil)
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				TeamName:             teamName,
+				DeletedUserIDs:       []uuid.UUID{},
+				PromotedMembers:      []PromotedMember{},
+				DetachedMembers:      []PromotedMember{{UserID: memberID, TeamName: defaultTeamName}},
+				AffectedPullRequests: []AffectedPullRequest{},
+			},
+		},
+		{
+			name: "member who reviewed merged PRs is moved to the created default team",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectMemberPRs(mockTeams, mockUsers, mockMemberships, mockPullRequests, mockPRReviewers, mockPRStatuses,
+					[]pull_requests2.PullRequestOut{}, []pull_requests2.PullRequestOut{mergedReviewedPR},
+					[]pr_statuses2.PRStatusOut{mergedStatus})
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), defaultTeamName).Return(nil, repository.ErrTeamNotFound)
+				mockTeams.EXPECT().SaveTeam(gomock.Any(), teams2.TeamIn{Name: defaultTeamName}).Return(defaultTeam, nil)
+				expectDetach(mockUsers, mockMemberships)
+				mockMemberships.EXPECT().DeleteTeamMembership(gomock.Any(), additionalMemberID, teamID).Return(nil)
+				mockTeams.EXPECT().DeleteTeamByID(gomock.Any(), teamID).Return(nil)
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				TeamName:             teamName,
+				DeletedUserIDs:       []uuid.UUID{},
+				PromotedMembers:      []PromotedMember{},
+				DetachedMembers:      []PromotedMember{{UserID: memberID, TeamName: defaultTeamName}},
+				AffectedPullRequests: []AffectedPullRequest{},
+			},
+		},
+		{
+			name:        "refuse delete of the default team when members have PR history",
+			req:         In{TeamName: teamName, CascadeReassign: true},
+			defaultTeam: teamName,
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectMemberPRs(mockTeams, mockUsers, mockMemberships, mockPullRequests, mockPRReviewers, mockPRStatuses,
+					[]pull_requests2.PullRequestOut{mergedAuthoredPR}, []pull_requests2.PullRequestOut{},
+					[]pr_statuses2.PRStatusOut{mergedStatus})
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrTeamHasPullRequestHistory,
+		},
+		{
+			name: "refuse delete while members review open PRs",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectMemberPRs(mockTeams, mockUsers, mockMemberships, mockPullRequests, mockPRReviewers, mockPRStatuses,
+					[]pull_requests2.PullRequestOut{}, []pull_requests2.PullRequestOut{reviewedPR},
+					[]pr_statuses2.PRStatusOut{openStatus})
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrTeamHasOpenPullRequests,
+		},
+		{
+			name: "refuse delete while members authored open PRs",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectMemberPRs(mockTeams, mockUsers, mockMemberships, mockPullRequests, mockPRReviewers, mockPRStatuses,
+					[]pull_requests2.PullRequestOut{authoredPR}, []pull_requests2.PullRequestOut{},
+					[]pr_statuses2.PRStatusOut{openStatus})
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrTeamHasOpenPullRequests,
+		},
+		{
+			name: "cascade delete keeps author of open PR in the default team",
+			req:  In{TeamName: teamName, CascadeReassign: true},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectMemberPRs(mockTeams, mockUsers, mockMemberships, mockPullRequests, mockPRReviewers, mockPRStatuses,
+					[]pull_requests2.PullRequestOut{authoredPR}, []pull_requests2.PullRequestOut{},
+					[]pr_statuses2.PRStatusOut{openStatus})
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), defaultTeamName).Return(defaultTeam, nil)
+				expectDetach(mockUsers, mockMemberships)
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), authoredPRID).
+					Return(&[]pr_reviewers2.PrReviewerOut{{ID: uuid.New(), PRID: authoredPRID, ReviewerID: additionalMemberID}}, nil)
+				mockMemberships.EXPECT().DeleteTeamMembership(gomock.Any(), additionalMemberID, teamID).Return(nil)
+				mockTeams.EXPECT().DeleteTeamByID(gomock.Any(), teamID).Return(nil)
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				TeamName:             teamName,
+				DeletedUserIDs:       []uuid.UUID{},
+				PromotedMembers:      []PromotedMember{},
+				DetachedMembers:      []PromotedMember{{UserID: memberID, TeamName: defaultTeamName}},
+				AffectedPullRequests: []AffectedPullRequest{},
+			},
+		},
+		{
+			name: "successful delete with cascade reassignment",
+			req:  In{TeamName: teamName, CascadeReassign: true},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectMemberPRs(mockTeams, mockUsers, mockMemberships, mockPullRequests, mockPRReviewers, mockPRStatuses,
+					[]pull_requests2.PullRequestOut{}, []pull_requests2.PullRequestOut{reviewedPR},
+					[]pr_statuses2.PRStatusOut{openStatus})
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByPRID(gomock.Any(), reviewedPRID).
+					Return(&reviewedPRReviewers, nil)
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), outsiderAuthorID).Return(&outsiderAuthor, nil)
+				mockUsers.EXPECT().
+					GetActiveUsersByTeamID(gomock.Any(), otherTeamID).
+					Return(&[]users2.UserOut{outsiderAuthor, additionalMember, candidate, member}, nil)
+				mockPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: reviewedPRID, ReviewerID: candidateID}).
+					Return(&pr_reviewers2.PrReviewerOut{}, nil)
+				mockPRReviewers.EXPECT().
+					DeletePRReviewerByPRAndReviewer(gomock.Any(), reviewedPRID, memberID).
+					Return(nil)
+				mockMemberships.EXPECT().DeleteTeamMembership(gomock.Any(), additionalMemberID, teamID).Return(nil)
+				mockUsers.EXPECT().DeleteUserByID(gomock.Any(), memberID).Return(nil)
+				mockTeams.EXPECT().DeleteTeamByID(gomock.Any(), teamID).Return(nil)
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				TeamName:        teamName,
+				DeletedUserIDs:  []uuid.UUID{memberID},
+				PromotedMembers: []PromotedMember{},
+				DetachedMembers: []PromotedMember{},
+				AffectedPullRequests: []AffectedPullRequest{
+					{
+						PullRequestID:    reviewedPRID,
+						PullRequestName:  "Reviewed PR",
+						AuthorID:         outsiderAuthorID,
+						Status:           usecase2.OpenStatusValue,
+						RemovedReviewers: []uuid.UUID{memberID},
+						AddedReviewers:   []uuid.UUID{candidateID},
+						Understaffed:     false,
+					},
+				},
+			},
+		},
+		{
+			name: "team not found",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(nil, repository.ErrTeamNotFound)
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrTeamNotFound,
+		},
+		{
+			name: "error getting team members",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(nil, errors.New("db error"))
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrGetUsers,
+		},
+		{
+			name: "error getting memberships",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&teamMembers, nil)
+				mockMemberships.EXPECT().
+					GetTeamMembershipsByUserIDs(gomock.Any(), []uuid.UUID{memberID}).
+					Return(nil, errors.New("db error"))
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrGetTeamMemberships,
+		},
+		{
+			name: "error getting authored PRs",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&teamMembers, nil)
+				mockMemberships.EXPECT().
+					GetTeamMembershipsByUserIDs(gomock.Any(), []uuid.UUID{memberID}).
+					Return(&memberMemberships, nil)
+				mockPullRequests.EXPECT().
+					GetPullRequestsByAuthorIDs(gomock.Any(), []uuid.UUID{memberID}).
+					Return(nil, errors.New("db error"))
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrGetPullRequest,
+		},
+		{
+			name: "error deleting user",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectMemberPRs(mockTeams, mockUsers, mockMemberships, mockPullRequests, mockPRReviewers, mockPRStatuses,
+					[]pull_requests2.PullRequestOut{}, []pull_requests2.PullRequestOut{}, []pr_statuses2.PRStatusOut{})
+				mockMemberships.EXPECT().DeleteTeamMembership(gomock.Any(), additionalMemberID, teamID).Return(nil)
+				mockUsers.EXPECT().DeleteUserByID(gomock.Any(), memberID).Return(errors.New("db error"))
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrDeleteUser,
+		},
+		{
+			name: "error deleting team",
+			req:  In{TeamName: teamName},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).Return(team, nil)
+				mockUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(nil, repository.ErrUserNotFound)
+				mockTeams.EXPECT().DeleteTeamByID(gomock.Any(), teamID).Return(errors.New("db error"))
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrDeleteTeam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoMemberships := team_memberships.NewMockRepositoryTeamMemberships(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockRandomizer := randomizer2.NewMockRandomizer(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockTrm := mock.NewMockManager(ctrl)
+
+			tt.setupMock(
+				mockRepoTeams,
+				mockRepoUsers,
+				mockRepoMemberships,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockTrm,
+			)
+
+			defaultTeam := tt.defaultTeam
+			if defaultTeam == "" {
+				defaultTeam = defaultTeamName
+			}
+			u := NewUsecase(
+				mockRepoTeams,
+				mockRepoUsers,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockRepoMemberships,
+				mockRandomizer,
+				cntReviewers,
+				mockPublisher,
+				defaultTeam,
+				mockTrm,
+			)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestDeleteTeamPublishesEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	team := &teams2.TeamOut{ID: teamID, Name: "billing"}
+	publishErr := errors.New("publish error")
+
+	tests := []struct {
+		name          string
+		publishErr    error
+		expectedError error
+	}{
+		{name: "deleted event is published"},
+		{name: "publish error rolls back delete", publishErr: publishErr, expectedError: publishErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockRepoMemberships := team_memberships.NewMockRepositoryTeamMemberships(ctrl)
+			mockRandomizer := randomizer2.NewMockRandomizer(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(team, nil)
+			mockRepoUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&[]users2.UserOut{}, nil)
+			mockRepoTeams.EXPECT().DeleteTeamByID(gomock.Any(), teamID).Return(nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{{Type: usecase2.EventTeamDeleted, TeamName: "billing"}}).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockRepoTeams, mockRepoUsers, mockRepoPullRequests, mockRepoPRReviewers,
+				mockRepoPRStatuses, mockRepoMemberships, mockRandomizer, cntReviewers, mockPublisher, defaultTeamName, mockTrm)
+
+			result, err := u.Run(context.Background(), In{TeamName: "billing"})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "billing", result.TeamName)
+		})
+	}
+}
+
+func TestReviewerEvents(t *testing.T) {
+	prID := uuid.New()
+	authorID := uuid.New()
+	removedID := uuid.New()
+	addedID := uuid.New()
+
+	result := reviewerEvents([]AffectedPullRequest{{
+		PullRequestID:    prID,
+		PullRequestName:  "PR",
+		AuthorID:         authorID,
+		RemovedReviewers: []uuid.UUID{removedID},
+		AddedReviewers:   []uuid.UUID{addedID},
+	}})
+
+	require.Len(t, result, 2)
+	assert.Equal(t, usecase2.EventReviewerUnassigned, result[0].Type)
+	assert.Equal(t, removedID, result[0].ReviewerID)
+	assert.Equal(t, usecase2.EventReviewerAssigned, result[1].Type)
+	assert.Equal(t, addedID, result[1].ReviewerID)
+	assert.Equal(t, prID, result[1].PullRequestID)
+	assert.Equal(t, authorID, result[1].AuthorID)
+}
@@ -0,0 +1,33 @@
+package team_rebalance
+
+import "github.com/google/uuid"
+
+type In struct {
+	TeamName  string
+	Tolerance int
+	DryRun    bool
+}
+
+type Reassignment struct {
+	PullRequestID   uuid.UUID
+	PullRequestName string
+	AuthorID        uuid.UUID
+	OldReviewerID   uuid.UUID
+	NewReviewerID   uuid.UUID
+}
+
+type MemberWorkload struct {
+	UserID            uuid.UUID
+	Username          string
+	OpenReviewsBefore int
+	OpenReviewsAfter  int
+}
+
+type Out struct {
+	TeamName      string
+	Tolerance     int
+	Reassignments []Reassignment
+	Workload      []MemberWorkload
+	Balanced      bool
+	DryRun        bool
+}
@@ -0,0 +1,345 @@
+package team_rebalance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
+	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+)
+
+// DefaultTolerance is the allowed gap between the busiest and the least busy member when none is requested.
+const DefaultTolerance = 1
+
+// errDryRunRollback aborts the transaction after the plan is built so that nothing is committed.
+var errDryRunRollback = errors.New("dry run rollback")
+
+type usecase struct {
+	repTeams        teams.RepositoryTeams
+	repUsers        users.RepositoryUsers
+	repPullRequests pull_requests.RepositoryPullRequests
+	repPRReviewers  pr_reviewers.RepositoryPrReviewers
+	repPRStatuses   pr_statuses.RepositoryPrStatuses
+	nower           nower.Nower
+	publisher       events.Publisher
+	trm             trm.Manager
+}
+
+func NewUsecase(
+	repTeams teams.RepositoryTeams,
+	repUsers users.RepositoryUsers,
+	repPullRequests pull_requests.RepositoryPullRequests,
+	repPRReviewers pr_reviewers.RepositoryPrReviewers,
+	repPRStatuses pr_statuses.RepositoryPrStatuses,
+	nower nower.Nower,
+	publisher events.Publisher,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repTeams:        repTeams,
+		repUsers:        repUsers,
+		repPullRequests: repPullRequests,
+		repPRReviewers:  repPRReviewers,
+		repPRStatuses:   repPRStatuses,
+		nower:           nower,
+		publisher:       publisher,
+		trm:             trm,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		if err != nil {
+			return err
+		}
+		if req.DryRun {
+			return errDryRunRollback
+		}
+		return nil
+	})
+	if errors.Is(err, errDryRunRollback) {
+		slog.DebugContext(ctx, "Dry run finished, changes rolled back")
+		return result, nil
+	}
+
+	return result, err
+}
+
+// openReviews holds the open PRs reviewed by active team members and who reviews each of them.
+type openReviews struct {
+	prs       []pull_requests2.PullRequestOut
+	reviewers map[uuid.UUID]map[uuid.UUID]struct{}
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Get team by name", "team_name", req.TeamName)
+	team, err := u.repTeams.GetTeamByName(ctx, req.TeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamNotFound, req.TeamName))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, req.TeamName))
+	}
+
+	slog.DebugContext(ctx, "Get team members", "team_id", team.ID)
+	teamUsers, err := u.repUsers.GetUsersByTeamID(ctx, team.ID)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, team.ID))
+	}
+
+	teamUserIDs := make(map[uuid.UUID]struct{})
+	activeMembers := make([]users2.UserOut, 0)
+	activeIDs := make([]uuid.UUID, 0)
+	if teamUsers != nil {
+		for _, user := range *teamUsers {
+			teamUserIDs[user.ID] = struct{}{}
+			if user.IsActive {
+				activeMembers = append(activeMembers, user)
+				activeIDs = append(activeIDs, user.ID)
+			}
+		}
+	}
+
+	result := &Out{
+		TeamName:      team.Name,
+		Tolerance:     req.Tolerance,
+		Reassignments: []Reassignment{},
+		Workload:      []MemberWorkload{},
+		Balanced:      true,
+		DryRun:        req.DryRun,
+	}
+	if len(activeMembers) == 0 {
+		slog.DebugContext(ctx, "Team has no active members, nothing to rebalance")
+		return result, nil
+	}
+
+	slog.DebugContext(ctx, "Load open reviews of active members", "active_members", len(activeMembers))
+	reviews, err := u.loadOpenReviews(ctx, activeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	load := make(map[uuid.UUID]int, len(activeMembers))
+	for _, pr := range reviews.prs {
+		for reviewerID := range reviews.reviewers[pr.ID] {
+			load[reviewerID]++
+		}
+	}
+	loadBefore := make(map[uuid.UUID]int, len(load))
+	for userID, cnt := range load {
+		loadBefore[userID] = cnt
+	}
+
+	now := u.nower.Now()
+	members := make([]users2.UserOut, len(activeMembers))
+	copy(members, activeMembers)
+	for {
+		sort.SliceStable(members, func(i, j int) bool {
+			return load[members[i].ID] < load[members[j].ID]
+		})
+		if load[members[len(members)-1].ID]-load[members[0].ID] <= req.Tolerance {
+			break
+		}
+
+		move, found := findMove(members, load, reviews, teamUserIDs, now)
+		if !found {
+			slog.WarnContext(ctx, "No further reassignment can reduce the workload gap",
+				"max_load", load[members[len(members)-1].ID],
+				"min_load", load[members[0].ID])
+			result.Balanced = false
+			break
+		}
+
+		_, err = u.repPRReviewers.SavePRReviewer(ctx, pr_reviewers2.PrReviewerIn{
+			PrID:       move.PullRequestID,
+			ReviewerID: move.NewReviewerID,
+		})
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer %s", usecase2.ErrAssignReviewer, move.NewReviewerID))
+		}
+		err = u.repPRReviewers.DeletePRReviewerByPRAndReviewer(ctx, move.PullRequestID, move.OldReviewerID)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer_id %s", usecase2.ErrRemoveReviewer, move.OldReviewerID))
+		}
+
+		delete(reviews.reviewers[move.PullRequestID], move.OldReviewerID)
+		reviews.reviewers[move.PullRequestID][move.NewReviewerID] = struct{}{}
+		load[move.OldReviewerID]--
+		load[move.NewReviewerID]++
+		result.Reassignments = append(result.Reassignments, move)
+		slog.DebugContext(ctx, "Reviewer reassigned",
+			"pr_id", move.PullRequestID,
+			"old_reviewer_id", move.OldReviewerID,
+			"new_reviewer_id", move.NewReviewerID)
+	}
+
+	slog.DebugContext(ctx, "Publish rebalance events", "count", 2*len(result.Reassignments))
+	if err = u.publisher.Publish(ctx, reviewerEvents(result.Reassignments)); err != nil {
+		return nil, err
+	}
+
+	for _, member := range activeMembers {
+		result.Workload = append(result.Workload, MemberWorkload{
+			UserID:            member.ID,
+			Username:          member.Name,
+			OpenReviewsBefore: loadBefore[member.ID],
+			OpenReviewsAfter:  load[member.ID],
+		})
+	}
+
+	slog.DebugContext(ctx, "UseCase TeamRebalance success",
+		"reassignments", len(result.Reassignments),
+		"balanced", result.Balanced,
+		"dry_run", req.DryRun)
+	return result, nil
+}
+
+func (u *usecase) loadOpenReviews(ctx context.Context, reviewerIDs []uuid.UUID) (*openReviews, error) {
+	reviews := &openReviews{
+		prs:       []pull_requests2.PullRequestOut{},
+		reviewers: make(map[uuid.UUID]map[uuid.UUID]struct{}),
+	}
+
+	assignments, err := u.repPRReviewers.GetPRReviewersByReviewerIDs(ctx, reviewerIDs)
+	if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPRReviewers))
+	}
+	if assignments == nil || len(*assignments) == 0 {
+		return reviews, nil
+	}
+
+	prIDs := make([]uuid.UUID, 0, len(*assignments))
+	for _, assignment := range *assignments {
+		if _, exist := reviews.reviewers[assignment.PRID]; !exist {
+			reviews.reviewers[assignment.PRID] = make(map[uuid.UUID]struct{})
+			prIDs = append(prIDs, assignment.PRID)
+		}
+		reviews.reviewers[assignment.PRID][assignment.ReviewerID] = struct{}{}
+	}
+
+	prs, err := u.repPullRequests.GetPullRequestsByPrIDs(ctx, prIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPullRequest))
+	}
+	statusIDs := make([]uuid.UUID, 0, len(*prs))
+	for _, pr := range *prs {
+		statusIDs = append(statusIDs, pr.StatusID)
+	}
+	prStatuses, err := u.repPRStatuses.GetPRStatusesByIDs(ctx, statusIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPRStatus))
+	}
+	statusMap := make(map[uuid.UUID]string)
+	if prStatuses != nil {
+		for _, status := range *prStatuses {
+			statusMap[status.ID] = status.Status
+		}
+	}
+
+	for _, pr := range *prs {
+		if statusMap[pr.StatusID] == usecase2.OpenStatusValue {
+			reviews.prs = append(reviews.prs, pr)
+		} else {
+			delete(reviews.reviewers, pr.ID)
+		}
+	}
+
+	slog.DebugContext(ctx, "Found open reviews", "total_prs", len(*prs), "open_prs", len(reviews.prs))
+	return reviews, nil
+}
+
+// findMove picks one review to hand over from a busier member to a less busy one.
+// members must be sorted by ascending load. Only PRs authored inside the team are moved and
+// the new reviewer has to pass the same candidate rules as every other reviewer selection,
+// unavailable members only hand their reviews over.
+func findMove(
+	members []users2.UserOut,
+	load map[uuid.UUID]int,
+	reviews *openReviews,
+	teamUserIDs map[uuid.UUID]struct{},
+	now time.Time,
+) (Reassignment, bool) {
+	for i := len(members) - 1; i > 0; i-- {
+		donor := members[i]
+		for j := 0; j < i; j++ {
+			candidate := members[j]
+			if load[candidate.ID]+1 >= load[donor.ID] {
+				break
+			}
+			if usecase2.IsUnavailable(candidate, now) {
+				continue
+			}
+			for _, pr := range reviews.prs {
+				if _, inTeam := teamUserIDs[pr.AuthorID]; !inTeam {
+					continue
+				}
+				reviewers := reviews.reviewers[pr.ID]
+				if _, assigned := reviewers[donor.ID]; !assigned {
+					continue
+				}
+				excluded := []uuid.UUID{pr.AuthorID}
+				for reviewerID := range reviewers {
+					excluded = append(excluded, reviewerID)
+				}
+				if len(usecase2.ReviewerCandidates([]users2.UserOut{candidate}, excluded...)) == 0 {
+					continue
+				}
+				return Reassignment{
+					PullRequestID:   pr.ID,
+					PullRequestName: pr.Name,
+					AuthorID:        pr.AuthorID,
+					OldReviewerID:   donor.ID,
+					NewReviewerID:   candidate.ID,
+				}, true
+			}
+		}
+	}
+
+	return Reassignment{}, false
+}
+
+// reviewerEvents turns every move into the same unassigned/assigned pair a manual reassign publishes.
+func reviewerEvents(reassignments []Reassignment) []usecase2.Event {
+	reviewerEvents := make([]usecase2.Event, 0, 2*len(reassignments))
+	for _, move := range reassignments {
+		reviewerEvents = append(reviewerEvents,
+			usecase2.Event{
+				Type:            usecase2.EventReviewerUnassigned,
+				PullRequestID:   move.PullRequestID,
+				PullRequestName: move.PullRequestName,
+				AuthorID:        move.AuthorID,
+				ReviewerID:      move.OldReviewerID,
+			},
+			usecase2.Event{
+				Type:            usecase2.EventReviewerAssigned,
+				PullRequestID:   move.PullRequestID,
+				PullRequestName: move.PullRequestName,
+				AuthorID:        move.AuthorID,
+				ReviewerID:      move.NewReviewerID,
+			},
+		)
+	}
+	return reviewerEvents
+}
@@ -0,0 +1,723 @@
+package team_rebalance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
+	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
+	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamRebalance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	teamName := "test-team"
+	user1ID := uuid.New()
+	user2ID := uuid.New()
+	user3ID := uuid.New()
+	user4ID := uuid.New()
+	pr1ID := uuid.New()
+	pr2ID := uuid.New()
+	pr3ID := uuid.New()
+	openStatusID := uuid.New()
+	mergedStatusID := uuid.New()
+	now := time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC)
+	unavailableUntil := now.Add(72 * time.Hour)
+
+	team := &teams2.TeamOut{
+		ID:   teamID,
+		Name: teamName,
+	}
+
+	teamMembers := []users2.UserOut{
+		{ID: user1ID, Name: "user1", IsActive: true, TeamID: teamID},
+		{ID: user2ID, Name: "user2", IsActive: true, TeamID: teamID},
+		{ID: user3ID, Name: "user3", IsActive: true, TeamID: teamID},
+		{ID: user4ID, Name: "user4", IsActive: false, TeamID: teamID},
+	}
+	activeIDs := []uuid.UUID{user1ID, user2ID, user3ID}
+
+	newPR := func(id uuid.UUID, name string, authorID, statusID uuid.UUID) pull_requests2.PullRequestOut {
+		return pull_requests2.PullRequestOut{
+			ID:        id,
+			Name:      name,
+			AuthorID:  authorID,
+			StatusID:  statusID,
+			CreatedAt: time.Now(),
+		}
+	}
+	newReviewer := func(prID, reviewerID uuid.UUID) pr_reviewers2.PrReviewerOut {
+		return pr_reviewers2.PrReviewerOut{
+			ID:         uuid.New(),
+			PRID:       prID,
+			ReviewerID: reviewerID,
+		}
+	}
+
+	openStatus := pr_statuses2.PRStatusOut{ID: openStatusID, Status: usecase2.OpenStatusValue}
+	mergedStatus := pr_statuses2.PRStatusOut{ID: mergedStatusID, Status: usecase2.MergedStatusValue}
+
+	// user1 reviews three open PRs while user2 and user3 review nothing.
+	overloadedReviewers := []pr_reviewers2.PrReviewerOut{
+		newReviewer(pr1ID, user1ID),
+		newReviewer(pr2ID, user1ID),
+		newReviewer(pr3ID, user1ID),
+	}
+	overloadedPRs := []pull_requests2.PullRequestOut{
+		newPR(pr1ID, "PR 1", user4ID, openStatusID),
+		newPR(pr2ID, "PR 2", user4ID, openStatusID),
+		newPR(pr3ID, "PR 3", user3ID, openStatusID),
+	}
+
+	expectTeam := func(mockTeams *teams.MockRepositoryTeams, mockUsers *users.MockRepositoryUsers) {
+		mockTeams.EXPECT().
+			GetTeamByName(gomock.Any(), teamName).
+			Return(team, nil)
+		mockUsers.EXPECT().
+			GetUsersByTeamID(gomock.Any(), teamID).
+			Return(&teamMembers, nil)
+	}
+	expectOverloaded := func(
+		mockPullRequests *pull_requests.MockRepositoryPullRequests,
+		mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+		mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+	) {
+		mockPRReviewers.EXPECT().
+			GetPRReviewersByReviewerIDs(gomock.Any(), activeIDs).
+			Return(&overloadedReviewers, nil)
+		mockPullRequests.EXPECT().
+			GetPullRequestsByPrIDs(gomock.Any(), []uuid.UUID{pr1ID, pr2ID, pr3ID}).
+			Return(&overloadedPRs, nil)
+		mockPRStatuses.EXPECT().
+			GetPRStatusesByIDs(gomock.Any(), []uuid.UUID{openStatusID, openStatusID, openStatusID}).
+			Return(&[]pr_statuses2.PRStatusOut{openStatus}, nil)
+	}
+	expectMove := func(mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers, prID, oldID, newID uuid.UUID) {
+		mockPRReviewers.EXPECT().
+			SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: prID, ReviewerID: newID}).
+			Return(&pr_reviewers2.PrReviewerOut{ID: uuid.New(), PRID: prID, ReviewerID: newID}, nil)
+		mockPRReviewers.EXPECT().
+			DeletePRReviewerByPRAndReviewer(gomock.Any(), prID, oldID).
+			Return(nil)
+	}
+
+	balancedOut := &Out{
+		TeamName:  teamName,
+		Tolerance: 1,
+		Reassignments: []Reassignment{
+			{PullRequestID: pr1ID, PullRequestName: "PR 1", AuthorID: user4ID, OldReviewerID: user1ID, NewReviewerID: user2ID},
+			{PullRequestID: pr2ID, PullRequestName: "PR 2", AuthorID: user4ID, OldReviewerID: user1ID, NewReviewerID: user3ID},
+		},
+		Workload: []MemberWorkload{
+			{UserID: user1ID, Username: "user1", OpenReviewsBefore: 3, OpenReviewsAfter: 1},
+			{UserID: user2ID, Username: "user2", OpenReviewsBefore: 0, OpenReviewsAfter: 1},
+			{UserID: user3ID, Username: "user3", OpenReviewsBefore: 0, OpenReviewsAfter: 1},
+		},
+		Balanced: true,
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockTeams *teams.MockRepositoryTeams,
+			mockUsers *users.MockRepositoryUsers,
+			mockPullRequests *pull_requests.MockRepositoryPullRequests,
+			mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+			mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+			mockTrm *mock.MockManager,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "moves reviews from overloaded member until gap is within tolerance",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectTeam(mockTeams, mockUsers)
+				expectOverloaded(mockPullRequests, mockPRReviewers, mockPRStatuses)
+				expectMove(mockPRReviewers, pr1ID, user1ID, user2ID)
+				expectMove(mockPRReviewers, pr2ID, user1ID, user3ID)
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expected: balancedOut,
+		},
+		{
+			name: "dry run returns plan and rolls back",
+			req:  In{TeamName: teamName, Tolerance: 1, DryRun: true},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectTeam(mockTeams, mockUsers)
+				expectOverloaded(mockPullRequests, mockPRReviewers, mockPRStatuses)
+				expectMove(mockPRReviewers, pr1ID, user1ID, user2ID)
+				expectMove(mockPRReviewers, pr2ID, user1ID, user3ID)
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						err := f(ctx)
+						assert.ErrorIs(t, err, errDryRunRollback)
+						return err
+					})
+			},
+			expected: func() *Out {
+				out := *balancedOut
+				out.DryRun = true
+				return &out
+			}(),
+		},
+		{
+			name: "never moves review to author or to already assigned reviewer",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), teamName).
+					Return(team, nil)
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{teamMembers[0], teamMembers[1], teamMembers[3]}, nil)
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByReviewerIDs(gomock.Any(), []uuid.UUID{user1ID, user2ID}).
+					Return(&[]pr_reviewers2.PrReviewerOut{
+						newReviewer(pr1ID, user1ID),
+						newReviewer(pr2ID, user1ID),
+						newReviewer(pr3ID, user1ID),
+						newReviewer(pr3ID, user2ID),
+					}, nil)
+				mockPullRequests.EXPECT().
+					GetPullRequestsByPrIDs(gomock.Any(), []uuid.UUID{pr1ID, pr2ID, pr3ID}).
+					Return(&[]pull_requests2.PullRequestOut{
+						newPR(pr1ID, "PR 1", user2ID, openStatusID),
+						newPR(pr2ID, "PR 2", user2ID, openStatusID),
+						newPR(pr3ID, "PR 3", user4ID, openStatusID),
+					}, nil)
+				mockPRStatuses.EXPECT().
+					GetPRStatusesByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]pr_statuses2.PRStatusOut{openStatus}, nil)
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expected: &Out{
+				TeamName:      teamName,
+				Tolerance:     1,
+				Reassignments: []Reassignment{},
+				Workload: []MemberWorkload{
+					{UserID: user1ID, Username: "user1", OpenReviewsBefore: 3, OpenReviewsAfter: 3},
+					{UserID: user2ID, Username: "user2", OpenReviewsBefore: 1, OpenReviewsAfter: 1},
+				},
+				Balanced: false,
+			},
+		},
+		{
+			name: "unavailable member only hands reviews over",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				unavailableMember := teamMembers[1]
+				unavailableMember.UnavailableUntil = &unavailableUntil
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), teamName).
+					Return(team, nil)
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(&[]users2.UserOut{teamMembers[0], unavailableMember, teamMembers[2], teamMembers[3]}, nil)
+				expectOverloaded(mockPullRequests, mockPRReviewers, mockPRStatuses)
+				expectMove(mockPRReviewers, pr1ID, user1ID, user3ID)
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expected: &Out{
+				TeamName:  teamName,
+				Tolerance: 1,
+				Reassignments: []Reassignment{
+					{PullRequestID: pr1ID, PullRequestName: "PR 1", AuthorID: user4ID, OldReviewerID: user1ID, NewReviewerID: user3ID},
+				},
+				Workload: []MemberWorkload{
+					{UserID: user1ID, Username: "user1", OpenReviewsBefore: 3, OpenReviewsAfter: 2},
+					{UserID: user2ID, Username: "user2", OpenReviewsBefore: 0, OpenReviewsAfter: 0},
+					{UserID: user3ID, Username: "user3", OpenReviewsBefore: 0, OpenReviewsAfter: 1},
+				},
+				Balanced: false,
+			},
+		},
+		{
+			name: "merged PRs are not counted and balanced team is left untouched",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectTeam(mockTeams, mockUsers)
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByReviewerIDs(gomock.Any(), activeIDs).
+					Return(&[]pr_reviewers2.PrReviewerOut{
+						newReviewer(pr1ID, user1ID),
+						newReviewer(pr2ID, user1ID),
+						newReviewer(pr3ID, user1ID),
+					}, nil)
+				mockPullRequests.EXPECT().
+					GetPullRequestsByPrIDs(gomock.Any(), []uuid.UUID{pr1ID, pr2ID, pr3ID}).
+					Return(&[]pull_requests2.PullRequestOut{
+						newPR(pr1ID, "PR 1", user4ID, mergedStatusID),
+						newPR(pr2ID, "PR 2", user4ID, mergedStatusID),
+						newPR(pr3ID, "PR 3", user4ID, openStatusID),
+					}, nil)
+				mockPRStatuses.EXPECT().
+					GetPRStatusesByIDs(gomock.Any(), []uuid.UUID{mergedStatusID, mergedStatusID, openStatusID}).
+					Return(&[]pr_statuses2.PRStatusOut{mergedStatus, openStatus}, nil)
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expected: &Out{
+				TeamName:      teamName,
+				Tolerance:     1,
+				Reassignments: []Reassignment{},
+				Workload: []MemberWorkload{
+					{UserID: user1ID, Username: "user1", OpenReviewsBefore: 1, OpenReviewsAfter: 1},
+					{UserID: user2ID, Username: "user2", OpenReviewsBefore: 0, OpenReviewsAfter: 0},
+					{UserID: user3ID, Username: "user3", OpenReviewsBefore: 0, OpenReviewsAfter: 0},
+				},
+				Balanced: true,
+			},
+		},
+		{
+			name: "team without active members",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), teamName).
+					Return(team, nil)
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(nil, repository.ErrUserNotFound)
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expected: &Out{
+				TeamName:      teamName,
+				Tolerance:     1,
+				Reassignments: []Reassignment{},
+				Workload:      []MemberWorkload{},
+				Balanced:      true,
+			},
+		},
+		{
+			name: "team not found",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), teamName).
+					Return(nil, repository.ErrTeamNotFound)
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrTeamNotFound,
+		},
+		{
+			name: "get team error",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), teamName).
+					Return(nil, errors.New("db error"))
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
+		{
+			name: "get team members error",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockTeams.EXPECT().
+					GetTeamByName(gomock.Any(), teamName).
+					Return(team, nil)
+				mockUsers.EXPECT().
+					GetUsersByTeamID(gomock.Any(), teamID).
+					Return(nil, errors.New("db error"))
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrGetUsers,
+		},
+		{
+			name: "get pr reviewers error",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectTeam(mockTeams, mockUsers)
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByReviewerIDs(gomock.Any(), activeIDs).
+					Return(nil, errors.New("db error"))
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrGetPRReviewers,
+		},
+		{
+			name: "get pull requests error",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectTeam(mockTeams, mockUsers)
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByReviewerIDs(gomock.Any(), activeIDs).
+					Return(&overloadedReviewers, nil)
+				mockPullRequests.EXPECT().
+					GetPullRequestsByPrIDs(gomock.Any(), []uuid.UUID{pr1ID, pr2ID, pr3ID}).
+					Return(nil, errors.New("db error"))
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrGetPullRequest,
+		},
+		{
+			name: "get pr statuses error",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectTeam(mockTeams, mockUsers)
+				mockPRReviewers.EXPECT().
+					GetPRReviewersByReviewerIDs(gomock.Any(), activeIDs).
+					Return(&overloadedReviewers, nil)
+				mockPullRequests.EXPECT().
+					GetPullRequestsByPrIDs(gomock.Any(), []uuid.UUID{pr1ID, pr2ID, pr3ID}).
+					Return(&overloadedPRs, nil)
+				mockPRStatuses.EXPECT().
+					GetPRStatusesByIDs(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrGetPRStatus,
+		},
+		{
+			name: "assign reviewer error",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectTeam(mockTeams, mockUsers)
+				expectOverloaded(mockPullRequests, mockPRReviewers, mockPRStatuses)
+				mockPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr1ID, ReviewerID: user2ID}).
+					Return(nil, errors.New("db error"))
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrAssignReviewer,
+		},
+		{
+			name: "remove reviewer error",
+			req:  In{TeamName: teamName, Tolerance: 1},
+			setupMock: func(
+				mockTeams *teams.MockRepositoryTeams,
+				mockUsers *users.MockRepositoryUsers,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				expectTeam(mockTeams, mockUsers)
+				expectOverloaded(mockPullRequests, mockPRReviewers, mockPRStatuses)
+				mockPRReviewers.EXPECT().
+					SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: pr1ID, ReviewerID: user2ID}).
+					Return(&pr_reviewers2.PrReviewerOut{ID: uuid.New(), PRID: pr1ID, ReviewerID: user2ID}, nil)
+				mockPRReviewers.EXPECT().
+					DeletePRReviewerByPRAndReviewer(gomock.Any(), pr1ID, user1ID).
+					Return(errors.New("db error"))
+
+				mockTrm.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, f func(context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrRemoveReviewer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+			mockNower.EXPECT().Now().Return(now).AnyTimes()
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockTrm := mock.NewMockManager(ctrl)
+
+			tt.setupMock(
+				mockRepoTeams,
+				mockRepoUsers,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockTrm,
+			)
+
+			u := NewUsecase(
+				mockRepoTeams,
+				mockRepoUsers,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockNower,
+				mockPublisher,
+				mockTrm,
+			)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestTeamRebalancePublishesEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	teamName := "test-team"
+	busyID := uuid.New()
+	freeID := uuid.New()
+	authorID := uuid.New()
+	prID := uuid.New()
+	otherPRID := uuid.New()
+	openStatusID := uuid.New()
+	publishErr := errors.New("publish error")
+
+	members := []users2.UserOut{
+		{ID: busyID, Name: "busy", IsActive: true, TeamID: teamID},
+		{ID: freeID, Name: "free", IsActive: true, TeamID: teamID},
+		{ID: authorID, Name: "author", IsActive: false, TeamID: teamID},
+	}
+
+	tests := []struct {
+		name          string
+		publishErr    error
+		expectedError error
+	}{
+		{name: "unassigned and assigned events are published for every move"},
+		{name: "publish error rolls back rebalance", publishErr: publishErr, expectedError: publishErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+			mockNower.EXPECT().Now().Return(time.Now()).AnyTimes()
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoTeams.EXPECT().GetTeamByName(gomock.Any(), teamName).
+				Return(&teams2.TeamOut{ID: teamID, Name: teamName}, nil)
+			mockRepoUsers.EXPECT().GetUsersByTeamID(gomock.Any(), teamID).Return(&members, nil)
+			mockRepoPRReviewers.EXPECT().GetPRReviewersByReviewerIDs(gomock.Any(), []uuid.UUID{busyID, freeID}).
+				Return(&[]pr_reviewers2.PrReviewerOut{
+					{ID: uuid.New(), PRID: prID, ReviewerID: busyID},
+					{ID: uuid.New(), PRID: otherPRID, ReviewerID: busyID},
+				}, nil)
+			mockRepoPullRequests.EXPECT().GetPullRequestsByPrIDs(gomock.Any(), []uuid.UUID{prID, otherPRID}).
+				Return(&[]pull_requests2.PullRequestOut{
+					{ID: prID, Name: "PR 1", AuthorID: authorID, StatusID: openStatusID},
+					{ID: otherPRID, Name: "PR 2", AuthorID: authorID, StatusID: openStatusID},
+				}, nil)
+			mockRepoPRStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), []uuid.UUID{openStatusID, openStatusID}).
+				Return(&[]pr_statuses2.PRStatusOut{{ID: openStatusID, Status: usecase2.OpenStatusValue}}, nil)
+			mockRepoPRReviewers.EXPECT().
+				SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: prID, ReviewerID: freeID}).
+				Return(&pr_reviewers2.PrReviewerOut{ID: uuid.New(), PRID: prID, ReviewerID: freeID}, nil)
+			mockRepoPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), prID, busyID).Return(nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{
+					{
+						Type:            usecase2.EventReviewerUnassigned,
+						PullRequestID:   prID,
+						PullRequestName: "PR 1",
+						AuthorID:        authorID,
+						ReviewerID:      busyID,
+					},
+					{
+						Type:            usecase2.EventReviewerAssigned,
+						PullRequestID:   prID,
+						PullRequestName: "PR 1",
+						AuthorID:        authorID,
+						ReviewerID:      freeID,
+					},
+				}).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockRepoTeams, mockRepoUsers, mockRepoPullRequests, mockRepoPRReviewers,
+				mockRepoPRStatuses, mockNower, mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), In{TeamName: teamName, Tolerance: 1})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, result.Reassignments, 1)
+		})
+	}
+}
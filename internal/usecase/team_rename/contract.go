@@ -0,0 +1,11 @@
+package team_rename
+
+type In struct {
+	TeamName    string
+	NewTeamName string
+}
+
+type Out struct {
+	TeamName         string
+	PreviousTeamName string
+}
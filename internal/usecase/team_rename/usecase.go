@@ -0,0 +1,78 @@
+package team_rename
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+type usecase struct {
+	repTeams  teams.RepositoryTeams
+	publisher events.Publisher
+	trm       trm.Manager
+}
+
+func NewUsecase(repTeams teams.RepositoryTeams, publisher events.Publisher, trm trm.Manager) *usecase {
+	return &usecase{
+		repTeams:  repTeams,
+		publisher: publisher,
+		trm:       trm,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Get team by name", "team_name", req.TeamName)
+	team, err := u.repTeams.GetTeamByName(ctx, req.TeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamNotFound, req.TeamName))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, req.TeamName))
+	}
+
+	if team.Name == req.NewTeamName {
+		slog.DebugContext(ctx, "Team already has required name", "team_name", req.TeamName)
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamDontNeedChange, req.TeamName))
+	}
+
+	slog.DebugContext(ctx, "Rename team", "team_id", team.ID, "new_team_name", req.NewTeamName)
+	renamedTeam, err := u.repTeams.UpdateTeamName(ctx, team.ID, req.NewTeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamAlreadyExists) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamAlreadyExists, req.NewTeamName))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrUpdateTeam, team.ID))
+	}
+
+	slog.DebugContext(ctx, "Publish renamed event")
+	if err = u.publisher.Publish(ctx, []usecase2.Event{{Type: usecase2.EventTeamRenamed, TeamName: renamedTeam.Name}}); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase RenameTeam success")
+	return &Out{
+		TeamName:         renamedTeam.Name,
+		PreviousTeamName: team.Name,
+	}, nil
+}
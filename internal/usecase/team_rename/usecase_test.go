@@ -0,0 +1,211 @@
+package team_rename
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenameTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	req := In{
+		TeamName:    "billing",
+		NewTeamName: "payments",
+	}
+	team := &teams2.TeamOut{
+		ID:   teamID,
+		Name: "billing",
+	}
+	renamedTeam := &teams2.TeamOut{
+		ID:   teamID,
+		Name: "payments",
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockTeams *teams.MockRepositoryTeams,
+			mockTrm *mock.MockManager,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "successful rename",
+			req:  req,
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(team, nil)
+				mockTeams.EXPECT().UpdateTeamName(gomock.Any(), teamID, "payments").Return(renamedTeam, nil)
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expected: &Out{
+				TeamName:         "payments",
+				PreviousTeamName: "billing",
+			},
+		},
+		{
+			name: "team not found",
+			req:  req,
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(nil, repository.ErrTeamNotFound)
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrTeamNotFound,
+		},
+		{
+			name: "error getting team",
+			req:  req,
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(nil, errors.New("db error"))
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrGetTeam,
+		},
+		{
+			name: "same name does not need change",
+			req: In{
+				TeamName:    "billing",
+				NewTeamName: "billing",
+			},
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(team, nil)
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrTeamDontNeedChange,
+		},
+		{
+			name: "new name is already taken",
+			req:  req,
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(team, nil)
+				mockTeams.EXPECT().UpdateTeamName(gomock.Any(), teamID, "payments").Return(nil, repository.ErrTeamAlreadyExists)
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrTeamAlreadyExists,
+		},
+		{
+			name: "error updating team",
+			req:  req,
+			setupMock: func(mockTeams *teams.MockRepositoryTeams, mockTrm *mock.MockManager) {
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").Return(team, nil)
+				mockTeams.EXPECT().UpdateTeamName(gomock.Any(), teamID, "payments").Return(nil, errors.New("db error"))
+				mockTrm.EXPECT().
+					Do(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+						return f(ctx)
+					})
+			},
+			expectedError: usecase2.ErrUpdateTeam,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			tt.setupMock(mockRepoTeams, mockTrm)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			u := NewUsecase(mockRepoTeams, mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestRenameTeamPublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	teamID := uuid.New()
+	publishErr := errors.New("publish error")
+
+	tests := []struct {
+		name          string
+		publishErr    error
+		expectedError error
+	}{
+		{name: "renamed event is published"},
+		{name: "publish error rolls back rename", publishErr: publishErr, expectedError: publishErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoTeams.EXPECT().GetTeamByName(gomock.Any(), "billing").
+				Return(&teams2.TeamOut{ID: teamID, Name: "billing"}, nil)
+			mockRepoTeams.EXPECT().UpdateTeamName(gomock.Any(), teamID, "payments").
+				Return(&teams2.TeamOut{ID: teamID, Name: "payments"}, nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{{Type: usecase2.EventTeamRenamed, TeamName: "payments"}}).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockRepoTeams, mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), In{TeamName: "billing", NewTeamName: "payments"})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "payments", result.TeamName)
+		})
+	}
+}
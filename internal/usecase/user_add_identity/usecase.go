@@ -0,0 +1,98 @@
+package user_add_identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+type usecase struct {
+	repUsers      users.RepositoryUsers
+	repIdentities user_identities.RepositoryUserIdentities
+	trm           trm.Manager
+}
+
+func NewUsecase(
+	repUsers users.RepositoryUsers,
+	repIdentities user_identities.RepositoryUserIdentities,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repUsers:      repUsers,
+		repIdentities: repIdentities,
+		trm:           trm,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	identity, err := usecase2.NewIdentity(req.Provider, req.ExternalID)
+	if err != nil {
+		return nil, logging.WrapError(ctx, err)
+	}
+
+	slog.DebugContext(ctx, "Call GetUserByID", "user_id", req.UserID)
+	if _, err = u.repUsers.GetUserByID(ctx, req.UserID); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrUserNotFound, req.UserID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, req.UserID))
+	}
+
+	slog.DebugContext(ctx, "Call GetUserIdentity", "identity", identity.String())
+	linked, err := u.repIdentities.GetUserIdentity(ctx, identity.Provider, identity.ExternalID)
+	switch {
+	case err == nil && linked.UserID != req.UserID:
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s is linked to user_id %s",
+			usecase2.ErrIdentityAlreadyLinked, identity, linked.UserID))
+	case err == nil:
+		slog.DebugContext(ctx, "Identity is already linked to the user", "identity", identity.String())
+	case errors.Is(err, repository.ErrIdentityNotFound):
+		slog.DebugContext(ctx, "Call SaveUserIdentitiesBatch", "identity", identity.String())
+		_, err = u.repIdentities.SaveUserIdentitiesBatch(ctx, []user_identities2.UserIdentityIn{{
+			UserID:     req.UserID,
+			Provider:   identity.Provider,
+			ExternalID: identity.ExternalID,
+		}})
+		if err != nil {
+			if errors.Is(err, repository.ErrIdentityExists) {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrIdentityAlreadyLinked, identity))
+			}
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSaveIdentities, identity))
+		}
+	default:
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetIdentities, identity))
+	}
+
+	identities, err := usecase2.GetUserIdentities(ctx, u.repIdentities, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase AddUserIdentity success", "user_id", req.UserID)
+	return &Out{
+		UserID:     req.UserID,
+		Identities: identities,
+	}, nil
+}
@@ -0,0 +1,245 @@
+package user_add_identity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	user_identities "pr-reviewers-service/internal/usecase/contract/repository/user_identities/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddUserIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	otherUserID := uuid.New()
+
+	req := In{
+		UserID:     userID,
+		Provider:   "GitHub",
+		ExternalID: " Alice ",
+	}
+	user := &users2.UserOut{
+		ID:       userID,
+		Name:     "alice",
+		IsActive: true,
+		TeamID:   uuid.New(),
+	}
+	linkedIdentities := &[]user_identities2.UserIdentityOut{
+		{UserID: userID, Provider: "email", ExternalID: "alice@example.com"},
+		{UserID: userID, Provider: "github", ExternalID: "alice"},
+	}
+	expectedOut := &Out{
+		UserID: userID,
+		Identities: []usecase2.Identity{
+			{Provider: "email", ExternalID: "alice@example.com"},
+			{Provider: "github", ExternalID: "alice"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockUsers *users.MockRepositoryUsers,
+			mockIdentities *user_identities.MockRepositoryUserIdentities,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "identity is linked",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(user, nil)
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "github", "alice").
+					Return(nil, repository.ErrIdentityNotFound)
+				mockIdentities.EXPECT().
+					SaveUserIdentitiesBatch(gomock.Any(), []user_identities2.UserIdentityIn{
+						{UserID: userID, Provider: "github", ExternalID: "alice"},
+					}).
+					Return(&[]user_identities2.UserIdentityOut{}, nil)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(linkedIdentities, nil)
+			},
+			expected: expectedOut,
+		},
+		{
+			name: "identity already linked to the same user",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(user, nil)
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "github", "alice").
+					Return(&user_identities2.UserIdentityOut{UserID: userID, Provider: "github", ExternalID: "alice"}, nil)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(linkedIdentities, nil)
+			},
+			expected: expectedOut,
+		},
+		{
+			name: "identity linked to another user",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(user, nil)
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "github", "alice").
+					Return(&user_identities2.UserIdentityOut{UserID: otherUserID, Provider: "github", ExternalID: "alice"}, nil)
+			},
+			expectedError: usecase2.ErrIdentityAlreadyLinked,
+		},
+		{
+			name: "identity linked concurrently",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(user, nil)
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "github", "alice").
+					Return(nil, repository.ErrIdentityNotFound)
+				mockIdentities.EXPECT().
+					SaveUserIdentitiesBatch(gomock.Any(), gomock.Any()).
+					Return(nil, repository.ErrIdentityExists)
+			},
+			expectedError: usecase2.ErrIdentityAlreadyLinked,
+		},
+		{
+			name: "unknown provider",
+			req: In{
+				UserID:     userID,
+				Provider:   "bitbucket",
+				ExternalID: "alice",
+			},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+			},
+			expectedError: usecase2.ErrInvalidIdentity,
+		},
+		{
+			name: "user not found",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(nil, repository.ErrUserNotFound)
+			},
+			expectedError: usecase2.ErrUserNotFound,
+		},
+		{
+			name: "error getting user",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetUser,
+		},
+		{
+			name: "error getting identity",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(user, nil)
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "github", "alice").
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetIdentities,
+		},
+		{
+			name: "error saving identity",
+			req:  req,
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockIdentities *user_identities.MockRepositoryUserIdentities,
+			) {
+				mockUsers.EXPECT().
+					GetUserByID(gomock.Any(), userID).
+					Return(user, nil)
+				mockIdentities.EXPECT().
+					GetUserIdentity(gomock.Any(), "github", "alice").
+					Return(nil, repository.ErrIdentityNotFound)
+				mockIdentities.EXPECT().
+					SaveUserIdentitiesBatch(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrSaveIdentities,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsers := users.NewMockRepositoryUsers(ctrl)
+			mockIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
+
+			mockTrm := mock.NewMockManager(ctrl)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			tt.setupMock(mockUsers, mockIdentities)
+
+			uc := NewUsecase(mockUsers, mockIdentities, mockTrm)
+			result, err := uc.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
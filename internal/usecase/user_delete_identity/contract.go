@@ -0,0 +1,18 @@
+package user_delete_identity
+
+import (
+	usecase2 "pr-reviewers-service/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+type In struct {
+	UserID     uuid.UUID
+	Provider   string
+	ExternalID string
+}
+
+type Out struct {
+	UserID     uuid.UUID
+	Identities []usecase2.Identity
+}
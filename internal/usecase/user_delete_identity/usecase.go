@@ -0,0 +1,66 @@
+package user_delete_identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/user_identities"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+type usecase struct {
+	repIdentities user_identities.RepositoryUserIdentities
+	trm           trm.Manager
+}
+
+func NewUsecase(repIdentities user_identities.RepositoryUserIdentities, trm trm.Manager) *usecase {
+	return &usecase{
+		repIdentities: repIdentities,
+		trm:           trm,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	identity, err := usecase2.NewIdentity(req.Provider, req.ExternalID)
+	if err != nil {
+		return nil, logging.WrapError(ctx, err)
+	}
+
+	slog.DebugContext(ctx, "Call DeleteUserIdentity", "identity", identity.String())
+	if err = u.repIdentities.DeleteUserIdentity(ctx, req.UserID, identity.Provider, identity.ExternalID); err != nil {
+		if errors.Is(err, repository.ErrIdentityNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s for user_id %s",
+				usecase2.ErrIdentityNotFound, identity, req.UserID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrDeleteIdentity, identity))
+	}
+
+	identities, err := usecase2.GetUserIdentities(ctx, u.repIdentities, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase DeleteUserIdentity success", "user_id", req.UserID)
+	return &Out{
+		UserID:     req.UserID,
+		Identities: identities,
+	}, nil
+}
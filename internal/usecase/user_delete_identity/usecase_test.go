@@ -0,0 +1,129 @@
+package user_delete_identity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	user_identities2 "pr-reviewers-service/internal/infrastructure/repository/user_identities"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	user_identities "pr-reviewers-service/internal/usecase/contract/repository/user_identities/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteUserIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+
+	req := In{
+		UserID:     userID,
+		Provider:   "GitHub",
+		ExternalID: "Alice",
+	}
+
+	tests := []struct {
+		name          string
+		req           In
+		setupMock     func(mockIdentities *user_identities.MockRepositoryUserIdentities)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "identity is unlinked",
+			req:  req,
+			setupMock: func(mockIdentities *user_identities.MockRepositoryUserIdentities) {
+				mockIdentities.EXPECT().
+					DeleteUserIdentity(gomock.Any(), userID, "github", "alice").
+					Return(nil)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]user_identities2.UserIdentityOut{
+						{UserID: userID, Provider: "email", ExternalID: "alice@example.com"},
+					}, nil)
+			},
+			expected: &Out{
+				UserID:     userID,
+				Identities: []usecase2.Identity{{Provider: "email", ExternalID: "alice@example.com"}},
+			},
+		},
+		{
+			name: "identity is not linked to the user",
+			req:  req,
+			setupMock: func(mockIdentities *user_identities.MockRepositoryUserIdentities) {
+				mockIdentities.EXPECT().
+					DeleteUserIdentity(gomock.Any(), userID, "github", "alice").
+					Return(repository.ErrIdentityNotFound)
+			},
+			expectedError: usecase2.ErrIdentityNotFound,
+		},
+		{
+			name: "unknown provider",
+			req: In{
+				UserID:     userID,
+				Provider:   "bitbucket",
+				ExternalID: "alice",
+			},
+			setupMock:     func(mockIdentities *user_identities.MockRepositoryUserIdentities) {},
+			expectedError: usecase2.ErrInvalidIdentity,
+		},
+		{
+			name: "error deleting identity",
+			req:  req,
+			setupMock: func(mockIdentities *user_identities.MockRepositoryUserIdentities) {
+				mockIdentities.EXPECT().
+					DeleteUserIdentity(gomock.Any(), userID, "github", "alice").
+					Return(errors.New("database error"))
+			},
+			expectedError: usecase2.ErrDeleteIdentity,
+		},
+		{
+			name: "error getting remaining identities",
+			req:  req,
+			setupMock: func(mockIdentities *user_identities.MockRepositoryUserIdentities) {
+				mockIdentities.EXPECT().
+					DeleteUserIdentity(gomock.Any(), userID, "github", "alice").
+					Return(nil)
+				mockIdentities.EXPECT().
+					GetUserIdentitiesByUserIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(nil, errors.New("database error"))
+			},
+			expectedError: usecase2.ErrGetIdentities,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockIdentities := user_identities.NewMockRepositoryUserIdentities(ctrl)
+
+			mockTrm := mock.NewMockManager(ctrl)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			tt.setupMock(mockIdentities)
+
+			uc := NewUsecase(mockIdentities, mockTrm)
+			result, err := uc.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
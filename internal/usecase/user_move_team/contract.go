@@ -0,0 +1,42 @@
+package user_move_team
+
+import "github.com/google/uuid"
+
+const (
+	// OutcomeReassigned means another member of the old team took over the review.
+	OutcomeReassigned = "REASSIGNED"
+	// OutcomeUnassigned means nobody in the old team could take the review and the PR lost a reviewer.
+	OutcomeUnassigned = "UNASSIGNED"
+)
+
+type In struct {
+	UserID            uuid.UUID
+	TeamName          string
+	ReassignReviews   bool
+	ReselectReviewers bool
+}
+
+type ReassignedReview struct {
+	PullRequestID   uuid.UUID
+	PullRequestName string
+	AuthorID        uuid.UUID
+	NewReviewerID   *uuid.UUID
+	Outcome         string
+}
+
+type ReselectedPullRequest struct {
+	PullRequestID    uuid.UUID
+	PullRequestName  string
+	RemovedReviewers []uuid.UUID
+	AddedReviewers   []uuid.UUID
+	Understaffed     bool
+}
+
+type Out struct {
+	UserID                 uuid.UUID
+	Username               string
+	OldTeamName            string
+	NewTeamName            string
+	ReassignedReviews      []ReassignedReview
+	ReselectedPullRequests []ReselectedPullRequest
+}
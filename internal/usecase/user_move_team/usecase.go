@@ -0,0 +1,421 @@
+package user_move_team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/events"
+	"pr-reviewers-service/internal/usecase/contract/randomizer"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers"
+	"pr-reviewers-service/internal/usecase/contract/repository/pr_statuses"
+	"pr-reviewers-service/internal/usecase/contract/repository/pull_requests"
+	"pr-reviewers-service/internal/usecase/contract/repository/team_memberships"
+	"pr-reviewers-service/internal/usecase/contract/repository/teams"
+	"pr-reviewers-service/internal/usecase/contract/repository/users"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+)
+
+type usecase struct {
+	repUsers        users.RepositoryUsers
+	repTeams        teams.RepositoryTeams
+	repMemberships  team_memberships.RepositoryTeamMemberships
+	repPullRequests pull_requests.RepositoryPullRequests
+	repPRReviewers  pr_reviewers.RepositoryPrReviewers
+	repPRStatuses   pr_statuses.RepositoryPrStatuses
+	selector        usecase2.ReviewerSelector
+	maxCntReviewers int
+	publisher       events.Publisher
+	trm             trm.Manager
+}
+
+func NewUsecase(
+	repUsers users.RepositoryUsers,
+	repTeams teams.RepositoryTeams,
+	repMemberships team_memberships.RepositoryTeamMemberships,
+	repPullRequests pull_requests.RepositoryPullRequests,
+	repPRReviewers pr_reviewers.RepositoryPrReviewers,
+	repPRStatuses pr_statuses.RepositoryPrStatuses,
+	randomizer randomizer.Randomizer,
+	maxCntReviewers int,
+	publisher events.Publisher,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repUsers:        repUsers,
+		repTeams:        repTeams,
+		repMemberships:  repMemberships,
+		repPullRequests: repPullRequests,
+		repPRReviewers:  repPRReviewers,
+		repPRStatuses:   repPRStatuses,
+		selector:        usecase2.NewReviewerSelector(repUsers, repTeams, randomizer),
+		maxCntReviewers: maxCntReviewers,
+		publisher:       publisher,
+		trm:             trm,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Call GetUserByID", "user_id", req.UserID)
+	user, err := u.repUsers.GetUserByID(ctx, req.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrUserNotFound, req.UserID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetUser, req.UserID))
+	}
+
+	slog.DebugContext(ctx, "Get team by name", "team_name", req.TeamName)
+	newTeam, err := u.repTeams.GetTeamByName(ctx, req.TeamName)
+	if err != nil {
+		if errors.Is(err, repository.ErrTeamNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: team %s", usecase2.ErrTeamNotFound, req.TeamName))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetTeam, req.TeamName))
+	}
+
+	if user.TeamID == newTeam.ID {
+		slog.DebugContext(ctx, "User already belongs to required team", "user_id", user.ID, "team_id", newTeam.ID)
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrUserDontNeedChange))
+	}
+
+	slog.DebugContext(ctx, "Call GetTeamByID", "team_id", user.TeamID)
+	oldTeam, err := u.repTeams.GetTeamByID(ctx, user.TeamID)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetTeam, user.TeamID))
+	}
+
+	slog.DebugContext(ctx, "Move user to new team", "user_id", user.ID, "old_team_id", oldTeam.ID, "new_team_id", newTeam.ID)
+	updatedUser, err := u.repUsers.UpdateUser(ctx, users2.UserIn{
+		ID:       user.ID,
+		Name:     user.Name,
+		IsActive: user.IsActive,
+		TeamID:   newTeam.ID,
+	})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateUser, user.ID))
+	}
+	_, err = u.repMemberships.SetPrimaryTeamMembership(ctx, user.ID, newTeam.ID)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: user_id %s", usecase2.ErrSaveTeamMemberships, user.ID))
+	}
+
+	reassigned := make([]ReassignedReview, 0)
+	if req.ReassignReviews {
+		slog.DebugContext(ctx, "Reassign open reviews held in old team", "team_id", oldTeam.ID)
+		reassigned, err = u.reassignOldTeamReviews(ctx, user.ID, oldTeam.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reselected := make([]ReselectedPullRequest, 0)
+	if req.ReselectReviewers {
+		slog.DebugContext(ctx, "Reselect reviewers of authored open PRs from new team", "team_id", newTeam.ID)
+		reselected, err = u.reselectAuthoredReviewers(ctx, user.ID, newTeam.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	domainEvents := reviewerEvents(user.ID, reassigned, reselected)
+	slog.DebugContext(ctx, "Publish team move events", "count", len(domainEvents))
+	if err = u.publisher.Publish(ctx, domainEvents); err != nil {
+		return nil, err
+	}
+
+	slog.DebugContext(ctx, "UseCase MoveUserTeam success",
+		"user_id", user.ID,
+		"reassigned_reviews", len(reassigned),
+		"reselected_prs", len(reselected))
+	return &Out{
+		UserID:                 updatedUser.ID,
+		Username:               updatedUser.Name,
+		OldTeamName:            oldTeam.Name,
+		NewTeamName:            newTeam.Name,
+		ReassignedReviews:      reassigned,
+		ReselectedPullRequests: reselected,
+	}, nil
+}
+
+// reassignOldTeamReviews hands the open reviews of the user on PRs authored in the old team over to
+// other active members of that team, or of its parent teams when nobody there is available.
+func (u *usecase) reassignOldTeamReviews(ctx context.Context, userID, oldTeamID uuid.UUID) ([]ReassignedReview, error) {
+	assignments, err := u.repPRReviewers.GetPRReviewersByReviewerID(ctx, userID)
+	if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer_id %s", usecase2.ErrGetPRReviewers, userID))
+	}
+	report := make([]ReassignedReview, 0)
+	if assignments == nil || len(*assignments) == 0 {
+		slog.DebugContext(ctx, "User is not assigned to any PR")
+		return report, nil
+	}
+
+	prIDs := make([]uuid.UUID, 0, len(*assignments))
+	for _, assignment := range *assignments {
+		prIDs = append(prIDs, assignment.PRID)
+	}
+	prs, err := u.repPullRequests.GetPullRequestsByPrIDs(ctx, prIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPullRequest))
+	}
+	openPRs, err := u.filterOpenPRs(ctx, *prs)
+	if err != nil {
+		return nil, err
+	}
+	if len(openPRs) == 0 {
+		return report, nil
+	}
+
+	authorIDs := make([]uuid.UUID, 0, len(openPRs))
+	for _, pr := range openPRs {
+		authorIDs = append(authorIDs, pr.AuthorID)
+	}
+	authors, err := u.repUsers.GetUsersByIDs(ctx, authorIDs)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetUsers))
+	}
+	authorTeams := make(map[uuid.UUID]uuid.UUID)
+	if authors != nil {
+		for _, author := range *authors {
+			authorTeams[author.ID] = author.TeamID
+		}
+	}
+
+	for _, pr := range openPRs {
+		if authorTeams[pr.AuthorID] != oldTeamID {
+			continue
+		}
+		item := ReassignedReview{
+			PullRequestID:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorID:        pr.AuthorID,
+		}
+
+		currentReviewers, err := u.repPRReviewers.GetPRReviewersByPRID(ctx, pr.ID)
+		if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrGetPRReviewers, pr.ID))
+		}
+		var reviewers []pr_reviewers2.PrReviewerOut
+		if currentReviewers != nil {
+			reviewers = *currentReviewers
+		}
+
+		excluded := []uuid.UUID{pr.AuthorID, userID}
+		for _, reviewer := range reviewers {
+			excluded = append(excluded, reviewer.ReviewerID)
+		}
+		selected, err := u.selector.Select(ctx, oldTeamID, 1, excluded...)
+		if err != nil {
+			return nil, err
+		}
+		if len(selected) == 0 {
+			slog.WarnContext(ctx, "No available reviewers found for PR", "pr_id", pr.ID, "team_id", oldTeamID)
+			item.Outcome = OutcomeUnassigned
+		} else {
+			newReviewerID := selected[0].User.ID
+			_, err = u.repPRReviewers.SavePRReviewer(ctx, pr_reviewers2.PrReviewerIn{
+				PrID:       pr.ID,
+				ReviewerID: newReviewerID,
+			})
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer %s", usecase2.ErrAssignReviewer, newReviewerID))
+			}
+			item.NewReviewerID = &newReviewerID
+			item.Outcome = OutcomeReassigned
+		}
+
+		err = u.repPRReviewers.DeletePRReviewerByPRAndReviewer(ctx, pr.ID, userID)
+		if err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer_id %s", usecase2.ErrRemoveReviewer, userID))
+		}
+
+		report = append(report, item)
+		slog.DebugContext(ctx, "Old team review reassigned", "pr_id", pr.ID, "outcome", item.Outcome)
+	}
+
+	return report, nil
+}
+
+// reselectAuthoredReviewers replaces reviewers of the user's open PRs who are not active members of the
+// new team and fills the PRs up to the reviewers limit from that team, or its parent teams when it runs short.
+func (u *usecase) reselectAuthoredReviewers(ctx context.Context, userID, newTeamID uuid.UUID) ([]ReselectedPullRequest, error) {
+	authored, err := u.repPullRequests.GetPullRequestsByAuthorIDs(ctx, []uuid.UUID{userID})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPullRequest))
+	}
+	openPRs, err := u.filterOpenPRs(ctx, *authored)
+	if err != nil {
+		return nil, err
+	}
+	report := make([]ReselectedPullRequest, 0, len(openPRs))
+	if len(openPRs) == 0 {
+		slog.DebugContext(ctx, "User has no open PRs")
+		return report, nil
+	}
+
+	teamMembers, err := u.repUsers.GetActiveUsersByTeamID(ctx, newTeamID)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: team_id %s", usecase2.ErrGetUsers, newTeamID))
+	}
+	newTeamMembers := make(map[uuid.UUID]struct{})
+	if teamMembers != nil {
+		for _, member := range *teamMembers {
+			newTeamMembers[member.ID] = struct{}{}
+		}
+	}
+
+	for _, pr := range openPRs {
+		item := ReselectedPullRequest{
+			PullRequestID:    pr.ID,
+			PullRequestName:  pr.Name,
+			RemovedReviewers: []uuid.UUID{},
+			AddedReviewers:   []uuid.UUID{},
+		}
+
+		currentReviewers, err := u.repPRReviewers.GetPRReviewersByPRID(ctx, pr.ID)
+		if err != nil && !errors.Is(err, repository.ErrPRReviewerNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: pr_id %s", usecase2.ErrGetPRReviewers, pr.ID))
+		}
+		var reviewers []pr_reviewers2.PrReviewerOut
+		if currentReviewers != nil {
+			reviewers = *currentReviewers
+		}
+
+		for _, reviewer := range reviewers {
+			if _, inNewTeam := newTeamMembers[reviewer.ReviewerID]; inNewTeam {
+				continue
+			}
+			err = u.repPRReviewers.DeletePRReviewerByPRAndReviewer(ctx, pr.ID, reviewer.ReviewerID)
+			if err != nil {
+				return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer_id %s", usecase2.ErrRemoveReviewer, reviewer.ReviewerID))
+			}
+			item.RemovedReviewers = append(item.RemovedReviewers, reviewer.ReviewerID)
+		}
+
+		needCnt := u.maxCntReviewers - (len(reviewers) - len(item.RemovedReviewers))
+		if needCnt > 0 {
+			excluded := []uuid.UUID{userID}
+			for _, reviewer := range reviewers {
+				excluded = append(excluded, reviewer.ReviewerID)
+			}
+			selected, err := u.selector.Select(ctx, newTeamID, needCnt, excluded...)
+			if err != nil {
+				return nil, err
+			}
+			for _, reviewer := range selected {
+				_, err = u.repPRReviewers.SavePRReviewer(ctx, pr_reviewers2.PrReviewerIn{
+					PrID:       pr.ID,
+					ReviewerID: reviewer.User.ID,
+				})
+				if err != nil {
+					return nil, logging.WrapError(ctx, fmt.Errorf("%w: reviewer %s", usecase2.ErrAssignReviewer, reviewer.User.ID))
+				}
+				item.AddedReviewers = append(item.AddedReviewers, reviewer.User.ID)
+			}
+		}
+
+		remainingReviewers := len(reviewers) - len(item.RemovedReviewers) + len(item.AddedReviewers)
+		item.Understaffed = remainingReviewers < u.maxCntReviewers
+
+		report = append(report, item)
+		slog.DebugContext(ctx, "PR reviewers reselected",
+			"pr_id", pr.ID,
+			"removed_reviewers", len(item.RemovedReviewers),
+			"added_reviewers", len(item.AddedReviewers),
+			"understaffed", item.Understaffed)
+	}
+
+	return report, nil
+}
+
+func (u *usecase) filterOpenPRs(ctx context.Context, prs []pull_requests2.PullRequestOut) ([]pull_requests2.PullRequestOut, error) {
+	statusIDs := make([]uuid.UUID, 0, len(prs))
+	for _, pr := range prs {
+		statusIDs = append(statusIDs, pr.StatusID)
+	}
+	prStatuses, err := u.repPRStatuses.GetPRStatusesByIDs(ctx, statusIDs)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w", usecase2.ErrGetPRStatus))
+	}
+	statusMap := make(map[uuid.UUID]string)
+	if prStatuses != nil {
+		for _, status := range *prStatuses {
+			statusMap[status.ID] = status.Status
+		}
+	}
+
+	openPRs := make([]pull_requests2.PullRequestOut, 0, len(prs))
+	for _, pr := range prs {
+		if statusMap[pr.StatusID] == usecase2.OpenStatusValue {
+			openPRs = append(openPRs, pr)
+		}
+	}
+	return openPRs, nil
+}
+
+// reviewerEvents reports the reviewer changes of a move: the moved user leaving old team reviews and the reviewers
+// swapped on the PRs the user authored.
+func reviewerEvents(userID uuid.UUID, reassigned []ReassignedReview, reselected []ReselectedPullRequest) []usecase2.Event {
+	var reviewerEvents []usecase2.Event
+	for _, pr := range reassigned {
+		reviewerEvents = append(reviewerEvents, usecase2.Event{
+			Type:            usecase2.EventReviewerUnassigned,
+			PullRequestID:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorID:        pr.AuthorID,
+			ReviewerID:      userID,
+		})
+		if pr.NewReviewerID != nil {
+			reviewerEvents = append(reviewerEvents, usecase2.Event{
+				Type:            usecase2.EventReviewerAssigned,
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				ReviewerID:      *pr.NewReviewerID,
+			})
+		}
+	}
+	for _, pr := range reselected {
+		for _, reviewerID := range pr.RemovedReviewers {
+			reviewerEvents = append(reviewerEvents, usecase2.Event{
+				Type:            usecase2.EventReviewerUnassigned,
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        userID,
+				ReviewerID:      reviewerID,
+			})
+		}
+		for _, reviewerID := range pr.AddedReviewers {
+			reviewerEvents = append(reviewerEvents, usecase2.Event{
+				Type:            usecase2.EventReviewerAssigned,
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        userID,
+				ReviewerID:      reviewerID,
+			})
+		}
+	}
+	return reviewerEvents
+}
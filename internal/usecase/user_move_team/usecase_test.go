@@ -0,0 +1,601 @@
+package user_move_team
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	pr_reviewers2 "pr-reviewers-service/internal/infrastructure/repository/pr_reviewers"
+	pr_statuses2 "pr-reviewers-service/internal/infrastructure/repository/pr_statuses"
+	pull_requests2 "pr-reviewers-service/internal/infrastructure/repository/pull_requests"
+	team_memberships2 "pr-reviewers-service/internal/infrastructure/repository/team_memberships"
+	teams2 "pr-reviewers-service/internal/infrastructure/repository/teams"
+	users2 "pr-reviewers-service/internal/infrastructure/repository/users"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	events "pr-reviewers-service/internal/usecase/contract/events/mocks"
+	randomizer2 "pr-reviewers-service/internal/usecase/contract/randomizer/mocks"
+	pr_reviewers "pr-reviewers-service/internal/usecase/contract/repository/pr_reviewers/mocks"
+	pr_statuses "pr-reviewers-service/internal/usecase/contract/repository/pr_statuses/mocks"
+	pull_requests "pr-reviewers-service/internal/usecase/contract/repository/pull_requests/mocks"
+	team_memberships "pr-reviewers-service/internal/usecase/contract/repository/team_memberships/mocks"
+	teams "pr-reviewers-service/internal/usecase/contract/repository/teams/mocks"
+	users "pr-reviewers-service/internal/usecase/contract/repository/users/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	cntReviewers = 2
+)
+
+func TestMoveUserTeam(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	oldTeamID := uuid.New()
+	newTeamID := uuid.New()
+	otherTeamID := uuid.New()
+	oldMateID := uuid.New()
+	oldAuthorID := uuid.New()
+	otherAuthorID := uuid.New()
+	newMateID1 := uuid.New()
+	newMateID2 := uuid.New()
+	oldTeamPRID := uuid.New()
+	otherTeamPRID := uuid.New()
+	authoredPRID := uuid.New()
+	openStatusID := uuid.New()
+
+	oldTeam := &teams2.TeamOut{ID: oldTeamID, Name: "backend"}
+	newTeam := &teams2.TeamOut{ID: newTeamID, Name: "frontend"}
+
+	user := &users2.UserOut{ID: userID, Name: "mover", IsActive: true, TeamID: oldTeamID}
+	movedUser := &users2.UserOut{ID: userID, Name: "mover", IsActive: true, TeamID: newTeamID}
+	movedUserIn := users2.UserIn{ID: userID, Name: "mover", IsActive: true, TeamID: newTeamID}
+	membership := &team_memberships2.TeamMembershipOut{ID: uuid.New(), UserID: userID, TeamID: newTeamID, IsPrimary: true}
+
+	oldMate := users2.UserOut{ID: oldMateID, Name: "old mate", IsActive: true, TeamID: oldTeamID}
+	oldAuthor := users2.UserOut{ID: oldAuthorID, Name: "old author", IsActive: true, TeamID: oldTeamID}
+	otherAuthor := users2.UserOut{ID: otherAuthorID, Name: "other author", IsActive: true, TeamID: otherTeamID}
+	newMate1 := users2.UserOut{ID: newMateID1, Name: "new mate 1", IsActive: true, TeamID: newTeamID}
+	newMate2 := users2.UserOut{ID: newMateID2, Name: "new mate 2", IsActive: true, TeamID: newTeamID}
+
+	oldTeamPR := pull_requests2.PullRequestOut{
+		ID:        oldTeamPRID,
+		Name:      "Old team PR",
+		AuthorID:  oldAuthorID,
+		StatusID:  openStatusID,
+		CreatedAt: time.Now(),
+	}
+	otherTeamPR := pull_requests2.PullRequestOut{
+		ID:        otherTeamPRID,
+		Name:      "Other team PR",
+		AuthorID:  otherAuthorID,
+		StatusID:  openStatusID,
+		CreatedAt: time.Now(),
+	}
+	authoredPR := pull_requests2.PullRequestOut{
+		ID:        authoredPRID,
+		Name:      "Authored PR",
+		AuthorID:  userID,
+		StatusID:  openStatusID,
+		CreatedAt: time.Now(),
+	}
+	userReviews := []pr_reviewers2.PrReviewerOut{
+		{ID: uuid.New(), PRID: oldTeamPRID, ReviewerID: userID},
+		{ID: uuid.New(), PRID: otherTeamPRID, ReviewerID: userID},
+	}
+	oldTeamPRReviewers := []pr_reviewers2.PrReviewerOut{
+		{ID: uuid.New(), PRID: oldTeamPRID, ReviewerID: userID},
+	}
+	authoredPRReviewers := []pr_reviewers2.PrReviewerOut{
+		{ID: uuid.New(), PRID: authoredPRID, ReviewerID: oldMateID},
+		{ID: uuid.New(), PRID: authoredPRID, ReviewerID: newMateID1},
+	}
+	openStatus := pr_statuses2.PRStatusOut{ID: openStatusID, Status: usecase2.OpenStatusValue}
+
+	trmDo := func(mockTrm *mock.MockManager) {
+		mockTrm.EXPECT().
+			Do(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+				return f(ctx)
+			})
+	}
+	moveUser := func(
+		mockUsers *users.MockRepositoryUsers,
+		mockTeams *teams.MockRepositoryTeams,
+		mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+	) {
+		mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+		mockTeams.EXPECT().GetTeamByName(gomock.Any(), newTeam.Name).Return(newTeam, nil)
+		mockTeams.EXPECT().GetTeamByID(gomock.Any(), oldTeamID).Return(oldTeam, nil)
+		mockUsers.EXPECT().UpdateUser(gomock.Any(), movedUserIn).Return(movedUser, nil)
+		mockMemberships.EXPECT().SetPrimaryTeamMembership(gomock.Any(), userID, newTeamID).Return(membership, nil)
+	}
+
+	tests := []struct {
+		name      string
+		req       In
+		setupMock func(
+			mockUsers *users.MockRepositoryUsers,
+			mockTeams *teams.MockRepositoryTeams,
+			mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+			mockPullRequests *pull_requests.MockRepositoryPullRequests,
+			mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+			mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+			mockTrm *mock.MockManager,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "successful move without reconciliation",
+			req:  In{UserID: userID, TeamName: newTeam.Name},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				moveUser(mockUsers, mockTeams, mockMemberships)
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				UserID:                 userID,
+				Username:               "mover",
+				OldTeamName:            oldTeam.Name,
+				NewTeamName:            newTeam.Name,
+				ReassignedReviews:      []ReassignedReview{},
+				ReselectedPullRequests: []ReselectedPullRequest{},
+			},
+		},
+		{
+			name: "successful move with reassignment of old team reviews",
+			req:  In{UserID: userID, TeamName: newTeam.Name, ReassignReviews: true},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				moveUser(mockUsers, mockTeams, mockMemberships)
+				mockPRReviewers.EXPECT().GetPRReviewersByReviewerID(gomock.Any(), userID).Return(&userReviews, nil)
+				mockPullRequests.EXPECT().GetPullRequestsByPrIDs(gomock.Any(), []uuid.UUID{oldTeamPRID, otherTeamPRID}).
+					Return(&[]pull_requests2.PullRequestOut{oldTeamPR, otherTeamPR}, nil)
+				mockPRStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]pr_statuses2.PRStatusOut{openStatus}, nil)
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{oldAuthorID, otherAuthorID}).
+					Return(&[]users2.UserOut{oldAuthor, otherAuthor}, nil)
+				mockUsers.EXPECT().GetActiveUsersByTeamID(gomock.Any(), oldTeamID).
+					Return(&[]users2.UserOut{oldAuthor, oldMate}, nil)
+				mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), oldTeamPRID).Return(&oldTeamPRReviewers, nil)
+				mockPRReviewers.EXPECT().SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{
+					PrID:       oldTeamPRID,
+					ReviewerID: oldMateID,
+				}).Return(&pr_reviewers2.PrReviewerOut{}, nil)
+				mockPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), oldTeamPRID, userID).Return(nil)
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				UserID:      userID,
+				Username:    "mover",
+				OldTeamName: oldTeam.Name,
+				NewTeamName: newTeam.Name,
+				ReassignedReviews: []ReassignedReview{
+					{
+						PullRequestID:   oldTeamPRID,
+						PullRequestName: "Old team PR",
+						AuthorID:        oldAuthorID,
+						NewReviewerID:   &oldMateID,
+						Outcome:         OutcomeReassigned,
+					},
+				},
+				ReselectedPullRequests: []ReselectedPullRequest{},
+			},
+		},
+		{
+			name: "old team review is unassigned when nobody can take it",
+			req:  In{UserID: userID, TeamName: newTeam.Name, ReassignReviews: true},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				moveUser(mockUsers, mockTeams, mockMemberships)
+				mockPRReviewers.EXPECT().GetPRReviewersByReviewerID(gomock.Any(), userID).
+					Return(&[]pr_reviewers2.PrReviewerOut{userReviews[0]}, nil)
+				mockPullRequests.EXPECT().GetPullRequestsByPrIDs(gomock.Any(), []uuid.UUID{oldTeamPRID}).
+					Return(&[]pull_requests2.PullRequestOut{oldTeamPR}, nil)
+				mockPRStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), gomock.Any()).
+					Return(&[]pr_statuses2.PRStatusOut{openStatus}, nil)
+				mockUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{oldAuthorID}).
+					Return(&[]users2.UserOut{oldAuthor}, nil)
+				mockUsers.EXPECT().GetActiveUsersByTeamID(gomock.Any(), oldTeamID).
+					Return(&[]users2.UserOut{oldAuthor}, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), oldTeamID).Return(oldTeam, nil)
+				mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), oldTeamPRID).Return(&oldTeamPRReviewers, nil)
+				mockPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), oldTeamPRID, userID).Return(nil)
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				UserID:      userID,
+				Username:    "mover",
+				OldTeamName: oldTeam.Name,
+				NewTeamName: newTeam.Name,
+				ReassignedReviews: []ReassignedReview{
+					{
+						PullRequestID:   oldTeamPRID,
+						PullRequestName: "Old team PR",
+						AuthorID:        oldAuthorID,
+						Outcome:         OutcomeUnassigned,
+					},
+				},
+				ReselectedPullRequests: []ReselectedPullRequest{},
+			},
+		},
+		{
+			name: "successful move with reviewers reselection from new team",
+			req:  In{UserID: userID, TeamName: newTeam.Name, ReselectReviewers: true},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				moveUser(mockUsers, mockTeams, mockMemberships)
+				mockPullRequests.EXPECT().GetPullRequestsByAuthorIDs(gomock.Any(), []uuid.UUID{userID}).
+					Return(&[]pull_requests2.PullRequestOut{authoredPR}, nil)
+				mockPRStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), []uuid.UUID{openStatusID}).
+					Return(&[]pr_statuses2.PRStatusOut{openStatus}, nil)
+				mockUsers.EXPECT().GetActiveUsersByTeamID(gomock.Any(), newTeamID).
+					Return(&[]users2.UserOut{*movedUser, newMate1, newMate2}, nil).
+					Times(2)
+				mockPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), authoredPRID).Return(&authoredPRReviewers, nil)
+				mockPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), authoredPRID, oldMateID).Return(nil)
+				mockPRReviewers.EXPECT().SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{
+					PrID:       authoredPRID,
+					ReviewerID: newMateID2,
+				}).Return(&pr_reviewers2.PrReviewerOut{}, nil)
+				trmDo(mockTrm)
+			},
+			expected: &Out{
+				UserID:            userID,
+				Username:          "mover",
+				OldTeamName:       oldTeam.Name,
+				NewTeamName:       newTeam.Name,
+				ReassignedReviews: []ReassignedReview{},
+				ReselectedPullRequests: []ReselectedPullRequest{
+					{
+						PullRequestID:    authoredPRID,
+						PullRequestName:  "Authored PR",
+						RemovedReviewers: []uuid.UUID{oldMateID},
+						AddedReviewers:   []uuid.UUID{newMateID2},
+						Understaffed:     false,
+					},
+				},
+			},
+		},
+		{
+			name: "user already in requested team",
+			req:  In{UserID: userID, TeamName: oldTeam.Name},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), oldTeam.Name).Return(oldTeam, nil)
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrUserDontNeedChange,
+		},
+		{
+			name: "user not found",
+			req:  In{UserID: userID, TeamName: newTeam.Name},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(nil, repository.ErrUserNotFound)
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrUserNotFound,
+		},
+		{
+			name: "team not found",
+			req:  In{UserID: userID, TeamName: "unknown"},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), "unknown").Return(nil, repository.ErrTeamNotFound)
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrTeamNotFound,
+		},
+		{
+			name: "error updating user",
+			req:  In{UserID: userID, TeamName: newTeam.Name},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), newTeam.Name).Return(newTeam, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), oldTeamID).Return(oldTeam, nil)
+				mockUsers.EXPECT().UpdateUser(gomock.Any(), movedUserIn).Return(nil, errors.New("db error"))
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrUpdateUser,
+		},
+		{
+			name: "error saving primary membership",
+			req:  In{UserID: userID, TeamName: newTeam.Name},
+			setupMock: func(
+				mockUsers *users.MockRepositoryUsers,
+				mockTeams *teams.MockRepositoryTeams,
+				mockMemberships *team_memberships.MockRepositoryTeamMemberships,
+				mockPullRequests *pull_requests.MockRepositoryPullRequests,
+				mockPRReviewers *pr_reviewers.MockRepositoryPrReviewers,
+				mockPRStatuses *pr_statuses.MockRepositoryPrStatuses,
+				mockTrm *mock.MockManager,
+			) {
+				mockUsers.EXPECT().GetUserByID(gomock.Any(), userID).Return(user, nil)
+				mockTeams.EXPECT().GetTeamByName(gomock.Any(), newTeam.Name).Return(newTeam, nil)
+				mockTeams.EXPECT().GetTeamByID(gomock.Any(), oldTeamID).Return(oldTeam, nil)
+				mockUsers.EXPECT().UpdateUser(gomock.Any(), movedUserIn).Return(movedUser, nil)
+				mockMemberships.EXPECT().SetPrimaryTeamMembership(gomock.Any(), userID, newTeamID).
+					Return(nil, errors.New("db error"))
+				trmDo(mockTrm)
+			},
+			expectedError: usecase2.ErrSaveTeamMemberships,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoMemberships := team_memberships.NewMockRepositoryTeamMemberships(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockRandomizer := randomizer2.NewMockRandomizer(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockPublisher.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockTrm := mock.NewMockManager(ctrl)
+
+			tt.setupMock(
+				mockRepoUsers,
+				mockRepoTeams,
+				mockRepoMemberships,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockTrm,
+			)
+
+			u := NewUsecase(
+				mockRepoUsers,
+				mockRepoTeams,
+				mockRepoMemberships,
+				mockRepoPullRequests,
+				mockRepoPRReviewers,
+				mockRepoPRStatuses,
+				mockRandomizer,
+				cntReviewers,
+				mockPublisher,
+				mockTrm,
+			)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestMoveUserTeamPublishesEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userID := uuid.New()
+	oldTeamID := uuid.New()
+	newTeamID := uuid.New()
+	authorID := uuid.New()
+	mateID := uuid.New()
+	prID := uuid.New()
+	openStatusID := uuid.New()
+	publishErr := errors.New("publish error")
+
+	oldTeam := &teams2.TeamOut{ID: oldTeamID, Name: "backend"}
+	newTeam := &teams2.TeamOut{ID: newTeamID, Name: "frontend"}
+	author := users2.UserOut{ID: authorID, Name: "author", IsActive: true, TeamID: oldTeamID}
+	mate := users2.UserOut{ID: mateID, Name: "mate", IsActive: true, TeamID: oldTeamID}
+
+	tests := []struct {
+		name          string
+		publishErr    error
+		expectedError error
+	}{
+		{name: "unassigned and assigned events are published"},
+		{name: "publish error rolls back the move", publishErr: publishErr, expectedError: publishErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepoUsers := users.NewMockRepositoryUsers(ctrl)
+			mockRepoTeams := teams.NewMockRepositoryTeams(ctrl)
+			mockRepoMemberships := team_memberships.NewMockRepositoryTeamMemberships(ctrl)
+			mockRepoPullRequests := pull_requests.NewMockRepositoryPullRequests(ctrl)
+			mockRepoPRReviewers := pr_reviewers.NewMockRepositoryPrReviewers(ctrl)
+			mockRepoPRStatuses := pr_statuses.NewMockRepositoryPrStatuses(ctrl)
+			mockPublisher := events.NewMockPublisher(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			mockRepoUsers.EXPECT().GetUserByID(gomock.Any(), userID).
+				Return(&users2.UserOut{ID: userID, Name: "mover", IsActive: true, TeamID: oldTeamID}, nil)
+			mockRepoTeams.EXPECT().GetTeamByName(gomock.Any(), newTeam.Name).Return(newTeam, nil)
+			mockRepoTeams.EXPECT().GetTeamByID(gomock.Any(), oldTeamID).Return(oldTeam, nil)
+			mockRepoUsers.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).
+				Return(&users2.UserOut{ID: userID, Name: "mover", IsActive: true, TeamID: newTeamID}, nil)
+			mockRepoMemberships.EXPECT().SetPrimaryTeamMembership(gomock.Any(), userID, newTeamID).
+				Return(&team_memberships2.TeamMembershipOut{UserID: userID, TeamID: newTeamID, IsPrimary: true}, nil)
+			mockRepoPRReviewers.EXPECT().GetPRReviewersByReviewerID(gomock.Any(), userID).
+				Return(&[]pr_reviewers2.PrReviewerOut{{PRID: prID, ReviewerID: userID}}, nil)
+			mockRepoPullRequests.EXPECT().GetPullRequestsByPrIDs(gomock.Any(), []uuid.UUID{prID}).
+				Return(&[]pull_requests2.PullRequestOut{{ID: prID, Name: "PR 1", AuthorID: authorID, StatusID: openStatusID}}, nil)
+			mockRepoPRStatuses.EXPECT().GetPRStatusesByIDs(gomock.Any(), []uuid.UUID{openStatusID}).
+				Return(&[]pr_statuses2.PRStatusOut{{ID: openStatusID, Status: usecase2.OpenStatusValue}}, nil)
+			mockRepoUsers.EXPECT().GetUsersByIDs(gomock.Any(), []uuid.UUID{authorID}).
+				Return(&[]users2.UserOut{author}, nil)
+			mockRepoPRReviewers.EXPECT().GetPRReviewersByPRID(gomock.Any(), prID).
+				Return(&[]pr_reviewers2.PrReviewerOut{{PRID: prID, ReviewerID: userID}}, nil)
+			mockRepoUsers.EXPECT().GetActiveUsersByTeamID(gomock.Any(), oldTeamID).
+				Return(&[]users2.UserOut{author, mate}, nil)
+			mockRepoPRReviewers.EXPECT().
+				SavePRReviewer(gomock.Any(), pr_reviewers2.PrReviewerIn{PrID: prID, ReviewerID: mateID}).
+				Return(&pr_reviewers2.PrReviewerOut{PRID: prID, ReviewerID: mateID}, nil)
+			mockRepoPRReviewers.EXPECT().DeletePRReviewerByPRAndReviewer(gomock.Any(), prID, userID).Return(nil)
+			mockPublisher.EXPECT().
+				Publish(gomock.Any(), []usecase2.Event{
+					{
+						Type:            usecase2.EventReviewerUnassigned,
+						PullRequestID:   prID,
+						PullRequestName: "PR 1",
+						AuthorID:        authorID,
+						ReviewerID:      userID,
+					},
+					{
+						Type:            usecase2.EventReviewerAssigned,
+						PullRequestID:   prID,
+						PullRequestName: "PR 1",
+						AuthorID:        authorID,
+						ReviewerID:      mateID,
+					},
+				}).
+				Return(tt.publishErr)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockRepoUsers, mockRepoTeams, mockRepoMemberships, mockRepoPullRequests,
+				mockRepoPRReviewers, mockRepoPRStatuses, randomizer2.NewMockRandomizer(ctrl), cntReviewers,
+				mockPublisher, mockTrm)
+
+			result, err := u.Run(context.Background(), In{UserID: userID, TeamName: newTeam.Name, ReassignReviews: true})
+
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, result.ReassignedReviews, 1)
+		})
+	}
+}
+
+func TestReviewerEvents(t *testing.T) {
+	userID := uuid.New()
+	authorID := uuid.New()
+	newReviewerID := uuid.New()
+	removedID := uuid.New()
+	addedID := uuid.New()
+	unassignedPRID := uuid.New()
+	reselectedPRID := uuid.New()
+
+	result := reviewerEvents(userID,
+		[]ReassignedReview{{
+			PullRequestID:   unassignedPRID,
+			PullRequestName: "unassigned",
+			AuthorID:        authorID,
+			Outcome:         OutcomeUnassigned,
+		}},
+		[]ReselectedPullRequest{{
+			PullRequestID:    reselectedPRID,
+			PullRequestName:  "reselected",
+			RemovedReviewers: []uuid.UUID{removedID},
+			AddedReviewers:   []uuid.UUID{addedID, newReviewerID},
+		}},
+	)
+
+	assert.Equal(t, []usecase2.Event{
+		{
+			Type:            usecase2.EventReviewerUnassigned,
+			PullRequestID:   unassignedPRID,
+			PullRequestName: "unassigned",
+			AuthorID:        authorID,
+			ReviewerID:      userID,
+		},
+		{
+			Type:            usecase2.EventReviewerUnassigned,
+			PullRequestID:   reselectedPRID,
+			PullRequestName: "reselected",
+			AuthorID:        userID,
+			ReviewerID:      removedID,
+		},
+		{
+			Type:            usecase2.EventReviewerAssigned,
+			PullRequestID:   reselectedPRID,
+			PullRequestName: "reselected",
+			AuthorID:        userID,
+			ReviewerID:      addedID,
+		},
+		{
+			Type:            usecase2.EventReviewerAssigned,
+			PullRequestID:   reselectedPRID,
+			PullRequestName: "reselected",
+			AuthorID:        userID,
+			ReviewerID:      newReviewerID,
+		},
+	}, result)
+}
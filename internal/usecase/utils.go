@@ -5,17 +5,41 @@ import "errors"
 const (
 	MergedStatusValue = "MERGED"
 	OpenStatusValue   = "OPEN"
+	ClosedStatusValue = "CLOSED"
+)
+
+const (
+	WebhookDeliveryPendingStatus   = "PENDING"
+	WebhookDeliveryDeliveredStatus = "DELIVERED"
+	WebhookDeliveryFailedStatus    = "FAILED"
+)
+
+const (
+	OutboxPendingStatus   = "PENDING"
+	OutboxPublishedStatus = "PUBLISHED"
+	OutboxFailedStatus    = "FAILED"
+)
+
+const (
+	NotificationKindEmailDigest   = "email_digest"
+	NotificationKindStaleReminder = "stale_reminder"
 )
 
 var (
 	ErrGetTeam                     = errors.New("failed to get team")
 	ErrSaveTeam                    = errors.New("failed to save team")
+	ErrUpdateTeam                  = errors.New("failed to update team")
+	ErrDeleteTeam                  = errors.New("failed to delete team")
 	ErrGetUsers                    = errors.New("failed to get users")
 	ErrGetUser                     = errors.New("failed to get user")
 	ErrGetPRStatus                 = errors.New("failed to get pr status")
 	ErrGetPRReviewers              = errors.New("failed to get assigned reviewers")
 	ErrPRsReviewersNotFound        = errors.New("prs reviewers found")
 	ErrSaveUsersBatch              = errors.New("failed to save users batch")
+	ErrSaveTeamMemberships         = errors.New("failed to save team memberships")
+	ErrGetTeamMemberships          = errors.New("failed to get team memberships")
+	ErrGetWorkload                 = errors.New("failed to get user workload")
+	ErrGetTeamsLoad                = errors.New("failed to get teams load")
 	ErrSetPRStatus                 = errors.New("failed to save pr status")
 	ErrUpdatePrMergeTime           = errors.New("failed to update pr merge time")
 	ErrUpdatePrStatus              = errors.New("failed to update pr status")
@@ -29,16 +53,60 @@ var (
 	ErrNoUsersWereUpdatedAddedTeam = errors.New("no one was added to team")
 	ErrAuthorPrNotFound            = errors.New("not found such user try to create pr from")
 	ErrUserDontNeedChange          = errors.New("no need to change user")
+	ErrTeamDontNeedChange          = errors.New("no need to change team")
 	ErrNoAvailableReviewers        = errors.New("no available users")
 	ErrNoActiveReviewers           = errors.New("no active reviewers at this pr")
 	ErrPullRequestExists           = errors.New("such pr already exist")
 	ErrPullRequestAlreadyMerged    = errors.New("such pr already merged")
+	ErrNotPullRequestAuthor        = errors.New("only the author can merge the pull request")
+	ErrPullRequestClosed           = errors.New("such pr is closed")
 	ErrDuplicateUsers              = errors.New("duplicate users ids got")
 	ErrReviewerNotFound            = errors.New("not found such reviewer for this pr")
 	ErrTeamNotFound                = errors.New("team not found")
+	ErrTeamAlreadyExists           = errors.New("team with such name already exists")
+	ErrTeamHasOpenPullRequests     = errors.New("team members have open pull requests")
+	ErrTeamHasPullRequestHistory   = errors.New("team members have pull request history")
+	ErrParentTeamNotFound          = errors.New("parent team not found")
+	ErrTeamHierarchyCycle          = errors.New("team cannot be nested under itself or its subteam")
 	ErrUserNotFound                = errors.New("user not found")
 	ErrUsersByIDsNotFound          = errors.New("not found user by ids in request")
 	ErrUserNotBelongsToTeam        = errors.New("user not belongs to team")
-	ErrNoPRsToAffect               = errors.New("no prs to change")
-	ErrNoUsersAssignedToPRs        = errors.New("no users that assign to prs")
+	ErrHandoverToSameUser          = errors.New("cannot hand over reviews to the same user")
+	ErrHandoverTargetInactive      = errors.New("handover target user is inactive")
+	ErrInvalidIdentity             = errors.New("invalid user identity")
+	ErrIdentityNotFound            = errors.New("user identity not found")
+	ErrIdentityAlreadyLinked       = errors.New("user identity is already linked to another user")
+	ErrGetIdentities               = errors.New("failed to get user identities")
+	ErrSaveIdentities              = errors.New("failed to save user identities")
+	ErrDeleteIdentity              = errors.New("failed to delete user identity")
+	ErrSaveWebhookDelivery         = errors.New("failed to save webhook delivery")
+	ErrUnknownWebhookEvent         = errors.New("unknown webhook event")
+	ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrWebhookDeliveryNotFound     = errors.New("webhook delivery not found")
+	ErrWebhookDeliveryNotFailed    = errors.New("only failed webhook deliveries can be replayed")
+	ErrGetWebhookSubscriptions     = errors.New("failed to get webhook subscriptions")
+	ErrSaveWebhookSubscription     = errors.New("failed to save webhook subscription")
+	ErrDeleteWebhookSubscription   = errors.New("failed to delete webhook subscription")
+	ErrGetWebhookDeliveries        = errors.New("failed to get webhook deliveries")
+	ErrSaveWebhookDeliveries       = errors.New("failed to save webhook deliveries")
+	ErrUpdateWebhookDelivery       = errors.New("failed to update webhook delivery")
+	ErrSaveOutboxEvents            = errors.New("failed to save outbox events")
+	ErrGetOutboxEvents             = errors.New("failed to get outbox events")
+	ErrUpdateOutboxEvent           = errors.New("failed to update outbox event")
+	ErrClaimUserNotification       = errors.New("failed to claim user notification")
+	ErrNotifyReviewStreams         = errors.New("failed to notify review streams")
+	ErrSnoozeInPast                = errors.New("snooze time must be in the future")
+	ErrUnavailableInPast           = errors.New("unavailability end must be in the future")
+	ErrSaveReviewSnooze            = errors.New("failed to save review snooze")
+	ErrGetReviewSnoozes            = errors.New("failed to get review snoozes")
+	ErrUserNameAlreadyExists       = errors.New("user with such name already exists")
+	ErrDeleteUser                  = errors.New("failed to delete user")
+	ErrDeleteTeamMembership        = errors.New("failed to delete team membership")
+	ErrDefaultTeamChange           = errors.New("default team cannot be renamed or deleted")
+	ErrInvalidTeamsState           = errors.New("invalid desired teams state")
+	ErrInvalidCodeOwners           = errors.New("invalid codeowners file")
+	ErrCodeOwnerNotFound           = errors.New("code owner not found")
+	ErrCodeOwnersNotFound          = errors.New("code owners not found")
+	ErrGetCodeOwners               = errors.New("failed to get code owners")
+	ErrSaveCodeOwners              = errors.New("failed to save code owners")
 )
@@ -0,0 +1,15 @@
+package webhook_delivery_replay
+
+import (
+	usecase2 "pr-reviewers-service/internal/usecase"
+
+	"github.com/google/uuid"
+)
+
+type In struct {
+	DeliveryID uuid.UUID
+}
+
+type Out struct {
+	Delivery usecase2.WebhookDelivery
+}
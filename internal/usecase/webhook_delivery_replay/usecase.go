@@ -0,0 +1,83 @@
+package webhook_delivery_replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	webhook_event_deliveries2 "pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/webhook_event_deliveries"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+type usecase struct {
+	repDeliveries webhook_event_deliveries.RepositoryWebhookEventDeliveries
+	nower         nower.Nower
+	trm           trm.Manager
+}
+
+func NewUsecase(
+	repDeliveries webhook_event_deliveries.RepositoryWebhookEventDeliveries,
+	nower nower.Nower,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repDeliveries: repDeliveries,
+		nower:         nower,
+		trm:           trm,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx, req)
+		return err
+	})
+
+	return result, err
+}
+
+// run puts a failed delivery back into the queue with a fresh attempts budget, the dispatcher
+// picks it up on its next run.
+func (u *usecase) run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Call GetWebhookEventDeliveryByID", "delivery_id", req.DeliveryID)
+	delivery, err := u.repDeliveries.GetWebhookEventDeliveryByID(ctx, req.DeliveryID)
+	if err != nil {
+		if errors.Is(err, repository.ErrEventDeliveryNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrWebhookDeliveryNotFound, req.DeliveryID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrGetWebhookDeliveries, req.DeliveryID))
+	}
+
+	if delivery.Status != usecase2.WebhookDeliveryFailedStatus {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: delivery %s is %s",
+			usecase2.ErrWebhookDeliveryNotFailed, req.DeliveryID, delivery.Status))
+	}
+
+	slog.DebugContext(ctx, "Call UpdateWebhookEventDeliveryAttempt", "delivery_id", req.DeliveryID)
+	updated, err := u.repDeliveries.UpdateWebhookEventDeliveryAttempt(ctx, webhook_event_deliveries2.WebhookEventDeliveryAttemptIn{
+		ID:            delivery.ID,
+		Status:        usecase2.WebhookDeliveryPendingStatus,
+		Attempts:      0,
+		NextAttemptAt: u.nower.Now(),
+		LastError:     delivery.LastError,
+		ResponseCode:  delivery.ResponseCode,
+	})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrUpdateWebhookDelivery, req.DeliveryID))
+	}
+
+	slog.DebugContext(ctx, "UseCase WebhookDeliveryReplay success", "delivery_id", req.DeliveryID)
+	return &Out{
+		Delivery: usecase2.NewWebhookDelivery(*updated),
+	}, nil
+}
@@ -0,0 +1,179 @@
+package webhook_delivery_replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	webhook_event_deliveries2 "pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	webhook_event_deliveries "pr-reviewers-service/internal/usecase/contract/repository/webhook_event_deliveries/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDeliveryReplay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deliveryID := uuid.New()
+	subscriptionID := uuid.New()
+	eventID := uuid.New()
+	createdAt := time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+	now := createdAt.Add(time.Hour)
+	lastError := "unexpected webhook response status: 500"
+	responseCode := 500
+
+	failedDelivery := webhook_event_deliveries2.WebhookEventDeliveryOut{
+		ID:             deliveryID,
+		SubscriptionID: subscriptionID,
+		EventID:        eventID,
+		Event:          usecase2.EventPullRequestMerged,
+		Status:         usecase2.WebhookDeliveryFailedStatus,
+		Attempts:       5,
+		NextAttemptAt:  createdAt,
+		LastError:      &lastError,
+		ResponseCode:   &responseCode,
+		CreatedAt:      createdAt,
+		UpdatedAt:      createdAt,
+	}
+	deliveredDelivery := failedDelivery
+	deliveredDelivery.Status = usecase2.WebhookDeliveryDeliveredStatus
+
+	tests := []struct {
+		name      string
+		setupMock func(
+			mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+			mockNower *nower.MockNower,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "failed delivery is queued again",
+			setupMock: func(
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockNower *nower.MockNower,
+			) {
+				mockDeliveries.EXPECT().GetWebhookEventDeliveryByID(gomock.Any(), deliveryID).Return(&failedDelivery, nil)
+				mockNower.EXPECT().Now().Return(now)
+				mockDeliveries.EXPECT().
+					UpdateWebhookEventDeliveryAttempt(gomock.Any(), webhook_event_deliveries2.WebhookEventDeliveryAttemptIn{
+						ID:            deliveryID,
+						Status:        usecase2.WebhookDeliveryPendingStatus,
+						Attempts:      0,
+						NextAttemptAt: now,
+						LastError:     &lastError,
+						ResponseCode:  &responseCode,
+					}).
+					Return(&webhook_event_deliveries2.WebhookEventDeliveryOut{
+						ID:             deliveryID,
+						SubscriptionID: subscriptionID,
+						EventID:        eventID,
+						Event:          usecase2.EventPullRequestMerged,
+						Status:         usecase2.WebhookDeliveryPendingStatus,
+						NextAttemptAt:  now,
+						LastError:      &lastError,
+						ResponseCode:   &responseCode,
+						CreatedAt:      createdAt,
+						UpdatedAt:      now,
+					}, nil)
+			},
+			expected: &Out{
+				Delivery: usecase2.WebhookDelivery{
+					ID:             deliveryID,
+					SubscriptionID: subscriptionID,
+					EventID:        eventID,
+					Event:          usecase2.EventPullRequestMerged,
+					Status:         usecase2.WebhookDeliveryPendingStatus,
+					NextAttemptAt:  now,
+					LastError:      &lastError,
+					ResponseCode:   &responseCode,
+					CreatedAt:      createdAt,
+					UpdatedAt:      now,
+				},
+			},
+		},
+		{
+			name: "delivery not found",
+			setupMock: func(
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockNower *nower.MockNower,
+			) {
+				mockDeliveries.EXPECT().GetWebhookEventDeliveryByID(gomock.Any(), deliveryID).
+					Return(nil, fmt.Errorf("%w: %s", repository.ErrEventDeliveryNotFound, deliveryID))
+			},
+			expectedError: usecase2.ErrWebhookDeliveryNotFound,
+		},
+		{
+			name: "delivered delivery is not replayed",
+			setupMock: func(
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockNower *nower.MockNower,
+			) {
+				mockDeliveries.EXPECT().GetWebhookEventDeliveryByID(gomock.Any(), deliveryID).Return(&deliveredDelivery, nil)
+			},
+			expectedError: usecase2.ErrWebhookDeliveryNotFailed,
+		},
+		{
+			name: "get delivery error",
+			setupMock: func(
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockNower *nower.MockNower,
+			) {
+				mockDeliveries.EXPECT().GetWebhookEventDeliveryByID(gomock.Any(), deliveryID).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetWebhookDeliveries,
+		},
+		{
+			name: "update delivery error",
+			setupMock: func(
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockNower *nower.MockNower,
+			) {
+				mockDeliveries.EXPECT().GetWebhookEventDeliveryByID(gomock.Any(), deliveryID).Return(&failedDelivery, nil)
+				mockNower.EXPECT().Now().Return(now)
+				mockDeliveries.EXPECT().UpdateWebhookEventDeliveryAttempt(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrUpdateWebhookDelivery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDeliveries := webhook_event_deliveries.NewMockRepositoryWebhookEventDeliveries(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			tt.setupMock(mockDeliveries, mockNower)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockDeliveries, mockNower, mockTrm)
+
+			result, err := u.Run(context.Background(), In{DeliveryID: deliveryID})
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package webhook_dispatch
+
+type Out struct {
+	Delivered int
+	Retried   int
+	Failed    int
+}
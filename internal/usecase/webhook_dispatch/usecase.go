@@ -0,0 +1,129 @@
+package webhook_dispatch
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	webhook_event_deliveries2 "pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+	webhook_sender2 "pr-reviewers-service/internal/infrastructure/webhook_sender"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/webhook_event_deliveries"
+	"pr-reviewers-service/internal/usecase/contract/webhook_sender"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+type usecase struct {
+	repDeliveries webhook_event_deliveries.RepositoryWebhookEventDeliveries
+	sender        webhook_sender.Sender
+	nower         nower.Nower
+	batchSize     uint64
+	maxAttempts   int
+	baseBackoff   time.Duration
+	maxBackoff    time.Duration
+	trm           trm.Manager
+}
+
+func NewUsecase(
+	repDeliveries webhook_event_deliveries.RepositoryWebhookEventDeliveries,
+	sender webhook_sender.Sender,
+	nower nower.Nower,
+	batchSize uint64,
+	maxAttempts int,
+	baseBackoff time.Duration,
+	maxBackoff time.Duration,
+	trm trm.Manager,
+) *usecase {
+	return &usecase{
+		repDeliveries: repDeliveries,
+		sender:        sender,
+		nower:         nower,
+		batchSize:     batchSize,
+		maxAttempts:   maxAttempts,
+		baseBackoff:   baseBackoff,
+		maxBackoff:    maxBackoff,
+		trm:           trm,
+	}
+}
+
+// Run sends one batch of due deliveries. Claimed rows stay locked until the batch is recorded, so
+// concurrent dispatchers never send the same delivery twice. Delivery is at-least-once: if recording an
+// attempt fails, the whole batch rolls back and deliveries already sent in it are sent again later.
+func (u *usecase) Run(ctx context.Context) (*Out, error) {
+	var result *Out
+	var err error
+
+	err = u.trm.Do(ctx, func(ctx context.Context) error {
+		result, err = u.run(ctx)
+		return err
+	})
+
+	return result, err
+}
+
+func (u *usecase) run(ctx context.Context) (*Out, error) {
+	deliveries, err := u.repDeliveries.ClaimDueWebhookEventDeliveries(ctx, usecase2.WebhookDeliveryPendingStatus, u.batchSize)
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetWebhookDeliveries, err))
+	}
+
+	out := &Out{}
+	for _, delivery := range *deliveries {
+		code, sendErr := u.sender.Send(ctx, webhook_sender2.Request{
+			URL:        delivery.URL,
+			Secret:     delivery.Secret,
+			DeliveryID: delivery.ID,
+			Event:      delivery.Event,
+			Payload:    delivery.Payload,
+		})
+
+		attempt := webhook_event_deliveries2.WebhookEventDeliveryAttemptIn{
+			ID:            delivery.ID,
+			Status:        usecase2.WebhookDeliveryDeliveredStatus,
+			Attempts:      delivery.Attempts + 1,
+			NextAttemptAt: delivery.NextAttemptAt,
+		}
+		if code != 0 {
+			attempt.ResponseCode = &code
+		}
+		switch {
+		case sendErr == nil:
+			out.Delivered++
+		case attempt.Attempts >= u.maxAttempts:
+			slog.WarnContext(ctx, "Webhook delivery failed", "delivery_id", delivery.ID, "attempts", attempt.Attempts, "error", sendErr)
+			lastError := sendErr.Error()
+			attempt.Status = usecase2.WebhookDeliveryFailedStatus
+			attempt.LastError = &lastError
+			out.Failed++
+		default:
+			slog.InfoContext(ctx, "Webhook delivery will be retried", "delivery_id", delivery.ID, "attempts", attempt.Attempts, "error", sendErr)
+			lastError := sendErr.Error()
+			attempt.Status = usecase2.WebhookDeliveryPendingStatus
+			attempt.NextAttemptAt = u.nower.Now().Add(u.backoff(attempt.Attempts))
+			attempt.LastError = &lastError
+			out.Retried++
+		}
+
+		if _, err = u.repDeliveries.UpdateWebhookEventDeliveryAttempt(ctx, attempt); err != nil {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: delivery_id %s: %v", usecase2.ErrUpdateWebhookDelivery, delivery.ID, err))
+		}
+	}
+
+	if len(*deliveries) > 0 {
+		slog.DebugContext(ctx, "UseCase WebhookDispatch success", "delivered", out.Delivered, "retried", out.Retried, "failed", out.Failed)
+	}
+	return out, nil
+}
+
+// backoff doubles the delay after every failed attempt, starting from baseBackoff and capped by maxBackoff.
+func (u *usecase) backoff(attempts int) time.Duration {
+	delay := u.baseBackoff
+	for i := 1; i < attempts && delay < u.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, u.maxBackoff)
+}
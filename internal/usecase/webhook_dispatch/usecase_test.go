@@ -0,0 +1,237 @@
+package webhook_dispatch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	webhook_event_deliveries2 "pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+	webhook_sender2 "pr-reviewers-service/internal/infrastructure/webhook_sender"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	webhook_event_deliveries "pr-reviewers-service/internal/usecase/contract/repository/webhook_event_deliveries/mocks"
+	webhook_sender "pr-reviewers-service/internal/usecase/contract/webhook_sender/mocks"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2/drivers/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	batchSize   = 10
+	maxAttempts = 3
+	baseBackoff = 30 * time.Second
+	maxBackoff  = time.Minute
+)
+
+func TestWebhookDispatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+	receiverErr := fmt.Errorf("%w: %d", webhook_sender2.ErrUnexpectedStatus, http.StatusInternalServerError)
+	lastError := receiverErr.Error()
+	okCode := http.StatusOK
+	failCode := http.StatusInternalServerError
+
+	newDue := func(attempts int) webhook_event_deliveries2.DueWebhookEventDeliveryOut {
+		return webhook_event_deliveries2.DueWebhookEventDeliveryOut{
+			WebhookEventDeliveryOut: webhook_event_deliveries2.WebhookEventDeliveryOut{
+				ID:            uuid.New(),
+				Event:         usecase2.EventReviewerAssigned,
+				Payload:       []byte(`{"event":"reviewer.assigned"}`),
+				Status:        usecase2.WebhookDeliveryPendingStatus,
+				Attempts:      attempts,
+				NextAttemptAt: now.Add(-time.Second),
+			},
+			URL:    "https://hooks.example.com",
+			Secret: "subscriber-secret",
+		}
+	}
+	request := func(delivery webhook_event_deliveries2.DueWebhookEventDeliveryOut) webhook_sender2.Request {
+		return webhook_sender2.Request{
+			URL:        delivery.URL,
+			Secret:     delivery.Secret,
+			DeliveryID: delivery.ID,
+			Event:      delivery.Event,
+			Payload:    delivery.Payload,
+		}
+	}
+	first := newDue(0)
+	second := newDue(1)
+	last := newDue(maxAttempts - 1)
+
+	tests := []struct {
+		name      string
+		setupMock func(
+			mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+			mockSender *webhook_sender.MockSender,
+			mockNower *nower.MockNower,
+		)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "deliveries are sent, retried with backoff and failed after last attempt",
+			setupMock: func(
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockSender *webhook_sender.MockSender,
+				mockNower *nower.MockNower,
+			) {
+				mockDeliveries.EXPECT().
+					ClaimDueWebhookEventDeliveries(gomock.Any(), usecase2.WebhookDeliveryPendingStatus, uint64(batchSize)).
+					Return(&[]webhook_event_deliveries2.DueWebhookEventDeliveryOut{first, second, last}, nil)
+
+				mockSender.EXPECT().Send(gomock.Any(), request(first)).Return(okCode, nil)
+				mockDeliveries.EXPECT().
+					UpdateWebhookEventDeliveryAttempt(gomock.Any(), webhook_event_deliveries2.WebhookEventDeliveryAttemptIn{
+						ID:            first.ID,
+						Status:        usecase2.WebhookDeliveryDeliveredStatus,
+						Attempts:      1,
+						NextAttemptAt: first.NextAttemptAt,
+						ResponseCode:  &okCode,
+					}).
+					Return(&webhook_event_deliveries2.WebhookEventDeliveryOut{}, nil)
+
+				mockSender.EXPECT().Send(gomock.Any(), request(second)).Return(failCode, receiverErr)
+				mockNower.EXPECT().Now().Return(now)
+				mockDeliveries.EXPECT().
+					UpdateWebhookEventDeliveryAttempt(gomock.Any(), webhook_event_deliveries2.WebhookEventDeliveryAttemptIn{
+						ID:            second.ID,
+						Status:        usecase2.WebhookDeliveryPendingStatus,
+						Attempts:      2,
+						NextAttemptAt: now.Add(2 * baseBackoff),
+						LastError:     &lastError,
+						ResponseCode:  &failCode,
+					}).
+					Return(&webhook_event_deliveries2.WebhookEventDeliveryOut{}, nil)
+
+				mockSender.EXPECT().Send(gomock.Any(), request(last)).Return(failCode, receiverErr)
+				mockDeliveries.EXPECT().
+					UpdateWebhookEventDeliveryAttempt(gomock.Any(), webhook_event_deliveries2.WebhookEventDeliveryAttemptIn{
+						ID:            last.ID,
+						Status:        usecase2.WebhookDeliveryFailedStatus,
+						Attempts:      maxAttempts,
+						NextAttemptAt: last.NextAttemptAt,
+						LastError:     &lastError,
+						ResponseCode:  &failCode,
+					}).
+					Return(&webhook_event_deliveries2.WebhookEventDeliveryOut{}, nil)
+			},
+			expected: &Out{Delivered: 1, Retried: 1, Failed: 1},
+		},
+		{
+			name: "unreachable receiver is retried without response code",
+			setupMock: func(
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockSender *webhook_sender.MockSender,
+				mockNower *nower.MockNower,
+			) {
+				mockDeliveries.EXPECT().
+					ClaimDueWebhookEventDeliveries(gomock.Any(), usecase2.WebhookDeliveryPendingStatus, uint64(batchSize)).
+					Return(&[]webhook_event_deliveries2.DueWebhookEventDeliveryOut{first}, nil)
+				mockSender.EXPECT().Send(gomock.Any(), request(first)).Return(0, errors.New("connection refused"))
+				mockNower.EXPECT().Now().Return(now)
+				connErr := "connection refused"
+				mockDeliveries.EXPECT().
+					UpdateWebhookEventDeliveryAttempt(gomock.Any(), webhook_event_deliveries2.WebhookEventDeliveryAttemptIn{
+						ID:            first.ID,
+						Status:        usecase2.WebhookDeliveryPendingStatus,
+						Attempts:      1,
+						NextAttemptAt: now.Add(baseBackoff),
+						LastError:     &connErr,
+					}).
+					Return(&webhook_event_deliveries2.WebhookEventDeliveryOut{}, nil)
+			},
+			expected: &Out{Retried: 1},
+		},
+		{
+			name: "no due deliveries",
+			setupMock: func(
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockSender *webhook_sender.MockSender,
+				mockNower *nower.MockNower,
+			) {
+				mockDeliveries.EXPECT().
+					ClaimDueWebhookEventDeliveries(gomock.Any(), usecase2.WebhookDeliveryPendingStatus, uint64(batchSize)).
+					Return(&[]webhook_event_deliveries2.DueWebhookEventDeliveryOut{}, nil)
+			},
+			expected: &Out{},
+		},
+		{
+			name: "claim deliveries error",
+			setupMock: func(
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockSender *webhook_sender.MockSender,
+				mockNower *nower.MockNower,
+			) {
+				mockDeliveries.EXPECT().
+					ClaimDueWebhookEventDeliveries(gomock.Any(), usecase2.WebhookDeliveryPendingStatus, uint64(batchSize)).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetWebhookDeliveries,
+		},
+		{
+			name: "update delivery error",
+			setupMock: func(
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockSender *webhook_sender.MockSender,
+				mockNower *nower.MockNower,
+			) {
+				mockDeliveries.EXPECT().
+					ClaimDueWebhookEventDeliveries(gomock.Any(), usecase2.WebhookDeliveryPendingStatus, uint64(batchSize)).
+					Return(&[]webhook_event_deliveries2.DueWebhookEventDeliveryOut{first}, nil)
+				mockSender.EXPECT().Send(gomock.Any(), request(first)).Return(okCode, nil)
+				mockDeliveries.EXPECT().
+					UpdateWebhookEventDeliveryAttempt(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrUpdateWebhookDelivery,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDeliveries := webhook_event_deliveries.NewMockRepositoryWebhookEventDeliveries(ctrl)
+			mockSender := webhook_sender.NewMockSender(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+			mockTrm := mock.NewMockManager(ctrl)
+
+			tt.setupMock(mockDeliveries, mockSender, mockNower)
+			mockTrm.EXPECT().
+				Do(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(ctx context.Context) error) error {
+					return f(ctx)
+				})
+
+			u := NewUsecase(mockDeliveries, mockSender, mockNower, batchSize, maxAttempts, baseBackoff, maxBackoff, mockTrm)
+
+			result, err := u.Run(context.Background())
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	u := &usecase{baseBackoff: 30 * time.Second, maxBackoff: 5 * time.Minute}
+
+	assert.Equal(t, 30*time.Second, u.backoff(1))
+	assert.Equal(t, time.Minute, u.backoff(2))
+	assert.Equal(t, 2*time.Minute, u.backoff(3))
+	assert.Equal(t, 4*time.Minute, u.backoff(4))
+	assert.Equal(t, 5*time.Minute, u.backoff(5))
+	assert.Equal(t, 5*time.Minute, u.backoff(50))
+}
@@ -0,0 +1,22 @@
+package webhook_publish
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Payload is the JSON body sent to subscribers.
+type Payload struct {
+	EventID     uuid.UUID          `json:"event_id"`
+	Event       string             `json:"event"`
+	OccurredAt  time.Time          `json:"occurred_at"`
+	PullRequest PayloadPullRequest `json:"pull_request"`
+	ReviewerID  *uuid.UUID         `json:"reviewer_id,omitempty"`
+}
+
+type PayloadPullRequest struct {
+	PullRequestID   uuid.UUID `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        uuid.UUID `json:"author_id"`
+}
@@ -0,0 +1,115 @@
+package webhook_publish
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	webhook_event_deliveries2 "pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/nower"
+	"pr-reviewers-service/internal/usecase/contract/repository/webhook_event_deliveries"
+	"pr-reviewers-service/internal/usecase/contract/repository/webhook_subscriptions"
+
+	"github.com/google/uuid"
+)
+
+type usecase struct {
+	repSubscriptions webhook_subscriptions.RepositoryWebhookSubscriptions
+	repDeliveries    webhook_event_deliveries.RepositoryWebhookEventDeliveries
+	nower            nower.Nower
+}
+
+func NewUsecase(
+	repSubscriptions webhook_subscriptions.RepositoryWebhookSubscriptions,
+	repDeliveries webhook_event_deliveries.RepositoryWebhookEventDeliveries,
+	nower nower.Nower,
+) *usecase {
+	return &usecase{
+		repSubscriptions: repSubscriptions,
+		repDeliveries:    repDeliveries,
+		nower:            nower,
+	}
+}
+
+// Publish queues a pending delivery of every event for each subscription that filters on it. It is called by
+// the outbox relay in its transaction, so deliveries are queued together with the event being marked published.
+func (u *usecase) Publish(ctx context.Context, events []usecase2.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	slog.DebugContext(ctx, "Get webhook subscriptions")
+	subscriptions, err := u.repSubscriptions.GetWebhookSubscriptions(ctx)
+	if err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrGetWebhookSubscriptions, err))
+	}
+	if len(*subscriptions) == 0 {
+		return nil
+	}
+
+	now := u.nower.Now()
+	deliveries := make([]webhook_event_deliveries2.WebhookEventDeliveryIn, 0)
+	for _, event := range events {
+		if event.ID == uuid.Nil {
+			event.ID = uuid.New()
+		}
+		if event.OccurredAt.IsZero() {
+			event.OccurredAt = now
+		}
+
+		var payload []byte
+		for _, subscription := range *subscriptions {
+			if !slices.Contains(subscription.Events, event.Type) {
+				continue
+			}
+			if payload == nil {
+				payload, err = json.Marshal(newPayload(event))
+				if err != nil {
+					return logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrSaveWebhookDeliveries, err))
+				}
+			}
+
+			deliveries = append(deliveries, webhook_event_deliveries2.WebhookEventDeliveryIn{
+				SubscriptionID: subscription.ID,
+				EventID:        event.ID,
+				Event:          event.Type,
+				Payload:        payload,
+				Status:         usecase2.WebhookDeliveryPendingStatus,
+				NextAttemptAt:  now,
+			})
+		}
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	slog.DebugContext(ctx, "Save webhook event deliveries", "count", len(deliveries))
+	if _, err = u.repDeliveries.SaveWebhookEventDeliveriesBatch(ctx, deliveries); err != nil {
+		return logging.WrapError(ctx, fmt.Errorf("%w: %v", usecase2.ErrSaveWebhookDeliveries, err))
+	}
+
+	return nil
+}
+
+func newPayload(event usecase2.Event) Payload {
+	payload := Payload{
+		EventID:    event.ID,
+		Event:      event.Type,
+		OccurredAt: event.OccurredAt,
+		PullRequest: PayloadPullRequest{
+			PullRequestID:   event.PullRequestID,
+			PullRequestName: event.PullRequestName,
+			AuthorID:        event.AuthorID,
+		},
+	}
+	if event.ReviewerID != uuid.Nil {
+		reviewerID := event.ReviewerID
+		payload.ReviewerID = &reviewerID
+	}
+	return payload
+}
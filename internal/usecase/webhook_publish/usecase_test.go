@@ -0,0 +1,208 @@
+package webhook_publish
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	webhook_event_deliveries2 "pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+	webhook_subscriptions2 "pr-reviewers-service/internal/infrastructure/repository/webhook_subscriptions"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	nower "pr-reviewers-service/internal/usecase/contract/nower/mocks"
+	webhook_event_deliveries "pr-reviewers-service/internal/usecase/contract/repository/webhook_event_deliveries/mocks"
+	webhook_subscriptions "pr-reviewers-service/internal/usecase/contract/repository/webhook_subscriptions/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookPublish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+	prID := uuid.New()
+	authorID := uuid.New()
+	reviewerID := uuid.New()
+	eventID := uuid.New()
+
+	assignedSubscription := webhook_subscriptions2.WebhookSubscriptionOut{
+		ID:     uuid.New(),
+		URL:    "https://assigned.example.com",
+		Events: []string{usecase2.EventReviewerAssigned},
+	}
+	allSubscription := webhook_subscriptions2.WebhookSubscriptionOut{
+		ID:     uuid.New(),
+		URL:    "https://all.example.com",
+		Events: usecase2.EventTypes,
+	}
+
+	assigned := usecase2.Event{
+		ID:              eventID,
+		Type:            usecase2.EventReviewerAssigned,
+		PullRequestID:   prID,
+		PullRequestName: "Add feature",
+		AuthorID:        authorID,
+		ReviewerID:      reviewerID,
+	}
+	merged := usecase2.Event{
+		ID:              uuid.New(),
+		Type:            usecase2.EventPullRequestMerged,
+		PullRequestID:   prID,
+		PullRequestName: "Add feature",
+		AuthorID:        authorID,
+		OccurredAt:      now.Add(-time.Minute),
+	}
+
+	tests := []struct {
+		name      string
+		events    []usecase2.Event
+		setupMock func(
+			mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions,
+			mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+			mockNower *nower.MockNower,
+		)
+		expectedError error
+	}{
+		{
+			name:   "events are queued for matching subscriptions",
+			events: []usecase2.Event{assigned, merged},
+			setupMock: func(
+				mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions,
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockNower *nower.MockNower,
+			) {
+				mockSubscriptions.EXPECT().
+					GetWebhookSubscriptions(gomock.Any()).
+					Return(&[]webhook_subscriptions2.WebhookSubscriptionOut{assignedSubscription, allSubscription}, nil)
+				mockNower.EXPECT().Now().Return(now)
+				mockDeliveries.EXPECT().
+					SaveWebhookEventDeliveriesBatch(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, deliveries []webhook_event_deliveries2.WebhookEventDeliveryIn) (*[]webhook_event_deliveries2.WebhookEventDeliveryOut, error) {
+						require.Len(t, deliveries, 3)
+						assert.Equal(t, assignedSubscription.ID, deliveries[0].SubscriptionID)
+						assert.Equal(t, allSubscription.ID, deliveries[1].SubscriptionID)
+						assert.Equal(t, allSubscription.ID, deliveries[2].SubscriptionID)
+						assert.Equal(t, usecase2.EventPullRequestMerged, deliveries[2].Event)
+						for _, delivery := range deliveries {
+							assert.Equal(t, usecase2.WebhookDeliveryPendingStatus, delivery.Status)
+							assert.Equal(t, now, delivery.NextAttemptAt)
+						}
+
+						var payload Payload
+						require.NoError(t, json.Unmarshal(deliveries[0].Payload, &payload))
+						assert.Equal(t, Payload{
+							EventID:    eventID,
+							Event:      usecase2.EventReviewerAssigned,
+							OccurredAt: now,
+							PullRequest: PayloadPullRequest{
+								PullRequestID:   prID,
+								PullRequestName: "Add feature",
+								AuthorID:        authorID,
+							},
+							ReviewerID: &reviewerID,
+						}, payload)
+
+						var mergedPayload Payload
+						require.NoError(t, json.Unmarshal(deliveries[2].Payload, &mergedPayload))
+						assert.Nil(t, mergedPayload.ReviewerID)
+						assert.Equal(t, now.Add(-time.Minute), mergedPayload.OccurredAt)
+						return &[]webhook_event_deliveries2.WebhookEventDeliveryOut{}, nil
+					})
+			},
+		},
+		{
+			name:   "no subscriptions queue nothing",
+			events: []usecase2.Event{assigned},
+			setupMock: func(
+				mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions,
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockNower *nower.MockNower,
+			) {
+				mockSubscriptions.EXPECT().
+					GetWebhookSubscriptions(gomock.Any()).
+					Return(&[]webhook_subscriptions2.WebhookSubscriptionOut{}, nil)
+			},
+		},
+		{
+			name:   "no matching subscriptions queue nothing",
+			events: []usecase2.Event{merged},
+			setupMock: func(
+				mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions,
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockNower *nower.MockNower,
+			) {
+				mockSubscriptions.EXPECT().
+					GetWebhookSubscriptions(gomock.Any()).
+					Return(&[]webhook_subscriptions2.WebhookSubscriptionOut{assignedSubscription}, nil)
+				mockNower.EXPECT().Now().Return(now)
+			},
+		},
+		{
+			name: "no events do nothing",
+			setupMock: func(
+				mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions,
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockNower *nower.MockNower,
+			) {
+			},
+		},
+		{
+			name:   "get subscriptions error",
+			events: []usecase2.Event{assigned},
+			setupMock: func(
+				mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions,
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockNower *nower.MockNower,
+			) {
+				mockSubscriptions.EXPECT().
+					GetWebhookSubscriptions(gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrGetWebhookSubscriptions,
+		},
+		{
+			name:   "save deliveries error",
+			events: []usecase2.Event{assigned},
+			setupMock: func(
+				mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions,
+				mockDeliveries *webhook_event_deliveries.MockRepositoryWebhookEventDeliveries,
+				mockNower *nower.MockNower,
+			) {
+				mockSubscriptions.EXPECT().
+					GetWebhookSubscriptions(gomock.Any()).
+					Return(&[]webhook_subscriptions2.WebhookSubscriptionOut{assignedSubscription}, nil)
+				mockNower.EXPECT().Now().Return(now)
+				mockDeliveries.EXPECT().
+					SaveWebhookEventDeliveriesBatch(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrSaveWebhookDeliveries,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSubscriptions := webhook_subscriptions.NewMockRepositoryWebhookSubscriptions(ctrl)
+			mockDeliveries := webhook_event_deliveries.NewMockRepositoryWebhookEventDeliveries(ctrl)
+			mockNower := nower.NewMockNower(ctrl)
+
+			tt.setupMock(mockSubscriptions, mockDeliveries, mockNower)
+
+			u := NewUsecase(mockSubscriptions, mockDeliveries, mockNower)
+
+			err := u.Publish(context.Background(), tt.events)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
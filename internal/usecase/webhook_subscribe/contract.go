@@ -0,0 +1,15 @@
+package webhook_subscribe
+
+import (
+	usecase2 "pr-reviewers-service/internal/usecase"
+)
+
+type In struct {
+	URL    string
+	Secret string
+	Events []string
+}
+
+type Out struct {
+	Subscription usecase2.WebhookSubscription
+}
@@ -0,0 +1,50 @@
+package webhook_subscribe
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	webhook_subscriptions2 "pr-reviewers-service/internal/infrastructure/repository/webhook_subscriptions"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/webhook_subscriptions"
+)
+
+type usecase struct {
+	repSubscriptions webhook_subscriptions.RepositoryWebhookSubscriptions
+}
+
+func NewUsecase(repSubscriptions webhook_subscriptions.RepositoryWebhookSubscriptions) *usecase {
+	return &usecase{
+		repSubscriptions: repSubscriptions,
+	}
+}
+
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	events := make([]string, 0, len(req.Events))
+	for _, event := range req.Events {
+		if !usecase2.IsKnownEventType(event) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %q", usecase2.ErrUnknownWebhookEvent, event))
+		}
+		if !slices.Contains(events, event) {
+			events = append(events, event)
+		}
+	}
+
+	slog.DebugContext(ctx, "Call SaveWebhookSubscription", "url", req.URL, "events", events)
+	subscription, err := u.repSubscriptions.SaveWebhookSubscription(ctx, webhook_subscriptions2.WebhookSubscriptionIn{
+		URL:    req.URL,
+		Secret: req.Secret,
+		Events: events,
+	})
+	if err != nil {
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrSaveWebhookSubscription, req.URL))
+	}
+
+	slog.DebugContext(ctx, "UseCase WebhookSubscribe success", "subscription_id", subscription.ID)
+	return &Out{
+		Subscription: usecase2.NewWebhookSubscription(*subscription),
+	}, nil
+}
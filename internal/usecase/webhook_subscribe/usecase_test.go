@@ -0,0 +1,110 @@
+package webhook_subscribe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	webhook_subscriptions2 "pr-reviewers-service/internal/infrastructure/repository/webhook_subscriptions"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	webhook_subscriptions "pr-reviewers-service/internal/usecase/contract/repository/webhook_subscriptions/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	subscriptionID := uuid.New()
+	createdAt := time.Date(2025, 12, 10, 9, 0, 0, 0, time.UTC)
+	events := []string{usecase2.EventReviewerAssigned, usecase2.EventPullRequestMerged}
+
+	tests := []struct {
+		name          string
+		req           In
+		setupMock     func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "subscription is saved with duplicate events dropped",
+			req: In{
+				URL:    "https://hooks.example.com",
+				Secret: "subscriber-secret",
+				Events: []string{usecase2.EventReviewerAssigned, usecase2.EventPullRequestMerged, usecase2.EventReviewerAssigned},
+			},
+			setupMock: func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions) {
+				mockSubscriptions.EXPECT().
+					SaveWebhookSubscription(gomock.Any(), webhook_subscriptions2.WebhookSubscriptionIn{
+						URL:    "https://hooks.example.com",
+						Secret: "subscriber-secret",
+						Events: events,
+					}).
+					Return(&webhook_subscriptions2.WebhookSubscriptionOut{
+						ID:        subscriptionID,
+						URL:       "https://hooks.example.com",
+						Secret:    "subscriber-secret",
+						Events:    events,
+						CreatedAt: createdAt,
+					}, nil)
+			},
+			expected: &Out{
+				Subscription: usecase2.WebhookSubscription{
+					ID:        subscriptionID,
+					URL:       "https://hooks.example.com",
+					Events:    events,
+					CreatedAt: createdAt,
+				},
+			},
+		},
+		{
+			name: "unknown event",
+			req: In{
+				URL:    "https://hooks.example.com",
+				Secret: "subscriber-secret",
+				Events: []string{usecase2.EventReviewerAssigned, "team.created"},
+			},
+			setupMock:     func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions) {},
+			expectedError: usecase2.ErrUnknownWebhookEvent,
+		},
+		{
+			name: "save subscription error",
+			req: In{
+				URL:    "https://hooks.example.com",
+				Secret: "subscriber-secret",
+				Events: events,
+			},
+			setupMock: func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions) {
+				mockSubscriptions.EXPECT().
+					SaveWebhookSubscription(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db error"))
+			},
+			expectedError: usecase2.ErrSaveWebhookSubscription,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSubscriptions := webhook_subscriptions.NewMockRepositoryWebhookSubscriptions(ctrl)
+			tt.setupMock(mockSubscriptions)
+
+			u := NewUsecase(mockSubscriptions)
+
+			result, err := u.Run(context.Background(), tt.req)
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package webhook_unsubscribe
+
+import (
+	"github.com/google/uuid"
+)
+
+type In struct {
+	SubscriptionID uuid.UUID
+}
+
+type Out struct {
+	SubscriptionID uuid.UUID
+}
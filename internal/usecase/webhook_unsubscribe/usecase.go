@@ -0,0 +1,39 @@
+package webhook_unsubscribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	"pr-reviewers-service/internal/logging"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	"pr-reviewers-service/internal/usecase/contract/repository/webhook_subscriptions"
+)
+
+type usecase struct {
+	repSubscriptions webhook_subscriptions.RepositoryWebhookSubscriptions
+}
+
+func NewUsecase(repSubscriptions webhook_subscriptions.RepositoryWebhookSubscriptions) *usecase {
+	return &usecase{
+		repSubscriptions: repSubscriptions,
+	}
+}
+
+// Run removes the subscription, its delivery log is removed with it.
+func (u *usecase) Run(ctx context.Context, req In) (*Out, error) {
+	slog.DebugContext(ctx, "Call DeleteWebhookSubscription", "subscription_id", req.SubscriptionID)
+	if err := u.repSubscriptions.DeleteWebhookSubscription(ctx, req.SubscriptionID); err != nil {
+		if errors.Is(err, repository.ErrSubscriptionNotFound) {
+			return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrWebhookSubscriptionNotFound, req.SubscriptionID))
+		}
+		return nil, logging.WrapError(ctx, fmt.Errorf("%w: %s", usecase2.ErrDeleteWebhookSubscription, req.SubscriptionID))
+	}
+
+	slog.DebugContext(ctx, "UseCase WebhookUnsubscribe success", "subscription_id", req.SubscriptionID)
+	return &Out{
+		SubscriptionID: req.SubscriptionID,
+	}, nil
+}
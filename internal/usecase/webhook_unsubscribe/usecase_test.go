@@ -0,0 +1,77 @@
+package webhook_unsubscribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"pr-reviewers-service/internal/infrastructure/repository"
+	usecase2 "pr-reviewers-service/internal/usecase"
+	webhook_subscriptions "pr-reviewers-service/internal/usecase/contract/repository/webhook_subscriptions/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookUnsubscribe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	subscriptionID := uuid.New()
+
+	tests := []struct {
+		name          string
+		setupMock     func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions)
+		expected      *Out
+		expectedError error
+	}{
+		{
+			name: "subscription is deleted",
+			setupMock: func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions) {
+				mockSubscriptions.EXPECT().DeleteWebhookSubscription(gomock.Any(), subscriptionID).Return(nil)
+			},
+			expected: &Out{SubscriptionID: subscriptionID},
+		},
+		{
+			name: "subscription not found",
+			setupMock: func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions) {
+				mockSubscriptions.EXPECT().
+					DeleteWebhookSubscription(gomock.Any(), subscriptionID).
+					Return(fmt.Errorf("%w: %s", repository.ErrSubscriptionNotFound, subscriptionID))
+			},
+			expectedError: usecase2.ErrWebhookSubscriptionNotFound,
+		},
+		{
+			name: "delete subscription error",
+			setupMock: func(mockSubscriptions *webhook_subscriptions.MockRepositoryWebhookSubscriptions) {
+				mockSubscriptions.EXPECT().
+					DeleteWebhookSubscription(gomock.Any(), subscriptionID).
+					Return(errors.New("db error"))
+			},
+			expectedError: usecase2.ErrDeleteWebhookSubscription,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSubscriptions := webhook_subscriptions.NewMockRepositoryWebhookSubscriptions(ctrl)
+			tt.setupMock(mockSubscriptions)
+
+			u := NewUsecase(mockSubscriptions)
+
+			result, err := u.Run(context.Background(), In{SubscriptionID: subscriptionID})
+
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"time"
+
+	"pr-reviewers-service/internal/infrastructure/repository/webhook_event_deliveries"
+	"pr-reviewers-service/internal/infrastructure/repository/webhook_subscriptions"
+
+	"github.com/google/uuid"
+)
+
+// WebhookSubscription is a subscriber endpoint as shown to admins, the signing secret is never exposed.
+type WebhookSubscription struct {
+	ID        uuid.UUID
+	URL       string
+	Events    []string
+	CreatedAt time.Time
+}
+
+type WebhookDelivery struct {
+	ID             uuid.UUID
+	SubscriptionID uuid.UUID
+	EventID        uuid.UUID
+	Event          string
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      *string
+	ResponseCode   *int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewWebhookSubscription(subscription webhook_subscriptions.WebhookSubscriptionOut) WebhookSubscription {
+	return WebhookSubscription{
+		ID:        subscription.ID,
+		URL:       subscription.URL,
+		Events:    subscription.Events,
+		CreatedAt: subscription.CreatedAt,
+	}
+}
+
+func NewWebhookDelivery(delivery webhook_event_deliveries.WebhookEventDeliveryOut) WebhookDelivery {
+	return WebhookDelivery{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		Event:          delivery.Event,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastError:      delivery.LastError,
+		ResponseCode:   delivery.ResponseCode,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS team_memberships (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    is_primary BOOLEAN NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT uq_team_memberships_user_team UNIQUE (user_id, team_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_team_memberships_primary ON team_memberships (user_id) WHERE is_primary;
+CREATE INDEX IF NOT EXISTS idx_team_memberships_team_id ON team_memberships (team_id);
+
+ALTER TABLE team_memberships DROP CONSTRAINT IF EXISTS fk_team_memberships_user_id;
+ALTER TABLE team_memberships DROP CONSTRAINT IF EXISTS fk_team_memberships_team_id;
+
+ALTER TABLE team_memberships ADD CONSTRAINT fk_team_memberships_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE team_memberships ADD CONSTRAINT fk_team_memberships_team_id FOREIGN KEY (team_id) REFERENCES teams(id) ON DELETE CASCADE;
+
+INSERT INTO team_memberships (id, user_id, team_id, is_primary, created_at)
+SELECT gen_random_uuid(), id, team_id, TRUE, created_at
+FROM users
+ON CONFLICT (user_id, team_id) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE team_memberships DROP CONSTRAINT IF EXISTS fk_team_memberships_team_id;
+ALTER TABLE team_memberships DROP CONSTRAINT IF EXISTS fk_team_memberships_user_id;
+
+DROP TABLE IF EXISTS team_memberships;
+-- +goose StatementEnd
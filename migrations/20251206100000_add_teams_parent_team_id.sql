@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS parent_team_id UUID NULL;
+
+CREATE INDEX IF NOT EXISTS idx_teams_parent_team_id ON teams (parent_team_id);
+
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS fk_teams_parent_team_id;
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS chk_teams_parent_team_id_not_self;
+
+ALTER TABLE teams ADD CONSTRAINT fk_teams_parent_team_id FOREIGN KEY (parent_team_id) REFERENCES teams(id) ON DELETE SET NULL;
+ALTER TABLE teams ADD CONSTRAINT chk_teams_parent_team_id_not_self CHECK (parent_team_id IS NULL OR parent_team_id <> id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS chk_teams_parent_team_id_not_self;
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS fk_teams_parent_team_id;
+
+DROP INDEX IF EXISTS idx_teams_parent_team_id;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS parent_team_id;
+-- +goose StatementEnd
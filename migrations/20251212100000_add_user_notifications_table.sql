@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS user_notifications (
+    kind VARCHAR(32) NOT NULL,
+    user_id UUID NOT NULL,
+    sent_on DATE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (kind, user_id, sent_on)
+);
+
+ALTER TABLE user_notifications DROP CONSTRAINT IF EXISTS fk_user_notifications_user_id;
+
+ALTER TABLE user_notifications ADD CONSTRAINT fk_user_notifications_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_notifications DROP CONSTRAINT IF EXISTS fk_user_notifications_user_id;
+
+DROP TABLE IF EXISTS user_notifications;
+-- +goose StatementEnd
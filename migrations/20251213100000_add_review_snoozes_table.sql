@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS review_snoozes (
+    pr_id UUID NOT NULL,
+    reviewer_id UUID NOT NULL,
+    snoozed_until TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (pr_id, reviewer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_snoozes_snoozed_until ON review_snoozes (snoozed_until);
+
+ALTER TABLE review_snoozes DROP CONSTRAINT IF EXISTS fk_review_snoozes_pr_id;
+
+ALTER TABLE review_snoozes ADD CONSTRAINT fk_review_snoozes_pr_id FOREIGN KEY (pr_id) REFERENCES pull_requests(id) ON DELETE CASCADE;
+
+ALTER TABLE review_snoozes DROP CONSTRAINT IF EXISTS fk_review_snoozes_reviewer_id;
+
+ALTER TABLE review_snoozes ADD CONSTRAINT fk_review_snoozes_reviewer_id FOREIGN KEY (reviewer_id) REFERENCES users(id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE review_snoozes DROP CONSTRAINT IF EXISTS fk_review_snoozes_reviewer_id;
+
+ALTER TABLE review_snoozes DROP CONSTRAINT IF EXISTS fk_review_snoozes_pr_id;
+
+DROP TABLE IF EXISTS review_snoozes;
+-- +goose StatementEnd
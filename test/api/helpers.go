@@ -33,7 +33,7 @@ func dummyLogin() (handler.DummyLoginOut, int, handler.ErrorResponse, error) {
 
 func createPullRequest(token string, authorID, prID uuid.UUID, prName string) (handler.CreatePullRequestResponse, int, handler.ErrorResponse, error) {
 	in := handler.PostPullRequestCreateJSONRequestBody{
-		AuthorId:        authorID,
+		AuthorId:        authorID.String(),
 		PullRequestId:   prID,
 		PullRequestName: prName,
 	}